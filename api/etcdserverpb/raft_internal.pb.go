@@ -85,6 +85,7 @@ type InternalRaftRequest struct {
 	LeaseRevoke              *LeaseRevokeRequest                       `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke,proto3" json:"lease_revoke,omitempty"`
 	Alarm                    *AlarmRequest                             `protobuf:"bytes,10,opt,name=alarm,proto3" json:"alarm,omitempty"`
 	LeaseCheckpoint          *LeaseCheckpointRequest                   `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint,proto3" json:"lease_checkpoint,omitempty"`
+	CompactionBarrier        *CompactionBarrierRequest                 `protobuf:"bytes,12,opt,name=compaction_barrier,json=compactionBarrier,proto3" json:"compaction_barrier,omitempty"`
 	AuthEnable               *AuthEnableRequest                        `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable,proto3" json:"auth_enable,omitempty"`
 	AuthDisable              *AuthDisableRequest                       `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable,proto3" json:"auth_disable,omitempty"`
 	AuthStatus               *AuthStatusRequest                        `protobuf:"bytes,1013,opt,name=auth_status,json=authStatus,proto3" json:"auth_status,omitempty"`
@@ -239,76 +240,78 @@ func init() {
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptor_b4c9a9be0cfca103) }
 
 var fileDescriptor_b4c9a9be0cfca103 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xcb, 0x72, 0x1b, 0x45,
-	0x14, 0x8d, 0x6c, 0xc7, 0xb6, 0x5a, 0xb6, 0xe3, 0xb4, 0x9d, 0xa4, 0xb1, 0xab, 0x8c, 0xe3, 0x90,
-	0x60, 0x20, 0xc8, 0xc1, 0x06, 0xaa, 0x60, 0x03, 0x8a, 0xe5, 0x72, 0x4c, 0x25, 0x29, 0xd7, 0xc4,
-	0x50, 0x29, 0x28, 0x6a, 0x68, 0xcd, 0x5c, 0x4b, 0x13, 0x8f, 0x66, 0x86, 0xee, 0x96, 0xe2, 0x6c,
-	0x59, 0xb2, 0x06, 0x8a, 0x8f, 0x60, 0xc1, 0x2b, 0xff, 0x90, 0x05, 0x8f, 0x00, 0x3f, 0x00, 0x66,
-	0xc3, 0x1e, 0xd8, 0xa7, 0xfa, 0x31, 0x2f, 0xa9, 0xe5, 0xdd, 0xe8, 0xde, 0x73, 0xcf, 0x39, 0xdd,
-	0x7d, 0xbb, 0x75, 0xd1, 0x02, 0xa3, 0x87, 0xc2, 0x0d, 0x22, 0x01, 0x2c, 0xa2, 0x61, 0x3d, 0x61,
-	0xb1, 0x88, 0xf1, 0x0c, 0x08, 0xcf, 0xe7, 0xc0, 0xfa, 0xc0, 0x92, 0xd6, 0xd2, 0x62, 0x3b, 0x6e,
-	0xc7, 0x2a, 0xb1, 0x21, 0xbf, 0x34, 0x66, 0x69, 0x3e, 0xc7, 0x98, 0x48, 0x95, 0x25, 0x9e, 0xf9,
-	0x5c, 0x95, 0xc9, 0x0d, 0x9a, 0x04, 0x1b, 0x7d, 0x60, 0x3c, 0x88, 0xa3, 0xa4, 0x95, 0x7e, 0x19,
-	0xc4, 0xb5, 0x0c, 0xd1, 0x85, 0x6e, 0x0b, 0x18, 0xef, 0x04, 0x49, 0xd2, 0x2a, 0xfc, 0xd0, 0xb8,
-	0x35, 0x86, 0x66, 0x1d, 0xf8, 0xb4, 0x07, 0x5c, 0xdc, 0x02, 0xea, 0x03, 0xc3, 0x73, 0x68, 0x6c,
-	0xaf, 0x49, 0x2a, 0xab, 0x95, 0xf5, 0x09, 0x67, 0x6c, 0xaf, 0x89, 0x97, 0xd0, 0x74, 0x8f, 0x4b,
-	0xf3, 0x5d, 0x20, 0x63, 0xab, 0x95, 0xf5, 0xaa, 0x93, 0xfd, 0xc6, 0xd7, 0xd1, 0x2c, 0xed, 0x89,
-	0x8e, 0xcb, 0xa0, 0x1f, 0x48, 0x6d, 0x32, 0x2e, 0xcb, 0x6e, 0x4e, 0x7d, 0xfe, 0x98, 0x8c, 0x6f,
-	0xd5, 0x5f, 0x73, 0x66, 0x64, 0xd6, 0x31, 0xc9, 0xb7, 0xa7, 0x3e, 0x53, 0xe1, 0x1b, 0x6b, 0x8f,
-	0x17, 0xd0, 0xc2, 0x9e, 0xd9, 0x11, 0x87, 0x1e, 0x0a, 0x63, 0x00, 0x6f, 0xa1, 0xc9, 0x8e, 0x32,
-	0x41, 0xfc, 0xd5, 0xca, 0x7a, 0x6d, 0x73, 0xb9, 0x5e, 0xdc, 0xa7, 0x7a, 0xc9, 0xa7, 0x63, 0xa0,
-	0x43, 0x7e, 0xaf, 0xa2, 0xb1, 0xfe, 0xa6, 0x72, 0x5a, 0xdb, 0xbc, 0x60, 0x25, 0x70, 0xc6, 0xfa,
-	0x9b, 0xf8, 0x06, 0x3a, 0xcb, 0x68, 0xd4, 0x06, 0x65, 0xb9, 0xb6, 0xb9, 0x34, 0x80, 0x94, 0xa9,
-	0x14, 0xae, 0x81, 0xf8, 0x65, 0x34, 0x9e, 0xf4, 0x04, 0x99, 0x50, 0x78, 0x52, 0xc6, 0xef, 0xf7,
-	0xd2, 0x45, 0x38, 0x12, 0x84, 0xb7, 0xd1, 0x8c, 0x0f, 0x21, 0x08, 0x70, 0xb5, 0xc8, 0x59, 0x55,
-	0xb4, 0x5a, 0x2e, 0x6a, 0x2a, 0x44, 0x49, 0xaa, 0xe6, 0xe7, 0x31, 0x29, 0x28, 0x8e, 0x23, 0x32,
-	0x69, 0x13, 0x3c, 0x38, 0x8e, 0x32, 0x41, 0x71, 0x1c, 0xe1, 0x77, 0x10, 0xf2, 0xe2, 0x6e, 0x42,
-	0x3d, 0x21, 0x8f, 0x61, 0x4a, 0x95, 0x3c, 0x5f, 0x2e, 0xd9, 0xce, 0xf2, 0x69, 0x65, 0xa1, 0x04,
-	0xbf, 0x8b, 0x6a, 0x21, 0x50, 0x0e, 0x6e, 0x9b, 0xd1, 0x48, 0x90, 0x69, 0x1b, 0xc3, 0x6d, 0x09,
-	0xd8, 0x95, 0xf9, 0x8c, 0x21, 0xcc, 0x42, 0x72, 0xcd, 0x9a, 0x81, 0x41, 0x3f, 0x3e, 0x02, 0x52,
-	0xb5, 0xad, 0x59, 0x51, 0x38, 0x0a, 0x90, 0xad, 0x39, 0xcc, 0x63, 0xf2, 0x58, 0x68, 0x48, 0x59,
-	0x97, 0x20, 0xdb, 0xb1, 0x34, 0x64, 0x2a, 0x3b, 0x16, 0x05, 0xc4, 0xf7, 0xd1, 0xbc, 0x96, 0xf5,
-	0x3a, 0xe0, 0x1d, 0x25, 0x71, 0x10, 0x09, 0x52, 0x53, 0xc5, 0x2f, 0x58, 0xa4, 0xb7, 0x33, 0x90,
-	0xa1, 0x49, 0x9b, 0xf5, 0x75, 0xe7, 0x5c, 0x58, 0x06, 0xe0, 0x06, 0xaa, 0xa9, 0xee, 0x86, 0x88,
-	0xb6, 0x42, 0x20, 0xff, 0x58, 0x77, 0xb5, 0xd1, 0x13, 0x9d, 0x1d, 0x05, 0xc8, 0xf6, 0x84, 0x66,
-	0x21, 0xdc, 0x44, 0xea, 0x0a, 0xb8, 0x7e, 0xc0, 0x15, 0xc7, 0xbf, 0x53, 0xb6, 0x4d, 0x91, 0x1c,
-	0x4d, 0x8d, 0xc8, 0x36, 0x85, 0xe6, 0x31, 0xfc, 0x9e, 0x31, 0xc2, 0x05, 0x15, 0x3d, 0x4e, 0xfe,
-	0x1f, 0x69, 0xe4, 0x9e, 0x02, 0x0c, 0xac, 0xec, 0x0d, 0xed, 0x48, 0xe7, 0xf0, 0x5d, 0xed, 0x08,
-	0x22, 0x11, 0x78, 0x54, 0x00, 0xf9, 0x4f, 0x93, 0xbd, 0x54, 0x26, 0x4b, 0x6f, 0x67, 0xa3, 0x00,
-	0x4d, 0xad, 0x95, 0xea, 0xf1, 0x8e, 0x79, 0x02, 0xe4, 0x9b, 0xe0, 0x52, 0xdf, 0x27, 0x3f, 0x4d,
-	0x8f, 0x5a, 0xe2, 0xfb, 0x1c, 0x58, 0xc3, 0xf7, 0x4b, 0x4b, 0x34, 0x31, 0x7c, 0x17, 0xcd, 0xe7,
-	0x34, 0xfa, 0x12, 0x90, 0x9f, 0x35, 0xd3, 0x15, 0x3b, 0x93, 0xb9, 0x3d, 0x86, 0x6c, 0x8e, 0x96,
-	0xc2, 0x65, 0x5b, 0x6d, 0x10, 0xe4, 0x97, 0x53, 0x6d, 0xed, 0x82, 0x18, 0xb2, 0xb5, 0x0b, 0x02,
-	0xb7, 0xd1, 0x73, 0x39, 0x8d, 0xd7, 0x91, 0xd7, 0xd2, 0x4d, 0x28, 0xe7, 0x0f, 0x63, 0xe6, 0x93,
-	0x5f, 0x35, 0xe5, 0x2b, 0x76, 0xca, 0x6d, 0x85, 0xde, 0x37, 0xe0, 0x94, 0xfd, 0x22, 0xb5, 0xa6,
-	0xf1, 0x7d, 0xb4, 0x58, 0xf0, 0x2b, 0xef, 0x93, 0xcb, 0xe2, 0x10, 0xc8, 0x53, 0xad, 0x71, 0x6d,
-	0x84, 0x6d, 0x75, 0x17, 0xe3, 0xbc, 0x6d, 0xce, 0xd3, 0xc1, 0x0c, 0xfe, 0x08, 0x5d, 0xc8, 0x99,
-	0xf5, 0xd5, 0xd4, 0xd4, 0xbf, 0x69, 0xea, 0x17, 0xed, 0xd4, 0xe6, 0x8e, 0x16, 0xb8, 0x31, 0x1d,
-	0x4a, 0xe1, 0x5b, 0x68, 0x2e, 0x27, 0x0f, 0x03, 0x2e, 0xc8, 0xef, 0x9a, 0xf5, 0xb2, 0x9d, 0xf5,
-	0x76, 0xc0, 0x45, 0xa9, 0x8f, 0xd2, 0x60, 0xc6, 0x24, 0xad, 0x69, 0xa6, 0x3f, 0x46, 0x32, 0x49,
-	0xe9, 0x21, 0xa6, 0x34, 0x98, 0x1d, 0xbd, 0x62, 0x92, 0x1d, 0xf9, 0x6d, 0x75, 0xd4, 0xd1, 0xcb,
-	0x9a, 0xc1, 0x8e, 0x34, 0xb1, 0xac, 0x23, 0x15, 0x8d, 0xe9, 0xc8, 0xef, 0xaa, 0xa3, 0x3a, 0x52,
-	0x56, 0x59, 0x3a, 0x32, 0x0f, 0x97, 0x6d, 0xc9, 0x8e, 0xfc, 0xfe, 0x54, 0x5b, 0x83, 0x1d, 0x69,
-	0x62, 0xf8, 0x01, 0x5a, 0x2a, 0xd0, 0xa8, 0x46, 0x49, 0x80, 0x75, 0x03, 0xae, 0xfe, 0x7f, 0x7f,
-	0xd0, 0x9c, 0xd7, 0x47, 0x70, 0x4a, 0xf8, 0x7e, 0x86, 0x4e, 0xf9, 0x2f, 0x51, 0x7b, 0x1e, 0x77,
-	0xd1, 0x72, 0xae, 0x65, 0x5a, 0xa7, 0x20, 0xf6, 0xa3, 0x16, 0x7b, 0xd5, 0x2e, 0xa6, 0xbb, 0x64,
-	0x58, 0x8d, 0xd0, 0x11, 0x00, 0xfc, 0x09, 0x5a, 0xf0, 0xc2, 0x1e, 0x17, 0xc0, 0x5c, 0x33, 0xcb,
-	0xb8, 0x1c, 0x04, 0xf9, 0x02, 0x99, 0x2b, 0x50, 0x1c, 0x64, 0xea, 0xdb, 0x1a, 0xf9, 0x81, 0x06,
-	0xde, 0x03, 0x31, 0xf4, 0xea, 0x9d, 0xf7, 0x06, 0x21, 0xf8, 0x01, 0xba, 0x94, 0x2a, 0x68, 0x32,
-	0x97, 0x0a, 0xc1, 0x94, 0xca, 0x97, 0xc8, 0xbc, 0x83, 0x36, 0x95, 0x3b, 0x2a, 0xd6, 0x10, 0x82,
-	0xd9, 0x84, 0x16, 0x3d, 0x0b, 0x0a, 0x7f, 0x8c, 0xb0, 0x1f, 0x3f, 0x8c, 0xda, 0x8c, 0xfa, 0xe0,
-	0x06, 0xd1, 0x61, 0xac, 0x64, 0xbe, 0xd2, 0x32, 0x57, 0xcb, 0x32, 0xcd, 0x14, 0xb8, 0x17, 0x1d,
-	0xc6, 0x36, 0x89, 0x79, 0x7f, 0x00, 0x81, 0x03, 0x74, 0x31, 0xa7, 0x4f, 0xb7, 0x4b, 0x00, 0x17,
-	0xe4, 0x9b, 0x3b, 0xb6, 0x17, 0x3d, 0x93, 0x30, 0xdb, 0x71, 0x00, 0x7c, 0x50, 0xe6, 0x4d, 0x67,
-	0xd1, 0xb7, 0xa0, 0xf2, 0xb9, 0xed, 0x1c, 0x9a, 0xdd, 0xe9, 0x26, 0xe2, 0x91, 0x03, 0x3c, 0x89,
-	0x23, 0x0e, 0x6b, 0x8f, 0xd0, 0xf2, 0x29, 0xff, 0x14, 0x18, 0xa3, 0x09, 0x35, 0x36, 0x56, 0xd4,
-	0xd8, 0xa8, 0xbe, 0xe5, 0x38, 0x99, 0x3d, 0xa0, 0x66, 0x9c, 0x4c, 0x7f, 0xe3, 0xcb, 0x68, 0x86,
-	0x07, 0xdd, 0x24, 0x04, 0x57, 0xc4, 0x47, 0xa0, 0xa7, 0xc9, 0xaa, 0x53, 0xd3, 0xb1, 0x03, 0x19,
-	0xca, 0xbc, 0xdc, 0x7c, 0xeb, 0xc9, 0x5f, 0x2b, 0x67, 0x9e, 0x9c, 0xac, 0x54, 0x9e, 0x9e, 0xac,
-	0x54, 0xfe, 0x3c, 0x59, 0xa9, 0x7c, 0xfd, 0xf7, 0xca, 0x99, 0x0f, 0xaf, 0xb4, 0x63, 0xb5, 0xec,
-	0x7a, 0x10, 0x6f, 0xe4, 0x23, 0xf2, 0xd6, 0x46, 0x71, 0x2b, 0x5a, 0x93, 0x6a, 0xf2, 0xdd, 0x7a,
-	0x16, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xa0, 0x15, 0x1f, 0x9b, 0x0b, 0x00, 0x00,
+	// 1128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xdb, 0x53, 0x1c, 0xc5,
+	0x17, 0xce, 0x02, 0x01, 0xb6, 0x17, 0x08, 0x34, 0x24, 0xe9, 0x1f, 0x54, 0xf1, 0x23, 0xc4, 0x44,
+	0xd4, 0xb8, 0x44, 0xf0, 0x52, 0xfa, 0xa2, 0x0b, 0x4b, 0x11, 0xac, 0x24, 0x45, 0x4d, 0xd0, 0x4a,
+	0x69, 0xe9, 0xd8, 0x3b, 0x73, 0xd8, 0x9d, 0x30, 0x3b, 0x33, 0x76, 0xf7, 0x6e, 0xc8, 0xab, 0x8f,
+	0x3e, 0x1b, 0xcb, 0x3f, 0xc2, 0x07, 0xaf, 0xff, 0x43, 0x1e, 0xbc, 0x44, 0xfd, 0x07, 0x14, 0x5f,
+	0x7c, 0x57, 0xdf, 0xad, 0xbe, 0xcc, 0x6d, 0xe9, 0xe5, 0x6d, 0xf6, 0x9c, 0xef, 0x7c, 0xdf, 0xd7,
+	0xdd, 0xa7, 0x7b, 0x0f, 0x9a, 0x67, 0xf4, 0x50, 0xb8, 0x41, 0x24, 0x80, 0x45, 0x34, 0xac, 0x27,
+	0x2c, 0x16, 0x31, 0x9e, 0x02, 0xe1, 0xf9, 0x1c, 0x58, 0x1f, 0x58, 0xd2, 0x5a, 0x5c, 0x68, 0xc7,
+	0xed, 0x58, 0x25, 0xd6, 0xe5, 0x97, 0xc6, 0x2c, 0xce, 0xe6, 0x18, 0x13, 0xa9, 0xb2, 0xc4, 0x33,
+	0x9f, 0x2b, 0x32, 0xb9, 0x4e, 0x93, 0x60, 0xbd, 0x0f, 0x8c, 0x07, 0x71, 0x94, 0xb4, 0xd2, 0x2f,
+	0x83, 0xb8, 0x9e, 0x21, 0xba, 0xd0, 0x6d, 0x01, 0xe3, 0x9d, 0x20, 0x49, 0x5a, 0x85, 0x1f, 0x1a,
+	0xb7, 0xca, 0xd0, 0xb4, 0x03, 0x1f, 0xf7, 0x80, 0x8b, 0x5b, 0x40, 0x7d, 0x60, 0x78, 0x06, 0x8d,
+	0xec, 0x35, 0x49, 0x65, 0xa5, 0xb2, 0x36, 0xe6, 0x8c, 0xec, 0x35, 0xf1, 0x22, 0x9a, 0xec, 0x71,
+	0x69, 0xbe, 0x0b, 0x64, 0x64, 0xa5, 0xb2, 0x56, 0x75, 0xb2, 0xdf, 0xf8, 0x06, 0x9a, 0xa6, 0x3d,
+	0xd1, 0x71, 0x19, 0xf4, 0x03, 0xa9, 0x4d, 0x46, 0x65, 0xd9, 0xd6, 0xc4, 0xa7, 0xdf, 0x93, 0xd1,
+	0xcd, 0xfa, 0x4b, 0xce, 0x94, 0xcc, 0x3a, 0x26, 0xf9, 0xc6, 0xc4, 0x27, 0x2a, 0x7c, 0x73, 0xf5,
+	0xf1, 0x02, 0x9a, 0xdf, 0x33, 0x3b, 0xe2, 0xd0, 0x43, 0x61, 0x0c, 0xe0, 0x4d, 0x34, 0xde, 0x51,
+	0x26, 0x88, 0xbf, 0x52, 0x59, 0xab, 0x6d, 0x2c, 0xd5, 0x8b, 0xfb, 0x54, 0x2f, 0xf9, 0x74, 0xc6,
+	0x3b, 0x76, 0xbf, 0xd7, 0xd0, 0x48, 0x7f, 0x43, 0x39, 0xad, 0x6d, 0x5c, 0xb4, 0x12, 0x38, 0x23,
+	0xfd, 0x0d, 0x7c, 0x13, 0x9d, 0x67, 0x34, 0x6a, 0x83, 0xb2, 0x5c, 0xdb, 0x58, 0x1c, 0x40, 0xca,
+	0x54, 0x0a, 0xd7, 0x40, 0xfc, 0x3c, 0x1a, 0x4d, 0x7a, 0x82, 0x8c, 0x29, 0x3c, 0x29, 0xe3, 0xf7,
+	0x7b, 0xe9, 0x22, 0x1c, 0x09, 0xc2, 0xdb, 0x68, 0xca, 0x87, 0x10, 0x04, 0xb8, 0x5a, 0xe4, 0xbc,
+	0x2a, 0x5a, 0x29, 0x17, 0x35, 0x15, 0xa2, 0x24, 0x55, 0xf3, 0xf3, 0x98, 0x14, 0x14, 0xc7, 0x11,
+	0x19, 0xb7, 0x09, 0x1e, 0x1c, 0x47, 0x99, 0xa0, 0x38, 0x8e, 0xf0, 0x9b, 0x08, 0x79, 0x71, 0x37,
+	0xa1, 0x9e, 0x90, 0xc7, 0x30, 0xa1, 0x4a, 0xfe, 0x5f, 0x2e, 0xd9, 0xce, 0xf2, 0x69, 0x65, 0xa1,
+	0x04, 0xbf, 0x85, 0x6a, 0x21, 0x50, 0x0e, 0x6e, 0x9b, 0xd1, 0x48, 0x90, 0x49, 0x1b, 0xc3, 0x6d,
+	0x09, 0xd8, 0x95, 0xf9, 0x8c, 0x21, 0xcc, 0x42, 0x72, 0xcd, 0x9a, 0x81, 0x41, 0x3f, 0x3e, 0x02,
+	0x52, 0xb5, 0xad, 0x59, 0x51, 0x38, 0x0a, 0x90, 0xad, 0x39, 0xcc, 0x63, 0xf2, 0x58, 0x68, 0x48,
+	0x59, 0x97, 0x20, 0xdb, 0xb1, 0x34, 0x64, 0x2a, 0x3b, 0x16, 0x05, 0xc4, 0xf7, 0xd1, 0xac, 0x96,
+	0xf5, 0x3a, 0xe0, 0x1d, 0x25, 0x71, 0x10, 0x09, 0x52, 0x53, 0xc5, 0xcf, 0x58, 0xa4, 0xb7, 0x33,
+	0x90, 0xa1, 0x49, 0x9b, 0xf5, 0x65, 0xe7, 0x42, 0x58, 0x06, 0xe0, 0x06, 0xaa, 0xa9, 0xee, 0x86,
+	0x88, 0xb6, 0x42, 0x20, 0x7f, 0x59, 0x77, 0xb5, 0xd1, 0x13, 0x9d, 0x1d, 0x05, 0xc8, 0xf6, 0x84,
+	0x66, 0x21, 0xdc, 0x44, 0xea, 0x0a, 0xb8, 0x7e, 0xc0, 0x15, 0xc7, 0xdf, 0x13, 0xb6, 0x4d, 0x91,
+	0x1c, 0xcd, 0x80, 0x17, 0x49, 0x6a, 0x34, 0x8f, 0xe1, 0xb7, 0x8d, 0x11, 0x2e, 0xa8, 0xe8, 0x71,
+	0xf2, 0xef, 0x50, 0x23, 0xf7, 0x14, 0x60, 0x60, 0x65, 0xaf, 0x68, 0x47, 0x3a, 0x87, 0xef, 0x6a,
+	0x47, 0x10, 0x89, 0xc0, 0xa3, 0x02, 0xc8, 0x3f, 0x9a, 0xec, 0xb9, 0x32, 0x59, 0x7a, 0x3b, 0x1b,
+	0x05, 0x68, 0x6a, 0xad, 0x54, 0x8f, 0x77, 0xcc, 0x13, 0xd0, 0xe3, 0xc0, 0x5c, 0xea, 0xfb, 0xe4,
+	0x87, 0xc9, 0x61, 0x4b, 0x7c, 0x87, 0x03, 0x6b, 0xf8, 0x7e, 0x69, 0x89, 0x26, 0x86, 0xef, 0xa2,
+	0xd9, 0x9c, 0x46, 0x5f, 0x02, 0xf2, 0xa3, 0x66, 0xba, 0x6a, 0x67, 0x32, 0xb7, 0xc7, 0x90, 0xcd,
+	0xd0, 0x52, 0xb8, 0x6c, 0xab, 0x0d, 0x82, 0xfc, 0x74, 0xa6, 0xad, 0x5d, 0x10, 0xa7, 0x6c, 0xed,
+	0x82, 0xc0, 0x6d, 0xf4, 0xbf, 0x9c, 0xc6, 0xeb, 0xc8, 0x6b, 0xe9, 0x26, 0x94, 0xf3, 0x87, 0x31,
+	0xf3, 0xc9, 0xcf, 0x9a, 0xf2, 0x05, 0x3b, 0xe5, 0xb6, 0x42, 0xef, 0x1b, 0x70, 0xca, 0x7e, 0x89,
+	0x5a, 0xd3, 0xf8, 0x3e, 0x5a, 0x28, 0xf8, 0x95, 0xf7, 0xc9, 0x65, 0x71, 0x08, 0xe4, 0xa9, 0xd6,
+	0xb8, 0x3e, 0xc4, 0xb6, 0xba, 0x8b, 0x71, 0xde, 0x36, 0x73, 0x74, 0x30, 0x83, 0xdf, 0x47, 0x17,
+	0x73, 0x66, 0x7d, 0x35, 0x35, 0xf5, 0x2f, 0x9a, 0xfa, 0x59, 0x3b, 0xb5, 0xb9, 0xa3, 0x05, 0x6e,
+	0x4c, 0x4f, 0xa5, 0xf0, 0x2d, 0x34, 0x93, 0x93, 0x87, 0x01, 0x17, 0xe4, 0x57, 0xcd, 0x7a, 0xc5,
+	0xce, 0x7a, 0x3b, 0xe0, 0xa2, 0xd4, 0x47, 0x69, 0x30, 0x63, 0x92, 0xd6, 0x34, 0xd3, 0x6f, 0x43,
+	0x99, 0xa4, 0xf4, 0x29, 0xa6, 0x34, 0x98, 0x1d, 0xbd, 0x62, 0x92, 0x1d, 0xf9, 0x55, 0x75, 0xd8,
+	0xd1, 0xcb, 0x9a, 0xc1, 0x8e, 0x34, 0xb1, 0xac, 0x23, 0x15, 0x8d, 0xe9, 0xc8, 0xaf, 0xab, 0xc3,
+	0x3a, 0x52, 0x56, 0x59, 0x3a, 0x32, 0x0f, 0x97, 0x6d, 0xc9, 0x8e, 0xfc, 0xe6, 0x4c, 0x5b, 0x83,
+	0x1d, 0x69, 0x62, 0xf8, 0x01, 0x5a, 0x2c, 0xd0, 0xa8, 0x46, 0x49, 0x80, 0x75, 0x03, 0xae, 0xfe,
+	0x7f, 0xbf, 0xd5, 0x9c, 0x37, 0x86, 0x70, 0x4a, 0xf8, 0x7e, 0x86, 0x4e, 0xf9, 0x2f, 0x53, 0x7b,
+	0x1e, 0x77, 0xd1, 0x52, 0xae, 0x65, 0x5a, 0xa7, 0x20, 0xf6, 0x9d, 0x16, 0x7b, 0xd1, 0x2e, 0xa6,
+	0xbb, 0xe4, 0xb4, 0x1a, 0xa1, 0x43, 0x00, 0xf8, 0x23, 0x34, 0xef, 0x85, 0x3d, 0x2e, 0x80, 0xb9,
+	0x66, 0x96, 0x71, 0x39, 0x08, 0xf2, 0x19, 0x32, 0x57, 0xa0, 0x38, 0xc8, 0xd4, 0xb7, 0x35, 0xf2,
+	0x5d, 0x0d, 0xbc, 0x07, 0xe2, 0xd4, 0xab, 0x37, 0xe7, 0x0d, 0x42, 0xf0, 0x03, 0x74, 0x39, 0x55,
+	0xd0, 0x64, 0x2e, 0x15, 0x82, 0x29, 0x95, 0xc7, 0xc8, 0xbc, 0x83, 0x36, 0x95, 0x3b, 0x2a, 0xd6,
+	0x10, 0x82, 0xd9, 0x84, 0x16, 0x3c, 0x0b, 0x0a, 0x7f, 0x80, 0xb0, 0x1f, 0x3f, 0x8c, 0xda, 0x8c,
+	0xfa, 0xe0, 0x06, 0xd1, 0x61, 0xac, 0x64, 0x3e, 0xd7, 0x32, 0xd7, 0xca, 0x32, 0xcd, 0x14, 0xb8,
+	0x17, 0x1d, 0xc6, 0x36, 0x89, 0x59, 0x7f, 0x00, 0x81, 0x03, 0x74, 0x29, 0xa7, 0x4f, 0xb7, 0x4b,
+	0x00, 0x17, 0xe4, 0xcb, 0x3b, 0xb6, 0x17, 0x3d, 0x93, 0x30, 0xdb, 0x71, 0x00, 0x7c, 0x50, 0xe6,
+	0x55, 0x67, 0xc1, 0xb7, 0xa0, 0xf0, 0x87, 0x08, 0xe7, 0x83, 0x82, 0xdb, 0xa2, 0x8c, 0x05, 0xc0,
+	0xc8, 0x94, 0xed, 0x61, 0xca, 0x67, 0x8c, 0x2d, 0x0d, 0x1b, 0x90, 0x78, 0xcd, 0x99, 0xf3, 0x06,
+	0x21, 0xf9, 0x5c, 0x78, 0x01, 0x4d, 0xef, 0x74, 0x13, 0xf1, 0xc8, 0x01, 0x9e, 0xc4, 0x11, 0x87,
+	0xd5, 0x47, 0x68, 0xe9, 0x8c, 0x7f, 0x22, 0x8c, 0xd1, 0x98, 0x1a, 0x4b, 0x2b, 0x6a, 0x2c, 0x55,
+	0xdf, 0x72, 0x5c, 0xcd, 0x1e, 0x68, 0x33, 0xae, 0xa6, 0xbf, 0xf1, 0x15, 0x34, 0xc5, 0x83, 0x6e,
+	0x12, 0x82, 0x2b, 0xe2, 0x23, 0xd0, 0xd3, 0x6a, 0xd5, 0xa9, 0xe9, 0xd8, 0x81, 0x0c, 0x65, 0x5e,
+	0xb6, 0x5e, 0x7f, 0xf2, 0xc7, 0xf2, 0xb9, 0x27, 0x27, 0xcb, 0x95, 0xa7, 0x27, 0xcb, 0x95, 0xdf,
+	0x4f, 0x96, 0x2b, 0x5f, 0xfc, 0xb9, 0x7c, 0xee, 0xbd, 0xab, 0xed, 0x58, 0x2d, 0xb8, 0x1e, 0xc4,
+	0xeb, 0xf9, 0x08, 0xbe, 0xb9, 0x5e, 0xdc, 0x84, 0xd6, 0xb8, 0x9a, 0xac, 0x37, 0xff, 0x1b, 0x00,
+	0xe1, 0x4b, 0x4a, 0x76, 0xfb, 0x0b, 0x00, 0x00,
 }
 
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
@@ -689,6 +692,18 @@ func (m *InternalRaftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.CompactionBarrier != nil {
+		{
+			size, err := m.CompactionBarrier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.LeaseCheckpoint != nil {
 		{
 			size, err := m.LeaseCheckpoint.MarshalToSizedBuffer(dAtA[:i])
@@ -974,6 +989,10 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.LeaseCheckpoint.Size()
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.CompactionBarrier != nil {
+		l = m.CompactionBarrier.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -1639,6 +1658,42 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionBarrier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactionBarrier == nil {
+				m.CompactionBarrier = &CompactionBarrierRequest{}
+			}
+			if err := m.CompactionBarrier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
@@ -15,6 +15,8 @@
 package rpctypes
 
 import (
+	"errors"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -32,6 +34,13 @@ var (
 	ErrGRPCCompacted               = status.Error(codes.OutOfRange, "etcdserver: mvcc: required revision has been compacted")
 	ErrGRPCFutureRev               = status.Error(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision")
 	ErrGRPCNoSpace                 = status.Error(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded")
+	ErrGRPCCompactionBarrier       = status.Error(codes.FailedPrecondition, "etcdserver: compaction blocked by a compaction barrier")
+	ErrGRPCTooManyKeysInPrefix     = status.Error(codes.ResourceExhausted, "etcdserver: too many keys under prefix")
+	ErrGRPCInvalidValue            = status.Error(codes.InvalidArgument, "etcdserver: value rejected by the validator of its prefix")
+	ErrGRPCValidatorUnavailable    = status.Error(codes.Unavailable, "etcdserver: validator of the prefix unavailable")
+
+	ErrGRPCInvalidWatchResumeToken  = status.Error(codes.InvalidArgument, "etcdserver: invalid watch resume token")
+	ErrGRPCWatchResumeTokenMismatch = status.Error(codes.FailedPrecondition, "etcdserver: watch resume token does not match the history of the cluster")
 
 	ErrGRPCLeaseNotFound    = status.Error(codes.NotFound, "etcdserver: requested lease not found")
 	ErrGRPCLeaseExist       = status.Error(codes.FailedPrecondition, "etcdserver: lease already exists")
@@ -47,6 +56,7 @@ var (
 	ErrGRPCMemberNotLearner       = status.Error(codes.FailedPrecondition, "etcdserver: can only promote a learner member")
 	ErrGRPCLearnerNotReady        = status.Error(codes.FailedPrecondition, "etcdserver: can only promote a learner member which is in sync with leader")
 	ErrGRPCTooManyLearners        = status.Error(codes.FailedPrecondition, "etcdserver: too many learner members in cluster")
+	ErrGRPCTooManyVotingMembers   = status.Error(codes.FailedPrecondition, "etcdserver: too many voting members in cluster")
 	ErrGRPCClusterIDMismatch      = status.Error(codes.FailedPrecondition, "etcdserver: cluster ID mismatch")
 	//revive:disable:var-naming
 	// Deprecated: Please use ErrGRPCClusterIDMismatch.
@@ -87,6 +97,7 @@ var (
 	ErrGRPCCorrupt                    = status.Error(codes.DataLoss, "etcdserver: corrupt cluster")
 	ErrGRPCNotSupportedForLearner     = status.Error(codes.FailedPrecondition, "etcdserver: rpc not supported for learner")
 	ErrGRPCBadLeaderTransferee        = status.Error(codes.FailedPrecondition, "etcdserver: bad leader transferee")
+	ErrGRPCRecoveryMode               = status.Error(codes.FailedPrecondition, "etcdserver: rpc not supported in recovery mode")
 
 	ErrGRPCWrongDowngradeVersionFormat   = status.Error(codes.InvalidArgument, "etcdserver: wrong downgrade target version format")
 	ErrGRPCInvalidDowngradeTargetVersion = status.Error(codes.InvalidArgument, "etcdserver: invalid downgrade target version")
@@ -103,12 +114,19 @@ var (
 		ErrorDesc(ErrGRPCValueProvided): ErrGRPCValueProvided,
 		ErrorDesc(ErrGRPCLeaseProvided): ErrGRPCLeaseProvided,
 
-		ErrorDesc(ErrGRPCTooManyOps):        ErrGRPCTooManyOps,
-		ErrorDesc(ErrGRPCDuplicateKey):      ErrGRPCDuplicateKey,
-		ErrorDesc(ErrGRPCInvalidSortOption): ErrGRPCInvalidSortOption,
-		ErrorDesc(ErrGRPCCompacted):         ErrGRPCCompacted,
-		ErrorDesc(ErrGRPCFutureRev):         ErrGRPCFutureRev,
-		ErrorDesc(ErrGRPCNoSpace):           ErrGRPCNoSpace,
+		ErrorDesc(ErrGRPCTooManyOps):           ErrGRPCTooManyOps,
+		ErrorDesc(ErrGRPCDuplicateKey):         ErrGRPCDuplicateKey,
+		ErrorDesc(ErrGRPCInvalidSortOption):    ErrGRPCInvalidSortOption,
+		ErrorDesc(ErrGRPCCompacted):            ErrGRPCCompacted,
+		ErrorDesc(ErrGRPCFutureRev):            ErrGRPCFutureRev,
+		ErrorDesc(ErrGRPCNoSpace):              ErrGRPCNoSpace,
+		ErrorDesc(ErrGRPCCompactionBarrier):    ErrGRPCCompactionBarrier,
+		ErrorDesc(ErrGRPCTooManyKeysInPrefix):  ErrGRPCTooManyKeysInPrefix,
+		ErrorDesc(ErrGRPCInvalidValue):         ErrGRPCInvalidValue,
+		ErrorDesc(ErrGRPCValidatorUnavailable): ErrGRPCValidatorUnavailable,
+
+		ErrorDesc(ErrGRPCInvalidWatchResumeToken):  ErrGRPCInvalidWatchResumeToken,
+		ErrorDesc(ErrGRPCWatchResumeTokenMismatch): ErrGRPCWatchResumeTokenMismatch,
 
 		ErrorDesc(ErrGRPCLeaseNotFound):    ErrGRPCLeaseNotFound,
 		ErrorDesc(ErrGRPCLeaseExist):       ErrGRPCLeaseExist,
@@ -122,6 +140,7 @@ var (
 		ErrorDesc(ErrGRPCMemberNotLearner):       ErrGRPCMemberNotLearner,
 		ErrorDesc(ErrGRPCLearnerNotReady):        ErrGRPCLearnerNotReady,
 		ErrorDesc(ErrGRPCTooManyLearners):        ErrGRPCTooManyLearners,
+		ErrorDesc(ErrGRPCTooManyVotingMembers):   ErrGRPCTooManyVotingMembers,
 		ErrorDesc(ErrGRPCClusterIDMismatch):      ErrGRPCClusterIDMismatch,
 
 		ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
@@ -156,6 +175,7 @@ var (
 		ErrorDesc(ErrGRPCCorrupt):                    ErrGRPCCorrupt,
 		ErrorDesc(ErrGRPCNotSupportedForLearner):     ErrGRPCNotSupportedForLearner,
 		ErrorDesc(ErrGRPCBadLeaderTransferee):        ErrGRPCBadLeaderTransferee,
+		ErrorDesc(ErrGRPCRecoveryMode):               ErrGRPCRecoveryMode,
 
 		ErrorDesc(ErrGRPCClusterVersionUnavailable):     ErrGRPCClusterVersionUnavailable,
 		ErrorDesc(ErrGRPCWrongDowngradeVersionFormat):   ErrGRPCWrongDowngradeVersionFormat,
@@ -167,16 +187,23 @@ var (
 
 // client-side error
 var (
-	ErrEmptyKey          = Error(ErrGRPCEmptyKey)
-	ErrKeyNotFound       = Error(ErrGRPCKeyNotFound)
-	ErrValueProvided     = Error(ErrGRPCValueProvided)
-	ErrLeaseProvided     = Error(ErrGRPCLeaseProvided)
-	ErrTooManyOps        = Error(ErrGRPCTooManyOps)
-	ErrDuplicateKey      = Error(ErrGRPCDuplicateKey)
-	ErrInvalidSortOption = Error(ErrGRPCInvalidSortOption)
-	ErrCompacted         = Error(ErrGRPCCompacted)
-	ErrFutureRev         = Error(ErrGRPCFutureRev)
-	ErrNoSpace           = Error(ErrGRPCNoSpace)
+	ErrEmptyKey             = Error(ErrGRPCEmptyKey)
+	ErrKeyNotFound          = Error(ErrGRPCKeyNotFound)
+	ErrValueProvided        = Error(ErrGRPCValueProvided)
+	ErrLeaseProvided        = Error(ErrGRPCLeaseProvided)
+	ErrTooManyOps           = Error(ErrGRPCTooManyOps)
+	ErrDuplicateKey         = Error(ErrGRPCDuplicateKey)
+	ErrInvalidSortOption    = Error(ErrGRPCInvalidSortOption)
+	ErrCompacted            = Error(ErrGRPCCompacted)
+	ErrFutureRev            = Error(ErrGRPCFutureRev)
+	ErrNoSpace              = Error(ErrGRPCNoSpace)
+	ErrCompactionBarrier    = Error(ErrGRPCCompactionBarrier)
+	ErrTooManyKeysInPrefix  = Error(ErrGRPCTooManyKeysInPrefix)
+	ErrInvalidValue         = Error(ErrGRPCInvalidValue)
+	ErrValidatorUnavailable = Error(ErrGRPCValidatorUnavailable)
+
+	ErrInvalidWatchResumeToken  = Error(ErrGRPCInvalidWatchResumeToken)
+	ErrWatchResumeTokenMismatch = Error(ErrGRPCWatchResumeTokenMismatch)
 
 	ErrLeaseNotFound    = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist       = Error(ErrGRPCLeaseExist)
@@ -190,6 +217,7 @@ var (
 	ErrMemberNotLearner       = Error(ErrGRPCMemberNotLearner)
 	ErrMemberLearnerNotReady  = Error(ErrGRPCLearnerNotReady)
 	ErrTooManyLearners        = Error(ErrGRPCTooManyLearners)
+	ErrTooManyVotingMembers   = Error(ErrGRPCTooManyVotingMembers)
 
 	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
 	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
@@ -228,6 +256,7 @@ var (
 	ErrUnhealthy                  = Error(ErrGRPCUnhealthy)
 	ErrCorrupt                    = Error(ErrGRPCCorrupt)
 	ErrBadLeaderTransferee        = Error(ErrGRPCBadLeaderTransferee)
+	ErrRecoveryMode               = Error(ErrGRPCRecoveryMode)
 
 	ErrClusterVersionUnavailable     = Error(ErrGRPCClusterVersionUnavailable)
 	ErrWrongDowngradeVersionFormat   = Error(ErrGRPCWrongDowngradeVersionFormat)
@@ -253,10 +282,17 @@ func (e EtcdError) Error() string {
 	return e.desc
 }
 
+// Error converts the gRPC status error err returned by a server into the
+// client-side error of the same description. ErrGRPCNoSpace errors carrying
+// details are converted into a *NoSpaceError.
 func Error(err error) error {
 	if err == nil {
 		return nil
 	}
+	var nerr *NoSpaceError
+	if errors.As(err, &nerr) {
+		return err
+	}
 	verr, ok := errStringToError[ErrorDesc(err)]
 	if !ok { // not gRPC error
 		return err
@@ -268,7 +304,11 @@ func Error(err error) error {
 	} else {
 		desc = verr.Error()
 	}
-	return EtcdError{code: ev.Code(), desc: desc}
+	eerr := EtcdError{code: ev.Code(), desc: desc}
+	if details, ok := noSpaceDetails(err); ok {
+		return &NoSpaceError{EtcdError: eerr, Details: details}
+	}
+	return eerr
 }
 
 func ErrorDesc(err error) string {
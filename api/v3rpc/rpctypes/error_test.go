@@ -39,3 +39,21 @@ func TestConvert(t *testing.T) {
 		require.Equal(t, ev2.Code(), e3.Code())
 	}
 }
+
+func TestNoSpaceErrorDetails(t *testing.T) {
+	details := NoSpaceDetails{DBSize: 2048, DBSizeInUse: 1024, Quota: 2000, RequestCost: 300}
+	err := Error(NoSpaceErrorWithDetails(details))
+
+	require.ErrorIs(t, err, ErrNoSpace)
+	var serverErr EtcdError
+	require.ErrorAs(t, err, &serverErr)
+	require.Equal(t, codes.ResourceExhausted, serverErr.Code())
+	var nerr *NoSpaceError
+	require.ErrorAs(t, err, &nerr)
+	require.Equal(t, details, nerr.Details)
+	require.Equal(t, "etcdserver: mvcc: database space exceeded (db size: 2048 bytes, db size in use: 1024 bytes, quota: 2000 bytes, request cost: 300 bytes)", err.Error())
+	require.Same(t, nerr, Error(err))
+
+	// errors without details are converted as before
+	require.Equal(t, ErrNoSpace, Error(ErrGRPCNoSpace))
+}
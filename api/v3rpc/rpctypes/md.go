@@ -19,4 +19,18 @@ var (
 	MetadataHasLeader        = "true"
 
 	MetadataClientAPIVersionKey = "client-api-version"
+
+	// MetadataImpersonateUserKey is the key of the name of the user whose
+	// permissions a root user executes a request with.
+	MetadataImpersonateUserKey = "impersonate-user"
+
+	// MetadataQuotaWarningKey is the key of the response header describing
+	// the backend database usage of the server once it passes the warning
+	// threshold of its space quota.
+	MetadataQuotaWarningKey = "quota-warning"
+
+	// MetadataValueSizeWarningKey is the key of the response header
+	// describing the values written by the request larger than the soft
+	// limit of the server, one value of the header per value written.
+	MetadataValueSizeWarningKey = "value-size-warning"
 )
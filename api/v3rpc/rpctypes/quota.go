@@ -0,0 +1,102 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpctypes
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	noSpaceReason = "NOSPACE"
+	errorDomain   = "etcd.io"
+)
+
+// NoSpaceDetails are the details of the space quota a request was rejected by.
+type NoSpaceDetails struct {
+	// DBSize is the size of the backend database file, in bytes.
+	DBSize int64
+	// DBSizeInUse is the size of the backend database actually in use, in
+	// bytes. It is the size the database shrinks to when defragmented, and an
+	// upper bound of its size once its history is compacted and defragmented.
+	DBSizeInUse int64
+	// Quota is the space quota of the backend database, in bytes.
+	Quota int64
+	// RequestCost is the estimated space the rejected request would use, in
+	// bytes.
+	RequestCost int64
+}
+
+// NoSpaceErrorWithDetails returns ErrGRPCNoSpace carrying the given details
+// as an errdetails.ErrorInfo.
+func NoSpaceErrorWithDetails(d NoSpaceDetails) error {
+	st, err := status.Convert(ErrGRPCNoSpace).WithDetails(&errdetails.ErrorInfo{
+		Reason: noSpaceReason,
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"db-size":        strconv.FormatInt(d.DBSize, 10),
+			"db-size-in-use": strconv.FormatInt(d.DBSizeInUse, 10),
+			"quota":          strconv.FormatInt(d.Quota, 10),
+			"request-cost":   strconv.FormatInt(d.RequestCost, 10),
+		},
+	})
+	if err != nil {
+		return ErrGRPCNoSpace
+	}
+	return st.Err()
+}
+
+// noSpaceDetails returns the details carried by a gRPC status error built by
+// NoSpaceErrorWithDetails.
+func noSpaceDetails(err error) (NoSpaceDetails, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return NoSpaceDetails{}, false
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Reason != noSpaceReason || info.Domain != errorDomain {
+			continue
+		}
+		var d NoSpaceDetails
+		for key, v := range map[string]*int64{
+			"db-size":        &d.DBSize,
+			"db-size-in-use": &d.DBSizeInUse,
+			"quota":          &d.Quota,
+			"request-cost":   &d.RequestCost,
+		} {
+			*v, _ = strconv.ParseInt(info.Metadata[key], 10, 64)
+		}
+		return d, true
+	}
+	return NoSpaceDetails{}, false
+}
+
+// NoSpaceError is the client-side ErrNoSpace of a server reporting the
+// details of its space quota. It unwraps to ErrNoSpace.
+type NoSpaceError struct {
+	EtcdError
+	Details NoSpaceDetails
+}
+
+func (e *NoSpaceError) Error() string {
+	return fmt.Sprintf("%s (db size: %d bytes, db size in use: %d bytes, quota: %d bytes, request cost: %d bytes)",
+		e.EtcdError.Error(), e.Details.DBSize, e.Details.DBSizeInUse, e.Details.Quota, e.Details.RequestCost)
+}
+
+func (e *NoSpaceError) Unwrap() error { return e.EtcdError }
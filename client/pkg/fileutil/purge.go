@@ -23,25 +23,46 @@ import (
 	"go.uber.org/zap"
 )
 
+// PurgeLimits defines which files are retained by the purge routine. Files
+// are purged oldest first for as long as any of the non-zero limits is
+// exceeded. A zero limit is not enforced.
+type PurgeLimits struct {
+	// MaxFiles is the maximum number of files to retain.
+	MaxFiles uint
+	// MaxBytes is the maximum total size of the files to retain. The most
+	// recent file is always retained, even if it alone exceeds the limit.
+	MaxBytes int64
+	// MaxAge is the maximum time since the last modification of the files to
+	// retain. The most recent file is always retained.
+	MaxAge time.Duration
+}
+
 func PurgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) <-chan error {
-	return purgeFile(lg, dirname, suffix, max, interval, stop, nil, nil, true)
+	return purgeFile(lg, dirname, suffix, PurgeLimits{MaxFiles: max}, interval, stop, nil, nil, true)
 }
 
 func PurgeFileWithDoneNotify(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) (<-chan struct{}, <-chan error) {
+	return PurgeFileWithLimits(lg, dirname, suffix, PurgeLimits{MaxFiles: max}, interval, stop)
+}
+
+// PurgeFileWithLimits is like PurgeFileWithDoneNotify, but retains files
+// according to the given limits. Files locked by another process, and
+// all the files more recent than them, are never purged.
+func PurgeFileWithLimits(lg *zap.Logger, dirname string, suffix string, limits PurgeLimits, interval time.Duration, stop <-chan struct{}) (<-chan struct{}, <-chan error) {
 	doneC := make(chan struct{})
-	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, true)
+	errC := purgeFile(lg, dirname, suffix, limits, interval, stop, nil, doneC, true)
 	return doneC, errC
 }
 
 func PurgeFileWithoutFlock(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}) (<-chan struct{}, <-chan error) {
 	doneC := make(chan struct{})
-	errC := purgeFile(lg, dirname, suffix, max, interval, stop, nil, doneC, false)
+	errC := purgeFile(lg, dirname, suffix, PurgeLimits{MaxFiles: max}, interval, stop, nil, doneC, false)
 	return doneC, errC
 }
 
 // purgeFile is the internal implementation for PurgeFile which can post purged files to purgec if non-nil.
 // if donec is non-nil, the function closes it to notify its exit.
-func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval time.Duration, stop <-chan struct{}, purgec chan<- string, donec chan<- struct{}, flock bool) <-chan error {
+func purgeFile(lg *zap.Logger, dirname string, suffix string, limits PurgeLimits, interval time.Duration, stop <-chan struct{}, purgec chan<- string, donec chan<- struct{}, flock bool) <-chan error {
 	if lg == nil {
 		lg = zap.NewNop()
 	}
@@ -49,7 +70,9 @@ func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval
 	lg.Info("started to purge file",
 		zap.String("dir", dirname),
 		zap.String("suffix", suffix),
-		zap.Uint("max", max),
+		zap.Uint("max", limits.MaxFiles),
+		zap.Int64("max-bytes", limits.MaxBytes),
+		zap.Duration("max-age", limits.MaxAge),
 		zap.Duration("interval", interval))
 
 	go func() {
@@ -62,8 +85,13 @@ func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval
 				errC <- err
 				return
 			}
+			nPurge, err := countPurgeable(dirname, fnamesWithSuffix, limits)
+			if err != nil {
+				errC <- err
+				return
+			}
 			nPurged := 0
-			for nPurged < len(fnamesWithSuffix)-int(max) {
+			for nPurged < nPurge {
 				f := filepath.Join(dirname, fnamesWithSuffix[nPurged])
 				var l *LockedFile
 				if flock {
@@ -104,6 +132,44 @@ func purgeFile(lg *zap.Logger, dirname string, suffix string, max uint, interval
 	return errC
 }
 
+// countPurgeable returns how many of the oldest files of fnames need to be
+// purged to satisfy the limits.
+func countPurgeable(dirname string, fnames []string, limits PurgeLimits) (int, error) {
+	n := 0
+	if limits.MaxFiles > 0 && len(fnames) > int(limits.MaxFiles) {
+		n = len(fnames) - int(limits.MaxFiles)
+	}
+	if limits.MaxBytes <= 0 && limits.MaxAge <= 0 {
+		return n, nil
+	}
+
+	infos := make([]os.FileInfo, len(fnames))
+	var total int64
+	for i, fname := range fnames {
+		info, err := os.Stat(filepath.Join(dirname, fname))
+		if err != nil {
+			return 0, err
+		}
+		infos[i] = info
+		total += info.Size()
+	}
+	for i := 0; i < n; i++ {
+		total -= infos[i].Size()
+	}
+
+	cutoff := time.Now().Add(-limits.MaxAge)
+	// the most recent file is never purged by the size and age limits
+	for ; n < len(fnames)-1; n++ {
+		overSize := limits.MaxBytes > 0 && total > limits.MaxBytes
+		overAge := limits.MaxAge > 0 && infos[n].ModTime().Before(cutoff)
+		if !overSize && !overAge {
+			break
+		}
+		total -= infos[n].Size()
+	}
+	return n, nil
+}
+
 func readDirWithSuffix(dirname string, suffix string) ([]string, error) {
 	fnames, err := ReadDir(dirname)
 	if err != nil {
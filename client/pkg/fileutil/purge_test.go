@@ -39,7 +39,7 @@ func TestPurgeFile(t *testing.T) {
 	stop, purgec := make(chan struct{}), make(chan string, 10)
 
 	// keep 3 most recent files
-	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, false)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", PurgeLimits{MaxFiles: 3}, time.Millisecond, stop, purgec, nil, false)
 	select {
 	case f := <-purgec:
 		t.Errorf("unexpected purge on %q", f)
@@ -100,7 +100,7 @@ func TestPurgeFileHoldingLockFile(t *testing.T) {
 	require.NoError(t, err)
 
 	stop, purgec := make(chan struct{}), make(chan string, 10)
-	errch := purgeFile(zaptest.NewLogger(t), dir, "test", 3, time.Millisecond, stop, purgec, nil, true)
+	errch := purgeFile(zaptest.NewLogger(t), dir, "test", PurgeLimits{MaxFiles: 3}, time.Millisecond, stop, purgec, nil, true)
 
 	for i := 0; i < 5; i++ {
 		select {
@@ -155,3 +155,74 @@ func TestPurgeFileHoldingLockFile(t *testing.T) {
 
 	close(stop)
 }
+
+func TestPurgeFileWithLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits PurgeLimits
+		wnames []string
+	}{
+		{
+			name:   "max bytes",
+			limits: PurgeLimits{MaxBytes: 300},
+			wnames: []string{"7.test", "8.test", "9.test"},
+		},
+		{
+			name:   "max bytes smaller than the most recent file",
+			limits: PurgeLimits{MaxBytes: 10},
+			wnames: []string{"9.test"},
+		},
+		{
+			name:   "max age",
+			limits: PurgeLimits{MaxAge: time.Hour},
+			wnames: []string{"6.test", "7.test", "8.test", "9.test"},
+		},
+		{
+			name:   "max files is stricter",
+			limits: PurgeLimits{MaxFiles: 2, MaxBytes: 1000, MaxAge: time.Hour},
+			wnames: []string{"8.test", "9.test"},
+		},
+		{
+			name:   "max bytes is stricter",
+			limits: PurgeLimits{MaxFiles: 5, MaxBytes: 200},
+			wnames: []string{"8.test", "9.test"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			// files 0-5 were last modified two hours ago, files 6-9 are recent
+			for i := 0; i < 10; i++ {
+				p := filepath.Join(dir, fmt.Sprintf("%d.test", i))
+				require.NoError(t, os.WriteFile(p, make([]byte, 100), PrivateFileMode))
+				if i < 6 {
+					old := time.Now().Add(-2 * time.Hour)
+					require.NoError(t, os.Chtimes(p, old, old))
+				}
+			}
+
+			stop, purgec := make(chan struct{}), make(chan string, 10)
+			errch := purgeFile(zaptest.NewLogger(t), dir, "test", tc.limits, time.Millisecond, stop, purgec, nil, true)
+			for range 10 - len(tc.wnames) {
+				select {
+				case <-purgec:
+				case <-time.After(time.Second):
+					t.Fatalf("purge took too long")
+				}
+			}
+
+			fnames, rerr := ReadDir(dir)
+			require.NoError(t, rerr)
+			require.Equal(t, tc.wnames, fnames)
+
+			select {
+			case f := <-purgec:
+				t.Errorf("unexpected purge on %q", f)
+			case err := <-errch:
+				t.Errorf("unexpected purge error %v", err)
+			case <-time.After(10 * time.Millisecond):
+			}
+			close(stop)
+		})
+	}
+}
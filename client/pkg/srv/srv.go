@@ -16,9 +16,12 @@
 package srv
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"net/url"
+	"sort"
 	"strings"
 
 	"go.etcd.io/etcd/client/pkg/v3/types"
@@ -28,11 +31,21 @@ var (
 	// indirection for testing
 	lookupSRV      = net.LookupSRV // net.DefaultResolver.LookupSRV when ctxs don't conflict
 	resolveTCPAddr = net.ResolveTCPAddr
+	randIntn       = rand.Intn
 )
 
 // GetCluster gets the cluster information via DNS discovery.
 // Also sees each entry as a separate instance.
 func GetCluster(serviceScheme, service, name, dns string, apurls types.URLs) ([]string, error) {
+	return GetClusterFromDomains(serviceScheme, service, name, []string{dns}, apurls)
+}
+
+// GetClusterFromDomains gets the cluster information via DNS discovery from
+// the SRV records of the service in all the given domains, for instance one
+// domain per datacenter. Each distinct entry is seen as a separate instance,
+// and the instances are returned in the order of the priorities and weights
+// of their records.
+func GetClusterFromDomains(serviceScheme, service, name string, domains []string, apurls types.URLs) ([]string, error) {
 	tcp2ap := make(map[string]url.URL)
 
 	// First, resolve the apurls
@@ -49,8 +62,8 @@ func GetCluster(serviceScheme, service, name, dns string, apurls types.URLs) ([]
 		stringParts []string
 	)
 	updateNodeMap := func(service, scheme string) error {
-		_, addrs, err := lookupSRV(service, "tcp", dns)
-		if err != nil {
+		addrs, err := lookupSRVs(service, domains)
+		if len(addrs) == 0 {
 			return err
 		}
 		for _, srv := range addrs {
@@ -92,16 +105,88 @@ func GetCluster(serviceScheme, service, name, dns string, apurls types.URLs) ([]
 	return stringParts, nil
 }
 
+// lookupSRVs looks up the SRV records of the service in the domains, and
+// returns the distinct records ordered by sortSRVs. It returns the lookup
+// errors together with the records found in the other domains, if any.
+func lookupSRVs(service string, domains []string) ([]*net.SRV, error) {
+	var (
+		addrs []*net.SRV
+		errs  []error
+		seen  = make(map[string]bool)
+	)
+	for _, domain := range domains {
+		_, records, err := lookupSRV(service, "tcp", domain)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, srv := range records {
+			target := net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), fmt.Sprintf("%d", srv.Port))
+			if seen[target] {
+				continue
+			}
+			seen[target] = true
+			addrs = append(addrs, srv)
+		}
+	}
+	sortSRVs(addrs)
+	return addrs, errors.Join(errs...)
+}
+
+// sortSRVs orders the records by increasing priority, and randomly by weight
+// among the records of the same priority, the records with the largest
+// weights being the most likely to come first, as described by RFC 2782.
+// net.LookupSRV orders the records of a single lookup the same way, sortSRVs
+// orders the records of several lookups together.
+func sortSRVs(addrs []*net.SRV) {
+	sort.SliceStable(addrs, func(i, j int) bool { return addrs[i].Priority < addrs[j].Priority })
+	first := 0
+	for i := 1; i <= len(addrs); i++ {
+		if i == len(addrs) || addrs[i].Priority != addrs[first].Priority {
+			shuffleByWeight(addrs[first:i])
+			first = i
+		}
+	}
+}
+
+func shuffleByWeight(addrs []*net.SRV) {
+	sum := 0
+	for _, srv := range addrs {
+		sum += int(srv.Weight)
+	}
+	for sum > 0 && len(addrs) > 1 {
+		s := 0
+		n := randIntn(sum)
+		for i := range addrs {
+			s += int(addrs[i].Weight)
+			if s > n {
+				if i > 0 {
+					addrs[0], addrs[i] = addrs[i], addrs[0]
+				}
+				break
+			}
+		}
+		sum -= int(addrs[0].Weight)
+		addrs = addrs[1:]
+	}
+}
+
 type SRVClients struct {
 	Endpoints []string
 	SRVs      []*net.SRV
 }
 
-// GetClient looks up the client endpoints for a service and domain.
+// GetClient looks up the client endpoints for a service and domain. The
+// endpoints of the https and http services are returned together, in the
+// order of the priorities and weights of their records.
 func GetClient(service, domain string, serviceName string) (*SRVClients, error) {
+	type record struct {
+		srv    *net.SRV
+		scheme string
+	}
 	var (
-		urls []*url.URL
-		srvs []*net.SRV
+		records []record
+		srvs    []*net.SRV
 	)
 
 	updateURLs := func(service, scheme string) error {
@@ -110,12 +195,12 @@ func GetClient(service, domain string, serviceName string) (*SRVClients, error)
 			return err
 		}
 		for _, srv := range addrs {
-			urls = append(urls, &url.URL{
-				Scheme: scheme,
-				Host:   net.JoinHostPort(srv.Target, fmt.Sprintf("%d", srv.Port)),
-			})
+			// copy the records so that each one is mapped to its scheme
+			// even if the lookups return the same records
+			srv := *srv
+			records = append(records, record{srv: &srv, scheme: scheme})
+			srvs = append(srvs, &srv)
 		}
-		srvs = append(srvs, addrs...)
 		return nil
 	}
 
@@ -126,9 +211,18 @@ func GetClient(service, domain string, serviceName string) (*SRVClients, error)
 		return nil, fmt.Errorf("dns lookup errors: %w and %w", errHTTPS, errHTTP)
 	}
 
-	endpoints := make([]string, len(urls))
-	for i := range urls {
-		endpoints[i] = urls[i].String()
+	sortSRVs(srvs)
+	schemes := make(map[*net.SRV]string, len(records))
+	for _, r := range records {
+		schemes[r.srv] = r.scheme
+	}
+	endpoints := make([]string, len(srvs))
+	for i, srv := range srvs {
+		u := url.URL{
+			Scheme: schemes[srv],
+			Host:   net.JoinHostPort(srv.Target, fmt.Sprintf("%d", srv.Port)),
+		}
+		endpoints[i] = u.String()
 	}
 	return &SRVClients{Endpoints: endpoints, SRVs: srvs}, nil
 }
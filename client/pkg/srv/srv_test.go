@@ -17,6 +17,7 @@ package srv
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"reflect"
 	"strings"
@@ -302,3 +303,81 @@ func TestGetSRVService(t *testing.T) {
 		}
 	}
 }
+
+func TestSortSRVs(t *testing.T) {
+	defer func() { randIntn = rand.Intn }()
+	// always pick the first record with a cumulated weight above 0
+	randIntn = func(int) int { return 0 }
+
+	addrs := []*net.SRV{
+		{Target: "dc2-a.example.com.", Priority: 20, Weight: 10},
+		{Target: "dc1-a.example.com.", Priority: 10, Weight: 0},
+		{Target: "dc1-b.example.com.", Priority: 10, Weight: 5},
+		{Target: "dc3-a.example.com.", Priority: 30},
+		{Target: "dc2-b.example.com.", Priority: 20, Weight: 10},
+	}
+	sortSRVs(addrs)
+	var targets []string
+	for _, srv := range addrs {
+		targets = append(targets, srv.Target)
+	}
+	require.Equal(t, []string{"dc1-b.example.com.", "dc1-a.example.com.", "dc2-a.example.com.", "dc2-b.example.com.", "dc3-a.example.com."}, targets)
+
+	// the last record is picked first when the random number falls in its weight
+	randIntn = func(n int) int { return n - 1 }
+	addrs = []*net.SRV{
+		{Target: "a.example.com.", Weight: 1},
+		{Target: "b.example.com.", Weight: 1},
+		{Target: "c.example.com.", Weight: 8},
+	}
+	sortSRVs(addrs)
+	require.Equal(t, "c.example.com.", addrs[0].Target)
+}
+
+func TestSRVGetClusterFromDomains(t *testing.T) {
+	defer func() {
+		lookupSRV = net.LookupSRV
+		resolveTCPAddr = net.ResolveTCPAddr
+	}()
+
+	records := map[string][]*net.SRV{
+		"dc1.example.com": {
+			{Target: "1.dc1.example.com.", Port: 2380, Priority: 10},
+			{Target: "2.dc1.example.com.", Port: 2380, Priority: 10},
+		},
+		"dc2.example.com": {
+			{Target: "1.dc2.example.com.", Port: 2380, Priority: 20},
+			// also published in the domain of dc1
+			{Target: "2.dc1.example.com.", Port: 2380, Priority: 10},
+		},
+	}
+	lookupSRV = func(service string, proto string, domain string) (string, []*net.SRV, error) {
+		if addrs, ok := records[domain]; ok {
+			return "", addrs, nil
+		}
+		return "", nil, notFoundErr(service, proto, domain)
+	}
+	resolveTCPAddr = func(network, addr string) (*net.TCPAddr, error) {
+		ips := map[string]string{
+			"1.dc1.example.com.:2380": "10.0.1.1:2380",
+			"2.dc1.example.com.:2380": "10.0.1.2:2380",
+			"1.dc2.example.com.:2380": "10.0.2.1:2380",
+		}
+		if ip, ok := ips[addr]; ok {
+			addr = ip
+		}
+		return net.ResolveTCPAddr(network, addr)
+	}
+
+	urls := testutil.MustNewURLs(t, []string{"http://10.0.2.1:2380"})
+	str, err := GetClusterFromDomains("http", "etcd-server", "infra3", []string{"dc2.example.com", "dc1.example.com", "dc3.example.com"}, urls)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"0=http://2.dc1.example.com:2380",
+		"1=http://1.dc1.example.com:2380",
+		"infra3=http://1.dc2.example.com:2380",
+	}, str)
+
+	_, err = GetClusterFromDomains("http", "etcd-server", "infra3", []string{"dc3.example.com"}, urls)
+	require.Error(t, err)
+}
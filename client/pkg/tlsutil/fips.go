@@ -0,0 +1,47 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"slices"
+)
+
+// fipsCipherSuites are the TLS 1.2 cipher suites approved by FIPS 140-3 and
+// implemented by Go. The TLS 1.3 cipher suites are not configurable.
+var fipsCipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+}
+
+// fipsCurves are the key exchange curves approved by FIPS 140-3.
+var fipsCurves = []tls.CurveID{tls.CurveP256, tls.CurveP384, tls.CurveP521}
+
+// FIPSCipherSuites returns the TLS 1.2 cipher suites approved by FIPS 140-3.
+func FIPSCipherSuites() []uint16 {
+	return slices.Clone(fipsCipherSuites)
+}
+
+// IsFIPSCipherSuite returns whether the cipher suite is approved by FIPS 140-3.
+func IsFIPSCipherSuite(id uint16) bool {
+	return slices.Contains(fipsCipherSuites, id)
+}
+
+// FIPSCurves returns the key exchange curves approved by FIPS 140-3.
+func FIPSCurves() []tls.CurveID {
+	return slices.Clone(fipsCurves)
+}
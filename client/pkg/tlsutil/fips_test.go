@@ -0,0 +1,31 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFIPSCipherSuite(t *testing.T) {
+	for _, id := range FIPSCipherSuites() {
+		assert.True(t, IsFIPSCipherSuite(id))
+	}
+	assert.False(t, IsFIPSCipherSuite(tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256))
+	assert.False(t, IsFIPSCipherSuite(tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA))
+	assert.False(t, IsFIPSCipherSuite(tls.TLS_RSA_WITH_AES_128_GCM_SHA256))
+}
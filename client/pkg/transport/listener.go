@@ -173,6 +173,10 @@ type TLSInfo struct {
 	// If not set, the default used by Go is selected (see tls.Config.MaxVersion).
 	MaxVersion uint16
 
+	// CurvePreferences is the list of the elliptic curves used for the key
+	// exchange. If empty, Go selects the default curves.
+	CurvePreferences []tls.CurveID
+
 	selfCert bool
 
 	// parseFunc exists to simplify testing. Typically, parseFunc
@@ -406,6 +410,9 @@ func (info TLSInfo) baseConfig() (*tls.Config, error) {
 	if len(info.CipherSuites) > 0 {
 		cfg.CipherSuites = info.CipherSuites
 	}
+	if len(info.CurvePreferences) > 0 {
+		cfg.CurvePreferences = info.CurvePreferences
+	}
 
 	// Client certificates may be verified by either an exact match on the CN,
 	// or a more general check of the CN and SANs.
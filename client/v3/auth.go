@@ -43,6 +43,7 @@ type (
 	AuthRoleDeleteResponse           pb.AuthRoleDeleteResponse
 	AuthUserListResponse             pb.AuthUserListResponse
 	AuthRoleListResponse             pb.AuthRoleListResponse
+	AuthTokenRefreshResponse         pb.AuthTokenRefreshResponse
 
 	PermissionType authpb.Permission_Type
 	Permission     authpb.Permission
@@ -110,6 +111,12 @@ type Auth interface {
 
 	// RoleDelete deletes a role.
 	RoleDelete(ctx context.Context, role string) (*AuthRoleDeleteResponse, error)
+
+	// AuthTokenRefresh gets a new token for the user of the token the request
+	// is sent with, without its password. The token the request is sent with
+	// stays valid until it expires.
+	// Supported since etcd 3.7.
+	AuthTokenRefresh(ctx context.Context) (*AuthTokenRefreshResponse, error)
 }
 
 type authClient struct {
@@ -228,6 +235,11 @@ func (auth *authClient) RoleDelete(ctx context.Context, role string) (*AuthRoleD
 	return (*AuthRoleDeleteResponse)(resp), ContextError(ctx, err)
 }
 
+func (auth *authClient) AuthTokenRefresh(ctx context.Context) (*AuthTokenRefreshResponse, error) {
+	resp, err := auth.remote.AuthTokenRefresh(ctx, &pb.AuthTokenRefreshRequest{}, auth.callOpts...)
+	return (*AuthTokenRefreshResponse)(resp), ContextError(ctx, err)
+}
+
 func StrToPermissionType(s string) (PermissionType, error) {
 	val, ok := authpb.Permission_Type_value[strings.ToUpper(s)]
 	if ok {
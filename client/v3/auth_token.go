@@ -0,0 +1,232 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// tokenRefreshRatio is the fraction of the lifetime of an auth token
+	// after which it is refreshed in the background, so that requests never
+	// have to wait for the token to be replaced once it expired.
+	tokenRefreshRatio = 0.8
+
+	// minTokenRefreshRetry is the minimum wait before a failed background
+	// refresh is retried.
+	minTokenRefreshRetry = time.Second
+)
+
+// authTokenState tracks the auth token fetched with the client's username and
+// password.
+type authTokenState struct {
+	mu sync.Mutex
+	// gen is incremented every time a new token is installed. Requests record
+	// the generation of the token they are sent with, so that a request failing
+	// with a token that was already replaced retries with the new one instead
+	// of fetching yet another token.
+	gen uint64
+	// refreshAt is the time the token is refreshed in the background, or zero
+	// if the expiry of the token is unknown.
+	refreshAt time.Time
+	timer     *time.Timer
+	flight    *tokenFlight
+}
+
+// tokenFlight is a token refresh in progress, shared by all the requests
+// failing with the same token.
+type tokenFlight struct {
+	done chan struct{}
+	err  error
+}
+
+// tokenRefreshKey marks the context of the Authenticate call of a token
+// refresh, which must not wait for the refresh it belongs to.
+type tokenRefreshKey struct{}
+
+// authTokenGen returns the generation of the current auth token.
+func (c *Client) authTokenGen() uint64 {
+	c.authToken.mu.Lock()
+	defer c.authToken.mu.Unlock()
+	return c.authToken.gen
+}
+
+// setAuthToken installs a new auth token and, if the token is a JWT fetched
+// with the client's username and password, schedules its refresh before it
+// expires.
+func (c *Client) setAuthToken(token string) {
+	s := c.authToken
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c.authTokenBundle.UpdateAuthToken(token)
+	s.gen++
+	s.refreshAt = time.Time{}
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if c.Username == "" || c.ctx == nil || c.ctx.Err() != nil {
+		return
+	}
+	expiry := tokenExpiry(token)
+	lifetime := time.Until(expiry)
+	if lifetime <= 0 {
+		return
+	}
+	gen := s.gen
+	wait := time.Duration(float64(lifetime) * tokenRefreshRatio)
+	s.refreshAt = time.Now().Add(wait)
+	s.timer = time.AfterFunc(wait, func() { c.refreshTokenInBackground(gen, expiry) })
+}
+
+// refreshTokenInBackground replaces the token of generation gen before it
+// expires. Failed attempts are retried until the token expires, after which
+// the token is refreshed by the first request it is rejected for.
+func (c *Client) refreshTokenInBackground(gen uint64, expiry time.Time) {
+	if c.ctx.Err() != nil {
+		return
+	}
+	ctx, cancel := context.WithDeadline(c.ctx, expiry)
+	err := c.refreshToken(ctx, gen)
+	cancel()
+	if err == nil {
+		return
+	}
+
+	remaining := time.Until(expiry)
+	if remaining <= 0 || c.ctx.Err() != nil {
+		c.GetLogger().Warn("failed to refresh auth token before it expired", zap.Error(err))
+		return
+	}
+	wait := min(max(remaining/2, minTokenRefreshRetry), remaining)
+	c.GetLogger().Warn("failed to refresh auth token, retrying", zap.Duration("retry-after", wait), zap.Error(err))
+
+	s := c.authToken
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.gen == gen {
+		s.timer = time.AfterFunc(wait, func() { c.refreshTokenInBackground(gen, expiry) })
+	}
+}
+
+// refreshToken fetches a new auth token to replace the token of generation
+// gen. It returns immediately if that token was already replaced, and
+// concurrent callers share a single Authenticate call.
+func (c *Client) refreshToken(ctx context.Context, gen uint64) error {
+	if c.authTokenBundle == nil {
+		// c.authTokenBundle will be initialized only when
+		// c.Username != "" && c.Password != "".
+		//
+		// When users use the TLS CommonName based authentication, the
+		// authTokenBundle is always nil. But it's possible for the clients
+		// to get `rpctypes.ErrAuthOldRevision` response when the clients
+		// concurrently modify auth data (e.g, addUser, deleteUser etc.).
+		// In this case, there is no need to refresh the token; instead the
+		// clients just need to retry the operations (e.g. Put, Delete etc).
+		return nil
+	}
+	if ctx.Value(tokenRefreshKey{}) != nil {
+		return c.getToken(ctx)
+	}
+
+	s := c.authToken
+	for {
+		s.mu.Lock()
+		if s.gen != gen {
+			s.mu.Unlock()
+			return nil
+		}
+		f := s.flight
+		if f == nil {
+			f = &tokenFlight{done: make(chan struct{})}
+			s.flight = f
+			s.mu.Unlock()
+
+			f.err = c.getToken(context.WithValue(ctx, tokenRefreshKey{}, struct{}{}))
+			s.mu.Lock()
+			s.flight = nil
+			s.mu.Unlock()
+			close(f.done)
+			return f.err
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		// a refresh canceled by the context of the request that started it is
+		// retried with the context of a request still waiting for it.
+		canceled := isContextError(f.err) || errors.Is(f.err, context.Canceled) || errors.Is(f.err, context.DeadlineExceeded)
+		if !canceled || ctx.Err() != nil {
+			return f.err
+		}
+	}
+}
+
+// ensureFreshToken refreshes the auth token unless it is known not to expire
+// before its background refresh is due.
+func (c *Client) ensureFreshToken(ctx context.Context) error {
+	if c.authTokenBundle == nil {
+		return nil
+	}
+	s := c.authToken
+	s.mu.Lock()
+	gen, refreshAt := s.gen, s.refreshAt
+	s.mu.Unlock()
+	if time.Now().Before(refreshAt) {
+		return nil
+	}
+	return c.refreshToken(ctx, gen)
+}
+
+// stopTokenRefresh stops the background refresh of the auth token.
+func (c *Client) stopTokenRefresh() {
+	c.authToken.mu.Lock()
+	defer c.authToken.mu.Unlock()
+	if c.authToken.timer != nil {
+		c.authToken.timer.Stop()
+		c.authToken.timer = nil
+	}
+}
+
+// tokenExpiry returns the expiry of a JWT auth token, or the zero time if the
+// token is not a JWT or carries no expiry, as simple tokens do.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
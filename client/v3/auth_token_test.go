@@ -0,0 +1,241 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/pkg/v3/testutil"
+)
+
+// rotatingAuthServer issues a new token on every Authenticate call and serves
+// Range requests carrying a valid token. A replaced token stays valid for the
+// grace period after its replacement, or until it expires.
+type rotatingAuthServer struct {
+	*etcdserverpb.UnimplementedAuthServer
+	*etcdserverpb.UnimplementedKVServer
+
+	ttl   time.Duration // tokens are simple tokens if zero
+	grace time.Duration
+	delay time.Duration // delay of Authenticate responses
+
+	mu       sync.Mutex
+	tokens   map[string]time.Time // token -> end of validity
+	latest   string
+	auths    int
+	rejected int
+}
+
+func newRotatingAuthServer(ttl, grace time.Duration) *rotatingAuthServer {
+	return &rotatingAuthServer{ttl: ttl, grace: grace, tokens: make(map[string]time.Time)}
+}
+
+func (s *rotatingAuthServer) Authenticate(context.Context, *etcdserverpb.AuthenticateRequest) (*etcdserverpb.AuthenticateResponse, error) {
+	time.Sleep(s.delay)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.auths++
+
+	token, validity := fmt.Sprintf("simple.%d", s.auths), time.Now().Add(time.Hour)
+	if s.ttl > 0 {
+		exp := time.Now().Add(s.ttl).Unix()
+		payload := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, `{"exp":%d,"n":%d}`, exp, s.auths))
+		token, validity = "header."+payload+".signature", time.Unix(exp, 0)
+	}
+	if old, ok := s.tokens[s.latest]; ok {
+		s.tokens[s.latest] = minTime(old, time.Now().Add(s.grace))
+	}
+	s.tokens[token], s.latest = validity, token
+	return &etcdserverpb.AuthenticateResponse{Token: token}, nil
+}
+
+func (s *rotatingAuthServer) Range(ctx context.Context, _ *etcdserverpb.RangeRequest) (*etcdserverpb.RangeResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var token string
+	if ts := md.Get(rpctypes.TokenFieldNameGRPC); len(ts) > 0 {
+		token = ts[0]
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if validity, ok := s.tokens[token]; !ok || !time.Now().Before(validity) {
+		s.rejected++
+		return nil, rpctypes.ErrGRPCInvalidAuthToken
+	}
+	return &etcdserverpb.RangeResponse{Header: &etcdserverpb.ResponseHeader{}}, nil
+}
+
+// revoke invalidates all the tokens issued so far.
+func (s *rotatingAuthServer) revoke() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.tokens)
+}
+
+func (s *rotatingAuthServer) stats() (auths, rejected int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.auths, s.rejected
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func newRotatingAuthClient(t *testing.T, s *rotatingAuthServer) *Client {
+	// The `etcd-auth-test:2` socket is created in the tmp dir the test changes
+	// the working directory to.
+	testutil.BeforeTest(t)
+
+	lis, err := net.Listen("unix", "etcd-auth-test:2")
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+	srv := grpc.NewServer()
+	etcdserverpb.RegisterAuthServer(srv, s)
+	etcdserverpb.RegisterKVServer(srv, s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	c, err := NewClient(t, Config{
+		DialTimeout: 5 * time.Second,
+		Endpoints:   []string{"unix://" + lis.Addr().String()},
+		Username:    "foo",
+		Password:    "bar",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestTokenExpiry(t *testing.T) {
+	jwt := func(payload string) string {
+		return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+	}
+	tests := []struct {
+		name  string
+		token string
+		want  time.Time
+	}{
+		{name: "JWT", token: jwt(`{"exp":1700000000,"username":"foo"}`), want: time.Unix(1700000000, 0)},
+		{name: "JWT without expiry", token: jwt(`{"username":"foo"}`)},
+		{name: "simple token", token: "WiAPzSWYrSZbkqkZ.42"},
+		{name: "malformed payload", token: "header.!!!.signature"},
+		{name: "payload not JSON", token: jwt(`exp`)},
+		{name: "empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tokenExpiry(tt.token))
+		})
+	}
+}
+
+func TestRefreshTokenDeduplicated(t *testing.T) {
+	s := newRotatingAuthServer(0, 0)
+	s.delay = 100 * time.Millisecond
+	c := newRotatingAuthClient(t, s)
+	gen := c.authTokenGen()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = c.refreshToken(t.Context(), gen)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	auths, _ := s.stats()
+	assert.Equal(t, 2, auths, "concurrent refreshes of a token should share one Authenticate call")
+	assert.Equal(t, gen+1, c.authTokenGen())
+
+	// the token of generation gen was already replaced
+	require.NoError(t, c.refreshToken(t.Context(), gen))
+	auths, _ = s.stats()
+	assert.Equal(t, 2, auths)
+}
+
+func TestConcurrentRequestsDuringTokenRotation(t *testing.T) {
+	s := newRotatingAuthServer(0, 0)
+	c := newRotatingAuthClient(t, s)
+	s.revoke()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = c.Get(t.Context(), "foo")
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	auths, _ := s.stats()
+	assert.Equal(t, 2, auths, "requests rejected with the same token should share one refresh")
+}
+
+func TestTokenRefreshedBeforeExpiry(t *testing.T) {
+	// Tokens expire within 2 seconds and the replaced token is only accepted
+	// for 200ms after its replacement, covering the requests already sent
+	// with it when it was replaced.
+	s := newRotatingAuthServer(2*time.Second, 200*time.Millisecond)
+	c := newRotatingAuthClient(t, s)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 2500*time.Millisecond)
+	defer cancel()
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				if _, err := c.Get(t.Context(), "foo"); err != nil {
+					errs[i] = err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	auths, rejected := s.stats()
+	assert.GreaterOrEqual(t, auths, 2, "the token should be refreshed before it expires")
+	assert.Zero(t, rejected, "no request should be sent with an expired or replaced token")
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+var (
+	ErrBarrierNotSupported = errors.New("etcdclient: watcher does not support barriers")
+	ErrWatchClosed         = errors.New("etcdclient: watch channel closed")
+)
+
+var (
+	// barrierProgressInterval is how often WaitBarrier requests a progress
+	// notification while waiting.
+	barrierProgressInterval = 500 * time.Millisecond
+	// barrierPollInterval is how often WaitBarrier checks whether the last
+	// response sent on a watch channel has been received.
+	barrierPollInterval = 10 * time.Millisecond
+)
+
+// Barrier is a point in the revision history of the store. A write
+// happens-before a barrier if it was committed at or before its revision.
+type Barrier int64
+
+// BarrierOf returns the barrier of a KV write response, that is the revision
+// the write was committed at. For example:
+//
+//	resp, err := cli.Put(ctx, "foo", "bar")
+//	...
+//	err = clientv3.WaitBarrier(ctx, cli, wch, clientv3.BarrierOf(resp.Header))
+func BarrierOf(h *pb.ResponseHeader) Barrier {
+	return Barrier(h.GetRevision())
+}
+
+type barrierWaiter interface {
+	waitBarrier(ctx context.Context, wch WatchChan, b Barrier) error
+}
+
+// WaitBarrier blocks until wch, a channel returned by w.Watch, has delivered
+// all its events with a revision up to the barrier b. An event counts as
+// delivered once it has been received from wch, so wch must be consumed by
+// another goroutine while waiting. Progress notifications are requested on the
+// watch stream of wch, and are delivered on all its watch channels, in order to
+// pass barriers that are not followed by any event on the watched range.
+//
+// WaitBarrier returns ErrWatchClosed if wch is closed before reaching the
+// barrier, and ErrBarrierNotSupported if w is not a watcher created by this
+// package, for example a namespaced or leasing watcher.
+func WaitBarrier(ctx context.Context, w Watcher, wch WatchChan, b Barrier) error {
+	if c, ok := w.(*Client); ok {
+		w = c.Watcher
+	}
+	bw, ok := w.(barrierWaiter)
+	if !ok {
+		return ErrBarrierNotSupported
+	}
+	return bw.waitBarrier(ctx, wch, b)
+}
+
+func (w *watcher) waitBarrier(ctx context.Context, wch WatchChan, b Barrier) error {
+	w.mu.Lock()
+	d := w.delivered[wch]
+	w.mu.Unlock()
+	if d == nil {
+		return ErrWatchClosed
+	}
+
+	ticker := time.NewTicker(barrierProgressInterval)
+	defer ticker.Stop()
+	requested := false
+	for {
+		rev, changec, closed := d.load()
+		if rev >= int64(b) {
+			return nil
+		}
+		if closed {
+			return ErrWatchClosed
+		}
+		var pollc <-chan time.Time
+		if d.sentRev() >= int64(b) {
+			// the barrier was sent but may still be buffered in the channel
+			pollc = time.After(barrierPollInterval)
+		} else if !requested {
+			if err := d.wgs.requestProgress(ctx); err != nil {
+				return err
+			}
+			requested = true
+		}
+		select {
+		case <-changec:
+		case <-pollc:
+		case <-ticker.C:
+			requested = false
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// requestProgress requests a progress notify response be sent in all the watch
+// channels of the grpc stream.
+func (w *watchGRPCStream) requestProgress(ctx context.Context) error {
+	select {
+	case w.reqc <- &progressRequest{}:
+	case <-w.donec:
+		// the substreams are closing; waiters are woken when they are closed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// deliveredRevision is the revision up to which all the events of a watcher
+// have been received by its subscriber.
+type deliveredRevision struct {
+	wgs *watchGRPCStream
+	// outc is the buffered channel of the watcher
+	outc chan WatchResponse
+
+	mu sync.Mutex
+	// rev is the revision received by the subscriber. Responses are sent one
+	// at a time on a channel with a buffer of one, so all the responses but
+	// the last one sent are known to be received.
+	rev int64
+	// sent is the revision up to which the events were sent on outc
+	sent   int64
+	closed bool
+	// changec is closed when rev advances or the watcher closes
+	changec chan struct{}
+}
+
+func newDeliveredRevision(wgs *watchGRPCStream, outc chan WatchResponse) *deliveredRevision {
+	return &deliveredRevision{wgs: wgs, outc: outc, changec: make(chan struct{})}
+}
+
+func (d *deliveredRevision) load() (int64, <-chan struct{}, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.outc) == 0 {
+		// the last response sent was received
+		return d.sent, d.changec, d.closed
+	}
+	return d.rev, d.changec, d.closed
+}
+
+func (d *deliveredRevision) sentRev() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.sent
+}
+
+// advance records that wr was sent on outc.
+func (d *deliveredRevision) advance(wr *WatchResponse) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	rev := d.sent
+	switch {
+	case len(wr.Events) > 0:
+		rev = max(rev, wr.Events[len(wr.Events)-1].Kv.ModRevision)
+	case wr.IsProgressNotify():
+		// all the events up to the revision of a progress notification
+		// were sent before it
+		rev = max(rev, wr.Header.Revision)
+	}
+	// wr could only be sent once the previous response was received
+	prev := d.rev
+	d.rev, d.sent = d.sent, rev
+	if d.rev > prev {
+		close(d.changec)
+		d.changec = make(chan struct{})
+	}
+}
+
+func (d *deliveredRevision) close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	d.closed = true
+	close(d.changec)
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func TestDeliveredRevisionAdvance(t *testing.T) {
+	outc := make(chan WatchResponse, 1)
+	d := newDeliveredRevision(nil, outc)
+	send := func(wr WatchResponse) {
+		outc <- wr
+		d.advance(&wr)
+	}
+
+	// created and canceled responses do not move the delivered revision
+	send(WatchResponse{Header: pb.ResponseHeader{Revision: 5}, Created: true})
+	<-outc
+	send(WatchResponse{Header: pb.ResponseHeader{Revision: 5}, Canceled: true})
+	<-outc
+	rev, changec, _ := d.load()
+	require.Equal(t, int64(0), rev)
+
+	// events are delivered up to the last event, not the header revision
+	send(WatchResponse{
+		Header: pb.ResponseHeader{Revision: 9},
+		Events: []*Event{{Kv: &mvccpb.KeyValue{ModRevision: 6}}, {Kv: &mvccpb.KeyValue{ModRevision: 7}}},
+	})
+	// the response is buffered until it is received
+	rev, _, _ = d.load()
+	require.Equal(t, int64(0), rev)
+	require.Equal(t, int64(7), d.sentRev())
+	<-outc
+	rev, _, _ = d.load()
+	require.Equal(t, int64(7), rev)
+
+	// sending the next response implies the previous one was received
+	send(WatchResponse{Header: pb.ResponseHeader{Revision: 9}})
+	rev, _, _ = d.load()
+	require.Equal(t, int64(7), rev)
+	select {
+	case <-changec:
+	default:
+		t.Fatal("expected change notification")
+	}
+	<-outc
+	rev, changec, _ = d.load()
+	require.Equal(t, int64(9), rev)
+
+	// older revisions are ignored
+	send(WatchResponse{Header: pb.ResponseHeader{Revision: 8}})
+	<-outc
+	rev, _, _ = d.load()
+	require.Equal(t, int64(9), rev)
+
+	d.close()
+	_, _, closed := d.load()
+	require.True(t, closed)
+	select {
+	case <-changec:
+	default:
+		t.Fatal("expected close notification")
+	}
+}
+
+type nopWatcher struct{ Watcher }
+
+func TestWaitBarrierNotSupported(t *testing.T) {
+	err := WaitBarrier(context.Background(), nopWatcher{}, nil, 1)
+	require.ErrorIs(t, err, ErrBarrierNotSupported)
+}
+
+func TestWaitBarrierUnknownChannel(t *testing.T) {
+	w := NewWatchFromWatchClient(nil, nil)
+	err := WaitBarrier(context.Background(), w, make(WatchChan), 1)
+	require.ErrorIs(t, err, ErrWatchClosed)
+}
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/client/pkg/v3/logutil"
@@ -38,6 +39,7 @@ import (
 	"go.etcd.io/etcd/client/v3/credentials"
 	"go.etcd.io/etcd/client/v3/internal/endpoint"
 	"go.etcd.io/etcd/client/v3/internal/resolver"
+	"go.etcd.io/etcd/client/v3/internal/xds"
 )
 
 var (
@@ -56,13 +58,18 @@ type Client struct {
 	Maintenance
 
 	conn *grpc.ClientConn
+	// learnerConn is the connection to the learners serving the Watch and
+	// serializable Range requests, nil if LearnerEndpoints is not set.
+	learnerConn *grpc.ClientConn
 
-	cfg      Config
-	creds    grpccredentials.TransportCredentials
-	resolver *resolver.EtcdManualResolver
+	cfg             Config
+	creds           grpccredentials.TransportCredentials
+	resolver        *resolver.EtcdManualResolver
+	learnerResolver *resolver.EtcdManualResolver
 
-	epMu      *sync.RWMutex
-	endpoints []string
+	epMu             *sync.RWMutex
+	endpoints        []string
+	learnerEndpoints []string
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -80,6 +87,12 @@ type Client struct {
 
 	lgMu *sync.RWMutex
 	lg   *zap.Logger
+
+	authToken *authTokenState
+
+	// retryThrottler is the retry budget of the RPCs, nil if the retries are
+	// not throttled.
+	retryThrottler *retryThrottler
 }
 
 // New creates a new etcdv3 client from a given configuration.
@@ -96,7 +109,7 @@ func New(cfg Config) (*Client, error) {
 // service interface implementations and do not need connection management.
 func NewCtxClient(ctx context.Context, opts ...Option) *Client {
 	cctx, cancel := context.WithCancel(ctx)
-	c := &Client{ctx: cctx, cancel: cancel, lgMu: new(sync.RWMutex), epMu: new(sync.RWMutex)}
+	c := &Client{ctx: cctx, cancel: cancel, lgMu: new(sync.RWMutex), epMu: new(sync.RWMutex), authToken: new(authTokenState)}
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -151,12 +164,16 @@ func (c *Client) GetLogger() *zap.Logger {
 // Close shuts down the client's etcd connections.
 func (c *Client) Close() error {
 	c.cancel()
+	c.stopTokenRefresh()
 	if c.Watcher != nil {
 		c.Watcher.Close()
 	}
 	if c.Lease != nil {
 		c.Lease.Close()
 	}
+	if c.learnerConn != nil {
+		c.learnerConn.Close()
+	}
 	if c.conn != nil {
 		return ContextError(c.ctx, c.conn.Close())
 	}
@@ -188,17 +205,16 @@ func (c *Client) SetEndpoints(eps ...string) {
 }
 
 // Sync synchronizes client's endpoints with the known endpoints from the etcd membership.
+// The endpoints of an xds target are discovered by the xDS management server instead.
 func (c *Client) Sync(ctx context.Context) error {
+	if target := c.xdsTarget(); target != "" {
+		return fmt.Errorf("etcdclient: cannot sync the endpoints of xds target %q", target)
+	}
 	mresp, err := c.MemberList(ctx)
 	if err != nil {
 		return err
 	}
-	var eps []string
-	for _, m := range mresp.Members {
-		if len(m.Name) != 0 && !m.IsLearner {
-			eps = append(eps, m.ClientURLs...)
-		}
-	}
+	eps := syncEndpoints(mresp.Members, c.cfg.Zone)
 	// The linearizable `MemberList` returned successfully, so the
 	// endpoints shouldn't be empty.
 	verify.Verify(func() {
@@ -208,11 +224,80 @@ func (c *Client) Sync(ctx context.Context) error {
 	})
 	c.SetEndpoints(eps...)
 	c.lg.Debug("set etcd endpoints by autoSync", zap.Strings("endpoints", eps))
+	if c.learnerResolver != nil {
+		leps := syncLearnerEndpoints(mresp.Members)
+		if len(leps) == 0 {
+			leps = eps
+		}
+		c.setLearnerEndpoints(leps...)
+		c.lg.Debug("set etcd learner endpoints by autoSync", zap.Strings("endpoints", leps))
+	}
 	return nil
 }
 
+// LearnerEndpoints lists the endpoints the Watch and serializable Range
+// requests are sent to, empty if they are sent to the client's endpoints.
+func (c *Client) LearnerEndpoints() []string {
+	c.epMu.RLock()
+	defer c.epMu.RUnlock()
+	eps := make([]string, len(c.learnerEndpoints))
+	copy(eps, c.learnerEndpoints)
+	return eps
+}
+
+func (c *Client) setLearnerEndpoints(eps ...string) {
+	c.epMu.Lock()
+	defer c.epMu.Unlock()
+	c.learnerEndpoints = eps
+
+	c.learnerResolver.SetEndpoints(eps)
+}
+
+// syncLearnerEndpoints returns the client URLs of the started learners, not
+// in maintenance.
+func syncLearnerEndpoints(members []*pb.Member) []string {
+	var eps []string
+	for _, m := range members {
+		if len(m.Name) == 0 || !m.IsLearner {
+			continue
+		}
+		if a := m.Attributes; a != nil && a.Maintenance {
+			continue
+		}
+		eps = append(eps, m.ClientURLs...)
+	}
+	return eps
+}
+
+// syncEndpoints returns the client URLs of the started voting members. The
+// members in maintenance are drained, and the members of other zones are
+// skipped if zone is set, unless that leaves no endpoint.
+func syncEndpoints(members []*pb.Member, zone string) []string {
+	var all, available, local []string
+	for _, m := range members {
+		if len(m.Name) == 0 || m.IsLearner {
+			continue
+		}
+		all = append(all, m.ClientURLs...)
+		if a := m.Attributes; a != nil && a.Maintenance {
+			continue
+		}
+		available = append(available, m.ClientURLs...)
+		if zone != "" && m.Attributes != nil && m.Attributes.Zone == zone {
+			local = append(local, m.ClientURLs...)
+		}
+	}
+	switch {
+	case len(local) > 0:
+		return local
+	case len(available) > 0:
+		return available
+	}
+	return all
+}
+
 func (c *Client) autoSync() {
-	if c.cfg.AutoSyncInterval == time.Duration(0) {
+	if c.cfg.AutoSyncInterval == time.Duration(0) || c.xdsTarget() != "" {
 		return
 	}
 
@@ -251,30 +336,15 @@ func (c *Client) dialSetupOpts(creds grpccredentials.TransportCredentials, dopts
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
-	unaryMaxRetries := defaultUnaryMaxRetries
-	if c.cfg.MaxUnaryRetries > 0 {
-		unaryMaxRetries = c.cfg.MaxUnaryRetries
-	}
-
-	backoffWaitBetween := defaultBackoffWaitBetween
-	if c.cfg.BackoffWaitBetween > 0 {
-		backoffWaitBetween = c.cfg.BackoffWaitBetween
-	}
-
-	backoffJitterFraction := defaultBackoffJitterFraction
-	if c.cfg.BackoffJitterFraction > 0 {
-		backoffJitterFraction = c.cfg.BackoffJitterFraction
-	}
-
 	// Interceptor retry and backoff.
 	// TODO: Replace all of clientv3/retry.go with RetryPolicy:
 	// https://github.com/grpc/grpc-proto/blob/cdd9ed5c3d3f87aef62f373b93361cf7bddc620d/grpc/service_config/service_config.proto#L130
-	rrBackoff := withBackoff(c.roundRobinQuorumBackoff(backoffWaitBetween, backoffJitterFraction))
+	policy := c.cfg.retryPolicy()
 	opts = append(opts,
 		// Disable stream retry by default since go-grpc-middleware/retry does not support client streams.
 		// Streams that are safe to retry are enabled individually.
-		grpc.WithStreamInterceptor(c.streamClientInterceptor(withMax(0), rrBackoff)),
-		grpc.WithUnaryInterceptor(c.unaryClientInterceptor(withMax(unaryMaxRetries), rrBackoff)),
+		grpc.WithStreamInterceptor(c.streamClientInterceptor(withMax(0), withBackoff(c.roundRobinQuorumBackoff(policy.Backoff)))),
+		grpc.WithUnaryInterceptor(c.unaryClientInterceptor(c.retryOptions(policy)...)),
 	)
 
 	return opts
@@ -282,18 +352,21 @@ func (c *Client) dialSetupOpts(creds grpccredentials.TransportCredentials, dopts
 
 // Dial connects to a single endpoint using the client's config.
 func (c *Client) Dial(ep string) (*grpc.ClientConn, error) {
+	if xds.IsEndpoint(ep) {
+		return c.dialXDS(ep)
+	}
 	creds := c.credentialsForEndpoint(ep)
 
 	// Using ad-hoc created resolver, to guarantee only explicitly given
 	// endpoint is used.
-	return c.dial(creds, grpc.WithResolvers(resolver.New(ep)))
+	return c.dial(c.resolverTarget(), creds, grpc.WithResolvers(resolver.New(ep)))
 }
 
 func (c *Client) getToken(ctx context.Context) error {
 	var err error // return last error in a case of fail
 
 	if c.Token != "" {
-		c.authTokenBundle.UpdateAuthToken(c.Token)
+		c.setAuthToken(c.Token)
 		return nil
 	}
 
@@ -304,25 +377,68 @@ func (c *Client) getToken(ctx context.Context) error {
 	resp, err := c.Auth.Authenticate(ctx, c.Username, c.Password)
 	if err != nil {
 		if errors.Is(err, rpctypes.ErrAuthNotEnabled) {
-			c.authTokenBundle.UpdateAuthToken("")
+			c.setAuthToken("")
 			return nil
 		}
 		return err
 	}
-	c.authTokenBundle.UpdateAuthToken(resp.Token)
+	c.setAuthToken(resp.Token)
 	return nil
 }
 
 // dialWithBalancer dials the client's current load balanced resolver group.  The scheme of the host
 // of the provided endpoint determines the scheme used for all endpoints of the client connection.
 func (c *Client) dialWithBalancer(dopts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if target := c.xdsTarget(); target != "" {
+		return c.dialXDS(target, dopts...)
+	}
 	creds := c.credentialsForEndpoint(c.Endpoints()[0])
 	opts := append(dopts, grpc.WithResolvers(c.resolver))
-	return c.dial(creds, opts...)
+	return c.dial(c.resolverTarget(), creds, opts...)
+}
+
+// dialLearners dials the learners serving the Watch and serializable Range
+// requests of the client.
+func (c *Client) dialLearners() (*grpc.ClientConn, error) {
+	eps := c.LearnerEndpoints()
+	creds := c.credentialsForEndpoint(eps[0])
+	target := fmt.Sprintf("%s://%p/%s", resolver.Schema, c.learnerResolver, authority(eps[0]))
+	return c.dial(target, creds, grpc.WithResolvers(c.learnerResolver))
+}
+
+// dialXDS dials an xds target, whose endpoints, load balancing policy and
+// transport security are configured by the xDS management server. The
+// credentials of the client are used if the management server does not
+// configure the transport security.
+func (c *Client) dialXDS(target string, dopts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	fallback := c.credentialsForEndpoint(target)
+	if fallback == nil {
+		fallback = insecure.NewCredentials()
+	}
+	creds, err := xds.NewCredentials(fallback)
+	if err != nil {
+		return nil, err
+	}
+	return c.dial(target, creds, dopts...)
+}
+
+// xdsTarget returns the xds endpoint of the client configuration, or an
+// empty string if the endpoints of the client are not discovered by xDS.
+func (c *Client) xdsTarget() string {
+	if len(c.cfg.Endpoints) == 1 && xds.IsEndpoint(c.cfg.Endpoints[0]) {
+		return c.cfg.Endpoints[0]
+	}
+	return ""
+}
+
+// resolverTarget returns the target of the connections resolved by the
+// resolvers of the client.
+func (c *Client) resolverTarget() string {
+	return fmt.Sprintf("%s://%p/%s", resolver.Schema, c, authority(c.endpoints[0]))
 }
 
 // dial configures and dials any grpc balancer target.
-func (c *Client) dial(creds grpccredentials.TransportCredentials, dopts ...grpc.DialOption) (*grpc.ClientConn, error) {
+func (c *Client) dial(target string, creds grpccredentials.TransportCredentials, dopts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	opts := c.dialSetupOpts(creds, dopts...)
 
 	if c.authTokenBundle != nil {
@@ -337,7 +453,6 @@ func (c *Client) dial(creds grpccredentials.TransportCredentials, dopts ...grpc.
 		dctx, cancel = context.WithTimeout(c.ctx, c.cfg.DialTimeout)
 		defer cancel() // TODO: Is this right for cases where grpc.WithBlock() is not set on the dial options?
 	}
-	target := fmt.Sprintf("%s://%p/%s", resolver.Schema, c, authority(c.endpoints[0]))
 	conn, err := grpc.DialContext(dctx, target, opts...)
 	if err != nil {
 		return nil, err
@@ -359,6 +474,24 @@ func authority(endpoint string) string {
 	return spl[1]
 }
 
+// validateXDSEndpoints checks that an xds endpoint is the only endpoint of the
+// client, the xDS management server discovering the members behind it, and
+// that the xds endpoints are enabled.
+func validateXDSEndpoints(eps []string) error {
+	for _, ep := range eps {
+		if !xds.IsEndpoint(ep) {
+			continue
+		}
+		if len(eps) > 1 {
+			return fmt.Errorf("etcdclient: xds endpoint %q cannot be used together with other endpoints", ep)
+		}
+		if xds.NewCredentials == nil {
+			return fmt.Errorf("etcdclient: xds endpoint %q requires importing go.etcd.io/etcd/client/v3/xds", ep)
+		}
+	}
+	return nil
+}
+
 func (c *Client) credentialsForEndpoint(ep string) grpccredentials.TransportCredentials {
 	r := endpoint.RequiresCredentials(ep)
 	switch r {
@@ -405,6 +538,8 @@ func newClient(cfg *Config) (*Client, error) {
 		epMu:     new(sync.RWMutex),
 		callOpts: defaultCallOpts,
 		lgMu:     new(sync.RWMutex),
+
+		authToken: new(authTokenState),
 	}
 
 	var err error
@@ -451,12 +586,24 @@ func newClient(cfg *Config) (*Client, error) {
 		client.callOpts = callOpts
 	}
 
+	if err = cfg.RetryPolicy.validate(); err != nil {
+		client.cancel()
+		return nil, err
+	}
+	if cfg.RetryPolicy != nil {
+		client.retryThrottler = newRetryThrottler(cfg.RetryPolicy.Throttling)
+	}
+
 	client.resolver = resolver.New(cfg.Endpoints...)
 
 	if len(cfg.Endpoints) < 1 {
 		client.cancel()
 		return nil, errors.New("at least one Endpoint is required in client config")
 	}
+	if err = validateXDSEndpoints(cfg.Endpoints); err != nil {
+		client.cancel()
+		return nil, err
+	}
 	client.SetEndpoints(cfg.Endpoints...)
 
 	// Use a provided endpoint target so that for https:// without any tls config given, then
@@ -470,6 +617,19 @@ func newClient(cfg *Config) (*Client, error) {
 	}
 	client.conn = conn
 
+	if len(cfg.LearnerEndpoints) > 0 {
+		client.learnerResolver = resolver.New(cfg.LearnerEndpoints...)
+		client.setLearnerEndpoints(cfg.LearnerEndpoints...)
+		client.learnerConn, err = client.dialLearners()
+		if err != nil {
+			client.cancel()
+			conn.Close()
+			client.resolver.Close()
+			client.learnerResolver.Close()
+			return nil, err
+		}
+	}
+
 	client.Cluster = NewCluster(client)
 	client.KV = NewKV(client)
 	client.Lease = NewLease(client)
@@ -504,14 +664,15 @@ func newClient(cfg *Config) (*Client, error) {
 
 // roundRobinQuorumBackoff retries against quorum between each backoff.
 // This is intended for use with a round robin load balancer.
-func (c *Client) roundRobinQuorumBackoff(waitBetween time.Duration, jitterFraction float64) backoffFunc {
+func (c *Client) roundRobinQuorumBackoff(b Backoff) backoffFunc {
 	return func(attempt uint) time.Duration {
 		// after each round robin across quorum, backoff for our wait between duration
 		n := uint(len(c.Endpoints()))
 		quorum := (n/2 + 1)
 		if attempt%quorum == 0 {
-			c.lg.Debug("backoff", zap.Uint("attempt", attempt), zap.Uint("quorum", quorum), zap.Duration("waitBetween", waitBetween), zap.Float64("jitterFraction", jitterFraction))
-			return jitterUp(waitBetween, jitterFraction)
+			waitBetween := b.wait(attempt / quorum)
+			c.lg.Debug("backoff", zap.Uint("attempt", attempt), zap.Uint("quorum", quorum), zap.Duration("waitBetween", waitBetween), zap.Float64("jitterFraction", b.JitterFraction))
+			return jitterUp(waitBetween, b.JitterFraction)
 		}
 		c.lg.Debug("backoff skipped", zap.Uint("attempt", attempt), zap.Uint("quorum", quorum))
 		return 0
@@ -152,6 +152,14 @@ func TestDialNoTimeout(t *testing.T) {
 	c.Close()
 }
 
+func TestXDSEndpoints(t *testing.T) {
+	_, err := NewClient(t, Config{Endpoints: []string{"xds:///etcd"}})
+	require.ErrorContains(t, err, `xds endpoint "xds:///etcd" requires importing go.etcd.io/etcd/client/v3/xds`)
+
+	_, err = NewClient(t, Config{Endpoints: []string{"127.0.0.1:12345", "xds:///etcd"}})
+	require.ErrorContains(t, err, `xds endpoint "xds:///etcd" cannot be used together with other endpoints`)
+}
+
 func TestMaxUnaryRetries(t *testing.T) {
 	maxUnaryRetries := uint(10)
 	cfg := Config{
@@ -404,6 +412,80 @@ func TestSyncFiltersMembers(t *testing.T) {
 	}
 }
 
+func TestSyncEndpointsAttributes(t *testing.T) {
+	member := func(url string, attrs *etcdserverpb.MemberAttributes) *etcdserverpb.Member {
+		return &etcdserverpb.Member{Name: url, ClientURLs: []string{url}, Attributes: attrs}
+	}
+	tests := []struct {
+		name    string
+		members []*etcdserverpb.Member
+		zone    string
+		want    []string
+	}{
+		{
+			name:    "no attributes",
+			members: []*etcdserverpb.Member{member("a", nil), member("b", nil)},
+			want:    []string{"a", "b"},
+		},
+		{
+			name:    "drain members in maintenance",
+			members: []*etcdserverpb.Member{member("a", &etcdserverpb.MemberAttributes{Maintenance: true}), member("b", nil)},
+			want:    []string{"b"},
+		},
+		{
+			name:    "all members in maintenance",
+			members: []*etcdserverpb.Member{member("a", &etcdserverpb.MemberAttributes{Maintenance: true})},
+			want:    []string{"a"},
+		},
+		{
+			name: "prefer the members of the zone",
+			members: []*etcdserverpb.Member{
+				member("a", &etcdserverpb.MemberAttributes{Zone: "z1"}),
+				member("b", &etcdserverpb.MemberAttributes{Zone: "z2"}),
+				member("c", &etcdserverpb.MemberAttributes{Zone: "z1", Maintenance: true}),
+				member("d", nil),
+			},
+			zone: "z1",
+			want: []string{"a"},
+		},
+		{
+			name:    "no member in the zone",
+			members: []*etcdserverpb.Member{member("a", &etcdserverpb.MemberAttributes{Zone: "z2"}), member("b", nil)},
+			zone:    "z1",
+			want:    []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, syncEndpoints(tt.members, tt.zone))
+		})
+	}
+}
+
+func TestSyncLearnerEndpoints(t *testing.T) {
+	c, _ := NewClient(t, Config{
+		Endpoints:        []string{"http://254.0.0.1:12345"},
+		LearnerEndpoints: []string{"http://254.0.0.2:12345"},
+	})
+	defer c.Close()
+	c.Cluster = &mockCluster{
+		[]*etcdserverpb.Member{
+			{ID: 0, Name: "voter", ClientURLs: []string{"http://254.0.0.1:12345"}},
+			{ID: 1, Name: "learner", ClientURLs: []string{"http://254.0.0.3:12345"}, IsLearner: true},
+			{ID: 2, Name: "", ClientURLs: []string{"http://254.0.0.4:12345"}, IsLearner: true},
+			{ID: 3, Name: "drained", ClientURLs: []string{"http://254.0.0.5:12345"}, IsLearner: true, Attributes: &etcdserverpb.MemberAttributes{Maintenance: true}},
+		},
+	}
+	require.NoError(t, c.Sync(t.Context()))
+	assert.Equal(t, []string{"http://254.0.0.1:12345"}, c.Endpoints())
+	assert.Equal(t, []string{"http://254.0.0.3:12345"}, c.LearnerEndpoints())
+
+	// without learners, the voting members serve the learner requests
+	c.Cluster = &mockCluster{[]*etcdserverpb.Member{{ID: 0, Name: "voter", ClientURLs: []string{"http://254.0.0.1:12345"}}}}
+	require.NoError(t, c.Sync(t.Context()))
+	assert.Equal(t, []string{"http://254.0.0.1:12345"}, c.LearnerEndpoints())
+}
+
 func TestMinSupportedVersion(t *testing.T) {
 	testutil.BeforeTest(t)
 	tests := []struct {
@@ -545,6 +627,22 @@ func (mm mockMaintenance) Downgrade(ctx context.Context, action DowngradeAction,
 	return nil, nil
 }
 
+func (mm mockMaintenance) CompactionBarrier(ctx context.Context, rev int64, leaseID LeaseID) (*CompactionBarrierResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) ConfigReport(ctx context.Context, endpoint string) (*ConfigReportResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) PeerCertificates(ctx context.Context, endpoint string) (*PeerCertificatesResponse, error) {
+	return nil, nil
+}
+
+func (mm mockMaintenance) CompactionControl(ctx context.Context, endpoint string, pause bool) (*CompactionControlResponse, error) {
+	return nil, nil
+}
+
 type mockFailingAuthServer struct {
 	*etcdserverpb.UnimplementedAuthServer
 }
@@ -585,6 +683,10 @@ func (mc *mockCluster) MemberUpdate(ctx context.Context, id uint64, peerAddrs []
 	return nil, nil
 }
 
+func (mc *mockCluster) MemberUpdateAttributes(ctx context.Context, id uint64, attrs MemberAttributes) (*MemberUpdateResponse, error) {
+	return nil, nil
+}
+
 func (mc *mockCluster) MemberPromote(ctx context.Context, id uint64) (*MemberPromoteResponse, error) {
 	return nil, nil
 }
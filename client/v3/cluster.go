@@ -30,10 +30,12 @@ type (
 	MemberRemoveResponse  pb.MemberRemoveResponse
 	MemberUpdateResponse  pb.MemberUpdateResponse
 	MemberPromoteResponse pb.MemberPromoteResponse
+	MemberAttributes      pb.MemberAttributes
 )
 
 type Cluster interface {
-	// MemberList lists the current cluster membership.
+	// MemberList lists the current cluster membership. With WithMemberDetails,
+	// it also returns the details of every member.
 	MemberList(ctx context.Context, opts ...OpOption) (*MemberListResponse, error)
 
 	// MemberAdd adds a new member into the cluster.
@@ -48,6 +50,11 @@ type Cluster interface {
 	// MemberUpdate updates the peer addresses of the member.
 	MemberUpdate(ctx context.Context, id uint64, peerAddrs []string) (*MemberUpdateResponse, error)
 
+	// MemberUpdateAttributes replaces the attributes of the member used for
+	// client-side balancing, keeping its peer addresses. Zero attributes
+	// remove them.
+	MemberUpdateAttributes(ctx context.Context, id uint64, attrs MemberAttributes) (*MemberUpdateResponse, error)
+
 	// MemberPromote promotes a member from raft learner (non-voting) to raft voting member.
 	MemberPromote(ctx context.Context, id uint64) (*MemberPromoteResponse, error)
 }
@@ -122,9 +129,19 @@ func (c *cluster) MemberUpdate(ctx context.Context, id uint64, peerAddrs []strin
 	return nil, ContextError(ctx, err)
 }
 
+func (c *cluster) MemberUpdateAttributes(ctx context.Context, id uint64, attrs MemberAttributes) (*MemberUpdateResponse, error) {
+	// it is safe to retry on update.
+	r := &pb.MemberUpdateRequest{ID: id, Attributes: (*pb.MemberAttributes)(&attrs)}
+	resp, err := c.remote.MemberUpdate(ctx, r, c.callOpts...)
+	if err == nil {
+		return (*MemberUpdateResponse)(resp), nil
+	}
+	return nil, ContextError(ctx, err)
+}
+
 func (c *cluster) MemberList(ctx context.Context, opts ...OpOption) (*MemberListResponse, error) {
 	opt := OpGet("", opts...)
-	resp, err := c.remote.MemberList(ctx, &pb.MemberListRequest{Linearizable: !opt.serializable}, c.callOpts...)
+	resp, err := c.remote.MemberList(ctx, &pb.MemberListRequest{Linearizable: !opt.serializable, Verbose: opt.memberDetails}, c.callOpts...)
 	if err == nil {
 		return (*MemberListResponse)(resp), nil
 	}
@@ -0,0 +1,162 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrNoVersion is returned when decoding an empty value with a versioned codec.
+	ErrNoVersion = errors.New("codec: value has no schema version")
+	// ErrUnknownVersion is returned when decoding a value written with a
+	// schema version the versioned codec has no codec for.
+	ErrUnknownVersion = errors.New("codec: unknown schema version")
+	// ErrNotEncodable is returned when encoding with a migration codec.
+	ErrNotEncodable = errors.New("codec: migration codecs cannot encode values")
+)
+
+// Codec encodes and decodes the values of keys.
+type Codec interface {
+	// Encode encodes v.
+	Encode(v any) ([]byte, error)
+	// Decode decodes data into v, which must be a pointer.
+	Decode(data []byte, v any) error
+}
+
+type jsonCodec struct{}
+
+// JSON returns a codec encoding values as JSON.
+func JSON() Codec { return jsonCodec{} }
+
+func (jsonCodec) Encode(v any) ([]byte, error)    { return json.Marshal(v) }
+func (jsonCodec) Decode(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+type protoCodec struct{}
+
+// Proto returns a codec encoding protobuf messages, either generated by
+// protoc-gen-go or by gogo/protobuf with Marshal and Unmarshal methods, like
+// the etcd API messages. Typed values should be pointers to messages.
+func Proto() Codec { return protoCodec{} }
+
+type gogoMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+func (protoCodec) Encode(v any) ([]byte, error) {
+	if rv := reflect.ValueOf(v); rv.IsValid() && rv.Kind() != reflect.Pointer {
+		// the methods of the messages have pointer receivers
+		p := reflect.New(rv.Type())
+		p.Elem().Set(rv)
+		v = p.Interface()
+	}
+	switch m := v.(type) {
+	case gogoMessage:
+		return m.Marshal()
+	case proto.Message:
+		return proto.Marshal(m)
+	}
+	return nil, fmt.Errorf("codec: %T is not a protobuf message", v)
+}
+
+func (protoCodec) Decode(data []byte, v any) error {
+	// allocate the message pointed to by v, when decoding into a message pointer
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.Elem().Kind() == reflect.Pointer {
+		if rv.Elem().IsNil() {
+			rv.Elem().Set(reflect.New(rv.Elem().Type().Elem()))
+		}
+		v = rv.Elem().Interface()
+	}
+	switch m := v.(type) {
+	case gogoMessage:
+		return m.Unmarshal(data)
+	case proto.Message:
+		return proto.Unmarshal(data, m)
+	}
+	return fmt.Errorf("codec: %T is not a protobuf message", v)
+}
+
+type versionedCodec struct {
+	current byte
+	codecs  map[byte]Codec
+}
+
+// Versioned returns a codec prefixing the values it encodes with the current
+// schema version byte, encoded by codecs[current], and decoding the values
+// of each version with the codec of that version. The codecs of the former
+// versions are typically migrations to the current type of the values.
+func Versioned(current byte, codecs map[byte]Codec) Codec {
+	if _, ok := codecs[current]; !ok {
+		panic(fmt.Sprintf("codec: no codec for the current schema version %d", current))
+	}
+	return &versionedCodec{current: current, codecs: codecs}
+}
+
+func (c *versionedCodec) Encode(v any) ([]byte, error) {
+	data, err := c.codecs[c.current].Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{c.current}, data...), nil
+}
+
+func (c *versionedCodec) Decode(data []byte, v any) error {
+	if len(data) == 0 {
+		return ErrNoVersion
+	}
+	vc, ok := c.codecs[data[0]]
+	if !ok {
+		return fmt.Errorf("%w %d", ErrUnknownVersion, data[0])
+	}
+	return vc.Decode(data[1:], v)
+}
+
+type migrationCodec[Old, New any] struct {
+	c       Codec
+	migrate func(Old) (New, error)
+}
+
+// Migration returns a codec decoding the values encoded by c as an Old and
+// converting them to a New with migrate. It only decodes values, into a
+// *New, and is meant to be registered for a former version of a Versioned
+// codec.
+func Migration[Old, New any](c Codec, migrate func(Old) (New, error)) Codec {
+	return &migrationCodec[Old, New]{c: c, migrate: migrate}
+}
+
+func (c *migrationCodec[Old, New]) Encode(any) ([]byte, error) { return nil, ErrNotEncodable }
+
+func (c *migrationCodec[Old, New]) Decode(data []byte, v any) error {
+	p, ok := v.(*New)
+	if !ok {
+		return fmt.Errorf("codec: cannot migrate a value into %T", v)
+	}
+	var old Old
+	if err := c.c.Decode(data, &old); err != nil {
+		return err
+	}
+	n, err := c.migrate(old)
+	if err != nil {
+		return fmt.Errorf("codec: failed to migrate value: %w", err)
+	}
+	*p = n
+	return nil
+}
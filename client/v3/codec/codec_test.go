@@ -0,0 +1,205 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type person struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+type personV0 struct {
+	FullName string `json:"full_name"`
+}
+
+func TestJSON(t *testing.T) {
+	data, err := JSON().Encode(person{Name: "foo", Age: 1})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"foo","age":1}`, string(data))
+
+	var p person
+	require.NoError(t, JSON().Decode(data, &p))
+	assert.Equal(t, person{Name: "foo", Age: 1}, p)
+}
+
+func TestProto(t *testing.T) {
+	kv := &mvccpb.KeyValue{Key: []byte("foo"), Value: []byte("bar"), Version: 1}
+	data, err := Proto().Encode(kv)
+	require.NoError(t, err)
+
+	var got mvccpb.KeyValue
+	require.NoError(t, Proto().Decode(data, &got))
+	assert.Equal(t, kv, &got)
+
+	var gotp *mvccpb.KeyValue
+	require.NoError(t, Proto().Decode(data, &gotp))
+	assert.Equal(t, kv, gotp)
+
+	_, err = Proto().Encode(person{})
+	require.Error(t, err)
+	require.Error(t, Proto().Decode(data, &person{}))
+}
+
+func TestVersioned(t *testing.T) {
+	c := Versioned(1, map[byte]Codec{
+		0: Migration(JSON(), func(old personV0) (person, error) {
+			if old.FullName == "" {
+				return person{}, errors.New("empty name")
+			}
+			return person{Name: old.FullName}, nil
+		}),
+		1: JSON(),
+	})
+
+	data, err := c.Encode(person{Name: "foo", Age: 1})
+	require.NoError(t, err)
+	assert.Equal(t, byte(1), data[0])
+	var p person
+	require.NoError(t, c.Decode(data, &p))
+	assert.Equal(t, person{Name: "foo", Age: 1}, p)
+
+	p = person{}
+	require.NoError(t, c.Decode(append([]byte{0}, `{"full_name":"bar"}`...), &p))
+	assert.Equal(t, person{Name: "bar"}, p)
+
+	require.ErrorContains(t, c.Decode(append([]byte{0}, `{}`...), &p), "empty name")
+	require.ErrorIs(t, c.Decode(nil, &p), ErrNoVersion)
+	require.ErrorIs(t, c.Decode([]byte{2}, &p), ErrUnknownVersion)
+
+	_, err = Versioned(0, map[byte]Codec{0: Migration(JSON(), func(old personV0) (person, error) { return person{}, nil })}).Encode(person{})
+	require.ErrorIs(t, err, ErrNotEncodable)
+
+	assert.Panics(t, func() { Versioned(1, map[byte]Codec{0: JSON()}) })
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	json, proto := JSON(), Proto()
+	r.Register("/a/", json)
+	r.Register("/a/b/", proto)
+
+	tests := []struct {
+		key  string
+		want Codec
+	}{
+		{key: "/a/", want: json},
+		{key: "/a/x", want: json},
+		{key: "/a/b", want: json},
+		{key: "/a/b/x", want: proto},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			c, err := r.Codec(tt.key)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, c)
+		})
+	}
+
+	_, err := r.Codec("/b/x")
+	require.ErrorIs(t, err, ErrNoCodec)
+}
+
+// fakeKV stores the values put and returns them for the key or the prefix
+// requested.
+type fakeKV struct {
+	clientv3.KV
+	kvs []*mvccpb.KeyValue
+}
+
+func (kv *fakeKV) Put(_ context.Context, key, val string, _ ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	kv.kvs = append(kv.kvs, &mvccpb.KeyValue{Key: []byte(key), Value: []byte(val)})
+	return &clientv3.PutResponse{}, nil
+}
+
+func (kv *fakeKV) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	op := clientv3.OpGet(key, opts...)
+	resp := &clientv3.GetResponse{}
+	for _, e := range kv.kvs {
+		if string(e.Key) < key || (len(op.RangeBytes()) == 0 && string(e.Key) != key) || (len(op.RangeBytes()) > 0 && string(e.Key) >= string(op.RangeBytes())) {
+			continue
+		}
+		if op.IsKeysOnly() {
+			e = &mvccpb.KeyValue{Key: e.Key}
+		}
+		resp.Kvs = append(resp.Kvs, e)
+	}
+	resp.Count = int64(len(resp.Kvs))
+	return resp, nil
+}
+
+func TestGetPutAs(t *testing.T) {
+	ctx := context.Background()
+	r := NewRegistry()
+	r.Register("/people/", JSON())
+	kv := &fakeKV{}
+
+	_, err := PutAs(ctx, kv, r, "/people/foo", person{Name: "foo", Age: 1})
+	require.NoError(t, err)
+	_, err = PutAs(ctx, kv, r, "/people/bar", person{Name: "bar", Age: 2})
+	require.NoError(t, err)
+	_, err = PutAs(ctx, kv, r, "/other", person{})
+	require.ErrorIs(t, err, ErrNoCodec)
+
+	resp, err := GetAs[person](ctx, kv, r, "/people/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	assert.Equal(t, []person{{Name: "foo", Age: 1}, {Name: "bar", Age: 2}}, resp.Values)
+
+	resp, err = GetAs[person](ctx, kv, r, "/people/", clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	require.NoError(t, err)
+	assert.Len(t, resp.Kvs, 2)
+	assert.Empty(t, resp.Values)
+
+	kv.kvs = append(kv.kvs, &mvccpb.KeyValue{Key: []byte("/people/baz"), Value: []byte("{")})
+	_, err = GetAs[person](ctx, kv, r, "/people/baz")
+	require.ErrorContains(t, err, `"/people/baz"`)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	r := NewRegistry()
+	r.Register("/people/", JSON())
+
+	ev := decodeEvent[person](r, &clientv3.Event{
+		Type:   clientv3.EventTypePut,
+		Kv:     &mvccpb.KeyValue{Key: []byte("/people/foo"), Value: []byte(`{"name":"foo","age":2}`)},
+		PrevKv: &mvccpb.KeyValue{Key: []byte("/people/foo"), Value: []byte(`{"name":"foo","age":1}`)},
+	})
+	require.NoError(t, ev.Err)
+	assert.Equal(t, person{Name: "foo", Age: 2}, ev.Value)
+	assert.Equal(t, person{Name: "foo", Age: 1}, ev.PrevValue)
+
+	ev = decodeEvent[person](r, &clientv3.Event{
+		Type: clientv3.EventTypeDelete,
+		Kv:   &mvccpb.KeyValue{Key: []byte("/people/foo")},
+	})
+	require.NoError(t, ev.Err)
+	assert.Equal(t, person{}, ev.Value)
+
+	ev = decodeEvent[person](r, &clientv3.Event{
+		Type: clientv3.EventTypePut,
+		Kv:   &mvccpb.KeyValue{Key: []byte("/people/foo"), Value: []byte(`{`)},
+	})
+	require.Error(t, ev.Err)
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codec encodes and decodes the values of clientv3 keys as typed
+// values, with a codec registered per key prefix.
+//
+// First, register the codecs of the prefixes and the schema versions of their
+// values:
+//
+//	reg := codec.NewRegistry()
+//	reg.Register("config/", codec.Versioned(2, map[byte]codec.Codec{
+//		1: codec.Migration(codec.JSON(), func(old ConfigV1) (Config, error) { return old.upgrade(), nil }),
+//		2: codec.JSON(),
+//	}))
+//
+// Values are written with the version 2 codec and prefixed with the version
+// byte, and values written with version 1 are decoded and migrated on read.
+//
+// Then, get and put typed values:
+//
+//	_, err := codec.PutAs(ctx, cli, reg, "config/app", Config{Replicas: 3})
+//	resp, err := codec.GetAs[Config](ctx, cli, reg, "config/app")
+//	fmt.Println(resp.Values[0].Replicas)
+//	// Output: 3
+//
+// and watch them:
+//
+//	for wresp := range codec.WatchAs[Config](ctx, cli, reg, "config/", clientv3.WithPrefix()) {
+//		for _, ev := range wresp.Values {
+//			fmt.Println(ev.Type, string(ev.Kv.Key), ev.Value.Replicas, ev.Err)
+//		}
+//	}
+package codec
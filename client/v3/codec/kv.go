@@ -0,0 +1,60 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// GetResponse is a clientv3.GetResponse along with the decoded values of its
+// key-value pairs.
+type GetResponse[T any] struct {
+	*clientv3.GetResponse
+	// Values are the decoded values of Kvs, in the same order.
+	Values []T
+}
+
+// PutAs encodes v with the codec registered for key and puts it.
+func PutAs[T any](ctx context.Context, kv clientv3.KV, r *Registry, key string, v T, opts ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	data, err := r.encode(key, v)
+	if err != nil {
+		return nil, err
+	}
+	return kv.Put(ctx, key, string(data), opts...)
+}
+
+// GetAs gets the keys selected by key and opts and decodes their values with
+// the codecs registered for each key. It fails if any value cannot be
+// decoded. The values are not decoded if opts include clientv3.WithKeysOnly
+// or clientv3.WithCountOnly.
+func GetAs[T any](ctx context.Context, kv clientv3.KV, r *Registry, key string, opts ...clientv3.OpOption) (*GetResponse[T], error) {
+	resp, err := kv.Get(ctx, key, opts...)
+	if err != nil {
+		return nil, err
+	}
+	op := clientv3.OpGet(key, opts...)
+	if op.IsKeysOnly() || op.IsCountOnly() {
+		return &GetResponse[T]{GetResponse: resp}, nil
+	}
+	values := make([]T, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		if err := r.decode(string(kv.Key), kv.Value, &values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &GetResponse[T]{GetResponse: resp, Values: values}, nil
+}
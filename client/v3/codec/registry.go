@@ -0,0 +1,83 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrNoCodec is returned for the keys no codec is registered for.
+var ErrNoCodec = errors.New("codec: no codec registered for key")
+
+// Registry holds the codecs of key prefixes. It is safe for concurrent use.
+type Registry struct {
+	mu     sync.RWMutex
+	codecs map[string]Codec
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{codecs: make(map[string]Codec)}
+}
+
+// Register registers the codec of the values of the keys starting with
+// prefix, replacing the codec previously registered for the same prefix. The
+// empty prefix registers the codec of all the keys.
+func (r *Registry) Register(prefix string, c Codec) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codecs[prefix] = c
+}
+
+// Codec returns the codec registered for the longest prefix of key.
+func (r *Registry) Codec(key string) (Codec, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var (
+		match string
+		c     Codec
+	)
+	for prefix, pc := range r.codecs {
+		if strings.HasPrefix(key, prefix) && (c == nil || len(prefix) > len(match)) {
+			match, c = prefix, pc
+		}
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w %q", ErrNoCodec, key)
+	}
+	return c, nil
+}
+
+func (r *Registry) encode(key string, v any) ([]byte, error) {
+	c, err := r.Codec(key)
+	if err != nil {
+		return nil, err
+	}
+	return c.Encode(v)
+}
+
+func (r *Registry) decode(key string, data []byte, v any) error {
+	c, err := r.Codec(key)
+	if err != nil {
+		return err
+	}
+	if err := c.Decode(data, v); err != nil {
+		return fmt.Errorf("codec: failed to decode the value of key %q: %w", key, err)
+	}
+	return nil
+}
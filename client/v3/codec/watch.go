@@ -0,0 +1,78 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Event is a clientv3.Event along with the decoded values of its key-value
+// pairs.
+type Event[T any] struct {
+	*clientv3.Event
+	// Value is the decoded value of Kv. It is the zero value for delete events.
+	Value T
+	// PrevValue is the decoded value of PrevKv, if set.
+	PrevValue T
+	// Err is set if Kv or PrevKv cannot be decoded.
+	Err error
+}
+
+// WatchResponse is a clientv3.WatchResponse along with its decoded events.
+type WatchResponse[T any] struct {
+	clientv3.WatchResponse
+	// Values are the decoded events of Events, in the same order.
+	Values []Event[T]
+}
+
+// WatchAs watches the keys selected by key and opts, as clientv3.Watcher
+// Watch does, and decodes the values of the events with the codecs
+// registered for each key. The returned channel is closed when the watch
+// channel is.
+func WatchAs[T any](ctx context.Context, w clientv3.Watcher, r *Registry, key string, opts ...clientv3.OpOption) <-chan WatchResponse[T] {
+	wch := w.Watch(ctx, key, opts...)
+	ch := make(chan WatchResponse[T])
+	go func() {
+		defer close(ch)
+		for wresp := range wch {
+			tresp := WatchResponse[T]{WatchResponse: wresp, Values: make([]Event[T], len(wresp.Events))}
+			for i, ev := range wresp.Events {
+				tresp.Values[i] = decodeEvent[T](r, ev)
+			}
+			select {
+			case ch <- tresp:
+			case <-ctx.Done():
+				// drain the watch channel until it is closed
+				for range wch {
+				}
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func decodeEvent[T any](r *Registry, ev *clientv3.Event) Event[T] {
+	tev := Event[T]{Event: ev}
+	if ev.Type == clientv3.EventTypePut {
+		tev.Err = r.decode(string(ev.Kv.Key), ev.Kv.Value, &tev.Value)
+	}
+	if ev.PrevKv != nil && tev.Err == nil {
+		tev.Err = r.decode(string(ev.PrevKv.Key), ev.PrevKv.Value, &tev.PrevValue)
+	}
+	return tev
+}
@@ -33,6 +33,17 @@ type Config struct {
 	// 0 disables auto-sync. By default auto-sync is disabled.
 	AutoSyncInterval time.Duration `json:"auto-sync-interval"`
 
+	// Zone is the locality of the client. If set, Sync only keeps the endpoints
+	// of the members whose zone attribute is Zone, unless none is available.
+	Zone string `json:"zone"`
+
+	// LearnerEndpoints are the URLs of the learners the Watch and serializable
+	// Range requests are sent to, offloading them from the voting members.
+	// The learners must serve the Watch requests (--learner-serve-watch). If
+	// set, Sync updates them with the learners of the cluster, or with its
+	// voting members if it has none.
+	LearnerEndpoints []string `json:"learner-endpoints"`
+
 	// DialTimeout is the timeout for failing to establish a connection.
 	DialTimeout time.Duration `json:"dial-timeout"`
 
@@ -102,6 +113,13 @@ type Config struct {
 	// BackoffJitterFraction is the jitter fraction to randomize backoff wait time.
 	BackoffJitterFraction float64 `json:"backoff-jitter-fraction"`
 
+	// RetryPolicy configures the retries of the unary RPCs: their maximum
+	// number of attempts, the backoff curve, the retried status codes, the
+	// policies of specific RPCs and the throttling of the retries. Its unset
+	// fields default to MaxUnaryRetries, BackoffWaitBetween and
+	// BackoffJitterFraction.
+	RetryPolicy *RetryPolicy `json:"retry-policy"`
+
 	// TODO: support custom balancer picker
 }
 
@@ -134,6 +152,9 @@ type AuthConfig struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
 	Token    string `json:"token"`
+	// ImpersonatedUser is the user whose permissions the requests are
+	// executed with, see WithImpersonatedUser.
+	ImpersonatedUser string `json:"impersonated-user"`
 }
 
 func (cs *ConfigSpec) Clone() *ConfigSpec {
@@ -185,6 +206,9 @@ func NewClientConfig(confSpec *ConfigSpec, lg *zap.Logger) (*Config, error) {
 		cfg.Username = confSpec.Auth.Username
 		cfg.Password = confSpec.Auth.Password
 		cfg.Token = confSpec.Auth.Token
+		if confSpec.Auth.ImpersonatedUser != "" {
+			cfg.DialOptions = append(cfg.DialOptions, impersonatedUserDialOptions(confSpec.Auth.ImpersonatedUser)...)
+		}
 	}
 
 	return cfg, nil
@@ -56,3 +56,12 @@ func TestMetadataWithClientAPIVersion(t *testing.T) {
 	ss = md.Get(rpctypes.MetadataClientAPIVersionKey)
 	require.Truef(t, reflect.DeepEqual(ss, []string{version.APIVersion}), "unexpected metadata for %q %v", rpctypes.MetadataClientAPIVersionKey, ss)
 }
+
+func TestMetadataWithImpersonatedUser(t *testing.T) {
+	ctx := WithImpersonatedUser(WithRequireLeader(t.Context()), "alice")
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.Truef(t, ok, "expected outgoing metadata ctx key")
+	require.Equal(t, []string{"alice"}, md.Get(rpctypes.MetadataImpersonateUserKey))
+	require.Equal(t, []string{rpctypes.MetadataHasLeader}, md.Get(rpctypes.MetadataRequireLeaderKey))
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xds holds the hooks the go.etcd.io/etcd/client/v3/xds package sets
+// to enable the xds endpoints of the client, keeping the dependencies of the
+// gRPC xDS implementation out of the clients not using them.
+package xds
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// Scheme is the scheme of the endpoints resolved by the xDS resolver of gRPC.
+const Scheme = "xds"
+
+// NewCredentials returns the transport credentials of the connections to
+// xds endpoints, configured by the xDS management server and falling back
+// to the given credentials if it does not configure any. It is nil unless
+// the go.etcd.io/etcd/client/v3/xds package is imported.
+var NewCredentials func(fallback credentials.TransportCredentials) (credentials.TransportCredentials, error)
+
+// IsEndpoint returns whether the endpoint is an xds target, e.g.
+// xds:///etcd.example.svc:2379.
+func IsEndpoint(ep string) bool {
+	return strings.HasPrefix(ep, Scheme+":")
+}
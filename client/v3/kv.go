@@ -94,7 +94,10 @@ func (resp *TxnResponse) OpResponse() OpResponse {
 }
 
 type kv struct {
-	remote   pb.KVClient
+	remote pb.KVClient
+	// learner serves the serializable Range requests if the client has
+	// LearnerEndpoints, nil otherwise.
+	learner  pb.KVClient
 	callOpts []grpc.CallOption
 }
 
@@ -102,6 +105,9 @@ func NewKV(c *Client) KV {
 	api := &kv{remote: RetryKVClient(c)}
 	if c != nil {
 		api.callOpts = c.callOpts
+		if c.learnerConn != nil {
+			api.learner = &retryKVClient{kc: pb.NewKVClient(c.learnerConn)}
+		}
 	}
 	return api
 }
@@ -150,8 +156,12 @@ func (kv *kv) Do(ctx context.Context, op Op) (OpResponse, error) {
 	switch op.t {
 	case tRange:
 		if op.IsSortOptionValid() {
+			remote := kv.remote
+			if op.serializable && kv.learner != nil {
+				remote = kv.learner
+			}
 			var resp *pb.RangeResponse
-			resp, err = kv.remote.Range(ctx, op.toRangeRequest(), kv.callOpts...)
+			resp, err = remote.Range(ctx, op.toRangeRequest(), kv.callOpts...)
 			if err == nil {
 				return OpResponse{get: (*GetResponse)(resp)}, nil
 			}
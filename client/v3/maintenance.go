@@ -35,6 +35,11 @@ type (
 	MoveLeaderResponse pb.MoveLeaderResponse
 	DowngradeResponse  pb.DowngradeResponse
 
+	CompactionBarrierResponse pb.CompactionBarrierResponse
+	ConfigReportResponse      pb.ConfigReportResponse
+	PeerCertificatesResponse  pb.PeerCertificatesResponse
+	CompactionControlResponse pb.CompactionControlResponse
+
 	DowngradeAction pb.DowngradeRequest_DowngradeAction
 )
 
@@ -87,6 +92,31 @@ type Maintenance interface {
 	// on the cluster version.
 	// Supported since etcd 3.5.
 	Downgrade(ctx context.Context, action DowngradeAction, version string) (*DowngradeResponse, error)
+
+	// CompactionBarrier prevents the compactions of the cluster from advancing
+	// past rev while the given lease is alive, and returns the current compacted
+	// revision. Calling it again with the same lease moves the barrier; a zero
+	// rev releases it. Revoking or letting the lease expire releases it as well.
+	// Supported since etcd 3.7.
+	CompactionBarrier(ctx context.Context, rev int64, leaseID LeaseID) (*CompactionBarrierResponse, error)
+
+	// ConfigReport reports the configuration of the endpoint that matters to
+	// upgrades: the deprecated flags it was started with and its use of the
+	// v2 store.
+	// Supported since etcd 3.7.
+	ConfigReport(ctx context.Context, endpoint string) (*ConfigReportResponse, error)
+
+	// PeerCertificates reports the expiry of the peer TLS certificate of the
+	// endpoint, and of the certificates its peers present on its raft streams.
+	// Supported since etcd 3.7.
+	PeerCertificates(ctx context.Context, endpoint string) (*PeerCertificatesResponse, error)
+
+	// CompactionControl pauses the compactions of the endpoint if pause is
+	// set, or resumes them otherwise, and returns their progress. A paused
+	// compaction stops between two batches of deletes until it is resumed,
+	// or the endpoint restarted.
+	// Supported since etcd 3.7.
+	CompactionControl(ctx context.Context, endpoint string, pause bool) (*CompactionControlResponse, error)
 }
 
 // SnapshotResponse is aggregated response from the snapshot stream.
@@ -102,6 +132,9 @@ type SnapshotResponse struct {
 	// Informs which etcd server version should be used when restoring the snapshot.
 	// Supported on etcd >= v3.6.
 	Version string
+	// Size is the size of the database snapshot in bytes, not including the
+	// sha256 checksum appended to the stream.
+	Size int64
 }
 
 type maintenance struct {
@@ -267,6 +300,7 @@ func (m *maintenance) SnapshotWithVersion(ctx context.Context) (*SnapshotRespons
 		Header:   resp.GetHeader(),
 		Snapshot: &snapshotReadCloser{ctx: ctx, ReadCloser: pr},
 		Version:  resp.GetVersion(),
+		Size:     int64(resp.GetRemainingBytes()) + int64(len(resp.GetBlob())),
 	}, nil
 }
 
@@ -348,3 +382,47 @@ func (m *maintenance) Downgrade(ctx context.Context, action DowngradeAction, ver
 	resp, err := m.remote.Downgrade(ctx, &pb.DowngradeRequest{Action: actionType, Version: version}, m.callOpts...)
 	return (*DowngradeResponse)(resp), ContextError(ctx, err)
 }
+
+func (m *maintenance) CompactionBarrier(ctx context.Context, rev int64, leaseID LeaseID) (*CompactionBarrierResponse, error) {
+	resp, err := m.remote.CompactionBarrier(ctx, &pb.CompactionBarrierRequest{Revision: rev, Lease: int64(leaseID)}, m.callOpts...)
+	return (*CompactionBarrierResponse)(resp), ContextError(ctx, err)
+}
+
+func (m *maintenance) ConfigReport(ctx context.Context, endpoint string) (*ConfigReportResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.ConfigReport(ctx, &pb.ConfigReportRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*ConfigReportResponse)(resp), nil
+}
+
+func (m *maintenance) PeerCertificates(ctx context.Context, endpoint string) (*PeerCertificatesResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.PeerCertificates(ctx, &pb.PeerCertificatesRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*PeerCertificatesResponse)(resp), nil
+}
+
+func (m *maintenance) CompactionControl(ctx context.Context, endpoint string, pause bool) (*CompactionControlResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.CompactionControl(ctx, &pb.CompactionControlRequest{Pause: pause}, m.callOpts...)
+	if err != nil {
+		return nil, ContextError(ctx, err)
+	}
+	return (*CompactionControlResponse)(resp), nil
+}
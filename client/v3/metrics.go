@@ -0,0 +1,66 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	retriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "retries_total",
+		Help:      "The total number of retried RPC attempts.",
+	},
+		[]string{"grpc_service", "grpc_method"},
+	)
+	retriesThrottled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "retries_throttled_total",
+		Help:      "The total number of RPCs not retried because the retry budget is exhausted.",
+	},
+		[]string{"grpc_service", "grpc_method"},
+	)
+	retriesExhausted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "retries_exhausted_total",
+		Help:      "The total number of RPCs failed after their maximum number of attempts.",
+	},
+		[]string{"grpc_service", "grpc_method"},
+	)
+)
+
+// RetryMetrics returns the collectors of the retry metrics of the clients,
+// for the application to register them, for instance with
+// prometheus.MustRegister(clientv3.RetryMetrics()...). The metrics are
+// shared by all the clients of the process.
+func RetryMetrics() []prometheus.Collector {
+	return []prometheus.Collector{retriesTotal, retriesThrottled, retriesExhausted}
+}
+
+// splitMethodName splits the full gRPC method name into its service and
+// method names.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
@@ -14,7 +14,11 @@
 
 package clientv3
 
-import pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+import (
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
 
 type opType int
 
@@ -44,6 +48,8 @@ type Op struct {
 	maxModRev    int64
 	minCreateRev int64
 	maxCreateRev int64
+	maxStaleness time.Duration
+	tombstones   bool
 
 	// for range, watch
 	rev int64
@@ -68,6 +74,11 @@ type Op struct {
 	// filters for watchers
 	filterPut    bool
 	filterDelete bool
+	// resumeToken is the resume token of a progress notification to resume the watcher from.
+	resumeToken []byte
+
+	// for member list
+	memberDetails bool
 
 	// for put
 	val     []byte
@@ -143,6 +154,12 @@ func (op Op) MinCreateRev() int64 { return op.minCreateRev }
 // MaxCreateRev returns the operation's maximum create revision.
 func (op Op) MaxCreateRev() int64 { return op.maxCreateRev }
 
+// MaxStaleness returns the operation's maximum staleness.
+func (op Op) MaxStaleness() time.Duration { return op.maxStaleness }
+
+// IsTombstones returns whether tombstones is set.
+func (op Op) IsTombstones() bool { return op.tombstones }
+
 // WithRangeBytes sets the byte slice for the Op's range end.
 func (op *Op) WithRangeBytes(end []byte) { op.end = end }
 
@@ -168,6 +185,8 @@ func (op Op) toRangeRequest() *pb.RangeRequest {
 		MaxModRevision:    op.maxModRev,
 		MinCreateRevision: op.minCreateRev,
 		MaxCreateRevision: op.maxCreateRev,
+		MaxStalenessMs:    op.maxStaleness.Milliseconds(),
+		Tombstones:        op.tombstones,
 	}
 	if op.sort != nil {
 		r.SortOrder = pb.RangeRequest_SortOrder(op.sort.Order)
@@ -260,8 +279,12 @@ func OpDelete(key string, opts ...OpOption) Op {
 		panic("unexpected sort in delete")
 	case ret.serializable:
 		panic("unexpected serializable in delete")
+	case ret.maxStaleness != 0:
+		panic("unexpected max staleness in delete")
 	case ret.countOnly:
 		panic("unexpected countOnly in delete")
+	case ret.tombstones:
+		panic("unexpected tombstones in delete")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in delete")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
@@ -289,8 +312,12 @@ func OpPut(key, val string, opts ...OpOption) Op {
 		panic("unexpected sort in put")
 	case ret.serializable:
 		panic("unexpected serializable in put")
+	case ret.maxStaleness != 0:
+		panic("unexpected max staleness in put")
 	case ret.countOnly:
 		panic("unexpected countOnly in put")
+	case ret.tombstones:
+		panic("unexpected tombstones in put")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in put")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
@@ -320,8 +347,12 @@ func opWatch(key string, opts ...OpOption) Op {
 		panic("unexpected sort in watch")
 	case ret.serializable:
 		panic("unexpected serializable in watch")
+	case ret.maxStaleness != 0:
+		panic("unexpected max staleness in watch")
 	case ret.countOnly:
 		panic("unexpected countOnly in watch")
+	case ret.tombstones:
+		panic("unexpected tombstones in watch")
 	case ret.minModRev != 0, ret.maxModRev != 0:
 		panic("unexpected mod revision filter in watch")
 	case ret.minCreateRev != 0, ret.maxCreateRev != 0:
@@ -438,6 +469,22 @@ func WithSerializable() OpOption {
 	return func(op *Op) { op.serializable = true }
 }
 
+// WithMemberDetails makes `MemberList` requests also return the details of
+// every member, like its health, version, db size and applied index, fetched
+// by the member serving the request from the other members.
+func WithMemberDetails() OpOption {
+	return func(op *Op) { op.memberDetails = true }
+}
+
+// WithMaxStaleness allows a linearizable `Get` request to be served by the
+// member it is sent to from its local data, as long as that data is known to
+// lag behind the leader by no more than d. Otherwise the request is served as
+// a linearizable read. The actual staleness of the response is reported in
+// its header. It has no effect on serializable requests.
+func WithMaxStaleness(d time.Duration) OpOption {
+	return func(op *Op) { op.maxStaleness = d }
+}
+
 // WithKeysOnly makes the 'Get' request return only the keys and the corresponding
 // values will be omitted.
 func WithKeysOnly() OpOption {
@@ -449,6 +496,14 @@ func WithCountOnly() OpOption {
 	return func(op *Op) { op.countOnly = true }
 }
 
+// WithTombstones makes the 'Get' request return the tombstones of the keys,
+// the deletions retained in the revision history since the last compaction,
+// instead of the keys. Each tombstone is a key-value holding only the key and,
+// as ModRevision, the revision the key was deleted at.
+func WithTombstones() OpOption {
+	return func(op *Op) { op.tombstones = true }
+}
+
 // WithMinModRev filters out keys for Get with modification revisions less than the given revision.
 func WithMinModRev(rev int64) OpOption { return func(op *Op) { op.minModRev = rev } }
 
@@ -529,6 +584,17 @@ func WithFragment() OpOption {
 	return func(op *Op) { op.fragment = true }
 }
 
+// WithResumeToken makes the watcher resume from a progress notification of an
+// earlier watcher on the same key range, as identified by its ResumeToken. The
+// watcher receives the events following the revision of the notification, or
+// following WithRev if it is later. If the history of the cluster no longer
+// matches the one the token was issued from, for instance after the cluster was
+// restored from a snapshot, the watcher is canceled with
+// rpctypes.ErrWatchResumeTokenMismatch and the watched keys should be read again.
+func WithResumeToken(token []byte) OpOption {
+	return func(op *Op) { op.resumeToken = token }
+}
+
 // WithIgnoreValue updates the key using its current value.
 // This option can not be combined with non-empty values.
 // Returns an error if the key does not exist.
@@ -17,6 +17,7 @@ package clientv3
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 )
@@ -103,3 +104,11 @@ func TestIsOptsWithFromKey(t *testing.T) {
 		t.Errorf("IsOptsWithFromKey = true, expected false")
 	}
 }
+
+func TestOpWithMaxStaleness(t *testing.T) {
+	req := OpGet("foo", WithMaxStaleness(1500*time.Millisecond)).toRangeRequest()
+	wreq := &pb.RangeRequest{Key: []byte("foo"), MaxStalenessMs: 1500}
+	if !reflect.DeepEqual(req, wreq) {
+		t.Fatalf("expected %+v, got %+v", wreq, req)
+	}
+}
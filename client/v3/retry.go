@@ -17,6 +17,7 @@ package clientv3
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -48,13 +49,15 @@ func (rp retryPolicy) String() string {
 //
 // immutable requests (e.g. Get) should be retried unless it's
 // an obvious server-side error (e.g. rpctypes.ErrRequestTooLarge).
+// They are retried on the given status codes, or only on codes.Unavailable
+// if retriableCodes is empty.
 //
 // Returning "false" means retry should stop, since client cannot
 // handle itself even with retries.
-func isSafeRetryImmutableRPC(err error) bool {
+func isSafeRetryImmutableRPC(err error, retriableCodes []codes.Code) bool {
 	eErr := rpctypes.Error(err)
 	var serverErr rpctypes.EtcdError
-	if errors.As(eErr, &serverErr) && serverErr.Code() != codes.Unavailable {
+	if errors.As(eErr, &serverErr) && !isRetriableCode(serverErr.Code(), retriableCodes) {
 		// interrupted by non-transient server-side or gRPC-side error
 		// client cannot handle itself (e.g. rpctypes.ErrCompacted)
 		return false
@@ -71,7 +74,14 @@ func isSafeRetryImmutableRPC(err error) bool {
 		// ref. https://github.com/grpc/grpc-go/issues/1581
 		return false
 	}
-	return ev.Code() == codes.Unavailable
+	return isRetriableCode(ev.Code(), retriableCodes)
+}
+
+func isRetriableCode(code codes.Code, retriableCodes []codes.Code) bool {
+	if len(retriableCodes) == 0 {
+		return code == codes.Unavailable
+	}
+	return slices.Contains(retriableCodes, code)
 }
 
 // isSafeRetryMutableRPC returns "true" when a mutable request is safe for retry.
@@ -221,6 +231,22 @@ func (rmc *retryMaintenanceClient) MoveLeader(ctx context.Context, in *pb.MoveLe
 	return rmc.mc.MoveLeader(ctx, in, append(opts, withRepeatablePolicy())...)
 }
 
+func (rmc *retryMaintenanceClient) CompactionBarrier(ctx context.Context, in *pb.CompactionBarrierRequest, opts ...grpc.CallOption) (resp *pb.CompactionBarrierResponse, err error) {
+	return rmc.mc.CompactionBarrier(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
+func (rmc *retryMaintenanceClient) ConfigReport(ctx context.Context, in *pb.ConfigReportRequest, opts ...grpc.CallOption) (resp *pb.ConfigReportResponse, err error) {
+	return rmc.mc.ConfigReport(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
+func (rmc *retryMaintenanceClient) PeerCertificates(ctx context.Context, in *pb.PeerCertificatesRequest, opts ...grpc.CallOption) (resp *pb.PeerCertificatesResponse, err error) {
+	return rmc.mc.PeerCertificates(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
+func (rmc *retryMaintenanceClient) CompactionControl(ctx context.Context, in *pb.CompactionControlRequest, opts ...grpc.CallOption) (resp *pb.CompactionControlResponse, err error) {
+	return rmc.mc.CompactionControl(ctx, in, append(opts, withRepeatablePolicy())...)
+}
+
 func (rmc *retryMaintenanceClient) Defragment(ctx context.Context, in *pb.DefragmentRequest, opts ...grpc.CallOption) (resp *pb.DefragmentResponse, err error) {
 	return rmc.mc.Defragment(ctx, in, opts...)
 }
@@ -307,3 +333,7 @@ func (rac *retryAuthClient) RoleRevokePermission(ctx context.Context, in *pb.Aut
 func (rac *retryAuthClient) Authenticate(ctx context.Context, in *pb.AuthenticateRequest, opts ...grpc.CallOption) (resp *pb.AuthenticateResponse, err error) {
 	return rac.ac.Authenticate(ctx, in, opts...)
 }
+
+func (rac *retryAuthClient) AuthTokenRefresh(ctx context.Context, in *pb.AuthTokenRefreshRequest, opts ...grpc.CallOption) (resp *pb.AuthTokenRefreshResponse, err error) {
+	return rac.ac.AuthTokenRefresh(ctx, in, opts...)
+}
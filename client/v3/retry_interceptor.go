@@ -39,27 +39,38 @@ import (
 // changed through options (e.g. WithMax) on creation of the interceptor or on call (through grpc.CallOptions).
 func (c *Client) unaryClientInterceptor(optFuncs ...retryOption) grpc.UnaryClientInterceptor {
 	intOpts := reuseOrNewWithCallOptions(defaultOptions, optFuncs)
+	methodOpts := intOpts.methodOptions()
 	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		ctx = withVersion(ctx)
 		grpcOpts, retryOpts := filterCallOptions(opts)
-		callOpts := reuseOrNewWithCallOptions(intOpts, retryOpts)
+		callOpts := intOpts
+		if mo, ok := methodOpts[method]; ok {
+			callOpts = mo
+		}
+		callOpts = reuseOrNewWithCallOptions(callOpts, retryOpts)
 		// short circuit for simplicity, and avoiding allocations.
 		if callOpts.max == 0 {
 			return invoker(ctx, method, req, reply, cc, grpcOpts...)
 		}
+		service, methodName := splitMethodName(method)
 		var lastErr error
 		for attempt := uint(0); attempt < callOpts.max; attempt++ {
 			if err := waitRetryBackoff(ctx, attempt, callOpts); err != nil {
 				return err
 			}
+			if attempt > 0 {
+				retriesTotal.WithLabelValues(service, methodName).Inc()
+			}
 			c.GetLogger().Debug(
 				"retrying of unary invoker",
 				zap.String("target", cc.Target()),
 				zap.String("method", method),
 				zap.Uint("attempt", attempt),
 			)
+			tokenGen := c.authTokenGen()
 			lastErr = invoker(ctx, method, req, reply, cc, grpcOpts...)
 			if lastErr == nil {
+				callOpts.throttler.success()
 				return nil
 			}
 			c.GetLogger().Warn(
@@ -75,10 +86,14 @@ func (c *Client) unaryClientInterceptor(optFuncs ...retryOption) grpc.UnaryClien
 					return lastErr
 				}
 				// its the callCtx deadline or cancellation, in which case try again.
+				if !callOpts.throttler.failure() {
+					retriesThrottled.WithLabelValues(service, methodName).Inc()
+					return lastErr
+				}
 				continue
 			}
 			if c.shouldRefreshToken(lastErr, callOpts) {
-				gtErr := c.refreshToken(ctx)
+				gtErr := c.refreshToken(ctx, tokenGen)
 				if gtErr != nil {
 					c.GetLogger().Warn(
 						"retrying of unary invoker failed to fetch new auth token",
@@ -92,7 +107,12 @@ func (c *Client) unaryClientInterceptor(optFuncs ...retryOption) grpc.UnaryClien
 			if !isSafeRetry(c, lastErr, callOpts) {
 				return lastErr
 			}
+			if !callOpts.throttler.failure() {
+				retriesThrottled.WithLabelValues(service, methodName).Inc()
+				return lastErr
+			}
 		}
+		retriesExhausted.WithLabelValues(service, methodName).Inc()
 		return lastErr
 	}
 }
@@ -109,11 +129,11 @@ func (c *Client) streamClientInterceptor(optFuncs ...retryOption) grpc.StreamCli
 	intOpts := reuseOrNewWithCallOptions(defaultOptions, optFuncs)
 	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 		ctx = withVersion(ctx)
-		// getToken automatically. Otherwise, auth token may be invalid after watch reconnection because the token has expired
-		// (see https://github.com/etcd-io/etcd/issues/11954 for more).
-		err := c.getToken(ctx)
+		// refresh the token automatically unless it is known to be fresh. Otherwise, auth token may be invalid after watch
+		// reconnection because the token has expired (see https://github.com/etcd-io/etcd/issues/11954 for more).
+		err := c.ensureFreshToken(ctx)
 		if err != nil {
-			c.GetLogger().Error("clientv3/retry_interceptor: getToken failed", zap.Error(err))
+			c.GetLogger().Error("clientv3/retry_interceptor: ensureFreshToken failed", zap.Error(err))
 			return nil, err
 		}
 		grpcOpts, retryOpts := filterCallOptions(opts)
@@ -125,6 +145,7 @@ func (c *Client) streamClientInterceptor(optFuncs ...retryOption) grpc.StreamCli
 		if desc.ClientStreams {
 			return nil, status.Errorf(codes.Unimplemented, "clientv3/retry_interceptor: cannot retry on ClientStreams, set Disable()")
 		}
+		tokenGen := c.authTokenGen()
 		newStreamer, err := streamer(ctx, desc, cc, method, grpcOpts...)
 		if err != nil {
 			c.GetLogger().Error("streamer failed to create ClientStream", zap.Error(err))
@@ -135,6 +156,8 @@ func (c *Client) streamClientInterceptor(optFuncs ...retryOption) grpc.StreamCli
 			ClientStream: newStreamer,
 			callOpts:     callOpts,
 			ctx:          ctx,
+			method:       method,
+			tokenGen:     tokenGen,
 			streamerCall: func(ctx context.Context) (grpc.ClientStream, error) {
 				return streamer(ctx, desc, cc, method, grpcOpts...)
 			},
@@ -156,23 +179,6 @@ func (c *Client) shouldRefreshToken(err error, callOpts *options) bool {
 		(errors.Is(rpctypes.Error(err), rpctypes.ErrInvalidAuthToken) || errors.Is(rpctypes.Error(err), rpctypes.ErrAuthOldRevision))
 }
 
-func (c *Client) refreshToken(ctx context.Context) error {
-	if c.authTokenBundle == nil {
-		// c.authTokenBundle will be initialized only when
-		// c.Username != "" && c.Password != "".
-		//
-		// When users use the TLS CommonName based authentication, the
-		// authTokenBundle is always nil. But it's possible for the clients
-		// to get `rpctypes.ErrAuthOldRevision` response when the clients
-		// concurrently modify auth data (e.g, addUser, deleteUser etc.).
-		// In this case, there is no need to refresh the token; instead the
-		// clients just need to retry the operations (e.g. Put, Delete etc).
-		return nil
-	}
-
-	return c.getToken(ctx)
-}
-
 // type serverStreamingRetryingStream is the implementation of grpc.ClientStream that acts as a
 // proxy to the underlying call. If any of the RecvMsg() calls fail, it will try to reestablish
 // a new ClientStream according to the retry policy.
@@ -183,8 +189,10 @@ type serverStreamingRetryingStream struct {
 	receivedGood  bool  // indicates whether any prior receives were successful
 	wasClosedSend bool  // indicates that CloseSend was closed
 	ctx           context.Context
+	method        string
 	callOpts      *options
 	streamerCall  func(ctx context.Context) (grpc.ClientStream, error)
+	tokenGen      uint64 // generation of the auth token the stream was established with
 	mu            sync.RWMutex
 }
 
@@ -233,14 +241,19 @@ func (s *serverStreamingRetryingStream) RecvMsg(m any) error {
 		if err := waitRetryBackoff(s.ctx, attempt, s.callOpts); err != nil {
 			return err
 		}
+		tokenGen := s.client.authTokenGen()
 		newStream, err := s.reestablishStreamAndResendBuffer(s.ctx)
 		if err != nil {
 			s.client.lg.Error("failed reestablishStreamAndResendBuffer", zap.Error(err))
 			return err // TODO(mwitkow): Maybe dial and transport errors should be retriable?
 		}
 		s.setStream(newStream)
+		s.mu.Lock()
+		s.tokenGen = tokenGen
+		s.mu.Unlock()
 
 		s.client.lg.Warn("retrying RecvMsg", zap.Error(lastErr))
+		retriesTotal.WithLabelValues(splitMethodName(s.method)).Inc()
 		attemptRetry, lastErr = s.receiveMsgAndIndicateRetry(m)
 		if !attemptRetry {
 			return lastErr
@@ -251,7 +264,7 @@ func (s *serverStreamingRetryingStream) RecvMsg(m any) error {
 
 func (s *serverStreamingRetryingStream) receiveMsgAndIndicateRetry(m any) (bool, error) {
 	s.mu.RLock()
-	wasGood := s.receivedGood
+	wasGood, tokenGen := s.receivedGood, s.tokenGen
 	s.mu.RUnlock()
 	err := s.getStream().RecvMsg(m)
 	if err == nil || errors.Is(err, io.EOF) {
@@ -271,7 +284,7 @@ func (s *serverStreamingRetryingStream) receiveMsgAndIndicateRetry(m any) (bool,
 		return true, err
 	}
 	if s.client.shouldRefreshToken(err, s.callOpts) {
-		gtErr := s.client.refreshToken(s.ctx)
+		gtErr := s.client.refreshToken(s.ctx, tokenGen)
 		if gtErr != nil {
 			s.client.lg.Warn("retry failed to fetch new auth token", zap.Error(gtErr))
 			return false, err // return the original error for simplicity
@@ -335,7 +348,7 @@ func isSafeRetry(c *Client, err error, callOpts *options) bool {
 
 	switch callOpts.retryPolicy {
 	case repeatable:
-		return isSafeRetryImmutableRPC(err)
+		return isSafeRetryImmutableRPC(err, callOpts.retriableCodes)
 	case nonRepeatable:
 		return isSafeRetryMutableRPC(err)
 	default:
@@ -395,11 +408,56 @@ func withBackoff(bf backoffFunc) retryOption {
 	}}
 }
 
+// withRetriableCodes sets the status codes the repeatable calls are retried
+// on, codes.Unavailable if empty.
+func withRetriableCodes(retriableCodes []codes.Code) retryOption {
+	return retryOption{applyFunc: func(o *options) {
+		o.retriableCodes = retriableCodes
+	}}
+}
+
+// withThrottler sets the retry budget of the calls, if not nil.
+func withThrottler(t *retryThrottler) retryOption {
+	return retryOption{applyFunc: func(o *options) {
+		o.throttler = t
+	}}
+}
+
+// withMethodOverride applies the options to the calls of the given method of
+// the interceptor.
+func withMethodOverride(method string, overrides ...retryOption) retryOption {
+	return retryOption{applyFunc: func(o *options) {
+		m := make(map[string][]retryOption, len(o.overrides)+1)
+		for k, v := range o.overrides {
+			m[k] = v
+		}
+		m[method] = overrides
+		o.overrides = m
+	}}
+}
+
 type options struct {
-	retryPolicy retryPolicy
-	max         uint
-	backoffFunc backoffFunc
-	retryAuth   bool
+	retryPolicy    retryPolicy
+	max            uint
+	backoffFunc    backoffFunc
+	retryAuth      bool
+	retriableCodes []codes.Code
+	throttler      *retryThrottler
+	overrides      map[string][]retryOption
+}
+
+// methodOptions returns the options of the methods overridden by
+// withMethodOverride.
+func (o *options) methodOptions() map[string]*options {
+	methodOpts := make(map[string]*options, len(o.overrides))
+	for method, overrides := range o.overrides {
+		mo := reuseOrNewWithCallOptions(o, overrides)
+		if mo == o {
+			continue
+		}
+		methodOpts[method] = mo
+	}
+	return methodOpts
 }
 
 // retryOption is a grpc.CallOption that is local to clientv3's retry interceptor.
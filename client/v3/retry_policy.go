@@ -0,0 +1,246 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// RetryPolicy configures how the client retries the unary RPCs failing
+// because no member could serve them, for instance to tame the retries of
+// many clients during a cluster brownout. Whatever the policy, the mutable
+// RPCs (e.g. Put, Txn) are only retried while no connection is established,
+// to keep their write-at-most-once semantics.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a unary RPC, including
+	// the first one. 1 disables the retries. If 0, MaxUnaryRetries of the
+	// Config is used, or 100 if it is not set either.
+	MaxAttempts uint `json:"max-attempts"`
+
+	// Backoff is the wait between the retries.
+	Backoff Backoff `json:"backoff"`
+
+	// RetriableCodes are the gRPC status codes the immutable RPCs (e.g. Range,
+	// MemberList) are retried on. If empty, they are only retried on
+	// codes.Unavailable.
+	RetriableCodes []codes.Code `json:"retriable-codes"`
+
+	// Overrides are the policies of specific RPCs, keyed by their full method
+	// name, for instance "/etcdserverpb.KV/Range" or
+	// "/etcdserverpb.Cluster/MemberList". The fields not set in an override
+	// are inherited from this policy.
+	Overrides map[string]RetryOverride `json:"overrides"`
+
+	// Throttling, if set, limits the retries of all the RPCs of the client
+	// once too many of them fail.
+	Throttling *RetryThrottling `json:"throttling"`
+}
+
+// Backoff is the curve of the wait between retries. The retries are spread
+// over the endpoints, and the client only waits after each round of retries
+// across a quorum of the endpoints.
+type Backoff struct {
+	// WaitBetween is the wait after the first round of retries. If 0,
+	// BackoffWaitBetween of the Config is used, or 25ms if it is not set
+	// either.
+	WaitBetween time.Duration `json:"wait-between"`
+
+	// Multiplier is the factor the wait grows by after each round of retries.
+	// If it is 1 or less, the wait is constant.
+	Multiplier float64 `json:"multiplier"`
+
+	// MaxWait caps the wait, if set.
+	MaxWait time.Duration `json:"max-wait"`
+
+	// JitterFraction is the fraction of the wait it is randomized by, for
+	// instance 0.1 for waits between 90% and 110% of the wait. If 0,
+	// BackoffJitterFraction of the Config is used, or 0.1 if it is not set
+	// either.
+	JitterFraction float64 `json:"jitter-fraction"`
+}
+
+// RetryOverride is the retry policy of a specific RPC. Its zero fields are
+// inherited from the RetryPolicy.
+type RetryOverride struct {
+	// MaxAttempts is the maximum number of attempts of the RPC, including the
+	// first one. 1 disables the retries.
+	MaxAttempts uint `json:"max-attempts"`
+	// Backoff is the wait between the retries of the RPC. Its wait between
+	// and jitter fraction are inherited if not set.
+	Backoff *Backoff `json:"backoff"`
+	// RetriableCodes are the gRPC status codes the RPC is retried on, if it
+	// is immutable.
+	RetriableCodes []codes.Code `json:"retriable-codes"`
+}
+
+// RetryThrottling is a budget of retries shared by all the RPCs of a client,
+// like the retry throttling of gRPC: the budget starts with MaxTokens tokens,
+// each failed attempt takes a token and each successful one gives back
+// TokenRatio tokens. The RPCs are not retried while the budget holds half of
+// MaxTokens or less, so that the clients stop adding load to a struggling
+// cluster.
+type RetryThrottling struct {
+	MaxTokens  float64 `json:"max-tokens"`
+	TokenRatio float64 `json:"token-ratio"`
+}
+
+// retryPolicy returns the retry policy of the config, with its unset fields
+// set from MaxUnaryRetries, BackoffWaitBetween and BackoffJitterFraction or
+// from the defaults.
+func (cfg *Config) retryPolicy() RetryPolicy {
+	var p RetryPolicy
+	if cfg.RetryPolicy != nil {
+		p = *cfg.RetryPolicy
+	}
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaultUnaryMaxRetries
+		if cfg.MaxUnaryRetries > 0 {
+			p.MaxAttempts = cfg.MaxUnaryRetries
+		}
+	}
+	if p.Backoff.WaitBetween == 0 {
+		p.Backoff.WaitBetween = defaultBackoffWaitBetween
+		if cfg.BackoffWaitBetween > 0 {
+			p.Backoff.WaitBetween = cfg.BackoffWaitBetween
+		}
+	}
+	if p.Backoff.JitterFraction == 0 {
+		p.Backoff.JitterFraction = defaultBackoffJitterFraction
+		if cfg.BackoffJitterFraction > 0 {
+			p.Backoff.JitterFraction = cfg.BackoffJitterFraction
+		}
+	}
+	return p
+}
+
+func (b Backoff) validate() error {
+	switch {
+	case b.WaitBetween < 0 || b.MaxWait < 0:
+		return errors.New("backoff waits must not be negative")
+	case b.Multiplier < 0:
+		return fmt.Errorf("backoff multiplier must not be negative (got %v)", b.Multiplier)
+	case b.JitterFraction < 0 || b.JitterFraction > 1:
+		return fmt.Errorf("backoff jitter fraction must be between 0 and 1 (got %v)", b.JitterFraction)
+	}
+	return nil
+}
+
+func (p *RetryPolicy) validate() error {
+	if p == nil {
+		return nil
+	}
+	if err := p.Backoff.validate(); err != nil {
+		return fmt.Errorf("invalid retry policy: %w", err)
+	}
+	for method, o := range p.Overrides {
+		if o.Backoff == nil {
+			continue
+		}
+		if err := o.Backoff.validate(); err != nil {
+			return fmt.Errorf("invalid retry policy of %s: %w", method, err)
+		}
+	}
+	if t := p.Throttling; t != nil && (t.MaxTokens <= 0 || t.TokenRatio <= 0) {
+		return fmt.Errorf("invalid retry policy: throttling max tokens and token ratio must be positive (got %v and %v)", t.MaxTokens, t.TokenRatio)
+	}
+	return nil
+}
+
+// wait returns the wait after the given round of retries, before jitter.
+func (b Backoff) wait(round uint) time.Duration {
+	wait := float64(b.WaitBetween)
+	if b.Multiplier > 1 && round > 1 {
+		wait *= math.Pow(b.Multiplier, float64(round-1))
+	}
+	if b.MaxWait > 0 && wait > float64(b.MaxWait) {
+		return b.MaxWait
+	}
+	return time.Duration(wait)
+}
+
+// retryOptions returns the retry options of the unary RPCs set by the
+// policy p.
+func (c *Client) retryOptions(p RetryPolicy) []retryOption {
+	opts := []retryOption{
+		withMax(p.MaxAttempts),
+		withBackoff(c.roundRobinQuorumBackoff(p.Backoff)),
+		withRetriableCodes(p.RetriableCodes),
+		withThrottler(c.retryThrottler),
+	}
+	for method, o := range p.Overrides {
+		var overrides []retryOption
+		if o.MaxAttempts > 0 {
+			overrides = append(overrides, withMax(o.MaxAttempts))
+		}
+		if o.Backoff != nil {
+			b := *o.Backoff
+			if b.WaitBetween == 0 {
+				b.WaitBetween = p.Backoff.WaitBetween
+			}
+			if b.JitterFraction == 0 {
+				b.JitterFraction = p.Backoff.JitterFraction
+			}
+			overrides = append(overrides, withBackoff(c.roundRobinQuorumBackoff(b)))
+		}
+		if len(o.RetriableCodes) > 0 {
+			overrides = append(overrides, withRetriableCodes(o.RetriableCodes))
+		}
+		opts = append(opts, withMethodOverride(method, overrides...))
+	}
+	return opts
+}
+
+// retryThrottler implements the retry budget of RetryThrottling.
+type retryThrottler struct {
+	mu         sync.Mutex
+	tokens     float64
+	maxTokens  float64
+	tokenRatio float64
+}
+
+func newRetryThrottler(t *RetryThrottling) *retryThrottler {
+	if t == nil {
+		return nil
+	}
+	return &retryThrottler{tokens: t.MaxTokens, maxTokens: t.MaxTokens, tokenRatio: t.TokenRatio}
+}
+
+// success gives back tokens to the budget after a successful attempt.
+func (t *retryThrottler) success() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.tokens = min(t.tokens+t.tokenRatio, t.maxTokens)
+	t.mu.Unlock()
+}
+
+// failure takes a token from the budget after a failed attempt, and returns
+// whether the RPC may still be retried.
+func (t *retryThrottler) failure() bool {
+	if t == nil {
+		return true
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tokens = max(t.tokens-1, 0)
+	return t.tokens > t.maxTokens/2
+}
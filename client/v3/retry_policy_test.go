@@ -0,0 +1,188 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfigRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected RetryPolicy
+	}{
+		{
+			name: "defaults",
+			expected: RetryPolicy{
+				MaxAttempts: defaultUnaryMaxRetries,
+				Backoff:     Backoff{WaitBetween: defaultBackoffWaitBetween, JitterFraction: defaultBackoffJitterFraction},
+			},
+		},
+		{
+			name: "legacy fields",
+			cfg:  Config{MaxUnaryRetries: 3, BackoffWaitBetween: time.Second, BackoffJitterFraction: 0.5},
+			expected: RetryPolicy{
+				MaxAttempts: 3,
+				Backoff:     Backoff{WaitBetween: time.Second, JitterFraction: 0.5},
+			},
+		},
+		{
+			name: "policy takes precedence",
+			cfg: Config{
+				MaxUnaryRetries:    3,
+				BackoffWaitBetween: time.Second,
+				RetryPolicy: &RetryPolicy{
+					MaxAttempts: 5,
+					Backoff:     Backoff{WaitBetween: 10 * time.Millisecond, Multiplier: 2, MaxWait: time.Second},
+				},
+			},
+			expected: RetryPolicy{
+				MaxAttempts: 5,
+				Backoff:     Backoff{WaitBetween: 10 * time.Millisecond, Multiplier: 2, MaxWait: time.Second, JitterFraction: defaultBackoffJitterFraction},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.cfg.retryPolicy())
+		})
+	}
+}
+
+func TestBackoffWait(t *testing.T) {
+	constant := Backoff{WaitBetween: 10 * time.Millisecond}
+	exponential := Backoff{WaitBetween: 10 * time.Millisecond, Multiplier: 2, MaxWait: 50 * time.Millisecond}
+	for round, expected := range []time.Duration{10, 20, 40, 50, 50} {
+		assert.Equal(t, 10*time.Millisecond, constant.wait(uint(round+1)))
+		assert.Equal(t, expected*time.Millisecond, exponential.wait(uint(round+1)), "round %d", round+1)
+	}
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	for _, p := range []*RetryPolicy{
+		{Backoff: Backoff{Multiplier: -1}},
+		{Backoff: Backoff{JitterFraction: 2}},
+		{Overrides: map[string]RetryOverride{"/etcdserverpb.KV/Range": {Backoff: &Backoff{WaitBetween: -time.Second}}}},
+		{Throttling: &RetryThrottling{MaxTokens: 10}},
+	} {
+		_, err := NewClient(t, Config{Endpoints: []string{"127.0.0.1:12345"}, RetryPolicy: p})
+		require.ErrorContains(t, err, "invalid retry policy")
+	}
+}
+
+func TestRetryThrottler(t *testing.T) {
+	th := newRetryThrottler(&RetryThrottling{MaxTokens: 4, TokenRatio: 0.5})
+	assert.True(t, th.failure())
+	assert.False(t, th.failure())
+	for range 3 {
+		th.success()
+	}
+	assert.True(t, th.failure())
+
+	var unthrottled *retryThrottler
+	unthrottled.success()
+	assert.True(t, unthrottled.failure())
+}
+
+// testRetryClient returns a client with the retry options of the policy,
+// invoking the RPCs with a connection that is never used.
+func testRetryClient(t *testing.T, p *RetryPolicy) (*Client, *grpc.ClientConn) {
+	cfg := Config{RetryPolicy: p}
+	c := &Client{
+		cfg:       cfg,
+		epMu:      new(sync.RWMutex),
+		endpoints: []string{"127.0.0.1:12345"},
+		lgMu:      new(sync.RWMutex),
+		lg:        zaptest.NewLogger(t),
+		authToken: new(authTokenState),
+	}
+	if p != nil {
+		c.retryThrottler = newRetryThrottler(p.Throttling)
+	}
+	cc, err := grpc.NewClient("passthrough:///127.0.0.1:12345", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { cc.Close() })
+	return c, cc
+}
+
+func TestUnaryClientInterceptorRetryPolicy(t *testing.T) {
+	const (
+		rangeMethod  = "/etcdserverpb.KV/Range"
+		statusMethod = "/etcdserverpb.Maintenance/Status"
+	)
+	p := &RetryPolicy{
+		MaxAttempts:    4,
+		Backoff:        Backoff{WaitBetween: time.Millisecond},
+		RetriableCodes: []codes.Code{codes.Unavailable, codes.ResourceExhausted},
+		Overrides: map[string]RetryOverride{
+			statusMethod: {MaxAttempts: 2, RetriableCodes: []codes.Code{codes.Internal}},
+		},
+	}
+	tests := []struct {
+		name     string
+		policy   *RetryPolicy
+		method   string
+		err      error
+		attempts int
+	}{
+		{name: "default code", policy: p, method: rangeMethod, err: status.Error(codes.Unavailable, "unavailable"), attempts: 4},
+		{name: "retriable code", policy: p, method: rangeMethod, err: status.Error(codes.ResourceExhausted, "too many requests"), attempts: 4},
+		{name: "not retriable code", policy: p, method: rangeMethod, err: status.Error(codes.Internal, "internal"), attempts: 1},
+		{name: "override", policy: p, method: statusMethod, err: status.Error(codes.Internal, "internal"), attempts: 2},
+		{name: "override codes", policy: p, method: statusMethod, err: status.Error(codes.Unavailable, "unavailable"), attempts: 1},
+		{
+			name: "throttled",
+			policy: &RetryPolicy{
+				MaxAttempts: 10,
+				Backoff:     Backoff{WaitBetween: time.Millisecond},
+				Throttling:  &RetryThrottling{MaxTokens: 6, TokenRatio: 1},
+			},
+			method:   rangeMethod,
+			err:      status.Error(codes.Unavailable, "unavailable"),
+			attempts: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, cc := testRetryClient(t, tt.policy)
+			interceptor := c.unaryClientInterceptor(c.retryOptions(c.cfg.retryPolicy())...)
+
+			service, method := splitMethodName(tt.method)
+			retries := testutil.ToFloat64(retriesTotal.WithLabelValues(service, method))
+			var attempts int
+			invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				attempts++
+				return tt.err
+			}
+			err := interceptor(context.Background(), tt.method, nil, nil, cc, invoker, withRepeatablePolicy())
+			require.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.attempts, attempts)
+			assert.InDelta(t, float64(tt.attempts-1), testutil.ToFloat64(retriesTotal.WithLabelValues(service, method))-retries, 0)
+		})
+	}
+}
@@ -13,4 +13,10 @@
 // limitations under the License.
 
 // Package snapshot implements utilities around etcd snapshot.
+//
+// Save downloads a snapshot from a member, verifying its checksum and
+// reporting its progress, and returns its Metadata, which can be kept next to
+// the snapshot with WriteMetadata. Verify and VerifyMetadata check the
+// integrity of a saved snapshot, for instance before restoring it with
+// etcdutl.
 package snapshot
@@ -0,0 +1,78 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+)
+
+// Metadata describes a snapshot saved by Save.
+type Metadata struct {
+	// Endpoint is the endpoint the snapshot was requested to.
+	Endpoint string `json:"endpoint"`
+	// Version is the storage version of the member that created the snapshot,
+	// empty for etcd <v3.6.
+	Version string `json:"version,omitempty"`
+
+	// ClusterID and MemberID identify the member that created the snapshot.
+	ClusterID uint64 `json:"cluster_id,omitempty"`
+	MemberID  uint64 `json:"member_id,omitempty"`
+	// Revision, RaftTerm and RaftIndex are read from the status of the member
+	// before the snapshot is requested, the snapshot holds at least the
+	// revision and the raft index. They are zero if the status could not be
+	// read.
+	Revision  int64  `json:"revision,omitempty"`
+	RaftTerm  uint64 `json:"raft_term,omitempty"`
+	RaftIndex uint64 `json:"raft_index,omitempty"`
+
+	// Size is the size of the snapshot file, including the sha256 checksum of
+	// the database appended to it.
+	Size int64 `json:"size"`
+	// SHA256 is the hex encoded sha256 checksum of the database.
+	SHA256 string `json:"sha256"`
+	// SavedAt is the time the snapshot was saved, and Took how long
+	// downloading it took.
+	SavedAt time.Time     `json:"saved_at"`
+	Took    time.Duration `json:"took"`
+}
+
+// WriteMetadata writes the metadata as JSON to the file at path, typically
+// next to the snapshot so that backup operators can later check the snapshot
+// with VerifyMetadata.
+func WriteMetadata(path string, md *Metadata) error {
+	b, err := json.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), fileutil.PrivateFileMode)
+}
+
+// ReadMetadata reads the metadata written by WriteMetadata to the file at path.
+func ReadMetadata(path string) (*Metadata, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var md Metadata
+	if err := json.Unmarshal(b, &md); err != nil {
+		return nil, fmt.Errorf("could not decode snapshot metadata %s (%w)", path, err)
+	}
+	return &md, nil
+}
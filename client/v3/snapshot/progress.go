@@ -0,0 +1,65 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"io"
+	"time"
+)
+
+// progressInterval is the minimum interval between two reports of the
+// progress, besides the last one.
+var progressInterval = time.Second
+
+// Progress is the progress of a snapshot transfer.
+type Progress struct {
+	// Bytes is the number of bytes transferred so far.
+	Bytes int64
+	// TotalBytes is the number of bytes to transfer, or zero if unknown.
+	TotalBytes int64
+	// Elapsed is the time since the transfer started.
+	Elapsed time.Duration
+}
+
+// ProgressFunc receives the progress of a snapshot transfer. It is called at
+// most once per second, and once when the transfer completes.
+type ProgressFunc func(Progress)
+
+type progressWriter struct {
+	w     io.Writer
+	fn    ProgressFunc
+	p     Progress
+	start time.Time
+	last  time.Time
+}
+
+// ProgressWriter returns a writer writing to w and reporting the number of
+// bytes written out of total to fn.
+func ProgressWriter(w io.Writer, total int64, fn ProgressFunc) io.Writer {
+	now := time.Now()
+	return &progressWriter{w: w, fn: fn, p: Progress{TotalBytes: total}, start: now, last: now}
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.p.Bytes += int64(n)
+	now := time.Now()
+	if now.Sub(pw.last) >= progressInterval || (pw.p.TotalBytes > 0 && pw.p.Bytes >= pw.p.TotalBytes) {
+		pw.last = now
+		pw.p.Elapsed = now.Sub(pw.start)
+		pw.fn(pw.p)
+	}
+	return n, err
+}
@@ -15,10 +15,13 @@
 package snapshot
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"os"
 	"time"
@@ -38,6 +41,21 @@ func hasChecksum(n int64) bool {
 	return (n % 512) == sha256.Size
 }
 
+// SaveOption configures Save.
+type SaveOption func(*saveOptions)
+
+type saveOptions struct {
+	progress ProgressFunc
+}
+
+// WithProgress sets the function Save reports the progress of the snapshot
+// download to.
+func WithProgress(fn ProgressFunc) SaveOption {
+	return func(o *saveOptions) {
+		o.progress = fn
+	}
+}
+
 // SaveWithVersion fetches snapshot from remote etcd server, saves data
 // to target path and returns server version. If the context "ctx" is canceled or timed out,
 // snapshot save stream will error out (e.g. context.Canceled,
@@ -47,13 +65,31 @@ func hasChecksum(n int64) bool {
 // the selected node.
 // Etcd <v3.6 will return "" as version.
 func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string) (string, error) {
+	md, err := Save(ctx, lg, cfg, dbPath)
+	if md == nil {
+		return "", err
+	}
+	return md.Version, err
+}
+
+// Save fetches snapshot from remote etcd server and saves data to target
+// path like SaveWithVersion. The sha256 checksum appended to the snapshot is
+// verified while the snapshot is downloaded, and the progress of the download
+// is reported to the function set by WithProgress. It returns the metadata of
+// the saved snapshot, which is also returned with the server version along
+// with an error when the snapshot cannot be saved once downloading started.
+func Save(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, dbPath string, opts ...SaveOption) (*Metadata, error) {
+	var o saveOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	cfg.Logger = lg.Named("client")
 	if len(cfg.Endpoints) != 1 {
-		return "", fmt.Errorf("snapshot must be requested to one selected node, not multiple %v", cfg.Endpoints)
+		return nil, fmt.Errorf("snapshot must be requested to one selected node, not multiple %v", cfg.Endpoints)
 	}
 	cli, err := clientv3.New(cfg)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer func() {
 		err = cli.Close()
@@ -72,7 +108,7 @@ func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, d
 
 	f, err := os.OpenFile(partpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
 	if err != nil {
-		return "", fmt.Errorf("could not open %s (%w)", partpath, err)
+		return nil, fmt.Errorf("could not open %s (%w)", partpath, err)
 	}
 	defer func() {
 		err = f.Close()
@@ -82,10 +118,23 @@ func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, d
 	}()
 	lg.Info("created temporary db file", zap.String("path", partpath))
 
+	md := &Metadata{Endpoint: cfg.Endpoints[0]}
+	// the member status is read before the snapshot is taken, the snapshot
+	// holds at least its revision and raft index
+	if status, serr := cli.Status(ctx, cfg.Endpoints[0]); serr != nil {
+		lg.Warn("could not read member status for snapshot metadata", zap.Error(serr))
+	} else {
+		md.ClusterID = status.Header.ClusterId
+		md.MemberID = status.Header.MemberId
+		md.Revision = status.Header.Revision
+		md.RaftTerm = status.RaftTerm
+		md.RaftIndex = status.RaftIndex
+	}
+
 	start := time.Now()
 	resp, err := cli.SnapshotWithVersion(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer func() {
 		err = resp.Snapshot.Close()
@@ -93,31 +142,83 @@ func SaveWithVersion(ctx context.Context, lg *zap.Logger, cfg clientv3.Config, d
 			lg.Error("Could not close snapshot stream", zap.Error(err))
 		}
 	}()
+	md.Version = resp.Version
 	lg.Info("fetching snapshot", zap.String("endpoint", cfg.Endpoints[0]))
+
+	w := newChecksumWriter(f, resp.Size)
+	var dst io.Writer = w
+	if o.progress != nil {
+		dst = ProgressWriter(w, resp.Size+sha256.Size, o.progress)
+	}
 	var size int64
-	size, err = io.Copy(f, resp.Snapshot)
+	size, err = io.Copy(dst, resp.Snapshot)
 	if err != nil {
-		return resp.Version, fmt.Errorf("could not write snapshot: %w", err)
+		return md, fmt.Errorf("could not write snapshot: %w", err)
 	}
 	if !hasChecksum(size) {
-		return resp.Version, fmt.Errorf("sha256 checksum not found [bytes: %d]", size)
+		return md, fmt.Errorf("%w [bytes: %d]", ErrChecksumNotFound, size)
+	}
+	if err = w.verify(); err != nil {
+		return md, err
 	}
 	if err = fileutil.Fsync(f); err != nil {
-		return resp.Version, fmt.Errorf("could not fsync snapshot: %w", err)
+		return md, fmt.Errorf("could not fsync snapshot: %w", err)
 	}
 	if err = f.Close(); err != nil {
-		return resp.Version, fmt.Errorf("could not close file descriptor: %w", err)
+		return md, fmt.Errorf("could not close file descriptor: %w", err)
 	}
+	md.Size = size
+	md.SHA256 = hex.EncodeToString(w.sum)
+	md.SavedAt = time.Now().UTC()
+	md.Took = time.Since(start)
 	lg.Info("fetched snapshot",
 		zap.String("endpoint", cfg.Endpoints[0]),
 		zap.String("size", humanize.Bytes(uint64(size))),
-		zap.Duration("took", time.Since(start)),
+		zap.Duration("took", md.Took),
 		zap.String("etcd-version", resp.Version),
 	)
 
 	if err = os.Rename(partpath, dbPath); err != nil {
-		return resp.Version, fmt.Errorf("could not rename %s to %s (%w)", partpath, dbPath, err)
+		return md, fmt.Errorf("could not rename %s to %s (%w)", partpath, dbPath, err)
 	}
 	lg.Info("saved", zap.String("path", dbPath))
-	return resp.Version, nil
+	return md, nil
+}
+
+// checksumWriter hashes the database written to it and keeps the sha256
+// checksum appended to it, to verify the snapshot as it is written.
+type checksumWriter struct {
+	w       io.Writer
+	h       hash.Hash
+	dbSize  int64
+	written int64
+	sum     []byte
+}
+
+func newChecksumWriter(w io.Writer, dbSize int64) *checksumWriter {
+	return &checksumWriter{w: w, h: sha256.New(), dbSize: dbSize}
+}
+
+func (c *checksumWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	written := p[:n]
+	if db := c.dbSize - c.written; db > 0 {
+		c.h.Write(written[:min(db, int64(len(written)))])
+	}
+	if c.written+int64(n) > c.dbSize {
+		c.sum = append(c.sum, written[max(c.dbSize-c.written, 0):]...)
+	}
+	c.written += int64(n)
+	return n, err
+}
+
+// verify checks the database hashes to the checksum appended to it.
+func (c *checksumWriter) verify() error {
+	if c.written != c.dbSize+sha256.Size {
+		return fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidSnapshotSize, c.dbSize+sha256.Size, c.written)
+	}
+	if sum := c.h.Sum(nil); !bytes.Equal(sum, c.sum) {
+		return fmt.Errorf("%w: expected sha256 %x, got %x", ErrChecksumMismatch, c.sum, sum)
+	}
+	return nil
 }
@@ -0,0 +1,84 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	ErrChecksumNotFound    = errors.New("snapshot: sha256 checksum not found")
+	ErrChecksumMismatch    = errors.New("snapshot: sha256 checksum mismatch")
+	ErrInvalidSnapshotSize = errors.New("snapshot: invalid snapshot size")
+	ErrMetadataMismatch    = errors.New("snapshot: snapshot does not match its metadata")
+)
+
+// Verify checks that the database of the snapshot file at dbPath hashes to
+// the sha256 checksum appended to it, and returns the hex encoded checksum.
+// Snapshots copied from a data directory have no checksum and fail with
+// ErrChecksumNotFound.
+func Verify(dbPath string) (string, error) {
+	f, err := os.Open(dbPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	if !hasChecksum(st.Size()) {
+		return "", fmt.Errorf("%w [bytes: %d]", ErrChecksumNotFound, st.Size())
+	}
+
+	h := sha256.New()
+	if _, err := io.CopyN(h, f, st.Size()-sha256.Size); err != nil {
+		return "", err
+	}
+	sum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(f, sum); err != nil {
+		return "", err
+	}
+	if dbsum := h.Sum(nil); !bytes.Equal(sum, dbsum) {
+		return "", fmt.Errorf("%w: expected sha256 %x, got %x", ErrChecksumMismatch, sum, dbsum)
+	}
+	return hex.EncodeToString(sum), nil
+}
+
+// VerifyMetadata checks the snapshot file at dbPath with Verify, and that its
+// size and checksum are the ones of its metadata.
+func VerifyMetadata(dbPath string, md *Metadata) error {
+	sum, err := Verify(dbPath)
+	if err != nil {
+		return err
+	}
+	st, err := os.Stat(dbPath)
+	if err != nil {
+		return err
+	}
+	if st.Size() != md.Size {
+		return fmt.Errorf("%w: expected size %d, got %d", ErrMetadataMismatch, md.Size, st.Size())
+	}
+	if sum != md.SHA256 {
+		return fmt.Errorf("%w: expected sha256 %s, got %s", ErrMetadataMismatch, md.SHA256, sum)
+	}
+	return nil
+}
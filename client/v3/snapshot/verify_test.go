@@ -0,0 +1,112 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSnapshot returns a database of 512 bytes followed by its checksum.
+func newSnapshot() []byte {
+	db := bytes.Repeat([]byte("db"), 256)
+	sum := sha256.Sum256(db)
+	return append(db, sum[:]...)
+}
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	snap := newSnapshot()
+	sum := hex.EncodeToString(snap[512:])
+
+	good := filepath.Join(dir, "good.db")
+	require.NoError(t, os.WriteFile(good, snap, 0o600))
+	got, err := Verify(good)
+	require.NoError(t, err)
+	assert.Equal(t, sum, got)
+
+	corrupt := filepath.Join(dir, "corrupt.db")
+	bad := bytes.Clone(snap)
+	bad[10] ^= 0xff
+	require.NoError(t, os.WriteFile(corrupt, bad, 0o600))
+	_, err = Verify(corrupt)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+
+	noChecksum := filepath.Join(dir, "nochecksum.db")
+	require.NoError(t, os.WriteFile(noChecksum, snap[:512], 0o600))
+	_, err = Verify(noChecksum)
+	require.ErrorIs(t, err, ErrChecksumNotFound)
+
+	require.NoError(t, VerifyMetadata(good, &Metadata{Size: int64(len(snap)), SHA256: sum}))
+	require.ErrorIs(t, VerifyMetadata(good, &Metadata{Size: int64(len(snap)) + 1, SHA256: sum}), ErrMetadataMismatch)
+}
+
+func TestChecksumWriter(t *testing.T) {
+	snap := newSnapshot()
+	for _, chunk := range []int{1, 7, 512, 530, len(snap)} {
+		var buf bytes.Buffer
+		w := newChecksumWriter(&buf, 512)
+		for b := snap; len(b) > 0; {
+			n := min(chunk, len(b))
+			_, err := w.Write(b[:n])
+			require.NoError(t, err)
+			b = b[n:]
+		}
+		require.NoErrorf(t, w.verify(), "chunk %d", chunk)
+		assert.Equal(t, snap, buf.Bytes())
+	}
+
+	bad := bytes.Clone(snap)
+	bad[len(bad)-1] ^= 0xff
+	w := newChecksumWriter(&bytes.Buffer{}, 512)
+	_, err := w.Write(bad)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.verify(), ErrChecksumMismatch)
+
+	w = newChecksumWriter(&bytes.Buffer{}, 512)
+	_, err = w.Write(snap[:600])
+	require.NoError(t, err)
+	require.ErrorIs(t, w.verify(), ErrInvalidSnapshotSize)
+}
+
+func TestProgressWriter(t *testing.T) {
+	var reports []Progress
+	w := ProgressWriter(&bytes.Buffer{}, 10, func(p Progress) { reports = append(reports, p) })
+	for i := 0; i < 5; i++ {
+		_, err := w.Write([]byte("ab"))
+		require.NoError(t, err)
+	}
+	// the writes are faster than the progress interval, only the completion
+	// is reported
+	require.Len(t, reports, 1)
+	assert.Equal(t, int64(10), reports[0].Bytes)
+	assert.Equal(t, int64(10), reports[0].TotalBytes)
+}
+
+func TestMetadataFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.db.json")
+	md := &Metadata{Endpoint: "http://127.0.0.1:2379", Version: "3.7.0", Revision: 5, Size: 544, SHA256: "ab"}
+	require.NoError(t, WriteMetadata(path, md))
+	got, err := ReadMetadata(path)
+	require.NoError(t, err)
+	assert.Equal(t, md, got)
+}
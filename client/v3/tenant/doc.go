@@ -0,0 +1,49 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenant is a clientv3 wrapper scoping a client to a tenant of a
+// shared cluster: the keys of the tenant begin with its prefix, its requests
+// are authenticated with its credentials, rate limited, and counted in
+// metrics labeled with its ID.
+//
+// First, provision the tenant with a root client, creating its user with
+// read and write permissions on its prefix only:
+//
+//	err := tenant.Provision(context.TODO(), rootCli, "acme", "secret", tenant.DefaultPrefix)
+//	if err != nil {
+//		// handle error!
+//	}
+//
+// Next, create a client of the tenant:
+//
+//	cli, err := tenant.NewClient(tenant.Config{
+//		Config: clientv3.Config{Endpoints: []string{"localhost:2379"}, Password: "secret"},
+//		QPS:    100,
+//		Burst:  200,
+//	}, "acme")
+//	if err != nil {
+//		// handle error!
+//	}
+//	defer cli.Close()
+//
+// Now calls using 'cli' are scoped to the tenant:
+//
+//	cli.Put(context.TODO(), "abc", "123")
+//	resp, _ := rootCli.Get(context.TODO(), "/tenants/acme/abc")
+//	fmt.Printf("%s\n", resp.Kvs[0].Value)
+//	// Output: 123
+//
+// The metrics of the tenants are registered with
+// prometheus.MustRegister(tenant.Metrics()...).
+package tenant
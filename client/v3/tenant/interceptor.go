@@ -0,0 +1,101 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "tenant_requests_total",
+		Help:      "The total number of RPC attempts and streams opened by the clients of the tenants.",
+	},
+		[]string{"tenant", "grpc_service", "grpc_method", "grpc_code"},
+	)
+	rateLimitedSeconds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "tenant_rate_limited_seconds_total",
+		Help:      "The total time the RPCs of the clients of the tenants waited for their rate limit.",
+	},
+		[]string{"tenant"},
+	)
+)
+
+// Metrics returns the collectors of the metrics of the clients of the
+// tenants, for the application to register them, for instance with
+// prometheus.MustRegister(tenant.Metrics()...).
+func Metrics() []prometheus.Collector {
+	return []prometheus.Collector{requestsTotal, rateLimitedSeconds}
+}
+
+func unaryInterceptor(tenantID string, l *rate.Limiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := wait(ctx, tenantID, l); err != nil {
+			return err
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		countRequest(tenantID, method, err)
+		return err
+	}
+}
+
+func streamInterceptor(tenantID string, l *rate.Limiter) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := wait(ctx, tenantID, l); err != nil {
+			return nil, err
+		}
+		s, err := streamer(ctx, desc, cc, method, opts...)
+		countRequest(tenantID, method, err)
+		return s, err
+	}
+}
+
+func wait(ctx context.Context, tenantID string, l *rate.Limiter) error {
+	if l == nil {
+		return nil
+	}
+	start := time.Now()
+	err := l.Wait(ctx)
+	rateLimitedSeconds.WithLabelValues(tenantID).Add(time.Since(start).Seconds())
+	return err
+}
+
+func countRequest(tenantID, fullMethod string, err error) {
+	service, method := "unknown", "unknown"
+	if s, m, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/"); ok {
+		service, method = s, m
+	}
+	requestsTotal.WithLabelValues(tenantID, service, method, status.Code(err).String()).Inc()
+}
+
+// newLimiter returns a limiter of qps requests per second, with bursts of
+// burst requests, or nil, which does not limit, if qps is not positive.
+func newLimiter(qps float64, burst int) *rate.Limiter {
+	if qps <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(qps), max(burst, 1))
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/namespace"
+)
+
+// DefaultPrefix is the default prefix of the key spaces of the tenants.
+const DefaultPrefix = "/tenants/"
+
+// Config configures the client of a tenant.
+type Config struct {
+	clientv3.Config
+
+	// Prefix is the prefix of the key spaces of the tenants: the keys of a
+	// tenant begin with Prefix, followed by its ID and "/". Defaults to
+	// DefaultPrefix.
+	Prefix string
+
+	// QPS is the number of requests per second the client sends, the
+	// attempts of the retried requests and the streams opened included.
+	// 0 disables the rate limit.
+	QPS float64
+
+	// Burst is the number of requests the client sends at once after being
+	// idle, over QPS. Defaults to 1.
+	Burst int
+}
+
+// NewClient returns a client scoped to the tenant of the ID tenantID:
+//
+//   - the keys of its KV, Watcher and Lease are prefixed with the key
+//     space of the tenant, see KeyPrefix;
+//   - it authenticates with the credentials of the config, the user name
+//     defaulting to the tenant ID if only the password is set;
+//   - its requests are rate limited to the QPS of the config;
+//   - its requests are counted in the metrics of Metrics, labeled with the
+//     tenant ID.
+//
+// The permissions of the user of the tenant are what keeps it out of the key
+// spaces of other tenants, see Provision.
+func NewClient(cfg Config, tenantID string) (*clientv3.Client, error) {
+	if err := validateTenantID(tenantID); err != nil {
+		return nil, err
+	}
+	if cfg.Prefix == "" {
+		cfg.Prefix = DefaultPrefix
+	}
+	ccfg := cfg.Config
+	if ccfg.Username == "" && ccfg.Password != "" {
+		ccfg.Username = tenantID
+	}
+	l := newLimiter(cfg.QPS, cfg.Burst)
+	ccfg.DialOptions = append(slices.Clip(ccfg.DialOptions),
+		grpc.WithChainUnaryInterceptor(unaryInterceptor(tenantID, l)),
+		grpc.WithChainStreamInterceptor(streamInterceptor(tenantID, l)),
+	)
+
+	cli, err := clientv3.New(ccfg)
+	if err != nil {
+		return nil, err
+	}
+	pfx := KeyPrefix(cfg.Prefix, tenantID)
+	cli.KV = namespace.NewKV(cli.KV, pfx)
+	cli.Watcher = namespace.NewWatcher(cli.Watcher, pfx)
+	cli.Lease = namespace.NewLease(cli.Lease, pfx)
+	return cli, nil
+}
+
+// KeyPrefix returns the prefix of the keys of the tenant of the ID tenantID.
+func KeyPrefix(prefix, tenantID string) string {
+	return prefix + tenantID + "/"
+}
+
+// RoleName returns the name of the role of the tenant of the ID tenantID
+// created by Provision.
+func RoleName(tenantID string) string {
+	return "tenant-" + tenantID
+}
+
+// Provision creates the user of the tenant of the ID tenantID, named after
+// it, and its role, granted read and write permissions on the keys of the
+// tenant only. The auth client must be of a user allowed to manage the users
+// and roles, like root.
+func Provision(ctx context.Context, auth clientv3.Auth, tenantID, password, prefix string) error {
+	if err := validateTenantID(tenantID); err != nil {
+		return err
+	}
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	pfx := KeyPrefix(prefix, tenantID)
+	role := RoleName(tenantID)
+	if _, err := auth.RoleAdd(ctx, role); err != nil {
+		return fmt.Errorf("cannot add role %q: %w", role, err)
+	}
+	if _, err := auth.RoleGrantPermission(ctx, role, pfx, clientv3.GetPrefixRangeEnd(pfx), clientv3.PermissionType(clientv3.PermReadWrite)); err != nil {
+		return fmt.Errorf("cannot grant role %q permissions on %q: %w", role, pfx, err)
+	}
+	if _, err := auth.UserAdd(ctx, tenantID, password); err != nil {
+		return fmt.Errorf("cannot add user %q: %w", tenantID, err)
+	}
+	if _, err := auth.UserGrantRole(ctx, tenantID, role); err != nil {
+		return fmt.Errorf("cannot grant role %q to user %q: %w", role, tenantID, err)
+	}
+	return nil
+}
+
+func validateTenantID(tenantID string) error {
+	switch {
+	case tenantID == "":
+		return errors.New("tenant ID must not be empty")
+	case strings.Contains(tenantID, "/"):
+		return fmt.Errorf("tenant ID %q must not contain '/'", tenantID)
+	case tenantID == "root":
+		return errors.New("tenant ID must not be root, the name of the root user")
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTenantID(t *testing.T) {
+	for _, tc := range []struct {
+		id      string
+		wantErr bool
+	}{
+		{id: "acme"},
+		{id: "acme-1.prod"},
+		{id: "", wantErr: true},
+		{id: "acme/prod", wantErr: true},
+		{id: "root", wantErr: true},
+	} {
+		t.Run(tc.id, func(t *testing.T) {
+			err := validateTenantID(tc.id)
+			assert.Equal(t, tc.wantErr, err != nil, "error: %v", err)
+		})
+	}
+}
+
+func TestKeyPrefix(t *testing.T) {
+	assert.Equal(t, "/tenants/acme/", KeyPrefix(DefaultPrefix, "acme"))
+	assert.Equal(t, "acme/", KeyPrefix("", "acme"))
+}
+
+func TestLimiterBurst(t *testing.T) {
+	l := newLimiter(1, 3)
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		require.NoError(t, wait(t.Context(), "acme", l))
+		assert.Less(t, time.Since(start), 100*time.Millisecond, "request %d of the burst waited", i)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, wait(ctx, "acme", l))
+	// the canceled wait did not take a token
+	assert.InDelta(t, 0, l.Tokens(), 0.1)
+}
+
+func TestLimiterRate(t *testing.T) {
+	l := newLimiter(100, 1)
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		require.NoError(t, wait(t.Context(), "acme", l))
+	}
+	// the first request is of the burst, the next 5 wait 10ms each
+	assert.GreaterOrEqual(t, time.Since(start), 45*time.Millisecond)
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	l := newLimiter(0, 10)
+	assert.Nil(t, l)
+	require.NoError(t, wait(t.Context(), "acme", l))
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"errors"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+// KeyPredicate is a condition on a key, given its key-value, which is nil if
+// the key does not exist.
+type KeyPredicate func(kv *mvccpb.KeyValue) bool
+
+// KeyPresent returns a predicate satisfied when the key exists.
+func KeyPresent() KeyPredicate {
+	return func(kv *mvccpb.KeyValue) bool { return kv != nil }
+}
+
+// KeyAbsent returns a predicate satisfied when the key does not exist.
+func KeyAbsent() KeyPredicate {
+	return func(kv *mvccpb.KeyValue) bool { return kv == nil }
+}
+
+// KeyValueEquals returns a predicate satisfied when the key exists with the
+// given value.
+func KeyValueEquals(val string) KeyPredicate {
+	return func(kv *mvccpb.KeyValue) bool { return kv != nil && string(kv.Value) == val }
+}
+
+// KeyVersionAtLeast returns a predicate satisfied when the key exists with a
+// version greater than or equal to the given version.
+func KeyVersionAtLeast(ver int64) KeyPredicate {
+	return func(kv *mvccpb.KeyValue) bool { return kv != nil && kv.Version >= ver }
+}
+
+// WaitFor blocks until the key satisfies pred, and returns its key-value, nil
+// if the key does not exist. For example:
+//
+//	kv, err := clientv3.WaitFor(ctx, cli, cli, "config/ready", clientv3.KeyValueEquals("true"))
+//
+// The key is read with kv, then watched with w from the revision following
+// the read, so that no change of the key is missed between them. If that
+// revision is compacted before the watch starts, the key is read again. The
+// key-values passed to pred are the ones of the watch events, which hold the
+// value of the key unless w filters it out.
+//
+// WaitFor returns ctx.Err() if ctx is done before the key satisfies pred, in
+// particular when its deadline is exceeded, and ErrWatchClosed if the watch
+// is closed otherwise.
+func WaitFor(ctx context.Context, kv KV, w Watcher, key string, pred KeyPredicate) (*mvccpb.KeyValue, error) {
+	for {
+		resp, err := kv.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		var cur *mvccpb.KeyValue
+		if len(resp.Kvs) > 0 {
+			cur = resp.Kvs[0]
+		}
+		if pred(cur) {
+			return cur, nil
+		}
+
+		cur, err = waitForWatch(ctx, w, key, resp.Header.Revision+1, pred)
+		if errors.Is(err, errWaitForCompacted) {
+			continue
+		}
+		return cur, err
+	}
+}
+
+var errWaitForCompacted = errors.New("etcdclient: watch revision compacted")
+
+// waitForWatch watches the key from rev until it satisfies pred. It returns
+// errWaitForCompacted if rev is compacted.
+func waitForWatch(ctx context.Context, w Watcher, key string, rev int64, pred KeyPredicate) (*mvccpb.KeyValue, error) {
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wr WatchResponse
+	for wr = range w.Watch(wctx, key, WithRev(rev)) {
+		if wr.CompactRevision != 0 {
+			return nil, errWaitForCompacted
+		}
+		for _, ev := range wr.Events {
+			var cur *mvccpb.KeyValue
+			if ev.Type == mvccpb.PUT {
+				cur = ev.Kv
+			}
+			if pred(cur) {
+				return cur, nil
+			}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := wr.Err(); err != nil {
+		return nil, err
+	}
+	return nil, ErrWatchClosed
+}
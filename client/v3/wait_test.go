@@ -0,0 +1,154 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+// waitForKV returns the key-values of its gets in order.
+type waitForKV struct {
+	KV
+	gets []*mvccpb.KeyValue
+	revs []int64
+}
+
+func (kv *waitForKV) Get(ctx context.Context, key string, opts ...OpOption) (*GetResponse, error) {
+	resp := &GetResponse{Header: &pb.ResponseHeader{Revision: kv.revs[0]}}
+	if kv.gets[0] != nil {
+		resp.Kvs = []*mvccpb.KeyValue{kv.gets[0]}
+	}
+	kv.gets, kv.revs = kv.gets[1:], kv.revs[1:]
+	return resp, nil
+}
+
+// waitForWatcher sends its responses in order on the watch channels, one
+// channel per watch, and records the revisions watched from.
+type waitForWatcher struct {
+	Watcher
+	resps [][]WatchResponse
+	revs  []int64
+}
+
+func (w *waitForWatcher) Watch(ctx context.Context, key string, opts ...OpOption) WatchChan {
+	op := OpGet(key, opts...)
+	w.revs = append(w.revs, op.Rev())
+	wch := make(chan WatchResponse, len(w.resps[0]))
+	for _, wr := range w.resps[0] {
+		wch <- wr
+	}
+	w.resps = w.resps[1:]
+	if len(w.resps) == 0 {
+		close(wch)
+	}
+	return wch
+}
+
+func TestWaitFor(t *testing.T) {
+	put := func(val string, ver int64) *Event {
+		return &Event{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte(val), Version: ver}}
+	}
+	del := &Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("a")}}
+
+	tests := []struct {
+		name  string
+		pred  KeyPredicate
+		gets  []*mvccpb.KeyValue
+		resps [][]WatchResponse
+
+		wkv   *mvccpb.KeyValue
+		wrevs []int64
+		werr  error
+	}{
+		{
+			name: "satisfied by the get",
+			pred: KeyPresent(),
+			gets: []*mvccpb.KeyValue{put("1", 1).Kv},
+			wkv:  put("1", 1).Kv,
+		},
+		{
+			name:  "satisfied by a watch event",
+			pred:  KeyValueEquals("2"),
+			gets:  []*mvccpb.KeyValue{nil},
+			resps: [][]WatchResponse{{{Events: []*Event{put("1", 1), put("2", 2)}}}},
+			wkv:   put("2", 2).Kv,
+			wrevs: []int64{11},
+		},
+		{
+			name:  "satisfied by a delete",
+			pred:  KeyAbsent(),
+			gets:  []*mvccpb.KeyValue{put("1", 1).Kv},
+			resps: [][]WatchResponse{{{Events: []*Event{put("2", 2)}}, {Events: []*Event{del}}}},
+			wrevs: []int64{11},
+		},
+		{
+			name: "read again after compaction",
+			pred: KeyVersionAtLeast(3),
+			gets: []*mvccpb.KeyValue{put("1", 1).Kv, put("2", 2).Kv},
+			resps: [][]WatchResponse{
+				{{CompactRevision: 15, Canceled: true}},
+				{{Events: []*Event{put("3", 3)}}},
+			},
+			wkv:   put("3", 3).Kv,
+			wrevs: []int64{11, 21},
+		},
+		{
+			name:  "watch closed",
+			pred:  KeyPresent(),
+			gets:  []*mvccpb.KeyValue{nil},
+			resps: [][]WatchResponse{{}},
+			wrevs: []int64{11},
+			werr:  ErrWatchClosed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := &waitForKV{gets: tt.gets, revs: []int64{10, 20}}
+			w := &waitForWatcher{resps: tt.resps}
+			got, err := WaitFor(t.Context(), kv, w, "a", tt.pred)
+			require.ErrorIs(t, err, tt.werr)
+			require.Equal(t, tt.wkv, got)
+			require.Equal(t, tt.wrevs, w.revs)
+		})
+	}
+}
+
+func TestWaitForDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	kv := &waitForKV{gets: []*mvccpb.KeyValue{nil}, revs: []int64{10}}
+	w := &blockingWatcher{}
+	_, err := WaitFor(ctx, kv, w, "a", KeyPresent())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// blockingWatcher closes its watch channels once their context is done.
+type blockingWatcher struct{ Watcher }
+
+func (w *blockingWatcher) Watch(ctx context.Context, key string, opts ...OpOption) WatchChan {
+	wch := make(chan WatchResponse)
+	go func() {
+		<-ctx.Done()
+		close(wch)
+	}()
+	return wch
+}
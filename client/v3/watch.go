@@ -103,6 +103,12 @@ type WatchResponse struct {
 	// Created is used to indicate the creation of the watcher.
 	Created bool
 
+	// ResumeToken is set on progress notifications. It can be persisted and
+	// passed with WithResumeToken to resume a later watcher on the same key
+	// range from this notification. Watchers use the last received token to
+	// resume after reconnecting.
+	ResumeToken []byte
+
 	closeErr error
 
 	// cancelReason is a reason of canceling watch
@@ -150,7 +156,11 @@ type watcher struct {
 
 	// streams holds all the active grpc streams keyed by ctx value.
 	streams map[string]*watchGRPCStream
-	lg      *zap.Logger
+	// delivered tracks the delivered revision of each open watch channel.
+	delivered map[WatchChan]*deliveredRevision
+	// stats holds the accounting of each open watch channel.
+	stats map[WatchChan]*watchStats
+	lg    *zap.Logger
 }
 
 // watchGRPCStream tracks all watch resources attached to a single grpc stream.
@@ -216,6 +226,8 @@ type watchRequest struct {
 	filters []pb.WatchCreateRequest_FilterType
 	// get the previous key-value pair before the event happens
 	prevKV bool
+	// resumeToken is the last resume token received for the watcher
+	resumeToken []byte
 	// retc receives a chan WatchResponse once the watcher is established
 	retc chan chan WatchResponse
 }
@@ -241,16 +253,29 @@ type watcherStream struct {
 
 	// buf holds all events received from etcd but not yet consumed by the client
 	buf []*WatchResponse
+
+	// delivered is the revision up to which all the events were consumed by the client
+	delivered *deliveredRevision
+	// stats accounts the responses received and sent to the subscriber
+	stats *watchStats
 }
 
+// NewWatcher returns a Watcher sending its requests to the learners of the
+// client if LearnerEndpoints is set, and to its endpoints otherwise.
 func NewWatcher(c *Client) Watcher {
-	return NewWatchFromWatchClient(pb.NewWatchClient(c.conn), c)
+	conn := c.conn
+	if c.learnerConn != nil {
+		conn = c.learnerConn
+	}
+	return NewWatchFromWatchClient(pb.NewWatchClient(conn), c)
 }
 
 func NewWatchFromWatchClient(wc pb.WatchClient, c *Client) Watcher {
 	w := &watcher{
-		remote:  wc,
-		streams: make(map[string]*watchGRPCStream),
+		remote:    wc,
+		streams:   make(map[string]*watchGRPCStream),
+		delivered: make(map[WatchChan]*deliveredRevision),
+		stats:     make(map[WatchChan]*watchStats),
 	}
 	if c != nil {
 		w.callOpts = c.callOpts
@@ -290,6 +315,7 @@ func (w *watcher) newWatcherGRPCStream(inctx context.Context) *watchGRPCStream {
 		resumec:    make(chan struct{}),
 		lg:         w.lg,
 	}
+	watchStreams.Inc()
 	go wgs.run()
 	return wgs
 }
@@ -316,6 +342,7 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		fragment:       ow.fragment,
 		filters:        filters,
 		prevKV:         ow.prevKV,
+		resumeToken:    ow.resumeToken,
 		retc:           make(chan chan WatchResponse, 1),
 	}
 
@@ -454,6 +481,26 @@ func (w *watcher) closeStream(wgs *watchGRPCStream) {
 		delete(w.streams, wgs.ctxKey)
 	}
 	w.mu.Unlock()
+	watchStreams.Dec()
+}
+
+func (w *watcher) addDelivered(ws *watcherStream) {
+	w.mu.Lock()
+	w.delivered[ws.outc] = ws.delivered
+	w.stats[ws.outc] = ws.stats
+	w.mu.Unlock()
+	watchWatchers.Inc()
+}
+
+func (w *watcher) removeDelivered(ws *watcherStream) {
+	// ws.outc is reset once closed by a canceled watcher being resumed
+	outc := ws.delivered.outc
+	w.mu.Lock()
+	delete(w.delivered, outc)
+	delete(w.stats, outc)
+	w.mu.Unlock()
+	watchWatchers.Dec()
+	ws.delivered.close()
 }
 
 func (w *watchGRPCStream) addSubstream(resp *pb.WatchResponse, ws *watcherStream) {
@@ -465,6 +512,7 @@ func (w *watchGRPCStream) addSubstream(resp *pb.WatchResponse, ws *watcherStream
 		return
 	}
 	ws.id = resp.WatchId
+	ws.stats.id.Store(ws.id)
 	w.substreams[ws.id] = ws
 }
 
@@ -483,6 +531,7 @@ func (w *watchGRPCStream) closeSubstream(ws *watcherStream) {
 	case ws.initReq.retc <- ws.outc:
 	default:
 	}
+	w.owner.removeDelivered(ws)
 	// close subscriber's channel
 	if closeErr := w.closeErr; closeErr != nil && ws.initReq.ctx.Err() == nil {
 		go w.sendCloseSubstream(ws, &WatchResponse{Canceled: true, closeErr: w.closeErr})
@@ -555,8 +604,11 @@ func (w *watchGRPCStream) run() {
 					id:      InvalidWatchID,
 					outc:    outc,
 					// unbuffered so resumes won't cause repeat events
-					recvc: make(chan *WatchResponse),
+					recvc:     make(chan *WatchResponse),
+					delivered: newDeliveredRevision(w, outc),
+					stats:     newWatchStats(w, wreq.key, wreq.end),
 				}
+				w.owner.addDelivered(ws)
 
 				ws.donec = make(chan struct{})
 				w.wg.Add(1)
@@ -723,6 +775,7 @@ func (w *watchGRPCStream) dispatchEvent(pbresp *pb.WatchResponse) bool {
 		CompactRevision: pbresp.CompactRevision,
 		Created:         pbresp.Created,
 		Canceled:        pbresp.Canceled,
+		ResumeToken:     pbresp.ResumeToken,
 		cancelReason:    pbresp.CancelReason,
 	}
 
@@ -814,6 +867,8 @@ func (w *watchGRPCStream) serveSubstream(ws *watcherStream, resumec chan struct{
 			if ws.buf[0].Err() != nil {
 				return
 			}
+			ws.delivered.advance(curWr)
+			ws.stats.deliver(curWr)
 			ws.buf[0] = nil
 			ws.buf = ws.buf[1:]
 		case wr, ok := <-ws.recvc:
@@ -823,6 +878,7 @@ func (w *watchGRPCStream) serveSubstream(ws *watcherStream, resumec chan struct{
 			}
 
 			if wr.Created {
+				ws.stats.created(wr, len(ws.buf) > 0)
 				if ws.initReq.retc != nil {
 					ws.initReq.retc <- ws.outc
 					// to prevent next write from taking the slot in buffered channel
@@ -842,13 +898,18 @@ func (w *watchGRPCStream) serveSubstream(ws *watcherStream, resumec chan struct{
 					// If the revision is only bound on the first observed event,
 					// if wch is disconnected before the Put is issued, then reconnects
 					// after it is committed, it'll miss the Put.
-					if ws.initReq.rev == 0 {
+					// A watcher resumed from a token starts from its revision,
+					// which the server resolves again on reconnection.
+					if ws.initReq.rev == 0 && ws.initReq.resumeToken == nil {
 						nextRev = wr.Header.Revision
 					}
 				}
 			} else {
 				// current progress of watch; <= store revision
 				nextRev = wr.Header.Revision + 1
+				if wr.ResumeToken != nil {
+					ws.initReq.resumeToken = wr.ResumeToken
+				}
 			}
 
 			if len(wr.Events) > 0 {
@@ -865,6 +926,7 @@ func (w *watchGRPCStream) serveSubstream(ws *watcherStream, resumec chan struct{
 
 			// TODO pause channel if buffer gets too large
 			ws.buf = append(ws.buf, wr)
+			ws.stats.receive(wr)
 		case <-w.ctx.Done():
 			return
 		case <-ws.initReq.ctx.Done():
@@ -884,6 +946,7 @@ func (w *watchGRPCStream) newWatchClient() (pb.Watch_WatchClient, error) {
 	w.joinSubstreams()
 	for _, ws := range w.substreams {
 		ws.id = InvalidWatchID
+		ws.stats.id.Store(InvalidWatchID)
 		w.resuming = append(w.resuming, ws)
 	}
 	// strip out nils, if any
@@ -1023,6 +1086,7 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		Filters:        wr.filters,
 		PrevKv:         wr.prevKV,
 		Fragment:       wr.fragment,
+		ResumeToken:    wr.resumeToken,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
@@ -0,0 +1,277 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"errors"
+	"sort"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+var ErrWatchStatsNotSupported = errors.New("etcdclient: watcher does not support stats")
+
+var (
+	watchStreams = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "watch_streams",
+		Help:      "The number of open gRPC watch streams.",
+	})
+	watchWatchers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "watch_watchers",
+		Help:      "The number of open watch channels, multiplexed on the gRPC watch streams.",
+	})
+	watchEventsDelivered = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "watch_events_delivered_total",
+		Help:      "The total number of events sent on the watch channels.",
+	})
+	watchBytesDelivered = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "watch_event_bytes_delivered_total",
+		Help:      "The total size in bytes of the events sent on the watch channels.",
+	})
+	watchLag = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "client",
+		Name:      "watch_lag_revisions",
+		Help:      "The number of revisions received from the server but not yet sent on a watch channel, when a response is sent on it.",
+
+		// lowest bucket start of upper bound 1 with factor 4
+		// highest bucket start of 1 * 4^9 == 262144
+		Buckets: prometheus.ExponentialBuckets(1, 4, 10),
+	})
+)
+
+// WatchMetrics returns the collectors of the watch metrics of the clients,
+// for the application to register them, for instance with
+// prometheus.MustRegister(clientv3.WatchMetrics()...). The metrics are
+// shared by all the clients of the process.
+func WatchMetrics() []prometheus.Collector {
+	return []prometheus.Collector{watchStreams, watchWatchers, watchEventsDelivered, watchBytesDelivered, watchLag}
+}
+
+// WatchStats is the accounting of a watch channel.
+type WatchStats struct {
+	// Key and RangeEnd are the range watched.
+	Key      string
+	RangeEnd string
+	// WatchID is the ID of the watcher on its gRPC stream, InvalidWatchID
+	// while it is created or resumed.
+	WatchID int64
+
+	// Responses, Events and Bytes are the number of responses, events and
+	// bytes of events sent on the channel.
+	Responses int64
+	Events    int64
+	Bytes     int64
+	// Pending is the number of responses received from the server that are
+	// not sent on the channel yet, since the subscriber did not receive the
+	// previous ones.
+	Pending int64
+
+	// HeadRevision is the revision of the last response received from the
+	// server for the watcher, and DeliveredRevision the one of the last
+	// response sent on the channel.
+	HeadRevision      int64
+	DeliveredRevision int64
+}
+
+// Lag is the number of revisions the subscriber of the channel is behind the
+// responses received from the server. A lag growing over time is the sign of
+// a slow consumer, that the server eventually cancels.
+func (s WatchStats) Lag() int64 {
+	return max(s.HeadRevision-s.DeliveredRevision, 0)
+}
+
+// WatchStreamStats is the accounting of a gRPC watch stream, on which the
+// watch channels opened with the same context metadata are multiplexed.
+type WatchStreamStats struct {
+	// Watchers are the stats of the open watch channels of the stream, by
+	// ascending watch ID.
+	Watchers []WatchStats
+}
+
+// Events returns the total number of events sent on the watch channels of the
+// stream.
+func (s WatchStreamStats) Events() (n int64) {
+	for _, ws := range s.Watchers {
+		n += ws.Events
+	}
+	return n
+}
+
+// Bytes returns the total size in bytes of the events sent on the watch
+// channels of the stream.
+func (s WatchStreamStats) Bytes() (n int64) {
+	for _, ws := range s.Watchers {
+		n += ws.Bytes
+	}
+	return n
+}
+
+type watchStatser interface {
+	watchChanStats(wch WatchChan) (WatchStats, bool)
+	watchStreamsStats() []WatchStreamStats
+}
+
+// WatchChanStats returns the stats of wch, a channel returned by w.Watch.
+//
+// WatchChanStats returns ErrWatchClosed if wch is closed, and
+// ErrWatchStatsNotSupported if w is not a watcher created by this package,
+// for example a namespaced or leasing watcher.
+func WatchChanStats(w Watcher, wch WatchChan) (WatchStats, error) {
+	if c, ok := w.(*Client); ok {
+		w = c.Watcher
+	}
+	sw, ok := w.(watchStatser)
+	if !ok {
+		return WatchStats{}, ErrWatchStatsNotSupported
+	}
+	s, ok := sw.watchChanStats(wch)
+	if !ok {
+		return WatchStats{}, ErrWatchClosed
+	}
+	return s, nil
+}
+
+// WatchStreamsStats returns the stats of the gRPC watch streams of w, in no
+// particular order. It returns ErrWatchStatsNotSupported if w is not a watcher
+// created by this package.
+func WatchStreamsStats(w Watcher) ([]WatchStreamStats, error) {
+	if c, ok := w.(*Client); ok {
+		w = c.Watcher
+	}
+	sw, ok := w.(watchStatser)
+	if !ok {
+		return nil, ErrWatchStatsNotSupported
+	}
+	return sw.watchStreamsStats(), nil
+}
+
+func (w *watcher) watchChanStats(wch WatchChan) (WatchStats, bool) {
+	w.mu.Lock()
+	s := w.stats[wch]
+	w.mu.Unlock()
+	if s == nil {
+		return WatchStats{}, false
+	}
+	return s.load(), true
+}
+
+func (w *watcher) watchStreamsStats() []WatchStreamStats {
+	w.mu.Lock()
+	byStream := make(map[*watchGRPCStream][]*watchStats)
+	for _, s := range w.stats {
+		byStream[s.wgs] = append(byStream[s.wgs], s)
+	}
+	w.mu.Unlock()
+
+	streams := make([]WatchStreamStats, 0, len(byStream))
+	for _, stats := range byStream {
+		ss := WatchStreamStats{Watchers: make([]WatchStats, len(stats))}
+		for i, s := range stats {
+			ss.Watchers[i] = s.load()
+		}
+		sort.Slice(ss.Watchers, func(i, j int) bool { return ss.Watchers[i].WatchID < ss.Watchers[j].WatchID })
+		streams = append(streams, ss)
+	}
+	return streams
+}
+
+// watchStats accounts the responses of a watcher. It is updated by the
+// goroutine of its substream and read by the callers of WatchChanStats.
+type watchStats struct {
+	wgs *watchGRPCStream
+	key string
+	end string
+
+	id        atomic.Int64
+	received  atomic.Int64
+	responses atomic.Int64
+	events    atomic.Int64
+	bytes     atomic.Int64
+	head      atomic.Int64
+	delivered atomic.Int64
+}
+
+func newWatchStats(wgs *watchGRPCStream, key, end string) *watchStats {
+	s := &watchStats{wgs: wgs, key: key, end: end}
+	s.id.Store(InvalidWatchID)
+	return s
+}
+
+func (s *watchStats) load() WatchStats {
+	responses := s.responses.Load()
+	return WatchStats{
+		Key:               s.key,
+		RangeEnd:          s.end,
+		WatchID:           s.id.Load(),
+		Responses:         responses,
+		Events:            s.events.Load(),
+		Bytes:             s.bytes.Load(),
+		Pending:           max(s.received.Load()-responses, 0),
+		HeadRevision:      s.head.Load(),
+		DeliveredRevision: s.delivered.Load(),
+	}
+}
+
+// created records the creation of the watcher on the server. The subscriber
+// is caught up if no response of the watcher is pending.
+func (s *watchStats) created(wr *WatchResponse, pending bool) {
+	storeMax(&s.head, wr.Header.Revision)
+	if !pending {
+		storeMax(&s.delivered, wr.Header.Revision)
+	}
+}
+
+// receive records that wr was received from the server.
+func (s *watchStats) receive(wr *WatchResponse) {
+	s.received.Add(1)
+	storeMax(&s.head, wr.Header.Revision)
+}
+
+// deliver records that wr was sent on the channel of the watcher.
+func (s *watchStats) deliver(wr *WatchResponse) {
+	size := 0
+	for _, ev := range wr.Events {
+		size += (*mvccpb.Event)(ev).Size()
+	}
+	s.responses.Add(1)
+	s.events.Add(int64(len(wr.Events)))
+	s.bytes.Add(int64(size))
+	storeMax(&s.delivered, wr.Header.Revision)
+
+	watchEventsDelivered.Add(float64(len(wr.Events)))
+	watchBytesDelivered.Add(float64(size))
+	watchLag.Observe(float64(max(s.head.Load()-s.delivered.Load(), 0)))
+}
+
+func storeMax(v *atomic.Int64, n int64) {
+	for {
+		cur := v.Load()
+		if n <= cur || v.CompareAndSwap(cur, n) {
+			return
+		}
+	}
+}
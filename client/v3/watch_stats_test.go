@@ -0,0 +1,85 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func TestWatchStatsAccounting(t *testing.T) {
+	s := newWatchStats(nil, "a", "b")
+	require.Equal(t, WatchStats{Key: "a", RangeEnd: "b", WatchID: InvalidWatchID}, s.load())
+
+	s.created(&WatchResponse{Header: pb.ResponseHeader{Revision: 5}, Created: true}, false)
+	s.id.Store(3)
+	st := s.load()
+	require.Equal(t, int64(3), st.WatchID)
+	require.Equal(t, int64(5), st.HeadRevision)
+	require.Equal(t, int64(5), st.DeliveredRevision)
+
+	ev := &Event{Type: EventTypePut, Kv: &mvccpb.KeyValue{Key: []byte("a"), Value: []byte("1"), ModRevision: 6}}
+	size := int64((*mvccpb.Event)(ev).Size())
+	wr1 := &WatchResponse{Header: pb.ResponseHeader{Revision: 6}, Events: []*Event{ev}}
+	wr2 := &WatchResponse{Header: pb.ResponseHeader{Revision: 9}, Events: []*Event{ev, ev}}
+	s.receive(wr1)
+	s.receive(wr2)
+	st = s.load()
+	require.Equal(t, int64(2), st.Pending)
+	require.Equal(t, int64(4), st.Lag())
+
+	s.deliver(wr1)
+	st = s.load()
+	require.Equal(t, int64(1), st.Responses)
+	require.Equal(t, int64(1), st.Events)
+	require.Equal(t, size, st.Bytes)
+	require.Equal(t, int64(1), st.Pending)
+	require.Equal(t, int64(3), st.Lag())
+
+	s.deliver(wr2)
+	st = s.load()
+	require.Equal(t, int64(2), st.Responses)
+	require.Equal(t, int64(3), st.Events)
+	require.Equal(t, 3*size, st.Bytes)
+	require.Equal(t, int64(0), st.Pending)
+	require.Equal(t, int64(0), st.Lag())
+
+	// a resumed watcher is created again while a response is pending
+	s.receive(&WatchResponse{Header: pb.ResponseHeader{Revision: 10}})
+	s.created(&WatchResponse{Header: pb.ResponseHeader{Revision: 12}, Created: true}, true)
+	st = s.load()
+	require.Equal(t, int64(12), st.HeadRevision)
+	require.Equal(t, int64(9), st.DeliveredRevision)
+}
+
+func TestWatchStatsNotSupported(t *testing.T) {
+	_, err := WatchChanStats(nopWatcher{}, nil)
+	require.ErrorIs(t, err, ErrWatchStatsNotSupported)
+	_, err = WatchStreamsStats(nopWatcher{})
+	require.ErrorIs(t, err, ErrWatchStatsNotSupported)
+}
+
+func TestWatchStatsUnknownChannel(t *testing.T) {
+	w := NewWatchFromWatchClient(nil, nil)
+	_, err := WatchChanStats(w, make(WatchChan))
+	require.ErrorIs(t, err, ErrWatchClosed)
+	streams, err := WatchStreamsStats(w)
+	require.NoError(t, err)
+	require.Empty(t, streams)
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xds enables the xds endpoints of clientv3, so that clients in
+// proxyless gRPC service meshes rely on the xDS management server of the mesh
+// for the discovery of the etcd members, the load balancing between them and
+// mTLS. It is enabled by importing the package for its side effects:
+//
+//	import _ "go.etcd.io/etcd/client/v3/xds"
+//
+// and by setting a single xds endpoint in the client configuration:
+//
+//	cli, err := clientv3.New(clientv3.Config{
+//		Endpoints: []string{"xds:///etcd.example.svc:2379"},
+//	})
+//
+// The gRPC xDS client is configured by the bootstrap file set by the
+// GRPC_XDS_BOOTSTRAP environment variable, or by the configuration of the
+// GRPC_XDS_BOOTSTRAP_CONFIG environment variable. The transport security of
+// the connections is the one the management server configures, falling back
+// to the TLS configuration of the client, or to plaintext, if it does not
+// configure any. The retries of the client and its authentication apply to
+// the connections as to any other endpoint, while the endpoints of xds
+// targets are not synchronized with the membership of the cluster.
+package xds
+
+import (
+	"google.golang.org/grpc/credentials"
+	xdscredentials "google.golang.org/grpc/credentials/xds"
+	_ "google.golang.org/grpc/xds" // registers the xds resolver and balancers
+
+	"go.etcd.io/etcd/client/v3/internal/xds"
+)
+
+func init() {
+	xds.NewCredentials = func(fallback credentials.TransportCredentials) (credentials.TransportCredentials, error) {
+		return xdscredentials.NewClientCredentials(xdscredentials.ClientOptions{FallbackCreds: fallback})
+	}
+}
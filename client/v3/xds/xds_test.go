@@ -0,0 +1,213 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/xds"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	_ "go.etcd.io/etcd/client/v3/xds"
+)
+
+// TestXDSEndpoint checks that the client resolves an xds endpoint through an
+// xDS management server and keeps retrying and authenticating the requests.
+func TestXDSEndpoint(t *testing.T) {
+	kv := &fakeKV{failures: 1}
+	etcdAddr := serve(t, func(s *grpc.Server) { pb.RegisterKVServer(s, kv) })
+	ads := &adsServer{resources: xdsResources(t, "etcd", etcdAddr)}
+	adsAddr := serve(t, func(s *grpc.Server) { discoveryv3.RegisterAggregatedDiscoveryServiceServer(s, ads) })
+	// the bootstrap environment variables are read when gRPC is initialized
+	resolver, err := xds.NewXDSResolverWithConfigForTesting([]byte(fmt.Sprintf(`{
+		"xds_servers": [{"server_uri": %q, "channel_creds": [{"type": "insecure"}], "server_features": ["xds_v3"]}],
+		"node": {"id": "etcd-client"}
+	}`, adsAddr)))
+	require.NoError(t, err)
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{"xds:///etcd"},
+		Token:       "token",
+		DialOptions: []grpc.DialOption{grpc.WithResolvers(resolver)},
+	})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := cli.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "bar", string(resp.Kvs[0].Value))
+	// the first request failed and was retried
+	assert.Equal(t, []string{"token", "token"}, kv.tokens())
+
+	require.ErrorContains(t, cli.Sync(ctx), `cannot sync the endpoints of xds target "xds:///etcd"`)
+}
+
+func serve(t *testing.T, register func(*grpc.Server)) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	register(s)
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+	return l.Addr().String()
+}
+
+type fakeKV struct {
+	pb.UnimplementedKVServer
+
+	mu       sync.Mutex
+	failures int
+	tok      []string
+}
+
+func (kv *fakeKV) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	kv.tok = append(kv.tok, md.Get(rpctypes.TokenFieldNameGRPC)...)
+	if kv.failures > 0 {
+		kv.failures--
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &pb.RangeResponse{Header: &pb.ResponseHeader{}, Kvs: []*mvccpb.KeyValue{{Key: r.Key, Value: []byte("bar")}}}, nil
+}
+
+func (kv *fakeKV) tokens() []string {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.tok
+}
+
+// xdsResources returns the listener, cluster and endpoints of the xds
+// target of the given name, resolved to addr, by type URL.
+func xdsResources(t *testing.T, name, addr string) map[string]proto.Message {
+	host, p, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(p)
+	require.NoError(t, err)
+	cluster := name + "-cluster"
+
+	router, err := anypb.New(&routerv3.Router{})
+	require.NoError(t, err)
+	hcm, err := anypb.New(&hcmv3.HttpConnectionManager{
+		RouteSpecifier: &hcmv3.HttpConnectionManager_RouteConfig{RouteConfig: &routev3.RouteConfiguration{
+			Name: name + "-route",
+			VirtualHosts: []*routev3.VirtualHost{{
+				Domains: []string{"*"},
+				Routes: []*routev3.Route{{
+					Match:  &routev3.RouteMatch{PathSpecifier: &routev3.RouteMatch_Prefix{Prefix: ""}},
+					Action: &routev3.Route_Route{Route: &routev3.RouteAction{ClusterSpecifier: &routev3.RouteAction_Cluster{Cluster: cluster}}},
+				}},
+			}},
+		}},
+		HttpFilters: []*hcmv3.HttpFilter{{Name: "router", ConfigType: &hcmv3.HttpFilter_TypedConfig{TypedConfig: router}}},
+	})
+	require.NoError(t, err)
+
+	return map[string]proto.Message{
+		"type.googleapis.com/envoy.config.listener.v3.Listener": &listenerv3.Listener{
+			Name:        name,
+			ApiListener: &listenerv3.ApiListener{ApiListener: hcm},
+		},
+		"type.googleapis.com/envoy.config.cluster.v3.Cluster": &clusterv3.Cluster{
+			Name:                 cluster,
+			ClusterDiscoveryType: &clusterv3.Cluster_Type{Type: clusterv3.Cluster_EDS},
+			EdsClusterConfig: &clusterv3.Cluster_EdsClusterConfig{EdsConfig: &corev3.ConfigSource{
+				ConfigSourceSpecifier: &corev3.ConfigSource_Ads{Ads: &corev3.AggregatedConfigSource{}},
+			}},
+			LbPolicy: clusterv3.Cluster_ROUND_ROBIN,
+		},
+		"type.googleapis.com/envoy.config.endpoint.v3.ClusterLoadAssignment": &endpointv3.ClusterLoadAssignment{
+			ClusterName: cluster,
+			Endpoints: []*endpointv3.LocalityLbEndpoints{{
+				Locality:            &corev3.Locality{Zone: "zone"},
+				LoadBalancingWeight: wrapperspb.UInt32(1),
+				LbEndpoints: []*endpointv3.LbEndpoint{{
+					HostIdentifier: &endpointv3.LbEndpoint_Endpoint{Endpoint: &endpointv3.Endpoint{
+						Address: &corev3.Address{Address: &corev3.Address_SocketAddress{SocketAddress: &corev3.SocketAddress{
+							Address:       host,
+							PortSpecifier: &corev3.SocketAddress_PortValue{PortValue: uint32(port)},
+						}}},
+					}},
+				}},
+			}},
+		},
+	}
+}
+
+// adsServer is an aggregated discovery service serving a single version of
+// its resources, one per type.
+type adsServer struct {
+	discoveryv3.UnimplementedAggregatedDiscoveryServiceServer
+	resources map[string]proto.Message
+}
+
+func (s *adsServer) StreamAggregatedResources(stream discoveryv3.AggregatedDiscoveryService_StreamAggregatedResourcesServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		// the requests acknowledging the resources are not answered
+		if req.VersionInfo == "1" {
+			continue
+		}
+		resource, ok := s.resources[req.TypeUrl]
+		if !ok {
+			continue
+		}
+		a, err := anypb.New(resource)
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(&discoveryv3.DiscoveryResponse{
+			VersionInfo: "1",
+			TypeUrl:     req.TypeUrl,
+			Nonce:       "1",
+			Resources:   []*anypb.Any{a},
+		}); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+var (
+	diffPrefixes   []string
+	diffRev        int64
+	diffRev2       int64
+	diffEndpoints2 []string
+	diffPageSize   int64
+)
+
+// NewDiffCommand returns the cobra command for "diff".
+func NewDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [options]",
+		Short: "Compares the key-values under two prefixes or of two clusters",
+		Long: `Compares the key-values under two prefixes or of two clusters.
+
+With two --prefix, the keys under the first prefix are compared with the keys
+under the second one, relative to their prefix. With --endpoints2, the keys of
+the cluster are compared with the keys of the second cluster, under the same
+prefix or under the two prefixes given. The key-values are read at pinned
+revisions, so that the comparison is consistent while they are written to.
+
+The command exits with an error if the key-values differ.
+`,
+		Run: diffCommandFunc,
+	}
+
+	cmd.Flags().StringArrayVar(&diffPrefixes, "prefix", nil, "Prefix of the keys compared, given twice to compare the keys under the first prefix with the keys under the second one")
+	cmd.Flags().Int64Var(&diffRev, "rev", 0, "Revision the first key-values are read at, 0 for the current revision")
+	cmd.Flags().Int64Var(&diffRev2, "rev2", 0, "Revision the second key-values are read at, 0 for the revision of the first ones on the same cluster and the current revision of the second cluster otherwise")
+	cmd.Flags().StringSliceVar(&diffEndpoints2, "endpoints2", nil, "Endpoints of the second cluster, connected to with the same security and authentication options")
+	cmd.Flags().Int64Var(&diffPageSize, "page-size", 1000, "Number of keys read per request")
+	return cmd
+}
+
+// diffReport is the result of "diff". The keys are relative to the prefixes.
+type diffReport struct {
+	From    diffSide     `json:"from"`
+	To      diffSide     `json:"to"`
+	Added   []diffKV     `json:"added"`
+	Removed []diffKV     `json:"removed"`
+	Changed []diffChange `json:"changed"`
+}
+
+// diffSide is a set of key-values compared.
+type diffSide struct {
+	Endpoints []string `json:"endpoints"`
+	Prefix    string   `json:"prefix"`
+	Revision  int64    `json:"revision"`
+}
+
+// diffKV is a key-value only in one of the sets.
+type diffKV struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// diffChange is a key whose value differs between the sets.
+type diffChange struct {
+	Key  string `json:"key"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func (r *diffReport) same() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0
+}
+
+// diffCommandFunc executes the "diff" command.
+func diffCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("diff takes no arguments"))
+	}
+	if len(diffPrefixes) > 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("diff takes at most two --prefix"))
+	}
+	if len(diffEndpoints2) == 0 && len(diffPrefixes) != 2 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("diff compares the keys under two --prefix, or the keys of two clusters with --endpoints2"))
+	}
+	if diffPageSize <= 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("--page-size must be positive (set to %d)", diffPageSize))
+	}
+
+	var prefix, prefix2 string
+	if len(diffPrefixes) > 0 {
+		prefix, prefix2 = diffPrefixes[0], diffPrefixes[0]
+	}
+	if len(diffPrefixes) == 2 {
+		prefix2 = diffPrefixes[1]
+	}
+
+	cli := mustClientFromCmd(cmd)
+	cli2 := cli
+	if len(diffEndpoints2) != 0 {
+		cfg := clientConfigFromCmd(cmd)
+		cfg.Endpoints = diffEndpoints2
+		cli2 = mustClient(cfg)
+	}
+
+	rev := diffRev
+	if rev == 0 {
+		rev = currentRevision(cmd, cli)
+	}
+	rev2 := diffRev2
+	if rev2 == 0 {
+		if cli2 == cli {
+			rev2 = rev
+		} else {
+			rev2 = currentRevision(cmd, cli2)
+		}
+	}
+
+	r := diffReport{
+		From: diffSide{Endpoints: cli.Endpoints(), Prefix: prefix, Revision: rev},
+		To:   diffSide{Endpoints: cli2.Endpoints(), Prefix: prefix2, Revision: rev2},
+	}
+	from := &pagedKVs{cmd: cmd, cli: cli, prefix: prefix, rev: rev}
+	to := &pagedKVs{cmd: cmd, cli: cli2, prefix: prefix2, rev: rev2}
+	if err := diffKVs(&r, from, to); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	display.Diff(r)
+	if !r.same() {
+		cobrautl.ExitWithError(cobrautl.ExitError, errors.New("the key-values differ"))
+	}
+}
+
+// currentRevision returns the current revision of the cluster.
+func currentRevision(cmd *cobra.Command, cli *clientv3.Client) int64 {
+	ctx, cancel := commandCtx(cmd)
+	resp, err := cli.Get(ctx, "\x00", clientv3.WithLimit(1), clientv3.WithKeysOnly())
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	return resp.Header.Revision
+}
+
+// kvIterator returns key-values in key order, and nil after the last one.
+type kvIterator interface {
+	next() (*mvccpb.KeyValue, error)
+}
+
+// diffKVs merges the key-values of from and to, whose keys have the prefixes
+// of r.From and r.To, into the differences of r.
+func diffKVs(r *diffReport, from, to kvIterator) error {
+	a, err := from.next()
+	if err != nil {
+		return err
+	}
+	b, err := to.next()
+	if err != nil {
+		return err
+	}
+	for a != nil || b != nil {
+		var c int
+		switch {
+		case a == nil:
+			c = 1
+		case b == nil:
+			c = -1
+		default:
+			c = bytes.Compare(a.Key[len(r.From.Prefix):], b.Key[len(r.To.Prefix):])
+		}
+		if c < 0 {
+			r.Removed = append(r.Removed, diffKV{Key: string(a.Key[len(r.From.Prefix):]), Value: string(a.Value)})
+		}
+		if c > 0 {
+			r.Added = append(r.Added, diffKV{Key: string(b.Key[len(r.To.Prefix):]), Value: string(b.Value)})
+		}
+		if c == 0 && !bytes.Equal(a.Value, b.Value) {
+			r.Changed = append(r.Changed, diffChange{Key: string(a.Key[len(r.From.Prefix):]), From: string(a.Value), To: string(b.Value)})
+		}
+		if c <= 0 {
+			if a, err = from.next(); err != nil {
+				return err
+			}
+		}
+		if c >= 0 {
+			if b, err = to.next(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pagedKVs iterates over the key-values under a prefix at a revision, reading
+// them by pages of --page-size keys.
+type pagedKVs struct {
+	cmd    *cobra.Command
+	cli    *clientv3.Client
+	prefix string
+	rev    int64
+
+	kvs  []*mvccpb.KeyValue
+	key  string
+	done bool
+}
+
+func (p *pagedKVs) next() (*mvccpb.KeyValue, error) {
+	for len(p.kvs) == 0 {
+		if p.done {
+			return nil, nil
+		}
+		if err := p.read(); err != nil {
+			return nil, err
+		}
+	}
+	kv := p.kvs[0]
+	p.kvs = p.kvs[1:]
+	return kv, nil
+}
+
+func (p *pagedKVs) read() error {
+	key := p.key
+	if key == "" {
+		// the keys cannot be empty
+		key = p.prefix
+		if key == "" {
+			key = "\x00"
+		}
+	}
+	ctx, cancel := commandCtx(p.cmd)
+	resp, err := p.cli.Get(ctx, key,
+		clientv3.WithRange(clientv3.GetPrefixRangeEnd(p.prefix)),
+		clientv3.WithRev(p.rev),
+		clientv3.WithLimit(diffPageSize),
+	)
+	cancel()
+	if err != nil {
+		return err
+	}
+	p.kvs = resp.Kvs
+	p.done = !resp.More
+	if len(resp.Kvs) != 0 {
+		p.key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+	return nil
+}
+
+// diffLines returns the differences of r as lines of a unified diff, in key
+// order: a line starting with - for a key-value only in the first set, with
+// + for a key-value only in the second set, and both for a changed value.
+func diffLines(r diffReport) []string {
+	type line struct {
+		key  string
+		text string
+	}
+	var lines []line
+	for _, kv := range r.Removed {
+		lines = append(lines, line{kv.Key, fmt.Sprintf("-%q %q", kv.Key, kv.Value)})
+	}
+	for _, kv := range r.Added {
+		lines = append(lines, line{kv.Key, fmt.Sprintf("+%q %q", kv.Key, kv.Value)})
+	}
+	for _, c := range r.Changed {
+		lines = append(lines,
+			line{c.Key, fmt.Sprintf("-%q %q", c.Key, c.From)},
+			line{c.Key, fmt.Sprintf("+%q %q", c.Key, c.To)},
+		)
+	}
+	// the keys are unique to each set, so a stable sort keeps the - line of a
+	// changed key before its + line
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].key < lines[j].key })
+
+	out := []string{
+		fmt.Sprintf("--- %s %q@%d", strings.Join(r.From.Endpoints, ","), r.From.Prefix, r.From.Revision),
+		fmt.Sprintf("+++ %s %q@%d", strings.Join(r.To.Endpoints, ","), r.To.Prefix, r.To.Revision),
+	}
+	for _, l := range lines {
+		out = append(out, l.text)
+	}
+	return out
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+type sliceKVs struct {
+	kvs []*mvccpb.KeyValue
+	err error
+}
+
+func (s *sliceKVs) next() (*mvccpb.KeyValue, error) {
+	if len(s.kvs) == 0 {
+		return nil, s.err
+	}
+	kv := s.kvs[0]
+	s.kvs = s.kvs[1:]
+	return kv, nil
+}
+
+func kvs(kv ...string) *sliceKVs {
+	s := &sliceKVs{}
+	for i := 0; i < len(kv); i += 2 {
+		s.kvs = append(s.kvs, &mvccpb.KeyValue{Key: []byte(kv[i]), Value: []byte(kv[i+1])})
+	}
+	return s
+}
+
+func TestDiffKVs(t *testing.T) {
+	r := diffReport{From: diffSide{Prefix: "/a/"}, To: diffSide{Prefix: "/bb/"}}
+	err := diffKVs(&r,
+		kvs("/a/1", "x", "/a/2", "y", "/a/4", "z", "/a/6", "w"),
+		kvs("/bb/0", "v", "/bb/2", "y", "/bb/4", "Z", "/bb/5", "u"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []diffKV{{Key: "0", Value: "v"}, {Key: "5", Value: "u"}}, r.Added)
+	assert.Equal(t, []diffKV{{Key: "1", Value: "x"}, {Key: "6", Value: "w"}}, r.Removed)
+	assert.Equal(t, []diffChange{{Key: "4", From: "z", To: "Z"}}, r.Changed)
+	assert.False(t, r.same())
+
+	assert.Equal(t, []string{
+		`--- http://a:2379 "/a/"@3`,
+		`+++ http://b:2379 "/bb/"@5`,
+		`+"0" "v"`,
+		`-"1" "x"`,
+		`-"4" "z"`,
+		`+"4" "Z"`,
+		`+"5" "u"`,
+		`-"6" "w"`,
+	}, diffLines(diffReport{
+		From:    diffSide{Endpoints: []string{"http://a:2379"}, Prefix: "/a/", Revision: 3},
+		To:      diffSide{Endpoints: []string{"http://b:2379"}, Prefix: "/bb/", Revision: 5},
+		Added:   r.Added,
+		Removed: r.Removed,
+		Changed: r.Changed,
+	}))
+}
+
+func TestDiffKVsSame(t *testing.T) {
+	r := diffReport{}
+	require.NoError(t, diffKVs(&r, kvs("a", "1", "b", "2"), kvs("a", "1", "b", "2")))
+	assert.True(t, r.same())
+
+	r = diffReport{}
+	require.NoError(t, diffKVs(&r, kvs(), kvs()))
+	assert.True(t, r.same())
+}
+
+func TestDiffKVsError(t *testing.T) {
+	errRead := errors.New("read failed")
+	to := kvs("a", "1")
+	to.err = errRead
+	r := diffReport{}
+	require.ErrorIs(t, diffKVs(&r, kvs("a", "1", "b", "2"), to), errRead)
+}
@@ -16,6 +16,7 @@ package command
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -34,11 +35,14 @@ var (
 	getRev          int64
 	getKeysOnly     bool
 	getCountOnly    bool
+	getTombstones   bool
 	printValueOnly  bool
 	getMinCreateRev int64
 	getMaxCreateRev int64
 	getMinModRev    int64
 	getMaxModRev    int64
+	getAll          bool
+	getPageSize     int64
 )
 
 // NewGetCommand returns the cobra command for "get".
@@ -58,11 +62,14 @@ func NewGetCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&getRev, "rev", 0, "Specify the kv revision")
 	cmd.Flags().BoolVar(&getKeysOnly, "keys-only", false, "Get only the keys")
 	cmd.Flags().BoolVar(&getCountOnly, "count-only", false, "Get only the count")
+	cmd.Flags().BoolVar(&getTombstones, "tombstones", false, "Get the tombstones of the keys deleted since the last compaction instead of the keys")
 	cmd.Flags().BoolVar(&printValueOnly, "print-value-only", false, `Only write values when using the "simple" output format`)
 	cmd.Flags().Int64Var(&getMinCreateRev, "min-create-rev", 0, "Minimum create revision")
 	cmd.Flags().Int64Var(&getMaxCreateRev, "max-create-rev", 0, "Maximum create revision")
 	cmd.Flags().Int64Var(&getMinModRev, "min-mod-rev", 0, "Minimum modification revision")
 	cmd.Flags().Int64Var(&getMaxModRev, "max-mod-rev", 0, "Maximum modification revision")
+	cmd.Flags().BoolVar(&getAll, "all", false, "Get the keys of the range by pages of --page-size keys at the same revision, printing each page once read")
+	cmd.Flags().Int64Var(&getPageSize, "page-size", 1000, "Number of keys read per request with --all")
 
 	cmd.RegisterFlagCompletionFunc("consistency", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"l", "s"}, cobra.ShellCompDirectiveDefault
@@ -80,12 +87,6 @@ func NewGetCommand() *cobra.Command {
 // getCommandFunc executes the "get" command.
 func getCommandFunc(cmd *cobra.Command, args []string) {
 	key, opts := getGetOp(args)
-	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).Get(ctx, key, opts...)
-	cancel()
-	if err != nil {
-		cobrautl.ExitWithError(cobrautl.ExitError, err)
-	}
 
 	if getCountOnly {
 		if _, fields := display.(*fieldsPrinter); !fields {
@@ -100,9 +101,63 @@ func getCommandFunc(cmd *cobra.Command, args []string) {
 		}
 		dp.valueOnly = true
 	}
+
+	cli := mustClientFromCmd(cmd)
+	if getAll {
+		err := getPages(cmd, cli, key, opts, getPageSize, getLimit, getRev, display.Get)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		return
+	}
+
+	ctx, cancel := commandCtx(cmd)
+	resp, err := cli.Get(ctx, key, opts...)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
 	display.Get(*resp)
 }
 
+// getPages gets the keys of the range of the get op of key and opts by pages
+// of pageSize keys, and at most limit keys if not zero, calling printPage with
+// each page once read. Every page is read at rev, or at the revision of the
+// first one if zero, the next page starting after the last key of the
+// previous one.
+func getPages(cmd *cobra.Command, kv clientv3.KV, key string, opts []clientv3.OpOption, pageSize, limit, rev int64, printPage func(clientv3.GetResponse)) error {
+	// the range end of a prefix is of the key of the op, which changes
+	end := string(clientv3.OpGet(key, opts...).RangeBytes())
+	for {
+		n := pageSize
+		if limit > 0 {
+			n = min(n, limit)
+		}
+		ctx, cancel := commandCtx(cmd)
+		resp, err := kv.Get(ctx, key, append(slices.Clip(opts),
+			clientv3.WithRange(end),
+			clientv3.WithLimit(n),
+			clientv3.WithRev(rev),
+		)...)
+		cancel()
+		if err != nil {
+			return err
+		}
+		printPage(*resp)
+
+		rev = resp.Header.Revision
+		if limit > 0 {
+			if limit -= int64(len(resp.Kvs)); limit <= 0 {
+				return nil
+			}
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return nil
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
 func getGetOp(args []string) (string, []clientv3.OpOption) {
 	if len(args) == 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("get command needs one argument as key and an optional argument as range_end"))
@@ -116,6 +171,20 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--keys-only` and `--count-only` cannot be set at the same time, choose one"))
 	}
 
+	if getAll {
+		switch {
+		case len(args) < 2 && !getPrefix && !getFromKey:
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--all` requires a range, set `--prefix`, `--from-key` or range_end"))
+		case getCountOnly:
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--all` and `--count-only` cannot be set at the same time, choose one"))
+		case getPageSize <= 0:
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("--page-size must be positive (set to %d)", getPageSize))
+		case strings.ToUpper(getSortOrder) == "DESCEND" || (getSortTarget != "" && strings.ToUpper(getSortTarget) != "KEY"):
+			// the pages follow each other in key order
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("`--all` only gets the keys in ascending key order"))
+		}
+	}
+
 	var opts []clientv3.OpOption
 	if IsSerializable(getConsistency) {
 		opts = append(opts, clientv3.WithSerializable())
@@ -192,6 +261,10 @@ func getGetOp(args []string) (string, []clientv3.OpOption) {
 		opts = append(opts, clientv3.WithCountOnly())
 	}
 
+	if getTombstones {
+		opts = append(opts, clientv3.WithTombstones())
+	}
+
 	if getMinCreateRev > 0 {
 		opts = append(opts, clientv3.WithMinCreateRev(getMinCreateRev))
 	}
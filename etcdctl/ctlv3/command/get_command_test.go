@@ -0,0 +1,93 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// sortedKV serves the gets of the sorted keys at revision 7, recording them.
+type sortedKV struct {
+	clientv3.KV
+	keys []string
+	ops  []clientv3.Op
+}
+
+func (s *sortedKV) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	op := clientv3.OpGet(key, opts...)
+	s.ops = append(s.ops, op)
+	end := string(op.RangeBytes())
+	resp := &clientv3.GetResponse{Header: &pb.ResponseHeader{Revision: 7}}
+	for _, k := range s.keys {
+		if k < key || (end != "\x00" && k >= end) {
+			continue
+		}
+		if op.Limit() > 0 && int64(len(resp.Kvs)) == op.Limit() {
+			resp.More = true
+			break
+		}
+		resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k)})
+	}
+	return resp, nil
+}
+
+func testGetPages(t *testing.T, kv *sortedKV, key string, opts []clientv3.OpOption, pageSize, limit int64) [][]string {
+	cmd := &cobra.Command{}
+	cmd.Flags().Duration("command-timeout", 5*time.Second, "")
+	var pages [][]string
+	err := getPages(cmd, kv, key, opts, pageSize, limit, 0, func(resp clientv3.GetResponse) {
+		var page []string
+		for _, kv := range resp.Kvs {
+			page = append(page, string(kv.Key))
+		}
+		pages = append(pages, page)
+	})
+	require.NoError(t, err)
+	return pages
+}
+
+func TestGetPages(t *testing.T) {
+	kv := &sortedKV{keys: []string{"/a", "/b/1", "/b/2", "/b/3", "/b/4", "/b/5", "/c"}}
+	pages := testGetPages(t, kv, "/b/", []clientv3.OpOption{clientv3.WithPrefix()}, 2, 0)
+	assert.Equal(t, [][]string{{"/b/1", "/b/2"}, {"/b/3", "/b/4"}, {"/b/5"}}, pages)
+
+	require.Len(t, kv.ops, 3)
+	assert.Equal(t, int64(0), kv.ops[0].Rev())
+	assert.Equal(t, "/b/2\x00", string(kv.ops[1].KeyBytes()))
+	for _, op := range kv.ops {
+		assert.Equal(t, "/b0", string(op.RangeBytes()))
+		assert.Equal(t, int64(2), op.Limit())
+	}
+	// the pages following the first one are read at its revision
+	assert.Equal(t, int64(7), kv.ops[1].Rev())
+	assert.Equal(t, int64(7), kv.ops[2].Rev())
+}
+
+func TestGetPagesLimit(t *testing.T) {
+	kv := &sortedKV{keys: []string{"/a", "/b", "/c", "/d", "/e"}}
+	pages := testGetPages(t, kv, "\x00", []clientv3.OpOption{clientv3.WithFromKey()}, 2, 3)
+	assert.Equal(t, [][]string{{"/a", "/b"}, {"/c"}}, pages)
+	assert.Equal(t, int64(1), kv.ops[1].Limit())
+}
@@ -59,6 +59,7 @@ type GlobalFlags struct {
 	User     string
 	Password string
 	Token    string
+	AsUser   string
 
 	Debug bool
 }
@@ -296,11 +297,20 @@ func authCfgFromCmd(cmd *cobra.Command) *clientv3.AuthConfig {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
 	}
 
+	asUserFlag, err := cmd.Flags().GetString("as-user")
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
 	if userFlag == "" && tokenFlag == "" {
-		return nil
+		if asUserFlag == "" {
+			return nil
+		}
+		// the root user may be authenticated by its client certificate
+		return &clientv3.AuthConfig{ImpersonatedUser: asUserFlag}
 	}
 
-	var cfg clientv3.AuthConfig
+	cfg := clientv3.AuthConfig{ImpersonatedUser: asUserFlag}
 
 	if tokenFlag != "" {
 		cfg.Token = tokenFlag
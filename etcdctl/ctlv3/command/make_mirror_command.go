@@ -15,15 +15,20 @@
 package command
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/bgentry/speakeasy"
 	"github.com/spf13/cobra"
+	"golang.org/x/time/rate"
 
 	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
@@ -48,6 +53,11 @@ var (
 	mmnodestprefix bool
 	mmrev          int64
 	mmmaxTxnOps    uint
+
+	mmexcludeprefixes []string
+	mmrewritekeys     []string
+	mmvaluefilter     string
+	mmratelimit       float64
 )
 
 // NewMakeMirrorCommand returns the cobra command for "makeMirror".
@@ -70,6 +80,10 @@ func NewMakeMirrorCommand() *cobra.Command {
 	c.Flags().BoolVar(&mminsecureTr, "dest-insecure-transport", true, "Disable transport security for client connections")
 	c.Flags().StringVar(&mmuser, "dest-user", "", "Destination username[:password] for authentication (prompt if password is not supplied)")
 	c.Flags().StringVar(&mmpassword, "dest-password", "", "Destination password for authentication (if this option is used, --user option shouldn't include password)")
+	c.Flags().StringArrayVar(&mmexcludeprefixes, "exclude-prefix", nil, "Prefix of the source keys not to mirror, may be given several times")
+	c.Flags().StringArrayVar(&mmrewritekeys, "rewrite-key", nil, "Rewrite rule '<regexp>=<replacement>' of the destination keys, applied in order after the prefix mapping, may be given several times. The replacement may refer to the submatches as $1")
+	c.Flags().StringVar(&mmvaluefilter, "value-filter", "", "Command, not run by a shell, the value of each put is piped to, replaced by its output. The source key is in the ETCD_MIRROR_KEY environment variable, and the destination one in ETCD_MIRROR_DEST_KEY")
+	c.Flags().Float64Var(&mmratelimit, "rate-limit", 0, "Maximum number of keys written per second to the destination cluster, 0 for no limit")
 
 	return c
 }
@@ -131,16 +145,29 @@ func makeMirrorCommandFunc(cmd *cobra.Command, args []string) {
 		Secure:             sec,
 		Auth:               auth,
 	}
+	tr, err := newMirrorTransform(mmexcludeprefixes, mmrewritekeys, mmvaluefilter)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+	if mmratelimit < 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("--rate-limit must not be negative"))
+	}
+
 	dc := mustClient(cc)
 	c := mustClientFromCmd(cmd)
 
-	err := makeMirror(context.TODO(), c, dc)
+	err = makeMirror(context.TODO(), c, dc, tr)
 	cobrautl.ExitWithError(cobrautl.ExitError, err)
 }
 
-func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) error {
+func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client, tr *mirrorTransform) error {
 	total := int64(0)
 
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if mmratelimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(mmratelimit), 1)
+	}
+
 	// if destination prefix is specified and remove destination prefix is true return error
 	if mmnodestprefix && len(mmdestprefix) > 0 {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("`--dest-prefix` and `--no-dest-prefix` cannot be set at the same time, choose one"))
@@ -172,7 +199,21 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 
 		for r := range rc {
 			for _, kv := range r.Kvs {
-				_, err := dc.Put(ctx, modifyPrefix(string(kv.Key)), string(kv.Value))
+				key, ok, err := tr.key(string(kv.Key))
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				value, err := tr.value(ctx, string(kv.Key), key, kv.Value)
+				if err != nil {
+					return err
+				}
+				if err = limiter.Wait(ctx); err != nil {
+					return err
+				}
+				_, err = dc.Put(ctx, key, string(value))
 				if err != nil {
 					return err
 				}
@@ -215,12 +256,26 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 				ops = []clientv3.Op{}
 			}
 
+			key, ok, err := tr.key(string(ev.Kv.Key))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if err = limiter.Wait(ctx); err != nil {
+				return err
+			}
 			switch ev.Type {
 			case mvccpb.PUT:
-				ops = append(ops, clientv3.OpPut(modifyPrefix(string(ev.Kv.Key)), string(ev.Kv.Value)))
+				value, err := tr.value(ctx, string(ev.Kv.Key), key, ev.Kv.Value)
+				if err != nil {
+					return err
+				}
+				ops = append(ops, clientv3.OpPut(key, string(value)))
 				atomic.AddInt64(&total, 1)
 			case mvccpb.DELETE:
-				ops = append(ops, clientv3.OpDelete(modifyPrefix(string(ev.Kv.Key))))
+				ops = append(ops, clientv3.OpDelete(key))
 				atomic.AddInt64(&total, 1)
 			default:
 				panic("unexpected event type")
@@ -241,3 +296,69 @@ func makeMirror(ctx context.Context, c *clientv3.Client, dc *clientv3.Client) er
 func modifyPrefix(key string) string {
 	return strings.Replace(key, mmprefix, mmdestprefix, 1)
 }
+
+// mirrorKeyRewrite is a --rewrite-key rule.
+type mirrorKeyRewrite struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// mirrorTransform filters the mirrored keys and transforms the keys and the
+// values written to the destination cluster.
+type mirrorTransform struct {
+	excludePrefixes []string
+	rewrites        []mirrorKeyRewrite
+	// valueFilter is the command and the arguments of --value-filter.
+	valueFilter []string
+}
+
+func newMirrorTransform(excludePrefixes, rewrites []string, valueFilter string) (*mirrorTransform, error) {
+	tr := &mirrorTransform{excludePrefixes: excludePrefixes, valueFilter: strings.Fields(valueFilter)}
+	for _, rule := range rewrites {
+		expr, replacement, ok := strings.Cut(rule, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --rewrite-key %q: expected '<regexp>=<replacement>'", rule)
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --rewrite-key %q: %w", rule, err)
+		}
+		tr.rewrites = append(tr.rewrites, mirrorKeyRewrite{re: re, replacement: replacement})
+	}
+	return tr, nil
+}
+
+// key returns the destination key of the source key, or false if the source
+// key is excluded.
+func (tr *mirrorTransform) key(src string) (string, bool, error) {
+	for _, p := range tr.excludePrefixes {
+		if strings.HasPrefix(src, p) {
+			return "", false, nil
+		}
+	}
+	key := modifyPrefix(src)
+	for _, r := range tr.rewrites {
+		key = r.re.ReplaceAllString(key, r.replacement)
+	}
+	if key == "" {
+		return "", false, fmt.Errorf("key %q rewritten to an empty key", src)
+	}
+	return key, true, nil
+}
+
+// value returns the value written to the destination key: the output of the
+// value filter given the source value, or the source value without filter.
+func (tr *mirrorTransform) value(ctx context.Context, src, dest string, value []byte) ([]byte, error) {
+	if len(tr.valueFilter) == 0 {
+		return value, nil
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, tr.valueFilter[0], tr.valueFilter[1:]...)
+	cmd.Env = append(os.Environ(), "ETCD_MIRROR_KEY="+src, "ETCD_MIRROR_DEST_KEY="+dest)
+	cmd.Stdin = bytes.NewReader(value)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("value filter %q of key %q failed: %w: %s", tr.valueFilter, src, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return stdout.Bytes(), nil
+}
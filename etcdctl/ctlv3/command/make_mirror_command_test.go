@@ -0,0 +1,93 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorTransformKey(t *testing.T) {
+	defer func(prefix, destPrefix string) { mmprefix, mmdestprefix = prefix, destPrefix }(mmprefix, mmdestprefix)
+	mmprefix, mmdestprefix = "/src/", "/dst/"
+
+	tr, err := newMirrorTransform(
+		[]string{"/src/events/", "/src/leases/"},
+		[]string{`^/dst/ns-(\w+)/=/dst/$1/`, `/tmp$=/scratch`},
+		"",
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		src  string
+		want string
+		ok   bool
+	}{
+		{src: "/src/foo", want: "/dst/foo", ok: true},
+		{src: "/src/events/1", ok: false},
+		{src: "/src/leases/a", ok: false},
+		{src: "/src/eventsx", want: "/dst/eventsx", ok: true},
+		{src: "/src/ns-a/key", want: "/dst/a/key", ok: true},
+		{src: "/src/ns-a/tmp", want: "/dst/a/scratch", ok: true},
+	}
+	for _, tt := range tests {
+		key, ok, err := tr.key(tt.src)
+		require.NoError(t, err, tt.src)
+		assert.Equal(t, tt.ok, ok, tt.src)
+		assert.Equal(t, tt.want, key, tt.src)
+	}
+}
+
+func TestMirrorTransformKeyRewrittenEmpty(t *testing.T) {
+	defer func(prefix, destPrefix string) { mmprefix, mmdestprefix = prefix, destPrefix }(mmprefix, mmdestprefix)
+	mmprefix, mmdestprefix = "", ""
+
+	tr, err := newMirrorTransform(nil, []string{`^.*$=`}, "")
+	require.NoError(t, err)
+	_, _, err = tr.key("foo")
+	require.ErrorContains(t, err, "rewritten to an empty key")
+}
+
+func TestNewMirrorTransformInvalidRewrite(t *testing.T) {
+	for _, rule := range []string{"no-separator", "(=x"} {
+		_, err := newMirrorTransform(nil, []string{rule}, "")
+		require.ErrorContains(t, err, "invalid --rewrite-key", rule)
+	}
+}
+
+func TestMirrorTransformValue(t *testing.T) {
+	tr, err := newMirrorTransform(nil, nil, "")
+	require.NoError(t, err)
+	value, err := tr.value(t.Context(), "/src/foo", "/dst/foo", []byte("bar"))
+	require.NoError(t, err)
+	assert.Equal(t, "bar", string(value))
+
+	if runtime.GOOS == "windows" {
+		t.Skip("the value filter runs tr")
+	}
+	tr, err = newMirrorTransform(nil, nil, "tr a-z A-Z")
+	require.NoError(t, err)
+	value, err = tr.value(t.Context(), "/src/foo", "/dst/foo", []byte("bar"))
+	require.NoError(t, err)
+	assert.Equal(t, "BAR", string(value))
+
+	tr, err = newMirrorTransform(nil, nil, "false")
+	require.NoError(t, err)
+	_, err = tr.value(t.Context(), "/src/foo", "/dst/foo", []byte("bar"))
+	require.ErrorContains(t, err, `value filter ["false"] of key "/src/foo" failed`)
+}
@@ -30,6 +30,11 @@ var (
 	memberPeerURLs    string
 	isLearner         bool
 	memberConsistency string
+	memberDetails     bool
+
+	memberZone        string
+	memberWeight      uint32
+	memberMaintenance bool
 )
 
 // NewMemberCommand returns the cobra command for "member".
@@ -85,6 +90,9 @@ func NewMemberUpdateCommand() *cobra.Command {
 	}
 
 	cc.Flags().StringVar(&memberPeerURLs, "peer-urls", "", "comma separated peer URLs for the updated member.")
+	cc.Flags().StringVar(&memberZone, "zone", "", "zone of the member, for clients preferring the members of their zone")
+	cc.Flags().Uint32Var(&memberWeight, "weight", 0, "relative capacity of the member for weighted balancing, 0 for the default weight")
+	cc.Flags().BoolVar(&memberMaintenance, "maintenance", false, "drains the member for maintenance, so that clients stop sending it new requests")
 
 	return cc
 }
@@ -96,12 +104,16 @@ func NewMemberListCommand() *cobra.Command {
 		Short: "Lists all members in the cluster",
 		Long: `When --write-out is set to simple, this command prints out comma-separated member lists for each endpoint.
 The items in the lists are ID, Status, Name, Peer Addrs, Client Addrs, Is Learner.
+
+With --details, the items also include the Health, Version, DB Size, Applied Index and Errors of each member,
+fetched by the member serving the request from the other members.
 `,
 
 		Run: memberListCommandFunc,
 	}
 
 	cc.Flags().StringVar(&memberConsistency, "consistency", "l", "Linearizable(l) or Serializable(s)")
+	cc.Flags().BoolVar(&memberDetails, "details", false, "Also print the health, version, db size, applied index and errors of each member")
 
 	return cc
 }
@@ -211,20 +223,75 @@ func memberUpdateCommandFunc(cmd *cobra.Command, args []string) {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("bad member ID arg (%w), expecting ID in Hex", err))
 	}
 
-	if len(memberPeerURLs) == 0 {
+	flags := cmd.Flags()
+	updateAttrs := flags.Changed("zone") || flags.Changed("weight") || flags.Changed("maintenance")
+	if len(memberPeerURLs) == 0 && !updateAttrs {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("member peer urls not provided"))
 	}
 
-	urls := strings.Split(memberPeerURLs, ",")
+	c := mustClientFromCmd(cmd)
+	var resp *clientv3.MemberUpdateResponse
+	if len(memberPeerURLs) != 0 {
+		urls := strings.Split(memberPeerURLs, ",")
+
+		ctx, cancel := commandCtx(cmd)
+		resp, err = c.MemberUpdate(ctx, id, urls)
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+	}
+	if updateAttrs {
+		attrs, err := memberAttributes(cmd, c, id)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+
+		ctx, cancel := commandCtx(cmd)
+		resp, err = c.MemberUpdateAttributes(ctx, id, attrs)
+		cancel()
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+	}
+
+	display.MemberUpdate(id, *resp)
+}
 
+// memberAttributes returns the attributes of the member with the attributes
+// set by the flags of the "member update" command.
+func memberAttributes(cmd *cobra.Command, c *clientv3.Client, id uint64) (clientv3.MemberAttributes, error) {
 	ctx, cancel := commandCtx(cmd)
-	resp, err := mustClientFromCmd(cmd).MemberUpdate(ctx, id, urls)
+	resp, err := c.MemberList(ctx)
 	cancel()
 	if err != nil {
-		cobrautl.ExitWithError(cobrautl.ExitError, err)
+		return clientv3.MemberAttributes{}, err
+	}
+	var attrs clientv3.MemberAttributes
+	found := false
+	for _, m := range resp.Members {
+		if m.ID == id {
+			found = true
+			if m.Attributes != nil {
+				attrs = clientv3.MemberAttributes(*m.Attributes)
+			}
+		}
+	}
+	if !found {
+		return clientv3.MemberAttributes{}, fmt.Errorf("member %x not found", id)
 	}
 
-	display.MemberUpdate(id, *resp)
+	flags := cmd.Flags()
+	if flags.Changed("zone") {
+		attrs.Zone = memberZone
+	}
+	if flags.Changed("weight") {
+		attrs.Weight = memberWeight
+	}
+	if flags.Changed("maintenance") {
+		attrs.Maintenance = memberMaintenance
+	}
+	return attrs, nil
 }
 
 // memberListCommandFunc executes the "member list" command.
@@ -233,6 +300,9 @@ func memberListCommandFunc(cmd *cobra.Command, args []string) {
 	if IsSerializable(memberConsistency) {
 		opts = append(opts, clientv3.WithSerializable())
 	}
+	if memberDetails {
+		opts = append(opts, clientv3.WithMemberDetails())
+	}
 	ctx, cancel := commandCtx(cmd)
 	resp, err := mustClientFromCmd(cmd).MemberList(ctx, opts...)
 	cancel()
@@ -55,6 +55,10 @@ type printer interface {
 	DowngradeEnable(r v3.DowngradeResponse)
 	DowngradeCancel(r v3.DowngradeResponse)
 
+	UpgradeCheck(r upgradeReport)
+
+	Diff(r diffReport)
+
 	Alarm(v3.AlarmResponse)
 
 	RoleAdd(role string, r v3.AuthRoleAddResponse)
@@ -83,6 +87,8 @@ func NewPrinter(printerType string, isHex bool) printer {
 		return &fieldsPrinter{printer: newPrinterUnsupported("fields"), isHex: isHex}
 	case "json":
 		return newJSONPrinter(isHex)
+	case "jsonl":
+		return newJSONLPrinter(isHex)
 	case "protobuf":
 		return newPBPrinter()
 	case "table":
@@ -181,9 +187,18 @@ func (p *printerUnsupported) MoveLeader(leader, target uint64, r v3.MoveLeaderRe
 func (p *printerUnsupported) DowngradeValidate(r v3.DowngradeResponse)                  { p.p(nil) }
 func (p *printerUnsupported) DowngradeEnable(r v3.DowngradeResponse)                    { p.p(nil) }
 func (p *printerUnsupported) DowngradeCancel(r v3.DowngradeResponse)                    { p.p(nil) }
+func (p *printerUnsupported) UpgradeCheck(r upgradeReport)                              { p.p(nil) }
+func (p *printerUnsupported) Diff(r diffReport)                                         { p.p(nil) }
 
 func makeMemberListTable(r v3.MemberListResponse) (hdr []string, rows [][]string) {
 	hdr = []string{"ID", "Status", "Name", "Peer Addrs", "Client Addrs", "Is Learner"}
+	details := make(map[uint64]*pb.MemberDetail, len(r.Details))
+	for _, d := range r.Details {
+		details[d.ID] = d
+	}
+	if len(details) > 0 {
+		hdr = append(hdr, "Health", "Version", "DB Size", "Applied Index", "Errors")
+	}
 	for _, m := range r.Members {
 		status := "started"
 		if len(m.Name) == 0 {
@@ -193,18 +208,41 @@ func makeMemberListTable(r v3.MemberListResponse) (hdr []string, rows [][]string
 		if m.IsLearner {
 			isLearner = "true"
 		}
-		rows = append(rows, []string{
+		row := []string{
 			fmt.Sprintf("%x", m.ID),
 			status,
 			m.Name,
 			strings.Join(m.PeerURLs, ","),
 			strings.Join(m.ClientURLs, ","),
 			isLearner,
-		})
+		}
+		if len(details) > 0 {
+			row = append(row, makeMemberDetailColumns(details[m.ID])...)
+		}
+		rows = append(rows, row)
 	}
 	return hdr, rows
 }
 
+// makeMemberDetailColumns returns the Health, Version, DB Size, Applied Index
+// and Errors columns of the member details d, empty if d is nil, when the
+// member was added or removed while fetching the details.
+func makeMemberDetailColumns(d *pb.MemberDetail) []string {
+	if d == nil {
+		return []string{"", "", "", "", ""}
+	}
+	health := "unhealthy"
+	if d.Healthy {
+		health = "healthy"
+	}
+	var dbSize, appliedIndex string
+	if d.Version != "" {
+		dbSize = humanize.Bytes(uint64(d.DbSize))
+		appliedIndex = fmt.Sprint(d.RaftAppliedIndex)
+	}
+	return []string{health, d.Version, dbSize, appliedIndex, strings.Join(d.Errors, "; ")}
+}
+
 func makeEndpointHealthTable(healthList []epHealth) (hdr []string, rows [][]string) {
 	hdr = []string{"endpoint", "health", "took", "error"}
 	for _, h := range healthList {
@@ -257,3 +295,18 @@ func makeEndpointHashKVTable(hashList []epHashKV) (hdr []string, rows [][]string
 	}
 	return hdr, rows
 }
+
+func makeUpgradeCheckTable(r upgradeReport) (hdr []string, rows [][]string) {
+	hdr = []string{"check", "member", "result", "detail"}
+	for _, f := range r.Findings {
+		rows = append(rows, []string{f.Check, f.Member, f.Result, f.Detail})
+	}
+	return hdr, rows
+}
+
+func upgradeVerdict(r upgradeReport) string {
+	if r.Go {
+		return fmt.Sprintf("Upgrade to %s: GO", r.Target)
+	}
+	return fmt.Sprintf("Upgrade to %s: NO-GO", r.Target)
+}
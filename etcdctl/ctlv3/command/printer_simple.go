@@ -176,6 +176,21 @@ func (s *simplePrinter) EndpointHashKV(hashList []epHashKV) {
 	}
 }
 
+func (s *simplePrinter) UpgradeCheck(r upgradeReport) {
+	_, rows := makeUpgradeCheckTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+	fmt.Println(upgradeVerdict(r))
+}
+
+func (s *simplePrinter) Diff(r diffReport) {
+	for _, l := range diffLines(r) {
+		fmt.Println(l)
+	}
+	fmt.Printf("%d added, %d removed, %d changed\n", len(r.Added), len(r.Removed), len(r.Changed))
+}
+
 func (s *simplePrinter) MoveLeader(leader, target uint64, r v3.MoveLeaderResponse) {
 	fmt.Printf("Leadership transferred from %s to %s\n", types.ID(leader), types.ID(target))
 }
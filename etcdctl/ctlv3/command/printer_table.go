@@ -15,6 +15,7 @@
 package command
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/olekukonko/tablewriter"
@@ -68,3 +69,15 @@ func (tp *tablePrinter) EndpointHashKV(r []epHashKV) {
 	}
 	table.Render()
 }
+
+func (tp *tablePrinter) UpgradeCheck(r upgradeReport) {
+	hdr, rows := makeUpgradeCheckTable(r)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignLeft)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+	fmt.Println(upgradeVerdict(r))
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"text/template"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// watchProgress is the record of a progress notification printed by the
+// jsonl output format.
+type watchProgress struct {
+	Type     string `json:"type"`
+	Revision int64  `json:"revision"`
+}
+
+// watchEvent is the record of a watch event printed by the jsonl output
+// format and rendered by the watch --format templates. The keys and values
+// are hex encoded with --hex.
+type watchEvent struct {
+	Type           string `json:"type"`
+	Key            string `json:"key"`
+	Value          string `json:"value"`
+	CreateRevision int64  `json:"create_revision"`
+	ModRevision    int64  `json:"mod_revision"`
+	Version        int64  `json:"version"`
+	Lease          int64  `json:"lease"`
+	// PrevValue is the value before the event, with --prev-kv.
+	PrevValue *string `json:"prev_value,omitempty"`
+	// Revision is the revision of the watch response of the event.
+	Revision int64 `json:"revision"`
+}
+
+func newWatchEvent(isHex bool, rev int64, ev *clientv3.Event) watchEvent {
+	encode := func(b []byte) string { return string(b) }
+	if isHex {
+		encode = hex.EncodeToString
+	}
+	e := watchEvent{
+		Type:           ev.Type.String(),
+		Key:            encode(ev.Kv.Key),
+		Value:          encode(ev.Kv.Value),
+		CreateRevision: ev.Kv.CreateRevision,
+		ModRevision:    ev.Kv.ModRevision,
+		Version:        ev.Kv.Version,
+		Lease:          ev.Kv.Lease,
+		Revision:       rev,
+	}
+	if ev.PrevKv != nil {
+		prev := encode(ev.PrevKv.Value)
+		e.PrevValue = &prev
+	}
+	return e
+}
+
+// watchEventPrinter prints each event of the watch responses as a line of
+// JSON, or rendered by a template followed by a newline. The progress
+// notifications are printed as lines of JSON, and not rendered by templates.
+// The other commands are not supported.
+type watchEventPrinter struct {
+	printer
+	writer io.Writer
+	isHex  bool
+	tmpl   *template.Template
+}
+
+func newJSONLPrinter(isHex bool) printer {
+	return &watchEventPrinter{printer: newPrinterUnsupported("jsonl"), writer: os.Stdout, isHex: isHex}
+}
+
+// newWatchTemplatePrinter returns a printer rendering the watch events with
+// the Go template text, whose fields are the ones of watchEvent.
+func newWatchTemplatePrinter(text string, isHex bool) (*watchEventPrinter, error) {
+	tmpl, err := template.New("format").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return &watchEventPrinter{printer: newPrinterUnsupported("--format"), writer: os.Stdout, isHex: isHex, tmpl: tmpl}, nil
+}
+
+func (p *watchEventPrinter) Watch(resp clientv3.WatchResponse) {
+	rev := resp.Header.Revision
+	if resp.IsProgressNotify() && p.tmpl == nil {
+		printJSONTo(p.writer, watchProgress{Type: "PROGRESS", Revision: rev})
+		return
+	}
+	for _, ev := range resp.Events {
+		e := newWatchEvent(p.isHex, rev, ev)
+		if p.tmpl == nil {
+			printJSONTo(p.writer, e)
+			continue
+		}
+		if err := p.tmpl.Execute(p.writer, e); err != nil {
+			fmt.Fprintf(os.Stderr, "cannot render the watch event of key %q: %v\n", ev.Kv.Key, err)
+			continue
+		}
+		fmt.Fprintln(p.writer)
+	}
+}
+
+// printsWatchProgress returns whether the printer prints the progress
+// notifications itself, rather than the plain text line of the watch command.
+func printsWatchProgress(p printer) bool {
+	_, ok := p.(*watchEventPrinter)
+	return ok
+}
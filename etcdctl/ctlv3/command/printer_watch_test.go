@@ -0,0 +1,72 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func testWatchResponse() clientv3.WatchResponse {
+	return clientv3.WatchResponse{
+		Header: pb.ResponseHeader{Revision: 5},
+		Events: []*clientv3.Event{
+			{
+				Type:   mvccpb.PUT,
+				Kv:     &mvccpb.KeyValue{Key: []byte("foo"), Value: []byte("bar"), CreateRevision: 2, ModRevision: 4, Version: 2, Lease: 0x10},
+				PrevKv: &mvccpb.KeyValue{Key: []byte("foo"), Value: []byte("baz")},
+			},
+			{
+				Type: mvccpb.DELETE,
+				Kv:   &mvccpb.KeyValue{Key: []byte("qux"), ModRevision: 5},
+			},
+		},
+	}
+}
+
+func TestWatchEventPrinterJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	p := &watchEventPrinter{writer: &buf}
+	p.Watch(testWatchResponse())
+	p.Watch(clientv3.WatchResponse{Header: pb.ResponseHeader{Revision: 6}})
+	require.Equal(t, `{"type":"PUT","key":"foo","value":"bar","create_revision":2,"mod_revision":4,"version":2,"lease":16,"prev_value":"baz","revision":5}
+{"type":"DELETE","key":"qux","value":"","create_revision":0,"mod_revision":5,"version":0,"lease":0,"revision":5}
+{"type":"PROGRESS","revision":6}
+`, buf.String())
+
+	buf.Reset()
+	p.isHex = true
+	p.Watch(testWatchResponse())
+	require.Contains(t, buf.String(), `"key":"666f6f","value":"626172"`)
+}
+
+func TestWatchEventPrinterTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	p, err := newWatchTemplatePrinter(`{{.Type}} {{.Key}}={{.Value}} rev={{.ModRevision}}{{with .PrevValue}} prev={{.}}{{end}}`, false)
+	require.NoError(t, err)
+	p.writer = &buf
+	p.Watch(testWatchResponse())
+	p.Watch(clientv3.WatchResponse{Header: pb.ResponseHeader{Revision: 6}})
+	require.Equal(t, "PUT foo=bar rev=4 prev=baz\nDELETE qux= rev=5\n", buf.String())
+
+	_, err = newWatchTemplatePrinter(`{{.Key`, false)
+	require.ErrorContains(t, err, "invalid --format template")
+}
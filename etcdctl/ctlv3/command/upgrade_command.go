@@ -0,0 +1,292 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+)
+
+const (
+	upgradePass = "PASS"
+	upgradeWarn = "WARN"
+	upgradeFail = "FAIL"
+)
+
+var upgradeTarget string
+
+// NewUpgradeCommand returns the cobra command for "upgrade".
+func NewUpgradeCommand() *cobra.Command {
+	uc := &cobra.Command{
+		Use:   "upgrade <subcommand>",
+		Short: "Upgrade related commands",
+	}
+
+	uc.AddCommand(NewUpgradeCheckCommand())
+
+	return uc
+}
+
+// NewUpgradeCheckCommand returns the cobra command for "upgrade check".
+func NewUpgradeCheckCommand() *cobra.Command {
+	cc := &cobra.Command{
+		Use:   "check --target <TARGET_VERSION>",
+		Short: "Checks that the cluster is ready for a rolling upgrade to the target version",
+		Long: `Checks that the cluster is ready for a rolling upgrade to the target version.
+
+The versions, storage versions, deprecated flags, v2 store content, downgrade
+status and alarms of all members are checked, and a go/no-go report is
+printed. The command exits with an error if any check fails.
+`,
+
+		Run: upgradeCheckCommandFunc,
+	}
+	cc.Flags().StringVar(&upgradeTarget, "target", "", "The etcd version the cluster is upgraded to, e.g. 3.7")
+	return cc
+}
+
+// upgradeReport is the go/no-go report of "upgrade check".
+type upgradeReport struct {
+	Target   string           `json:"target"`
+	Go       bool             `json:"go"`
+	Findings []upgradeFinding `json:"findings"`
+}
+
+// upgradeFinding is the result of a check, for a member or for the whole
+// cluster if Member is empty.
+type upgradeFinding struct {
+	Check  string `json:"check"`
+	Member string `json:"member,omitempty"`
+	Result string `json:"result"`
+	Detail string `json:"detail"`
+}
+
+// upgradeMember is what the checks know about a member.
+type upgradeMember struct {
+	name      string
+	endpoint  string
+	status    *clientv3.StatusResponse
+	statusErr error
+	config    *clientv3.ConfigReportResponse
+	configErr error
+}
+
+// upgradeCheckCommandFunc executes the "upgrade check" command.
+func upgradeCheckCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("upgrade check takes no arguments"))
+	}
+	if upgradeTarget == "" {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("target version not provided"))
+	}
+	target, err := parseUpgradeTarget(upgradeTarget)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+
+	cli := mustClientFromCmd(cmd)
+	ctx, cancel := commandCtx(cmd)
+	resp, err := cli.MemberList(ctx)
+	cancel()
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+
+	var members []upgradeMember
+	for _, m := range resp.Members {
+		um := upgradeMember{name: memberName(m)}
+		if len(m.ClientURLs) == 0 {
+			um.statusErr = errors.New("the member is not started")
+			members = append(members, um)
+			continue
+		}
+		um.endpoint = m.ClientURLs[0]
+		ctx, cancel := commandCtx(cmd)
+		um.status, um.statusErr = cli.Status(ctx, um.endpoint)
+		cancel()
+		if um.statusErr == nil {
+			ctx, cancel := commandCtx(cmd)
+			um.config, um.configErr = cli.ConfigReport(ctx, um.endpoint)
+			cancel()
+		}
+		members = append(members, um)
+	}
+
+	report := checkUpgrade(target, members)
+	display.UpgradeCheck(report)
+	if !report.Go {
+		cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("the cluster is not ready for the upgrade to %s", report.Target))
+	}
+}
+
+// memberName returns the name of the member, or its ID if it has no name yet.
+func memberName(m *etcdserverpb.Member) string {
+	if m.Name != "" {
+		return m.Name
+	}
+	return types.ID(m.ID).String()
+}
+
+// parseUpgradeTarget parses a target version given as major.minor or
+// major.minor.patch, with an optional v prefix.
+func parseUpgradeTarget(s string) (*semver.Version, error) {
+	v := strings.TrimPrefix(s, "v")
+	if strings.Count(v, ".") == 1 {
+		v += ".0"
+	}
+	target, err := semver.NewVersion(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target version %q: %w", s, err)
+	}
+	return target, nil
+}
+
+// checkUpgrade runs the checks of the upgrade of the members to the target
+// version. The upgrade is a go if none of the checks fails.
+func checkUpgrade(target *semver.Version, members []upgradeMember) upgradeReport {
+	r := upgradeReport{Target: fmt.Sprintf("%d.%d", target.Major, target.Minor), Go: true}
+	add := func(check, member, result, detail string) {
+		r.Findings = append(r.Findings, upgradeFinding{Check: check, Member: member, Result: result, Detail: detail})
+		if result == upgradeFail {
+			r.Go = false
+		}
+	}
+
+	minors := make(map[string]bool)
+	for _, m := range members {
+		if m.statusErr != nil {
+			add("reachable", m.name, upgradeFail, m.statusErr.Error())
+			continue
+		}
+		add("reachable", m.name, upgradePass, m.endpoint)
+
+		v, err := semver.NewVersion(m.status.Version)
+		if err != nil {
+			add("version", m.name, upgradeFail, fmt.Sprintf("cannot parse the version %q: %v", m.status.Version, err))
+			continue
+		}
+		minors[fmt.Sprintf("%d.%d", v.Major, v.Minor)] = true
+		checkUpgradeVersion(add, target, m.name, v)
+		checkUpgradeStorageVersion(add, m.name, v, m.status.StorageVersion)
+
+		if d := m.status.DowngradeInfo; d.GetEnabled() {
+			add("downgrade", m.name, upgradeFail, fmt.Sprintf("a downgrade to %s is in progress, cancel it with 'etcdctl downgrade cancel' first", d.GetTargetVersion()))
+		} else {
+			add("downgrade", m.name, upgradePass, "no downgrade in progress")
+		}
+		if len(m.status.Errors) > 0 {
+			add("health", m.name, upgradeFail, strings.Join(m.status.Errors, ", "))
+		} else {
+			add("health", m.name, upgradePass, "no errors or alarms")
+		}
+
+		checkUpgradeConfig(add, target, m)
+	}
+
+	if len(minors) > 1 {
+		var vs []string
+		for v := range minors {
+			vs = append(vs, v)
+		}
+		sort.Strings(vs)
+		add("version", "", upgradeWarn, fmt.Sprintf("the members run different minor versions (%s), an upgrade or a downgrade is in progress", strings.Join(vs, ", ")))
+	}
+	return r
+}
+
+// checkUpgradeVersion checks that upgrading from v to the target version
+// is supported: upgrades go one minor version at a time.
+func checkUpgradeVersion(add func(check, member, result, detail string), target *semver.Version, member string, v *semver.Version) {
+	from := fmt.Sprintf("%d.%d", v.Major, v.Minor)
+	switch {
+	case target.Major != v.Major || target.Minor > v.Minor+1:
+		add("version", member, upgradeFail, fmt.Sprintf("%s cannot be upgraded to %d.%d directly, upgrade to %d.%d first", v, target.Major, target.Minor, v.Major, v.Minor+1))
+	case target.Minor < v.Minor:
+		add("version", member, upgradeFail, fmt.Sprintf("%s is newer than %d.%d, use 'etcdctl downgrade' to downgrade", v, target.Major, target.Minor))
+	case target.Minor == v.Minor:
+		add("version", member, upgradePass, fmt.Sprintf("%s, patch upgrade within %s", v, from))
+	default:
+		add("version", member, upgradePass, fmt.Sprintf("%s, upgrade from %s", v, from))
+	}
+}
+
+// checkUpgradeStorageVersion checks that the storage schema of a member was
+// migrated to its version. The storage version is reported since 3.6, and it
+// is migrated once all the members run the new version.
+func checkUpgradeStorageVersion(add func(check, member, result, detail string), member string, v *semver.Version, storageVersion string) {
+	if v.Major == 3 && v.Minor < 6 {
+		add("storage", member, upgradePass, "no storage version before 3.6")
+		return
+	}
+	if storageVersion == "" {
+		add("storage", member, upgradeWarn, "the member does not report its storage version yet")
+		return
+	}
+	sv, err := semver.NewVersion(storageVersion)
+	if err != nil {
+		add("storage", member, upgradeFail, fmt.Sprintf("cannot parse the storage version %q: %v", storageVersion, err))
+		return
+	}
+	if sv.Major != v.Major || sv.Minor != v.Minor {
+		add("storage", member, upgradeFail, fmt.Sprintf("the storage version %d.%d is not migrated to %d.%d yet, finish the previous upgrade first", sv.Major, sv.Minor, v.Major, v.Minor))
+		return
+	}
+	add("storage", member, upgradePass, fmt.Sprintf("%d.%d", sv.Major, sv.Minor))
+}
+
+// checkUpgradeConfig checks the deprecated flags and the v2 store content
+// reported by the member. The flags removed by the target version fail the
+// check, as the member would not start with them.
+func checkUpgradeConfig(add func(check, member, result, detail string), target *semver.Version, m upgradeMember) {
+	if m.configErr != nil {
+		if status.Code(m.configErr) == codes.Unimplemented {
+			add("flags", m.name, upgradeWarn, "the member does not report its configuration before 3.7, check its deprecated flags manually")
+		} else {
+			add("flags", m.name, upgradeWarn, fmt.Sprintf("cannot get the configuration of the member: %v", m.configErr))
+		}
+		return
+	}
+
+	if len(m.config.DeprecatedFlags) == 0 {
+		add("flags", m.name, upgradePass, "no deprecated flags")
+	}
+	for _, f := range m.config.DeprecatedFlags {
+		if f.RemovedIn != "" {
+			if removedIn, err := parseUpgradeTarget(f.RemovedIn); err == nil && !target.LessThan(*removedIn) {
+				add("flags", m.name, upgradeFail, fmt.Sprintf("--%s is removed in %s, unset it first", f.Name, f.RemovedIn))
+				continue
+			}
+		}
+		add("flags", m.name, upgradeWarn, f.Message)
+	}
+
+	if m.config.V2StoreCustomContent {
+		add("v2store", m.name, upgradeFail, "the v2 store holds data written by v2 API clients, migrate and remove it first")
+	} else {
+		add("v2store", m.name, upgradePass, "the v2 store only holds the cluster membership")
+	}
+}
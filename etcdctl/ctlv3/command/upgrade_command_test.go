@@ -0,0 +1,191 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func readyMember(name, version, storageVersion string) upgradeMember {
+	return upgradeMember{
+		name:     name,
+		endpoint: "http://" + name + ":2379",
+		status:   &clientv3.StatusResponse{Version: version, StorageVersion: storageVersion},
+		config:   &clientv3.ConfigReportResponse{V2Deprecation: "write-only"},
+	}
+}
+
+func failures(r upgradeReport) []string {
+	var fs []string
+	for _, f := range r.Findings {
+		if f.Result == upgradeFail {
+			fs = append(fs, f.Check+" "+f.Member+": "+f.Detail)
+		}
+	}
+	return fs
+}
+
+func TestCheckUpgrade(t *testing.T) {
+	tcs := []struct {
+		name         string
+		target       string
+		members      func() []upgradeMember
+		wantGo       bool
+		wantFailures []string
+	}{
+		{
+			name:   "ready",
+			target: "3.7",
+			members: func() []upgradeMember {
+				return []upgradeMember{readyMember("a", "3.6.1", "3.6.0"), readyMember("b", "3.6.2", "3.6.0")}
+			},
+			wantGo: true,
+		},
+		{
+			name:    "patch upgrade",
+			target:  "v3.6.3",
+			members: func() []upgradeMember { return []upgradeMember{readyMember("a", "3.6.1", "3.6.0")} },
+			wantGo:  true,
+		},
+		{
+			name:         "skipping a minor version",
+			target:       "3.7",
+			members:      func() []upgradeMember { return []upgradeMember{readyMember("a", "3.5.17", "")} },
+			wantFailures: []string{"version a: 3.5.17 cannot be upgraded to 3.7 directly, upgrade to 3.6 first"},
+		},
+		{
+			name:         "older target",
+			target:       "3.6",
+			members:      func() []upgradeMember { return []upgradeMember{readyMember("a", "3.7.0", "3.7.0")} },
+			wantFailures: []string{"version a: 3.7.0 is newer than 3.6, use 'etcdctl downgrade' to downgrade"},
+		},
+		{
+			name:         "storage not migrated",
+			target:       "3.7",
+			members:      func() []upgradeMember { return []upgradeMember{readyMember("a", "3.6.1", "3.5.0")} },
+			wantFailures: []string{"storage a: the storage version 3.5 is not migrated to 3.6 yet, finish the previous upgrade first"},
+		},
+		{
+			name:   "unreachable",
+			target: "3.7",
+			members: func() []upgradeMember {
+				return []upgradeMember{readyMember("a", "3.6.1", "3.6.0"), {name: "b", statusErr: errors.New("context deadline exceeded")}}
+			},
+			wantFailures: []string{"reachable b: context deadline exceeded"},
+		},
+		{
+			name:   "removed flag",
+			target: "3.7",
+			members: func() []upgradeMember {
+				m := readyMember("a", "3.6.1", "3.6.0")
+				m.config.DeprecatedFlags = []*pb.DeprecatedFlag{
+					{Name: "snapshot-count", Message: "--snapshot-count is deprecated", RemovedIn: "3.7"},
+					{Name: "v2-deprecation", Message: "--v2-deprecation is deprecated", RemovedIn: "3.8"},
+				}
+				return []upgradeMember{m}
+			},
+			wantFailures: []string{"flags a: --snapshot-count is removed in 3.7, unset it first"},
+		},
+		{
+			name:   "v2 store content",
+			target: "3.7",
+			members: func() []upgradeMember {
+				m := readyMember("a", "3.6.1", "3.6.0")
+				m.config.V2StoreCustomContent = true
+				return []upgradeMember{m}
+			},
+			wantFailures: []string{"v2store a: the v2 store holds data written by v2 API clients, migrate and remove it first"},
+		},
+		{
+			name:   "downgrade in progress",
+			target: "3.7",
+			members: func() []upgradeMember {
+				m := readyMember("a", "3.6.1", "3.6.0")
+				m.status.DowngradeInfo = &pb.DowngradeInfo{Enabled: true, TargetVersion: "3.5.0"}
+				return []upgradeMember{m}
+			},
+			wantFailures: []string{"downgrade a: a downgrade to 3.5.0 is in progress, cancel it with 'etcdctl downgrade cancel' first"},
+		},
+		{
+			name:   "alarm",
+			target: "3.7",
+			members: func() []upgradeMember {
+				m := readyMember("a", "3.6.1", "3.6.0")
+				m.status.Errors = []string{"memberID:1 alarm:NOSPACE "}
+				return []upgradeMember{m}
+			},
+			wantFailures: []string{"health a: memberID:1 alarm:NOSPACE "},
+		},
+		{
+			name:   "config report not supported",
+			target: "3.7",
+			members: func() []upgradeMember {
+				m := readyMember("a", "3.6.1", "3.6.0")
+				m.config, m.configErr = nil, status.Error(codes.Unimplemented, "unknown method ConfigReport")
+				return []upgradeMember{m}
+			},
+			wantGo: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			target, err := parseUpgradeTarget(tc.target)
+			require.NoError(t, err)
+			r := checkUpgrade(target, tc.members())
+			assert.Equal(t, tc.wantGo, r.Go)
+			assert.Equal(t, tc.wantFailures, failures(r))
+		})
+	}
+}
+
+func TestCheckUpgradeWarnings(t *testing.T) {
+	target, err := parseUpgradeTarget("3.7")
+	require.NoError(t, err)
+	m := readyMember("b", "3.7.0", "3.6.0")
+	m.config.DeprecatedFlags = []*pb.DeprecatedFlag{{Name: "v2-deprecation", Message: "--v2-deprecation is deprecated", RemovedIn: "3.8"}}
+	r := checkUpgrade(target, []upgradeMember{readyMember("a", "3.6.1", "3.6.0"), m})
+
+	var warnings []upgradeFinding
+	for _, f := range r.Findings {
+		if f.Result == upgradeWarn {
+			warnings = append(warnings, f)
+		}
+	}
+	assert.Equal(t, []upgradeFinding{
+		{Check: "flags", Member: "b", Result: upgradeWarn, Detail: "--v2-deprecation is deprecated"},
+		{Check: "version", Result: upgradeWarn, Detail: "the members run different minor versions (3.6, 3.7), an upgrade or a downgrade is in progress"},
+	}, warnings)
+	// the storage version of b is migrated once all the members run 3.7
+	assert.Equal(t, []string{"storage b: the storage version 3.6 is not migrated to 3.7 yet, finish the previous upgrade first"}, failures(r))
+}
+
+func TestParseUpgradeTarget(t *testing.T) {
+	for _, s := range []string{"3.7", "v3.7", "3.7.0"} {
+		v, err := parseUpgradeTarget(s)
+		require.NoError(t, err)
+		assert.Equal(t, "3.7.0", v.String())
+	}
+	_, err := parseUpgradeTarget("three")
+	require.Error(t, err)
+}
@@ -42,6 +42,7 @@ var (
 	watchInteractive bool
 	watchPrevKey     bool
 	progressNotify   bool
+	watchFormat      string
 )
 
 // NewWatchCommand returns the cobra command for "watch".
@@ -57,6 +58,7 @@ func NewWatchCommand() *cobra.Command {
 	cmd.Flags().Int64Var(&watchRev, "rev", 0, "Revision to start watching")
 	cmd.Flags().BoolVar(&watchPrevKey, "prev-kv", false, "get the previous key-value pair before the event happens")
 	cmd.Flags().BoolVar(&progressNotify, "progress-notify", false, "get periodic watch progress notification from server")
+	cmd.Flags().StringVar(&watchFormat, "format", "", "Go template rendering each event on a line, e.g. '{{.Type}} {{.Key}}={{.Value}}', with the fields Type, Key, Value, CreateRevision, ModRevision, Version, Lease, PrevValue and Revision")
 
 	return cmd
 }
@@ -79,6 +81,7 @@ func watchCommandFunc(cmd *cobra.Command, args []string) {
 	}
 
 	c := mustClientFromCmd(cmd)
+	initWatchDisplayFromCmd(cmd)
 	wc, err := getWatchChan(c, watchArgs)
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
@@ -93,6 +96,7 @@ func watchCommandFunc(cmd *cobra.Command, args []string) {
 
 func watchInteractiveFunc(cmd *cobra.Command, osArgs []string, envKey, envRange string) {
 	c := mustClientFromCmd(cmd)
+	initWatchDisplayFromCmd(cmd)
 
 	reader := bufio.NewReader(os.Stdin)
 
@@ -137,6 +141,23 @@ func watchInteractiveFunc(cmd *cobra.Command, osArgs []string, envKey, envRange
 	}
 }
 
+// initWatchDisplayFromCmd replaces the display with the printer rendering
+// the events with the --format template, if any.
+func initWatchDisplayFromCmd(cmd *cobra.Command) {
+	if watchFormat == "" {
+		return
+	}
+	if outputType, _ := cmd.Flags().GetString("write-out"); outputType != "simple" {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("--format cannot be used with --write-out"))
+	}
+	isHex, _ := cmd.Flags().GetBool("hex")
+	p, err := newWatchTemplatePrinter(watchFormat, isHex)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
+	}
+	display = p
+}
+
 func getWatchChan(c *clientv3.Client, args []string) (clientv3.WatchChan, error) {
 	if len(args) < 1 {
 		return nil, errBadArgsNum
@@ -167,7 +188,7 @@ func printWatchCh(c *clientv3.Client, ch clientv3.WatchChan, execArgs []string)
 		if resp.Canceled {
 			fmt.Fprintf(os.Stderr, "watch was canceled (%v)\n", resp.Err())
 		}
-		if resp.IsProgressNotify() {
+		if resp.IsProgressNotify() && !printsWatchProgress(display) {
 			fmt.Fprintf(os.Stdout, "progress notify: %d\n", resp.Header.Revision)
 		}
 		display.Watch(resp)
@@ -40,11 +40,19 @@ func init() {
 
 	rootCmd.AddCommand(
 		etcdutl.NewDefragCommand(),
+		etcdutl.NewCompactCommand(),
 		etcdutl.NewSnapshotCommand(),
 		etcdutl.NewHashKVCommand(),
 		etcdutl.NewVersionCommand(),
 		etcdutl.NewCompletionCommand(),
 		etcdutl.NewMigrateCommand(),
+		etcdutl.NewAuthCommand(),
+		etcdutl.NewApplyDigestCommand(),
+		etcdutl.NewCrossCheckCommand(),
+		etcdutl.NewWALCommand(),
+		etcdutl.NewBackupCommand(),
+		etcdutl.NewRemoveMemberCommand(),
+		etcdutl.NewVerifyCommand(),
 	)
 }
 
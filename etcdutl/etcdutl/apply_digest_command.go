@@ -0,0 +1,86 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/etcdserver/applydigest"
+)
+
+// NewApplyDigestCommand returns the cobra command for "apply-digest".
+func NewApplyDigestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply-digest <subcommand>",
+		Short: "Apply digest log related commands",
+	}
+	cmd.AddCommand(newApplyDigestDiffCommand())
+	return cmd
+}
+
+func newApplyDigestDiffCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff <filename> <filename>...",
+		Short: "Reports the first entry the apply digest logs of the members diverge on",
+		Long: `Compares the apply digest logs written by members started with --apply-digest-log and reports the
+first entry, over the range of indexes recorded by all the logs, that the members applied differently:
+missing if a log does not record it, request if the members applied different entries, response if
+applying it returned different results and revision if it resulted in different revisions.
+
+Exits with a non-zero status if the logs diverge.
+`,
+		Args: cobra.MinimumNArgs(2),
+		Run:  applyDigestDiffCommandFunc,
+	}
+}
+
+func applyDigestDiffCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	d, err := diffApplyDigests(args)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.ApplyDigestDiff(d)
+	if d.Divergence != nil {
+		os.Exit(cobrautl.ExitError)
+	}
+}
+
+// ApplyDigestDiff is the result of comparing the apply digest logs of Files.
+type ApplyDigestDiff struct {
+	Files []string `json:"files"`
+	applydigest.DiffResult
+}
+
+func diffApplyDigests(files []string) (ApplyDigestDiff, error) {
+	logs := make([][]applydigest.Record, len(files))
+	for i, file := range files {
+		records, err := applydigest.ReadFile(file)
+		if err != nil {
+			return ApplyDigestDiff{}, err
+		}
+		logs[i] = records
+	}
+	d := ApplyDigestDiff{Files: files, DiffResult: applydigest.Diff(logs...)}
+	if d.Compared == 0 {
+		return ApplyDigestDiff{}, errors.New("the apply digest logs have no index in common")
+	}
+	return d, nil
+}
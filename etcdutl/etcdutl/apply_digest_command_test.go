@@ -0,0 +1,74 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/applydigest"
+)
+
+func TestDiffApplyDigests(t *testing.T) {
+	dir := t.TempDir()
+	writeLog := func(name string, records ...applydigest.Record) string {
+		path := filepath.Join(dir, name)
+		l, err := applydigest.Open(path, 4)
+		require.NoError(t, err)
+		for _, r := range records {
+			require.NoError(t, l.Append(r))
+		}
+		require.NoError(t, l.Close())
+		return path
+	}
+	a := writeLog("a",
+		applydigest.Record{Index: 1, RequestHash: 1, Revision: 1},
+		applydigest.Record{Index: 2, RequestHash: 2, ResponseHash: 2, Revision: 2},
+		applydigest.Record{Index: 3, RequestHash: 3, ResponseHash: 3, Revision: 3},
+	)
+	b := writeLog("b",
+		applydigest.Record{Index: 2, RequestHash: 2, ResponseHash: 2, Revision: 2},
+		applydigest.Record{Index: 3, RequestHash: 3, ResponseHash: 4, Revision: 3},
+		applydigest.Record{Index: 4, RequestHash: 4, Revision: 3},
+	)
+	c := writeLog("c", applydigest.Record{Index: 5, RequestHash: 5, Revision: 4})
+
+	d, err := diffApplyDigests([]string{a, a})
+	require.NoError(t, err)
+	assert.Equal(t, ApplyDigestDiff{Files: []string{a, a}, DiffResult: applydigest.DiffResult{First: 1, Last: 3, Compared: 3}}, d)
+
+	d, err = diffApplyDigests([]string{a, b})
+	require.NoError(t, err)
+	assert.Equal(t, ApplyDigestDiff{
+		Files: []string{a, b},
+		DiffResult: applydigest.DiffResult{First: 2, Last: 3, Compared: 2, Divergence: &applydigest.Divergence{
+			Index: 3,
+			Records: []*applydigest.Record{
+				{Index: 3, RequestHash: 3, ResponseHash: 3, Revision: 3},
+				{Index: 3, RequestHash: 3, ResponseHash: 4, Revision: 3},
+			},
+			Reason: "response",
+		}},
+	}, d)
+
+	_, err = diffApplyDigests([]string{a, c})
+	require.ErrorContains(t, err, "no index in common")
+
+	_, err = diffApplyDigests([]string{a, filepath.Join(dir, "missing")})
+	require.Error(t, err)
+}
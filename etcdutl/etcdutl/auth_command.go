@@ -0,0 +1,305 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/pkg/v3/adt"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+const rootRole = "root"
+
+var authAnalyzeTraffic string
+
+// NewAuthCommand returns the cobra command for "auth".
+func NewAuthCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth <subcommand>",
+		Short: "Auth related commands",
+	}
+	cmd.AddCommand(newAuthAnalyzeCommand())
+	return cmd
+}
+
+func newAuthAnalyzeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze <filename>",
+		Short: "Reports the unused permissions and the denied accesses of the roles of a given db file against observed traffic",
+		Long: `Reports the permissions of the roles of a given db file that are not used by any access of the traffic
+and the accesses of the traffic that the roles of their users do not permit.
+
+The traffic is read from the file set by --traffic ("-" for stdin), as a sequence of JSON objects, e.g. one per
+line of an audit log:
+
+  {"user":"alice","op":"range","key":"/app/","range_end":"/app0"}
+  {"user":"bob","op":"put","key":"/app/config"}
+
+where op is one of range, watch, put and delete_range, and range_end is set for range accesses, with "\u0000"
+for all the keys greater than or equal to key. The permissions of the root role are not reported.
+`,
+		Args: cobra.ExactArgs(1),
+		Run:  authAnalyzeCommandFunc,
+	}
+	cmd.Flags().StringVar(&authAnalyzeTraffic, "traffic", "", `Required. The file of the accesses to analyze ("-" for stdin)`)
+	cmd.MarkFlagRequired("traffic")
+	return cmd
+}
+
+func authAnalyzeCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	traffic := io.Reader(os.Stdin)
+	if authAnalyzeTraffic != "-" {
+		f, err := os.Open(authAnalyzeTraffic)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitIO, err)
+		}
+		defer f.Close()
+		traffic = f
+	}
+
+	users, roles := readAuth(args[0])
+	analysis, err := analyzeAuth(users, roles, traffic)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitInvalidInput, err)
+	}
+	printer.AuthAnalysis(analysis)
+}
+
+// readAuth reads the users and roles of the db file.
+func readAuth(dbPath string) ([]*authpb.User, []*authpb.Role) {
+	if _, err := os.Stat(dbPath); err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitIO, err)
+	}
+	lg := GetLogger()
+	cfg := backend.DefaultBackendConfig(lg)
+	cfg.Path = dbPath
+	be := backend.New(cfg)
+	defer be.Close()
+	abe := schema.NewAuthBackend(lg, be)
+	return abe.GetAllUsers(), abe.GetAllRoles()
+}
+
+// AuthAccess is an access to the keyspace observed in the traffic analyzed by
+// "auth analyze".
+type AuthAccess struct {
+	User     string `json:"user"`
+	Op       string `json:"op"`
+	Key      string `json:"key"`
+	RangeEnd string `json:"range_end,omitempty"`
+}
+
+// AuthAnalysis is the result of "auth analyze".
+type AuthAnalysis struct {
+	Accesses          int                  `json:"accesses"`
+	UnusedPermissions []AuthRolePermission `json:"unusedPermissions"`
+	DeniedAccesses    []AuthDeniedAccess   `json:"deniedAccesses"`
+}
+
+// AuthRolePermission is a permission of a role.
+type AuthRolePermission struct {
+	Role     string `json:"role"`
+	PermType string `json:"permType"`
+	Key      string `json:"key"`
+	RangeEnd string `json:"rangeEnd,omitempty"`
+}
+
+// AuthDeniedAccess aggregates the identical accesses that the roles of their
+// user do not permit.
+type AuthDeniedAccess struct {
+	AuthAccess
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
+
+// authPermission is a permission of a role, with the interval of keys it
+// applies to.
+type authPermission struct {
+	role string
+	perm *authpb.Permission
+	ivl  adt.Interval
+	used bool
+}
+
+func (p *authPermission) allows(permType authpb.Permission_Type) bool {
+	return p.perm.PermType == authpb.READWRITE || p.perm.PermType == permType
+}
+
+// authUser is a user along with the permissions of its roles, merged in
+// interval trees as the auth store does.
+type authUser struct {
+	root       bool
+	perms      []*authPermission
+	readPerms  adt.IntervalTree
+	writePerms adt.IntervalTree
+}
+
+// analyzeAuth checks each access of the traffic against the permissions of
+// the roles of its user, the way the auth store of etcd does, and reports the
+// permissions no permitted access falls into and the accesses that are not
+// permitted.
+func analyzeAuth(users []*authpb.User, roles []*authpb.Role, traffic io.Reader) (AuthAnalysis, error) {
+	rolePerms := make(map[string][]*authPermission)
+	var perms []*authPermission
+	for _, role := range roles {
+		name := string(role.Name)
+		for _, perm := range role.KeyPermission {
+			p := &authPermission{role: name, perm: perm, ivl: permissionInterval(perm)}
+			rolePerms[name] = append(rolePerms[name], p)
+			if name != rootRole {
+				perms = append(perms, p)
+			}
+		}
+	}
+	authUsers := make(map[string]*authUser)
+	for _, user := range users {
+		u := &authUser{readPerms: adt.NewIntervalTree(), writePerms: adt.NewIntervalTree()}
+		for _, role := range user.Roles {
+			u.root = u.root || role == rootRole
+			for _, p := range rolePerms[role] {
+				u.perms = append(u.perms, p)
+				if p.allows(authpb.READ) {
+					u.readPerms.Insert(p.ivl, struct{}{})
+				}
+				if p.allows(authpb.WRITE) {
+					u.writePerms.Insert(p.ivl, struct{}{})
+				}
+			}
+		}
+		authUsers[string(user.Name)] = u
+	}
+
+	var analysis AuthAnalysis
+	denied := make(map[AuthDeniedAccess]int)
+	dec := json.NewDecoder(traffic)
+	for {
+		var a AuthAccess
+		if err := dec.Decode(&a); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return AuthAnalysis{}, fmt.Errorf("failed to decode access %d: %w", analysis.Accesses+1, err)
+		}
+		analysis.Accesses++
+		permType, err := accessPermType(a.Op)
+		if err != nil {
+			return AuthAnalysis{}, fmt.Errorf("access %d: %w", analysis.Accesses, err)
+		}
+		if a.Key == "" {
+			return AuthAnalysis{}, fmt.Errorf("access %d: key is empty", analysis.Accesses)
+		}
+
+		u, ok := authUsers[a.User]
+		switch {
+		case !ok:
+			denied[AuthDeniedAccess{AuthAccess: a, Reason: "user not found"}]++
+		case u.root:
+		case !u.permits(a, permType):
+			denied[AuthDeniedAccess{AuthAccess: a, Reason: "permission denied"}]++
+		default:
+			ivl := accessInterval(a)
+			for _, p := range u.perms {
+				if p.allows(permType) && p.ivl.Compare(&ivl) == 0 {
+					p.used = true
+				}
+			}
+		}
+	}
+
+	analysis.UnusedPermissions = []AuthRolePermission{}
+	for _, p := range perms {
+		if !p.used {
+			analysis.UnusedPermissions = append(analysis.UnusedPermissions, AuthRolePermission{
+				Role:     p.role,
+				PermType: p.perm.PermType.String(),
+				Key:      string(p.perm.Key),
+				RangeEnd: string(p.perm.RangeEnd),
+			})
+		}
+	}
+	analysis.DeniedAccesses = []AuthDeniedAccess{}
+	for a, count := range denied {
+		a.Count = count
+		analysis.DeniedAccesses = append(analysis.DeniedAccesses, a)
+	}
+	slices.SortFunc(analysis.DeniedAccesses, func(a, b AuthDeniedAccess) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		return strings.Compare(a.User+"\x00"+a.Key+"\x00"+a.Op, b.User+"\x00"+b.Key+"\x00"+b.Op)
+	})
+	return analysis, nil
+}
+
+func accessPermType(op string) (authpb.Permission_Type, error) {
+	switch op {
+	case "range", "watch":
+		return authpb.READ, nil
+	case "put", "delete_range":
+		return authpb.WRITE, nil
+	}
+	return 0, fmt.Errorf("unknown operation %q, must be range, watch, put or delete_range", op)
+}
+
+// permits mirrors the range permission check of the auth store.
+func (u *authUser) permits(a AuthAccess, permType authpb.Permission_Type) bool {
+	perms := u.readPerms
+	if permType == authpb.WRITE {
+		perms = u.writePerms
+	}
+	if a.RangeEnd == "" {
+		return perms.Intersects(adt.NewBytesAffinePoint([]byte(a.Key)))
+	}
+	return perms.Contains(accessInterval(a))
+}
+
+// accessInterval returns the interval of the keys of the access, where a
+// range end of "\x00" stands for all the keys greater than or equal to the key.
+func accessInterval(a AuthAccess) adt.Interval {
+	if a.RangeEnd == "" {
+		return adt.NewBytesAffinePoint([]byte(a.Key))
+	}
+	var rangeEnd []byte
+	if a.RangeEnd != "\x00" {
+		rangeEnd = []byte(a.RangeEnd)
+	}
+	return adt.NewBytesAffineInterval([]byte(a.Key), rangeEnd)
+}
+
+// permissionInterval returns the interval of the keys of the permission, the
+// way the auth store inserts it in its interval trees.
+func permissionInterval(perm *authpb.Permission) adt.Interval {
+	if len(perm.RangeEnd) == 0 {
+		return adt.NewBytesAffinePoint(perm.Key)
+	}
+	var rangeEnd []byte
+	if len(perm.RangeEnd) != 1 || perm.RangeEnd[0] != 0 {
+		rangeEnd = perm.RangeEnd
+	}
+	return adt.NewBytesAffineInterval(perm.Key, rangeEnd)
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+)
+
+func TestAnalyzeAuth(t *testing.T) {
+	users := []*authpb.User{
+		{Name: []byte("root"), Roles: []string{"root"}},
+		{Name: []byte("alice"), Roles: []string{"app", "metrics"}},
+		{Name: []byte("bob"), Roles: []string{"reader"}},
+	}
+	roles := []*authpb.Role{
+		{Name: []byte("root")},
+		{Name: []byte("app"), KeyPermission: []*authpb.Permission{
+			{PermType: authpb.READWRITE, Key: []byte("/app/"), RangeEnd: []byte("/app0")},
+			{PermType: authpb.WRITE, Key: []byte("/locks/"), RangeEnd: []byte("/locks0")},
+		}},
+		{Name: []byte("metrics"), KeyPermission: []*authpb.Permission{
+			{PermType: authpb.READ, Key: []byte("/metrics")},
+		}},
+		{Name: []byte("reader"), KeyPermission: []*authpb.Permission{
+			{PermType: authpb.READ, Key: []byte("/"), RangeEnd: []byte{0}},
+		}},
+	}
+	traffic := `
+{"user":"root","op":"put","key":"/secret"}
+{"user":"alice","op":"put","key":"/app/config"}
+{"user":"alice","op":"range","key":"/locks/","range_end":"/locks0"}
+{"user":"alice","op":"range","key":"/locks/","range_end":"/locks0"}
+{"user":"bob","op":"watch","key":"/app/","range_end":"/app0"}
+{"user":"bob","op":"put","key":"/app/config"}
+{"user":"carol","op":"range","key":"/app/config"}
+`
+	analysis, err := analyzeAuth(users, roles, strings.NewReader(traffic))
+	require.NoError(t, err)
+	assert.Equal(t, 7, analysis.Accesses)
+	assert.Equal(t, []AuthRolePermission{
+		{Role: "app", PermType: "WRITE", Key: "/locks/", RangeEnd: "/locks0"},
+		{Role: "metrics", PermType: "READ", Key: "/metrics"},
+	}, analysis.UnusedPermissions)
+	assert.Equal(t, []AuthDeniedAccess{
+		{AuthAccess: AuthAccess{User: "alice", Op: "range", Key: "/locks/", RangeEnd: "/locks0"}, Reason: "permission denied", Count: 2},
+		{AuthAccess: AuthAccess{User: "bob", Op: "put", Key: "/app/config"}, Reason: "permission denied", Count: 1},
+		{AuthAccess: AuthAccess{User: "carol", Op: "range", Key: "/app/config"}, Reason: "user not found", Count: 1},
+	}, analysis.DeniedAccesses)
+}
+
+func TestAnalyzeAuthRangeSpanningPermissions(t *testing.T) {
+	users := []*authpb.User{{Name: []byte("alice"), Roles: []string{"a", "b"}}}
+	roles := []*authpb.Role{
+		{Name: []byte("a"), KeyPermission: []*authpb.Permission{
+			{PermType: authpb.READ, Key: []byte("a"), RangeEnd: []byte("c")},
+		}},
+		{Name: []byte("b"), KeyPermission: []*authpb.Permission{
+			{PermType: authpb.READ, Key: []byte("c"), RangeEnd: []byte("e")},
+			{PermType: authpb.READ, Key: []byte("x")},
+		}},
+	}
+	analysis, err := analyzeAuth(users, roles, strings.NewReader(`{"user":"alice","op":"range","key":"b","range_end":"d"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []AuthRolePermission{{Role: "b", PermType: "READ", Key: "x"}}, analysis.UnusedPermissions)
+	assert.Empty(t, analysis.DeniedAccesses)
+}
+
+func TestAnalyzeAuthInvalidTraffic(t *testing.T) {
+	tests := []struct {
+		name    string
+		traffic string
+		wantErr string
+	}{
+		{"unknown operation", `{"user":"alice","op":"txn","key":"a"}`, `access 1: unknown operation "txn"`},
+		{"empty key", `{"user":"alice","op":"range"} {"user":"alice","op":"range","key":""}`, "access 1: key is empty"},
+		{"malformed", `{"user":"alice","op":"range","key":"a"} {"user"`, "failed to decode access 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := analyzeAuth(nil, nil, strings.NewReader(tt.traffic))
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+)
+
+// BackupManifestName is the name of the manifest of a backup, in its directory.
+const BackupManifestName = "backup.json"
+
+var (
+	backupDataDir     string
+	backupDir         string
+	backupIncremental string
+)
+
+// NewBackupCommand returns the cobra command for "backup".
+func NewBackupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Backs up the files of a data directory, incrementally from a previous backup",
+		Long: `Backs up the files of a data directory not in use by etcd to a new backup directory, along
+with a manifest listing them with their sizes and SHA-256 hashes.
+
+With --incremental, only the files that are new or changed since the previous backup, like the
+new WAL segments, the last one and the db file, are copied. The manifest of the backup still
+lists all the files of the data directory, referring to the backups of the chain holding the
+unchanged ones by their paths relative to it, so that the backups of a chain must be kept, and
+moved, together.
+
+The manifest of a backup can be restored with 'etcdutl snapshot restore', which restores the db
+file of the chain after verifying its hash.
+`,
+		Args: cobra.NoArgs,
+		Run:  backupCommandFunc,
+	}
+	cmd.Flags().StringVar(&backupDataDir, "data-dir", "", "Required. Backs up a data directory not in use by etcd.")
+	cmd.Flags().StringVar(&backupDir, "backup-dir", "", "Required. The backup directory, which must not exist.")
+	cmd.Flags().StringVar(&backupIncremental, "incremental", "", "The manifest, or the directory, of the previous backup to back up incrementally from.")
+	cmd.MarkFlagRequired("data-dir")
+	cmd.MarkFlagRequired("backup-dir")
+	cmd.MarkFlagDirname("data-dir")
+	cmd.MarkFlagDirname("backup-dir")
+	return cmd
+}
+
+func backupCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	b, err := BackupData(backupDataDir, backupDir, backupIncremental)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError,
+			fmt.Errorf("Failed to back up etcd data[%s] (%w)", backupDataDir, err))
+	}
+	printer.Backup(b)
+}
+
+// BackupManifest lists the files of a backup of a data directory.
+type BackupManifest struct {
+	Created time.Time `json:"created"`
+	// Previous is the path of the manifest of the previous backup, relative
+	// to the directory of this one, or empty for a full backup.
+	Previous string       `json:"previous,omitempty"`
+	Files    []BackupFile `json:"files"`
+}
+
+// BackupFile is a file of a backup of a data directory.
+type BackupFile struct {
+	// Path is the slash-separated path of the file, relative to the data
+	// directory.
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+	// Backup is the path of the directory of the backup holding the file,
+	// relative to the directory of the manifest: "." if the file is copied
+	// by this backup.
+	Backup string `json:"backup"`
+}
+
+// Backup is the result of a backup of a data directory.
+type Backup struct {
+	// Manifest is the path of the manifest of the backup.
+	Manifest string `json:"manifest"`
+	// Files is the number of files of the data directory, CopiedFiles and
+	// CopiedBytes the ones copied by the backup, and ReusedBytes the size of
+	// the files held by the previous backups.
+	Files       int   `json:"files"`
+	CopiedFiles int   `json:"copiedFiles"`
+	CopiedBytes int64 `json:"copiedBytes"`
+	ReusedBytes int64 `json:"reusedBytes"`
+}
+
+// BackupData backs up the files of the data directory to the backup directory,
+// which must not exist. If previous is set, to the manifest or the directory
+// of a previous backup, only the files not held with the same hash by the
+// backups of its chain are copied.
+func BackupData(dataDir, dir, previous string) (Backup, error) {
+	var b Backup
+	if fileutil.Exist(dir) {
+		return b, fmt.Errorf("backup directory %q exists", dir)
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return b, err
+	}
+
+	m := BackupManifest{Created: time.Now().UTC()}
+	// the files held by the previous backups, by path
+	reusable := map[string]BackupFile{}
+	if previous != "" {
+		prevPath, prev, err := LoadBackupManifest(previous)
+		if err != nil {
+			return b, err
+		}
+		if m.Previous, err = filepath.Rel(dir, prevPath); err != nil {
+			return b, err
+		}
+		for _, f := range prev.Files {
+			if f.Backup, err = filepath.Rel(dir, filepath.Join(filepath.Dir(prevPath), f.Backup)); err != nil {
+				return b, err
+			}
+			reusable[f.Path] = f
+		}
+	}
+
+	// hold a lock on the db file, so that etcd does not start during the
+	// backup, after waiting for it to stop
+	dbPath := datadir.ToBackendFileName(dataDir)
+	opts := &bolt.Options{ReadOnly: true, Timeout: time.Second}
+	db, err := bolt.Open(dbPath, 0o600, opts)
+	if errors.Is(err, bolterrors.ErrTimeout) {
+		fmt.Fprintf(os.Stderr, "waiting for etcd to close and release its lock on %q\n", dbPath)
+		opts.Timeout = 0
+		db, err = bolt.Open(dbPath, 0o600, opts)
+	}
+	if err != nil {
+		return b, err
+	}
+	defer db.Close()
+
+	if err = os.MkdirAll(dir, fileutil.PrivateDirMode); err != nil {
+		return b, err
+	}
+	err = filepath.WalkDir(dataDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// the temporary files of the backend and of the WAL are left by
+		// failures, and removed by etcd when it starts
+		if strings.HasSuffix(d.Name(), ".tmp") || strings.Contains(d.Name(), ".tmp.") {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dataDir, path)
+		if err != nil {
+			return err
+		}
+		f, err := backupFile(path, filepath.Join(dir, rel), filepath.ToSlash(rel), reusable)
+		if err != nil {
+			return err
+		}
+		m.Files = append(m.Files, f)
+		b.Files++
+		if f.Backup == "." {
+			b.CopiedFiles++
+			b.CopiedBytes += f.Size
+		} else {
+			b.ReusedBytes += f.Size
+		}
+		return nil
+	})
+	if err != nil {
+		return b, err
+	}
+
+	// the manifest is written last, so that a failed backup has none
+	b.Manifest = filepath.Join(dir, BackupManifestName)
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return b, err
+	}
+	if err = writeFileSync(b.Manifest+".tmp", data); err != nil {
+		return b, err
+	}
+	return b, os.Rename(b.Manifest+".tmp", b.Manifest)
+}
+
+// backupFile copies the file at path to dst, unless the previous backups hold
+// it with the same size and hash.
+func backupFile(path, dst, rel string, reusable map[string]BackupFile) (BackupFile, error) {
+	f := BackupFile{Path: rel, Backup: "."}
+	st, err := os.Stat(path)
+	if err != nil {
+		return f, err
+	}
+	f.Size = st.Size()
+	if prev, ok := reusable[rel]; ok && prev.Size == f.Size {
+		if f.SHA256, err = sha256File(path); err != nil {
+			return f, err
+		}
+		if f.SHA256 == prev.SHA256 {
+			return prev, nil
+		}
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return f, err
+	}
+	defer src.Close()
+	if err = os.MkdirAll(filepath.Dir(dst), fileutil.PrivateDirMode); err != nil {
+		return f, err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fileutil.PrivateFileMode)
+	if err != nil {
+		return f, err
+	}
+	defer out.Close()
+	h := sha256.New()
+	if f.Size, err = io.Copy(io.MultiWriter(out, h), src); err != nil {
+		return f, err
+	}
+	f.SHA256 = hex.EncodeToString(h.Sum(nil))
+	return f, fileutil.Fsync(out)
+}
+
+// LoadBackupManifest loads the manifest at path, or in the directory at path,
+// and returns its absolute path.
+func LoadBackupManifest(path string) (string, BackupManifest, error) {
+	var m BackupManifest
+	if st, err := os.Stat(path); err == nil && st.IsDir() {
+		path = filepath.Join(path, BackupManifestName)
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return path, m, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return path, m, err
+	}
+	if err = json.Unmarshal(data, &m); err != nil {
+		return path, m, fmt.Errorf("invalid backup manifest %q: %w", path, err)
+	}
+	return path, m, nil
+}
+
+// IsBackupManifest returns whether the file at path is the manifest of a
+// backup, rather than a snapshot.
+func IsBackupManifest(path string) bool {
+	return filepath.Base(path) == BackupManifestName
+}
+
+// BackupDBPath returns the path of the db file of the backup of the manifest
+// at path, in the backup of its chain holding it, after verifying its hash.
+func BackupDBPath(path string) (string, error) {
+	path, m, err := LoadBackupManifest(path)
+	if err != nil {
+		return "", err
+	}
+	rel := filepath.ToSlash(datadir.ToBackendFileName(""))
+	for _, f := range m.Files {
+		if f.Path != rel {
+			continue
+		}
+		dbPath := filepath.Join(filepath.Dir(path), f.Backup, filepath.FromSlash(f.Path))
+		sum, err := sha256File(dbPath)
+		if err != nil {
+			return "", err
+		}
+		if sum != f.SHA256 {
+			return "", fmt.Errorf("backup db file %q has sha256 %s, expected %s", dbPath, sum, f.SHA256)
+		}
+		return dbPath, nil
+	}
+	return "", fmt.Errorf("backup manifest %q has no db file", path)
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = f.Write(data); err != nil {
+		return err
+	}
+	return fileutil.Fsync(f)
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+)
+
+// writeDataDir runs an embedded etcd server on the data dir, putting the keys.
+// A raft snapshot file is written at every other entry.
+func writeDataDir(t *testing.T, dataDir string, keys ...string) {
+	t.Helper()
+	cfg := embed.NewConfig()
+	cfg.LogLevel = "fatal"
+	cfg.Dir = dataDir
+	cfg.SnapshotCount = 2
+	etcd, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer etcd.Close()
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+	for _, k := range keys {
+		_, err := etcd.Server.Put(t.Context(), &pb.PutRequest{Key: []byte(k), Value: []byte(k)})
+		require.NoError(t, err)
+	}
+}
+
+func TestBackupData(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "data")
+	writeDataDir(t, dataDir, "a", "b")
+	backups := t.TempDir()
+
+	full, err := BackupData(dataDir, filepath.Join(backups, "full"), "")
+	require.NoError(t, err)
+	assert.Positive(t, full.Files)
+	assert.Equal(t, full.Files, full.CopiedFiles)
+	assert.Zero(t, full.ReusedBytes)
+
+	_, err = BackupData(dataDir, filepath.Join(backups, "full"), "")
+	require.ErrorContains(t, err, "exists")
+
+	// nothing changed since the full backup
+	same, err := BackupData(dataDir, filepath.Join(backups, "same"), filepath.Join(backups, "full"))
+	require.NoError(t, err)
+	assert.Equal(t, full.Files, same.Files)
+	assert.Zero(t, same.CopiedFiles)
+	assert.Equal(t, full.CopiedBytes, same.ReusedBytes)
+
+	writeDataDir(t, dataDir, "c")
+	incr, err := BackupData(dataDir, filepath.Join(backups, "incr"), filepath.Join(backups, "same"))
+	require.NoError(t, err)
+	assert.Positive(t, incr.CopiedFiles)
+	assert.Less(t, incr.CopiedFiles, incr.Files)
+
+	_, m, err := LoadBackupManifest(incr.Manifest)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("..", "same", BackupManifestName), m.Previous)
+	backupOf := map[string]string{}
+	for _, f := range m.Files {
+		backupOf[f.Path] = f.Backup
+		// the files reused are the ones copied by the full backup
+		assert.Contains(t, []string{".", filepath.Join("..", "full")}, f.Backup)
+	}
+	assert.Equal(t, ".", backupOf["member/snap/db"])
+
+	// the db file of the chain is restored
+	dbPath, err := BackupDBPath(incr.Manifest)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(backups, "incr", "member", "snap", "db"), dbPath)
+	restored := filepath.Join(t.TempDir(), "restored")
+	require.NoError(t, snapshot.NewV3(zap.NewNop()).Restore(snapshot.RestoreConfig{
+		SnapshotPath:        dbPath,
+		Name:                "default",
+		OutputDataDir:       restored,
+		OutputWALDir:        datadir.ToWALDir(restored),
+		PeerURLs:            []string{defaultInitialAdvertisePeerURLs},
+		InitialCluster:      initialClusterFromName("default"),
+		InitialClusterToken: "etcd-cluster",
+		SkipHashCheck:       true,
+	}))
+	want, err := snapshot.NewV3(zap.NewNop()).Status(datadir.ToBackendFileName(dataDir))
+	require.NoError(t, err)
+	got, err := snapshot.NewV3(zap.NewNop()).Status(datadir.ToBackendFileName(restored))
+	require.NoError(t, err)
+	assert.Equal(t, want.Revision, got.Revision)
+
+	// a corrupt db file is detected
+	require.NoError(t, os.WriteFile(dbPath, []byte("corrupt"), 0o600))
+	_, err = BackupDBPath(incr.Manifest)
+	require.ErrorContains(t, err, "expected")
+}
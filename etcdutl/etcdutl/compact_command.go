@@ -0,0 +1,153 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+var (
+	compactDataDir  string
+	compactRevision int64
+	compactDefrag   bool
+)
+
+// NewCompactCommand returns the cobra command for "compact".
+func NewCompactCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Compacts the key-value history of the storage of the etcd",
+		Long: `Compacts the key-value history of a data directory not in use by etcd, removing the
+revisions of the keys superseded before the given revision, as 'etcdctl compaction' does
+online.
+
+The compaction is not replicated: compact the data directories of all the members at the
+same revision, so that their key-value hashes still match. The revision cannot be past a
+compaction barrier held by an existing lease.
+`,
+		Args: cobra.NoArgs,
+		Run:  compactCommandFunc,
+	}
+	cmd.Flags().StringVar(&compactDataDir, "data-dir", "", "Required. Compacts a data directory not in use by etcd.")
+	cmd.Flags().Int64Var(&compactRevision, "rev", 0, "Required. The revision to compact the key-value history to.")
+	cmd.Flags().BoolVar(&compactDefrag, "defrag", false, "Defragments the storage after the compaction, to release the space freed.")
+	cmd.MarkFlagRequired("data-dir")
+	cmd.MarkFlagRequired("rev")
+	cmd.MarkFlagDirname("data-dir")
+	return cmd
+}
+
+func compactCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	c, err := CompactData(compactDataDir, compactRevision, compactDefrag)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError,
+			fmt.Errorf("Failed to compact etcd data[%s] (%w)", compactDataDir, err))
+	}
+	printer.DBCompaction(c)
+}
+
+// Compaction is the result of the compaction of a data directory.
+type Compaction struct {
+	// Revision is the revision the history is compacted to, and
+	// PreviousRevision the one it was compacted to before.
+	Revision         int64 `json:"revision"`
+	PreviousRevision int64 `json:"previousRevision"`
+	// KeyRevisionsBefore and KeyRevisionsAfter are the numbers of revisions
+	// of keys stored before and after the compaction.
+	KeyRevisionsBefore int `json:"keyRevisionsBefore"`
+	KeyRevisionsAfter  int `json:"keyRevisionsAfter"`
+	// Size and SizeInUse are the sizes of the db file after the compaction,
+	// and its defragmentation if Defragmented.
+	Size         int64 `json:"size"`
+	SizeInUse    int64 `json:"sizeInUse"`
+	Defragmented bool  `json:"defragmented"`
+}
+
+// CompactData compacts the key-value history of the data directory to the
+// revision, and defragments it if defrag is set.
+func CompactData(dataDir string, rev int64, defrag bool) (Compaction, error) {
+	lg := GetLogger()
+	be := openBackend(lg, datadir.ToBackendFileName(dataDir), "To compact a running etcd instance, use `etcdctl compaction` instead.")
+	defer be.Close()
+
+	c := Compaction{Revision: rev}
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	c.PreviousRevision, _ = mvcc.UnsafeReadFinishedCompact(tx)
+	c.KeyRevisionsBefore = unsafeCountKeyRevisions(tx)
+	barrier, leaseID, ok := unsafeCompactionBarrier(tx)
+	tx.Unlock()
+	if ok && rev > barrier {
+		return c, fmt.Errorf("revision %d is past the compaction barrier at revision %d of lease %016x", rev, barrier, leaseID)
+	}
+
+	st := mvcc.NewStore(lg, be, nil, mvcc.StoreConfig{})
+	done, err := st.Compact(traceutil.TODO(), rev)
+	if err != nil {
+		st.Close()
+		return c, err
+	}
+	<-done
+	st.Close()
+	be.ForceCommit()
+
+	tx.LockOutsideApply()
+	c.KeyRevisionsAfter = unsafeCountKeyRevisions(tx)
+	tx.Unlock()
+
+	if defrag {
+		if err := be.Defrag(); err != nil {
+			return c, err
+		}
+		c.Defragmented = true
+	}
+	c.Size, c.SizeInUse = be.Size(), be.SizeInUse()
+	return c, nil
+}
+
+func unsafeCountKeyRevisions(tx backend.UnsafeReader) int {
+	n := 0
+	tx.UnsafeForEach(schema.Key, func(k, v []byte) error {
+		n++
+		return nil
+	})
+	return n
+}
+
+// unsafeCompactionBarrier returns the lowest revision held back by the
+// compaction barrier of an existing lease, if any. The barriers of the leases
+// that no longer exist are ignored, as the server releases them.
+func unsafeCompactionBarrier(tx backend.UnsafeReader) (rev int64, leaseID int64, ok bool) {
+	for id, r := range schema.MustUnsafeGetAllCompactionBarriers(tx) {
+		if schema.MustUnsafeGetLease(tx, id) == nil {
+			continue
+		}
+		if !ok || r < rev {
+			rev, leaseID, ok = r, id, true
+		}
+	}
+	return rev, leaseID, ok
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// createCompactDataDir runs an embedded etcd server writing 3 revisions of the
+// keys a and b, and returns its data dir and the ID of a lease granted.
+func createCompactDataDir(t *testing.T) (string, int64) {
+	t.Helper()
+	cfg := embed.NewConfig()
+	cfg.LogLevel = "fatal"
+	cfg.Dir = t.TempDir()
+	etcd, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer etcd.Close()
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+
+	for _, v := range []string{"1", "2", "3"} {
+		for _, k := range []string{"a", "b"} {
+			_, err := etcd.Server.Put(t.Context(), &pb.PutRequest{Key: []byte(k), Value: []byte(v)})
+			require.NoError(t, err)
+		}
+	}
+	l, err := etcd.Server.LeaseGrant(t.Context(), &pb.LeaseGrantRequest{TTL: 600})
+	require.NoError(t, err)
+	return cfg.Dir, l.ID
+}
+
+func TestCompactData(t *testing.T) {
+	dataDir, _ := createCompactDataDir(t)
+
+	c, err := CompactData(dataDir, 5, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), c.PreviousRevision)
+	assert.Equal(t, 6, c.KeyRevisionsBefore)
+	// a and b are written alternately from revision 2: the revisions 4 and 5
+	// are the last ones of a and b at revision 5, and are kept
+	assert.Equal(t, 4, c.KeyRevisionsAfter)
+	assert.False(t, c.Defragmented)
+
+	c, err = CompactData(dataDir, 6, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), c.PreviousRevision)
+	assert.Equal(t, 3, c.KeyRevisionsAfter)
+	assert.True(t, c.Defragmented)
+
+	cfg := backend.DefaultBackendConfig(zap.NewNop())
+	cfg.Path = datadir.ToBackendFileName(dataDir)
+	be := backend.New(cfg)
+	defer be.Close()
+	tx := be.ReadTx()
+	tx.RLock()
+	rev, _ := mvcc.UnsafeReadFinishedCompact(tx)
+	tx.RUnlock()
+	assert.Equal(t, int64(6), rev)
+}
+
+func TestCompactDataErrors(t *testing.T) {
+	dataDir, _ := createCompactDataDir(t)
+
+	_, err := CompactData(dataDir, 100, false)
+	require.ErrorIs(t, err, mvcc.ErrFutureRev)
+
+	_, err = CompactData(dataDir, 4, false)
+	require.NoError(t, err)
+	_, err = CompactData(dataDir, 3, false)
+	require.ErrorIs(t, err, mvcc.ErrCompacted)
+}
+
+func TestCompactDataBarrier(t *testing.T) {
+	dataDir, leaseID := createCompactDataDir(t)
+
+	cfg := backend.DefaultBackendConfig(zap.NewNop())
+	cfg.Path = datadir.ToBackendFileName(dataDir)
+	be := backend.New(cfg)
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	schema.UnsafeCreateCompactionBarrierBucket(tx)
+	schema.UnsafePutCompactionBarrier(tx, leaseID, 4)
+	// the barrier of a lease that no longer exists is ignored
+	schema.UnsafePutCompactionBarrier(tx, leaseID+1, 2)
+	tx.Unlock()
+	require.NoError(t, be.Close())
+
+	_, err := CompactData(dataDir, 5, false)
+	require.ErrorContains(t, err, "past the compaction barrier at revision 4")
+	_, err = CompactData(dataDir, 4, false)
+	require.NoError(t, err)
+}
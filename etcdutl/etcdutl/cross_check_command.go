@@ -0,0 +1,498 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	bolt "go.etcd.io/bbolt"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
+	"go.etcd.io/etcd/server/v3/etcdserver/apply"
+	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+var (
+	crossCheckDataDir string
+	crossCheckWALDir  string
+	crossCheckBaseDB  string
+)
+
+// NewCrossCheckCommand returns the cobra command for "cross-check".
+func NewCrossCheckCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-check",
+		Short: "Verifies the backend of a data dir against the replay of its WAL",
+		Long: `Replays the entries of the WAL of the data dir up to the consistent index of its backend, through the
+same appliers as etcd, and compares the key revisions written by the replay with the ones of the backend
+after its compaction revision. Reports the hashes of the compared revisions and the first divergent
+revision, with the index of the WAL entry that wrote it.
+
+The replay starts from an empty backend, which requires the WAL to hold all the entries from index 1, or
+from a copy of the backend given with --base-db, for instance a backup taken while the WAL still held
+the entries following its consistent index. The data dir is not modified; the member must be stopped.
+
+Exits with a non-zero status if the backend diverges from the replay.
+`,
+		Args: cobra.NoArgs,
+		Run:  crossCheckCommandFunc,
+	}
+	cmd.Flags().StringVar(&crossCheckDataDir, "data-dir", "", "Path to the etcd data dir")
+	cmd.MarkFlagRequired("data-dir")
+	cmd.MarkFlagDirname("data-dir")
+	cmd.Flags().StringVar(&crossCheckWALDir, "wal-dir", "", "Path to the WAL directory, if not the default one of the data dir")
+	cmd.MarkFlagDirname("wal-dir")
+	cmd.Flags().StringVar(&crossCheckBaseDB, "base-db", "", "Path to the backend the replay starts from, copied before the replay (default: an empty backend)")
+	cmd.MarkFlagFilename("base-db")
+	return cmd
+}
+
+func crossCheckCommandFunc(cmd *cobra.Command, _ []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	walDir := crossCheckWALDir
+	if walDir == "" {
+		walDir = datadir.ToWALDir(crossCheckDataDir)
+	}
+	c, err := crossCheck(zap.NewNop(), datadir.ToBackendFileName(crossCheckDataDir), walDir, crossCheckBaseDB)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.CrossCheck(c)
+	if c.Divergence != nil {
+		os.Exit(cobrautl.ExitError)
+	}
+}
+
+// CrossCheck is the result of comparing a backend with the replay of its WAL.
+type CrossCheck struct {
+	// ConsistentIndex is the consistent index of the backend, the last index
+	// replayed.
+	ConsistentIndex uint64 `json:"consistentIndex"`
+	// BaseIndex is the consistent index of the backend the replay starts from.
+	BaseIndex uint64 `json:"baseIndex"`
+	// Replayed is the number of entries applied by the replay, the others not
+	// changing the keys.
+	Replayed int `json:"replayed"`
+	// Revision and ReplayRevision are the current revisions of the backend
+	// and of the replay.
+	Revision       int64 `json:"revision"`
+	ReplayRevision int64 `json:"replayRevision"`
+	// CompactRevision is the revision the revisions compared follow, the
+	// greatest compaction revision of the backend and of the replay.
+	CompactRevision int64 `json:"compactRevision"`
+	// Compared is the number of key revisions compared.
+	Compared int `json:"compared"`
+	// Hash and ReplayHash are the hashes of the key revisions compared, up to
+	// the divergence if any.
+	Hash       uint32                `json:"hash"`
+	ReplayHash uint32                `json:"replayHash"`
+	Divergence *CrossCheckDivergence `json:"divergence,omitempty"`
+}
+
+// CrossCheckDivergence is the first key revision the backend and the replay
+// disagree on.
+type CrossCheckDivergence struct {
+	Revision    int64 `json:"revision"`
+	SubRevision int64 `json:"subRevision"`
+	// Index is the index of the WAL entry that wrote the revision in the
+	// replay, or 0 if the replay did not write it.
+	Index  uint64 `json:"index,omitempty"`
+	Key    string `json:"key"`
+	Reason string `json:"reason"`
+	// Value and ReplayValue are the key-values of the revision in the backend
+	// and in the replay, nil if missing.
+	Value       *mvccpb.KeyValue `json:"value,omitempty"`
+	ReplayValue *mvccpb.KeyValue `json:"replayValue,omitempty"`
+}
+
+// The reasons of the divergences.
+const (
+	divergenceMissing    = "missing from the backend"
+	divergenceUnexpected = "missing from the replay"
+	divergenceTombstone  = "tombstone"
+	divergenceValue      = "key-value"
+)
+
+func crossCheck(lg *zap.Logger, dbPath, walDir, baseDBPath string) (CrossCheck, error) {
+	var c CrossCheck
+	if _, err := os.Stat(dbPath); err != nil {
+		return c, err
+	}
+	target, err := readBackendMeta(dbPath)
+	if err != nil {
+		return c, err
+	}
+	c.ConsistentIndex = target.consistentIndex
+
+	dir, err := os.MkdirTemp("", "etcdutl-cross-check-")
+	if err != nil {
+		return c, err
+	}
+	defer os.RemoveAll(dir)
+	replayPath := filepath.Join(dir, "db")
+	if baseDBPath != "" {
+		if err = copyFile(baseDBPath, replayPath); err != nil {
+			return c, fmt.Errorf("failed to copy the base backend: %w", err)
+		}
+	}
+
+	revs, err := replayWAL(lg, replayPath, walDir, &c)
+	if err != nil {
+		return c, err
+	}
+	replay, err := readBackendMeta(replayPath)
+	if err != nil {
+		return c, err
+	}
+	c.CompactRevision = max(target.compactRevision, replay.compactRevision)
+	if err = compareKeyRevisions(dbPath, replayPath, &c); err != nil {
+		return c, err
+	}
+	if c.Divergence != nil && c.Divergence.ReplayValue != nil {
+		c.Divergence.Index = revs.index(c.Divergence.Revision)
+	}
+	return c, nil
+}
+
+type backendMeta struct {
+	consistentIndex uint64
+	// compactRevision is the greatest compaction revision scheduled, the
+	// revisions up to it being possibly removed.
+	compactRevision int64
+}
+
+func readBackendMeta(dbPath string) (backendMeta, error) {
+	var m backendMeta
+	db, err := bolt.Open(dbPath, 0o400, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return m, err
+	}
+	defer db.Close()
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schema.Meta.Name())
+		if b == nil {
+			return nil
+		}
+		if v := b.Get(schema.MetaConsistentIndexKeyName); len(v) == 8 {
+			m.consistentIndex = binary.BigEndian.Uint64(v)
+		}
+		for _, k := range [][]byte{schema.ScheduledCompactKeyName, schema.FinishedCompactKeyName} {
+			if v := b.Get(k); v != nil {
+				m.compactRevision = max(m.compactRevision, mvcc.BytesToRev(v).Main)
+			}
+		}
+		return nil
+	})
+	return m, err
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// replayRevisions records the revision reached after applying each entry that
+// changed it, in index order.
+type replayRevisions []replayRevision
+
+type replayRevision struct {
+	index    uint64
+	revision int64
+}
+
+// index returns the index of the entry that wrote the revision.
+func (revs replayRevisions) index(rev int64) uint64 {
+	for _, r := range revs {
+		if r.revision >= rev {
+			return r.index
+		}
+	}
+	return 0
+}
+
+// replayWAL applies the entries of the WAL following the consistent index of
+// the backend at replayPath, created if missing, up to c.ConsistentIndex.
+func replayWAL(lg *zap.Logger, replayPath, walDir string, c *CrossCheck) (revs replayRevisions, err error) {
+	be := backend.NewDefaultBackend(lg, replayPath)
+	cluster := membership.NewCluster(lg)
+	cluster.SetBackend(schema.NewMembershipBackend(lg, be))
+	cluster.UnsafeLoad()
+	lessor := lease.NewLessor(lg, be, cluster, lease.LessorConfig{})
+	kv := mvcc.NewStore(lg, be, lessor, mvcc.StoreConfig{})
+	ci := cindex.NewConsistentIndex(be)
+	defer func() {
+		lessor.Stop()
+		if cerr := kv.Close(); err == nil {
+			err = cerr
+		}
+		be.ForceCommit()
+		if cerr := be.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	alarmStore, err := v3alarm.NewAlarmStore(lg, schema.NewAlarmBackend(lg, be))
+	if err != nil {
+		return nil, err
+	}
+	tp, err := auth.NewTokenProvider(lg, "simple", func(uint64) <-chan struct{} {
+		ch := make(chan struct{})
+		close(ch)
+		return ch
+	}, auth.DefaultTTL)
+	if err != nil {
+		return nil, err
+	}
+	authStore := auth.NewAuthStore(lg, schema.NewAuthBackend(lg, be), tp, bcrypt.MinCost)
+	defer authStore.Close()
+
+	c.BaseIndex = ci.ConsistentIndex()
+	if c.BaseIndex > c.ConsistentIndex {
+		return nil, fmt.Errorf("the consistent index %d of the base backend is ahead of the one of the backend %d", c.BaseIndex, c.ConsistentIndex)
+	}
+	ents, err := readWALEntries(lg, walDir, c.BaseIndex)
+	if err != nil {
+		return nil, err
+	}
+	if len(ents) == 0 || ents[0].Index > c.BaseIndex+1 {
+		first := c.ConsistentIndex + 1
+		if len(ents) != 0 {
+			first = ents[0].Index
+		}
+		return nil, fmt.Errorf("the WAL starts at index %d, after the consistent index %d the replay starts from, provide a --base-db with a more recent consistent index", first, c.BaseIndex)
+	}
+	if last := ents[len(ents)-1].Index; last < c.ConsistentIndex {
+		return nil, fmt.Errorf("the WAL ends at index %d, before the consistent index %d of the backend", last, c.ConsistentIndex)
+	}
+
+	ua := apply.NewUberApplier(apply.ApplierOptions{
+		Logger:               lg,
+		KV:                   kv,
+		AlarmStore:           alarmStore,
+		AuthStore:            authStore,
+		Lessor:               lessor,
+		Cluster:              cluster,
+		RaftStatus:           &replayRaftStatus{},
+		SnapshotServer:       &replayRaftStatus{},
+		ConsistentIndex:      ci,
+		Backend:              be,
+		QuotaBackendBytesCfg: -1,
+		WarningApplyDuration: time.Hour,
+	})
+	rev := kv.Rev()
+	for i := range ents {
+		e := &ents[i]
+		if e.Index <= c.BaseIndex {
+			continue
+		}
+		if e.Index > c.ConsistentIndex {
+			break
+		}
+		ci.SetConsistentIndex(e.Index, e.Term)
+		if e.Type != raftpb.EntryNormal || len(e.Data) == 0 {
+			continue
+		}
+		var r pb.InternalRaftRequest
+		if !pbutil.MaybeUnmarshal(&r, e.Data) || !writesKeys(&r) {
+			continue
+		}
+		c.Replayed++
+		if ar := ua.Apply(&r, membership.ApplyBoth); ar != nil && ar.Physc != nil {
+			<-ar.Physc
+		}
+		if kv.Rev() != rev {
+			rev = kv.Rev()
+			revs = append(revs, replayRevision{index: e.Index, revision: rev})
+		}
+	}
+	c.ReplayRevision = kv.Rev()
+	return revs, nil
+}
+
+// writesKeys tells whether applying the request may change the keys, or the
+// leases and the auth state that the keys written later depend on. The
+// membership of the cluster, changed by the configuration changes that are
+// not replayed, is left out.
+func writesKeys(r *pb.InternalRaftRequest) bool {
+	return r.V2 == nil && r.Range == nil && r.ClusterVersionSet == nil && r.ClusterMemberAttrSet == nil &&
+		r.DowngradeInfoSet == nil && r.DowngradeVersionTest == nil
+}
+
+// readWALEntries reads the entries of the WAL from the last snapshot record
+// at or before index.
+func readWALEntries(lg *zap.Logger, walDir string, index uint64) ([]raftpb.Entry, error) {
+	snaps, err := wal.ValidSnapshotEntries(lg, walDir)
+	if err != nil {
+		return nil, err
+	}
+	var start walpb.Snapshot
+	for _, s := range snaps {
+		if s.Index <= index && s.Index >= start.Index {
+			start = s
+		}
+	}
+	w, err := wal.OpenForRead(lg, walDir, start)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+	_, _, ents, err := w.ReadAll()
+	return ents, err
+}
+
+// replayRaftStatus stands for the raft status and the snapshots of the server
+// the entries are applied by.
+type replayRaftStatus struct{}
+
+func (*replayRaftStatus) MemberID() types.ID     { return 0 }
+func (*replayRaftStatus) Leader() types.ID       { return 0 }
+func (*replayRaftStatus) CommittedIndex() uint64 { return 0 }
+func (*replayRaftStatus) AppliedIndex() uint64   { return 0 }
+func (*replayRaftStatus) Term() uint64           { return 0 }
+func (*replayRaftStatus) ForceSnapshot()         {}
+
+// revisionLen is the length of the key revisions without their tombstone mark.
+const revisionLen = 17
+
+// compareKeyRevisions walks the key revisions of the backend and of the replay
+// following c.CompactRevision in order, hashing them until the first
+// divergence.
+func compareKeyRevisions(dbPath, replayPath string, c *CrossCheck) error {
+	db, err := bolt.Open(dbPath, 0o400, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	rdb, err := bolt.Open(replayPath, 0o400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer rdb.Close()
+
+	return db.View(func(tx *bolt.Tx) error {
+		return rdb.View(func(rtx *bolt.Tx) error {
+			b, rb := tx.Bucket(schema.Key.Name()), rtx.Bucket(schema.Key.Name())
+			if b == nil || rb == nil {
+				return errors.New("the key bucket is missing")
+			}
+			cur, rcur := b.Cursor(), rb.Cursor()
+			if k, _ := cur.Last(); k != nil {
+				c.Revision = mvcc.BytesToRev(k).Main
+			}
+
+			start := mvcc.RevToBytes(mvcc.Revision{Main: c.CompactRevision + 1}, mvcc.NewRevBytes())
+			k, v := cur.Seek(start)
+			rk, rv := rcur.Seek(start)
+			h, rh := crc32.New(crc32.MakeTable(crc32.Castagnoli)), crc32.New(crc32.MakeTable(crc32.Castagnoli))
+			defer func() { c.Hash, c.ReplayHash = h.Sum32(), rh.Sum32() }()
+			for k != nil || rk != nil {
+				var reason string
+				switch {
+				case rk == nil || (k != nil && bytes.Compare(k[:revisionLen], rk[:revisionLen]) < 0):
+					reason, rk, rv = divergenceUnexpected, nil, nil
+				case k == nil || bytes.Compare(k[:revisionLen], rk[:revisionLen]) > 0:
+					reason, k, v = divergenceMissing, nil, nil
+				case !bytes.Equal(k, rk):
+					reason = divergenceTombstone
+				case !bytes.Equal(v, rv):
+					reason = divergenceValue
+				}
+				if reason != "" {
+					c.Divergence, err = newCrossCheckDivergence(reason, k, v, rk, rv)
+					return err
+				}
+				h.Write(k)
+				h.Write(v)
+				rh.Write(rk)
+				rh.Write(rv)
+				c.Compared++
+				k, v = cur.Next()
+				rk, rv = rcur.Next()
+			}
+			return nil
+		})
+	})
+}
+
+// newCrossCheckDivergence returns the divergence of the revision of the key
+// and value of the backend, k and v, and of the replay, rk and rv, nil if the
+// revision is missing.
+func newCrossCheckDivergence(reason string, k, v, rk, rv []byte) (*CrossCheckDivergence, error) {
+	d := &CrossCheckDivergence{Reason: reason}
+	var err error
+	if k != nil {
+		if d.Value, err = unmarshalKeyValue(k, v); err != nil {
+			return nil, err
+		}
+	}
+	if rk != nil {
+		if d.ReplayValue, err = unmarshalKeyValue(rk, rv); err != nil {
+			return nil, err
+		}
+	}
+	rev, kv := mvcc.BytesToRev(rk), d.ReplayValue
+	if k != nil {
+		rev, kv = mvcc.BytesToRev(k), d.Value
+	}
+	d.Revision, d.SubRevision, d.Key = rev.Main, rev.Sub, string(kv.Key)
+	return d, nil
+}
+
+func unmarshalKeyValue(k, v []byte) (*mvccpb.KeyValue, error) {
+	var kv mvccpb.KeyValue
+	if err := kv.Unmarshal(v); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the key-value of revision %x: %w", k, err)
+	}
+	return &kv, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// createCrossCheckDataDir runs an embedded etcd server writing keys, leases
+// and compacting, and returns its data dir and a copy of its backend taken
+// half way.
+func createCrossCheckDataDir(t *testing.T) (dataDir, basePath string) {
+	t.Helper()
+	cfg := embed.NewConfig()
+	cfg.LogLevel = "fatal"
+	cfg.Dir = t.TempDir()
+	etcd, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer etcd.Close()
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+
+	srv := etcd.Server
+	ctx := t.Context()
+	put := func(key, value string, lease int64) {
+		_, err := srv.Put(ctx, &pb.PutRequest{Key: []byte(key), Value: []byte(value), Lease: lease})
+		require.NoError(t, err)
+	}
+	for _, k := range []string{"a", "b", "c", "d"} {
+		put(k, "1", 0)
+	}
+	l, err := srv.LeaseGrant(ctx, &pb.LeaseGrantRequest{TTL: 600})
+	require.NoError(t, err)
+	put("leased", "1", l.ID)
+	_, err = srv.DeleteRange(ctx, &pb.DeleteRangeRequest{Key: []byte("b")})
+	require.NoError(t, err)
+	_, err = srv.Compact(ctx, &pb.CompactionRequest{Revision: 4, Physical: true})
+	require.NoError(t, err)
+
+	basePath = filepath.Join(t.TempDir(), "base.db")
+	f, err := os.Create(basePath)
+	require.NoError(t, err)
+	srv.Backend().ForceCommit()
+	snapshot := srv.Backend().Snapshot()
+	_, err = snapshot.WriteTo(f)
+	require.NoError(t, err)
+	require.NoError(t, snapshot.Close())
+	require.NoError(t, f.Close())
+
+	_, err = srv.Txn(ctx, &pb.TxnRequest{
+		Compare: []*pb.Compare{{Key: []byte("a"), Target: pb.Compare_VALUE, Result: pb.Compare_EQUAL, TargetUnion: &pb.Compare_Value{Value: []byte("1")}}},
+		Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("a"), Value: []byte("2")}}}},
+	})
+	require.NoError(t, err)
+	put("c", "2", 0)
+	_, err = srv.LeaseRevoke(ctx, &pb.LeaseRevokeRequest{ID: l.ID})
+	require.NoError(t, err)
+	put("e", "1", 0)
+	return cfg.Dir, basePath
+}
+
+func TestCrossCheck(t *testing.T) {
+	dataDir, basePath := createCrossCheckDataDir(t)
+	dbPath := filepath.Join(dataDir, "member", "snap", "db")
+	walDir := filepath.Join(dataDir, "member", "wal")
+
+	c, err := crossCheck(zap.NewNop(), dbPath, walDir, "")
+	require.NoError(t, err)
+	require.Nil(t, c.Divergence)
+	assert.Zero(t, c.BaseIndex)
+	assert.Equal(t, int64(4), c.CompactRevision)
+	assert.Equal(t, c.Revision, c.ReplayRevision)
+	assert.Equal(t, c.Hash, c.ReplayHash)
+	// the revisions 5 to 11, plus the deletion of the leased key
+	assert.Equal(t, 7, c.Compared)
+
+	fromBase, err := crossCheck(zap.NewNop(), dbPath, walDir, basePath)
+	require.NoError(t, err)
+	require.Nil(t, fromBase.Divergence)
+	assert.NotZero(t, fromBase.BaseIndex)
+	assert.Less(t, fromBase.Replayed, c.Replayed)
+	assert.Equal(t, c.Hash, fromBase.ReplayHash)
+}
+
+func TestCrossCheckDivergence(t *testing.T) {
+	dataDir, _ := createCrossCheckDataDir(t)
+	dbPath := filepath.Join(dataDir, "member", "snap", "db")
+	walDir := filepath.Join(dataDir, "member", "wal")
+
+	// rewrite the value of c at revision 9 and remove revision 10
+	db, err := bolt.Open(dbPath, 0o600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schema.Key.Name())
+		k := mvcc.RevToBytes(mvcc.Revision{Main: 9}, mvcc.NewRevBytes())
+		var kv mvccpb.KeyValue
+		require.NoError(t, kv.Unmarshal(b.Get(k)))
+		require.Equal(t, "c", string(kv.Key))
+		kv.Value = []byte("corrupted")
+		v, err := kv.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, b.Put(k, v))
+		// revision 10 is the tombstone of the leased key
+		c := b.Cursor()
+		k, _ = c.Seek(mvcc.RevToBytes(mvcc.Revision{Main: 10}, mvcc.NewRevBytes()))
+		require.True(t, mvcc.IsTombstone(k))
+		return c.Delete()
+	}))
+	snapshot := func() []byte {
+		b, err := os.ReadFile(dbPath)
+		require.NoError(t, err)
+		return b
+	}
+	before := snapshot()
+	require.NoError(t, db.Close())
+
+	c, err := crossCheck(zap.NewNop(), dbPath, walDir, "")
+	require.NoError(t, err)
+	require.NotNil(t, c.Divergence)
+	d := c.Divergence
+	assert.Equal(t, int64(9), d.Revision)
+	assert.Equal(t, "c", d.Key)
+	assert.Equal(t, divergenceValue, d.Reason)
+	assert.Equal(t, "corrupted", string(d.Value.Value))
+	assert.Equal(t, "2", string(d.ReplayValue.Value))
+	assert.NotZero(t, d.Index)
+	assert.Equal(t, before, snapshot(), "the backend must not be modified")
+
+	// repair revision 9, revision 10 is still missing
+	db, err = bolt.Open(dbPath, 0o600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(schema.Key.Name())
+		k := mvcc.RevToBytes(mvcc.Revision{Main: 9}, mvcc.NewRevBytes())
+		var kv mvccpb.KeyValue
+		require.NoError(t, kv.Unmarshal(b.Get(k)))
+		kv.Value = []byte("2")
+		v, err := kv.Marshal()
+		require.NoError(t, err)
+		return b.Put(k, v)
+	}))
+	require.NoError(t, db.Close())
+	c, err = crossCheck(zap.NewNop(), dbPath, walDir, "")
+	require.NoError(t, err)
+	require.NotNil(t, c.Divergence)
+	assert.Equal(t, int64(10), c.Divergence.Revision)
+	assert.Equal(t, divergenceMissing, c.Divergence.Reason)
+	assert.Nil(t, c.Divergence.Value)
+	assert.Equal(t, "leased", c.Divergence.Key)
+}
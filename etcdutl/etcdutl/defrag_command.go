@@ -15,18 +15,37 @@
 package etcdutl
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/datadir"
 )
 
-var defragDataDir string
+const (
+	// defragCommitLimit is the number of key-values copied per transaction,
+	// as by the defragmentation of the backend.
+	defragCommitLimit = 10000
+	// defragProgressInterval is the interval of the reports of the progress
+	// of the defragmentation.
+	defragProgressInterval = time.Second
+)
+
+var (
+	defragDataDir     string
+	defragIORateLimit string
+	defragDryRun      bool
+)
 
 // NewDefragCommand returns the cobra command for "Defrag".
 func NewDefragCommand() *cobra.Command {
@@ -36,37 +55,290 @@ func NewDefragCommand() *cobra.Command {
 		Run:   defragCommandFunc,
 	}
 	cmd.Flags().StringVar(&defragDataDir, "data-dir", "", "Required. Defragments a data directory not in use by etcd.")
+	cmd.Flags().StringVar(&defragIORateLimit, "io-rate-limit", "", "Maximum rate the data is copied at per second, e.g. 50MB. Empty for no limit.")
+	cmd.Flags().BoolVar(&defragDryRun, "dry-run", false, "Reports the expected space savings without defragmenting.")
 	cmd.MarkFlagRequired("data-dir")
 	cmd.MarkFlagDirname("data-dir")
 	return cmd
 }
 
 func defragCommandFunc(cmd *cobra.Command, args []string) {
-	err := DefragData(defragDataDir)
+	if defragDryRun {
+		printer := initPrinterFromCmd(cmd)
+		e, err := EstimateDefragData(defragDataDir)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError,
+				fmt.Errorf("Failed to estimate the defragmentation of etcd data[%s] (%w)", defragDataDir, err))
+		}
+		printer.DBDefragEstimate(e)
+		return
+	}
+
+	opts := DefragOptions{Progress: printDefragProgress}
+	if defragIORateLimit != "" {
+		limit, err := humanize.ParseBytes(defragIORateLimit)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --io-rate-limit %q (%w)", defragIORateLimit, err))
+		}
+		opts.RateLimit = int64(limit)
+	}
+	err := DefragDataWithOptions(defragDataDir, opts)
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError,
 			fmt.Errorf("Failed to defragment etcd data[%s] (%w)", defragDataDir, err))
 	}
 }
 
+func printDefragProgress(p DefragProgress) {
+	fmt.Fprintf(os.Stderr, "defragmenting: %.1f%% (%s / %s copied), ETA %s\n",
+		p.Percent(), humanize.Bytes(uint64(p.Copied)), humanize.Bytes(uint64(p.Total)), p.ETA().Round(time.Second))
+}
+
 func DefragData(dataDir string) error {
+	return DefragDataWithOptions(dataDir, DefragOptions{})
+}
+
+// DefragOptions configures the defragmentation of a data directory.
+type DefragOptions struct {
+	// Progress, if set, is called with the progress of the defragmentation
+	// about every second while the data is copied, and once it is copied.
+	Progress func(DefragProgress)
+	// RateLimit is the maximum number of bytes copied per second, 0 for no
+	// limit, so that the defragmentation does not saturate a shared disk.
+	RateLimit int64
+}
+
+// DefragProgress is the progress of a defragmentation.
+type DefragProgress struct {
+	// Copied is the number of bytes of the key-values copied, and Total the
+	// estimated number of bytes to copy, from the size in use of the db file.
+	Copied  int64
+	Total   int64
+	Elapsed time.Duration
+}
+
+// Percent returns the percentage of the data copied.
+func (p DefragProgress) Percent() float64 {
+	if p.Total == 0 {
+		return 100
+	}
+	return 100 * float64(p.Copied) / float64(p.Total)
+}
+
+// ETA returns the estimated time left to copy the data, at the average rate
+// it has been copied at.
+func (p DefragProgress) ETA() time.Duration {
+	if p.Copied == 0 {
+		return 0
+	}
+	return time.Duration(float64(p.Elapsed) * float64(p.Total-p.Copied) / float64(p.Copied))
+}
+
+// DefragDataWithOptions defragments the data directory, copying its db file
+// to a new one that replaces it.
+func DefragDataWithOptions(dataDir string, opts DefragOptions) error {
+	dbPath := datadir.ToBackendFileName(dataDir)
+	db, err := openBolt(dbPath, "To defrag a running etcd instance, use `etcdctl defrag` instead.")
+	if err != nil {
+		return err
+	}
+	_, sizeInUse := boltSizes(db)
+
+	// the temporary files left by a failed defragmentation are removed by
+	// etcd when it starts, as the ones of the backend
+	temp, err := os.CreateTemp(filepath.Dir(dbPath), "db.tmp.*")
+	if err != nil {
+		db.Close()
+		return err
+	}
+	tmpPath := temp.Name()
+	temp.Close()
+	tmpdb, err := bolt.Open(tmpPath, 0o600, &bolt.Options{NoFreelistSync: true, FreelistType: bolt.FreelistMapType})
+	if err != nil {
+		db.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+
+	c := &defragCopier{opts: opts, total: sizeInUse, start: time.Now()}
+	c.reported = c.start
+	if err = c.copy(db, tmpdb); err != nil {
+		tmpdb.Close()
+		db.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err = db.Close(); err != nil {
+		tmpdb.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err = tmpdb.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, dbPath)
+}
+
+// defragCopier copies the buckets of a db file to a new one, throttled to the
+// rate limit and reporting its progress.
+type defragCopier struct {
+	opts     DefragOptions
+	total    int64
+	copied   int64
+	start    time.Time
+	reported time.Time
+}
+
+func (c *defragCopier) copy(odb, tmpdb *bolt.DB) (err error) {
+	tmptx, err := tmpdb.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmptx.Rollback()
+		}
+	}()
+
+	tx, err := odb.Begin(false)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	count := 0
+	cur := tx.Cursor()
+	for next, _ := cur.First(); next != nil; next, _ = cur.Next() {
+		b := tx.Bucket(next)
+		if b == nil {
+			return fmt.Errorf("cannot defrag bucket %s", next)
+		}
+		tmpb, err := tmptx.CreateBucketIfNotExists(next)
+		if err != nil {
+			return err
+		}
+		tmpb.FillPercent = 0.9 // for bucket2seq write in for each
+
+		if err = b.ForEach(func(k, v []byte) error {
+			count++
+			if count > defragCommitLimit {
+				if err := tmptx.Commit(); err != nil {
+					return err
+				}
+				if tmptx, err = tmpdb.Begin(true); err != nil {
+					return err
+				}
+				tmpb = tmptx.Bucket(next)
+				tmpb.FillPercent = 0.9 // for bucket2seq write in for each
+				count = 0
+			}
+			if err := tmpb.Put(k, v); err != nil {
+				return err
+			}
+			c.add(int64(len(k) + len(v)))
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	if err = tmptx.Commit(); err != nil {
+		return err
+	}
+	if c.opts.Progress != nil {
+		c.opts.Progress(DefragProgress{Copied: c.copied, Total: c.copied, Elapsed: time.Since(c.start)})
+	}
+	return nil
+}
+
+// add accounts for n bytes copied, sleeping if they are copied faster than
+// the rate limit.
+func (c *defragCopier) add(n int64) {
+	c.copied += n
+	// the key-values are less than the size in use of the db file, which
+	// only estimates them
+	c.total = max(c.total, c.copied)
+	if c.opts.RateLimit > 0 {
+		due := time.Duration(float64(c.copied) / float64(c.opts.RateLimit) * float64(time.Second))
+		if ahead := due - time.Since(c.start); ahead > 10*time.Millisecond {
+			time.Sleep(ahead)
+		}
+	}
+	if c.opts.Progress != nil && time.Since(c.reported) >= defragProgressInterval {
+		c.reported = time.Now()
+		c.opts.Progress(DefragProgress{Copied: c.copied, Total: c.total, Elapsed: time.Since(c.start)})
+	}
+}
+
+// DefragEstimate is the expected result of the defragmentation of a data
+// directory.
+type DefragEstimate struct {
+	// Size is the size of the db file, and SizeInUse the size of its pages
+	// in use, that its defragmented copy is expected to be.
+	Size      int64 `json:"size"`
+	SizeInUse int64 `json:"sizeInUse"`
+	// Savings is the expected space released by the defragmentation.
+	Savings int64 `json:"savings"`
+}
+
+// EstimateDefragData returns the expected result of the defragmentation of
+// the data directory, without defragmenting it.
+func EstimateDefragData(dataDir string) (DefragEstimate, error) {
+	db, err := openBolt(datadir.ToBackendFileName(dataDir), "")
+	if err != nil {
+		return DefragEstimate{}, err
+	}
+	defer db.Close()
+	size, sizeInUse := boltSizes(db)
+	return DefragEstimate{Size: size, SizeInUse: sizeInUse, Savings: size - sizeInUse}, nil
+}
+
+// openBolt opens the db file, waiting for etcd to close and release its lock
+// on it. The db file is opened for writing, as the free pages of a db file
+// opened read-only are not known.
+func openBolt(dbPath string, hint string) (*bolt.DB, error) {
+	opts := &bolt.Options{Timeout: time.Second, NoFreelistSync: true, FreelistType: bolt.FreelistMapType}
+	db, err := bolt.Open(dbPath, 0o600, opts)
+	if errors.Is(err, bolterrors.ErrTimeout) {
+		fmt.Fprintf(os.Stderr, "waiting for etcd to close and release its lock on %q. %s\n", dbPath, hint)
+		opts.Timeout = 0
+		db, err = bolt.Open(dbPath, 0o600, opts)
+	}
+	return db, err
+}
+
+// boltSizes returns the size of the db file, and the size of its pages in use.
+func boltSizes(db *bolt.DB) (size, sizeInUse int64) {
+	var err error
+	err = db.View(func(tx *bolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, 0
+	}
+	stats := db.Stats()
+	free := int64(stats.FreePageN+stats.PendingPageN) * int64(db.Info().PageSize)
+	return size, size - free
+}
+
+// openBackend opens the backend of the db file, waiting for etcd to close and
+// release its lock on it. The hint tells how to run the command online.
+func openBackend(lg *zap.Logger, dbPath string, hint string) backend.Backend {
 	var be backend.Backend
-	lg := GetLogger()
 	bch := make(chan struct{})
-	dbDir := datadir.ToBackendFileName(dataDir)
 	go func() {
 		defer close(bch)
 		cfg := backend.DefaultBackendConfig(lg)
 		cfg.Logger = lg
-		cfg.Path = dbDir
+		cfg.Path = dbPath
 		be = backend.New(cfg)
 	}()
 	select {
 	case <-bch:
 	case <-time.After(time.Second):
-		fmt.Fprintf(os.Stderr, "waiting for etcd to close and release its lock on %q. "+
-			"To defrag a running etcd instance, use `etcdctl defrag` instead.\n", dbDir)
+		fmt.Fprintf(os.Stderr, "waiting for etcd to close and release its lock on %q. %s\n", dbPath, hint)
 		<-bch
 	}
-	return be.Defrag()
+	return be
 }
@@ -0,0 +1,111 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+)
+
+// createDefragDataDir writes a db file of 1000 key-values of 100 bytes, and
+// deletes half of them to leave free pages.
+func createDefragDataDir(t *testing.T) string {
+	t.Helper()
+	dataDir := t.TempDir()
+	dbPath := datadir.ToBackendFileName(dataDir)
+	require.NoError(t, os.MkdirAll(filepath.Dir(dbPath), 0o700))
+	db, err := bolt.Open(dbPath, 0o600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket([]byte("key"))
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 1000; i++ {
+			if err := b.Put([]byte(fmt.Sprintf("%04d", i)), make([]byte, 96)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("key"))
+		for i := 0; i < 1000; i += 2 {
+			if err := b.Delete([]byte(fmt.Sprintf("%04d", i))); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, db.Close())
+	return dataDir
+}
+
+func TestDefragData(t *testing.T) {
+	dataDir := createDefragDataDir(t)
+
+	e, err := EstimateDefragData(dataDir)
+	require.NoError(t, err)
+	assert.Positive(t, e.Savings)
+	assert.Equal(t, e.Size-e.SizeInUse, e.Savings)
+
+	var last DefragProgress
+	require.NoError(t, DefragDataWithOptions(dataDir, DefragOptions{Progress: func(p DefragProgress) { last = p }}))
+	assert.Equal(t, int64(500*100), last.Copied)
+	assert.InDelta(t, 100, last.Percent(), 0.01)
+
+	db, err := bolt.Open(datadir.ToBackendFileName(dataDir), 0o600, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("key"))
+		require.NotNil(t, b)
+		assert.Equal(t, 500, b.Stats().KeyN)
+		assert.NotNil(t, b.Get([]byte("0001")))
+		assert.Nil(t, b.Get([]byte("0002")))
+		assert.Less(t, tx.Size(), e.Size)
+		return nil
+	}))
+
+	// the temporary file is renamed to the db file
+	matches, err := filepath.Glob(filepath.Join(filepath.Dir(datadir.ToBackendFileName(dataDir)), "db.tmp.*"))
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
+
+func TestDefragDataRateLimit(t *testing.T) {
+	dataDir := createDefragDataDir(t)
+
+	// 50000 bytes are copied at 100000 bytes per second
+	start := time.Now()
+	require.NoError(t, DefragDataWithOptions(dataDir, DefragOptions{RateLimit: 100000}))
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestDefragProgress(t *testing.T) {
+	p := DefragProgress{Copied: 25, Total: 100, Elapsed: time.Second}
+	assert.InDelta(t, 25, p.Percent(), 0.01)
+	assert.Equal(t, 3*time.Second, p.ETA())
+	assert.Equal(t, time.Duration(0), DefragProgress{Total: 100}.ETA())
+}
@@ -15,32 +15,51 @@
 package etcdutl
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
-	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
-var hashKVRevision int64
+var (
+	hashKVRevision int64
+	hashKVDataDir  string
+)
 
 // NewHashKVCommand returns the cobra command for "hashkv".
 func NewHashKVCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "hashkv <filename>",
+		Use:   "hashkv [<filename> | --data-dir <data dir>]",
 		Short: "Prints the KV history hash of a given file",
-		Args:  cobra.ExactArgs(1),
-		Run:   hashKVCommandFunc,
+		Long: `Prints the KV history hash of a given db file, or of the db file of a data directory not
+in use by etcd. The hash is the one the HashKV RPC returns for the same revision, so that the
+data of an offline member can be compared with the data of the live members before rejoining
+it to the cluster.
+`,
+		Args: cobra.MaximumNArgs(1),
+		Run:  hashKVCommandFunc,
 	}
 	cmd.Flags().Int64Var(&hashKVRevision, "rev", 0, "maximum revision to hash (default: latest revision)")
+	cmd.Flags().StringVar(&hashKVDataDir, "data-dir", "", "Hashes the db file of a data directory not in use by etcd, instead of the given file")
+	cmd.MarkFlagDirname("data-dir")
 	return cmd
 }
 
 func hashKVCommandFunc(cmd *cobra.Command, args []string) {
 	printer := initPrinterFromCmd(cmd)
 
-	ds, err := calculateHashKV(args[0], hashKVRevision)
+	if (len(args) == 1) == (hashKVDataDir != "") {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, errors.New("hashkv takes either a filename or --data-dir"))
+	}
+	dbPath := datadir.ToBackendFileName(hashKVDataDir)
+	if len(args) == 1 {
+		dbPath = args[0]
+	}
+	ds, err := calculateHashKV(dbPath, hashKVRevision)
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
@@ -54,10 +73,10 @@ type HashKV struct {
 }
 
 func calculateHashKV(dbPath string, rev int64) (HashKV, error) {
-	cfg := backend.DefaultBackendConfig(zap.NewNop())
-	cfg.Path = dbPath
-	b := backend.New(cfg)
+	b := openBackend(zap.NewNop(), dbPath, "To hash the KV of a running etcd instance, use `etcdctl endpoint hashkv` instead.")
+	defer b.Close()
 	st := mvcc.NewStore(zap.NewNop(), b, nil, mvcc.StoreConfig{})
+	defer st.Close()
 	hst := mvcc.NewHashStorage(zap.NewNop(), st)
 
 	h, _, err := hst.HashByRev(rev)
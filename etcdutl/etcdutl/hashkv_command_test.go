@@ -0,0 +1,70 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// TestCalculateHashKV verifies that the hash of an offline data directory is
+// the one the live server returns.
+func TestCalculateHashKV(t *testing.T) {
+	cfg := embed.NewConfig()
+	cfg.LogLevel = "fatal"
+	cfg.Dir = t.TempDir()
+	etcd, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+	for _, k := range []string{"a", "b", "c", "a"} {
+		_, err = etcd.Server.Put(t.Context(), &pb.PutRequest{Key: []byte(k), Value: []byte(k)})
+		require.NoError(t, err)
+	}
+	_, err = etcd.Server.Compact(t.Context(), &pb.CompactionRequest{Revision: 3, Physical: true})
+	require.NoError(t, err)
+
+	live := map[int64]mvcc.KeyValueHash{}
+	for _, rev := range []int64{3, 4, 5} {
+		live[rev], _, err = etcd.Server.KV().HashStorage().HashByRev(rev)
+		require.NoError(t, err)
+	}
+	etcd.Close()
+
+	for rev, h := range live {
+		ds, err := calculateHashKV(datadir.ToBackendFileName(cfg.Dir), rev)
+		require.NoError(t, err)
+		if rev == 3 {
+			// the live server returns the hash stored by the compaction at the
+			// revision, with the revision compacted before
+			assert.Equal(t, h.Hash, ds.Hash)
+			continue
+		}
+		assert.Equal(t, HashKV{Hash: h.Hash, HashRevision: h.Revision, CompactRevision: h.CompactRevision}, ds)
+	}
+	_, err = calculateHashKV(datadir.ToBackendFileName(cfg.Dir), 2)
+	require.ErrorIs(t, err, mvcc.ErrCompacted)
+}
@@ -15,6 +15,7 @@
 package etcdutl
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -28,6 +29,7 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/datadir"
 	"go.etcd.io/etcd/server/v3/storage/schema"
 	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/raft/v3/raftpb"
 )
 
 // NewMigrateCommand prints out the version of etcd.
@@ -36,11 +38,23 @@ func NewMigrateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate",
 		Short: "Migrates schema of etcd data dir files to make them compatible with different etcd version",
+		Long: `Migrates the schema of the files of an etcd data dir not in use by etcd to make them compatible
+with a different etcd version.
+
+A downgrade is refused if the WAL contains entries that etcd of the target version cannot
+interpret, listing them, unless --force is set.
+
+With --dry-run, the data dir is not changed: the storage schema fields the migration would
+change are printed, along with the WAL entries blocking a downgrade.
+`,
 		Run: func(cmd *cobra.Command, args []string) {
 			cfg, err := o.Config()
 			if err != nil {
 				cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
 			}
+			if cfg.dryRun {
+				cfg.printer = initPrinterFromCmd(cmd)
+			}
 			err = migrateCommandFunc(cfg)
 			if err != nil {
 				cobrautl.ExitWithError(cobrautl.ExitError, err)
@@ -55,6 +69,7 @@ type migrateOptions struct {
 	dataDir       string
 	targetVersion string
 	force         bool
+	dryRun        bool
 }
 
 func newMigrateOptions() *migrateOptions {
@@ -70,11 +85,13 @@ func (o *migrateOptions) AddFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("target-version")
 
 	cmd.Flags().BoolVar(&o.force, "force", o.force, "Ignore migration failure and forcefully override storage version. Not recommended.")
+	cmd.Flags().BoolVar(&o.dryRun, "dry-run", o.dryRun, "Print the storage schema fields the migration would change and the WAL entries blocking a downgrade, without changing the data dir.")
 }
 
 func (o *migrateOptions) Config() (*migrateConfig, error) {
 	c := &migrateConfig{
 		force:   o.force,
+		dryRun:  o.dryRun,
 		dataDir: o.dataDir,
 		lg:      GetLogger(),
 	}
@@ -98,8 +115,12 @@ type migrateConfig struct {
 	lg            *zap.Logger
 	targetVersion *semver.Version
 	walVersion    wal.Version
-	dataDir       string
-	force         bool
+	// walEntries are the entries of the WAL since its latest snapshot.
+	walEntries []raftpb.Entry
+	dataDir    string
+	force      bool
+	dryRun     bool
+	printer    printer
 }
 
 func (c *migrateConfig) finalize() error {
@@ -113,10 +134,11 @@ func (c *migrateConfig) finalize() error {
 		return fmt.Errorf(`failed to open wal: %w`, err)
 	}
 	defer w.Close()
-	c.walVersion, err = wal.ReadWALVersion(w)
+	_, _, c.walEntries, err = w.ReadAll()
 	if err != nil {
 		return fmt.Errorf(`failed to read wal: %w`, err)
 	}
+	c.walVersion = walEntriesVersion(c.walEntries)
 
 	return nil
 }
@@ -132,7 +154,7 @@ func migrateCommandFunc(c *migrateConfig) error {
 		c.lg.Error("failed to detect storage version. Please make sure you are using data dir from etcd v3.5 and older")
 		return err
 	}
-	if current == *c.targetVersion {
+	if current == *c.targetVersion && !c.dryRun {
 		c.lg.Info("storage version up-to-date", zap.String("storage-version", storageVersionToString(&current)))
 		return nil
 	}
@@ -142,6 +164,25 @@ func migrateCommandFunc(c *migrateConfig) error {
 		return err
 	}
 
+	var blocking []wal.EntryVersion
+	if c.targetVersion.LessThan(current) {
+		blocking, err = wal.EntriesNewerThan(c.walEntries, *c.targetVersion)
+		if err != nil {
+			return fmt.Errorf("failed to read the versions of the wal entries: %w", err)
+		}
+	}
+	if c.dryRun {
+		d := migrateDryRun(c, be.ReadTx(), current, blocking)
+		c.printer.Migrate(d)
+		if d.Error != "" && !c.force {
+			return errors.New(d.Error)
+		}
+		return nil
+	}
+	if len(blocking) > 0 && !c.force {
+		return blockingEntriesError(c.targetVersion, blocking)
+	}
+
 	err = schema.Migrate(c.lg, tx, c.walVersion, *c.targetVersion)
 	if err != nil {
 		if !c.force {
@@ -157,6 +198,10 @@ func migrateCommandFunc(c *migrateConfig) error {
 func migrateForce(lg *zap.Logger, tx backend.BatchTx, target *semver.Version) {
 	tx.LockOutsideApply()
 	defer tx.Unlock()
+	unsafeMigrateForce(lg, tx, target)
+}
+
+func unsafeMigrateForce(lg *zap.Logger, tx backend.UnsafeWriter, target *semver.Version) {
 	// Storage version is only supported since v3.6
 	if target.LessThan(version.V3_6) {
 		schema.UnsafeClearStorageVersion(tx)
@@ -167,6 +212,32 @@ func migrateForce(lg *zap.Logger, tx backend.BatchTx, target *semver.Version) {
 	}
 }
 
+// walEntriesVersion is the version of the entries of a WAL.
+type walEntriesVersion []raftpb.Entry
+
+func (ents walEntriesVersion) MinimalEtcdVersion() *semver.Version {
+	return wal.MinimalEtcdVersion(ents)
+}
+
+// maxBlockingEntriesListed is the number of the entries blocking a downgrade
+// listed by its error.
+const maxBlockingEntriesListed = 20
+
+// blockingEntriesError returns the error refusing a downgrade to target, listing
+// the WAL entries blocking it.
+func blockingEntriesError(target *semver.Version, blocking []wal.EntryVersion) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "cannot downgrade storage to %s, %d WAL entries are newer than it:", storageVersionToString(target), len(blocking))
+	for i, e := range blocking {
+		if i == maxBlockingEntriesListed {
+			fmt.Fprintf(&b, "\n  and %d more", len(blocking)-i)
+			break
+		}
+		fmt.Fprintf(&b, "\n  index %d, term %d: %s requires etcd %s", e.Index, e.Term, e.Field, storageVersionToString(e.Version))
+	}
+	return errors.New(b.String())
+}
+
 func storageVersionToString(ver *semver.Version) string {
 	return fmt.Sprintf("%d.%d", ver.Major, ver.Minor)
 }
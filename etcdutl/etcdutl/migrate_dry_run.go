@@ -0,0 +1,156 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/coreos/go-semver/semver"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+)
+
+// MigrateDiff is the result of a dry run of migrate.
+type MigrateDiff struct {
+	CurrentVersion string         `json:"currentVersion"`
+	TargetVersion  string         `json:"targetVersion"`
+	Fields         []MigrateField `json:"fields"`
+	// BlockingEntries are the WAL entries newer than the target version,
+	// blocking a downgrade.
+	BlockingEntries []wal.EntryVersion `json:"blockingEntries,omitempty"`
+	// Error is the error the migration fails with, if any. With --force, the
+	// storage version is then forcefully set, as reflected by the fields.
+	Error string `json:"error,omitempty"`
+}
+
+// MigrateField is a storage schema field, before and after a migration. The
+// values are empty if the field is not set.
+type MigrateField struct {
+	Bucket  string `json:"bucket"`
+	Key     string `json:"key"`
+	Before  string `json:"before"`
+	After   string `json:"after"`
+	Changed bool   `json:"changed"`
+}
+
+// migrateFields are the storage schema fields reported by a dry run of migrate.
+var migrateFields = []struct {
+	bucket backend.Bucket
+	key    []byte
+	// uint64 tells whether the value is a big endian uint64.
+	uint64 bool
+}{
+	{schema.Meta, schema.MetaStorageVersionName, false},
+	{schema.Meta, schema.MetaConfStateName, false},
+	{schema.Meta, schema.MetaTermKeyName, true},
+	{schema.Meta, schema.MetaConsistentIndexKeyName, true},
+	{schema.Cluster, schema.ClusterClusterVersionKeyName, false},
+	{schema.Cluster, schema.ClusterDowngradeKeyName, false},
+}
+
+// migrateDryRun migrates the storage schema read from rtx to the target version
+// of c without writing it, and returns the fields the migration changes.
+func migrateDryRun(c *migrateConfig, rtx backend.ReadTx, current semver.Version, blocking []wal.EntryVersion) MigrateDiff {
+	rtx.RLock()
+	defer rtx.RUnlock()
+	d := MigrateDiff{
+		CurrentVersion:  storageVersionToString(&current),
+		TargetVersion:   storageVersionToString(c.targetVersion),
+		BlockingEntries: blocking,
+	}
+	tx := newDryRunTx(rtx)
+	if len(blocking) > 0 {
+		d.Error = blockingEntriesError(c.targetVersion, blocking).Error()
+	} else if err := schema.UnsafeMigrate(zap.NewNop(), tx, c.walVersion, *c.targetVersion); err != nil {
+		d.Error = err.Error()
+	}
+	if d.Error != "" && c.force {
+		unsafeMigrateForce(zap.NewNop(), tx, c.targetVersion)
+	}
+	for _, f := range migrateFields {
+		mf := MigrateField{
+			Bucket: f.bucket.String(),
+			Key:    string(f.key),
+			Before: readMigrateField(rtx, f.bucket, f.key, f.uint64),
+			After:  readMigrateField(tx, f.bucket, f.key, f.uint64),
+		}
+		mf.Changed = mf.Before != mf.After
+		d.Fields = append(d.Fields, mf)
+	}
+	return d
+}
+
+func readMigrateField(tx backend.UnsafeReader, bucket backend.Bucket, key []byte, isUint64 bool) string {
+	_, vs := tx.UnsafeRange(bucket, key, nil, 0)
+	if len(vs) == 0 {
+		return ""
+	}
+	if isUint64 && len(vs[0]) == 8 {
+		return fmt.Sprint(binary.BigEndian.Uint64(vs[0]))
+	}
+	return string(vs[0])
+}
+
+// dryRunTx records the writes of a migration over the keys read from a
+// transaction, without applying them, so that they are read back from it.
+type dryRunTx struct {
+	backend.UnsafeReader
+	// writes are the values written by bucket and key, nil if deleted.
+	writes map[string]map[string][]byte
+}
+
+func newDryRunTx(tx backend.UnsafeReader) *dryRunTx {
+	return &dryRunTx{UnsafeReader: tx, writes: make(map[string]map[string][]byte)}
+}
+
+func (tx *dryRunTx) UnsafeRange(bucket backend.Bucket, key, endKey []byte, limit int64) ([][]byte, [][]byte) {
+	if len(endKey) == 0 {
+		if v, ok := tx.writes[string(bucket.Name())][string(key)]; ok {
+			if v == nil {
+				return nil, nil
+			}
+			return [][]byte{key}, [][]byte{v}
+		}
+	}
+	return tx.UnsafeReader.UnsafeRange(bucket, key, endKey, limit)
+}
+
+func (tx *dryRunTx) UnsafePut(bucket backend.Bucket, key, value []byte) {
+	b, ok := tx.writes[string(bucket.Name())]
+	if !ok {
+		b = make(map[string][]byte)
+		tx.writes[string(bucket.Name())] = b
+	}
+	b[string(key)] = bytes.Clone(value)
+}
+
+func (tx *dryRunTx) UnsafeSeqPut(bucket backend.Bucket, key, value []byte) {
+	tx.UnsafePut(bucket, key, value)
+}
+
+func (tx *dryRunTx) UnsafeDelete(bucket backend.Bucket, key []byte) {
+	tx.UnsafePut(bucket, key, nil)
+}
+
+func (tx *dryRunTx) UnsafeCreateBucket(backend.Bucket) {}
+
+func (tx *dryRunTx) UnsafeDeleteBucket(bucket backend.Bucket) {
+	panic(fmt.Sprintf("dry run of migrate cannot delete bucket %s", bucket))
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+)
+
+func TestMigrateDryRun(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "data")
+	writeDataDir(t, dataDir, "a")
+	lg := zaptest.NewLogger(t)
+
+	be := backend.NewDefaultBackend(lg, datadir.ToBackendFileName(dataDir))
+	defer be.Close()
+	current, err := schema.DetectSchemaVersion(lg, be.ReadTx())
+	require.NoError(t, err)
+
+	c := &migrateConfig{lg: lg, targetVersion: &version.V3_5, dataDir: dataDir, dryRun: true}
+	require.NoError(t, c.finalize())
+	d := migrateDryRun(c, be.ReadTx(), current, nil)
+	require.Empty(t, d.Error)
+	assert.Equal(t, "3.5", d.TargetVersion)
+	changed := map[string]MigrateField{}
+	for _, f := range d.Fields {
+		if f.Changed {
+			changed[f.Key] = f
+		}
+	}
+	require.Len(t, changed, 1)
+	assert.Equal(t, current.String(), changed["storageVersion"].Before)
+	assert.Empty(t, changed["storageVersion"].After)
+
+	// the dry run does not change the storage version
+	after, err := schema.DetectSchemaVersion(lg, be.ReadTx())
+	require.NoError(t, err)
+	assert.Equal(t, current, after)
+}
+
+func TestDryRunTx(t *testing.T) {
+	be, _ := betesting.NewTmpBackend(t, time.Hour, 10000)
+	defer betesting.Close(t, be)
+	wtx := be.BatchTx()
+	wtx.Lock()
+	wtx.UnsafeCreateBucket(schema.Meta)
+	wtx.UnsafePut(schema.Meta, []byte("a"), []byte("1"))
+	wtx.UnsafePut(schema.Meta, []byte("b"), []byte("2"))
+	wtx.Unlock()
+	be.ForceCommit()
+
+	rtx := be.ReadTx()
+	rtx.RLock()
+	defer rtx.RUnlock()
+	tx := newDryRunTx(rtx)
+	tx.UnsafePut(schema.Meta, []byte("a"), []byte("3"))
+	tx.UnsafeDelete(schema.Meta, []byte("b"))
+	tx.UnsafePut(schema.Meta, []byte("c"), []byte("4"))
+
+	for k, want := range map[string]string{"a": "3", "b": "", "c": "4"} {
+		assert.Equal(t, want, readMigrateField(tx, schema.Meta, []byte(k), false), k)
+	}
+	for k, want := range map[string]string{"a": "1", "b": "2", "c": ""} {
+		assert.Equal(t, want, readMigrateField(rtx, schema.Meta, []byte(k), false), k)
+	}
+}
+
+func TestBlockingEntriesError(t *testing.T) {
+	var blocking []wal.EntryVersion
+	for i := 1; i <= maxBlockingEntriesListed+2; i++ {
+		blocking = append(blocking, wal.EntryVersion{Index: uint64(i), Term: 2, Version: &version.V3_6, Field: "etcdserverpb.InternalRaftRequest.downgrade_version_test"})
+	}
+	err := blockingEntriesError(&version.V3_5, blocking)
+	assert.ErrorContains(t, err, "cannot downgrade storage to 3.5, 22 WAL entries are newer than it")
+	assert.ErrorContains(t, err, "index 1, term 2: etcdserverpb.InternalRaftRequest.downgrade_version_test requires etcd 3.6")
+	assert.ErrorContains(t, err, "and 2 more")
+	assert.NotContains(t, err.Error(), "index 21,")
+}
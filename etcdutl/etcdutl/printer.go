@@ -21,8 +21,10 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/storage/wal"
 )
 
 var OutputFormat string
@@ -30,6 +32,20 @@ var OutputFormat string
 type printer interface {
 	DBStatus(snapshot.Status)
 	DBHashKV(HashKV)
+	DBCompaction(Compaction)
+	DBDefragEstimate(DefragEstimate)
+	AuthAnalysis(AuthAnalysis)
+	ApplyDigestDiff(ApplyDigestDiff)
+	SnapshotScrub(snapshot.ScrubStatus)
+	SnapshotTrimRevisions(snapshot.TrimStatus)
+	SnapshotExport(snapshot.ExportStatus)
+	SnapshotImport(snapshot.ImportStatus)
+	CrossCheck(CrossCheck)
+	WALRepair(wal.RepairReport)
+	Backup(Backup)
+	Migrate(MigrateDiff)
+	RemoveMember(RemovedMember)
+	Verify(VerifyReport)
 }
 
 func NewPrinter(printerType string) printer {
@@ -62,8 +78,22 @@ func newPrinterUnsupported(n string) printer {
 	return &printerUnsupported{printerRPC{nil, f}}
 }
 
-func (p *printerUnsupported) DBStatus(snapshot.Status) { p.p(nil) }
-func (p *printerUnsupported) DBHashKV(HashKV)          { p.p(nil) }
+func (p *printerUnsupported) DBStatus(snapshot.Status)                  { p.p(nil) }
+func (p *printerUnsupported) DBHashKV(HashKV)                           { p.p(nil) }
+func (p *printerUnsupported) DBCompaction(Compaction)                   { p.p(nil) }
+func (p *printerUnsupported) DBDefragEstimate(DefragEstimate)           { p.p(nil) }
+func (p *printerUnsupported) AuthAnalysis(AuthAnalysis)                 { p.p(nil) }
+func (p *printerUnsupported) ApplyDigestDiff(ApplyDigestDiff)           { p.p(nil) }
+func (p *printerUnsupported) SnapshotScrub(snapshot.ScrubStatus)        { p.p(nil) }
+func (p *printerUnsupported) SnapshotTrimRevisions(snapshot.TrimStatus) { p.p(nil) }
+func (p *printerUnsupported) SnapshotExport(snapshot.ExportStatus)      { p.p(nil) }
+func (p *printerUnsupported) SnapshotImport(snapshot.ImportStatus)      { p.p(nil) }
+func (p *printerUnsupported) CrossCheck(CrossCheck)                     { p.p(nil) }
+func (p *printerUnsupported) WALRepair(wal.RepairReport)                { p.p(nil) }
+func (p *printerUnsupported) Backup(Backup)                             { p.p(nil) }
+func (p *printerUnsupported) Migrate(MigrateDiff)                       { p.p(nil) }
+func (p *printerUnsupported) RemoveMember(RemovedMember)                { p.p(nil) }
+func (p *printerUnsupported) Verify(VerifyReport)                       { p.p(nil) }
 
 func makeDBStatusTable(ds snapshot.Status) (hdr []string, rows [][]string) {
 	hdr = []string{"hash", "revision", "total keys", "total size", "version"}
@@ -77,6 +107,22 @@ func makeDBStatusTable(ds snapshot.Status) (hdr []string, rows [][]string) {
 	return hdr, rows
 }
 
+func makeDBStatusBucketsTable(ds snapshot.Status) (hdr []string, rows [][]string) {
+	hdr = []string{"bucket", "keys", "size"}
+	for _, b := range ds.Buckets {
+		rows = append(rows, []string{b.Name, fmt.Sprint(b.Keys), humanize.Bytes(uint64(b.Size))})
+	}
+	return hdr, rows
+}
+
+func makeDBStatusPrefixesTable(ds snapshot.Status) (hdr []string, rows [][]string) {
+	hdr = []string{"prefix", "keys", "revisions", "size"}
+	for _, p := range ds.Prefixes {
+		rows = append(rows, []string{p.Prefix, fmt.Sprint(p.Keys), fmt.Sprint(p.Revisions), humanize.Bytes(uint64(p.Size))})
+	}
+	return hdr, rows
+}
+
 func makeDBHashKVTable(ds HashKV) (hdr []string, rows [][]string) {
 	hdr = []string{"hash", "hash revision", "compact revision"}
 	rows = append(rows, []string{
@@ -87,6 +133,229 @@ func makeDBHashKVTable(ds HashKV) (hdr []string, rows [][]string) {
 	return hdr, rows
 }
 
+func makeDBCompactionTable(c Compaction) (hdr []string, rows [][]string) {
+	hdr = []string{"revision", "previous revision", "key revisions before", "key revisions after", "size", "size in use", "defragmented"}
+	rows = append(rows, []string{
+		fmt.Sprint(c.Revision),
+		fmt.Sprint(c.PreviousRevision),
+		fmt.Sprint(c.KeyRevisionsBefore),
+		fmt.Sprint(c.KeyRevisionsAfter),
+		humanize.Bytes(uint64(c.Size)),
+		humanize.Bytes(uint64(c.SizeInUse)),
+		fmt.Sprint(c.Defragmented),
+	})
+	return hdr, rows
+}
+
+func makeDBDefragEstimateTable(e DefragEstimate) (hdr []string, rows [][]string) {
+	hdr = []string{"size", "size in use", "expected savings"}
+	rows = append(rows, []string{
+		humanize.Bytes(uint64(e.Size)),
+		humanize.Bytes(uint64(e.SizeInUse)),
+		humanize.Bytes(uint64(e.Savings)),
+	})
+	return hdr, rows
+}
+
+func makeWALRepairTable(r wal.RepairReport) (hdr []string, rows [][]string) {
+	hdr = []string{"path", "size", "corruption", "offset", "removed bytes", "backup"}
+	rows = append(rows, []string{
+		r.Path,
+		fmt.Sprint(r.Size),
+		r.Corruption,
+		fmt.Sprint(r.Offset),
+		fmt.Sprint(r.RemovedBytes),
+		r.Backup,
+	})
+	return hdr, rows
+}
+
+func makeBackupTable(b Backup) (hdr []string, rows [][]string) {
+	hdr = []string{"manifest", "files", "copied files", "copied size", "reused size"}
+	rows = append(rows, []string{
+		b.Manifest,
+		fmt.Sprint(b.Files),
+		fmt.Sprint(b.CopiedFiles),
+		humanize.Bytes(uint64(b.CopiedBytes)),
+		humanize.Bytes(uint64(b.ReusedBytes)),
+	})
+	return hdr, rows
+}
+
+func makeRemoveMemberTable(r RemovedMember) (hdr []string, rows [][]string) {
+	hdr = []string{"id", "local id", "index", "term", "discarded entries", "backup"}
+	rows = append(rows, []string{
+		r.ID,
+		r.LocalID,
+		fmt.Sprint(r.Index),
+		fmt.Sprint(r.Term),
+		fmt.Sprint(r.DiscardedEntries),
+		r.Backup,
+	})
+	return hdr, rows
+}
+
+func makeVerifyTable(r VerifyReport) (hdr []string, rows [][]string) {
+	hdr = []string{"check", "status", "detail", "error"}
+	for _, c := range r.Checks {
+		status := "ok"
+		if !c.OK {
+			status = "failed"
+		}
+		rows = append(rows, []string{c.Name, status, c.Detail, c.Error})
+	}
+	return hdr, rows
+}
+
+func makeSnapshotScrubTable(st snapshot.ScrubStatus) (hdr []string, rows [][]string) {
+	action := "rewritten"
+	if st.Deleted {
+		action = "deleted"
+	}
+	hdr = []string{"keys", "revisions", "action", "hash", "revision", "total keys", "total size"}
+	rows = append(rows, []string{
+		fmt.Sprint(st.Keys),
+		fmt.Sprint(st.Revisions),
+		action,
+		fmt.Sprintf("%x", st.Status.Hash),
+		fmt.Sprint(st.Status.Revision),
+		fmt.Sprint(st.Status.TotalKey),
+		humanize.Bytes(uint64(st.Status.TotalSize)),
+	})
+	return hdr, rows
+}
+
+func makeSnapshotTrimRevisionsTable(st snapshot.TrimStatus) (hdr []string, rows [][]string) {
+	hdr = []string{"keys", "dropped revisions", "dropped tombstones", "compact revision", "hash", "revision", "total keys", "total size"}
+	rows = append(rows, []string{
+		fmt.Sprint(st.Keys),
+		fmt.Sprint(st.Revisions),
+		fmt.Sprint(st.Tombstones),
+		fmt.Sprint(st.CompactRevision),
+		fmt.Sprintf("%x", st.Status.Hash),
+		fmt.Sprint(st.Status.Revision),
+		fmt.Sprint(st.Status.TotalKey),
+		humanize.Bytes(uint64(st.Status.TotalSize)),
+	})
+	return hdr, rows
+}
+
+func makeSnapshotExportTable(st snapshot.ExportStatus) (hdr []string, rows [][]string) {
+	hdr = []string{"keys", "leases", "revision"}
+	rows = append(rows, []string{
+		fmt.Sprint(st.Keys),
+		fmt.Sprint(st.Leases),
+		fmt.Sprint(st.Revision),
+	})
+	return hdr, rows
+}
+
+func makeSnapshotImportTable(st snapshot.ImportStatus) (hdr []string, rows [][]string) {
+	hdr = []string{"keys", "leases", "hash", "revision", "total keys", "total size"}
+	rows = append(rows, []string{
+		fmt.Sprint(st.Keys),
+		fmt.Sprint(st.Leases),
+		fmt.Sprintf("%x", st.Status.Hash),
+		fmt.Sprint(st.Status.Revision),
+		fmt.Sprint(st.Status.TotalKey),
+		humanize.Bytes(uint64(st.Status.TotalSize)),
+	})
+	return hdr, rows
+}
+
+func makeAuthUnusedPermissionsTable(a AuthAnalysis) (hdr []string, rows [][]string) {
+	hdr = []string{"role", "permission", "key", "range end"}
+	for _, p := range a.UnusedPermissions {
+		rows = append(rows, []string{p.Role, p.PermType, p.Key, p.RangeEnd})
+	}
+	return hdr, rows
+}
+
+func makeAuthDeniedAccessesTable(a AuthAnalysis) (hdr []string, rows [][]string) {
+	hdr = []string{"user", "operation", "key", "range end", "reason", "count"}
+	for _, d := range a.DeniedAccesses {
+		rows = append(rows, []string{d.User, d.Op, d.Key, d.RangeEnd, d.Reason, fmt.Sprint(d.Count)})
+	}
+	return hdr, rows
+}
+
+func makeApplyDigestDivergenceTable(d ApplyDigestDiff) (hdr []string, rows [][]string) {
+	hdr = []string{"file", "request hash", "response hash", "revision"}
+	if d.Divergence == nil {
+		return hdr, rows
+	}
+	for i, r := range d.Divergence.Records {
+		if r == nil {
+			rows = append(rows, []string{d.Files[i], "missing", "missing", "missing"})
+			continue
+		}
+		rows = append(rows, []string{
+			d.Files[i],
+			fmt.Sprintf("%016x", r.RequestHash),
+			fmt.Sprintf("%016x", r.ResponseHash),
+			fmt.Sprint(r.Revision),
+		})
+	}
+	return hdr, rows
+}
+
+func makeCrossCheckTable(c CrossCheck) (hdr []string, rows [][]string) {
+	hdr = []string{"consistent index", "base index", "replayed", "revision", "replay revision", "compact revision", "compared", "hash", "replay hash"}
+	rows = append(rows, []string{
+		fmt.Sprint(c.ConsistentIndex),
+		fmt.Sprint(c.BaseIndex),
+		fmt.Sprint(c.Replayed),
+		fmt.Sprint(c.Revision),
+		fmt.Sprint(c.ReplayRevision),
+		fmt.Sprint(c.CompactRevision),
+		fmt.Sprint(c.Compared),
+		fmt.Sprint(c.Hash),
+		fmt.Sprint(c.ReplayHash),
+	})
+	return hdr, rows
+}
+
+func makeCrossCheckDivergenceTable(d *CrossCheckDivergence) (hdr []string, rows [][]string) {
+	hdr = []string{"source", "revision", "key", "create revision", "mod revision", "version", "lease", "value size"}
+	for _, r := range []struct {
+		source string
+		kv     *mvccpb.KeyValue
+	}{{"backend", d.Value}, {"replay", d.ReplayValue}} {
+		rev := fmt.Sprintf("%d_%d", d.Revision, d.SubRevision)
+		if r.kv == nil {
+			rows = append(rows, []string{r.source, rev, "missing", "", "", "", "", ""})
+			continue
+		}
+		rows = append(rows, []string{
+			r.source,
+			rev,
+			string(r.kv.Key),
+			fmt.Sprint(r.kv.CreateRevision),
+			fmt.Sprint(r.kv.ModRevision),
+			fmt.Sprint(r.kv.Version),
+			fmt.Sprintf("%x", r.kv.Lease),
+			fmt.Sprint(len(r.kv.Value)),
+		})
+	}
+	return hdr, rows
+}
+
+func makeMigrateFieldsTable(d MigrateDiff) (hdr []string, rows [][]string) {
+	hdr = []string{"bucket", "key", "before", "after", "changed"}
+	for _, f := range d.Fields {
+		rows = append(rows, []string{f.Bucket, f.Key, f.Before, f.After, fmt.Sprint(f.Changed)})
+	}
+	return hdr, rows
+}
+
+func makeMigrateBlockingEntriesTable(d MigrateDiff) (hdr []string, rows [][]string) {
+	hdr = []string{"index", "term", "version", "field"}
+	for _, e := range d.BlockingEntries {
+		rows = append(rows, []string{fmt.Sprint(e.Index), fmt.Sprint(e.Term), e.Version.String(), e.Field})
+	}
+	return hdr, rows
+}
+
 func initPrinterFromCmd(cmd *cobra.Command) (p printer) {
 	outputType, err := cmd.Flags().GetString("write-out")
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/storage/wal"
 )
 
 type fieldsPrinter struct{ printer }
@@ -30,6 +31,48 @@ func (p *fieldsPrinter) DBStatus(r snapshot.Status) {
 	fmt.Println(`"Version" :`, r.Version)
 }
 
+func (p *fieldsPrinter) DBCompaction(r Compaction) {
+	fmt.Println(`"Revision" :`, r.Revision)
+	fmt.Println(`"Previous revision" :`, r.PreviousRevision)
+	fmt.Println(`"Key revisions before" :`, r.KeyRevisionsBefore)
+	fmt.Println(`"Key revisions after" :`, r.KeyRevisionsAfter)
+	fmt.Println(`"Size" :`, r.Size)
+	fmt.Println(`"Size in use" :`, r.SizeInUse)
+	fmt.Println(`"Defragmented" :`, r.Defragmented)
+}
+
+func (p *fieldsPrinter) DBDefragEstimate(e DefragEstimate) {
+	fmt.Println(`"Size" :`, e.Size)
+	fmt.Println(`"Size in use" :`, e.SizeInUse)
+	fmt.Println(`"Expected savings" :`, e.Savings)
+}
+
+func (p *fieldsPrinter) WALRepair(r wal.RepairReport) {
+	fmt.Println(`"Path" :`, r.Path)
+	fmt.Println(`"Size" :`, r.Size)
+	fmt.Println(`"Corruption" :`, r.Corruption)
+	fmt.Println(`"Offset" :`, r.Offset)
+	fmt.Println(`"Removed bytes" :`, r.RemovedBytes)
+	fmt.Println(`"Backup" :`, r.Backup)
+}
+
+func (p *fieldsPrinter) Backup(b Backup) {
+	fmt.Println(`"Manifest" :`, b.Manifest)
+	fmt.Println(`"Files" :`, b.Files)
+	fmt.Println(`"Copied files" :`, b.CopiedFiles)
+	fmt.Println(`"Copied bytes" :`, b.CopiedBytes)
+	fmt.Println(`"Reused bytes" :`, b.ReusedBytes)
+}
+
+func (p *fieldsPrinter) RemoveMember(r RemovedMember) {
+	fmt.Println(`"ID" :`, r.ID)
+	fmt.Println(`"Local ID" :`, r.LocalID)
+	fmt.Println(`"Index" :`, r.Index)
+	fmt.Println(`"Term" :`, r.Term)
+	fmt.Println(`"Discarded entries" :`, r.DiscardedEntries)
+	fmt.Println(`"Backup" :`, r.Backup)
+}
+
 func (p *fieldsPrinter) DBHashKV(r HashKV) {
 	fmt.Println(`"Hash" :`, r.Hash)
 	fmt.Println(`"Hash revision" :`, r.HashRevision)
@@ -20,6 +20,7 @@ import (
 	"os"
 
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/storage/wal"
 )
 
 type jsonPrinter struct {
@@ -32,8 +33,22 @@ func newJSONPrinter() printer {
 	}
 }
 
-func (p *jsonPrinter) DBStatus(r snapshot.Status) { printJSON(r) }
-func (p *jsonPrinter) DBHashKV(r HashKV)          { printJSON(r) }
+func (p *jsonPrinter) DBStatus(r snapshot.Status)                  { printJSON(r) }
+func (p *jsonPrinter) DBHashKV(r HashKV)                           { printJSON(r) }
+func (p *jsonPrinter) DBCompaction(r Compaction)                   { printJSON(r) }
+func (p *jsonPrinter) DBDefragEstimate(r DefragEstimate)           { printJSON(r) }
+func (p *jsonPrinter) AuthAnalysis(r AuthAnalysis)                 { printJSON(r) }
+func (p *jsonPrinter) ApplyDigestDiff(r ApplyDigestDiff)           { printJSON(r) }
+func (p *jsonPrinter) SnapshotScrub(r snapshot.ScrubStatus)        { printJSON(r) }
+func (p *jsonPrinter) SnapshotTrimRevisions(r snapshot.TrimStatus) { printJSON(r) }
+func (p *jsonPrinter) SnapshotExport(r snapshot.ExportStatus)      { printJSON(r) }
+func (p *jsonPrinter) SnapshotImport(r snapshot.ImportStatus)      { printJSON(r) }
+func (p *jsonPrinter) CrossCheck(r CrossCheck)                     { printJSON(r) }
+func (p *jsonPrinter) WALRepair(r wal.RepairReport)                { printJSON(r) }
+func (p *jsonPrinter) Backup(r Backup)                             { printJSON(r) }
+func (p *jsonPrinter) Migrate(r MigrateDiff)                       { printJSON(r) }
+func (p *jsonPrinter) RemoveMember(r RemovedMember)                { printJSON(r) }
+func (p *jsonPrinter) Verify(r VerifyReport)                       { printJSON(r) }
 
 // !!! Share ??
 func printJSON(v any) {
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/storage/wal"
 )
 
 type simplePrinter struct{}
@@ -36,3 +37,137 @@ func (s *simplePrinter) DBHashKV(ds HashKV) {
 		fmt.Println(strings.Join(row, ", "))
 	}
 }
+
+func (s *simplePrinter) DBCompaction(c Compaction) {
+	_, rows := makeDBCompactionTable(c)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) DBDefragEstimate(e DefragEstimate) {
+	_, rows := makeDBDefragEstimateTable(e)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) WALRepair(r wal.RepairReport) {
+	_, rows := makeWALRepairTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) Backup(b Backup) {
+	_, rows := makeBackupTable(b)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) RemoveMember(r RemovedMember) {
+	_, rows := makeRemoveMemberTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) Verify(r VerifyReport) {
+	_, rows := makeVerifyTable(r)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) SnapshotScrub(st snapshot.ScrubStatus) {
+	_, rows := makeSnapshotScrubTable(st)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) SnapshotTrimRevisions(st snapshot.TrimStatus) {
+	_, rows := makeSnapshotTrimRevisionsTable(st)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) SnapshotExport(st snapshot.ExportStatus) {
+	_, rows := makeSnapshotExportTable(st)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) SnapshotImport(st snapshot.ImportStatus) {
+	_, rows := makeSnapshotImportTable(st)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) AuthAnalysis(a AuthAnalysis) {
+	fmt.Printf("Analyzed %d accesses\n", a.Accesses)
+	_, rows := makeAuthUnusedPermissionsTable(a)
+	fmt.Printf("\nUnused permissions (%d):\n", len(rows))
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+	_, rows = makeAuthDeniedAccessesTable(a)
+	fmt.Printf("\nDenied accesses (%d):\n", len(rows))
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) ApplyDigestDiff(d ApplyDigestDiff) {
+	fmt.Printf("Compared %d entries from index %d to %d\n", d.Compared, d.First, d.Last)
+	if d.Divergence == nil {
+		fmt.Println("No divergence")
+		return
+	}
+	fmt.Printf("\nDiverged at index %d (%s):\n", d.Divergence.Index, d.Divergence.Reason)
+	_, rows := makeApplyDigestDivergenceTable(d)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) CrossCheck(c CrossCheck) {
+	fmt.Printf("Replayed %d entries from index %d to %d\n", c.Replayed, c.BaseIndex+1, c.ConsistentIndex)
+	fmt.Printf("Compared %d key revisions after compact revision %d: hash %d, replay hash %d\n", c.Compared, c.CompactRevision, c.Hash, c.ReplayHash)
+	if c.Divergence == nil {
+		fmt.Println("No divergence")
+		return
+	}
+	d := c.Divergence
+	if d.Index != 0 {
+		fmt.Printf("\nDiverged at revision %d of key %q (%s), written by the entry of index %d:\n", d.Revision, d.Key, d.Reason, d.Index)
+	} else {
+		fmt.Printf("\nDiverged at revision %d of key %q (%s):\n", d.Revision, d.Key, d.Reason)
+	}
+	_, rows := makeCrossCheckDivergenceTable(d)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+}
+
+func (s *simplePrinter) Migrate(d MigrateDiff) {
+	fmt.Printf("Migrating storage version %s to %s\n", d.CurrentVersion, d.TargetVersion)
+	_, rows := makeMigrateFieldsTable(d)
+	for _, row := range rows {
+		fmt.Println(strings.Join(row, ", "))
+	}
+	if len(d.BlockingEntries) > 0 {
+		fmt.Printf("\n%d WAL entries are newer than %s:\n", len(d.BlockingEntries), d.TargetVersion)
+		_, rows = makeMigrateBlockingEntriesTable(d)
+		for _, row := range rows {
+			fmt.Println(strings.Join(row, ", "))
+		}
+	}
+	if d.Error != "" {
+		fmt.Printf("\nMigration fails: %s\n", d.Error)
+	}
+}
@@ -15,12 +15,14 @@
 package etcdutl
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/olekukonko/tablewriter/tw"
 
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/storage/wal"
 )
 
 type tablePrinter struct{ printer }
@@ -34,6 +36,18 @@ func (tp *tablePrinter) DBStatus(r snapshot.Status) {
 		table.Append(row)
 	}
 	table.Render()
+	if r.Buckets == nil {
+		return
+	}
+	for _, makeTable := range []func(snapshot.Status) ([]string, [][]string){makeDBStatusBucketsTable, makeDBStatusPrefixesTable} {
+		hdr, rows = makeTable(r)
+		table = tablewriter.NewTable(os.Stdout)
+		table.Header(hdr)
+		for _, row := range rows {
+			table.Append(row)
+		}
+		table.Render()
+	}
 }
 
 func (tp *tablePrinter) DBHashKV(r HashKV) {
@@ -46,3 +60,185 @@ func (tp *tablePrinter) DBHashKV(r HashKV) {
 	}
 	table.Render()
 }
+
+func (tp *tablePrinter) DBCompaction(c Compaction) {
+	hdr, rows := makeDBCompactionTable(c)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) DBDefragEstimate(e DefragEstimate) {
+	hdr, rows := makeDBDefragEstimateTable(e)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) WALRepair(r wal.RepairReport) {
+	hdr, rows := makeWALRepairTable(r)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) Backup(b Backup) {
+	hdr, rows := makeBackupTable(b)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) RemoveMember(r RemovedMember) {
+	hdr, rows := makeRemoveMemberTable(r)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) Verify(r VerifyReport) {
+	hdr, rows := makeVerifyTable(r)
+	table := tablewriter.NewTable(os.Stdout)
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) SnapshotScrub(st snapshot.ScrubStatus) {
+	hdr, rows := makeSnapshotScrubTable(st)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) SnapshotTrimRevisions(st snapshot.TrimStatus) {
+	hdr, rows := makeSnapshotTrimRevisionsTable(st)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) SnapshotExport(st snapshot.ExportStatus) {
+	hdr, rows := makeSnapshotExportTable(st)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) SnapshotImport(st snapshot.ImportStatus) {
+	hdr, rows := makeSnapshotImportTable(st)
+	cfgBuilder := tablewriter.NewConfigBuilder().WithRowAlignment(tw.AlignRight)
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithConfig(cfgBuilder.Build()))
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) AuthAnalysis(a AuthAnalysis) {
+	for _, makeTable := range []func(AuthAnalysis) ([]string, [][]string){makeAuthUnusedPermissionsTable, makeAuthDeniedAccessesTable} {
+		hdr, rows := makeTable(a)
+		table := tablewriter.NewTable(os.Stdout)
+		table.Header(hdr)
+		for _, row := range rows {
+			table.Append(row)
+		}
+		table.Render()
+	}
+}
+
+func (tp *tablePrinter) ApplyDigestDiff(d ApplyDigestDiff) {
+	table := tablewriter.NewTable(os.Stdout)
+	table.Header([]string{"first index", "last index", "compared", "divergence index", "reason"})
+	row := []string{fmt.Sprint(d.First), fmt.Sprint(d.Last), fmt.Sprint(d.Compared), "", ""}
+	if d.Divergence != nil {
+		row[3], row[4] = fmt.Sprint(d.Divergence.Index), d.Divergence.Reason
+	}
+	table.Append(row)
+	table.Render()
+	if d.Divergence == nil {
+		return
+	}
+	hdr, rows := makeApplyDigestDivergenceTable(d)
+	table = tablewriter.NewTable(os.Stdout)
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) CrossCheck(c CrossCheck) {
+	hdr, rows := makeCrossCheckTable(c)
+	table := tablewriter.NewTable(os.Stdout)
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+	if c.Divergence == nil {
+		return
+	}
+	hdr, rows = makeCrossCheckDivergenceTable(c.Divergence)
+	table = tablewriter.NewTable(os.Stdout)
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func (tp *tablePrinter) Migrate(d MigrateDiff) {
+	hdr, rows := makeMigrateFieldsTable(d)
+	table := tablewriter.NewTable(os.Stdout)
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+	if len(d.BlockingEntries) == 0 {
+		return
+	}
+	hdr, rows = makeMigrateBlockingEntriesTable(d)
+	table = tablewriter.NewTable(os.Stdout)
+	table.Header(hdr)
+	for _, row := range rows {
+		table.Append(row)
+	}
+	table.Render()
+}
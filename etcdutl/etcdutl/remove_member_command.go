@@ -0,0 +1,247 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+var (
+	removeMemberDataDir   string
+	removeMemberID        string
+	removeMemberBackupDir string
+)
+
+// NewRemoveMemberCommand returns the cobra command for "remove-member".
+func NewRemoveMemberCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-member",
+		Short: "Removes a member from the cluster of a data directory not in use by etcd",
+		Long: `Removes a member from the cluster of a data directory not in use by etcd, so that a member left
+without quorum, like the last one surviving of its cluster, can be reconfigured without
+--force-new-cluster, which removes all the other members.
+
+The removal is appended to the WAL as a committed configuration change, along with the
+uncommitted entries being discarded, and is applied by etcd when it starts. The WAL directory
+is backed up first, to --backup-dir.
+
+The member must not be the local one, nor the only voting member other than the learners. The
+removal is refused if the WAL has committed configuration changes etcd did not apply yet: start
+etcd to apply them first.
+`,
+		Args: cobra.NoArgs,
+		Run:  removeMemberCommandFunc,
+	}
+	cmd.Flags().StringVar(&removeMemberDataDir, "data-dir", "", "Required. Removes the member from the cluster of a data directory not in use by etcd.")
+	cmd.Flags().StringVar(&removeMemberID, "id", "", "Required. The hexadecimal ID of the member to remove.")
+	cmd.Flags().StringVar(&removeMemberBackupDir, "backup-dir", "", "The directory the WAL directory is backed up to, which must not exist. Defaults to the WAL directory suffixed by the time.")
+	cmd.MarkFlagRequired("data-dir")
+	cmd.MarkFlagRequired("id")
+	cmd.MarkFlagDirname("data-dir")
+	cmd.MarkFlagDirname("backup-dir")
+	return cmd
+}
+
+func removeMemberCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	id, err := types.IDFromString(removeMemberID)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --id %q: %w", removeMemberID, err))
+	}
+	r, err := RemoveMember(GetLogger(), removeMemberDataDir, removeMemberBackupDir, id)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError,
+			fmt.Errorf("Failed to remove member %s from etcd data[%s] (%w)", id, removeMemberDataDir, err))
+	}
+	printer.RemoveMember(r)
+}
+
+// RemovedMember is the result of the removal of a member from the cluster of a
+// data directory.
+type RemovedMember struct {
+	ID      string `json:"id"`
+	LocalID string `json:"localID"`
+	// Index and Term are the ones of the entry of the removal.
+	Index uint64 `json:"index"`
+	Term  uint64 `json:"term"`
+	// DiscardedEntries is the number of uncommitted entries discarded.
+	DiscardedEntries int `json:"discardedEntries"`
+	// Backup is the directory the WAL directory is backed up to.
+	Backup string `json:"backup"`
+}
+
+// RemoveMember removes the member of the id from the cluster of the data
+// directory, not in use by etcd, by appending the configuration change to its
+// WAL, after backing up the WAL directory to backupDir. backupDir defaults to
+// the WAL directory suffixed by the time.
+func RemoveMember(lg *zap.Logger, dataDir, backupDir string, id types.ID) (RemovedMember, error) {
+	r := RemovedMember{ID: id.String()}
+	walDir := datadir.ToWALDir(dataDir)
+	if backupDir == "" {
+		backupDir = walDir + "." + time.Now().UTC().Format("20060102T150405")
+	}
+	if fileutil.Exist(backupDir) {
+		return r, fmt.Errorf("backup directory %q exists", backupDir)
+	}
+
+	dbPath := datadir.ToBackendFileName(dataDir)
+	if err := checkDBNotInUse(dbPath); err != nil {
+		return r, err
+	}
+	be := backend.NewDefaultBackend(lg, dbPath)
+	defer be.Close()
+	members, removed := schema.NewMembershipBackend(lg, be).MustReadMembersFromBackend()
+	appliedIndex, _ := schema.ReadConsistentIndex(be.ReadTx())
+	m, ok := members[id]
+	switch {
+	case removed[id]:
+		return r, fmt.Errorf("member %s is already removed", id)
+	case !ok:
+		return r, fmt.Errorf("member %s not found", id)
+	}
+	if !m.IsLearner {
+		voters := 0
+		for _, m := range members {
+			if !m.IsLearner {
+				voters++
+			}
+		}
+		if voters == 1 {
+			return r, fmt.Errorf("member %s is the only voting member", id)
+		}
+	}
+
+	walSnap, err := getLatestWALSnap(lg, dataDir)
+	if err != nil {
+		return r, fmt.Errorf("failed to get the latest snapshot: %w", err)
+	}
+	rw, err := wal.OpenForRead(lg, walDir, walSnap)
+	if err != nil {
+		return r, fmt.Errorf("failed to open wal: %w", err)
+	}
+	metadata, st, ents, err := rw.ReadAll()
+	rw.Close()
+	if err != nil {
+		return r, fmt.Errorf("failed to read wal: %w", err)
+	}
+	var meta pb.Metadata
+	pbutil.MustUnmarshal(&meta, metadata)
+	local := types.ID(meta.NodeID)
+	r.LocalID = local.String()
+	if local == id {
+		return r, fmt.Errorf("member %s is the local member", id)
+	}
+	if lm, ok := members[local]; ok && lm.IsLearner {
+		return r, fmt.Errorf("local member %s is a learner", local)
+	}
+
+	// the entries applied to the backend are committed, even if the commit
+	// index of the hard state was not saved after them
+	commit := max(st.Commit, appliedIndex)
+	for _, e := range ents {
+		if e.Index > commit {
+			r.DiscardedEntries++
+			continue
+		}
+		if e.Index > appliedIndex && (e.Type == raftpb.EntryConfChange || e.Type == raftpb.EntryConfChangeV2) {
+			return r, fmt.Errorf("committed configuration change of index %d is not applied, start etcd to apply it first", e.Index)
+		}
+	}
+
+	cc := raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: uint64(id)}
+	e := raftpb.Entry{Type: raftpb.EntryConfChange, Term: st.Term, Index: commit + 1, Data: pbutil.MustMarshal(&cc)}
+	r.Index, r.Term = e.Index, e.Term
+
+	if err = copyDir(walDir, backupDir); err != nil {
+		return r, fmt.Errorf("failed to back up the WAL directory: %w", err)
+	}
+	r.Backup = backupDir
+	w, err := wal.Open(lg, walDir, walSnap)
+	if err != nil {
+		return r, fmt.Errorf("failed to open wal: %w", err)
+	}
+	defer w.Close()
+	if _, _, _, err = w.ReadAll(); err != nil {
+		return r, fmt.Errorf("failed to read wal: %w", err)
+	}
+	// saving the entry of the index following the commit index truncates the
+	// uncommitted entries
+	st.Commit = e.Index
+	if err = w.Save(st, []raftpb.Entry{e}); err != nil {
+		return r, fmt.Errorf("failed to save the removal to the wal: %w", err)
+	}
+	lg.Info(
+		"removed member",
+		zap.String("member-id", id.String()),
+		zap.String("local-member-id", local.String()),
+		zap.Uint64("index", e.Index),
+		zap.Int("discarded-entries", r.DiscardedEntries),
+	)
+	return r, nil
+}
+
+// checkDBNotInUse returns an error if etcd holds the lock of the db file.
+func checkDBNotInUse(dbPath string) error {
+	db, err := bolt.Open(dbPath, 0o600, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if errors.Is(err, bolterrors.ErrTimeout) {
+		return fmt.Errorf("db file %q is in use by etcd", dbPath)
+	}
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// copyDir copies the files of the directory src to the directory dst, which
+// must not exist.
+func copyDir(src, dst string) error {
+	files, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dst, fileutil.PrivateDirMode); err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		if _, err = backupFile(filepath.Join(src, f.Name()), filepath.Join(dst, f.Name()), f.Name(), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+)
+
+func TestRemoveMember(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "data")
+	cfg := embed.NewConfig()
+	cfg.LogLevel = "fatal"
+	cfg.Dir = dataDir
+	cfg.StrictReconfigCheck = false
+
+	// adding a member that never starts leaves the cluster without quorum
+	etcd, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+	local := etcd.Server.MemberID()
+	now := time.Now()
+	m := membership.NewMember("wedged", types.MustNewURLs([]string{"http://127.0.0.1:1"}), "etcd-cluster", &now)
+	_, err = etcd.Server.AddMember(t.Context(), *m)
+	require.NoError(t, err)
+	etcd.Close()
+
+	lg := zaptest.NewLogger(t)
+	_, err = RemoveMember(lg, dataDir, "", local)
+	require.ErrorContains(t, err, "is the local member")
+	_, err = RemoveMember(lg, dataDir, "", types.ID(42))
+	require.ErrorContains(t, err, "not found")
+
+	backupDir := filepath.Join(t.TempDir(), "wal")
+	r, err := RemoveMember(lg, dataDir, backupDir, m.ID)
+	require.NoError(t, err)
+	assert.Equal(t, m.ID.String(), r.ID)
+	assert.Equal(t, local.String(), r.LocalID)
+	assert.Equal(t, backupDir, r.Backup)
+	assert.True(t, fileutil.Exist(backupDir))
+
+	// the local member regains quorum
+	etcd, err = embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	select {
+	case <-etcd.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.FailNow()
+	}
+	_, err = etcd.Server.Put(t.Context(), &pb.PutRequest{Key: []byte("a"), Value: []byte("a")})
+	require.NoError(t, err)
+	members := etcd.Server.Cluster().Members()
+	require.Len(t, members, 1)
+	assert.Equal(t, local, members[0].ID)
+
+	_, err = RemoveMember(lg, dataDir, "", m.ID)
+	require.ErrorContains(t, err, "is in use")
+	etcd.Close()
+	_, err = RemoveMember(lg, dataDir, "", m.ID)
+	require.ErrorContains(t, err, "already removed")
+}
@@ -15,11 +15,15 @@
 package etcdutl
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"regexp"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/cobrautl"
 	"go.etcd.io/etcd/server/v3/storage/backend"
@@ -42,6 +46,27 @@ var (
 	initialMmapSize     = backend.InitialMmapSize
 	markCompacted       bool
 	revisionBump        uint64
+	filterPrefixes      []string
+	rewritePrefixes     []string
+	fetchOutputFile     string
+	fetchRetries        int
+
+	scrubOutputFile   string
+	scrubMatchPrefix  []string
+	scrubMatchRegex   []string
+	scrubDelete       bool
+	scrubReplaceValue string
+
+	trimOutputFile string
+
+	dumpFormat       string
+	exportOutputFile string
+	importOutputFile string
+
+	statusBreakdown       bool
+	statusTopPrefixes     int
+	statusPrefixDelimiter string
+	statusPrefixDepth     int
 )
 
 // NewSnapshotCommand returns the cobra command for "snapshot".
@@ -52,25 +77,47 @@ func NewSnapshotCommand() *cobra.Command {
 	}
 	cmd.AddCommand(NewSnapshotRestoreCommand())
 	cmd.AddCommand(newSnapshotStatusCommand())
+	cmd.AddCommand(newSnapshotScrubCommand())
+	cmd.AddCommand(newSnapshotTrimRevisionsCommand())
+	cmd.AddCommand(newSnapshotExportCommand())
+	cmd.AddCommand(newSnapshotImportCommand())
 	return cmd
 }
 
 func newSnapshotStatusCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "status <filename>",
 		Short: "Gets backend snapshot status of a given file",
 		Long: `When --write-out is set to simple, this command prints out comma-separated status lists for each endpoint.
 The items in the lists are hash, revision, total keys, total size.
+
+With --breakdown, and --write-out set to json or table, it also prints the number of keys and the size of each
+bucket, and the key prefixes having the most bytes, with their numbers of keys and of revisions. The prefix of a
+key is the key up to its --prefix-depth-th --prefix-delimiter, not counting a leading one.
 `,
 		Run: SnapshotStatusCommandFunc,
 	}
+	cmd.Flags().BoolVar(&statusBreakdown, "breakdown", false, "Print the size breakdown per bucket and per key prefix (--write-out=json or table)")
+	cmd.Flags().IntVar(&statusTopPrefixes, "top-prefixes", 10, "Number of key prefixes printed with --breakdown, the ones with the most bytes (0 to print all)")
+	cmd.Flags().StringVar(&statusPrefixDelimiter, "prefix-delimiter", "/", "Delimiter of the key prefixes of --breakdown")
+	cmd.Flags().IntVar(&statusPrefixDepth, "prefix-depth", 2, "Number of delimiters ending the key prefixes of --breakdown")
+	return cmd
 }
 
 func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "restore <filename> --data-dir {output dir} [options]",
+		Use:   "restore <filename | url | -> --data-dir {output dir} [options]",
 		Short: "Restores an etcd member snapshot to an etcd directory",
-		Run:   snapshotRestoreCommandFunc,
+		Long: `Restores an etcd member snapshot to an etcd directory.
+
+The snapshot is a file, an http or https URL, like a presigned URL of an object storage, or "-" to
+read it from stdin. A snapshot of a URL or of stdin is first fetched to --fetch-file, checkpointing
+the progress of the download to the file named after it with the ".checkpoint" suffix. A download
+interrupted by an error is resumed up to --fetch-retries times, and a restore run again with the
+same URL and --fetch-file resumes the download from its checkpoint. A snapshot read from stdin is
+never resumed. The fetched snapshot is removed once restored.
+`,
+		Run: snapshotRestoreCommandFunc,
 	}
 	cmd.Flags().StringVar(&restoreDataDir, "data-dir", "", "Path to the output data directory")
 	cmd.Flags().StringVar(&restoreWALDir, "wal-dir", "", "Path to the WAL directory (use --data-dir if none given)")
@@ -82,6 +129,10 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	cmd.Flags().Uint64Var(&initialMmapSize, "initial-memory-map-size", initialMmapSize, "Initial memory map size of the database in bytes. It uses the default value if not defined or defined to 0")
 	cmd.Flags().Uint64Var(&revisionBump, "bump-revision", 0, "How much to increase the latest revision after restore")
 	cmd.Flags().BoolVar(&markCompacted, "mark-compacted", false, "Mark the latest revision after restore as the point of scheduled compaction (required if --bump-revision > 0, disallowed otherwise)")
+	cmd.Flags().StringArrayVar(&filterPrefixes, "filter-prefix", nil, "Restore only the keys having the prefix, and the leases attached to them (can be repeated)")
+	cmd.Flags().StringArrayVar(&rewritePrefixes, "rewrite-prefix", nil, "Restore the keys having the prefix old to the prefix new, given as old=new (can be repeated, the first matching rewrite applies)")
+	cmd.Flags().StringVar(&fetchOutputFile, "fetch-file", "", "Path of the snapshot fetched from a URL or stdin (default: the data directory with the \".snapshot.db\" suffix)")
+	cmd.Flags().IntVar(&fetchRetries, "fetch-retries", 5, "Number of times the fetch of a snapshot from a URL interrupted by an error is resumed")
 
 	cmd.MarkFlagDirname("data-dir")
 	cmd.MarkFlagDirname("wal-dir")
@@ -89,6 +140,97 @@ func NewSnapshotRestoreCommand() *cobra.Command {
 	return cmd
 }
 
+func newSnapshotScrubCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scrub <filename> --output-file {output file} (--match-prefix {prefix} | --match-regex {regex}) (--delete | --replace-value {value})",
+		Short: "Writes a copy of a snapshot with the matching keys removed or their values replaced",
+		Long: `Writes a copy of a snapshot in which all the revisions of the keys having any of the --match-prefix prefixes
+or matching any of the --match-regex regular expressions are deleted with --delete, or have their values replaced
+with --replace-value, to produce sanitized data sets for support cases and test environments.
+
+The key-values are copied to a new db file, so that the scrubbed values do not remain in its free pages, and the
+integrity hash of the copy is appended to it so that it can be restored without --skip-hash-check.
+`,
+		Args: cobra.ExactArgs(1),
+		Run:  snapshotScrubCommandFunc,
+	}
+	cmd.Flags().StringVar(&scrubOutputFile, "output-file", "", "Required. Path to the scrubbed snapshot file, which must not exist")
+	cmd.Flags().StringArrayVar(&scrubMatchPrefix, "match-prefix", nil, "Prefix of the keys to scrub (can be repeated)")
+	cmd.Flags().StringArrayVar(&scrubMatchRegex, "match-regex", nil, "Regular expression matching the keys to scrub (can be repeated)")
+	cmd.Flags().BoolVar(&scrubDelete, "delete", false, "Delete all the revisions of the matching keys")
+	cmd.Flags().StringVar(&scrubReplaceValue, "replace-value", "", "Value replacing the values of the revisions of the matching keys")
+	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	cmd.MarkFlagRequired("output-file")
+	cmd.MarkFlagsOneRequired("match-prefix", "match-regex")
+	cmd.MarkFlagsOneRequired("delete", "replace-value")
+	cmd.MarkFlagsMutuallyExclusive("delete", "replace-value")
+	return cmd
+}
+
+func newSnapshotTrimRevisionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trim-revisions <filename> --output-file {output file}",
+		Short: "Writes a copy of a snapshot keeping only the latest revision of every key",
+		Long: `Writes a copy of a snapshot keeping only the latest revision of every key, without the history of the keys
+nor the deleted keys, to produce a minimal restore artifact. The copy is compacted at the latest revision of the
+snapshot, which is kept once restored.
+
+The key-values are copied to a new db file, and the integrity hash of the copy is appended to it so that it can be
+restored without --skip-hash-check.
+`,
+		Args: cobra.ExactArgs(1),
+		Run:  snapshotTrimRevisionsCommandFunc,
+	}
+	cmd.Flags().StringVar(&trimOutputFile, "output-file", "", "Required. Path to the trimmed snapshot file, which must not exist")
+	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	cmd.MarkFlagRequired("output-file")
+	return cmd
+}
+
+func newSnapshotExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <filename> [--format jsonl|sql] [--output-file {output file}]",
+		Short: "Writes a dump of the latest revision of the keys of a snapshot, and of its leases",
+		Long: `Writes a text dump of the latest revision of every key of a snapshot, without the history of the keys nor
+the deleted keys, and of its leases, for audits and migrations between etcd and other stores.
+
+With --format jsonl, the dump is a JSON object per line: a header holding the revision of the snapshot, then a
+"kv" object per key-value, of base64 encoded key and value, and a "lease" object per lease. With --format sql,
+the dump is SQL statements creating and filling the etcd_meta, etcd_kv and etcd_lease tables, the keys and
+values being blob literals, one statement per line.
+
+The create and mod revisions, versions and leases of the key-values are dumped as they are, so that
+'etcdutl snapshot import' recreates them losslessly.
+`,
+		Args: cobra.ExactArgs(1),
+		Run:  snapshotExportCommandFunc,
+	}
+	cmd.Flags().StringVar(&dumpFormat, "format", snapshot.DumpFormatJSONL, "Format of the dump (jsonl, sql)")
+	cmd.Flags().StringVar(&exportOutputFile, "output-file", "-", "Path to the dump file, which must not exist, or \"-\" for stdout")
+	cmd.Flags().BoolVar(&skipHashCheck, "skip-hash-check", false, "Ignore snapshot integrity hash value (required if copied from data directory)")
+	return cmd
+}
+
+func newSnapshotImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <dump file | -> --output-file {output file} [--format jsonl|sql]",
+		Short: "Writes a snapshot of the key-values and of the leases of a dump",
+		Long: `Writes a snapshot of the key-values and of the leases of a dump written by 'etcdutl snapshot export', read
+from a file or from stdin with "-", recreating the key-values with their revisions, versions and leases.
+
+The snapshot has no history of the keys, and is compacted at the revision of the dump, which is kept once
+restored. The integrity hash of the snapshot is appended to it so that it can be restored without
+--skip-hash-check.
+`,
+		Args: cobra.ExactArgs(1),
+		Run:  snapshotImportCommandFunc,
+	}
+	cmd.Flags().StringVar(&dumpFormat, "format", snapshot.DumpFormatJSONL, "Format of the dump (jsonl, sql)")
+	cmd.Flags().StringVar(&importOutputFile, "output-file", "", "Required. Path to the snapshot file, which must not exist")
+	cmd.MarkFlagRequired("output-file")
+	return cmd
+}
+
 func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 	if len(args) != 1 {
 		err := fmt.Errorf("snapshot status requires exactly one argument")
@@ -98,16 +240,136 @@ func SnapshotStatusCommandFunc(cmd *cobra.Command, args []string) {
 
 	lg := GetLogger()
 	sp := snapshot.NewV3(lg)
-	ds, err := sp.Status(args[0])
+	var ds snapshot.Status
+	var err error
+	if statusBreakdown {
+		if outputType, _ := cmd.Flags().GetString("write-out"); outputType != "json" && outputType != "table" {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("--breakdown requires --write-out=json or table"))
+		}
+		ds, err = sp.StatusBreakdown(args[0], snapshot.BreakdownConfig{
+			TopPrefixes:     statusTopPrefixes,
+			PrefixDelimiter: statusPrefixDelimiter,
+			PrefixDepth:     statusPrefixDepth,
+		})
+	} else {
+		ds, err = sp.Status(args[0])
+	}
 	if err != nil {
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
 	printer.DBStatus(ds)
 }
 
+func snapshotScrubCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	regexes := make([]*regexp.Regexp, len(scrubMatchRegex))
+	for i, r := range scrubMatchRegex {
+		re, err := regexp.Compile(r)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --match-regex %q: %w", r, err))
+		}
+		regexes[i] = re
+	}
+
+	lg := GetLogger()
+	sp := snapshot.NewV3(lg)
+	st, err := sp.Scrub(snapshot.ScrubConfig{
+		SnapshotPath:  args[0],
+		OutputPath:    scrubOutputFile,
+		MatchPrefixes: scrubMatchPrefix,
+		MatchRegexes:  regexes,
+		Delete:        scrubDelete,
+		ReplaceValue:  []byte(scrubReplaceValue),
+		SkipHashCheck: skipHashCheck,
+	})
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.SnapshotScrub(st)
+}
+
+func snapshotTrimRevisionsCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	lg := GetLogger()
+	sp := snapshot.NewV3(lg)
+	st, err := sp.TrimRevisions(snapshot.TrimConfig{
+		SnapshotPath:  args[0],
+		OutputPath:    trimOutputFile,
+		SkipHashCheck: skipHashCheck,
+	})
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.SnapshotTrimRevisions(st)
+}
+
+func snapshotExportCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	lg := GetLogger()
+	sp := snapshot.NewV3(lg)
+	cfg := snapshot.ExportConfig{
+		SnapshotPath:  args[0],
+		Format:        dumpFormat,
+		SkipHashCheck: skipHashCheck,
+	}
+	if exportOutputFile == "-" {
+		// the status would be mixed with the dump
+		if _, err := sp.Export(cfg, os.Stdout); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		return
+	}
+
+	f, err := os.OpenFile(exportOutputFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fileutil.PrivateFileMode)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	st, err := sp.Export(cfg, f)
+	if err == nil {
+		err = fileutil.Fsync(f)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(exportOutputFile)
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.SnapshotExport(st)
+}
+
+func snapshotImportCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	r := os.Stdin
+	if args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	lg := GetLogger()
+	sp := snapshot.NewV3(lg)
+	st, err := sp.Import(snapshot.ImportConfig{
+		Format:     dumpFormat,
+		OutputPath: importOutputFile,
+	}, r)
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError, err)
+	}
+	printer.SnapshotImport(st)
+}
+
 func snapshotRestoreCommandFunc(_ *cobra.Command, args []string) {
 	SnapshotRestoreCommandFunc(restoreCluster, restoreClusterToken, restoreDataDir, restoreWALDir,
-		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted, args)
+		restorePeerURLs, restoreName, skipHashCheck, initialMmapSize, revisionBump, markCompacted,
+		filterPrefixes, rewritePrefixes, fetchOutputFile, fetchRetries, args)
 }
 
 func SnapshotRestoreCommandFunc(restoreCluster string,
@@ -120,6 +382,10 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	initialMmapSize uint64,
 	revisionBump uint64,
 	markCompacted bool,
+	filterPrefixes []string,
+	rewritePrefixes []string,
+	fetchOutputFile string,
+	fetchRetries int,
 	args []string,
 ) {
 	if len(args) != 1 {
@@ -132,6 +398,15 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		cobrautl.ExitWithError(cobrautl.ExitBadArgs, err)
 	}
 
+	rewrites := make([]snapshot.PrefixRewrite, len(rewritePrefixes))
+	for i, rp := range rewritePrefixes {
+		rw, err := snapshot.ParsePrefixRewrite(rp)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --rewrite-prefix: %w", err))
+		}
+		rewrites[i] = rw
+	}
+
 	dataDir := restoreDataDir
 	if dataDir == "" {
 		dataDir = restoreName + ".etcd"
@@ -145,8 +420,36 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 	lg := GetLogger()
 	sp := snapshot.NewV3(lg)
 
+	snapshotPath, fetched := args[0], ""
+	if snapshot.IsFetchSource(snapshotPath) {
+		// checked before fetching a snapshot only to fail restoring it
+		if fileutil.Exist(dataDir) && !fileutil.DirEmpty(dataDir) {
+			cobrautl.ExitWithError(cobrautl.ExitError, fmt.Errorf("data-dir %q not empty or could not be read", dataDir))
+		}
+		if fetchOutputFile == "" {
+			fetchOutputFile = dataDir + ".snapshot.db"
+		}
+		if err := sp.Fetch(context.Background(), snapshot.FetchConfig{
+			Source:     snapshotPath,
+			OutputPath: fetchOutputFile,
+			Retries:    fetchRetries,
+		}); err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		snapshotPath, fetched = fetchOutputFile, fetchOutputFile
+	}
+	if IsBackupManifest(snapshotPath) {
+		// the db file of a backup is copied from a data directory, without
+		// the integrity hash of a snapshot, and verified against its manifest
+		dbPath, err := BackupDBPath(snapshotPath)
+		if err != nil {
+			cobrautl.ExitWithError(cobrautl.ExitError, err)
+		}
+		snapshotPath, skipHashCheck = dbPath, true
+	}
+
 	if err := sp.Restore(snapshot.RestoreConfig{
-		SnapshotPath:        args[0],
+		SnapshotPath:        snapshotPath,
 		Name:                restoreName,
 		OutputDataDir:       dataDir,
 		OutputWALDir:        walDir,
@@ -157,9 +460,18 @@ func SnapshotRestoreCommandFunc(restoreCluster string,
 		InitialMmapSize:     initialMmapSize,
 		RevisionBump:        revisionBump,
 		MarkCompacted:       markCompacted,
+		FilterPrefixes:      filterPrefixes,
+		RewritePrefixes:     rewrites,
 	}); err != nil {
+		if fetched != "" {
+			err = fmt.Errorf("%w (the fetched snapshot %q is kept, remove the data directory to restore it again)", err, fetched)
+		}
 		cobrautl.ExitWithError(cobrautl.ExitError, err)
 	}
+	if fetched != "" {
+		os.Remove(fetched)
+		os.Remove(snapshot.FetchCheckpointPath(fetched))
+	}
 }
 
 func initialClusterFromName(name string) string {
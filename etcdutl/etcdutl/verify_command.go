@@ -0,0 +1,305 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/server/v3/verify"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+var (
+	verifyDataDir string
+	verifyWALDir  string
+)
+
+// The checks of "verify", in the order they are run.
+const (
+	verifyCheckWAL             = "wal"
+	verifyCheckSnapshot        = "snapshot"
+	verifyCheckBackend         = "backend"
+	verifyCheckConsistentIndex = "consistent-index"
+	verifyCheckMembership      = "membership"
+)
+
+// NewVerifyCommand returns the cobra command for "verify".
+func NewVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verifies the WAL, the snapshots and the backend of a data dir not in use by etcd",
+		Long: `Verifies a data dir not in use by etcd, running the following checks:
+
+  wal               the CRCs of the WAL records, and the snapshot entries of the WAL
+  snapshot          the CRCs of the snap files, and that the latest snapshot entry of the WAL has one
+  backend           the page checksums and the structure of the bbolt backend
+  consistent-index  the consistent index and term of the backend against the hard state of the WAL
+  membership        the members of the backend against its raft configuration
+
+A check is skipped, and reported failed, if a check it depends on failed. The data dir is not
+modified. Exits with a non-zero status if any check fails.
+`,
+		Args: cobra.NoArgs,
+		Run:  verifyCommandFunc,
+	}
+	cmd.Flags().StringVar(&verifyDataDir, "data-dir", "", "Required. Path to the etcd data dir")
+	cmd.Flags().StringVar(&verifyWALDir, "wal-dir", "", "Path to the WAL directory, if not the default one of the data dir")
+	cmd.MarkFlagRequired("data-dir")
+	cmd.MarkFlagDirname("data-dir")
+	cmd.MarkFlagDirname("wal-dir")
+	return cmd
+}
+
+func verifyCommandFunc(cmd *cobra.Command, _ []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	walDir := verifyWALDir
+	if walDir == "" {
+		walDir = datadir.ToWALDir(verifyDataDir)
+	}
+	r := VerifyDataDir(GetLogger(), verifyDataDir, walDir)
+	printer.Verify(r)
+	if !r.OK() {
+		os.Exit(cobrautl.ExitError)
+	}
+}
+
+// VerifyReport is the result of the verification of a data dir.
+type VerifyReport struct {
+	DataDir string        `json:"dataDir"`
+	WALDir  string        `json:"walDir"`
+	Checks  []VerifyCheck `json:"checks"`
+}
+
+// OK returns whether all the checks passed.
+func (r VerifyReport) OK() bool {
+	for _, c := range r.Checks {
+		if !c.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyCheck is the result of a check of the verification of a data dir.
+type VerifyCheck struct {
+	Name string `json:"name"`
+	OK   bool   `json:"ok"`
+	// Detail describes what was checked.
+	Detail string `json:"detail,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// VerifyDataDir runs the checks of the data dir, not in use by etcd, and of
+// its WAL directory. It does not modify them.
+func VerifyDataDir(lg *zap.Logger, dataDir, walDir string) VerifyReport {
+	r := VerifyReport{DataDir: dataDir, WALDir: walDir}
+	dbPath := datadir.ToBackendFileName(dataDir)
+
+	var (
+		walSnaps  []walpb.Snapshot
+		hardstate *raftpb.HardState
+	)
+	walCheck := runVerifyCheck(verifyCheckWAL, func() (string, error) {
+		var err error
+		if walSnaps, err = wal.ValidSnapshotEntries(lg, walDir); err != nil {
+			return "", err
+		}
+		if hardstate, err = wal.Verify(lg, walDir, walSnaps[len(walSnaps)-1]); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d snapshot entries, term %d, commit index %d", len(walSnaps), hardstate.Term, hardstate.Commit), nil
+	})
+	r.Checks = append(r.Checks, walCheck)
+
+	if walCheck.OK {
+		r.Checks = append(r.Checks, runVerifyCheck(verifyCheckSnapshot, func() (string, error) {
+			return verifySnapFiles(lg, datadir.ToSnapDir(dataDir), walSnaps[len(walSnaps)-1])
+		}))
+	} else {
+		r.Checks = append(r.Checks, skippedVerifyCheck(verifyCheckSnapshot, verifyCheckWAL))
+	}
+
+	backendCheck := runVerifyCheck(verifyCheckBackend, func() (string, error) {
+		return verifyBackendPages(dbPath)
+	})
+	r.Checks = append(r.Checks, backendCheck)
+	if !backendCheck.OK {
+		r.Checks = append(r.Checks,
+			skippedVerifyCheck(verifyCheckConsistentIndex, verifyCheckBackend),
+			skippedVerifyCheck(verifyCheckMembership, verifyCheckBackend),
+		)
+		return r
+	}
+
+	be := backend.NewDefaultBackend(lg, dbPath)
+	defer be.Close()
+	if walCheck.OK {
+		r.Checks = append(r.Checks, runVerifyCheck(verifyCheckConsistentIndex, func() (string, error) {
+			walSnap := walSnaps[len(walSnaps)-1]
+			if err := verify.ValidateConsistentIndex(verify.Config{Logger: lg}, hardstate, &walSnap, be); err != nil {
+				return "", err
+			}
+			index, term := schema.ReadConsistentIndex(be.ReadTx())
+			return fmt.Sprintf("consistent index %d, term %d", index, term), nil
+		}))
+	} else {
+		r.Checks = append(r.Checks, skippedVerifyCheck(verifyCheckConsistentIndex, verifyCheckWAL))
+	}
+	r.Checks = append(r.Checks, runVerifyCheck(verifyCheckMembership, func() (string, error) {
+		return verifyMembership(lg, be)
+	}))
+	return r
+}
+
+// runVerifyCheck runs the check f, returning the description of what it
+// checked, recovering from the panics of reading corrupt data.
+func runVerifyCheck(name string, f func() (string, error)) (c VerifyCheck) {
+	c.Name = name
+	defer func() {
+		if p := recover(); p != nil {
+			c.OK, c.Detail, c.Error = false, "", fmt.Sprint(p)
+		}
+	}()
+	detail, err := f()
+	if err != nil {
+		c.Error = err.Error()
+		return c
+	}
+	c.OK, c.Detail = true, detail
+	return c
+}
+
+func skippedVerifyCheck(name, dependency string) VerifyCheck {
+	return VerifyCheck{Name: name, Error: fmt.Sprintf("skipped: the %s check failed", dependency)}
+}
+
+// verifySnapFiles checks the CRCs of the snap files of snapDir, and that
+// the snapshot entry walSnap has one, unless it is the initial empty one.
+func verifySnapFiles(lg *zap.Logger, snapDir string, walSnap walpb.Snapshot) (string, error) {
+	names, err := filepath.Glob(filepath.Join(snapDir, "*.snap"))
+	if err != nil {
+		return "", err
+	}
+	var (
+		errs  []error
+		found bool
+	)
+	for _, name := range names {
+		s, err := snap.Read(lg, name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(name), err))
+			continue
+		}
+		if s.Metadata.Index == walSnap.Index && s.Metadata.Term == walSnap.Term {
+			found = true
+		}
+	}
+	if !found && walSnap.Index > 0 {
+		errs = append(errs, fmt.Errorf("no valid snap file of the latest snapshot entry of the WAL, of index %d and term %d", walSnap.Index, walSnap.Term))
+	}
+	if err = errors.Join(errs...); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d snap files, latest snapshot index %d", len(names), walSnap.Index), nil
+}
+
+// verifyBackendPages checks the page checksums and the structure of the
+// bbolt db file.
+func verifyBackendPages(dbPath string) (string, error) {
+	if err := checkDBNotInUse(dbPath); err != nil {
+		return "", err
+	}
+	db, err := bolt.Open(dbPath, 0o400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	var (
+		errs []string
+		size int64
+	)
+	err = db.View(func(tx *bolt.Tx) error {
+		for err := range tx.Check() {
+			errs = append(errs, err.Error())
+		}
+		size = tx.Size()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(errs) > 0 {
+		return "", fmt.Errorf("%d errors found: %s", len(errs), strings.Join(errs, "; "))
+	}
+	return fmt.Sprintf("%d bytes", size), nil
+}
+
+// verifyMembership checks that the members of the backend are the voters and
+// the learners of its raft configuration.
+func verifyMembership(lg *zap.Logger, be backend.Backend) (string, error) {
+	members, removed := schema.NewMembershipBackend(lg, be).MustReadMembersFromBackend()
+	tx := be.ReadTx()
+	tx.RLock()
+	confState := schema.UnsafeConfStateFromBackend(lg, tx)
+	tx.RUnlock()
+	if confState == nil {
+		return fmt.Sprintf("%d members, no raft configuration in the backend", len(members)), nil
+	}
+
+	var errs []error
+	for id, m := range members {
+		switch {
+		case m.IsLearner && !slices.Contains(confState.Learners, uint64(id)):
+			errs = append(errs, fmt.Errorf("learner member %s is not a learner of the raft configuration", id))
+		case !m.IsLearner && !slices.Contains(confState.Voters, uint64(id)):
+			errs = append(errs, fmt.Errorf("member %s is not a voter of the raft configuration", id))
+		}
+	}
+	for _, ids := range [][]uint64{confState.Voters, confState.Learners} {
+		for _, id := range ids {
+			if _, ok := members[types.ID(id)]; !ok {
+				errs = append(errs, fmt.Errorf("node %s of the raft configuration is not a member", types.ID(id)))
+			}
+		}
+	}
+	for id := range removed {
+		if slices.Contains(confState.Voters, uint64(id)) || slices.Contains(confState.Learners, uint64(id)) {
+			errs = append(errs, fmt.Errorf("removed member %s is in the raft configuration", id))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d voters, %d learners", len(confState.Voters), len(confState.Learners)), nil
+}
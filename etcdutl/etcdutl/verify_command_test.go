@@ -0,0 +1,69 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestVerifyDataDir(t *testing.T) {
+	dataDir, _ := createCrossCheckDataDir(t)
+	walDir := filepath.Join(dataDir, "member", "wal")
+
+	r := VerifyDataDir(zap.NewNop(), dataDir, walDir)
+	var names []string
+	for _, c := range r.Checks {
+		assert.Truef(t, c.OK, "check %s failed: %s", c.Name, c.Error)
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"wal", "snapshot", "backend", "consistent-index", "membership"}, names)
+	assert.True(t, r.OK())
+
+	// a consistent index ahead of the WAL
+	be := backend.NewDefaultBackend(zap.NewNop(), filepath.Join(dataDir, "member", "snap", "db"))
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	schema.UnsafeUpdateConsistentIndexForce(tx, 1<<20, 1)
+	tx.Unlock()
+	be.ForceCommit()
+	require.NoError(t, be.Close())
+
+	r = VerifyDataDir(zap.NewNop(), dataDir, walDir)
+	require.False(t, r.OK())
+	for _, c := range r.Checks {
+		if c.Name == verifyCheckConsistentIndex {
+			assert.False(t, c.OK)
+			assert.Contains(t, c.Error, "must be <= WAL.HardState.commit")
+			continue
+		}
+		assert.Truef(t, c.OK, "check %s failed: %s", c.Name, c.Error)
+	}
+
+	// a WAL that cannot be read skips the checks depending on it
+	r = VerifyDataDir(zap.NewNop(), dataDir, filepath.Join(t.TempDir(), "missing"))
+	require.False(t, r.OK())
+	assert.False(t, r.Checks[0].OK)
+	assert.Contains(t, r.Checks[1].Error, "skipped")
+	assert.True(t, r.Checks[2].OK)
+	assert.Contains(t, r.Checks[3].Error, "skipped")
+}
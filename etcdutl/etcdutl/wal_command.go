@@ -0,0 +1,92 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdutl
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/cobrautl"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+)
+
+var (
+	walRepairDataDir string
+	walRepairWALDir  string
+	walRepairMode    string
+	walRepairDryRun  bool
+)
+
+// NewWALCommand returns the cobra command for "wal".
+func NewWALCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wal <subcommand>",
+		Short: "WAL related commands",
+	}
+	cmd.AddCommand(newWALRepairCommand())
+	return cmd
+}
+
+func newWALRepairCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Repairs the corrupt tail of the WAL of a data directory not in use by etcd",
+		Long: `Repairs the last WAL file of a data directory not in use by etcd, removing its bytes from its
+first corrupt record, as etcd does on startup for a torn write only.
+
+The modes are:
+
+  truncate   truncates the WAL file at its first corrupt record, torn by a partial write or
+             failing its CRC check. The entries following the corrupt record are lost.
+  zero-fill  zero-fills the torn tail of the WAL file, keeping its size. The other corruptions
+             are not repaired.
+
+The removed bytes are always written to the backup file named after the WAL file with the
+".broken" suffix. The corruptions of the other WAL files cannot be repaired.
+`,
+		Args: cobra.NoArgs,
+		Run:  walRepairCommandFunc,
+	}
+	cmd.Flags().StringVar(&walRepairDataDir, "data-dir", "", "Required. Repairs the WAL of a data directory not in use by etcd.")
+	cmd.Flags().StringVar(&walRepairWALDir, "wal-dir", "", "Path to the WAL directory, if not the default one of the data dir")
+	cmd.Flags().StringVar(&walRepairMode, "mode", string(wal.RepairTruncate), "The way the corrupt tail is removed: truncate or zero-fill")
+	cmd.Flags().BoolVar(&walRepairDryRun, "dry-run", false, "Reports the corruption and the bytes to remove without repairing.")
+	cmd.MarkFlagRequired("data-dir")
+	cmd.MarkFlagDirname("data-dir")
+	cmd.MarkFlagDirname("wal-dir")
+	return cmd
+}
+
+func walRepairCommandFunc(cmd *cobra.Command, args []string) {
+	printer := initPrinterFromCmd(cmd)
+
+	mode := wal.RepairMode(walRepairMode)
+	if mode != wal.RepairTruncate && mode != wal.RepairZeroFill {
+		cobrautl.ExitWithError(cobrautl.ExitBadArgs, fmt.Errorf("invalid --mode %q, expected truncate or zero-fill", walRepairMode))
+	}
+	walDir := walRepairWALDir
+	if walDir == "" {
+		walDir = datadir.ToWALDir(walRepairDataDir)
+	}
+
+	r, err := wal.RepairWithOptions(GetLogger(), walDir, wal.RepairOptions{Mode: mode, DryRun: walRepairDryRun})
+	if err != nil {
+		cobrautl.ExitWithError(cobrautl.ExitError,
+			fmt.Errorf("Failed to repair the WAL of etcd data[%s] (%w)", walRepairDataDir, err))
+	}
+	printer.WALRepair(r)
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"sort"
+	"strings"
+)
+
+// BreakdownConfig configures the size breakdown of snapshot status.
+type BreakdownConfig struct {
+	// TopPrefixes is the number of key prefixes reported, the ones with the
+	// most bytes. All of them are reported if it is not positive.
+	TopPrefixes int
+	// PrefixDelimiter and PrefixDepth define the prefix of a key: the key up
+	// to its PrefixDepth-th PrefixDelimiter, not counting a leading one. The
+	// prefix of a key having fewer delimiters is the key up to its last one,
+	// or the key itself if it has none.
+	PrefixDelimiter string
+	PrefixDepth     int
+}
+
+// BucketStatus is the status of a bucket of a snapshot file.
+type BucketStatus struct {
+	Name string `json:"name"`
+	Keys int    `json:"keys"`
+	// Size is the total size of the keys and values of the bucket.
+	Size int64 `json:"size"`
+}
+
+// PrefixStatus is the status of the keys of a snapshot file having a prefix.
+type PrefixStatus struct {
+	Prefix string `json:"prefix"`
+	// Keys is the number of keys not deleted, Revisions the number of their
+	// revisions not compacted, including the deletions, and Size the total
+	// size of the revisions.
+	Keys      int   `json:"keys"`
+	Revisions int   `json:"revisions"`
+	Size      int64 `json:"size"`
+}
+
+// prefix returns the prefix of key.
+func (cfg BreakdownConfig) prefix(key string) string {
+	if cfg.PrefixDelimiter == "" {
+		return key
+	}
+	end, i := 0, 0
+	if strings.HasPrefix(key, cfg.PrefixDelimiter) {
+		i = len(cfg.PrefixDelimiter)
+		end = i
+	}
+	for depth := 0; depth < cfg.PrefixDepth; depth++ {
+		j := strings.Index(key[i:], cfg.PrefixDelimiter)
+		if j < 0 {
+			break
+		}
+		i += j + len(cfg.PrefixDelimiter)
+		end = i
+	}
+	if end == 0 {
+		return key
+	}
+	return key[:end]
+}
+
+// breakdown accumulates the sizes of the buckets and key prefixes of a
+// snapshot file.
+type breakdown struct {
+	cfg      BreakdownConfig
+	buckets  []BucketStatus
+	prefixes map[string]*PrefixStatus
+}
+
+func newBreakdown(cfg BreakdownConfig) *breakdown {
+	return &breakdown{cfg: cfg, prefixes: make(map[string]*PrefixStatus)}
+}
+
+func (b *breakdown) addBucket(name []byte) {
+	b.buckets = append(b.buckets, BucketStatus{Name: string(name)})
+}
+
+func (b *breakdown) addKey(k, v []byte) {
+	bs := &b.buckets[len(b.buckets)-1]
+	bs.Keys++
+	bs.Size += int64(len(k) + len(v))
+}
+
+// addRevision adds a revision of key, of size bytes in the key bucket.
+func (b *breakdown) addRevision(key string, size int) {
+	p := b.cfg.prefix(key)
+	ps, ok := b.prefixes[p]
+	if !ok {
+		ps = &PrefixStatus{Prefix: p}
+		b.prefixes[p] = ps
+	}
+	ps.Revisions++
+	ps.Size += int64(size)
+}
+
+// finish sets the breakdown of ds, given the keys not deleted.
+func (b *breakdown) finish(ds *Status, keys map[string]struct{}) {
+	for key := range keys {
+		b.prefixes[b.cfg.prefix(key)].Keys++
+	}
+	ds.Buckets = b.buckets
+	ds.Prefixes = make([]PrefixStatus, 0, len(b.prefixes))
+	for _, ps := range b.prefixes {
+		ds.Prefixes = append(ds.Prefixes, *ps)
+	}
+	sort.Slice(ds.Prefixes, func(i, j int) bool {
+		pi, pj := ds.Prefixes[i], ds.Prefixes[j]
+		if pi.Size != pj.Size {
+			return pi.Size > pj.Size
+		}
+		if pi.Revisions != pj.Revisions {
+			return pi.Revisions > pj.Revisions
+		}
+		return pi.Prefix < pj.Prefix
+	})
+	if b.cfg.TopPrefixes > 0 && len(ds.Prefixes) > b.cfg.TopPrefixes {
+		ds.Prefixes = ds.Prefixes[:b.cfg.TopPrefixes]
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestBreakdownPrefix(t *testing.T) {
+	tests := []struct {
+		key   string
+		depth int
+		want  string
+	}{
+		{key: "/registry/pods/default/a", depth: 2, want: "/registry/pods/"},
+		{key: "/registry/pods/default/a", depth: 1, want: "/registry/"},
+		{key: "registry/pods/a", depth: 2, want: "registry/pods/"},
+		{key: "/registry/a", depth: 2, want: "/registry/"},
+		{key: "/a", depth: 2, want: "/"},
+		{key: "a", depth: 2, want: "a"},
+		{key: "a/b", depth: 0, want: "a/b"},
+	}
+	for _, tt := range tests {
+		cfg := BreakdownConfig{PrefixDelimiter: "/", PrefixDepth: tt.depth}
+		assert.Equalf(t, tt.want, cfg.prefix(tt.key), "prefix of %q at depth %d", tt.key, tt.depth)
+	}
+}
+
+func TestSnapshotStatusBreakdown(t *testing.T) {
+	dbpath := createDB(t, func(srv *etcdserver.EtcdServer) {
+		put := func(key string, size int) {
+			_, err := srv.Put(t.Context(), &etcdserverpb.PutRequest{Key: []byte(key), Value: make([]byte, size)})
+			require.NoError(t, err)
+		}
+		put("/a/x/1", 100)
+		put("/a/x/1", 100)
+		put("/a/x/2", 100)
+		put("/a/y/1", 10)
+		_, err := srv.DeleteRange(t.Context(), &etcdserverpb.DeleteRangeRequest{Key: []byte("/a/y/1")})
+		require.NoError(t, err)
+		put("b", 1)
+	})
+
+	status, err := NewV3(zap.NewNop()).StatusBreakdown(dbpath, BreakdownConfig{
+		TopPrefixes:     2,
+		PrefixDelimiter: "/",
+		PrefixDepth:     2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, status.TotalKey)
+
+	var keyBucket *BucketStatus
+	for i, b := range status.Buckets {
+		if b.Name == string(schema.Key.Name()) {
+			keyBucket = &status.Buckets[i]
+		}
+	}
+	require.NotNil(t, keyBucket)
+	assert.Equal(t, 6, keyBucket.Keys)
+
+	require.Len(t, status.Prefixes, 2)
+	assert.Equal(t, "/a/x/", status.Prefixes[0].Prefix)
+	assert.Equal(t, 2, status.Prefixes[0].Keys)
+	assert.Equal(t, 3, status.Prefixes[0].Revisions)
+	assert.Greater(t, status.Prefixes[0].Size, int64(300))
+	assert.Equal(t, "/a/y/", status.Prefixes[1].Prefix)
+	assert.Equal(t, 0, status.Prefixes[1].Keys)
+	assert.Equal(t, 2, status.Prefixes[1].Revisions)
+
+	// the breakdown does not change the status
+	plain, err := NewV3(zap.NewNop()).Status(dbpath)
+	require.NoError(t, err)
+	status.Buckets, status.Prefixes = nil, nil
+	assert.Equal(t, plain, status)
+}
@@ -0,0 +1,607 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/lease/leasepb"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// The formats of the dumps of the key-values of a snapshot.
+const (
+	// DumpFormatJSONL dumps a JSON object per line: a header, then the
+	// key-values and the leases. The keys and values are base64 encoded.
+	DumpFormatJSONL = "jsonl"
+	// DumpFormatSQL dumps SQL statements creating the etcd_meta, etcd_kv
+	// and etcd_lease tables and inserting their rows. The keys and values
+	// are blob literals.
+	DumpFormatSQL = "sql"
+)
+
+// dumpFormatVersion is the version of the dump formats.
+const dumpFormatVersion = 1
+
+// ExportConfig configures snapshot export operation.
+type ExportConfig struct {
+	// SnapshotPath is the path of snapshot file to export.
+	SnapshotPath string
+	// Format is the format of the dump, DumpFormatJSONL or DumpFormatSQL.
+	Format string
+
+	// SkipHashCheck is "true" to ignore snapshot integrity hash value
+	// (required if copied from data directory).
+	SkipHashCheck bool
+}
+
+// ExportStatus is the result of snapshot export operation.
+type ExportStatus struct {
+	// Keys is the number of keys exported, each with its latest revision.
+	Keys int `json:"keys"`
+	// Leases is the number of leases exported.
+	Leases int `json:"leases"`
+	// Revision is the revision of the snapshot.
+	Revision int64 `json:"revision"`
+}
+
+// ImportConfig configures snapshot import operation.
+type ImportConfig struct {
+	// Format is the format of the dump, DumpFormatJSONL or DumpFormatSQL.
+	Format string
+	// OutputPath is the path of the imported snapshot file. It must not
+	// exist.
+	OutputPath string
+}
+
+// ImportStatus is the result of snapshot import operation.
+type ImportStatus struct {
+	// Keys is the number of keys imported.
+	Keys int `json:"keys"`
+	// Leases is the number of leases imported.
+	Leases int `json:"leases"`
+	// Status is the status of the imported snapshot file.
+	Status Status `json:"status"`
+}
+
+type dumpHeader struct {
+	FormatVersion  int    `json:"formatVersion"`
+	Revision       int64  `json:"revision"`
+	StorageVersion string `json:"storageVersion,omitempty"`
+}
+
+type dumpKeyValue struct {
+	Key            []byte `json:"key"`
+	Value          []byte `json:"value"`
+	CreateRevision int64  `json:"createRevision"`
+	ModRevision    int64  `json:"modRevision"`
+	Version        int64  `json:"version"`
+	Lease          int64  `json:"lease,omitempty"`
+}
+
+type dumpLease struct {
+	ID  int64 `json:"id"`
+	TTL int64 `json:"ttl"`
+}
+
+// dumpRecord is a record of a dump, with one of its fields set. It is the
+// line of a dump in DumpFormatJSONL.
+type dumpRecord struct {
+	Header *dumpHeader   `json:"header,omitempty"`
+	KV     *dumpKeyValue `json:"kv,omitempty"`
+	Lease  *dumpLease    `json:"lease,omitempty"`
+}
+
+func validateDumpFormat(format string) error {
+	if format != DumpFormatJSONL && format != DumpFormatSQL {
+		return fmt.Errorf("unsupported dump format %q, must be %q or %q", format, DumpFormatJSONL, DumpFormatSQL)
+	}
+	return nil
+}
+
+// Export writes a dump of the latest revision of the keys of the snapshot
+// file, without their history nor the deleted keys, and of its leases to w.
+// The dump holds the key-values as they are, so that Import recreates them
+// with their revisions, versions and leases.
+func (s *v3Manager) Export(cfg ExportConfig, w io.Writer) (ExportStatus, error) {
+	if err := validateDumpFormat(cfg.Format); err != nil {
+		return ExportStatus{}, err
+	}
+	if err := verifySnapshotHash(cfg.SnapshotPath, cfg.SkipHashCheck); err != nil {
+		return ExportStatus{}, err
+	}
+	latest, rev, err := readLatestRevisions(cfg.SnapshotPath)
+	if err != nil {
+		return ExportStatus{}, err
+	}
+	db, err := bolt.Open(cfg.SnapshotPath, 0o400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return ExportStatus{}, err
+	}
+	defer db.Close()
+
+	s.lg.Info("exporting snapshot", zap.String("path", cfg.SnapshotPath), zap.String("format", cfg.Format))
+	st := ExportStatus{Revision: rev}
+	bw := bufio.NewWriter(w)
+	enc := newDumpEncoder(cfg.Format, bw)
+	err = db.View(func(tx *bolt.Tx) error {
+		h := &dumpHeader{FormatVersion: dumpFormatVersion, Revision: rev}
+		if v := schema.ReadStorageVersionFromSnapshot(tx); v != nil {
+			h.StorageVersion = v.String()
+		}
+		if err := enc.encode(dumpRecord{Header: h}); err != nil {
+			return err
+		}
+		if kb := tx.Bucket(schema.Key.Name()); kb != nil {
+			err := kb.ForEach(func(k, v []byte) error {
+				var kv mvccpb.KeyValue
+				if err := kv.Unmarshal(v); err != nil {
+					return fmt.Errorf("cannot unmarshal value, key: %q err: %w", k, err)
+				}
+				if !bytes.Equal(latest[string(kv.Key)], k) || mvcc.IsTombstone(k) {
+					return nil
+				}
+				st.Keys++
+				return enc.encode(dumpRecord{KV: &dumpKeyValue{
+					Key:            kv.Key,
+					Value:          kv.Value,
+					CreateRevision: kv.CreateRevision,
+					ModRevision:    kv.ModRevision,
+					Version:        kv.Version,
+					Lease:          kv.Lease,
+				}})
+			})
+			if err != nil {
+				return err
+			}
+		}
+		lb := tx.Bucket(schema.Lease.Name())
+		if lb == nil {
+			return nil
+		}
+		return lb.ForEach(func(k, v []byte) error {
+			var l leasepb.Lease
+			if err := l.Unmarshal(v); err != nil {
+				return fmt.Errorf("cannot unmarshal lease, key: %x err: %w", k, err)
+			}
+			st.Leases++
+			return enc.encode(dumpRecord{Lease: &dumpLease{ID: l.ID, TTL: l.TTL}})
+		})
+	})
+	if err != nil {
+		return ExportStatus{}, err
+	}
+	if err = bw.Flush(); err != nil {
+		return ExportStatus{}, err
+	}
+	s.lg.Info("exported snapshot", zap.Int("keys", st.Keys), zap.Int("leases", st.Leases), zap.Int64("revision", st.Revision))
+	return st, nil
+}
+
+// Import writes a snapshot file of the key-values and of the leases of the
+// dump read from r, as written by Export. The snapshot file is compacted at
+// the revision of the dump, which is kept once restored, and the integrity
+// hash of the snapshot file is appended to it.
+func (s *v3Manager) Import(cfg ImportConfig, r io.Reader) (ImportStatus, error) {
+	if err := validateDumpFormat(cfg.Format); err != nil {
+		return ImportStatus{}, err
+	}
+	if fileutil.Exist(cfg.OutputPath) {
+		return ImportStatus{}, fmt.Errorf("output file %q exists", cfg.OutputPath)
+	}
+
+	s.lg.Info("importing snapshot", zap.String("format", cfg.Format), zap.String("output-path", cfg.OutputPath))
+	st, err := s.importDB(cfg, r)
+	if err != nil {
+		os.Remove(cfg.OutputPath)
+		return ImportStatus{}, err
+	}
+	if st.Status, err = s.Status(cfg.OutputPath); err != nil {
+		return ImportStatus{}, err
+	}
+	if err = appendSnapshotHash(cfg.OutputPath); err != nil {
+		return ImportStatus{}, err
+	}
+	s.lg.Info(
+		"imported snapshot",
+		zap.String("output-path", cfg.OutputPath),
+		zap.Int("keys", st.Keys),
+		zap.Int("leases", st.Leases),
+		zap.Int64("revision", st.Status.Revision),
+	)
+	return st, nil
+}
+
+func (s *v3Manager) importDB(cfg ImportConfig, r io.Reader) (st ImportStatus, err error) {
+	be := backend.NewDefaultBackend(s.lg, cfg.OutputPath)
+	defer be.Close()
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	locked := true
+	defer func() {
+		if locked {
+			tx.Unlock()
+		}
+		be.ForceCommit()
+	}()
+	for _, b := range schema.AllBuckets {
+		tx.UnsafeCreateBucket(b)
+	}
+
+	var (
+		header *dumpHeader
+		maxRev int64
+		keys   = make(map[string]struct{})
+		// the next sub revision of the main revisions, the key-values of a
+		// revision being written in the order of the dump
+		subs = make(map[int64]int64)
+		n    int
+	)
+	err = decodeDump(cfg.Format, r, func(rec dumpRecord) error {
+		if header == nil && rec.Header == nil {
+			return errors.New("dump does not start with a header")
+		}
+		switch {
+		case rec.Header != nil:
+			if header != nil {
+				return errors.New("dump has more than one header")
+			}
+			if rec.Header.FormatVersion != dumpFormatVersion {
+				return fmt.Errorf("unsupported dump format version %d", rec.Header.FormatVersion)
+			}
+			header = rec.Header
+			if header.StorageVersion != "" {
+				v, err := semver.NewVersion(header.StorageVersion)
+				if err != nil {
+					return fmt.Errorf("invalid storage version: %w", err)
+				}
+				schema.UnsafeSetStorageVersion(tx, v)
+			}
+		case rec.KV != nil:
+			kv := rec.KV
+			if err := validateDumpKeyValue(kv); err != nil {
+				return err
+			}
+			if _, ok := keys[string(kv.Key)]; ok {
+				return fmt.Errorf("key %q is dumped more than once", kv.Key)
+			}
+			keys[string(kv.Key)] = struct{}{}
+			v, err := (&mvccpb.KeyValue{
+				Key:            kv.Key,
+				Value:          kv.Value,
+				CreateRevision: kv.CreateRevision,
+				ModRevision:    kv.ModRevision,
+				Version:        kv.Version,
+				Lease:          kv.Lease,
+			}).Marshal()
+			if err != nil {
+				return err
+			}
+			rev := mvcc.Revision{Main: kv.ModRevision, Sub: subs[kv.ModRevision]}
+			subs[kv.ModRevision]++
+			tx.UnsafePut(schema.Key, mvcc.RevToBytes(rev, mvcc.NewRevBytes()), v)
+			maxRev = max(maxRev, kv.ModRevision)
+			st.Keys++
+		case rec.Lease != nil:
+			schema.MustUnsafePutLease(tx, &leasepb.Lease{ID: rec.Lease.ID, TTL: rec.Lease.TTL})
+			st.Leases++
+		}
+		// let the backend commit the batches of the writes
+		if n++; n%scrubBatchSize == 0 {
+			tx.Unlock()
+			tx.LockOutsideApply()
+		}
+		return nil
+	})
+	if err != nil {
+		return st, err
+	}
+	if header == nil {
+		return st, errors.New("empty dump")
+	}
+	if header.Revision < maxRev {
+		return st, fmt.Errorf("revision %d of the dump is lower than the mod revision %d of a key", header.Revision, maxRev)
+	}
+	// the history of the keys is not in the dump
+	mvcc.UnsafeSetScheduledCompact(tx, header.Revision)
+	mvcc.UnsafeSetFinishedCompact(tx, header.Revision)
+	tx.Unlock()
+	locked = false
+	return st, nil
+}
+
+func validateDumpKeyValue(kv *dumpKeyValue) error {
+	switch {
+	case len(kv.Key) == 0:
+		return errors.New("key-value with an empty key")
+	case kv.ModRevision <= 0 || kv.CreateRevision <= 0 || kv.CreateRevision > kv.ModRevision:
+		return fmt.Errorf("key %q has invalid revisions: create revision %d, mod revision %d", kv.Key, kv.CreateRevision, kv.ModRevision)
+	case kv.Version <= 0:
+		return fmt.Errorf("key %q has invalid version %d", kv.Key, kv.Version)
+	}
+	return nil
+}
+
+// dumpEncoder writes the records of a dump.
+type dumpEncoder interface {
+	encode(rec dumpRecord) error
+}
+
+func newDumpEncoder(format string, w io.Writer) dumpEncoder {
+	if format == DumpFormatSQL {
+		return &sqlDumpEncoder{w: w}
+	}
+	return &jsonlDumpEncoder{enc: json.NewEncoder(w)}
+}
+
+type jsonlDumpEncoder struct {
+	enc *json.Encoder
+}
+
+func (e *jsonlDumpEncoder) encode(rec dumpRecord) error {
+	return e.enc.Encode(rec)
+}
+
+const sqlDumpSchema = `CREATE TABLE etcd_meta (name TEXT PRIMARY KEY, value TEXT NOT NULL);
+CREATE TABLE etcd_kv (key BLOB PRIMARY KEY, value BLOB NOT NULL, create_revision INTEGER NOT NULL, mod_revision INTEGER NOT NULL, version INTEGER NOT NULL, lease INTEGER NOT NULL);
+CREATE TABLE etcd_lease (id INTEGER PRIMARY KEY, ttl INTEGER NOT NULL);
+`
+
+// The rows of the etcd_meta table.
+const (
+	sqlMetaFormatVersion  = "format_version"
+	sqlMetaRevision       = "revision"
+	sqlMetaStorageVersion = "storage_version"
+)
+
+type sqlDumpEncoder struct {
+	w io.Writer
+}
+
+func (e *sqlDumpEncoder) encode(rec dumpRecord) (err error) {
+	switch {
+	case rec.Header != nil:
+		h := rec.Header
+		if _, err = io.WriteString(e.w, sqlDumpSchema); err != nil {
+			return err
+		}
+		if err = e.meta(sqlMetaFormatVersion, strconv.Itoa(h.FormatVersion)); err != nil {
+			return err
+		}
+		if err = e.meta(sqlMetaRevision, strconv.FormatInt(h.Revision, 10)); err != nil {
+			return err
+		}
+		if h.StorageVersion != "" {
+			err = e.meta(sqlMetaStorageVersion, h.StorageVersion)
+		}
+	case rec.KV != nil:
+		kv := rec.KV
+		_, err = fmt.Fprintf(e.w, "INSERT INTO etcd_kv VALUES (X'%x', X'%x', %d, %d, %d, %d);\n",
+			kv.Key, kv.Value, kv.CreateRevision, kv.ModRevision, kv.Version, kv.Lease)
+	case rec.Lease != nil:
+		_, err = fmt.Fprintf(e.w, "INSERT INTO etcd_lease VALUES (%d, %d);\n", rec.Lease.ID, rec.Lease.TTL)
+	}
+	return err
+}
+
+func (e *sqlDumpEncoder) meta(name, value string) error {
+	_, err := fmt.Fprintf(e.w, "INSERT INTO etcd_meta VALUES (%s, %s);\n", sqlQuote(name), sqlQuote(value))
+	return err
+}
+
+func sqlQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// decodeDump reads the records of a dump, calling f with each of them.
+func decodeDump(format string, r io.Reader, f func(dumpRecord) error) error {
+	if format == DumpFormatSQL {
+		return decodeSQLDump(r, f)
+	}
+	dec := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var rec dumpRecord
+		err := dec.Decode(&rec)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("record %d: %w", line, err)
+		}
+		if err = f(rec); err != nil {
+			return fmt.Errorf("record %d: %w", line, err)
+		}
+	}
+}
+
+// decodeSQLDump reads the records of a dump in DumpFormatSQL, of a statement
+// per line. It only reads the statements Export writes, the CREATE TABLE
+// statements and the comments being skipped.
+func decodeSQLDump(r io.Reader, f func(dumpRecord) error) error {
+	sc := bufio.NewScanner(r)
+	// a line holds a whole key-value, hex encoded
+	sc.Buffer(make([]byte, 0, 64*1024), 8*1024*1024)
+	header := &dumpHeader{}
+	headerDone := false
+	for line := 1; sc.Scan(); line++ {
+		stmt := strings.TrimSpace(sc.Text())
+		if stmt == "" || strings.HasPrefix(stmt, "--") || strings.HasPrefix(stmt, "CREATE TABLE ") {
+			continue
+		}
+		table, values, err := parseSQLInsert(stmt)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		var rec dumpRecord
+		switch table {
+		case "etcd_meta":
+			if headerDone {
+				return fmt.Errorf("line %d: etcd_meta row after the key-values", line)
+			}
+			err = header.setSQLMeta(values)
+		case "etcd_kv":
+			rec.KV, err = parseSQLKeyValue(values)
+		case "etcd_lease":
+			rec.Lease, err = parseSQLLease(values)
+		default:
+			err = fmt.Errorf("unknown table %q", table)
+		}
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if table == "etcd_meta" {
+			continue
+		}
+		if !headerDone {
+			headerDone = true
+			if err = f(dumpRecord{Header: header}); err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		if err = f(rec); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if !headerDone && header.FormatVersion != 0 {
+		return f(dumpRecord{Header: header})
+	}
+	return nil
+}
+
+func (h *dumpHeader) setSQLMeta(values []string) (err error) {
+	if len(values) != 2 {
+		return fmt.Errorf("etcd_meta row has %d values, expected 2", len(values))
+	}
+	name, err := sqlUnquote(values[0])
+	if err != nil {
+		return err
+	}
+	value, err := sqlUnquote(values[1])
+	if err != nil {
+		return err
+	}
+	switch name {
+	case sqlMetaFormatVersion:
+		h.FormatVersion, err = strconv.Atoi(value)
+	case sqlMetaRevision:
+		h.Revision, err = strconv.ParseInt(value, 10, 64)
+	case sqlMetaStorageVersion:
+		h.StorageVersion = value
+	default:
+		err = fmt.Errorf("unknown etcd_meta row %q", name)
+	}
+	return err
+}
+
+func parseSQLKeyValue(values []string) (*dumpKeyValue, error) {
+	if len(values) != 6 {
+		return nil, fmt.Errorf("etcd_kv row has %d values, expected 6", len(values))
+	}
+	kv := &dumpKeyValue{}
+	var err error
+	if kv.Key, err = sqlUnhex(values[0]); err != nil {
+		return nil, err
+	}
+	if kv.Value, err = sqlUnhex(values[1]); err != nil {
+		return nil, err
+	}
+	for i, p := range []*int64{&kv.CreateRevision, &kv.ModRevision, &kv.Version, &kv.Lease} {
+		if *p, err = strconv.ParseInt(values[i+2], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return kv, nil
+}
+
+func parseSQLLease(values []string) (*dumpLease, error) {
+	if len(values) != 2 {
+		return nil, fmt.Errorf("etcd_lease row has %d values, expected 2", len(values))
+	}
+	l := &dumpLease{}
+	var err error
+	if l.ID, err = strconv.ParseInt(values[0], 10, 64); err != nil {
+		return nil, err
+	}
+	if l.TTL, err = strconv.ParseInt(values[1], 10, 64); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// parseSQLInsert returns the table and the literals of the values of an
+// "INSERT INTO <table> VALUES (...);" statement.
+func parseSQLInsert(stmt string) (table string, values []string, err error) {
+	rest, ok := strings.CutPrefix(stmt, "INSERT INTO ")
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported statement %q", stmt)
+	}
+	table, rest, ok = strings.Cut(rest, " VALUES (")
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported statement %q", stmt)
+	}
+	rest, ok = strings.CutSuffix(rest, ");")
+	if !ok {
+		return "", nil, fmt.Errorf("unterminated statement %q", stmt)
+	}
+	// the commas of the quoted strings do not separate values
+	start, quoted := 0, false
+	for i := 0; i < len(rest); i++ {
+		switch {
+		case rest[i] == '\'':
+			quoted = !quoted
+		case rest[i] == ',' && !quoted:
+			values = append(values, strings.TrimSpace(rest[start:i]))
+			start = i + 1
+		}
+	}
+	if quoted {
+		return "", nil, fmt.Errorf("unterminated string in statement %q", stmt)
+	}
+	return table, append(values, strings.TrimSpace(rest[start:])), nil
+}
+
+func sqlUnquote(s string) (string, error) {
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return "", fmt.Errorf("invalid string literal %s", s)
+	}
+	return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+}
+
+func sqlUnhex(s string) ([]byte, error) {
+	if len(s) < 3 || (s[0] != 'X' && s[0] != 'x') || s[1] != '\'' || s[len(s)-1] != '\'' {
+		return nil, fmt.Errorf("invalid blob literal %s", s)
+	}
+	return hex.DecodeString(s[2 : len(s)-1])
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestSnapshotExportImport(t *testing.T) {
+	var (
+		leaseID int64
+		rev     int64
+	)
+	dbpath := createDB(t, func(srv *etcdserver.EtcdServer) {
+		lresp, err := srv.LeaseGrant(t.Context(), &etcdserverpb.LeaseGrantRequest{TTL: 3600})
+		require.NoError(t, err)
+		leaseID = lresp.ID
+		for _, kv := range [][2]string{{"/a", "1"}, {"/b", "1"}, {"/a", "2"}, {"/c", "1"}, {"it's, binary\x00", "\xff'\n"}} {
+			_, err = srv.Put(t.Context(), &etcdserverpb.PutRequest{Key: []byte(kv[0]), Value: []byte(kv[1])})
+			require.NoError(t, err)
+		}
+		_, err = srv.Put(t.Context(), &etcdserverpb.PutRequest{Key: []byte("/leased"), Lease: leaseID})
+		require.NoError(t, err)
+		// the key-values of a txn share their revision
+		_, err = srv.Txn(t.Context(), &etcdserverpb.TxnRequest{Success: []*etcdserverpb.RequestOp{
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("/t/1"), Value: []byte("x")}}},
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("/t/2"), Value: []byte("y")}}},
+		}})
+		require.NoError(t, err)
+		// the latest revision is a tombstone, dropped
+		resp, err := srv.DeleteRange(t.Context(), &etcdserverpb.DeleteRangeRequest{Key: []byte("/c")})
+		require.NoError(t, err)
+		rev = resp.Header.Revision
+	})
+	want := readLatestKeyValues(t, dbpath)
+	require.Len(t, want, 6)
+
+	for _, format := range []string{DumpFormatJSONL, DumpFormatSQL} {
+		t.Run(format, func(t *testing.T) {
+			sp := NewV3(zap.NewNop())
+			var dump bytes.Buffer
+			est, err := sp.Export(ExportConfig{SnapshotPath: dbpath, Format: format, SkipHashCheck: true}, &dump)
+			require.NoError(t, err)
+			assert.Equal(t, ExportStatus{Keys: 6, Leases: 1, Revision: rev}, est)
+
+			output := filepath.Join(t.TempDir(), "imported.db")
+			ist, err := sp.Import(ImportConfig{Format: format, OutputPath: output}, &dump)
+			require.NoError(t, err)
+			assert.Equal(t, 6, ist.Keys)
+			assert.Equal(t, 1, ist.Leases)
+			assert.Equal(t, 6, ist.Status.TotalKey)
+			assert.Equal(t, want, readLatestKeyValues(t, output))
+			assert.Equal(t, 1, countLeases(t, output))
+
+			// the revision of the snapshot is kept, even if it is of a
+			// dropped tombstone
+			latest, compactRev, err := readLatestRevisions(output)
+			require.NoError(t, err)
+			assert.Len(t, latest, 6)
+			assert.Equal(t, rev, compactRev)
+
+			// the integrity hash of the imported snapshot is appended to it
+			require.NoError(t, sp.Restore(RestoreConfig{
+				SnapshotPath:        output,
+				Name:                "default",
+				OutputDataDir:       filepath.Join(t.TempDir(), "restored"),
+				PeerURLs:            []string{"http://localhost:2380"},
+				InitialCluster:      "default=http://localhost:2380",
+				InitialClusterToken: "etcd-cluster",
+			}))
+
+			_, err = sp.Import(ImportConfig{Format: format, OutputPath: output}, &dump)
+			require.ErrorContains(t, err, "exists")
+		})
+	}
+}
+
+func TestSnapshotImportInvalidDump(t *testing.T) {
+	tcs := []struct {
+		name   string
+		format string
+		dump   string
+		werr   string
+	}{
+		{
+			name:   "no header",
+			format: DumpFormatJSONL,
+			dump:   `{"kv":{"key":"YQ==","value":"","createRevision":2,"modRevision":2,"version":1}}`,
+			werr:   "dump does not start with a header",
+		},
+		{
+			name:   "empty",
+			format: DumpFormatJSONL,
+			werr:   "empty dump",
+		},
+		{
+			name:   "revision lower than a key",
+			format: DumpFormatJSONL,
+			dump: `{"header":{"formatVersion":1,"revision":1}}
+{"kv":{"key":"YQ==","value":"","createRevision":2,"modRevision":2,"version":1}}`,
+			werr: "revision 1 of the dump is lower than the mod revision 2 of a key",
+		},
+		{
+			name:   "duplicate key",
+			format: DumpFormatSQL,
+			dump: `INSERT INTO etcd_meta VALUES ('format_version', '1');
+INSERT INTO etcd_meta VALUES ('revision', '3');
+INSERT INTO etcd_kv VALUES (X'61', X'', 2, 2, 1, 0);
+INSERT INTO etcd_kv VALUES (X'61', X'', 3, 3, 1, 0);`,
+			werr: `line 4: key "a" is dumped more than once`,
+		},
+		{
+			name:   "invalid revisions",
+			format: DumpFormatSQL,
+			dump: `INSERT INTO etcd_meta VALUES ('format_version', '1');
+INSERT INTO etcd_kv VALUES (X'61', X'', 3, 2, 1, 0);`,
+			werr: `key "a" has invalid revisions`,
+		},
+		{
+			name:   "unsupported statement",
+			format: DumpFormatSQL,
+			dump:   `DELETE FROM etcd_kv;`,
+			werr:   "unsupported statement",
+		},
+		{
+			name:   "unsupported format",
+			format: "csv",
+			werr:   `unsupported dump format "csv"`,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), "imported.db")
+			_, err := NewV3(zap.NewNop()).Import(ImportConfig{Format: tc.format, OutputPath: output}, strings.NewReader(tc.dump))
+			require.ErrorContains(t, err, tc.werr)
+			assert.NoFileExists(t, output)
+		})
+	}
+}
+
+func TestParseSQLInsert(t *testing.T) {
+	table, values, err := parseSQLInsert(`INSERT INTO etcd_meta VALUES ('it''s, quoted', '1');`)
+	require.NoError(t, err)
+	assert.Equal(t, "etcd_meta", table)
+	assert.Equal(t, []string{`'it''s, quoted'`, `'1'`}, values)
+	s, err := sqlUnquote(values[0])
+	require.NoError(t, err)
+	assert.Equal(t, "it's, quoted", s)
+}
+
+// readLatestKeyValues returns the latest revision of the keys of the db file
+// not deleted.
+func readLatestKeyValues(t *testing.T, path string) map[string]mvccpb.KeyValue {
+	db, err := bbolt.Open(path, 0o400, &bbolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer db.Close()
+
+	kvs := make(map[string]mvccpb.KeyValue)
+	err = db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(schema.Key.Name()).ForEach(func(k, v []byte) error {
+			var kv mvccpb.KeyValue
+			if err := kv.Unmarshal(v); err != nil {
+				return err
+			}
+			if mvcc.IsTombstone(k) {
+				delete(kvs, string(kv.Key))
+				return nil
+			}
+			kvs[string(kv.Key)] = kv
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	return kvs
+}
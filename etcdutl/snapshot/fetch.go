@@ -0,0 +1,301 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+)
+
+// StdinSource is the source of FetchConfig reading the snapshot from stdin.
+const StdinSource = "-"
+
+const (
+	// fetchCheckpointInterval is the number of bytes downloaded between two
+	// checkpoints.
+	fetchCheckpointInterval = 64 * 1024 * 1024
+	fetchMaxBackoff         = 30 * time.Second
+)
+
+// FetchConfig configures snapshot fetch operation.
+type FetchConfig struct {
+	// Source is the http or https URL of the snapshot file, like a presigned
+	// URL of an object storage, or StdinSource.
+	Source string
+	// OutputPath is the path of the downloaded snapshot file. The progress
+	// of the download is checkpointed to the file named after it with the
+	// ".checkpoint" suffix, which an interrupted download resumes from.
+	OutputPath string
+	// Retries is the number of times a download interrupted by an error is
+	// resumed before failing. A snapshot read from stdin is never resumed.
+	Retries int
+}
+
+// fetchCheckpoint is the progress of the download of a snapshot file.
+type fetchCheckpoint struct {
+	Source string `json:"source"`
+	// ETag or LastModified identify the version of the snapshot file the
+	// download resumes, if the server returned them.
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	// Size is the size of the snapshot file, or -1 if unknown.
+	Size int64 `json:"size"`
+	// Offset is the number of bytes of the snapshot file synced to the
+	// output file.
+	Offset   int64 `json:"offset"`
+	Complete bool  `json:"complete"`
+}
+
+// IsFetchSource returns whether the snapshot path is a source to fetch
+// rather than a local snapshot file.
+func IsFetchSource(path string) bool {
+	if path == StdinSource {
+		return true
+	}
+	for _, scheme := range []string{"http://", "https://"} {
+		if strings.HasPrefix(path, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// FetchCheckpointPath returns the path of the checkpoint of the download of a
+// snapshot file to path.
+func FetchCheckpointPath(path string) string {
+	return path + ".checkpoint"
+}
+
+// Fetch downloads the snapshot file of the source to the output path,
+// resuming a download interrupted by a previous Fetch of the same source
+// from its checkpoint. It returns at once if the snapshot file is already
+// downloaded.
+func (s *v3Manager) Fetch(ctx context.Context, cfg FetchConfig) error {
+	if !IsFetchSource(cfg.Source) {
+		return fmt.Errorf("cannot fetch %q: not an http or https URL, like a presigned URL of an object storage, or %q for stdin", cfg.Source, StdinSource)
+	}
+
+	cp, err := readFetchCheckpoint(cfg)
+	if err != nil {
+		return err
+	}
+	if cp.Complete {
+		s.lg.Info("snapshot already fetched", zap.String("source", cfg.Source), zap.String("path", cfg.OutputPath))
+		return nil
+	}
+
+	f, err := os.OpenFile(cfg.OutputPath, os.O_WRONLY|os.O_CREATE, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	// the bytes following the checkpoint may not have been synced
+	if err = f.Truncate(cp.Offset); err != nil {
+		return err
+	}
+	if cp.Offset > 0 {
+		s.lg.Info("resuming snapshot fetch", zap.String("source", cfg.Source), zap.Int64("offset", cp.Offset), zap.Int64("size", cp.Size))
+	}
+
+	if cfg.Source == StdinSource {
+		err = s.fetchStream(cfg, cp, f, os.Stdin)
+	} else {
+		err = s.fetchHTTP(ctx, cfg, cp, f)
+	}
+	if err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	cp.Complete = true
+	if err = writeFetchCheckpoint(cfg, cp); err != nil {
+		return err
+	}
+	s.lg.Info("fetched snapshot", zap.String("source", cfg.Source), zap.String("path", cfg.OutputPath), zap.Int64("size", cp.Offset))
+	return nil
+}
+
+// readFetchCheckpoint returns the checkpoint of the download of the source to
+// the output path, or a new one if there is none or if it is of another
+// source, or of stdin which cannot be resumed.
+func readFetchCheckpoint(cfg FetchConfig) (*fetchCheckpoint, error) {
+	b, err := os.ReadFile(FetchCheckpointPath(cfg.OutputPath))
+	if errors.Is(err, os.ErrNotExist) {
+		if fileutil.Exist(cfg.OutputPath) {
+			return nil, fmt.Errorf("output file %q exists without a checkpoint", cfg.OutputPath)
+		}
+		return &fetchCheckpoint{Source: cfg.Source, Size: -1}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cp := &fetchCheckpoint{}
+	if err = json.Unmarshal(b, cp); err != nil {
+		return nil, fmt.Errorf("cannot read the checkpoint of %q: %w", cfg.OutputPath, err)
+	}
+	if cp.Source != cfg.Source || (cp.Source == StdinSource && !cp.Complete) {
+		return &fetchCheckpoint{Source: cfg.Source, Size: -1}, nil
+	}
+	return cp, nil
+}
+
+// writeFetchCheckpoint atomically replaces the checkpoint of the download.
+func writeFetchCheckpoint(cfg FetchConfig, cp *fetchCheckpoint) error {
+	b, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	path := FetchCheckpointPath(cfg.OutputPath)
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, b, fileutil.PrivateFileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// checkpoint syncs the downloaded bytes of the output file, then records them
+// in the checkpoint.
+func checkpoint(cfg FetchConfig, cp *fetchCheckpoint, f *os.File, offset int64) error {
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	cp.Offset = offset
+	return writeFetchCheckpoint(cfg, cp)
+}
+
+// copyCheckpointed appends r to the output file f, checkpointing the
+// download every fetchCheckpointInterval bytes and when r fails.
+func copyCheckpointed(cfg FetchConfig, cp *fetchCheckpoint, f *os.File, r io.Reader) error {
+	offset := cp.Offset
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	for {
+		n, err := io.CopyN(f, r, fetchCheckpointInterval)
+		offset += n
+		if errors.Is(err, io.EOF) {
+			cp.Offset = offset
+			return nil
+		}
+		if cerr := checkpoint(cfg, cp, f, offset); cerr != nil {
+			return cerr
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *v3Manager) fetchStream(cfg FetchConfig, cp *fetchCheckpoint, f *os.File, r io.Reader) error {
+	if err := copyCheckpointed(cfg, cp, f, r); err != nil {
+		return fmt.Errorf("cannot fetch the snapshot from stdin: %w", err)
+	}
+	return nil
+}
+
+// fetchHTTP downloads the snapshot file with range requests starting from the
+// checkpoint, resuming it up to cfg.Retries times with an exponential
+// backoff. The download restarts from the beginning if the server does not
+// support range requests, or if the snapshot file changed.
+func (s *v3Manager) fetchHTTP(ctx context.Context, cfg FetchConfig, cp *fetchCheckpoint, f *os.File) error {
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		err := s.fetchHTTPOnce(ctx, cfg, cp, f)
+		if err == nil || attempt >= cfg.Retries || ctx.Err() != nil {
+			return err
+		}
+		s.lg.Warn(
+			"snapshot fetch interrupted, resuming",
+			zap.String("source", cfg.Source),
+			zap.Int64("offset", cp.Offset),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff = min(2*backoff, fetchMaxBackoff)
+	}
+}
+
+func (s *v3Manager) fetchHTTPOnce(ctx context.Context, cfg FetchConfig, cp *fetchCheckpoint, f *os.File) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.Source, nil)
+	if err != nil {
+		return err
+	}
+	if cp.Offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", cp.Offset))
+		// the whole snapshot file is returned if it changed
+		switch {
+		case cp.ETag != "":
+			req.Header.Set("If-Range", cp.ETag)
+		case cp.LastModified != "":
+			req.Header.Set("If-Range", cp.LastModified)
+		}
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if cp.Offset > 0 {
+			s.lg.Warn("snapshot changed or range requests not supported, restarting fetch", zap.String("source", cfg.Source))
+		}
+		cp.Offset = 0
+		if err = f.Truncate(0); err != nil {
+			return err
+		}
+		cp.ETag, cp.LastModified = resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+		cp.Size = resp.ContentLength
+	case http.StatusPartialContent:
+		var start int64
+		if _, err = fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-", &start); err != nil || start != cp.Offset {
+			return fmt.Errorf("unexpected content range %q resuming at %d", resp.Header.Get("Content-Range"), cp.Offset)
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		if cp.Size == cp.Offset {
+			return nil
+		}
+		fallthrough
+	default:
+		return fmt.Errorf("cannot fetch the snapshot: %s", resp.Status)
+	}
+
+	if err = copyCheckpointed(cfg, cp, f, resp.Body); err != nil {
+		return err
+	}
+	if cp.Size >= 0 && cp.Offset != cp.Size {
+		return fmt.Errorf("snapshot truncated: fetched %d bytes of %d", cp.Offset, cp.Size)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newFetchServer returns a server of the snapshot content, supporting range
+// requests, that aborts the responses of the first interruptions requests
+// half way.
+func newFetchServer(t *testing.T, content []byte, interruptions int) (srv *httptest.Server, ranges func() []string) {
+	var (
+		mu   sync.Mutex
+		rngs []string
+	)
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		rngs = append(rngs, r.Header.Get("Range"))
+		interrupt := len(rngs) <= interruptions
+		mu.Unlock()
+
+		w.Header().Set("ETag", `"v1"`)
+		if !interrupt {
+			http.ServeContent(w, r, "snapshot.db", time.Time{}, bytes.NewReader(content))
+			return
+		}
+		w.Header().Set("Content-Length", "1048576")
+		w.WriteHeader(http.StatusOK)
+		w.Write(content[:1024])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), rngs...)
+	}
+}
+
+func TestFetchResume(t *testing.T) {
+	content := make([]byte, 1<<20)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+	srv, ranges := newFetchServer(t, content, 1)
+
+	cfg := FetchConfig{Source: srv.URL, OutputPath: filepath.Join(t.TempDir(), "snapshot.db")}
+	sp := NewV3(zap.NewNop())
+
+	// the interrupted download is checkpointed
+	require.Error(t, sp.Fetch(t.Context(), cfg))
+	cp, err := readFetchCheckpoint(cfg)
+	require.NoError(t, err)
+	assert.False(t, cp.Complete)
+	assert.Equal(t, int64(1024), cp.Offset)
+	assert.Equal(t, `"v1"`, cp.ETag)
+
+	// and resumed from its checkpoint
+	require.NoError(t, sp.Fetch(t.Context(), cfg))
+	assert.Equal(t, []string{"", "bytes=1024-"}, ranges())
+	b, err := os.ReadFile(cfg.OutputPath)
+	require.NoError(t, err)
+	assert.Equal(t, content, b)
+
+	// a fetched snapshot is not fetched again
+	require.NoError(t, sp.Fetch(t.Context(), cfg))
+	assert.Len(t, ranges(), 2)
+}
+
+func TestFetchRetries(t *testing.T) {
+	content := make([]byte, 1<<20)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+	srv, ranges := newFetchServer(t, content, 1)
+
+	cfg := FetchConfig{Source: srv.URL, OutputPath: filepath.Join(t.TempDir(), "snapshot.db"), Retries: 1}
+	require.NoError(t, NewV3(zap.NewNop()).Fetch(t.Context(), cfg))
+	assert.Equal(t, []string{"", "bytes=1024-"}, ranges())
+	b, err := os.ReadFile(cfg.OutputPath)
+	require.NoError(t, err)
+	assert.Equal(t, content, b)
+}
+
+func TestFetchUnsupportedSource(t *testing.T) {
+	sp := NewV3(zap.NewNop())
+	out := filepath.Join(t.TempDir(), "snapshot.db")
+	require.ErrorContains(t, sp.Fetch(t.Context(), FetchConfig{Source: "s3://bucket/snapshot.db", OutputPath: out}), "not an http")
+	assert.False(t, IsFetchSource("s3://bucket/snapshot.db"))
+	require.ErrorContains(t, sp.Fetch(t.Context(), FetchConfig{Source: "/tmp/snapshot.db", OutputPath: out}), "not an http")
+}
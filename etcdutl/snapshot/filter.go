@@ -0,0 +1,160 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// PrefixRewrite remaps the keys having the prefix Old to the prefix New.
+type PrefixRewrite struct {
+	Old string
+	New string
+}
+
+// ParsePrefixRewrite parses a prefix rewrite of the form "old=new".
+func ParsePrefixRewrite(s string) (PrefixRewrite, error) {
+	oldPrefix, newPrefix, ok := strings.Cut(s, "=")
+	if !ok {
+		return PrefixRewrite{}, fmt.Errorf("invalid prefix rewrite %q, must be old=new", s)
+	}
+	return PrefixRewrite{Old: oldPrefix, New: newPrefix}, nil
+}
+
+// keyFilter selects and rewrites the keys restored from a snapshot.
+type keyFilter struct {
+	prefixes []string
+	rewrites []PrefixRewrite
+
+	// origins maps the restored keys to the keys of the snapshot they are
+	// rewritten from, to detect the keys rewritten to the same key.
+	origins map[string]string
+	// leases are the leases attached to the revisions of the restored keys.
+	leases map[string]struct{}
+
+	revisions int
+	dropped   int
+}
+
+func newKeyFilter(prefixes []string, rewrites []PrefixRewrite) *keyFilter {
+	if len(prefixes) == 0 && len(rewrites) == 0 {
+		return nil
+	}
+	return &keyFilter{
+		prefixes: prefixes,
+		rewrites: rewrites,
+		origins:  make(map[string]string),
+		leases:   make(map[string]struct{}),
+	}
+}
+
+// restored returns the key a key of the snapshot is restored to, or false if
+// it is not restored. The key is rewritten by the first rewrite whose prefix
+// it has.
+func (f *keyFilter) restored(key []byte) ([]byte, bool) {
+	if len(f.prefixes) != 0 {
+		selected := false
+		for _, p := range f.prefixes {
+			if bytes.HasPrefix(key, []byte(p)) {
+				selected = true
+				break
+			}
+		}
+		if !selected {
+			return nil, false
+		}
+	}
+	for _, r := range f.rewrites {
+		if bytes.HasPrefix(key, []byte(r.Old)) {
+			return append([]byte(r.New), key[len(r.Old):]...), true
+		}
+	}
+	return key, true
+}
+
+// transform drops the revisions of the keys that are not restored, and the
+// leases they are attached to, and rewrites the keys of the others.
+func (f *keyFilter) transform(bucket, k, v []byte) ([]byte, bool, error) {
+	switch {
+	case bytes.Equal(bucket, schema.Key.Name()):
+		var kv mvccpb.KeyValue
+		if err := kv.Unmarshal(v); err != nil {
+			return nil, false, fmt.Errorf("cannot unmarshal value, key: %q err: %w", k, err)
+		}
+		key, ok := f.restored(kv.Key)
+		if !ok {
+			f.dropped++
+			return nil, false, nil
+		}
+		if origin, ok := f.origins[string(key)]; !ok {
+			f.origins[string(key)] = string(kv.Key)
+		} else if origin != string(kv.Key) {
+			return nil, false, fmt.Errorf("keys %q and %q are both restored to key %q", origin, kv.Key, key)
+		}
+		f.revisions++
+		if kv.Lease != 0 {
+			f.leases[string(leaseIDBytes(kv.Lease))] = struct{}{}
+		}
+		if bytes.Equal(key, kv.Key) {
+			return v, true, nil
+		}
+		kv.Key = key
+		v, err := kv.Marshal()
+		return v, true, err
+	case bytes.Equal(bucket, schema.Lease.Name()):
+		// the key bucket is copied first
+		_, ok := f.leases[string(k)]
+		return v, ok, nil
+	}
+	return v, true, nil
+}
+
+// filterDB rewrites the db file restored at path with the keys selected and
+// rewritten by the filter only. The db file is copied rather than modified in
+// place, so that the values of the dropped keys do not remain in its free
+// pages.
+func (s *v3Manager) filterDB(path string, f *keyFilter) error {
+	tmpPath := path + ".filtered"
+	if err := copyDB(path, tmpPath, f.transform); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	s.lg.Info(
+		"filtered restored keys",
+		zap.Int("keys", len(f.origins)),
+		zap.Int("revisions", f.revisions),
+		zap.Int("dropped-revisions", f.dropped),
+		zap.Int("leases", len(f.leases)),
+	)
+	return nil
+}
+
+func leaseIDBytes(id int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(id))
+	return b
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestSnapshotRestoreFilter(t *testing.T) {
+	var leaseID int64
+	dbpath := createDB(t, func(srv *etcdserver.EtcdServer) {
+		lresp, err := srv.LeaseGrant(t.Context(), &etcdserverpb.LeaseGrantRequest{TTL: 3600})
+		require.NoError(t, err)
+		leaseID = lresp.ID
+		for _, key := range []string{"/a/x", "/b/x", "/a/y", "/c/x"} {
+			_, err := srv.Put(t.Context(), &etcdserverpb.PutRequest{Key: []byte(key), Value: []byte("value of " + key)})
+			require.NoError(t, err)
+		}
+		_, err = srv.Put(t.Context(), &etcdserverpb.PutRequest{Key: []byte("/b/leased"), Value: []byte("v"), Lease: leaseID})
+		require.NoError(t, err)
+	})
+
+	tcs := []struct {
+		name     string
+		prefixes []string
+		rewrites []PrefixRewrite
+		wkvs     map[string][]string
+		wleases  int
+		werr     string
+	}{
+		{
+			name:     "filter prefixes",
+			prefixes: []string{"/a/", "/c/"},
+			wkvs: map[string][]string{
+				"/a/x": {"value of /a/x"},
+				"/a/y": {"value of /a/y"},
+				"/c/x": {"value of /c/x"},
+			},
+		},
+		{
+			name:     "filter and rewrite prefix",
+			prefixes: []string{"/b/"},
+			rewrites: []PrefixRewrite{{Old: "/b/", New: "/tenant/"}},
+			wkvs: map[string][]string{
+				"/tenant/x":      {"value of /b/x"},
+				"/tenant/leased": {"v"},
+			},
+			wleases: 1,
+		},
+		{
+			name:     "first matching rewrite applies",
+			rewrites: []PrefixRewrite{{Old: "/a/x", New: "/z"}, {Old: "/a/", New: "/b/"}, {Old: "/b/", New: "/d/"}},
+			wkvs: map[string][]string{
+				"/z":        {"value of /a/x"},
+				"/b/y":      {"value of /a/y"},
+				"/d/x":      {"value of /b/x"},
+				"/d/leased": {"v"},
+				"/c/x":      {"value of /c/x"},
+			},
+			wleases: 1,
+		},
+		{
+			name:     "keys restored to the same key",
+			rewrites: []PrefixRewrite{{Old: "/a/", New: "/c/"}},
+			werr:     `keys "/a/x" and "/c/x" are both restored to key "/c/x"`,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			dataDir := filepath.Join(t.TempDir(), "restored")
+			err := NewV3(zap.NewNop()).Restore(RestoreConfig{
+				SnapshotPath:        dbpath,
+				Name:                "default",
+				OutputDataDir:       dataDir,
+				PeerURLs:            []string{"http://localhost:2380"},
+				InitialCluster:      "default=http://localhost:2380",
+				InitialClusterToken: "etcd-cluster",
+				SkipHashCheck:       true,
+				FilterPrefixes:      tc.prefixes,
+				RewritePrefixes:     tc.rewrites,
+			})
+			if tc.werr != "" {
+				require.ErrorContains(t, err, tc.werr)
+				return
+			}
+			require.NoError(t, err)
+			restored := filepath.Join(dataDir, "member", "snap", "db")
+			assert.Equal(t, tc.wkvs, readKeyValues(t, restored))
+			assert.Equal(t, tc.wleases, countLeases(t, restored))
+		})
+	}
+}
+
+func TestParsePrefixRewrite(t *testing.T) {
+	rw, err := ParsePrefixRewrite("/a/=/b=c/")
+	require.NoError(t, err)
+	assert.Equal(t, PrefixRewrite{Old: "/a/", New: "/b=c/"}, rw)
+
+	_, err = ParsePrefixRewrite("/a/")
+	require.ErrorContains(t, err, "must be old=new")
+}
+
+func countLeases(t *testing.T, path string) int {
+	db, err := bbolt.Open(path, 0o400, &bbolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer db.Close()
+
+	n := 0
+	err = db.View(func(tx *bbolt.Tx) error {
+		n = tx.Bucket(schema.Lease.Name()).Stats().KeyN
+		return nil
+	})
+	require.NoError(t, err)
+	return n
+}
@@ -0,0 +1,280 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// scrubBatchSize is the number of key-values copied per write transaction.
+const scrubBatchSize = 10000
+
+// ScrubConfig configures snapshot scrub operation.
+type ScrubConfig struct {
+	// SnapshotPath is the path of snapshot file to scrub.
+	SnapshotPath string
+	// OutputPath is the path of the scrubbed snapshot file. It must not exist.
+	OutputPath string
+
+	// MatchPrefixes and MatchRegexes select the keys to scrub: a key is
+	// scrubbed if it has any of the prefixes or matches any of the regexes.
+	MatchPrefixes []string
+	MatchRegexes  []*regexp.Regexp
+
+	// Delete is "true" to remove all the revisions of the selected keys.
+	// Otherwise their values are replaced by ReplaceValue.
+	Delete       bool
+	ReplaceValue []byte
+
+	// SkipHashCheck is "true" to ignore snapshot integrity hash value
+	// (required if copied from data directory).
+	SkipHashCheck bool
+}
+
+func (cfg ScrubConfig) matches(key []byte) bool {
+	for _, p := range cfg.MatchPrefixes {
+		if bytes.HasPrefix(key, []byte(p)) {
+			return true
+		}
+	}
+	for _, re := range cfg.MatchRegexes {
+		if re.Match(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScrubStatus is the result of snapshot scrub operation.
+type ScrubStatus struct {
+	// Keys is the number of distinct keys scrubbed.
+	Keys int `json:"keys"`
+	// Revisions is the number of revisions of these keys deleted or rewritten.
+	Revisions int `json:"revisions"`
+	// Deleted is "true" if the revisions were deleted rather than rewritten.
+	Deleted bool `json:"deleted"`
+	// Status is the status of the scrubbed snapshot file.
+	Status Status `json:"status"`
+}
+
+// Scrub writes a copy of the snapshot file in which the revisions of the
+// selected keys are deleted or have their values replaced. The key-values are
+// copied to a new db file, so the scrubbed values do not linger in its free
+// pages, and the integrity hash of the copy is appended to it.
+func (s *v3Manager) Scrub(cfg ScrubConfig) (ScrubStatus, error) {
+	if len(cfg.MatchPrefixes) == 0 && len(cfg.MatchRegexes) == 0 {
+		return ScrubStatus{}, errors.New("no key to scrub selected")
+	}
+	if fileutil.Exist(cfg.OutputPath) {
+		return ScrubStatus{}, fmt.Errorf("output file %q exists", cfg.OutputPath)
+	}
+	if err := verifySnapshotHash(cfg.SnapshotPath, cfg.SkipHashCheck); err != nil {
+		return ScrubStatus{}, err
+	}
+
+	s.lg.Info(
+		"scrubbing snapshot",
+		zap.String("path", cfg.SnapshotPath),
+		zap.String("output-path", cfg.OutputPath),
+		zap.Strings("match-prefixes", cfg.MatchPrefixes),
+		zap.Int("match-regexes", len(cfg.MatchRegexes)),
+		zap.Bool("delete", cfg.Delete),
+	)
+	st, err := scrubDB(cfg)
+	if err != nil {
+		os.Remove(cfg.OutputPath)
+		return ScrubStatus{}, err
+	}
+	if st.Status, err = s.Status(cfg.OutputPath); err != nil {
+		return ScrubStatus{}, err
+	}
+	if err = appendSnapshotHash(cfg.OutputPath); err != nil {
+		return ScrubStatus{}, err
+	}
+	s.lg.Info(
+		"scrubbed snapshot",
+		zap.String("output-path", cfg.OutputPath),
+		zap.Int("keys", st.Keys),
+		zap.Int("revisions", st.Revisions),
+	)
+	return st, nil
+}
+
+func scrubDB(cfg ScrubConfig) (st ScrubStatus, err error) {
+	st.Deleted = cfg.Delete
+	keys := make(map[string]struct{})
+	err = copyDB(cfg.SnapshotPath, cfg.OutputPath, func(bucket, k, v []byte) ([]byte, bool, error) {
+		if !bytes.Equal(bucket, schema.Key.Name()) {
+			return v, true, nil
+		}
+		var kv mvccpb.KeyValue
+		if err := kv.Unmarshal(v); err != nil {
+			return nil, false, fmt.Errorf("cannot unmarshal value, key: %q err: %w", k, err)
+		}
+		if !cfg.matches(kv.Key) {
+			return v, true, nil
+		}
+		keys[string(kv.Key)] = struct{}{}
+		if cfg.Delete {
+			st.Revisions++
+			return nil, false, nil
+		}
+		// tombstones have no value to replace
+		if mvcc.IsTombstone(k) {
+			return v, true, nil
+		}
+		kv.Value = cfg.ReplaceValue
+		st.Revisions++
+		v, err := kv.Marshal()
+		return v, true, err
+	})
+	st.Keys = len(keys)
+	return st, err
+}
+
+// copyTransform returns the value of the key of the bucket to write to the
+// copy of a db file, or false to drop the key.
+type copyTransform func(bucket, k, v []byte) ([]byte, bool, error)
+
+// copyDB copies all the buckets of the db file at srcPath to a new db file at
+// dstPath, passing their key-values through transform. The buckets are copied
+// in the order of their names, so the key bucket is copied before the lease
+// bucket. The keys are copied in order, as when defragmenting, so the copy
+// has no free page holding the dropped values.
+func copyDB(srcPath, dstPath string, transform copyTransform) (err error) {
+	src, err := bolt.Open(srcPath, 0o400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := bolt.Open(dstPath, 0o600, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := dst.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	return src.View(func(stx *bolt.Tx) error {
+		return stx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			dtx, err := dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			defer func() { dtx.Rollback() }()
+			db, err := dtx.CreateBucket(name)
+			if err != nil {
+				return err
+			}
+			db.FillPercent = 0.9
+
+			n := 0
+			c := b.Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				v, keep, err := transform(name, k, v)
+				if err != nil {
+					return err
+				}
+				if !keep {
+					continue
+				}
+				if err := db.Put(k, v); err != nil {
+					return err
+				}
+				if n++; n%scrubBatchSize == 0 {
+					if err := dtx.Commit(); err != nil {
+						return err
+					}
+					if dtx, err = dst.Begin(true); err != nil {
+						return err
+					}
+					db = dtx.Bucket(name)
+					db.FillPercent = 0.9
+				}
+			}
+			return dtx.Commit()
+		})
+	})
+}
+
+// verifySnapshotHash checks the sha256 digest appended to the snapshot file,
+// or that it has none if skipHashCheck is set.
+func verifySnapshotHash(path string, skipHashCheck bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if !hasChecksum(fi.Size()) {
+		if !skipHashCheck {
+			return fmt.Errorf("snapshot missing hash but --skip-hash-check=false")
+		}
+		return nil
+	}
+	if skipHashCheck {
+		return nil
+	}
+
+	h := sha256.New()
+	if _, err = io.CopyN(h, f, fi.Size()-sha256.Size); err != nil {
+		return err
+	}
+	sha := make([]byte, sha256.Size)
+	if _, err = io.ReadFull(f, sha); err != nil {
+		return err
+	}
+	if dbsha := h.Sum(nil); !bytes.Equal(sha, dbsha) {
+		return fmt.Errorf("expected sha256 %v, got %v", sha, dbsha)
+	}
+	return nil
+}
+
+// appendSnapshotHash appends the sha256 digest of the db file to it, as the
+// snapshots saved from a member.
+func appendSnapshotHash(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return err
+	}
+	if _, err = f.Write(h.Sum(nil)); err != nil {
+		return err
+	}
+	return fileutil.Fsync(f)
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestSnapshotScrub(t *testing.T) {
+	dbpath := createDB(t, func(srv *etcdserver.EtcdServer) {
+		for _, key := range []string{"/secret/a", "/secret/b", "/public/a", "/secret/a"} {
+			_, err := srv.Put(t.Context(), &etcdserverpb.PutRequest{Key: []byte(key), Value: []byte("value of " + key)})
+			require.NoError(t, err)
+		}
+		_, err := srv.DeleteRange(t.Context(), &etcdserverpb.DeleteRangeRequest{Key: []byte("/secret/b")})
+		require.NoError(t, err)
+	})
+
+	tcs := []struct {
+		name    string
+		cfg     ScrubConfig
+		wstatus ScrubStatus
+		wkvs    map[string][]string
+	}{
+		{
+			name: "replace values of prefix",
+			cfg:  ScrubConfig{MatchPrefixes: []string{"/secret/"}, ReplaceValue: []byte("redacted")},
+			wstatus: ScrubStatus{
+				Keys:      2,
+				Revisions: 3,
+			},
+			wkvs: map[string][]string{
+				"/secret/a": {"redacted", "redacted"},
+				"/secret/b": {"redacted", ""},
+				"/public/a": {"value of /public/a"},
+			},
+		},
+		{
+			name: "delete keys matching regex",
+			cfg:  ScrubConfig{MatchRegexes: []*regexp.Regexp{regexp.MustCompile(`/b$`)}, Delete: true},
+			wstatus: ScrubStatus{
+				Keys:      1,
+				Revisions: 2,
+				Deleted:   true,
+			},
+			wkvs: map[string][]string{
+				"/secret/a": {"value of /secret/a", "value of /secret/a"},
+				"/public/a": {"value of /public/a"},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := tc.cfg
+			cfg.SnapshotPath = dbpath
+			cfg.OutputPath = filepath.Join(t.TempDir(), "scrubbed.db")
+			cfg.SkipHashCheck = true
+			sp := NewV3(zap.NewNop())
+
+			st, err := sp.Scrub(cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wstatus.Keys, st.Keys)
+			assert.Equal(t, tc.wstatus.Revisions, st.Revisions)
+			assert.Equal(t, tc.wstatus.Deleted, st.Deleted)
+			assert.Equal(t, tc.wkvs, readKeyValues(t, cfg.OutputPath))
+
+			// the integrity hash of the scrubbed snapshot is appended to it
+			require.NoError(t, verifySnapshotHash(cfg.OutputPath, false))
+
+			_, err = sp.Scrub(cfg)
+			require.ErrorContains(t, err, "exists")
+		})
+	}
+}
+
+func TestSnapshotScrubNoMatch(t *testing.T) {
+	_, err := NewV3(zap.NewNop()).Scrub(ScrubConfig{SnapshotPath: "snapshot.db", OutputPath: "scrubbed.db", Delete: true})
+	require.ErrorContains(t, err, "no key to scrub selected")
+}
+
+// readKeyValues returns the values of the revisions of each key of the db file,
+// in revision order.
+func readKeyValues(t *testing.T, path string) map[string][]string {
+	db, err := bbolt.Open(path, 0o400, &bbolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer db.Close()
+
+	kvs := make(map[string][]string)
+	err = db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(schema.Key.Name()).ForEach(func(_, v []byte) error {
+			var kv mvccpb.KeyValue
+			if err := kv.Unmarshal(v); err != nil {
+				return err
+			}
+			kvs[string(kv.Key)] = append(kvs[string(kv.Key)], string(kv.Value))
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	return kvs
+}
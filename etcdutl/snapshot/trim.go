@@ -0,0 +1,183 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// TrimConfig configures snapshot trim-revisions operation.
+type TrimConfig struct {
+	// SnapshotPath is the path of snapshot file to trim.
+	SnapshotPath string
+	// OutputPath is the path of the trimmed snapshot file. It must not exist.
+	OutputPath string
+
+	// SkipHashCheck is "true" to ignore snapshot integrity hash value
+	// (required if copied from data directory).
+	SkipHashCheck bool
+}
+
+// TrimStatus is the result of snapshot trim-revisions operation.
+type TrimStatus struct {
+	// Keys is the number of keys kept, each with its latest revision.
+	Keys int `json:"keys"`
+	// Revisions is the number of revisions dropped, tombstones included.
+	Revisions int `json:"revisions"`
+	// Tombstones is the number of deleted keys dropped.
+	Tombstones int `json:"tombstones"`
+	// CompactRevision is the revision the trimmed snapshot is compacted at.
+	CompactRevision int64 `json:"compactRevision"`
+	// Status is the status of the trimmed snapshot file.
+	Status Status `json:"status"`
+}
+
+// TrimRevisions writes a copy of the snapshot file keeping only the latest
+// revision of every key, and dropping the deleted keys. The copy is compacted
+// at the latest revision, as its history is gone, and the integrity hash of
+// the copy is appended to it.
+func (s *v3Manager) TrimRevisions(cfg TrimConfig) (TrimStatus, error) {
+	if fileutil.Exist(cfg.OutputPath) {
+		return TrimStatus{}, fmt.Errorf("output file %q exists", cfg.OutputPath)
+	}
+	if err := verifySnapshotHash(cfg.SnapshotPath, cfg.SkipHashCheck); err != nil {
+		return TrimStatus{}, err
+	}
+
+	s.lg.Info(
+		"trimming snapshot revisions",
+		zap.String("path", cfg.SnapshotPath),
+		zap.String("output-path", cfg.OutputPath),
+	)
+	st, err := s.trimDB(cfg)
+	if err != nil {
+		os.Remove(cfg.OutputPath)
+		return TrimStatus{}, err
+	}
+	if st.Status, err = s.Status(cfg.OutputPath); err != nil {
+		return TrimStatus{}, err
+	}
+	if err = appendSnapshotHash(cfg.OutputPath); err != nil {
+		return TrimStatus{}, err
+	}
+	s.lg.Info(
+		"trimmed snapshot revisions",
+		zap.String("output-path", cfg.OutputPath),
+		zap.Int("keys", st.Keys),
+		zap.Int("revisions", st.Revisions),
+		zap.Int64("compact-revision", st.CompactRevision),
+	)
+	return st, nil
+}
+
+func (s *v3Manager) trimDB(cfg TrimConfig) (st TrimStatus, err error) {
+	latest, compactRev, err := readLatestRevisions(cfg.SnapshotPath)
+	if err != nil {
+		return st, err
+	}
+	st.CompactRevision = compactRev
+
+	err = copyDB(cfg.SnapshotPath, cfg.OutputPath, func(bucket, k, v []byte) ([]byte, bool, error) {
+		if !bytes.Equal(bucket, schema.Key.Name()) {
+			return v, true, nil
+		}
+		var kv mvccpb.KeyValue
+		if err := kv.Unmarshal(v); err != nil {
+			return nil, false, fmt.Errorf("cannot unmarshal value, key: %q err: %w", k, err)
+		}
+		if !bytes.Equal(latest[string(kv.Key)], k) {
+			st.Revisions++
+			return nil, false, nil
+		}
+		if mvcc.IsTombstone(k) {
+			st.Revisions++
+			st.Tombstones++
+			return nil, false, nil
+		}
+		st.Keys++
+		return v, true, nil
+	})
+	if err != nil {
+		return st, err
+	}
+	s.markCompacted(cfg.OutputPath, compactRev)
+	return st, nil
+}
+
+// readLatestRevisions returns the bucket key of the latest revision of every
+// key of the db file, and the revision to compact it at once trimmed: its
+// latest revision, or its compact revision if greater.
+func readLatestRevisions(path string) (latest map[string][]byte, compactRev int64, err error) {
+	db, err := bolt.Open(path, 0o400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer db.Close()
+
+	latest = make(map[string][]byte)
+	err = db.View(func(tx *bolt.Tx) error {
+		if mb := tx.Bucket(schema.Meta.Name()); mb != nil {
+			for _, name := range [][]byte{schema.ScheduledCompactKeyName, schema.FinishedCompactKeyName} {
+				if v := mb.Get(name); v != nil {
+					compactRev = max(compactRev, mvcc.BytesToRev(v).Main)
+				}
+			}
+		}
+		kb := tx.Bucket(schema.Key.Name())
+		if kb == nil {
+			return nil
+		}
+		// the revisions are in order, so the last one of a key is its latest
+		return kb.ForEach(func(k, v []byte) error {
+			var kv mvccpb.KeyValue
+			if err := kv.Unmarshal(v); err != nil {
+				return fmt.Errorf("cannot unmarshal value, key: %q err: %w", k, err)
+			}
+			latest[string(kv.Key)] = bytes.Clone(k)
+			compactRev = max(compactRev, mvcc.BytesToRev(k).Main)
+			return nil
+		})
+	})
+	return latest, compactRev, err
+}
+
+// markCompacted sets the compact revision of the db file, so that the
+// revisions trimmed from it are reported as compacted and its current
+// revision is kept once restored, even if the latest revision was a dropped
+// tombstone.
+func (s *v3Manager) markCompacted(path string, rev int64) {
+	be := backend.NewDefaultBackend(s.lg, path)
+	defer func() {
+		be.ForceCommit()
+		be.Close()
+	}()
+
+	tx := be.BatchTx()
+	tx.LockOutsideApply()
+	defer tx.Unlock()
+	mvcc.UnsafeSetScheduledCompact(tx, rev)
+	mvcc.UnsafeSetFinishedCompact(tx, rev)
+}
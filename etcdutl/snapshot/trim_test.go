@@ -0,0 +1,74 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+func TestSnapshotTrimRevisions(t *testing.T) {
+	var rev int64
+	dbpath := createDB(t, func(srv *etcdserver.EtcdServer) {
+		for _, kv := range [][2]string{{"/a", "1"}, {"/b", "1"}, {"/a", "2"}, {"/c", "1"}, {"/a", "3"}} {
+			_, err := srv.Put(t.Context(), &etcdserverpb.PutRequest{Key: []byte(kv[0]), Value: []byte(kv[1])})
+			require.NoError(t, err)
+		}
+		// the latest revision is a tombstone, dropped
+		resp, err := srv.DeleteRange(t.Context(), &etcdserverpb.DeleteRangeRequest{Key: []byte("/c")})
+		require.NoError(t, err)
+		rev = resp.Header.Revision
+	})
+
+	cfg := TrimConfig{
+		SnapshotPath:  dbpath,
+		OutputPath:    filepath.Join(t.TempDir(), "trimmed.db"),
+		SkipHashCheck: true,
+	}
+	sp := NewV3(zap.NewNop())
+	st, err := sp.TrimRevisions(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 2, st.Keys)
+	assert.Equal(t, 4, st.Revisions)
+	assert.Equal(t, 1, st.Tombstones)
+	assert.Equal(t, rev, st.CompactRevision)
+	assert.Equal(t, 2, st.Status.TotalKey)
+	assert.Equal(t, map[string][]string{"/a": {"3"}, "/b": {"1"}}, readKeyValues(t, cfg.OutputPath))
+
+	// the integrity hash of the trimmed snapshot is appended to it
+	require.NoError(t, verifySnapshotHash(cfg.OutputPath, false))
+
+	be := backend.NewDefaultBackend(zap.NewNop(), cfg.OutputPath)
+	defer be.Close()
+	tx := be.ReadTx()
+	tx.RLock()
+	finished, _ := mvcc.UnsafeReadFinishedCompact(tx)
+	scheduled, _ := mvcc.UnsafeReadScheduledCompact(tx)
+	tx.RUnlock()
+	assert.Equal(t, rev, finished)
+	assert.Equal(t, rev, scheduled)
+
+	_, err = sp.TrimRevisions(cfg)
+	require.ErrorContains(t, err, "exists")
+}
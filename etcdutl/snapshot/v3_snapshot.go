@@ -65,10 +65,34 @@ type Manager interface {
 	// Status returns the snapshot file information.
 	Status(dbPath string) (Status, error)
 
+	// StatusBreakdown returns the snapshot file information, with the sizes
+	// of its buckets and of its top key prefixes.
+	StatusBreakdown(dbPath string, cfg BreakdownConfig) (Status, error)
+
 	// Restore restores a new etcd data directory from given snapshot
 	// file. It returns an error if specified data directory already
 	// exists, to prevent unintended data directory overwrites.
 	Restore(cfg RestoreConfig) error
+
+	// Fetch downloads a snapshot file from a URL or from stdin, resuming
+	// the download interrupted by a previous Fetch from its checkpoint.
+	Fetch(ctx context.Context, cfg FetchConfig) error
+
+	// Scrub writes a copy of the snapshot file in which the revisions of
+	// the selected keys are deleted or have their values replaced.
+	Scrub(cfg ScrubConfig) (ScrubStatus, error)
+
+	// TrimRevisions writes a copy of the snapshot file keeping only the
+	// latest revision of every key.
+	TrimRevisions(cfg TrimConfig) (TrimStatus, error)
+
+	// Export writes a dump of the latest revision of the keys of the
+	// snapshot file, and of its leases.
+	Export(cfg ExportConfig, w io.Writer) (ExportStatus, error)
+
+	// Import writes a snapshot file of the key-values and of the leases of
+	// a dump written by Export.
+	Import(cfg ImportConfig, r io.Reader) (ImportStatus, error)
 }
 
 // NewV3 returns a new snapshot Manager for v3.x snapshot.
@@ -87,6 +111,9 @@ type v3Manager struct {
 
 	skipHashCheck   bool
 	initialMmapSize uint64
+	progress        snapshot.ProgressFunc
+	// filter selects and rewrites the restored keys, if set
+	filter *keyFilter
 }
 
 // hasChecksum returns "true" if the file size "n"
@@ -111,10 +138,24 @@ type Status struct {
 	// Version is equal to storageVersion of the snapshot
 	// Empty if server does not supports versioned snapshots (<v3.6)
 	Version string `json:"version"`
+
+	// Buckets and Prefixes are the size breakdown of the snapshot file,
+	// only set by StatusBreakdown.
+	Buckets  []BucketStatus `json:"buckets,omitempty"`
+	Prefixes []PrefixStatus `json:"prefixes,omitempty"`
 }
 
 // Status returns the snapshot file information.
-func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
+func (s *v3Manager) Status(dbPath string) (Status, error) {
+	return s.status(dbPath, nil)
+}
+
+// StatusBreakdown returns the snapshot file information, with its size breakdown.
+func (s *v3Manager) StatusBreakdown(dbPath string, cfg BreakdownConfig) (Status, error) {
+	return s.status(dbPath, newBreakdown(cfg))
+}
+
+func (s *v3Manager) status(dbPath string, bd *breakdown) (ds Status, err error) {
 	if _, err = os.Stat(dbPath); err != nil {
 		return ds, err
 	}
@@ -152,6 +193,9 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 			if err != nil {
 				return fmt.Errorf("cannot hash bucket name: %q err: %w", string(next), err)
 			}
+			if bd != nil {
+				bd.addBucket(next)
+			}
 
 			iskeyb := (bytes.Equal(next, schema.Key.Name()))
 			if err = b.ForEach(func(k, v []byte) error {
@@ -163,6 +207,9 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 				if err != nil {
 					return fmt.Errorf("cannot hash bucket key: %q value: %q err: %w", k, v, err)
 				}
+				if bd != nil {
+					bd.addKey(k, v)
+				}
 				if iskeyb {
 					var rev mvcc.Revision
 					rev, err = bytesToRev(k)
@@ -177,6 +224,9 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 						return fmt.Errorf("cannot unmarshal value, key: %q value: %q err: %w", k, v, err)
 					}
 					key := string(kv.Key)
+					if bd != nil {
+						bd.addRevision(key, len(k)+len(v))
+					}
 					// refer to https://etcd.io/docs/v3.5/learning/data_model/
 					if !mvcc.IsTombstone(k) {
 						seenKeys[key] = struct{}{}
@@ -196,6 +246,9 @@ func (s *v3Manager) Status(dbPath string) (ds Status, err error) {
 
 	ds.TotalKey = len(seenKeys)
 	ds.Hash = h.Sum32()
+	if bd != nil {
+		bd.finish(&ds, seenKeys)
+	}
 	return ds, nil
 }
 
@@ -250,6 +303,18 @@ type RestoreConfig struct {
 	// MarkCompacted is "true" to mark the latest revision as compacted.
 	// (required if RevisionBump > 0)
 	MarkCompacted bool
+
+	// Progress, if set, receives the progress of the copy of the snapshot
+	// database to the data directory.
+	Progress snapshot.ProgressFunc
+
+	// FilterPrefixes, if set, restores only the revisions of the keys having
+	// any of the prefixes, and the leases attached to them.
+	FilterPrefixes []string
+	// RewritePrefixes remaps the prefixes of the restored keys. A key is
+	// rewritten by the first rewrite whose Old prefix it has. It is an error
+	// to restore two keys of the snapshot to the same key.
+	RewritePrefixes []PrefixRewrite
 }
 
 // Restore restores a new etcd data directory from given snapshot file.
@@ -301,6 +366,8 @@ func (s *v3Manager) Restore(cfg RestoreConfig) error {
 	s.snapDir = filepath.Join(dataDir, "member", "snap")
 	s.skipHashCheck = cfg.SkipHashCheck
 	s.initialMmapSize = cfg.InitialMmapSize
+	s.progress = cfg.Progress
+	s.filter = newKeyFilter(cfg.FilterPrefixes, cfg.RewritePrefixes)
 
 	s.lg.Info(
 		"restoring snapshot",
@@ -356,6 +423,11 @@ func (s *v3Manager) saveDB() error {
 	if err != nil {
 		return err
 	}
+	if s.filter != nil {
+		if err = s.filterDB(s.outDbPath(), s.filter); err != nil {
+			return err
+		}
+	}
 
 	be := backend.NewDefaultBackend(s.lg, s.outDbPath(), backend.WithMmapSize(s.initialMmapSize))
 	defer be.Close()
@@ -463,7 +535,15 @@ func (s *v3Manager) copyAndVerifyDB() error {
 	}
 	defer db.Close()
 
-	if _, err := io.Copy(db, srcf); err != nil {
+	var dst io.Writer = db
+	if s.progress != nil {
+		st, err := srcf.Stat()
+		if err != nil {
+			return err
+		}
+		dst = snapshot.ProgressWriter(db, st.Size(), s.progress)
+	}
+	if _, err := io.Copy(dst, srcf); err != nil {
 		return err
 	}
 
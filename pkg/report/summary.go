@@ -0,0 +1,175 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// z95 is the standard score of the 95% confidence intervals.
+const z95 = 1.96
+
+// Summary is the summary of the stats of a report, encoded in JSON by the
+// reports of NewJSONReport. The durations are in seconds.
+type Summary struct {
+	Name      string              `json:"name"`
+	Requests  int                 `json:"requests"`
+	Errors    int                 `json:"errors"`
+	Total     float64             `json:"total"`
+	RPS       float64             `json:"rps"`
+	Average   float64             `json:"average"`
+	Stddev    float64             `json:"stddev"`
+	Fastest   float64             `json:"fastest"`
+	Slowest   float64             `json:"slowest"`
+	Latencies []PercentileLatency `json:"latencies"`
+}
+
+// PercentileLatency is the latency of a percentile, along with the bounds of
+// its 95% confidence interval.
+type PercentileLatency struct {
+	Percentile float64 `json:"percentile"`
+	Latency    float64 `json:"latency"`
+	Lower      float64 `json:"lower"`
+	Upper      float64 `json:"upper"`
+}
+
+// NewSummary returns the summary of the stats s of the report named name.
+func NewSummary(name string, s Stats) Summary {
+	sum := Summary{
+		Name:     name,
+		Requests: len(s.Lats),
+		Total:    s.Total.Seconds(),
+	}
+	for _, n := range s.ErrorDist {
+		sum.Errors += n
+	}
+	// the stats of no latencies are NaN, which JSON cannot encode
+	if len(s.Lats) == 0 {
+		return sum
+	}
+	sum.RPS, sum.Average, sum.Stddev = s.RPS, s.Average, s.Stddev
+	sum.Fastest, sum.Slowest = s.Fastest, s.Slowest
+	lats := s.Lats
+	if !slices.IsSorted(lats) {
+		lats = slices.Sorted(slices.Values(lats))
+	}
+	for i, lat := range percentiles(lats) {
+		lower, upper := percentileInterval(lats, pctls[i])
+		sum.Latencies = append(sum.Latencies, PercentileLatency{Percentile: pctls[i], Latency: lat, Lower: lower, Upper: upper})
+	}
+	return sum
+}
+
+// percentileInterval returns the bounds of the distribution-free 95%
+// confidence interval of the percentile pctl of the sorted latencies: the
+// ones of the ranks the count of latencies below the percentile, binomially
+// distributed, is unlikely to go beyond.
+func percentileInterval(lats []float64, pctl float64) (lower, upper float64) {
+	n := float64(len(lats))
+	p := pctl / 100
+	rank, spread := n*p, z95*math.Sqrt(n*p*(1-p))
+	lo := max(int(math.Floor(rank-spread)), 0)
+	hi := min(int(math.Ceil(rank+spread)), len(lats)-1)
+	return lats[lo], lats[hi]
+}
+
+type jsonReport struct {
+	Report
+	name string
+}
+
+// NewJSONReport returns a report running the report r, printing the summary
+// of each of its stats as a line of JSON. The summaries following the first
+// one, like the unweighted stats of a weighted report, are named after their
+// position.
+func NewJSONReport(r Report, reportName string) Report {
+	return &jsonReport{Report: r, name: reportName}
+}
+
+func (jr *jsonReport) Run() <-chan string {
+	donec := make(chan string, 2)
+	go func() {
+		defer close(donec)
+		i := 0
+		for s := range jr.Report.Stats() {
+			name := jr.name
+			if i > 0 {
+				name = fmt.Sprintf("%s-%d", jr.name, i)
+			}
+			i++
+			b, err := json.Marshal(NewSummary(name, s))
+			if err != nil {
+				donec <- fmt.Sprintf("cannot encode the summary of %s: %v", name, err)
+				continue
+			}
+			donec <- string(b)
+		}
+	}()
+	return donec
+}
+
+// Comparison is the comparison of the latency of a percentile of a report in
+// a baseline run and in a new run.
+type Comparison struct {
+	Name       string  `json:"name"`
+	Percentile float64 `json:"percentile"`
+	Old        float64 `json:"old"`
+	New        float64 `json:"new"`
+	// Change is the relative change of the latency, in percent.
+	Change float64 `json:"change"`
+	// Regression is true if the latency is significantly greater in the new
+	// run: the confidence intervals of both runs do not overlap and the
+	// change is above the threshold.
+	Regression bool `json:"regression"`
+	// Improvement is true if the latency is significantly lower in the new
+	// run.
+	Improvement bool `json:"improvement"`
+}
+
+// CompareSummaries compares the latencies of the percentiles of the reports
+// of the same names of a baseline run and of a new run, in the order of the
+// new run. A change of the latency below threshold percent is never
+// significant.
+func CompareSummaries(old, cur []Summary, threshold float64) []Comparison {
+	olds := make(map[string]Summary, len(old))
+	for _, s := range old {
+		olds[s.Name] = s
+	}
+	var cs []Comparison
+	for _, s := range cur {
+		o, ok := olds[s.Name]
+		if !ok {
+			continue
+		}
+		for _, nl := range s.Latencies {
+			i := slices.IndexFunc(o.Latencies, func(l PercentileLatency) bool { return l.Percentile == nl.Percentile })
+			if i < 0 {
+				continue
+			}
+			ol := o.Latencies[i]
+			c := Comparison{Name: s.Name, Percentile: nl.Percentile, Old: ol.Latency, New: nl.Latency}
+			if ol.Latency > 0 {
+				c.Change = (nl.Latency - ol.Latency) / ol.Latency * 100
+			}
+			c.Regression = nl.Lower > ol.Upper && c.Change > threshold
+			c.Improvement = nl.Upper < ol.Lower && -c.Change > threshold
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONReport(t *testing.T) {
+	r := NewJSONReport(NewReport("%f", "", false), "put")
+	go func() {
+		start := time.Now()
+		for i := 1; i <= 1000; i++ {
+			r.Results() <- Result{Start: start, End: start.Add(time.Duration(i) * time.Millisecond)}
+		}
+		r.Results() <- Result{Start: start, End: start, Err: errors.New("oops")}
+		close(r.Results())
+	}()
+
+	var s Summary
+	require.NoError(t, json.Unmarshal([]byte(<-r.Run()), &s))
+	assert.Equal(t, "put", s.Name)
+	assert.Equal(t, 1000, s.Requests)
+	assert.Equal(t, 1, s.Errors)
+	assert.InDelta(t, 0.001, s.Fastest, 1e-9)
+	assert.InDelta(t, 1.0, s.Slowest, 1e-9)
+	require.Len(t, s.Latencies, len(pctls))
+	for _, l := range s.Latencies {
+		assert.LessOrEqual(t, l.Lower, l.Latency)
+		assert.GreaterOrEqual(t, l.Upper, l.Latency)
+	}
+	// the 50th percentile is within +/-1.96*sqrt(1000*0.5*0.5) = 31 ranks
+	p50 := s.Latencies[2]
+	assert.InDelta(t, 0.501, p50.Latency, 1e-9)
+	assert.InDelta(t, 0.470, p50.Lower, 1e-9)
+	assert.InDelta(t, 0.532, p50.Upper, 1e-9)
+}
+
+func TestCompareSummaries(t *testing.T) {
+	summary := func(name string, lat, lower, upper float64) Summary {
+		return Summary{Name: name, Latencies: []PercentileLatency{{Percentile: 50, Latency: lat, Lower: lower, Upper: upper}}}
+	}
+	old := []Summary{
+		summary("slower", 1.0, 0.9, 1.1),
+		summary("faster", 1.0, 0.9, 1.1),
+		summary("overlapping", 1.0, 0.9, 1.1),
+		summary("below-threshold", 1.0, 0.99, 1.01),
+	}
+	cur := []Summary{
+		summary("slower", 1.5, 1.4, 1.6),
+		summary("faster", 0.5, 0.4, 0.6),
+		summary("overlapping", 1.2, 1.0, 1.4),
+		summary("below-threshold", 1.03, 1.02, 1.04),
+		summary("new", 1.0, 0.9, 1.1),
+	}
+	cs := CompareSummaries(old, cur, 5)
+	require.Len(t, cs, 4)
+	assert.Equal(t, Comparison{Name: "slower", Percentile: 50, Old: 1.0, New: 1.5, Change: 50, Regression: true}, cs[0])
+	assert.Equal(t, Comparison{Name: "faster", Percentile: 50, Old: 1.0, New: 0.5, Change: -50, Improvement: true}, cs[1])
+	assert.False(t, cs[2].Regression)
+	assert.False(t, cs[3].Regression)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronNext(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2025, time.January, 15, 10, 30, 20, 0, time.UTC)
+	tcs := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, time.January, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2025, time.January, 16, 10, 30, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2025, time.January, 16, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2025, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2025, time.January, 15, 11, 0, 0, 0, time.UTC)},
+		{"0 3 * * 6,7", time.Date(2025, time.January, 18, 3, 0, 0, 0, time.UTC)},
+		{"0 3 * * 0", time.Date(2025, time.January, 19, 3, 0, 0, 0, time.UTC)},
+		{"0 0 1 */3 *", time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 22-23/1 * * 1-5", time.Date(2025, time.January, 15, 22, 0, 0, 0, time.UTC)},
+		// the day fields match if any does when both are restricted
+		{"0 0 20 * 5", time.Date(2025, time.January, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.spec, func(t *testing.T) {
+			c, err := ParseCron(tc.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, c.Next(now))
+			assert.Equal(t, tc.spec, c.String())
+		})
+	}
+}
+
+func TestCronNextNever(t *testing.T) {
+	c, err := ParseCron("0 0 31 2 *")
+	require.NoError(t, err)
+	assert.True(t, c.Next(time.Now()).IsZero())
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@sometimes",
+	} {
+		_, err := ParseCron(spec)
+		assert.Errorf(t, err, "spec %q", spec)
+	}
+}
@@ -76,6 +76,7 @@ tools_build() {
   tools_path="tools/benchmark
     tools/etcd-dump-db
     tools/etcd-dump-logs
+    tools/etcd-topology
     tools/local-tester/bridge"
   for tool in ${tools_path}
   do
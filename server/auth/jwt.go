@@ -38,6 +38,7 @@ func (t *tokenJWT) enable()                         {}
 func (t *tokenJWT) disable()                        {}
 func (t *tokenJWT) invalidateUser(string)           {}
 func (t *tokenJWT) genTokenPrefix() (string, error) { return "", nil }
+func (t *tokenJWT) defaultTTL() time.Duration       { return t.ttl }
 
 func (t *tokenJWT) info(ctx context.Context, token string, rev uint64) (*AuthInfo, bool) {
 	// rev isn't used in JWT, it is only used in simple token
@@ -90,10 +91,13 @@ func (t *tokenJWT) info(ctx context.Context, token string, rev uint64) (*AuthInf
 	return &AuthInfo{Username: username, Revision: uint64(revision)}, true
 }
 
-func (t *tokenJWT) assign(ctx context.Context, username string, revision uint64) (string, error) {
+func (t *tokenJWT) assign(ctx context.Context, username string, revision uint64, ttl time.Duration) (string, error) {
 	if t.verifyOnly {
 		return "", ErrVerifyOnly
 	}
+	if ttl <= 0 {
+		ttl = t.ttl
+	}
 
 	// Future work: let a jwt token include permission information would be useful for
 	// permission checking in proxy side.
@@ -101,7 +105,7 @@ func (t *tokenJWT) assign(ctx context.Context, username string, revision uint64)
 		jwt.MapClaims{
 			"username": username,
 			"revision": revision,
-			"exp":      time.Now().Add(t.ttl).Unix(),
+			"exp":      time.Now().Add(ttl).Unix(),
 		})
 
 	token, err := tk.SignedString(t.key)
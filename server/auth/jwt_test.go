@@ -97,7 +97,7 @@ func testJWTInfo(t *testing.T, opts map[string]string) {
 
 	ctx := t.Context()
 
-	token, aerr := jwt.assign(ctx, "abc", 123)
+	token, aerr := jwt.assign(ctx, "abc", 123, 0)
 	if aerr != nil {
 		t.Fatalf("%#v", aerr)
 	}
@@ -128,7 +128,7 @@ func testJWTInfo(t *testing.T, opts map[string]string) {
 				t.Fatalf("expected aaa to fail to authenticate, got %+v", ai)
 			}
 
-			_, aerr := verify.assign(ctx, "abc", 123)
+			_, aerr := verify.assign(ctx, "abc", 123, 0)
 			require.ErrorIsf(t, aerr, ErrVerifyOnly, "unexpected error when attempting to sign with public key: %v", aerr)
 		})
 	}
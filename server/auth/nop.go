@@ -16,6 +16,7 @@ package auth
 
 import (
 	"context"
+	"time"
 )
 
 type tokenNop struct{}
@@ -24,11 +25,12 @@ func (t *tokenNop) enable()                         {}
 func (t *tokenNop) disable()                        {}
 func (t *tokenNop) invalidateUser(string)           {}
 func (t *tokenNop) genTokenPrefix() (string, error) { return "", nil }
+func (t *tokenNop) defaultTTL() time.Duration       { return 0 }
 func (t *tokenNop) info(ctx context.Context, token string, rev uint64) (*AuthInfo, bool) {
 	return nil, false
 }
 
-func (t *tokenNop) assign(ctx context.Context, username string, revision uint64) (string, error) {
+func (t *tokenNop) assign(ctx context.Context, username string, revision uint64, ttl time.Duration) (string, error) {
 	return "", ErrAuthFailed
 }
 
@@ -21,6 +21,8 @@ import (
 	"go.etcd.io/etcd/pkg/v3/adt"
 )
 
+// getMergedPerms computes the permissions of the user at the auth revision
+// of tx. It returns nil if the user does not exist.
 func getMergedPerms(tx UnsafeAuthReader, userName string) *unifiedRangePermissions {
 	user := tx.UnsafeGetUser(userName)
 	if user == nil {
@@ -65,6 +67,8 @@ func getMergedPerms(tx UnsafeAuthReader, userName string) *unifiedRangePermissio
 	}
 
 	return &unifiedRangePermissions{
+		revision:   tx.UnsafeReadAuthRevision(),
+		isRoot:     hasRootRole(user),
 		readPerms:  readPerms,
 		writePerms: writePerms,
 	}
@@ -107,54 +111,61 @@ func checkKeyPoint(lg *zap.Logger, cachedPerms *unifiedRangePermissions, key []b
 	return false
 }
 
-func (as *authStore) isRangeOpPermitted(userName string, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
-	// assumption: tx is Lock()ed
+func isRangeOpPermitted(lg *zap.Logger, perms *unifiedRangePermissions, key, rangeEnd []byte, permtyp authpb.Permission_Type) bool {
+	if len(rangeEnd) == 0 {
+		return checkKeyPoint(lg, perms, key, permtyp)
+	}
+	return checkKeyInterval(lg, perms, key, rangeEnd, permtyp)
+}
+
+// rangePerms returns the permissions of the user at the current auth revision.
+// They are computed once per user and auth revision: the cached permissions are
+// returned as long as the auth revision they were computed at is current, and
+// recomputed from the backend otherwise. It returns nil if the user does not
+// exist.
+func (as *authStore) rangePerms(userName string) *unifiedRangePermissions {
+	rev := as.Revision()
 	as.rangePermCacheMu.RLock()
-	defer as.rangePermCacheMu.RUnlock()
-
-	rangePerm, ok := as.rangePermCache[userName]
-	if !ok {
-		as.lg.Error(
-			"user doesn't exist",
-			zap.String("user-name", userName),
-		)
-		return false
+	perms, ok := as.rangePermCache[userName]
+	as.rangePermCacheMu.RUnlock()
+	if ok && perms.revision == rev {
+		return perms
 	}
 
-	if len(rangeEnd) == 0 {
-		return checkKeyPoint(as.lg, rangePerm, key, permtyp)
+	// The permissions are tagged with the auth revision read in the same
+	// transaction as the users and roles, so that permissions computed while
+	// an auth configuration change is being written are not cached as current.
+	tx := as.be.ReadTx()
+	tx.RLock()
+	perms = getMergedPerms(tx, userName)
+	tx.RUnlock()
+	if perms == nil {
+		return nil
 	}
 
-	return checkKeyInterval(as.lg, rangePerm, key, rangeEnd, permtyp)
+	as.rangePermCacheMu.Lock()
+	if cached, ok := as.rangePermCache[userName]; !ok || cached.revision < perms.revision {
+		as.rangePermCache[userName] = perms
+	}
+	as.rangePermCacheMu.Unlock()
+	return perms
 }
 
-func (as *authStore) refreshRangePermCache(tx UnsafeAuthReader) {
-	// Note that every authentication configuration update calls this method and it invalidates the entire
-	// rangePermCache and reconstruct it based on information of users and roles stored in the backend.
-	// This can be a costly operation.
+// invalidateRangePermCache drops all the cached permissions. Every
+// authentication configuration change calls this method; the permissions of
+// each user are then recomputed on its next request.
+func (as *authStore) invalidateRangePermCache() {
 	as.rangePermCacheMu.Lock()
 	defer as.rangePermCacheMu.Unlock()
-
-	as.lg.Debug("Refreshing rangePermCache")
-
-	as.rangePermCache = make(map[string]*unifiedRangePermissions)
-
-	users := tx.UnsafeGetAllUsers()
-	for _, user := range users {
-		userName := string(user.Name)
-		perms := getMergedPerms(tx, userName)
-		if perms == nil {
-			as.lg.Error(
-				"failed to create a merged permission",
-				zap.String("user-name", userName),
-			)
-			continue
-		}
-		as.rangePermCache[userName] = perms
-	}
+	clear(as.rangePermCache)
 }
 
 type unifiedRangePermissions struct {
+	// revision is the auth revision the permissions were computed at.
+	revision uint64
+	// isRoot is set if the user has the root role, which is permitted on all
+	// ranges.
+	isRoot     bool
 	readPerms  adt.IntervalTree
 	writePerms adt.IntervalTree
 }
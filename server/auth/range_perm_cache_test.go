@@ -15,11 +15,15 @@
 package auth
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
+	"golang.org/x/crypto/bcrypt"
 
 	"go.etcd.io/etcd/api/v3/authpb"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/pkg/v3/adt"
 )
 
@@ -219,3 +223,93 @@ func TestRangeCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestRangePermsCachedPerRevision(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	perm := &authpb.Permission{PermType: authpb.READ, Key: []byte("a"), RangeEnd: []byte("c")}
+	_, err := as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test", Perm: perm})
+	require.NoError(t, err)
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "role-test"})
+	require.NoError(t, err)
+
+	perms := as.rangePerms("foo")
+	require.NotNil(t, perms)
+	require.Equal(t, as.Revision(), perms.revision)
+	require.False(t, perms.isRoot)
+	require.Same(t, perms, as.rangePerms("foo"), "permissions should be computed once per auth revision")
+	require.NoError(t, as.isOpPermitted("foo", as.Revision(), []byte("b"), nil, authpb.READ))
+	require.ErrorIs(t, as.isOpPermitted("foo", as.Revision(), []byte("d"), nil, authpb.READ), ErrPermissionDenied)
+
+	// the auth revision bump invalidates the cached permissions
+	perm = &authpb.Permission{PermType: authpb.READ, Key: []byte("d")}
+	_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: "role-test", Perm: perm})
+	require.NoError(t, err)
+	require.NotSame(t, perms, as.rangePerms("foo"))
+	require.NoError(t, as.isOpPermitted("foo", as.Revision(), []byte("d"), nil, authpb.READ))
+
+	_, err = as.UserRevokeRole(&pb.AuthUserRevokeRoleRequest{Name: "foo", Role: "role-test"})
+	require.NoError(t, err)
+	require.ErrorIs(t, as.isOpPermitted("foo", as.Revision(), []byte("b"), nil, authpb.READ), ErrPermissionDenied)
+
+	require.True(t, as.rangePerms("root").isRoot)
+	require.NoError(t, as.IsAdminPermitted(&AuthInfo{Username: "root", Revision: as.Revision()}))
+	require.ErrorIs(t, as.IsAdminPermitted(&AuthInfo{Username: "foo", Revision: as.Revision()}), ErrPermissionDenied)
+	require.ErrorIs(t, as.IsAdminPermitted(&AuthInfo{Username: "nobody", Revision: as.Revision()}), ErrUserNotFound)
+}
+
+func TestRangePermsIgnoresStaleEntries(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	// permissions computed at an older auth revision are never used
+	as.rangePermCacheMu.Lock()
+	as.rangePermCache["foo"] = &unifiedRangePermissions{
+		revision:   as.Revision() - 1,
+		isRoot:     true,
+		readPerms:  adt.NewIntervalTree(),
+		writePerms: adt.NewIntervalTree(),
+	}
+	as.rangePermCacheMu.Unlock()
+
+	perms := as.rangePerms("foo")
+	require.NotNil(t, perms)
+	require.False(t, perms.isRoot)
+	require.Equal(t, as.Revision(), perms.revision)
+	require.ErrorIs(t, as.isOpPermitted("foo", as.Revision(), []byte("foo"), nil, authpb.WRITE), ErrPermissionDenied)
+}
+
+func BenchmarkIsOpPermitted(b *testing.B) {
+	tp, err := NewTokenProvider(zaptest.NewLogger(b), tokenTypeSimple, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(b, err)
+	as := NewAuthStore(zaptest.NewLogger(b), newBackendMock(), tp, bcrypt.MinCost)
+	defer as.Close()
+	require.NoError(b, enableAuthAndCreateRoot(as))
+
+	_, err = as.UserAdd(&pb.AuthUserAddRequest{Name: "foo", Options: &authpb.UserAddOptions{NoPassword: true}})
+	require.NoError(b, err)
+	for i := range 100 {
+		role := fmt.Sprintf("role-%d", i)
+		_, err = as.RoleAdd(&pb.AuthRoleAddRequest{Name: role})
+		require.NoError(b, err)
+		for j := range 10 {
+			key := fmt.Sprintf("/%d/%d/", i, j)
+			perm := &authpb.Permission{PermType: authpb.READWRITE, Key: []byte(key), RangeEnd: []byte(key + "\xff")}
+			_, err = as.RoleGrantPermission(&pb.AuthRoleGrantPermissionRequest{Name: role, Perm: perm})
+			require.NoError(b, err)
+		}
+		_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: role})
+		require.NoError(b, err)
+	}
+
+	authInfo := &AuthInfo{Username: "foo", Revision: as.Revision()}
+	key := []byte("/99/9/key")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		if err := as.IsPutPermitted(authInfo, key); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -44,7 +44,10 @@ var (
 )
 
 type simpleTokenTTLKeeper struct {
-	tokens          map[string]time.Time
+	tokens map[string]time.Time
+	// fixedTokens are the tokens assigned with a TTL of their own, which
+	// expire after it even if they are used.
+	fixedTokens     map[string]struct{}
 	donec           chan struct{}
 	stopc           chan struct{}
 	deleteTokenFunc func(string)
@@ -60,11 +63,21 @@ func (tm *simpleTokenTTLKeeper) stop() {
 	<-tm.donec
 }
 
-func (tm *simpleTokenTTLKeeper) addSimpleToken(token string) {
+// addSimpleToken adds a token expiring after ttl, or after the TTL of the
+// keeper without being used if ttl is 0.
+func (tm *simpleTokenTTLKeeper) addSimpleToken(token string, ttl time.Duration) {
+	if ttl > 0 {
+		tm.tokens[token] = time.Now().Add(ttl)
+		tm.fixedTokens[token] = struct{}{}
+		return
+	}
 	tm.tokens[token] = time.Now().Add(tm.simpleTokenTTL)
 }
 
 func (tm *simpleTokenTTLKeeper) resetSimpleToken(token string) {
+	if _, ok := tm.fixedTokens[token]; ok {
+		return
+	}
 	if _, ok := tm.tokens[token]; ok {
 		tm.tokens[token] = time.Now().Add(tm.simpleTokenTTL)
 	}
@@ -72,6 +85,7 @@ func (tm *simpleTokenTTLKeeper) resetSimpleToken(token string) {
 
 func (tm *simpleTokenTTLKeeper) deleteSimpleToken(token string) {
 	delete(tm.tokens, token)
+	delete(tm.fixedTokens, token)
 }
 
 func (tm *simpleTokenTTLKeeper) run() {
@@ -88,7 +102,7 @@ func (tm *simpleTokenTTLKeeper) run() {
 			for t, tokenendtime := range tm.tokens {
 				if nowtime.After(tokenendtime) {
 					tm.deleteTokenFunc(t)
-					delete(tm.tokens, t)
+					tm.deleteSimpleToken(t)
 				}
 			}
 			tm.mu.Unlock()
@@ -122,7 +136,7 @@ func (t *tokenSimple) genTokenPrefix() (string, error) {
 	return string(ret), nil
 }
 
-func (t *tokenSimple) assignSimpleTokenToUser(username, token string) {
+func (t *tokenSimple) assignSimpleTokenToUser(username, token string, ttl time.Duration) {
 	t.simpleTokensMu.Lock()
 	defer t.simpleTokensMu.Unlock()
 	if t.simpleTokenKeeper == nil {
@@ -139,7 +153,7 @@ func (t *tokenSimple) assignSimpleTokenToUser(username, token string) {
 	}
 
 	t.simpleTokens[token] = username
-	t.simpleTokenKeeper.addSimpleToken(token)
+	t.simpleTokenKeeper.addSimpleToken(token, ttl)
 }
 
 func (t *tokenSimple) invalidateUser(username string) {
@@ -178,6 +192,7 @@ func (t *tokenSimple) enable() {
 	}
 	t.simpleTokenKeeper = &simpleTokenTTLKeeper{
 		tokens:          make(map[string]time.Time),
+		fixedTokens:     make(map[string]struct{}),
 		donec:           make(chan struct{}),
 		stopc:           make(chan struct{}),
 		deleteTokenFunc: delf,
@@ -211,7 +226,7 @@ func (t *tokenSimple) info(ctx context.Context, token string, revision uint64) (
 	return &AuthInfo{Username: username, Revision: revision}, ok
 }
 
-func (t *tokenSimple) assign(ctx context.Context, username string, rev uint64) (string, error) {
+func (t *tokenSimple) assign(ctx context.Context, username string, rev uint64, ttl time.Duration) (string, error) {
 	// rev isn't used in simple token, it is only used in JWT
 	var index uint64
 	var ok bool
@@ -220,11 +235,18 @@ func (t *tokenSimple) assign(ctx context.Context, username string, rev uint64) (
 	}
 	simpleTokenPrefix := ctx.Value(AuthenticateParamSimpleTokenPrefix{}).(string)
 	token := fmt.Sprintf("%s.%d", simpleTokenPrefix, index)
-	t.assignSimpleTokenToUser(username, token)
+	t.assignSimpleTokenToUser(username, token, ttl)
 
 	return token, nil
 }
 
+func (t *tokenSimple) defaultTTL() time.Duration {
+	if t.simpleTokenTTL <= 0 {
+		return simpleTokenTTLDefault
+	}
+	return t.simpleTokenTTL
+}
+
 func (t *tokenSimple) isValidSimpleToken(ctx context.Context, token string) bool {
 	splitted := strings.Split(token, ".")
 	if len(splitted) != 2 {
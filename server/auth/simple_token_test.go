@@ -17,6 +17,7 @@ package auth
 import (
 	"context"
 	"testing"
+	"time"
 
 	"go.uber.org/zap/zaptest"
 )
@@ -32,7 +33,7 @@ func TestSimpleTokenDisabled(t *testing.T) {
 
 	for _, tp := range []*tokenSimple{initialState, explicitlyDisabled} {
 		ctx := context.WithValue(context.WithValue(t.Context(), AuthenticateParamIndex{}, uint64(1)), AuthenticateParamSimpleTokenPrefix{}, "dummy")
-		token, err := tp.assign(ctx, "user1", 0)
+		token, err := tp.assign(ctx, "user1", 0, 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -52,7 +53,7 @@ func TestSimpleTokenAssign(t *testing.T) {
 	tp.enable()
 	defer tp.disable()
 	ctx := context.WithValue(context.WithValue(t.Context(), AuthenticateParamIndex{}, uint64(1)), AuthenticateParamSimpleTokenPrefix{}, "dummy")
-	token, err := tp.assign(ctx, "user1", 0)
+	token, err := tp.assign(ctx, "user1", 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -68,3 +69,31 @@ func TestSimpleTokenAssign(t *testing.T) {
 		t.Errorf("expected ok == false after user is invalidated")
 	}
 }
+
+// TestSimpleTokenAssignTTL ensures that a token assigned with a TTL of its own
+// expires after it even if it is used.
+func TestSimpleTokenAssignTTL(t *testing.T) {
+	tp := newTokenProviderSimple(zaptest.NewLogger(t), dummyIndexWaiter, simpleTokenTTLDefault)
+	tp.enable()
+	defer tp.disable()
+	ctx := context.WithValue(context.WithValue(t.Context(), AuthenticateParamIndex{}, uint64(1)), AuthenticateParamSimpleTokenPrefix{}, "dummy")
+	token, err := tp.assign(ctx, "user1", 0, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tp.simpleTokensMu.Lock()
+	expiry := tp.simpleTokenKeeper.tokens[token]
+	tp.simpleTokensMu.Unlock()
+	if time.Until(expiry) > time.Minute {
+		t.Errorf("expected the token to expire within %v, got %v", time.Minute, time.Until(expiry))
+	}
+
+	if _, ok := tp.info(ctx, token, 0); !ok {
+		t.Fatal("expected the token to be valid")
+	}
+	tp.simpleTokensMu.Lock()
+	defer tp.simpleTokensMu.Unlock()
+	if got := tp.simpleTokenKeeper.tokens[token]; !got.Equal(expiry) {
+		t.Errorf("expected the expiry of the token to stay %v after it is used, got %v", expiry, got)
+	}
+}
@@ -172,6 +172,10 @@ type AuthStore interface {
 	// AuthInfoFromTLS gets AuthInfo from TLS info of gRPC's context
 	AuthInfoFromTLS(ctx context.Context) *AuthInfo
 
+	// Impersonate gets the AuthInfo of the user impersonated by the gRPC's
+	// context, or the given AuthInfo if no user is impersonated
+	Impersonate(ctx context.Context, authInfo *AuthInfo) (*AuthInfo, error)
+
 	// WithRoot generates and installs a token that can be used as a root credential
 	WithRoot(ctx context.Context) context.Context
 
@@ -180,11 +184,30 @@ type AuthStore interface {
 
 	// BcryptCost gets strength of hashing bcrypted auth password
 	BcryptCost() int
+
+	// TokenTTL gets the lifetime of the tokens assigned to the user
+	TokenTTL(username string) time.Duration
+}
+
+// StoreOption configures the auth store created by NewAuthStore.
+type StoreOption func(*authStore)
+
+// WithRoleTokenTTLs overrides the lifetime of the tokens of the users having
+// the given roles, by role name. The tokens of a user having several of them
+// live for the shortest one. Unlike the simple tokens of the other users, which
+// live until they are not used for the TTL of the token provider, these tokens
+// expire after their lifetime even if they are used, and are refreshed with
+// AuthTokenRefresh.
+func WithRoleTokenTTLs(ttls map[string]time.Duration) StoreOption {
+	return func(as *authStore) { as.roleTokenTTLs = ttls }
 }
 
 type TokenProvider interface {
 	info(ctx context.Context, token string, revision uint64) (*AuthInfo, bool)
-	assign(ctx context.Context, username string, revision uint64) (string, error)
+	// assign assigns a token to the user, living for ttl, or for the default
+	// TTL of the provider if ttl is 0.
+	assign(ctx context.Context, username string, revision uint64, ttl time.Duration) (string, error)
+	defaultTTL() time.Duration
 	enable()
 	disable()
 
@@ -249,8 +272,9 @@ type authStore struct {
 	enabledMu sync.RWMutex
 
 	// rangePermCache needs to be protected by rangePermCacheMu
-	// rangePermCacheMu needs to be write locked only in initialization phase or configuration changes
-	// Hot paths like Range(), needs to acquire read lock for improving performance
+	// rangePermCacheMu needs to be write locked only when the permissions of a user are computed or the cache
+	// is invalidated by configuration changes. Hot paths like Range(), needs to acquire read lock for improving
+	// performance
 	//
 	// Note that BatchTx and ReadTx cannot be a mutex for rangePermCache because they are independent resources
 	// see also: https://github.com/etcd-io/etcd/pull/13920#discussion_r849114855
@@ -259,6 +283,9 @@ type authStore struct {
 
 	tokenProvider TokenProvider
 	bcryptCost    int // the algorithm cost / strength for hashing auth passwords
+	// roleTokenTTLs are the lifetimes of the tokens of the users by role,
+	// overriding the TTL of the token provider.
+	roleTokenTTLs map[string]time.Duration
 }
 
 func (as *authStore) AuthEnable() error {
@@ -288,7 +315,7 @@ func (as *authStore) AuthEnable() error {
 	as.enabled = true
 	as.tokenProvider.enable()
 
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.setRevision(tx.UnsafeReadAuthRevision())
 
@@ -344,7 +371,7 @@ func (as *authStore) Authenticate(ctx context.Context, username, password string
 	// Password checking is already performed in the API layer, so we don't need to check for now.
 	// Staleness of password can be detected with OCC in the API layer, too.
 
-	token, err := as.tokenProvider.assign(ctx, username, as.Revision())
+	token, err := as.tokenProvider.assign(ctx, username, as.Revision(), as.roleTokenTTL(user))
 	if err != nil {
 		return nil, err
 	}
@@ -357,6 +384,28 @@ func (as *authStore) Authenticate(ctx context.Context, username, password string
 	return &pb.AuthenticateResponse{Token: token}, nil
 }
 
+func (as *authStore) TokenTTL(username string) time.Duration {
+	if ttl := as.roleTokenTTL(as.be.GetUser(username)); ttl > 0 {
+		return ttl
+	}
+	return as.tokenProvider.defaultTTL()
+}
+
+// roleTokenTTL returns the shortest lifetime of the tokens of the roles of the
+// user, or 0 if none of its roles overrides it.
+func (as *authStore) roleTokenTTL(user *authpb.User) time.Duration {
+	if user == nil {
+		return 0
+	}
+	var ttl time.Duration
+	for _, role := range user.Roles {
+		if t, ok := as.roleTokenTTLs[role]; ok && (ttl == 0 || t < ttl) {
+			ttl = t
+		}
+	}
+	return ttl
+}
+
 func (as *authStore) CheckPassword(username, password string) (uint64, error) {
 	if !as.IsAuthEnabled() {
 		return 0, ErrAuthNotEnabled
@@ -399,7 +448,7 @@ func (as *authStore) Recover(be AuthBackend) {
 
 	enabled := tx.UnsafeReadAuthEnabled()
 	as.setRevision(tx.UnsafeReadAuthRevision())
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	tx.RUnlock()
 
@@ -458,7 +507,7 @@ func (as *authStore) UserAdd(r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse,
 	tx.UnsafePutUser(newUser)
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.lg.Info("added a user", zap.String("user-name", r.Name))
 	return &pb.AuthUserAddResponse{}, nil
@@ -481,7 +530,7 @@ func (as *authStore) UserDelete(r *pb.AuthUserDeleteRequest) (*pb.AuthUserDelete
 	tx.UnsafeDeleteUser(r.Name)
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.tokenProvider.invalidateUser(r.Name)
 
@@ -523,7 +572,7 @@ func (as *authStore) UserChangePassword(r *pb.AuthUserChangePasswordRequest) (*p
 	tx.UnsafePutUser(updatedUser)
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.tokenProvider.invalidateUser(r.Name)
 
@@ -569,7 +618,7 @@ func (as *authStore) UserGrantRole(r *pb.AuthUserGrantRoleRequest) (*pb.AuthUser
 	tx.UnsafePutUser(user)
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.lg.Info(
 		"granted a role to a user",
@@ -640,7 +689,7 @@ func (as *authStore) UserRevokeRole(r *pb.AuthUserRevokeRoleRequest) (*pb.AuthUs
 	tx.UnsafePutUser(updatedUser)
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.lg.Info(
 		"revoked a role from a user",
@@ -704,7 +753,7 @@ func (as *authStore) RoleRevokePermission(r *pb.AuthRoleRevokePermissionRequest)
 	tx.UnsafePutRole(updatedRole)
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.lg.Info(
 		"revoked a permission on range",
@@ -754,7 +803,7 @@ func (as *authStore) RoleDelete(r *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDelete
 	}
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.lg.Info("deleted a role", zap.String("role-name", r.Role))
 	return &pb.AuthRoleDeleteResponse{}, nil
@@ -843,7 +892,7 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 	tx.UnsafePutRole(role)
 
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	as.lg.Info(
 		"granted/updated a permission to a user",
@@ -856,7 +905,6 @@ func (as *authStore) RoleGrantPermission(r *pb.AuthRoleGrantPermissionRequest) (
 }
 
 func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeEnd []byte, permTyp authpb.Permission_Type) error {
-	// TODO(mitake): this function would be costly so we need a caching mechanism
 	if !as.IsAuthEnabled() {
 		return nil
 	}
@@ -875,22 +923,18 @@ func (as *authStore) isOpPermitted(userName string, revision uint64, key, rangeE
 		return ErrAuthOldRevision
 	}
 
-	tx := as.be.ReadTx()
-	tx.RLock()
-	defer tx.RUnlock()
-
-	user := tx.UnsafeGetUser(userName)
-	if user == nil {
+	perms := as.rangePerms(userName)
+	if perms == nil {
 		as.lg.Error("cannot find a user for permission check", zap.String("user-name", userName))
 		return ErrPermissionDenied
 	}
 
 	// root role should have permission on all ranges
-	if hasRootRole(user) {
+	if perms.isRoot {
 		return nil
 	}
 
-	if as.isRangeOpPermitted(userName, key, rangeEnd, permTyp) {
+	if isRangeOpPermitted(as.lg, perms, key, rangeEnd, permTyp) {
 		return nil
 	}
 
@@ -917,16 +961,12 @@ func (as *authStore) IsAdminPermitted(authInfo *AuthInfo) error {
 		return ErrUserEmpty
 	}
 
-	tx := as.be.ReadTx()
-	tx.RLock()
-	defer tx.RUnlock()
-	u := tx.UnsafeGetUser(authInfo.Username)
-
-	if u == nil {
+	perms := as.rangePerms(authInfo.Username)
+	if perms == nil {
 		return ErrUserNotFound
 	}
 
-	if !hasRootRole(u) {
+	if !perms.isRoot {
 		return ErrPermissionDenied
 	}
 
@@ -940,7 +980,7 @@ func (as *authStore) IsAuthEnabled() bool {
 }
 
 // NewAuthStore creates a new AuthStore.
-func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost int) AuthStore {
+func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost int, opts ...StoreOption) AuthStore {
 	if lg == nil {
 		lg = zap.NewNop()
 	}
@@ -971,6 +1011,9 @@ func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost i
 		tokenProvider:  tp,
 		bcryptCost:     bcryptCost,
 	}
+	for _, opt := range opts {
+		opt(as)
+	}
 
 	if enabled {
 		as.tokenProvider.enable()
@@ -982,7 +1025,7 @@ func NewAuthStore(lg *zap.Logger, be AuthBackend, tp TokenProvider, bcryptCost i
 
 	as.setupMetricsReporter()
 
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 
 	tx.Unlock()
 	be.ForceCommit()
@@ -1081,6 +1124,40 @@ func (as *authStore) AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error) {
 	return authInfo, nil
 }
 
+// Impersonate returns the AuthInfo of the user named by the impersonation
+// metadata of the context, so that the request is executed with the
+// permissions of that user. Only root users may impersonate other users.
+func (as *authStore) Impersonate(ctx context.Context, authInfo *AuthInfo) (*AuthInfo, error) {
+	if !as.IsAuthEnabled() {
+		return authInfo, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return authInfo, nil
+	}
+	users := md[rpctypes.MetadataImpersonateUserKey]
+	if len(users) == 0 {
+		return authInfo, nil
+	}
+	if err := as.IsAdminPermitted(authInfo); err != nil {
+		return nil, err
+	}
+
+	tx := as.be.ReadTx()
+	tx.RLock()
+	u := tx.UnsafeGetUser(users[0])
+	tx.RUnlock()
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	as.lg.Info(
+		"impersonating user",
+		zap.String("user-name", authInfo.Username),
+		zap.String("impersonated-user-name", users[0]),
+	)
+	return &AuthInfo{Username: users[0], Revision: authInfo.Revision}, nil
+}
+
 func (as *authStore) GenTokenPrefix() (string, error) {
 	return as.tokenProvider.genTokenPrefix()
 }
@@ -1175,7 +1252,7 @@ func (as *authStore) WithRoot(ctx context.Context) context.Context {
 		ctxForAssign = ctx
 	}
 
-	token, err := as.tokenProvider.assign(ctxForAssign, "root", as.Revision())
+	token, err := as.tokenProvider.assign(ctxForAssign, "root", as.Revision(), 0)
 	if err != nil {
 		// this must not happen
 		as.lg.Error(
@@ -132,7 +132,7 @@ func addUserWithNoOption(as *authStore) {
 		Password: []byte("bar"),
 	})
 	as.commitRevision(tx)
-	as.refreshRangePermCache(tx)
+	as.invalidateRangePermCache()
 }
 
 func enableAuthAndCreateRoot(as AuthStore) error {
@@ -170,8 +170,7 @@ func TestUserAdd(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, ok := as.rangePermCache[userName]
-	require.Truef(t, ok, "user %s should be added but it doesn't exist in rangePermCache", userName)
+	require.NotNilf(t, as.rangePerms(userName), "user %s should be added but it doesn't have permissions", userName)
 }
 
 func TestRecover(t *testing.T) {
@@ -196,11 +195,10 @@ func TestRecoverWithEmptyRangePermCache(t *testing.T) {
 
 	require.Truef(t, as.IsAuthEnabled(), "expected auth enabled got disabled")
 
+	for _, userName := range []string{"root", "foo", "foo-no-user-options"} {
+		require.NotNilf(t, as.rangePerms(userName), "user %q should be created by setupAuthStore() but doesn't have permissions", userName)
+	}
 	require.Lenf(t, as.rangePermCache, 3, "rangePermCache should have permission information for 3 users (\"root\" and \"foo\",\"foo-no-user-options\"), but has %d information", len(as.rangePermCache))
-	_, ok := as.rangePermCache["root"]
-	require.Truef(t, ok, "user \"root\" should be created by setupAuthStore() but doesn't exist in rangePermCache")
-	_, ok = as.rangePermCache["foo"]
-	require.Truef(t, ok, "user \"foo\" should be created by setupAuthStore() but doesn't exist in rangePermCache")
 }
 
 func TestCheckPassword(t *testing.T) {
@@ -241,6 +239,7 @@ func TestUserDelete(t *testing.T) {
 	require.Errorf(t, err, "expected %v, got %v", ErrUserNotFound, err)
 	require.ErrorIsf(t, err, ErrUserNotFound, "expected %v, got %v", ErrUserNotFound, err)
 
+	require.Nilf(t, as.rangePerms(userName), "user %s should be deleted but it still has permissions", userName)
 	_, ok := as.rangePermCache[userName]
 	require.Falsef(t, ok, "user %s should be deleted but it exists in rangePermCache", userName)
 }
@@ -261,6 +260,7 @@ func TestUserDeleteAndPermCache(t *testing.T) {
 	_, err = as.UserDelete(ud)
 	require.ErrorIsf(t, err, ErrUserNotFound, "expected %v, got %v", ErrUserNotFound, err)
 
+	require.Nilf(t, as.rangePerms(deletedUserName), "user %s should be deleted but it still has permissions", deletedUserName)
 	_, ok := as.rangePermCache[deletedUserName]
 	require.Falsef(t, ok, "user %s should be deleted but it exists in rangePermCache", deletedUserName)
 
@@ -272,8 +272,7 @@ func TestUserDeleteAndPermCache(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, ok = as.rangePermCache[newUser]
-	require.Truef(t, ok, "user %s should exist but it doesn't exist in rangePermCache", deletedUserName)
+	require.NotNilf(t, as.rangePerms(newUser), "user %s should exist but it doesn't have permissions", newUser)
 }
 
 func TestUserChangePassword(t *testing.T) {
@@ -346,6 +345,38 @@ func TestUserGrant(t *testing.T) {
 	}
 }
 
+func TestTokenTTL(t *testing.T) {
+	tp, err := NewTokenProvider(zaptest.NewLogger(t), tokenTypeSimple, dummyIndexWaiter, simpleTokenTTLDefault)
+	require.NoError(t, err)
+	as := NewAuthStore(zaptest.NewLogger(t), newBackendMock(), tp, bcrypt.MinCost,
+		WithRoleTokenTTLs(map[string]time.Duration{"automation": time.Minute, "ci": 30 * time.Second}))
+	defer as.Close()
+	require.NoError(t, enableAuthAndCreateRoot(as))
+	for _, role := range []string{"automation", "ci"} {
+		_, err = as.RoleAdd(&pb.AuthRoleAddRequest{Name: role})
+		require.NoError(t, err)
+	}
+	_, err = as.UserAdd(&pb.AuthUserAddRequest{Name: "foo", HashedPassword: encodePassword("bar"), Options: &authpb.UserAddOptions{}})
+	require.NoError(t, err)
+
+	require.Equal(t, simpleTokenTTLDefault, as.TokenTTL("foo"))
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "automation"})
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, as.TokenTTL("foo"))
+	_, err = as.UserGrantRole(&pb.AuthUserGrantRoleRequest{User: "foo", Role: "ci"})
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, as.TokenTTL("foo"))
+
+	ctx := context.WithValue(context.WithValue(t.Context(), AuthenticateParamIndex{}, uint64(1)), AuthenticateParamSimpleTokenPrefix{}, "dummy")
+	resp, err := as.Authenticate(ctx, "foo", "bar")
+	require.NoError(t, err)
+	ts := tp.(*tokenSimple)
+	ts.simpleTokensMu.Lock()
+	defer ts.simpleTokensMu.Unlock()
+	require.Contains(t, ts.simpleTokenKeeper.fixedTokens, resp.Token)
+	require.WithinDuration(t, time.Now().Add(30*time.Second), ts.simpleTokenKeeper.tokens[resp.Token], time.Second)
+}
+
 func TestHasRole(t *testing.T) {
 	as, tearDown := setupAuthStore(t)
 	defer tearDown(t)
@@ -406,12 +437,12 @@ func TestIsOpPermitted(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	// Drop the user's permission from cache and expect a permission denied
-	// error.
+	// Drop the user's permission from cache and expect it to be recomputed
+	// from the backend.
 	as.rangePermCacheMu.Lock()
 	delete(as.rangePermCache, "foo")
 	as.rangePermCacheMu.Unlock()
-	if err := as.isOpPermitted("foo", as.Revision(), perm.Key, perm.RangeEnd, perm.PermType); !errors.Is(err, ErrPermissionDenied) {
+	if err := as.isOpPermitted("foo", as.Revision(), perm.Key, perm.RangeEnd, perm.PermType); err != nil {
 		t.Fatal(err)
 	}
 }
@@ -758,9 +789,8 @@ func TestUserRevokePermission(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	_, ok := as.rangePermCache[userName]
-	require.Truef(t, ok, "User %s should have its entry in rangePermCache", userName)
-	unifiedPerm := as.rangePermCache[userName]
+	unifiedPerm := as.rangePerms(userName)
+	require.NotNilf(t, unifiedPerm, "User %s should have its entry in rangePermCache", userName)
 	pt1 := adt.NewBytesAffinePoint([]byte("WriteKeyBegin"))
 	require.Truef(t, unifiedPerm.writePerms.Contains(pt1), "rangePermCache should contain WriteKeyBegin")
 	pt2 := adt.NewBytesAffinePoint([]byte("OutOfRange"))
@@ -1172,3 +1202,30 @@ func TestUserChangePasswordWithOldLog(t *testing.T) {
 	require.Errorf(t, err, "expected %v, got %v", ErrUserNotFound, err)
 	require.ErrorIsf(t, err, ErrUserNotFound, "expected %v, got %v", ErrUserNotFound, err)
 }
+
+func TestImpersonate(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	root := &AuthInfo{Username: "root", Revision: as.Revision()}
+	foo := &AuthInfo{Username: "foo", Revision: as.Revision()}
+
+	// no impersonation requested
+	ai, err := as.Impersonate(t.Context(), foo)
+	require.NoError(t, err)
+	require.Equal(t, foo, ai)
+
+	impersonate := func(user string) context.Context {
+		return metadata.NewIncomingContext(t.Context(), metadata.Pairs(rpctypes.MetadataImpersonateUserKey, user))
+	}
+
+	ai, err = as.Impersonate(impersonate("foo"), root)
+	require.NoError(t, err)
+	require.Equal(t, &AuthInfo{Username: "foo", Revision: root.Revision}, ai)
+
+	_, err = as.Impersonate(impersonate("root"), foo)
+	require.ErrorIs(t, err, ErrPermissionDenied)
+
+	_, err = as.Impersonate(impersonate("nonexistent"), root)
+	require.ErrorIs(t, err, ErrUserNotFound)
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 
@@ -31,6 +32,7 @@ import (
 	"go.etcd.io/etcd/pkg/v3/featuregate"
 	"go.etcd.io/etcd/pkg/v3/netutil"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
+	"go.etcd.io/etcd/server/v3/etcdserver/validator"
 	"go.etcd.io/etcd/server/v3/storage/datadir"
 )
 
@@ -62,6 +64,11 @@ type ServerConfig struct {
 
 	MaxSnapFiles uint
 	MaxWALFiles  uint
+	// MaxWALBytes is the maximum total size of WAL files to retain.
+	// 0 means no size limit.
+	MaxWALBytes int64
+	// MaxWALAge is the maximum age of WAL files to retain. 0 means no age limit.
+	MaxWALAge time.Duration
 
 	// BackendBatchInterval is the maximum time before commit the backend transaction.
 	BackendBatchInterval time.Duration
@@ -70,6 +77,8 @@ type ServerConfig struct {
 
 	// BackendFreelistType is the type of the backend boltdb freelist.
 	BackendFreelistType bolt.FreelistType
+	// BackendSnapshotSpool makes backend snapshots spool their read transaction to a temporary file.
+	BackendSnapshotSpool bool
 
 	InitialPeerURLsMap  types.URLsMap
 	InitialClusterToken string
@@ -122,10 +131,25 @@ type ServerConfig struct {
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
 	QuotaBackendBytes       int64
-	MaxTxnOps               uint
+	// QuotaBackendWarningRatio is the ratio of the backend quota past which
+	// the responses to mutating requests carry a quota warning header. Zero
+	// disables the warning.
+	QuotaBackendWarningRatio float64
+	MaxTxnOps                uint
+	// MaxKeysPerPrefix are the maximum numbers of keys under the prefixes,
+	// past which the requests creating keys under them are rejected when
+	// applied. All the members must be configured with the same limits.
+	MaxKeysPerPrefix map[string]int64
+	// ValueValidators are the validators of the values written under the
+	// prefixes, checked before the writes are proposed.
+	ValueValidators map[string]validator.Validator
 
 	// MaxRequestBytes is the maximum request size to send over raft.
 	MaxRequestBytes uint
+	// WarningValueSizeBytes is the value size past which the responses to the
+	// requests writing values carry a value size warning header. Zero
+	// disables the warning.
+	WarningValueSizeBytes uint
 
 	// MaxConcurrentStreams specifies the maximum number of concurrent
 	// streams that each client can open at a time.
@@ -134,14 +158,29 @@ type ServerConfig struct {
 	WarningApplyDuration        time.Duration
 	WarningUnaryRequestDuration time.Duration
 
+	// ApplyDigestLog is the file the digests of the applied entries are
+	// recorded into. Empty disables the recording.
+	ApplyDigestLog string
+	// ApplyDigestLogEntries is the number of digests kept by the apply
+	// digest log.
+	ApplyDigestLogEntries int
+
 	StrictReconfigCheck bool
 
 	// ClientCertAuthEnabled is true when cert has been signed by the client CA.
 	ClientCertAuthEnabled bool
 
+	// MetricsRegisterer is the registerer the metrics registered by the
+	// server when it starts are registered to, instead of the prometheus
+	// default registerer.
+	MetricsRegisterer prometheus.Registerer
+
 	AuthToken  string
 	BcryptCost uint
 	TokenTTL   uint
+	// RoleTokenTTLs are the lifetimes of the auth tokens of the users by role,
+	// overriding TokenTTL.
+	RoleTokenTTLs map[string]time.Duration
 
 	// InitialCorruptCheck is true to check data corruption on boot
 	// before serving any peer/client traffic.
@@ -149,6 +188,35 @@ type ServerConfig struct {
 	CorruptCheckTime     time.Duration
 	CompactHashCheckTime time.Duration
 
+	// DefragSchedule is the cron expression of the times the leader
+	// defragments the members of the cluster, one at a time. Empty disables
+	// the scheduled defragmentation, and the leader cannot defragment this
+	// member.
+	DefragSchedule string
+	// DefragFreeRatioThreshold is the ratio of the backend size a member must
+	// free to be defragmented by the scheduled defragmentation.
+	DefragFreeRatioThreshold float64
+
+	// RequestFingerprintWindow is the window of the detection of the shifts
+	// of the request patterns of the clients. 0 disables the detection.
+	RequestFingerprintWindow time.Duration
+	// RequestFingerprintMinRequests is the number of requests of a new
+	// pattern of a client in a window from which it is reported.
+	RequestFingerprintMinRequests int
+
+	// WebhookURLs are the webhooks the events on the critical conditions of
+	// the member are posted to. Empty disables the events.
+	WebhookURLs []string
+	// WebhookSecret signs the requests posted to the webhooks if not empty.
+	WebhookSecret []byte
+	// WebhookQuotaThreshold is the ratio of the backend quota used from which
+	// the member posts a quota nearly exceeded event.
+	WebhookQuotaThreshold float64
+
+	// LearnerServeWatch is true to serve the Watch requests while the member
+	// is a learner.
+	LearnerServeWatch bool
+
 	// PreVote is true to enable Raft Pre-Vote.
 	PreVote bool
 
@@ -171,6 +239,14 @@ type ServerConfig struct {
 	TracerOptions []otelgrpc.Option
 
 	WatchProgressNotifyInterval time.Duration
+	// WatchSkipIndexBlockSize is the number of revisions summarized by each
+	// block of the watch skip index. Zero disables the index.
+	WatchSkipIndexBlockSize int64
+
+	// HeartbeatProtection sheds the low priority work, the sync of the
+	// unsynced watchers and the metrics scrapes, while the raft loop is
+	// found falling behind its ticks, to protect the heartbeats.
+	HeartbeatProtection bool
 
 	// UnsafeNoFsync disables all uses of fsync.
 	// Setting this is unsafe and will cause data loss.
@@ -193,9 +269,21 @@ type ServerConfig struct {
 	// MaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	MaxLearners int `json:"max-learners"`
 
+	// MaxVotingMembers sets a limit to the number of voting members that can exist in the cluster membership.
+	// Zero means no limit.
+	MaxVotingMembers int `json:"max-voting-members"`
+
+	// MaxConcurrentSnapshotSends limits the number of snapshots the leader transmits to
+	// followers at the same time. Zero means no limit.
+	MaxConcurrentSnapshotSends int `json:"max-concurrent-snapshot-sends"`
+
 	// V2Deprecation defines a phase of v2store deprecation process.
 	V2Deprecation V2DeprecationEnum `json:"v2-deprecation"`
 
+	// DeprecatedFlags are the deprecated flags set on the command line or in
+	// the configuration file, sorted by name.
+	DeprecatedFlags []DeprecatedFlag `json:"-"`
+
 	// LocalAddress is the local IP address to use when communicating with a peer.
 	LocalAddress string `json:"local-address"`
 
@@ -0,0 +1,46 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// DeprecatedFlag is a deprecated flag of etcd.
+type DeprecatedFlag struct {
+	// Name is the name of the flag, without leading dashes.
+	Name string
+	// Message describes the deprecation, it is logged when the flag is set.
+	Message string
+	// RemovedIn is the etcd version the flag is removed in, or empty if its
+	// removal is not scheduled yet.
+	RemovedIn string
+}
+
+// DeprecatedFlags are the deprecated flags of etcd, by name.
+var DeprecatedFlags = map[string]DeprecatedFlag{
+	// TODO: remove in 3.7.
+	"snapshot-count": {
+		Name:      "snapshot-count",
+		Message:   "--snapshot-count is deprecated in 3.6 and will be decommissioned in 3.7.",
+		RemovedIn: "3.7",
+	},
+	"max-snapshots": {
+		Name:      "max-snapshots",
+		Message:   "--max-snapshots is deprecated in 3.6 and will be decommissioned in 3.7.",
+		RemovedIn: "3.7",
+	},
+	"v2-deprecation": {
+		Name:      "v2-deprecation",
+		Message:   "--v2-deprecation is deprecated and scheduled for removal in v3.8. The default value is enforced, ignoring user input.",
+		RemovedIn: "3.8",
+	},
+}
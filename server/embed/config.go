@@ -26,10 +26,13 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
@@ -45,12 +48,15 @@ import (
 	"go.etcd.io/etcd/pkg/v3/featuregate"
 	"go.etcd.io/etcd/pkg/v3/flags"
 	"go.etcd.io/etcd/pkg/v3/netutil"
+	"go.etcd.io/etcd/pkg/v3/schedule"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
+	"go.etcd.io/etcd/server/v3/etcdserver/applydigest"
+	"go.etcd.io/etcd/server/v3/etcdserver/validator"
 	"go.etcd.io/etcd/server/v3/features"
 )
 
@@ -58,23 +64,40 @@ const (
 	ClusterStateFlagNew      = "new"
 	ClusterStateFlagExisting = "existing"
 
-	DefaultName                        = "default"
-	DefaultMaxSnapshots                = 5
-	DefaultMaxWALs                     = 5
-	DefaultMaxTxnOps                   = uint(128)
-	DefaultWarningApplyDuration        = 100 * time.Millisecond
-	DefaultWarningUnaryRequestDuration = 300 * time.Millisecond
-	DefaultMaxRequestBytes             = 1.5 * 1024 * 1024
-	DefaultMaxConcurrentStreams        = math.MaxUint32
-	DefaultGRPCKeepAliveMinTime        = 5 * time.Second
-	DefaultGRPCKeepAliveInterval       = 2 * time.Hour
-	DefaultGRPCKeepAliveTimeout        = 20 * time.Second
-	DefaultDowngradeCheckTime          = 5 * time.Second
-	DefaultAutoCompactionMode          = "periodic"
-	DefaultAutoCompactionRetention     = "0"
-	DefaultAuthToken                   = "simple"
-	DefaultCompactHashCheckTime        = time.Minute
-	DefaultLoggingFormat               = "json"
+	DefaultName                          = "default"
+	DefaultMaxSnapshots                  = 5
+	DefaultMaxWALs                       = 5
+	DefaultMaxTxnOps                     = uint(128)
+	DefaultWarningApplyDuration          = 100 * time.Millisecond
+	DefaultWarningUnaryRequestDuration   = 300 * time.Millisecond
+	DefaultMaxRequestBytes               = 1.5 * 1024 * 1024
+	DefaultMaxConcurrentStreams          = math.MaxUint32
+	DefaultGRPCKeepAliveMinTime          = 5 * time.Second
+	DefaultGRPCKeepAliveInterval         = 2 * time.Hour
+	DefaultGRPCKeepAliveTimeout          = 20 * time.Second
+	DefaultDowngradeCheckTime            = 5 * time.Second
+	DefaultAutoCompactionMode            = "periodic"
+	DefaultAutoCompactionRetention       = "0"
+	DefaultAuthToken                     = "simple"
+	DefaultCompactHashCheckTime          = time.Minute
+	DefaultDefragFreeRatioThreshold      = 0.5
+	DefaultRequestFingerprintMinRequests = 100
+	DefaultWebhookQuotaThreshold         = 0.9
+	DefaultWatchSkipIndexBlockSize       = 1024
+	DefaultLoggingFormat                 = "json"
+
+	DefaultTickMs     = 100
+	DefaultElectionMs = 1000
+
+	// DefaultLargeClusterTickMs and DefaultLargeClusterElectionMs replace the
+	// default heartbeat interval and election timeout in large-cluster mode,
+	// since the leader sends every heartbeat to more followers.
+	DefaultLargeClusterTickMs     = 200
+	DefaultLargeClusterElectionMs = 2000
+	// DefaultLargeClusterMaxConcurrentSnapshotSends is the number of snapshots
+	// the leader of a large cluster transmits at the same time unless limited
+	// explicitly.
+	DefaultLargeClusterMaxConcurrentSnapshotSends = 2
 
 	DefaultDiscoveryDialTimeout       = 2 * time.Second
 	DefaultDiscoveryRequestTimeOut    = 5 * time.Second
@@ -132,7 +155,8 @@ var (
 	defaultHostStatus error
 
 	// indirection for testing
-	getCluster = srv.GetCluster
+	getCluster          = srv.GetClusterFromDomains
+	dnsClusterRetryWait = time.Second
 )
 
 var (
@@ -181,6 +205,14 @@ type Config struct {
 	MaxSnapFiles uint `json:"max-snapshots"`
 	//revive:disable-next-line:var-naming
 	MaxWalFiles uint `json:"max-wals"`
+	// MaxWalBytes is the maximum total size of wal files to retain (0 is unlimited).
+	// WAL files that are still needed to recover from the latest snapshot are never purged.
+	//revive:disable-next-line:var-naming
+	MaxWalBytes int64 `json:"max-wal-bytes"`
+	// MaxWalAge is the maximum age of wal files to retain (0 is unlimited).
+	// WAL files that are still needed to recover from the latest snapshot are never purged.
+	//revive:disable-next-line:var-naming
+	MaxWalAge time.Duration `json:"max-wal-age"`
 
 	// TickMs is the number of milliseconds between heartbeat ticks.
 	// TODO: decouple tickMs and heartbeat tick (current heartbeat tick = 1).
@@ -221,12 +253,36 @@ type Config struct {
 	BackendBatchInterval time.Duration `json:"backend-batch-interval"`
 	// BackendBatchLimit is the maximum operations before commit the backend transaction.
 	BackendBatchLimit int `json:"backend-batch-limit"`
+	// BackendSnapshotSpool makes snapshots sent to followers and clients copy the
+	// backend to a temporary file and release their boltdb read transaction once
+	// copied, so that slow transfers do not stall the apply of large databases.
+	BackendSnapshotSpool bool `json:"backend-snapshot-spool"`
 	// BackendFreelistType specifies the type of freelist that boltdb backend uses (array and map are supported types).
 	BackendFreelistType string `json:"backend-bbolt-freelist-type"`
 	QuotaBackendBytes   int64  `json:"quota-backend-bytes"`
 	MaxTxnOps           uint   `json:"max-txn-ops"`
 	MaxRequestBytes     uint   `json:"max-request-bytes"`
 
+	// WarningValueSizeBytes is the value size past which the responses to the
+	// requests writing values carry a value size warning header, and the
+	// values are counted per key prefix. Zero disables the warning.
+	WarningValueSizeBytes uint `json:"warning-value-size-bytes"`
+
+	// QuotaBackendWarningRatio is the ratio of the backend quota past which the
+	// responses to mutating requests carry a quota warning header. Zero
+	// disables the warning.
+	QuotaBackendWarningRatio float64 `json:"quota-backend-warning-ratio"`
+
+	// MaxKeysPerPrefix are the maximum numbers of keys under prefixes, in the
+	// form "prefix=limit". The requests creating keys under a prefix past its
+	// limit are rejected.
+	MaxKeysPerPrefix []string `json:"max-keys-per-prefix"`
+
+	// ValueValidators are the validators of the values written under
+	// prefixes, in the form "prefix=validator", the validator being "json" or
+	// the http(s) URL of a webhook. The writes of invalid values are rejected.
+	ValueValidators []string `json:"value-validators"`
+
 	// MaxConcurrentStreams specifies the maximum number of concurrent
 	// streams that each client can open at a time.
 	MaxConcurrentStreams uint32 `json:"max-concurrent-streams"`
@@ -259,9 +315,22 @@ type Config struct {
 	//revive:disable-next-line:var-naming
 	TlsMaxVersion string `json:"tls-max-version"`
 
-	ClusterState          string `json:"initial-cluster-state"`
+	// FIPS restricts the TLS configuration to the cipher suites and curves
+	// approved by FIPS 140-3 and rejects the configurations that do not
+	// comply with it. It requires etcd to be built with a FIPS 140 validated
+	// crypto module.
+	FIPS bool `json:"fips"`
+
+	ClusterState string `json:"initial-cluster-state"`
+	// DNSCluster is the comma separated list of the DNS domains of the SRV
+	// records describing the initial cluster, for instance one domain per
+	// datacenter.
 	DNSCluster            string `json:"discovery-srv"`
 	DNSClusterServiceName string `json:"discovery-srv-name"`
+	// DNSClusterRetryTimeout is how long the SRV records are re-resolved when
+	// none is found, or when the records do not describe the local member
+	// yet, before failing the bootstrap. 0 disables the retries.
+	DNSClusterRetryTimeout time.Duration `json:"discovery-srv-retry-timeout"`
 
 	DiscoveryCfg v3discovery.DiscoveryConfig `json:"discovery-config"`
 
@@ -349,24 +418,72 @@ type Config struct {
 	//	}
 	//	embed.StartEtcd(cfg)
 	ServiceRegister func(*grpc.Server) `json:"-"`
+	// GRPCServices are users' gRPC services served on the client listeners
+	// next to etcd's own services, sharing their ports, TLS configuration and
+	// interceptors. The calls to the services with RequireAuth set are
+	// authenticated like the calls to etcd's services, see GRPCService.
+	GRPCServices []GRPCService `json:"-"`
 
 	AuthToken  string `json:"auth-token"`
 	BcryptCost uint   `json:"bcrypt-cost"`
 
 	// AuthTokenTTL in seconds of the simple token
 	AuthTokenTTL uint `json:"auth-token-ttl"`
+	// AuthTokenRoleTTLs override the lifetime of the tokens of the users
+	// having the roles, in the form "role=duration". These tokens expire after
+	// it even if they are used, and are refreshed with AuthTokenRefresh.
+	AuthTokenRoleTTLs []string `json:"auth-token-role-ttl"`
 
 	// CorruptCheckTime is the duration of time between cluster corruption check passes.
 	CorruptCheckTime time.Duration `json:"corrupt-check-time"`
 
 	// CompactHashCheckTime is the duration of time between leader checks followers compaction hashes.
 	CompactHashCheckTime time.Duration `json:"compact-hash-check-time"`
+	// DefragSchedule is the cron expression of the times the leader defragments
+	// the members of the cluster, one at a time and itself last. Empty
+	// disables the scheduled defragmentation.
+	DefragSchedule string `json:"defrag-schedule"`
+	// DefragFreeRatioThreshold is the ratio of its backend size a member must
+	// free to be defragmented by the scheduled defragmentation.
+	DefragFreeRatioThreshold float64 `json:"defrag-free-ratio-threshold"`
+	// RequestFingerprintWindow is the window of the detection of the shifts
+	// of the request patterns of the clients, such as full keyspace scans
+	// from a new user, from the fingerprints of their requests. 0 disables
+	// the detection.
+	RequestFingerprintWindow time.Duration `json:"request-fingerprint-window"`
+	// RequestFingerprintMinRequests is the number of requests of a new
+	// pattern of a client in a window from which it is reported.
+	RequestFingerprintMinRequests int `json:"request-fingerprint-min-requests"`
+	// WebhookURLs are the webhooks the member posts Kubernetes-style events to
+	// on its critical conditions: alarms raised, leader changes, corruption
+	// detected and backend quota nearly exceeded. Empty disables the events.
+	WebhookURLs []string `json:"webhook-urls"`
+	// WebhookSecretFile is the path to the file holding the secret signing
+	// the requests posted to the webhooks. Empty leaves them unsigned.
+	WebhookSecretFile string `json:"webhook-secret-file"`
+	// WebhookQuotaThreshold is the ratio of the backend quota used from which
+	// the member posts a quota nearly exceeded event.
+	WebhookQuotaThreshold float64 `json:"webhook-quota-threshold"`
+	// LearnerServeWatch is true to serve the Watch requests while the member
+	// is a learner, in addition to the serializable Range requests, so that
+	// the watch fan-out can be offloaded from the voting members.
+	LearnerServeWatch bool `json:"learner-serve-watch"`
 	// CompactionBatchLimit Sets the maximum revisions deleted in each compaction batch.
 	CompactionBatchLimit int `json:"compaction-batch-limit"`
 	// CompactionSleepInterval is the sleep interval between every etcd compaction loop.
 	CompactionSleepInterval time.Duration `json:"compaction-sleep-interval"`
 	// WatchProgressNotifyInterval is the time duration of periodic watch progress notifications.
 	WatchProgressNotifyInterval time.Duration `json:"watch-progress-notify-interval"`
+	// WatchSkipIndexBlockSize is the number of revisions summarized by each
+	// block of the index letting the watchers catching up from an old
+	// revision skip the revisions without events on their keys. Zero
+	// disables the index.
+	WatchSkipIndexBlockSize int64 `json:"watch-skip-index-block-size"`
+	// HeartbeatProtection sheds the low priority work, the sync of the
+	// watchers catching up from an old revision and the metrics scrapes,
+	// while the raft loop is found falling behind its ticks, to protect the
+	// heartbeats.
+	HeartbeatProtection bool `json:"heartbeat-protection"`
 	// WarningApplyDuration is the time duration after which a warning is generated if applying request
 	WarningApplyDuration time.Duration `json:"warning-apply-duration"`
 	// BootstrapDefragThresholdMegabytes is the minimum number of megabytes needed to be freed for etcd server to
@@ -376,6 +493,14 @@ type Config struct {
 	WarningUnaryRequestDuration time.Duration `json:"warning-unary-request-duration"`
 	// MaxLearners sets a limit to the number of learner members that can exist in the cluster membership.
 	MaxLearners int `json:"max-learners"`
+	// LargeCluster raises the maximum number of voting members from
+	// membership.DefaultMaxVotingMembers to membership.LargeClusterMaxVotingMembers.
+	// The heartbeat interval, election timeout and snapshot concurrency left at
+	// their defaults are adjusted for the larger quorum.
+	LargeCluster bool `json:"large-cluster"`
+	// MaxConcurrentSnapshotSends limits the number of snapshots the leader
+	// transmits to followers at the same time. Zero means no limit.
+	MaxConcurrentSnapshotSends int `json:"max-concurrent-snapshot-sends"`
 
 	// ForceNewCluster starts a new cluster even if previously started; unsafe.
 	ForceNewCluster bool `json:"force-new-cluster"`
@@ -384,6 +509,27 @@ type Config struct {
 	Metrics               string `json:"metrics"`
 	ListenMetricsUrls     []url.URL
 	ListenMetricsUrlsJSON string `json:"listen-metrics-urls"`
+	// MetricsRegisterer is the registerer the metrics of etcd are exported
+	// to, along with the prometheus default registerer the packages of etcd
+	// register them to, so that an application embedding etcd exports them
+	// with its own metrics. The process metrics, like the Go runtime ones, are
+	// not exported to it.
+	MetricsRegisterer prometheus.Registerer `json:"-"`
+	// MetricsGatherer is the gatherer of the metrics served on /metrics,
+	// usually the one of MetricsRegisterer. Defaults to the prometheus default
+	// gatherer.
+	MetricsGatherer prometheus.Gatherer `json:"-"`
+	// MetricsNamespace prefixes the names of the metrics exported to
+	// MetricsRegisterer, separated by "_".
+	MetricsNamespace string `json:"-"`
+
+	// ApplyDigestLog is the file the digests of the applied entries are
+	// recorded into, to compare the state machines of the members with
+	// "etcdutl apply-digest diff". Empty disables the recording.
+	ApplyDigestLog string `json:"apply-digest-log"`
+	// ApplyDigestLogEntries is the number of digests of the last applied
+	// entries kept by the apply digest log.
+	ApplyDigestLogEntries int `json:"apply-digest-log-entries"`
 
 	// EnableDistributedTracing indicates if tracing using OpenTelemetry is enabled.
 	EnableDistributedTracing bool `json:"enable-distributed-tracing"`
@@ -436,6 +582,11 @@ type Config struct {
 	// Setting this is unsafe and will cause data loss.
 	UnsafeNoFsync bool `json:"unsafe-no-fsync"`
 
+	// RecoveryMode starts the member without joining the cluster, serving a
+	// read-only view of its backend and the maintenance RPCs reading it on
+	// the client URLs. See StartRecovery.
+	RecoveryMode bool `json:"recovery-mode"`
+
 	// DowngradeCheckTime is the duration between two downgrade status checks (in seconds).
 	DowngradeCheckTime time.Duration `json:"downgrade-check-time"`
 
@@ -459,6 +610,25 @@ type Config struct {
 	FlagsExplicitlySet map[string]bool
 }
 
+// GRPCService is a user gRPC service served by the embedded etcd. A simple
+// usage example:
+//
+//	cfg := embed.NewConfig()
+//	cfg.GRPCServices = []embed.GRPCService{
+//		{Desc: &pb.Foo_ServiceDesc, Impl: &fooServer{}, RequireAuth: true},
+//	}
+//	embed.StartEtcd(cfg)
+type GRPCService struct {
+	// Desc describes the service, as generated by protoc-gen-go-grpc.
+	Desc *grpc.ServiceDesc
+	// Impl implements the service.
+	Impl any
+	// RequireAuth rejects the calls that do not carry a valid etcd token or
+	// client certificate when etcd authentication is enabled. The service
+	// can get the authenticated user with v3rpc.AuthInfoFromContext.
+	RequireAuth bool
+}
+
 // configYAML holds the config suitable for yaml parsing
 type configYAML struct {
 	Config
@@ -524,8 +694,8 @@ func NewConfig() *Config {
 			ReuseAddress: false,
 		},
 
-		TickMs:                     100,
-		ElectionMs:                 1000,
+		TickMs:                     DefaultTickMs,
+		ElectionMs:                 DefaultElectionMs,
 		InitialElectionTickAdvance: true,
 
 		ListenPeerUrls:      []url.URL{*lpurl},
@@ -564,11 +734,19 @@ func NewConfig() *Config {
 		MemoryMlock:        false,
 		MaxLearners:        membership.DefaultMaxLearners,
 
+		ApplyDigestLogEntries: applydigest.DefaultEntries,
+
+		WatchSkipIndexBlockSize: DefaultWatchSkipIndexBlockSize,
+
 		DistributedTracingAddress:     DefaultDistributedTracingAddress,
 		DistributedTracingServiceName: DefaultDistributedTracingServiceName,
 
 		CompactHashCheckTime: DefaultCompactHashCheckTime,
 
+		DefragFreeRatioThreshold:      DefaultDefragFreeRatioThreshold,
+		RequestFingerprintMinRequests: DefaultRequestFingerprintMinRequests,
+		WebhookQuotaThreshold:         DefaultWebhookQuotaThreshold,
+
 		V2Deprecation: config.V2DeprDefault,
 
 		DiscoveryCfg: v3discovery.DiscoveryConfig{
@@ -618,17 +796,24 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	)
 	fs.UintVar(&cfg.MaxSnapFiles, "max-snapshots", cfg.MaxSnapFiles, "Maximum number of snapshot files to retain (0 is unlimited). Deprecated in v3.6 and will be decommissioned in v3.7.")
 	fs.UintVar(&cfg.MaxWalFiles, "max-wals", cfg.MaxWalFiles, "Maximum number of wal files to retain (0 is unlimited).")
+	fs.Int64Var(&cfg.MaxWalBytes, "max-wal-bytes", cfg.MaxWalBytes, "Maximum total size in bytes of wal files to retain (0 is unlimited). Files needed to recover from the latest snapshot are always retained.")
+	fs.DurationVar(&cfg.MaxWalAge, "max-wal-age", cfg.MaxWalAge, "Maximum age of wal files to retain (0 is unlimited). Files needed to recover from the latest snapshot are always retained.")
 	fs.StringVar(&cfg.Name, "name", cfg.Name, "Human-readable name for this member.")
 	fs.Uint64Var(&cfg.SnapshotCount, "snapshot-count", cfg.SnapshotCount, "Number of committed transactions to trigger a snapshot to disk. Deprecated in v3.6 and will be decommissioned in v3.7.")
 	fs.UintVar(&cfg.TickMs, "heartbeat-interval", cfg.TickMs, "Time (in milliseconds) of a heartbeat interval.")
 	fs.UintVar(&cfg.ElectionMs, "election-timeout", cfg.ElectionMs, "Time (in milliseconds) for an election to timeout.")
 	fs.BoolVar(&cfg.InitialElectionTickAdvance, "initial-election-tick-advance", cfg.InitialElectionTickAdvance, "Whether to fast-forward initial election ticks on boot for faster election.")
 	fs.Int64Var(&cfg.QuotaBackendBytes, "quota-backend-bytes", cfg.QuotaBackendBytes, "Raise alarms when backend size exceeds the given quota. 0 means use the default quota.")
+	fs.Float64Var(&cfg.QuotaBackendWarningRatio, "quota-backend-warning-ratio", cfg.QuotaBackendWarningRatio, "Add a quota warning header to the responses of mutating requests once the backend size exceeds the given ratio of the quota. 0 disables the warning.")
 	fs.StringVar(&cfg.BackendFreelistType, "backend-bbolt-freelist-type", cfg.BackendFreelistType, "BackendFreelistType specifies the type of freelist that boltdb backend uses(array and map are supported types)")
 	fs.DurationVar(&cfg.BackendBatchInterval, "backend-batch-interval", cfg.BackendBatchInterval, "BackendBatchInterval is the maximum time before commit the backend transaction.")
 	fs.IntVar(&cfg.BackendBatchLimit, "backend-batch-limit", cfg.BackendBatchLimit, "BackendBatchLimit is the maximum operations before commit the backend transaction.")
+	fs.BoolVar(&cfg.BackendSnapshotSpool, "backend-snapshot-spool", cfg.BackendSnapshotSpool, "Spool backend snapshots to a temporary file so that slow snapshot transfers do not hold the backend read transaction open.")
 	fs.UintVar(&cfg.MaxTxnOps, "max-txn-ops", cfg.MaxTxnOps, "Maximum number of operations permitted in a transaction.")
 	fs.UintVar(&cfg.MaxRequestBytes, "max-request-bytes", cfg.MaxRequestBytes, "Maximum client request size in bytes the server will accept.")
+	fs.UintVar(&cfg.WarningValueSizeBytes, "warning-value-size-bytes", cfg.WarningValueSizeBytes, "Add a value size warning header to the responses of the requests writing values larger than the given size in bytes, and count them per key prefix. 0 disables the warning.")
+	fs.Var(flags.NewStringsValue(""), "max-keys-per-prefix", "Comma-separated list of prefix=limit, the maximum numbers of keys under the prefixes past which the requests creating keys under them are rejected. All the members must be configured with the same limits.")
+	fs.Var(flags.NewStringsValue(""), "value-validators", "Comma-separated list of prefix=validator, the validators of the values written under the prefixes: 'json' or the http(s) URL of a webhook.")
 	fs.DurationVar(&cfg.GRPCKeepAliveMinTime, "grpc-keepalive-min-time", cfg.GRPCKeepAliveMinTime, "Minimum interval duration that a client should wait before pinging server.")
 	fs.DurationVar(&cfg.GRPCKeepAliveInterval, "grpc-keepalive-interval", cfg.GRPCKeepAliveInterval, "Frequency duration of server-to-client ping to check if a connection is alive (0 to disable).")
 	fs.DurationVar(&cfg.GRPCKeepAliveTimeout, "grpc-keepalive-timeout", cfg.GRPCKeepAliveTimeout, "Additional duration of wait before closing a non-responsive connection (0 to disable).")
@@ -672,8 +857,9 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&cfg.DiscoveryCfg.Auth.Username, "discovery-user", "", "V3 discovery: username[:password] for authentication (prompt if password is not supplied).")
 	fs.StringVar(&cfg.DiscoveryCfg.Auth.Password, "discovery-password", "", "V3 discovery: password for authentication (if this option is used, --user option shouldn't include password).")
 
-	fs.StringVar(&cfg.DNSCluster, "discovery-srv", cfg.DNSCluster, "DNS domain used to bootstrap initial cluster.")
+	fs.StringVar(&cfg.DNSCluster, "discovery-srv", cfg.DNSCluster, "Comma separated DNS domains used to bootstrap initial cluster.")
 	fs.StringVar(&cfg.DNSClusterServiceName, "discovery-srv-name", cfg.DNSClusterServiceName, "Service name to query when using DNS discovery.")
+	fs.DurationVar(&cfg.DNSClusterRetryTimeout, "discovery-srv-retry-timeout", cfg.DNSClusterRetryTimeout, "How long to re-resolve the SRV records when none is found or when they do not describe the local member yet. 0 disables the retries.")
 	fs.StringVar(&cfg.InitialCluster, "initial-cluster", cfg.InitialCluster, "Initial cluster configuration for bootstrapping.")
 	fs.StringVar(&cfg.InitialClusterToken, "initial-cluster-token", cfg.InitialClusterToken, "Initial cluster token for the etcd cluster during bootstrap.")
 	fs.BoolVar(&cfg.StrictReconfigCheck, "strict-reconfig-check", cfg.StrictReconfigCheck, "Reject reconfiguration requests that would cause quorum loss.")
@@ -705,6 +891,7 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&cfg.PeerTLSInfo.SkipClientSANVerify, "peer-skip-client-san-verification", false, "Skip verification of SAN field in client certificate for peer connections.")
 	fs.StringVar(&cfg.TlsMinVersion, "tls-min-version", string(tlsutil.TLSVersion12), "Minimum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3.")
 	fs.StringVar(&cfg.TlsMaxVersion, "tls-max-version", string(tlsutil.TLSVersionDefault), "Maximum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3 (empty defers to Go).")
+	fs.BoolVar(&cfg.FIPS, "fips", false, "Restrict TLS to the FIPS 140-3 approved cipher suites and curves and reject non-compliant configuration. Requires etcd to be built with a FIPS 140 validated crypto module.")
 
 	fs.Var(
 		flags.NewUniqueURLsWithExceptions("*", "*"),
@@ -730,6 +917,10 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	// additional metrics
 	fs.StringVar(&cfg.Metrics, "metrics", cfg.Metrics, "Set level of detail for exported metrics, specify 'extensive' to include server side grpc histogram metrics")
 
+	// apply digest log
+	fs.StringVar(&cfg.ApplyDigestLog, "apply-digest-log", "", "Record a digest of every applied entry into the given ring file, to find the entry the state machines of the members diverged at with 'etcdutl apply-digest diff'. Empty disables the recording.")
+	fs.IntVar(&cfg.ApplyDigestLogEntries, "apply-digest-log-entries", cfg.ApplyDigestLogEntries, "The number of digests of the last applied entries kept by the apply digest log.")
+
 	fs.BoolVar(&cfg.EnableDistributedTracing, "enable-distributed-tracing", false, "Enable distributed tracing using OpenTelemetry Tracing.")
 	fs.StringVar(&cfg.DistributedTracingAddress, "distributed-tracing-address", cfg.DistributedTracingAddress, "Address for distributed tracing used for OpenTelemetry Tracing (if enabled with enable-distributed-tracing flag).")
 	fs.StringVar(&cfg.DistributedTracingServiceName, "distributed-tracing-service-name", cfg.DistributedTracingServiceName, "Configures service name for distributed tracing to be used to define service name for OpenTelemetry Tracing (if enabled with enable-distributed-tracing flag). 'etcd' is the default service name. Use the same service name for all instances of etcd.")
@@ -740,26 +931,40 @@ func (cfg *Config) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&cfg.AuthToken, "auth-token", cfg.AuthToken, "Specify auth token specific options.")
 	fs.UintVar(&cfg.BcryptCost, "bcrypt-cost", cfg.BcryptCost, "Specify bcrypt algorithm cost factor for auth password hashing.")
 	fs.UintVar(&cfg.AuthTokenTTL, "auth-token-ttl", cfg.AuthTokenTTL, "The lifetime in seconds of the auth token.")
+	fs.Var(flags.NewStringsValue(""), "auth-token-role-ttl", "Comma-separated list of role=duration, the lifetimes of the auth tokens of the users having the roles, which expire even if they are used and are refreshed with AuthTokenRefresh.")
 
 	// gateway
 	fs.BoolVar(&cfg.EnableGRPCGateway, "enable-grpc-gateway", cfg.EnableGRPCGateway, "Enable GRPC gateway.")
 	fs.DurationVar(&cfg.CorruptCheckTime, "corrupt-check-time", cfg.CorruptCheckTime, "Duration of time between cluster corruption check passes.")
 	fs.DurationVar(&cfg.CompactHashCheckTime, "compact-hash-check-time", cfg.CompactHashCheckTime, "Duration of time between leader checks followers compaction hashes.")
+	fs.StringVar(&cfg.DefragSchedule, "defrag-schedule", cfg.DefragSchedule, "Cron expression of the times the leader defragments the members of the cluster, one at a time and itself last. Empty disables the scheduled defragmentation, and the leader cannot defragment this member.")
+	fs.Float64Var(&cfg.DefragFreeRatioThreshold, "defrag-free-ratio-threshold", cfg.DefragFreeRatioThreshold, "Ratio of its backend size a member must free to be defragmented by the scheduled defragmentation.")
+	fs.DurationVar(&cfg.RequestFingerprintWindow, "request-fingerprint-window", cfg.RequestFingerprintWindow, "Window of the detection of the shifts of the request patterns of the clients, logged and counted. 0 disables the detection.")
+	fs.IntVar(&cfg.RequestFingerprintMinRequests, "request-fingerprint-min-requests", cfg.RequestFingerprintMinRequests, "Number of requests of a new pattern of a client in a window from which it is reported.")
+	fs.Var(flags.NewStringsValue(""), "webhook-urls", "Comma-separated list of webhooks to post Kubernetes-style events to on alarms raised, leader changes, corruption detected and backend quota nearly exceeded.")
+	fs.StringVar(&cfg.WebhookSecretFile, "webhook-secret-file", cfg.WebhookSecretFile, "Path to the file holding the secret signing the requests posted to the webhooks.")
+	fs.Float64Var(&cfg.WebhookQuotaThreshold, "webhook-quota-threshold", cfg.WebhookQuotaThreshold, "Ratio of the backend quota used from which a quota nearly exceeded event is posted to the webhooks.")
+	fs.BoolVar(&cfg.LearnerServeWatch, "learner-serve-watch", cfg.LearnerServeWatch, "Serve Watch requests while the member is a learner, to offload the watch fan-out from the voting members.")
 
 	fs.IntVar(&cfg.CompactionBatchLimit, "compaction-batch-limit", cfg.CompactionBatchLimit, "Sets the maximum revisions deleted in each compaction batch.")
 	fs.DurationVar(&cfg.CompactionSleepInterval, "compaction-sleep-interval", cfg.CompactionSleepInterval, "Sets the sleep interval between each compaction batch.")
 	fs.DurationVar(&cfg.WatchProgressNotifyInterval, "watch-progress-notify-interval", cfg.WatchProgressNotifyInterval, "Duration of periodic watch progress notifications.")
+	fs.Int64Var(&cfg.WatchSkipIndexBlockSize, "watch-skip-index-block-size", cfg.WatchSkipIndexBlockSize, "Number of revisions summarized by each block of the index letting watchers catching up from an old revision skip the revisions without events on their keys. 0 disables the index.")
+	fs.BoolVar(&cfg.HeartbeatProtection, "heartbeat-protection", cfg.HeartbeatProtection, "Pause the sync of the watchers catching up from an old revision and reject the metrics scrapes while the raft loop falls behind its ticks, to protect the heartbeats.")
 	fs.DurationVar(&cfg.DowngradeCheckTime, "downgrade-check-time", cfg.DowngradeCheckTime, "Duration of time between two downgrade status checks.")
 	fs.DurationVar(&cfg.WarningApplyDuration, "warning-apply-duration", cfg.WarningApplyDuration, "Time duration after which a warning is generated if watch progress takes more time.")
 	fs.DurationVar(&cfg.WarningUnaryRequestDuration, "warning-unary-request-duration", cfg.WarningUnaryRequestDuration, "Time duration after which a warning is generated if a unary request takes more time.")
 	fs.BoolVar(&cfg.MemoryMlock, "memory-mlock", cfg.MemoryMlock, "Enable to enforce etcd pages (in particular bbolt) to stay in RAM.")
 	fs.UintVar(&cfg.BootstrapDefragThresholdMegabytes, "bootstrap-defrag-threshold-megabytes", 0, "Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.")
 	fs.IntVar(&cfg.MaxLearners, "max-learners", membership.DefaultMaxLearners, "Sets the maximum number of learners that can be available in the cluster membership.")
+	fs.BoolVar(&cfg.LargeCluster, "large-cluster", false, fmt.Sprintf("Allow up to %d voting members instead of %d, adjusting the default raft timing and snapshot concurrency.", membership.LargeClusterMaxVotingMembers, membership.DefaultMaxVotingMembers))
+	fs.IntVar(&cfg.MaxConcurrentSnapshotSends, "max-concurrent-snapshot-sends", 0, "Maximum number of snapshots the leader sends to followers at the same time. 0 means no limit, or 2 with --large-cluster.")
 	fs.Uint64Var(&cfg.SnapshotCatchUpEntries, "snapshot-catchup-entries", cfg.SnapshotCatchUpEntries, "Number of entries for a slow follower to catch up after compacting the raft storage entries.")
 
 	// unsafe
 	fs.BoolVar(&cfg.UnsafeNoFsync, "unsafe-no-fsync", false, "Disables fsync, unsafe, will cause data loss.")
 	fs.BoolVar(&cfg.ForceNewCluster, "force-new-cluster", false, "Force to create a new one member cluster.")
+	fs.BoolVar(&cfg.RecoveryMode, "recovery-mode", false, "Start without joining the cluster, serving a read-only view of the backend and the maintenance RPCs reading it on the client URLs.")
 
 	// featuregate
 	cfg.ServerFeatureGate.(featuregate.MutableFeatureGate).AddFlag(fs, ServerFeatureGateFlagName)
@@ -937,6 +1142,11 @@ func (cfg *Config) Validate() error {
 	if err := cfg.setupLogging(); err != nil {
 		return err
 	}
+	for i, svc := range cfg.GRPCServices {
+		if svc.Desc == nil || svc.Impl == nil {
+			return fmt.Errorf("gRPC service %d must have a description and an implementation", i)
+		}
+	}
 	if err := checkBindURLs(cfg.ListenPeerUrls); err != nil {
 		return err
 	}
@@ -988,6 +1198,11 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	cfg.applyLargeClusterDefaults()
+	if cfg.MaxConcurrentSnapshotSends < 0 {
+		return fmt.Errorf("--max-concurrent-snapshot-sends must be >=0 (set to %v)", cfg.MaxConcurrentSnapshotSends)
+	}
+
 	if cfg.TickMs == 0 {
 		return fmt.Errorf("--heartbeat-interval must be >0 (set to %dms)", cfg.TickMs)
 	}
@@ -1029,10 +1244,70 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("enabling feature gate LeaseCheckpointPersist requires enabling feature gate LeaseCheckpoint")
 	}
 
+	if cfg.QuotaBackendWarningRatio < 0 || cfg.QuotaBackendWarningRatio >= 1 {
+		return fmt.Errorf("--quota-backend-warning-ratio must be >=0 and <1 (set to %v)", cfg.QuotaBackendWarningRatio)
+	}
+	if _, err := parseMaxKeysPerPrefix(cfg.MaxKeysPerPrefix); err != nil {
+		return fmt.Errorf("--max-keys-per-prefix: %w", err)
+	}
+	if _, err := parseValueValidators(cfg.ValueValidators); err != nil {
+		return fmt.Errorf("--value-validators: %w", err)
+	}
+	if _, err := parseAuthTokenRoleTTLs(cfg.AuthTokenRoleTTLs); err != nil {
+		return fmt.Errorf("--auth-token-role-ttl: %w", err)
+	}
+	if cfg.MetricsNamespace != "" {
+		if cfg.MetricsRegisterer == nil || cfg.MetricsRegisterer == prometheus.DefaultRegisterer {
+			return errors.New("MetricsNamespace requires a MetricsRegisterer other than the prometheus default one")
+		}
+		if !metricsNamespaceRegexp.MatchString(cfg.MetricsNamespace) {
+			return fmt.Errorf("invalid MetricsNamespace %q", cfg.MetricsNamespace)
+		}
+	}
+	if cfg.ApplyDigestLog != "" && cfg.ApplyDigestLogEntries <= 0 {
+		return fmt.Errorf("--apply-digest-log-entries must be >0 (set to %v)", cfg.ApplyDigestLogEntries)
+	}
+	if cfg.WatchSkipIndexBlockSize < 0 {
+		return fmt.Errorf("--watch-skip-index-block-size must be >=0 (set to %v)", cfg.WatchSkipIndexBlockSize)
+	}
+	if cfg.MaxWalBytes < 0 {
+		return fmt.Errorf("--max-wal-bytes must be >=0 (set to %v)", cfg.MaxWalBytes)
+	}
+	if cfg.MaxWalAge < 0 {
+		return fmt.Errorf("--max-wal-age must be >=0 (set to %v)", cfg.MaxWalAge)
+	}
+
 	if cfg.CompactHashCheckTime <= 0 {
 		return fmt.Errorf("--compact-hash-check-time must be >0 (set to %v)", cfg.CompactHashCheckTime)
 	}
 
+	if cfg.DefragSchedule != "" {
+		if _, err := schedule.ParseCron(cfg.DefragSchedule); err != nil {
+			return fmt.Errorf("--defrag-schedule: %w", err)
+		}
+	}
+	if cfg.DefragFreeRatioThreshold < 0 || cfg.DefragFreeRatioThreshold >= 1 {
+		return fmt.Errorf("--defrag-free-ratio-threshold must be >=0 and <1 (set to %v)", cfg.DefragFreeRatioThreshold)
+	}
+	if cfg.RequestFingerprintWindow < 0 {
+		return fmt.Errorf("--request-fingerprint-window must be >=0 (set to %v)", cfg.RequestFingerprintWindow)
+	}
+	if cfg.RequestFingerprintWindow > 0 && cfg.RequestFingerprintMinRequests <= 0 {
+		return fmt.Errorf("--request-fingerprint-min-requests must be >0 (set to %v)", cfg.RequestFingerprintMinRequests)
+	}
+	for _, u := range cfg.WebhookURLs {
+		wu, err := url.Parse(u)
+		if err != nil {
+			return fmt.Errorf("--webhook-urls: %w", err)
+		}
+		if wu.Scheme != "http" && wu.Scheme != "https" {
+			return fmt.Errorf("--webhook-urls: %q must be an http or https URL", u)
+		}
+	}
+	if cfg.WebhookQuotaThreshold <= 0 || cfg.WebhookQuotaThreshold > 1 {
+		return fmt.Errorf("--webhook-quota-threshold must be >0 and <=1 (set to %v)", cfg.WebhookQuotaThreshold)
+	}
+
 	// If `--name` isn't configured, then multiple members may have the same "default" name.
 	// When adding a new member with the "default" name as well, etcd may regards its peerURL
 	// as one additional peerURL of the existing member which has the same "default" name,
@@ -1064,9 +1339,42 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("cipher suites cannot be configured when only TLS1.3 is enabled")
 	}
 
+	if cfg.FIPS {
+		if err := cfg.validateFIPS(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// applyLargeClusterDefaults replaces the raft timing and snapshot concurrency
+// left at their defaults with the large-cluster ones.
+func (cfg *Config) applyLargeClusterDefaults() {
+	if !cfg.LargeCluster {
+		return
+	}
+	if cfg.TickMs == DefaultTickMs && cfg.ElectionMs == DefaultElectionMs {
+		cfg.TickMs, cfg.ElectionMs = DefaultLargeClusterTickMs, DefaultLargeClusterElectionMs
+		cfg.logger.Info(
+			"adjusted raft timing for large cluster",
+			zap.Uint("heartbeat-interval-ms", cfg.TickMs),
+			zap.Uint("election-timeout-ms", cfg.ElectionMs),
+		)
+	}
+	if cfg.MaxConcurrentSnapshotSends == 0 {
+		cfg.MaxConcurrentSnapshotSends = DefaultLargeClusterMaxConcurrentSnapshotSends
+	}
+}
+
+// MaxVotingMembers returns the maximum number of voting members of the cluster.
+func (cfg *Config) MaxVotingMembers() int {
+	if cfg.LargeCluster {
+		return membership.LargeClusterMaxVotingMembers
+	}
+	return membership.DefaultMaxVotingMembers
+}
+
 // PeerURLsMapAndToken sets up an initial peer URLsMap and cluster token for bootstrap or discovery.
 func (cfg *Config) PeerURLsMapAndToken(which string) (urlsmap types.URLsMap, token string, err error) {
 	token = cfg.InitialClusterToken
@@ -1079,29 +1387,7 @@ func (cfg *Config) PeerURLsMapAndToken(which string) (urlsmap types.URLsMap, tok
 		token = cfg.DiscoveryCfg.Token
 
 	case cfg.DNSCluster != "":
-		clusterStrs, cerr := cfg.GetDNSClusterNames()
-		lg := cfg.logger
-		if cerr != nil {
-			lg.Warn("failed to resolve during SRV discovery", zap.Error(cerr))
-		}
-		if len(clusterStrs) == 0 {
-			return nil, "", cerr
-		}
-		for _, s := range clusterStrs {
-			lg.Info("got bootstrap from DNS for etcd-server", zap.String("node", s))
-		}
-		clusterStr := strings.Join(clusterStrs, ",")
-		if strings.Contains(clusterStr, "https://") && cfg.PeerTLSInfo.TrustedCAFile == "" {
-			cfg.PeerTLSInfo.ServerName = cfg.DNSCluster
-		}
-		urlsmap, err = types.NewURLsMap(clusterStr)
-		// only etcd member must belong to the discovered cluster.
-		// proxy does not need to belong to the discovered cluster.
-		if which == "etcd" {
-			if _, ok := urlsmap[cfg.Name]; !ok {
-				return nil, "", fmt.Errorf("cannot find local etcd member %q in SRV records", cfg.Name)
-			}
-		}
+		urlsmap, err = cfg.dnsClusterURLsMap(which)
 
 	default:
 		// We're statically configured, and cluster has appropriately been set.
@@ -1110,6 +1396,72 @@ func (cfg *Config) PeerURLsMapAndToken(which string) (urlsmap types.URLsMap, tok
 	return urlsmap, token, err
 }
 
+// dnsClusterURLsMap resolves the initial cluster from the DNS SRV records,
+// re-resolving them for up to DNSClusterRetryTimeout while none is found or,
+// for an etcd member, while they do not describe the local member.
+func (cfg *Config) dnsClusterURLsMap(which string) (types.URLsMap, error) {
+	lg := cfg.GetLogger()
+	deadline := time.Now().Add(cfg.DNSClusterRetryTimeout)
+	wait := dnsClusterRetryWait
+	for {
+		urlsmap, err := cfg.resolveDNSCluster(which)
+		if err == nil || !time.Now().Add(wait).Before(deadline) {
+			return urlsmap, err
+		}
+		lg.Warn(
+			"failed to bootstrap from SRV records, re-resolving",
+			zap.String("discovery-srv", cfg.DNSCluster),
+			zap.Duration("retry-after", wait),
+			zap.Error(err),
+		)
+		time.Sleep(wait)
+		wait = min(2*wait, 10*dnsClusterRetryWait)
+	}
+}
+
+func (cfg *Config) resolveDNSCluster(which string) (types.URLsMap, error) {
+	clusterStrs, cerr := cfg.GetDNSClusterNames()
+	lg := cfg.GetLogger()
+	if cerr != nil {
+		lg.Warn("failed to resolve during SRV discovery", zap.Error(cerr))
+	}
+	if len(clusterStrs) == 0 {
+		return nil, cerr
+	}
+	for _, s := range clusterStrs {
+		lg.Info("got bootstrap from DNS for etcd-server", zap.String("node", s))
+	}
+	clusterStr := strings.Join(clusterStrs, ",")
+	// the certificates of the members can only be checked against the
+	// discovery domain if there is a single one
+	if domains := cfg.dnsClusterDomains(); strings.Contains(clusterStr, "https://") && cfg.PeerTLSInfo.TrustedCAFile == "" && len(domains) == 1 {
+		cfg.PeerTLSInfo.ServerName = domains[0]
+	}
+	urlsmap, err := types.NewURLsMap(clusterStr)
+	if err != nil {
+		return nil, err
+	}
+	// only etcd member must belong to the discovered cluster.
+	// proxy does not need to belong to the discovered cluster.
+	if which == "etcd" {
+		if _, ok := urlsmap[cfg.Name]; !ok {
+			return nil, fmt.Errorf("cannot find local etcd member %q in SRV records", cfg.Name)
+		}
+	}
+	return urlsmap, nil
+}
+
+// dnsClusterDomains returns the domains of DNSCluster.
+func (cfg *Config) dnsClusterDomains() []string {
+	var domains []string
+	for _, d := range strings.Split(cfg.DNSCluster, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			domains = append(domains, d)
+		}
+	}
+	return domains
+}
+
 // GetDNSClusterNames uses DNS SRV records to get a list of initial nodes for cluster bootstrapping.
 // This function will return a list of one or more nodes, as well as any errors encountered while
 // performing service discovery.
@@ -1129,7 +1481,8 @@ func (cfg *Config) GetDNSClusterNames() ([]string, error) {
 
 	// Use both etcd-server-ssl and etcd-server for discovery.
 	// Combine the results if both are available.
-	clusterStrs, cerr = getCluster("https", "etcd-server-ssl"+serviceNameSuffix, cfg.Name, cfg.DNSCluster, cfg.AdvertisePeerUrls)
+	domains := cfg.dnsClusterDomains()
+	clusterStrs, cerr = getCluster("https", "etcd-server-ssl"+serviceNameSuffix, cfg.Name, domains, cfg.AdvertisePeerUrls)
 	if cerr != nil {
 		clusterStrs = make([]string, 0)
 	}
@@ -1144,7 +1497,7 @@ func (cfg *Config) GetDNSClusterNames() ([]string, error) {
 		zap.Error(cerr),
 	)
 
-	defaultHTTPClusterStrs, httpCerr := getCluster("http", "etcd-server"+serviceNameSuffix, cfg.Name, cfg.DNSCluster, cfg.AdvertisePeerUrls)
+	defaultHTTPClusterStrs, httpCerr := getCluster("http", "etcd-server"+serviceNameSuffix, cfg.Name, domains, cfg.AdvertisePeerUrls)
 	if httpCerr == nil {
 		clusterStrs = append(clusterStrs, defaultHTTPClusterStrs...)
 	}
@@ -1220,6 +1573,19 @@ func (cfg *Config) V2DeprecationEffective() config.V2DeprecationEnum {
 	return cfg.V2Deprecation
 }
 
+// DeprecatedFlagsSet returns the deprecated flags explicitly set on the command
+// line or in the configuration file, sorted by name.
+func (cfg *Config) DeprecatedFlagsSet() []config.DeprecatedFlag {
+	var flags []config.DeprecatedFlag
+	for name := range cfg.FlagsExplicitlySet {
+		if f, ok := config.DeprecatedFlags[name]; ok {
+			flags = append(flags, f)
+		}
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags
+}
+
 func (cfg *Config) defaultPeerHost() bool {
 	return len(cfg.AdvertisePeerUrls) == 1 && cfg.AdvertisePeerUrls[0].String() == DefaultInitialAdvertisePeerURLs
 }
@@ -1394,3 +1760,77 @@ func parseBackendFreelistType(freelistType string) bolt.FreelistType {
 
 	return bolt.FreelistMapType
 }
+
+// parseMaxKeysPerPrefix parses the maximum numbers of keys of the prefixes,
+// given as "prefix=limit".
+func parseMaxKeysPerPrefix(specs []string) (map[string]int64, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	limits := make(map[string]int64, len(specs))
+	for _, spec := range specs {
+		i := strings.LastIndex(spec, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("%q must be prefix=limit", spec)
+		}
+		prefix := spec[:i]
+		limit, err := strconv.ParseInt(spec[i+1:], 10, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("the limit of %q must be a non-negative integer", spec)
+		}
+		if _, ok := limits[prefix]; ok {
+			return nil, fmt.Errorf("prefix %q has several limits", prefix)
+		}
+		limits[prefix] = limit
+	}
+	return limits, nil
+}
+
+// parseAuthTokenRoleTTLs parses the lifetimes of the auth tokens of the roles,
+// given as "role=duration".
+func parseAuthTokenRoleTTLs(specs []string) (map[string]time.Duration, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	ttls := make(map[string]time.Duration, len(specs))
+	for _, spec := range specs {
+		i := strings.LastIndex(spec, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("%q must be role=duration", spec)
+		}
+		role := spec[:i]
+		ttl, err := time.ParseDuration(spec[i+1:])
+		if err != nil || ttl < time.Second {
+			return nil, fmt.Errorf("the lifetime of %q must be a duration of at least 1s", spec)
+		}
+		if _, ok := ttls[role]; ok {
+			return nil, fmt.Errorf("role %q has several lifetimes", role)
+		}
+		ttls[role] = ttl
+	}
+	return ttls, nil
+}
+
+// parseValueValidators parses the validators of the values of the prefixes,
+// given as "prefix=validator". The prefix ends at the first "=", as the URLs of
+// the webhooks may contain some.
+func parseValueValidators(specs []string) (map[string]validator.Validator, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	validators := make(map[string]validator.Validator, len(specs))
+	for _, spec := range specs {
+		prefix, v, ok := strings.Cut(spec, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q must be prefix=validator", spec)
+		}
+		if _, ok := validators[prefix]; ok {
+			return nil, fmt.Errorf("prefix %q has several validators", prefix)
+		}
+		var err error
+		if validators[prefix], err = validator.Parse(v); err != nil {
+			return nil, err
+		}
+	}
+	return validators, nil
+}
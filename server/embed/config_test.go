@@ -32,10 +32,12 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"go.etcd.io/etcd/client/pkg/v3/srv"
+	"go.etcd.io/etcd/client/pkg/v3/tlsutil"
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/pkg/v3/featuregate"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3discovery"
 	"go.etcd.io/etcd/server/v3/features"
 )
@@ -494,7 +496,7 @@ func TestAutoCompactionModeParse(t *testing.T) {
 }
 
 func TestPeerURLsMapAndTokenFromSRV(t *testing.T) {
-	defer func() { getCluster = srv.GetCluster }()
+	defer func() { getCluster = srv.GetClusterFromDomains }()
 
 	tests := []struct {
 		withSSL    []string
@@ -538,7 +540,7 @@ func TestPeerURLsMapAndTokenFromSRV(t *testing.T) {
 	}
 
 	for i, tt := range tests {
-		getCluster = func(serviceScheme string, service string, name string, dns string, apurls types.URLs) ([]string, error) {
+		getCluster = func(serviceScheme string, service string, name string, domains []string, apurls types.URLs) ([]string, error) {
 			var urls []string
 			if serviceScheme == "https" && service == "etcd-server-ssl" {
 				urls = tt.withSSL
@@ -548,7 +550,7 @@ func TestPeerURLsMapAndTokenFromSRV(t *testing.T) {
 			if len(urls) > 0 {
 				return urls, nil
 			}
-			return urls, notFoundErr(service, dns)
+			return urls, notFoundErr(service, domains[0])
 		}
 
 		cfg := NewConfig()
@@ -573,6 +575,90 @@ func TestPeerURLsMapAndTokenFromSRV(t *testing.T) {
 	}
 }
 
+func TestPeerURLsMapAndTokenFromSRVDomains(t *testing.T) {
+	defer func(wait time.Duration) {
+		getCluster = srv.GetClusterFromDomains
+		dnsClusterRetryWait = wait
+	}(dnsClusterRetryWait)
+	dnsClusterRetryWait = time.Millisecond
+
+	tests := []struct {
+		name         string
+		dnsCluster   string
+		retryTimeout time.Duration
+		// missing is the number of lookups not returning the local member
+		missing     int
+		wdomains    []string
+		wurls       string
+		wserverName string
+		werr        bool
+	}{
+		{
+			name:        "single domain",
+			dnsCluster:  "example.com",
+			wdomains:    []string{"example.com"},
+			wurls:       "0=https://2.example.com:2380,1.example.com=https://1.example.com:2380",
+			wserverName: "example.com",
+		},
+		{
+			name:       "several domains",
+			dnsCluster: "dc1.example.com, dc2.example.com,",
+			wdomains:   []string{"dc1.example.com", "dc2.example.com"},
+			wurls:      "0=https://2.example.com:2380,1.example.com=https://1.example.com:2380",
+		},
+		{
+			name:       "local member missing without retries",
+			dnsCluster: "example.com",
+			missing:    1,
+			wdomains:   []string{"example.com"},
+			werr:       true,
+		},
+		{
+			name:         "local member eventually published",
+			dnsCluster:   "example.com",
+			retryTimeout: time.Minute,
+			missing:      3,
+			wdomains:     []string{"example.com"},
+			wurls:        "0=https://2.example.com:2380,1.example.com=https://1.example.com:2380",
+			wserverName:  "example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookups := 0
+			getCluster = func(serviceScheme string, service string, name string, domains []string, apurls types.URLs) ([]string, error) {
+				assert.Equal(t, tt.wdomains, domains)
+				if serviceScheme != "https" {
+					return nil, notFoundErr(service, domains[0])
+				}
+				lookups++
+				if lookups <= tt.missing {
+					return []string{"0=https://2.example.com:2380"}, nil
+				}
+				return []string{"0=https://2.example.com:2380", "1.example.com=https://1.example.com:2380"}, nil
+			}
+
+			cfg := NewConfig()
+			cfg.Name = "1.example.com"
+			cfg.InitialCluster = ""
+			cfg.InitialClusterToken = ""
+			cfg.DNSCluster = tt.dnsCluster
+			cfg.DNSClusterRetryTimeout = tt.retryTimeout
+			cfg.AdvertisePeerUrls = types.MustNewURLs([]string{"https://1.example.com:2380"})
+			require.NoError(t, cfg.Validate())
+
+			urlsmap, _, err := cfg.PeerURLsMapAndToken("etcd")
+			if tt.werr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wurls, urlsmap.String())
+			assert.Equal(t, tt.wserverName, cfg.PeerTLSInfo.ServerName)
+		})
+	}
+}
+
 func TestLeaseCheckpointValidate(t *testing.T) {
 	tcs := []struct {
 		name               string
@@ -608,6 +694,205 @@ func TestLeaseCheckpointValidate(t *testing.T) {
 	}
 }
 
+func TestLargeClusterValidate(t *testing.T) {
+	tcs := []struct {
+		name                           string
+		largeCluster                   bool
+		tickMs, electionMs             uint
+		maxConcurrentSnapshotSends     int
+		wantTickMs, wantElectionMs     uint
+		wantMaxConcurrentSnapshotSends int
+		wantMaxVotingMembers           int
+		expectError                    bool
+	}{
+		{
+			name:                 "Default config keeps the default raft timing",
+			tickMs:               DefaultTickMs,
+			electionMs:           DefaultElectionMs,
+			wantTickMs:           DefaultTickMs,
+			wantElectionMs:       DefaultElectionMs,
+			wantMaxVotingMembers: membership.DefaultMaxVotingMembers,
+		},
+		{
+			name:                           "Large cluster adjusts the default raft timing",
+			largeCluster:                   true,
+			tickMs:                         DefaultTickMs,
+			electionMs:                     DefaultElectionMs,
+			wantTickMs:                     DefaultLargeClusterTickMs,
+			wantElectionMs:                 DefaultLargeClusterElectionMs,
+			wantMaxConcurrentSnapshotSends: DefaultLargeClusterMaxConcurrentSnapshotSends,
+			wantMaxVotingMembers:           membership.LargeClusterMaxVotingMembers,
+		},
+		{
+			name:                           "Large cluster keeps the configured values",
+			largeCluster:                   true,
+			tickMs:                         300,
+			electionMs:                     3000,
+			maxConcurrentSnapshotSends:     4,
+			wantTickMs:                     300,
+			wantElectionMs:                 3000,
+			wantMaxConcurrentSnapshotSends: 4,
+			wantMaxVotingMembers:           membership.LargeClusterMaxVotingMembers,
+		},
+		{
+			name:                       "Negative snapshot concurrency should fail",
+			tickMs:                     DefaultTickMs,
+			electionMs:                 DefaultElectionMs,
+			maxConcurrentSnapshotSends: -1,
+			expectError:                true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.LargeCluster = tc.largeCluster
+			cfg.TickMs, cfg.ElectionMs = tc.tickMs, tc.electionMs
+			cfg.MaxConcurrentSnapshotSends = tc.maxConcurrentSnapshotSends
+			err := cfg.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantTickMs, cfg.TickMs)
+			assert.Equal(t, tc.wantElectionMs, cfg.ElectionMs)
+			assert.Equal(t, tc.wantMaxConcurrentSnapshotSends, cfg.MaxConcurrentSnapshotSends)
+			assert.Equal(t, tc.wantMaxVotingMembers, cfg.MaxVotingMembers())
+		})
+	}
+}
+
+func TestDefragScheduleValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		schedule    string
+		threshold   float64
+		expectError bool
+	}{
+		{name: "Disabled by default", threshold: DefaultDefragFreeRatioThreshold},
+		{name: "Valid schedule", schedule: "0 3 * * 6", threshold: 0.3},
+		{name: "Descriptor", schedule: "@daily", threshold: DefaultDefragFreeRatioThreshold},
+		{name: "Invalid schedule should fail", schedule: "0 25 * * *", threshold: DefaultDefragFreeRatioThreshold, expectError: true},
+		{name: "Negative threshold should fail", schedule: "@daily", threshold: -0.1, expectError: true},
+		{name: "Threshold of 1 should fail", schedule: "@daily", threshold: 1, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.DefragSchedule = tc.schedule
+			cfg.DefragFreeRatioThreshold = tc.threshold
+			err := cfg.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMaxKeysPerPrefixValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		specs       []string
+		expected    map[string]int64
+		expectError bool
+	}{
+		{name: "Disabled by default"},
+		{name: "Valid limits", specs: []string{"/registry/events/=10000", "/a=b/=1", "=0"}, expected: map[string]int64{"/registry/events/": 10000, "/a=b/": 1, "": 0}},
+		{name: "Missing limit should fail", specs: []string{"/registry/"}, expectError: true},
+		{name: "Negative limit should fail", specs: []string{"/registry/=-1"}, expectError: true},
+		{name: "Duplicated prefix should fail", specs: []string{"/registry/=1", "/registry/=2"}, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.MaxKeysPerPrefix = tc.specs
+			err := cfg.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			limits, err := parseMaxKeysPerPrefix(tc.specs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, limits)
+		})
+	}
+}
+
+func TestAuthTokenRoleTTLsValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		specs       []string
+		expected    map[string]time.Duration
+		expectError bool
+	}{
+		{name: "Disabled by default"},
+		{name: "Valid lifetimes", specs: []string{"automation=5m", "ci=30s"}, expected: map[string]time.Duration{"automation": 5 * time.Minute, "ci": 30 * time.Second}},
+		{name: "Missing lifetime should fail", specs: []string{"automation"}, expectError: true},
+		{name: "Missing role should fail", specs: []string{"=5m"}, expectError: true},
+		{name: "Lifetime shorter than a second should fail", specs: []string{"automation=500ms"}, expectError: true},
+		{name: "Duplicated role should fail", specs: []string{"ci=1m", "ci=2m"}, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.AuthTokenRoleTTLs = tc.specs
+			err := cfg.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			ttls, err := parseAuthTokenRoleTTLs(tc.specs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ttls)
+		})
+	}
+}
+
+func TestValueValidatorsValidate(t *testing.T) {
+	tcs := []struct {
+		name        string
+		specs       []string
+		expected    map[string]string
+		expectError bool
+	}{
+		{name: "Disabled by default"},
+		{
+			name:     "Valid validators",
+			specs:    []string{"/config/=json", "/app/=https://validator.example.com/validate?schema=app"},
+			expected: map[string]string{"/config/": "json", "/app/": "https://validator.example.com/validate?schema=app"},
+		},
+		{name: "Missing validator should fail", specs: []string{"/config/"}, expectError: true},
+		{name: "Unknown validator should fail", specs: []string{"/config/=yaml"}, expectError: true},
+		{name: "Duplicated prefix should fail", specs: []string{"/config/=json", "/config/=http://localhost:8080"}, expectError: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.ValueValidators = tc.specs
+			err := cfg.Validate()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			validators, err := parseValueValidators(tc.specs)
+			require.NoError(t, err)
+			var specs map[string]string
+			for prefix, v := range validators {
+				if specs == nil {
+					specs = make(map[string]string)
+				}
+				specs[prefix] = v.String()
+			}
+			require.Equal(t, tc.expected, specs)
+		})
+	}
+}
+
 func TestLogRotation(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -767,6 +1052,72 @@ func TestTLSVersionMinMax(t *testing.T) {
 	}
 }
 
+func TestFIPSValidate(t *testing.T) {
+	tlsURL := url.URL{Scheme: "https", Host: "localhost:2379"}
+	tlsPeerURL := url.URL{Scheme: "https", Host: "localhost:2380"}
+	tests := []struct {
+		name              string
+		noFIPSCrypto      bool
+		givenClientURL    url.URL
+		givenCipherSuites []string
+		expectErr         string
+	}{
+		{
+			name:           "FIPS crypto and TLS",
+			givenClientURL: tlsURL,
+		},
+		{
+			name:              "FIPS cipher suites",
+			givenClientURL:    tlsURL,
+			givenCipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+		},
+		{
+			name:           "crypto module without FIPS validation",
+			noFIPSCrypto:   true,
+			givenClientURL: tlsURL,
+			expectErr:      errFIPSUnsupported.Error(),
+		},
+		{
+			name:           "client URL without TLS",
+			givenClientURL: url.URL{Scheme: "http", Host: "localhost:2379"},
+			expectErr:      `--fips requires TLS, but --listen-client-urls contains "http://localhost:2379"`,
+		},
+		{
+			name:              "cipher suite not approved",
+			givenClientURL:    tlsURL,
+			givenCipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305"},
+			expectErr:         `--fips does not allow TLS cipher suite "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(f func() bool) { fipsCrypto = f }(fipsCrypto)
+			fipsCrypto = func() bool { return !tt.noFIPSCrypto }
+
+			cfg := NewConfig()
+			cfg.FIPS = true
+			cfg.ListenClientUrls, cfg.AdvertiseClientUrls = []url.URL{tt.givenClientURL}, []url.URL{tlsURL}
+			cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = []url.URL{tlsPeerURL}, []url.URL{tlsPeerURL}
+			cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+			cfg.CipherSuites = tt.givenCipherSuites
+
+			err := cfg.Validate()
+			if tt.expectErr != "" {
+				require.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			updateFIPS(&cfg.PeerTLSInfo)
+			if len(tt.givenCipherSuites) == 0 {
+				assert.Equal(t, tlsutil.FIPSCipherSuites(), cfg.PeerTLSInfo.CipherSuites)
+			}
+			assert.Equal(t, tlsutil.FIPSCurves(), cfg.PeerTLSInfo.CurvePreferences)
+		})
+	}
+}
+
 func TestUndefinedAutoCompactionModeValidate(t *testing.T) {
 	cfg := *NewConfig()
 	cfg.AutoCompactionMode = ""
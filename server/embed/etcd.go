@@ -15,6 +15,7 @@
 package embed
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -24,6 +25,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"runtime"
 	"sort"
 	"strconv"
@@ -47,6 +49,7 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/etcdhttp"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
 	"go.etcd.io/etcd/server/v3/features"
 	"go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/verify"
@@ -75,6 +78,9 @@ type Etcd struct {
 	metricsListeners []net.Listener
 
 	tracingExporterShutdown func()
+	// unregisterMetrics stops exporting the metrics of etcd to the
+	// MetricsRegisterer of the configuration.
+	unregisterMetrics func()
 
 	Server *etcdserver.EtcdServer
 
@@ -189,6 +195,8 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		SnapshotCatchUpEntries:            cfg.SnapshotCatchUpEntries,
 		MaxSnapFiles:                      cfg.MaxSnapFiles,
 		MaxWALFiles:                       cfg.MaxWalFiles,
+		MaxWALBytes:                       cfg.MaxWalBytes,
+		MaxWALAge:                         cfg.MaxWalAge,
 		InitialPeerURLsMap:                urlsmap,
 		InitialClusterToken:               token,
 		DiscoveryCfg:                      cfg.DiscoveryCfg,
@@ -200,11 +208,14 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		AutoCompactionRetention:           autoCompactionRetention,
 		AutoCompactionMode:                cfg.AutoCompactionMode,
 		QuotaBackendBytes:                 cfg.QuotaBackendBytes,
+		QuotaBackendWarningRatio:          cfg.QuotaBackendWarningRatio,
 		BackendBatchLimit:                 cfg.BackendBatchLimit,
 		BackendFreelistType:               backendFreelistType,
 		BackendBatchInterval:              cfg.BackendBatchInterval,
+		BackendSnapshotSpool:              cfg.BackendSnapshotSpool,
 		MaxTxnOps:                         cfg.MaxTxnOps,
 		MaxRequestBytes:                   cfg.MaxRequestBytes,
+		WarningValueSizeBytes:             cfg.WarningValueSizeBytes,
 		MaxConcurrentStreams:              cfg.MaxConcurrentStreams,
 		SocketOpts:                        cfg.SocketOpts,
 		StrictReconfigCheck:               cfg.StrictReconfigCheck,
@@ -216,6 +227,13 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		HostWhitelist:                     cfg.HostWhitelist,
 		CorruptCheckTime:                  cfg.CorruptCheckTime,
 		CompactHashCheckTime:              cfg.CompactHashCheckTime,
+		DefragSchedule:                    cfg.DefragSchedule,
+		DefragFreeRatioThreshold:          cfg.DefragFreeRatioThreshold,
+		RequestFingerprintWindow:          cfg.RequestFingerprintWindow,
+		RequestFingerprintMinRequests:     cfg.RequestFingerprintMinRequests,
+		WebhookURLs:                       cfg.WebhookURLs,
+		WebhookQuotaThreshold:             cfg.WebhookQuotaThreshold,
+		LearnerServeWatch:                 cfg.LearnerServeWatch,
 		PreVote:                           cfg.PreVote,
 		Logger:                            cfg.logger,
 		ForceNewCluster:                   cfg.ForceNewCluster,
@@ -225,13 +243,20 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		CompactionBatchLimit:              cfg.CompactionBatchLimit,
 		CompactionSleepInterval:           cfg.CompactionSleepInterval,
 		WatchProgressNotifyInterval:       cfg.WatchProgressNotifyInterval,
+		WatchSkipIndexBlockSize:           cfg.WatchSkipIndexBlockSize,
+		HeartbeatProtection:               cfg.HeartbeatProtection,
 		DowngradeCheckTime:                cfg.DowngradeCheckTime,
 		WarningApplyDuration:              cfg.WarningApplyDuration,
 		WarningUnaryRequestDuration:       cfg.WarningUnaryRequestDuration,
 		MemoryMlock:                       cfg.MemoryMlock,
 		BootstrapDefragThresholdMegabytes: cfg.BootstrapDefragThresholdMegabytes,
 		MaxLearners:                       cfg.MaxLearners,
+		MaxVotingMembers:                  cfg.MaxVotingMembers(),
+		MaxConcurrentSnapshotSends:        cfg.MaxConcurrentSnapshotSends,
+		ApplyDigestLog:                    cfg.ApplyDigestLog,
+		ApplyDigestLogEntries:             cfg.ApplyDigestLogEntries,
 		V2Deprecation:                     cfg.V2DeprecationEffective(),
+		DeprecatedFlags:                   cfg.DeprecatedFlagsSet(),
 		LocalAddress:                      cfg.InferLocalAddr(),
 		ServerFeatureGate:                 cfg.ServerFeatureGate,
 		Metrics:                           cfg.Metrics,
@@ -253,6 +278,28 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		)
 	}
 
+	srvcfg.MetricsRegisterer = metricsRegisterer(cfg)
+	if err = e.registerMetrics(srvcfg.MetricsRegisterer); err != nil {
+		return e, err
+	}
+
+	if srvcfg.MaxKeysPerPrefix, err = parseMaxKeysPerPrefix(cfg.MaxKeysPerPrefix); err != nil {
+		return e, err
+	}
+	if srvcfg.ValueValidators, err = parseValueValidators(cfg.ValueValidators); err != nil {
+		return e, err
+	}
+	if srvcfg.RoleTokenTTLs, err = parseAuthTokenRoleTTLs(cfg.AuthTokenRoleTTLs); err != nil {
+		return e, err
+	}
+
+	if cfg.WebhookSecretFile != "" {
+		if srvcfg.WebhookSecret, err = os.ReadFile(cfg.WebhookSecretFile); err != nil {
+			return e, fmt.Errorf("cannot read the webhook secret file: %w", err)
+		}
+		srvcfg.WebhookSecret = bytes.TrimSpace(srvcfg.WebhookSecret)
+	}
+
 	srvcfg.PeerTLSInfo.LocalAddr = srvcfg.LocalAddress
 
 	print(e.cfg.logger, *cfg, srvcfg, memberInitialized)
@@ -327,6 +374,8 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.String("go-arch", runtime.GOARCH),
 		zap.Int("max-cpu-set", runtime.GOMAXPROCS(0)),
 		zap.Int("max-cpu-available", runtime.NumCPU()),
+		zap.Bool("fips", ec.FIPS),
+		zap.Bool("fips-crypto", fipsCrypto()),
 		zap.Bool("member-initialized", memberInitialized),
 		zap.String("name", sc.Name),
 		zap.String("data-dir", sc.DataDir),
@@ -339,6 +388,8 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.Bool("initial-election-tick-advance", sc.InitialElectionTickAdvance),
 		zap.Uint64("snapshot-count", sc.SnapshotCount),
 		zap.Uint("max-wals", sc.MaxWALFiles),
+		zap.Int64("max-wal-bytes", sc.MaxWALBytes),
+		zap.Duration("max-wal-age", sc.MaxWALAge),
 		zap.Uint("max-snapshots", sc.MaxSnapFiles),
 		zap.Uint64("snapshot-catchup-entries", sc.SnapshotCatchUpEntries),
 		zap.Strings("initial-advertise-peer-urls", ec.getAdvertisePeerURLs()),
@@ -354,6 +405,9 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.String("initial-cluster-token", sc.InitialClusterToken),
 		zap.Int64("quota-backend-bytes", quota),
 		zap.Uint("max-request-bytes", sc.MaxRequestBytes),
+		zap.Uint("warning-value-size-bytes", sc.WarningValueSizeBytes),
+		zap.Strings("max-keys-per-prefix", ec.MaxKeysPerPrefix),
+		zap.Strings("value-validators", ec.ValueValidators),
 		zap.Uint32("max-concurrent-streams", sc.MaxConcurrentStreams),
 
 		zap.Bool("pre-vote", sc.PreVote),
@@ -361,6 +415,13 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 		zap.Bool("initial-corrupt-check", sc.InitialCorruptCheck),
 		zap.String("corrupt-check-time-interval", sc.CorruptCheckTime.String()),
 		zap.Duration("compact-check-time-interval", sc.CompactHashCheckTime),
+		zap.String("defrag-schedule", sc.DefragSchedule),
+		zap.Float64("defrag-free-ratio-threshold", sc.DefragFreeRatioThreshold),
+		zap.Duration("request-fingerprint-window", sc.RequestFingerprintWindow),
+		zap.Int("request-fingerprint-min-requests", sc.RequestFingerprintMinRequests),
+		zap.Strings("webhook-urls", sc.WebhookURLs),
+		zap.Float64("webhook-quota-threshold", sc.WebhookQuotaThreshold),
+		zap.Bool("learner-serve-watch", sc.LearnerServeWatch),
 		zap.String("auto-compaction-mode", sc.AutoCompactionMode),
 		zap.Duration("auto-compaction-retention", sc.AutoCompactionRetention),
 		zap.String("auto-compaction-interval", sc.AutoCompactionRetention.String()),
@@ -380,6 +441,8 @@ func print(lg *zap.Logger, ec Config, sc config.ServerConfig, memberInitialized
 
 		zap.String("downgrade-check-interval", sc.DowngradeCheckTime.String()),
 		zap.Int("max-learners", sc.MaxLearners),
+		zap.Int("max-voting-members", sc.MaxVotingMembers),
+		zap.Int("max-concurrent-snapshot-sends", sc.MaxConcurrentSnapshotSends),
 
 		zap.String("v2-deprecation", string(ec.V2Deprecation)),
 	)
@@ -466,6 +529,10 @@ func (e *Etcd) Close() {
 		e.tracingExporterShutdown()
 	}
 
+	if e.unregisterMetrics != nil {
+		e.unregisterMetrics()
+	}
+
 	// close rafthttp transports
 	if e.Server != nil {
 		e.Server.Stop()
@@ -536,6 +603,9 @@ func configurePeerListeners(cfg *Config) (peers []*peerListener, err error) {
 		cfg.logger.Fatal("failed to get peer self-signed certs", zap.Error(err))
 	}
 	updateMinMaxVersions(&cfg.PeerTLSInfo, cfg.TlsMinVersion, cfg.TlsMaxVersion)
+	if cfg.FIPS {
+		updateFIPS(&cfg.PeerTLSInfo)
+	}
 	if !cfg.PeerTLSInfo.Empty() {
 		cfg.logger.Info(
 			"starting with peer TLS",
@@ -650,6 +720,9 @@ func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err erro
 		cfg.logger.Fatal("failed to get client self-signed certs", zap.Error(err))
 	}
 	updateMinMaxVersions(&cfg.ClientTLSInfo, cfg.TlsMinVersion, cfg.TlsMaxVersion)
+	if cfg.FIPS {
+		updateFIPS(&cfg.ClientTLSInfo)
+	}
 	if cfg.EnablePprof {
 		cfg.logger.Info("pprof is enabled", zap.String("path", debugutil.HTTPPrefixPProf))
 	}
@@ -736,6 +809,7 @@ func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err erro
 			sctx.userHandlers[k] = cfg.UserHandlers[k]
 		}
 		sctx.serviceRegister = cfg.ServiceRegister
+		sctx.grpcServices = cfg.GRPCServices
 		if cfg.EnablePprof || cfg.LogLevel == "debug" {
 			sctx.registerPprof()
 		}
@@ -770,7 +844,7 @@ func (e *Etcd) serveClients() {
 	mux := http.NewServeMux()
 	etcdhttp.HandleDebug(mux)
 	etcdhttp.HandleVersion(mux, e.Server)
-	etcdhttp.HandleMetrics(mux)
+	e.handleMetrics(mux)
 	etcdhttp.HandleHealth(e.cfg.logger, mux, e.Server)
 
 	var gopts []grpc.ServerOption
@@ -787,6 +861,16 @@ func (e *Etcd) serveClients() {
 			Timeout: e.cfg.GRPCKeepAliveTimeout,
 		}))
 	}
+	var authServices []string
+	for _, svc := range e.cfg.GRPCServices {
+		if svc.RequireAuth {
+			authServices = append(authServices, svc.Desc.ServiceName)
+		}
+	}
+	if len(authServices) > 0 {
+		unary, stream := v3rpc.NewServiceAuthInterceptors(e.Server, authServices)
+		gopts = append(gopts, grpc.ChainUnaryInterceptor(unary), grpc.ChainStreamInterceptor(stream))
+	}
 	gopts = append(gopts, e.cfg.GRPCAdditionalServerOptions...)
 
 	splitHTTP := false
@@ -868,10 +952,24 @@ func (e *Etcd) createMetricsListener(murl url.URL) (net.Listener, error) {
 	)
 }
 
+// handleMetrics registers the metrics handler, which sheds the scrapes while
+// the heartbeats are protected.
+func (e *Etcd) handleMetrics(mux *http.ServeMux) {
+	if e.cfg.HeartbeatProtection {
+		etcdhttp.HandleMetricsShedding(mux, e.cfg.MetricsGatherer, e.Server.HeartbeatStarved)
+		return
+	}
+	if e.cfg.MetricsGatherer != nil {
+		etcdhttp.HandleMetricsGatherer(mux, e.cfg.MetricsGatherer)
+		return
+	}
+	etcdhttp.HandleMetrics(mux)
+}
+
 func (e *Etcd) serveMetrics() (err error) {
 	if len(e.cfg.ListenMetricsUrls) > 0 {
 		metricsMux := http.NewServeMux()
-		etcdhttp.HandleMetrics(metricsMux)
+		e.handleMetrics(metricsMux)
 		etcdhttp.HandleHealth(e.cfg.logger, metricsMux, e.Server)
 
 		for _, murl := range e.cfg.ListenMetricsUrls {
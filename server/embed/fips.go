@@ -0,0 +1,84 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"crypto/fips140"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.etcd.io/etcd/client/pkg/v3/tlsutil"
+	"go.etcd.io/etcd/client/pkg/v3/transport"
+)
+
+var errFIPSUnsupported = errors.New("--fips requires etcd to be built with a FIPS 140 validated crypto module (GOEXPERIMENT=boringcrypto, see 'make build-fips') or run with GODEBUG=fips140=on")
+
+// fipsCrypto returns whether the crypto primitives are provided by a FIPS 140
+// validated module: BoringCrypto when built with GOEXPERIMENT=boringcrypto, or
+// the Go Cryptographic Module when its FIPS 140-3 mode is enabled.
+var fipsCrypto = func() bool {
+	return boringCrypto || fips140.Enabled()
+}
+
+// validateFIPS rejects the configurations that cannot be operated in FIPS
+// mode: a crypto backend without FIPS validation, client or peer traffic not
+// protected by TLS, and TLS cipher suites not approved by FIPS 140-3.
+func (cfg *Config) validateFIPS() error {
+	if !fipsCrypto() {
+		return errFIPSUnsupported
+	}
+	for _, urls := range []struct {
+		flag string
+		urls []url.URL
+	}{
+		{"--listen-client-urls", cfg.ListenClientUrls},
+		{"--listen-client-http-urls", cfg.ListenClientHttpUrls},
+		{"--listen-peer-urls", cfg.ListenPeerUrls},
+		{"--advertise-client-urls", cfg.AdvertiseClientUrls},
+		{"--initial-advertise-peer-urls", cfg.AdvertisePeerUrls},
+	} {
+		for _, u := range urls.urls {
+			if u.Scheme != "https" && u.Scheme != "unixs" {
+				return fmt.Errorf("--fips requires TLS, but %s contains %q (must use the https or unixs scheme)", urls.flag, u.String())
+			}
+		}
+	}
+	for _, s := range cfg.CipherSuites {
+		if id, ok := tlsutil.GetCipherSuite(s); ok && !tlsutil.IsFIPSCipherSuite(id) {
+			return fmt.Errorf("--fips does not allow TLS cipher suite %q, --cipher-suites must be a subset of the FIPS 140-3 approved cipher suites %v", s, fipsCipherSuiteNames())
+		}
+	}
+	return nil
+}
+
+func fipsCipherSuiteNames() []string {
+	var names []string
+	for _, id := range tlsutil.FIPSCipherSuites() {
+		names = append(names, tls.CipherSuiteName(id))
+	}
+	return names
+}
+
+// updateFIPS restricts the TLS configuration to the cipher suites and to the
+// key exchange curves approved by FIPS 140-3. The configured cipher suites
+// are kept, validateFIPS has checked them.
+func updateFIPS(info *transport.TLSInfo) {
+	if len(info.CipherSuites) == 0 {
+		info.CipherSuites = tlsutil.FIPSCipherSuites()
+	}
+	info.CurvePreferences = tlsutil.FIPSCurves()
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// metricsNamespaceRegexp matches the valid prefixes of metric names.
+var metricsNamespaceRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// excludedMetricPrefixes are the prefixes of the names of the metrics of the
+// prometheus default registerer not exported to Config.MetricsRegisterer: the
+// ones of the process rather than of etcd, as the registerer usually has its
+// own, and the gRPC server ones, registered to it directly, as the ones of the
+// default registerer are of the other servers of the process.
+var excludedMetricPrefixes = []string{"go_", "process_", "promhttp_", "grpc_server_"}
+
+// metricsRegisterer returns the registerer of cfg, prefixed by its namespace,
+// or nil if not set.
+func metricsRegisterer(cfg *Config) prometheus.Registerer {
+	if cfg.MetricsRegisterer == nil {
+		return nil
+	}
+	if cfg.MetricsNamespace != "" {
+		return prometheus.WrapRegistererWithPrefix(cfg.MetricsNamespace+"_", cfg.MetricsRegisterer)
+	}
+	return cfg.MetricsRegisterer
+}
+
+// registerMetrics exports the metrics of etcd of the prometheus default
+// registerer to reg, the registerer of the configuration, until Close is
+// called. Nothing is exported if the registerer of the configuration is the
+// default one.
+func (e *Etcd) registerMetrics(reg prometheus.Registerer) error {
+	if reg == nil || e.cfg.MetricsRegisterer == prometheus.DefaultRegisterer {
+		return nil
+	}
+	c := &gathererCollector{g: prometheus.DefaultGatherer}
+	if err := reg.Register(c); err != nil {
+		return fmt.Errorf("cannot register the metrics of etcd: %w", err)
+	}
+	// the unchecked collectors cannot be unregistered
+	e.unregisterMetrics = func() { c.stopped.Store(true) }
+	return nil
+}
+
+// gathererCollector collects the metrics of etcd gathered from a gatherer,
+// until it is stopped. It is unchecked, as the metrics are only known once
+// gathered.
+type gathererCollector struct {
+	g       prometheus.Gatherer
+	stopped atomic.Bool
+}
+
+func (c *gathererCollector) Describe(chan<- *prometheus.Desc) {}
+
+func (c *gathererCollector) Collect(ch chan<- prometheus.Metric) {
+	if c.stopped.Load() {
+		return
+	}
+	// the families gathered without errors are returned along with the error
+	mfs, _ := c.g.Gather()
+	for _, mf := range mfs {
+		if isExcludedMetric(mf.GetName()) {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			ch <- constMetric(mf, m)
+		}
+	}
+}
+
+func isExcludedMetric(name string) bool {
+	for _, prefix := range excludedMetricPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// constMetric returns the metric m of the family mf as a constant metric.
+func constMetric(mf *dto.MetricFamily, m *dto.Metric) prometheus.Metric {
+	names := make([]string, 0, len(m.GetLabel()))
+	values := make([]string, 0, len(m.GetLabel()))
+	for _, l := range m.GetLabel() {
+		names = append(names, l.GetName())
+		values = append(values, l.GetValue())
+	}
+	desc := prometheus.NewDesc(mf.GetName(), mf.GetHelp(), names, nil)
+
+	var (
+		metric prometheus.Metric
+		err    error
+	)
+	switch mf.GetType() {
+	case dto.MetricType_COUNTER:
+		metric, err = prometheus.NewConstMetric(desc, prometheus.CounterValue, m.GetCounter().GetValue(), values...)
+	case dto.MetricType_GAUGE:
+		metric, err = prometheus.NewConstMetric(desc, prometheus.GaugeValue, m.GetGauge().GetValue(), values...)
+	case dto.MetricType_SUMMARY:
+		s := m.GetSummary()
+		quantiles := make(map[float64]float64, len(s.GetQuantile()))
+		for _, q := range s.GetQuantile() {
+			quantiles[q.GetQuantile()] = q.GetValue()
+		}
+		metric, err = prometheus.NewConstSummary(desc, s.GetSampleCount(), s.GetSampleSum(), quantiles, values...)
+	case dto.MetricType_HISTOGRAM:
+		h := m.GetHistogram()
+		buckets := make(map[float64]uint64, len(h.GetBucket()))
+		for _, b := range h.GetBucket() {
+			buckets[b.GetUpperBound()] = b.GetCumulativeCount()
+		}
+		metric, err = prometheus.NewConstHistogram(desc, h.GetSampleCount(), h.GetSampleSum(), buckets, values...)
+	default:
+		metric, err = prometheus.NewConstMetric(desc, prometheus.UntypedValue, m.GetUntyped().GetValue(), values...)
+	}
+	if err != nil {
+		return prometheus.NewInvalidMetric(desc, err)
+	}
+	return metric
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGathererCollector(t *testing.T) {
+	src := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "etcd_test_total", Help: "counter"}, []string{"kind"})
+	counter.WithLabelValues("a").Add(3)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "etcd_test_gauge", Help: "gauge"})
+	gauge.Set(7)
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "etcd_test_seconds", Help: "histogram", Buckets: []float64{1, 2}})
+	histogram.Observe(1.5)
+	summary := prometheus.NewSummary(prometheus.SummaryOpts{Name: "etcd_test_summary", Help: "summary"})
+	summary.Observe(4)
+	goroutines := prometheus.NewGauge(prometheus.GaugeOpts{Name: "go_goroutines", Help: "process"})
+	grpcStarted := prometheus.NewCounter(prometheus.CounterOpts{Name: "grpc_server_started_total", Help: "grpc"})
+	src.MustRegister(counter, gauge, histogram, summary, goroutines, grpcStarted)
+
+	dst := prometheus.NewRegistry()
+	c := &gathererCollector{g: src}
+	dst.MustRegister(c)
+
+	got := gatherByName(t, dst)
+	require.NotContains(t, got, "go_goroutines")
+	require.NotContains(t, got, "grpc_server_started_total")
+	require.Equal(t, 3.0, got["etcd_test_total"].GetMetric()[0].GetCounter().GetValue())
+	require.Equal(t, "kind", got["etcd_test_total"].GetMetric()[0].GetLabel()[0].GetName())
+	require.Equal(t, 7.0, got["etcd_test_gauge"].GetMetric()[0].GetGauge().GetValue())
+	h := got["etcd_test_seconds"].GetMetric()[0].GetHistogram()
+	require.Equal(t, uint64(1), h.GetSampleCount())
+	require.Equal(t, 1.5, h.GetSampleSum())
+	require.Len(t, h.GetBucket(), 2)
+	require.Equal(t, uint64(1), got["etcd_test_summary"].GetMetric()[0].GetSummary().GetSampleCount())
+
+	c.stopped.Store(true)
+	require.Empty(t, gatherByName(t, dst))
+}
+
+func TestStartEtcdMetricsRegisterer(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	cfg := NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.MetricsRegisterer = reg
+	cfg.MetricsGatherer = reg
+	cfg.MetricsNamespace = "app"
+	e, err := StartEtcd(cfg)
+	require.NoError(t, err)
+	defer e.Close()
+	select {
+	case <-e.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatal("etcd did not become ready")
+	}
+
+	got := gatherByName(t, reg)
+	require.Contains(t, got, "app_etcd_server_has_leader")
+	require.NotContains(t, got, "app_go_goroutines")
+	require.NotContains(t, got, "etcd_server_has_leader")
+	// the gRPC server metrics are registered to the registerer directly, once
+	// the clients are served
+	require.Eventually(t, func() bool {
+		_, ok := gatherByName(t, reg)["app_grpc_server_started_total"]
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestMetricsNamespaceValidate(t *testing.T) {
+	cfg := NewConfig()
+	cfg.MetricsNamespace = "app"
+	require.Error(t, cfg.Validate())
+	cfg.MetricsRegisterer = prometheus.DefaultRegisterer
+	require.Error(t, cfg.Validate())
+	cfg.MetricsRegisterer = prometheus.NewRegistry()
+	require.NoError(t, cfg.Validate())
+	cfg.MetricsNamespace = "app-1"
+	require.Error(t, cfg.Validate())
+}
+
+func gatherByName(t *testing.T, g prometheus.Gatherer) map[string]*dto.MetricFamily {
+	mfs, err := g.Gather()
+	require.NoError(t, err)
+	byName := make(map[string]*dto.MetricFamily, len(mfs))
+	for _, mf := range mfs {
+		byName[mf.GetName()] = mf
+	}
+	return byName
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	bolt "go.etcd.io/bbolt"
+	bolterrors "go.etcd.io/bbolt/errors"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/client/pkg/v3/transport"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
+	"go.etcd.io/etcd/server/v3/lease"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/datadir"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// Recovery is a member started in recovery mode by StartRecovery. It does not
+// join the cluster, and serves a read-only view of the key-value store of its
+// backend, along with the maintenance RPCs reading it, so that the data of a
+// member that cannot rejoin the cluster can be inspected and exported.
+type Recovery struct {
+	Clients []net.Listener
+
+	cfg         Config
+	be          backend.Backend
+	kv          mvcc.KV
+	grpcServers []*grpc.Server
+
+	closeOnce sync.Once
+	stopc     chan struct{}
+	errc      chan error
+	wg        sync.WaitGroup
+}
+
+// StartRecovery starts a member in recovery mode on the backend of the data dir
+// of the configuration, serving gRPC on its client URLs. Since the
+// authentication of the cluster is not enforced, each client URL must be a
+// unix socket, a loopback address, or an https URL with client certificate
+// authentication.
+func StartRecovery(inCfg *Config) (r *Recovery, err error) {
+	if err = inCfg.Validate(); err != nil {
+		return nil, err
+	}
+	r = &Recovery{
+		cfg:   *inCfg,
+		stopc: make(chan struct{}),
+		errc:  make(chan error, len(inCfg.ListenClientUrls)),
+	}
+	cfg := &r.cfg
+	lg := cfg.GetLogger()
+	defer func() {
+		if err != nil {
+			r.Close()
+			r = nil
+		}
+	}()
+
+	if len(cfg.ListenClientHttpUrls) > 0 {
+		return r, errors.New("--listen-client-http-urls is not supported in recovery mode")
+	}
+	for _, u := range cfg.ListenClientUrls {
+		if err = checkRecoveryClientURL(u, cfg.ClientTLSInfo.ClientCertAuth); err != nil {
+			return r, err
+		}
+	}
+
+	dbPath := datadir.ToBackendFileName(cfg.Dir)
+	if !fileutil.Exist(dbPath) {
+		return r, fmt.Errorf("no backend to recover in data dir %q", cfg.Dir)
+	}
+	if err = checkBackendNotInUse(dbPath); err != nil {
+		return r, err
+	}
+	lg.Warn(
+		"starting in recovery mode; not joining the cluster, serving a read-only view of the backend",
+		zap.String("name", cfg.Name),
+		zap.String("backend-path", dbPath),
+	)
+	r.be = backend.NewDefaultBackend(lg, dbPath)
+	// the scheduled compaction is not finished, so that the backend is
+	// left as it was found
+	r.kv = mvcc.NewStore(lg, r.be, &lease.FakeLessor{}, mvcc.StoreConfig{SkipScheduledCompaction: true})
+
+	memberID := recoveryMemberID(lg, r.be, cfg.Name)
+	var insecureServer, secureServer *grpc.Server
+	for _, u := range cfg.ListenClientUrls {
+		addr, secure, _ := resolveURL(u)
+		var gs *grpc.Server
+		switch {
+		case secure && secureServer == nil:
+			if err = updateCipherSuites(&cfg.ClientTLSInfo, cfg.CipherSuites); err != nil {
+				return r, err
+			}
+			updateMinMaxVersions(&cfg.ClientTLSInfo, cfg.TlsMinVersion, cfg.TlsMaxVersion)
+			tlscfg, tlsErr := cfg.ClientTLSInfo.ServerConfig()
+			if tlsErr != nil {
+				return r, tlsErr
+			}
+			secureServer = v3rpc.RecoveryServer(lg, r.be, r.kv, memberID, tlscfg)
+			r.grpcServers = append(r.grpcServers, secureServer)
+			fallthrough
+		case secure:
+			gs = secureServer
+		case insecureServer == nil:
+			insecureServer = v3rpc.RecoveryServer(lg, r.be, r.kv, memberID, nil)
+			r.grpcServers = append(r.grpcServers, insecureServer)
+			fallthrough
+		default:
+			gs = insecureServer
+		}
+
+		var l net.Listener
+		if l, err = transport.NewListenerWithOpts(addr, u.Scheme,
+			transport.WithSocketOpts(&cfg.SocketOpts),
+			transport.WithSkipTLSInfoCheck(true),
+		); err != nil {
+			return r, err
+		}
+		r.Clients = append(r.Clients, l)
+		r.serve(gs, l)
+		lg.Info("serving client traffic in recovery mode", zap.String("address", u.String()))
+	}
+	return r, nil
+}
+
+func (r *Recovery) serve(gs *grpc.Server, l net.Listener) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if err := gs.Serve(l); err != nil {
+			select {
+			case <-r.stopc:
+			case r.errc <- err:
+			}
+		}
+	}()
+}
+
+// Config returns the current configuration.
+func (r *Recovery) Config() Config {
+	return r.cfg
+}
+
+// Err returns the channel the errors of serving the client listeners are sent
+// to.
+func (r *Recovery) Err() <-chan error {
+	return r.errc
+}
+
+// StopNotify returns a channel closed when the member is closed.
+func (r *Recovery) StopNotify() <-chan struct{} {
+	return r.stopc
+}
+
+// Close stops serving the clients, then closes the backend.
+func (r *Recovery) Close() {
+	r.closeOnce.Do(func() {
+		close(r.stopc)
+		for _, gs := range r.grpcServers {
+			gs.Stop()
+		}
+		for _, l := range r.Clients {
+			l.Close()
+		}
+		r.wg.Wait()
+		if r.kv != nil {
+			r.kv.Close()
+		}
+		if r.be != nil {
+			r.be.Close()
+		}
+		r.cfg.GetLogger().Info("closed recovery mode server", zap.String("name", r.cfg.Name))
+	})
+}
+
+// checkRecoveryClientURL returns an error if the client URL u can be reached
+// from other hosts without a client certificate.
+func checkRecoveryClientURL(u url.URL, clientCertAuth bool) error {
+	switch {
+	case u.Scheme == "unix" || u.Scheme == "unixs":
+		return nil
+	case u.Scheme == "https" && clientCertAuth:
+		return nil
+	}
+	host := u.Hostname()
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("client URL %s is not allowed in recovery mode, which does not enforce authentication: use a unix socket, a loopback address, or https with --client-cert-auth", u.String())
+}
+
+// checkBackendNotInUse returns an error if the backend is locked by another
+// process, like a running etcd.
+func checkBackendNotInUse(dbPath string) error {
+	db, err := bolt.Open(dbPath, 0o600, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if errors.Is(err, bolterrors.ErrTimeout) {
+		return fmt.Errorf("backend %q is in use by another process", dbPath)
+	}
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// recoveryMemberID returns the ID of the member named name in the backend, or
+// zero if there is none.
+func recoveryMemberID(lg *zap.Logger, be backend.Backend, name string) types.ID {
+	members, _ := schema.NewMembershipBackend(lg, be).MustReadMembersFromBackend()
+	for id, m := range members {
+		if m.Name == name {
+			return id
+		}
+	}
+	lg.Warn("member not found in the backend", zap.String("name", name))
+	return 0
+}
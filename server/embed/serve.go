@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	etcdservergw "go.etcd.io/etcd/api/v3/etcdserverpb/gw"
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	"go.etcd.io/etcd/pkg/v3/debugutil"
@@ -68,6 +69,7 @@ type serveCtx struct {
 
 	userHandlers    map[string]http.Handler
 	serviceRegister func(*grpc.Server)
+	grpcServices    []GRPCService
 
 	// serversC is used to receive the http and grpc server objects (created
 	// in `serve`), both of which will be closed when shutting down the etcd.
@@ -185,9 +187,7 @@ func (sctx *serveCtx) serve(
 			gs = v3rpc.Server(s, nil, nil, gopts...)
 			v3electionpb.RegisterElectionServer(gs, servElection)
 			v3lockpb.RegisterLockServer(gs, servLock)
-			if sctx.serviceRegister != nil {
-				sctx.serviceRegister(gs)
-			}
+			sctx.registerGRPCServices(gs)
 			defer func(gs *grpc.Server) {
 				if err != nil {
 					sctx.lg.Warn("stopping insecure grpc server due to error", zap.Error(err))
@@ -237,9 +237,7 @@ func (sctx *serveCtx) serve(
 			gs = v3rpc.Server(s, tlscfg, nil, gopts...)
 			v3electionpb.RegisterElectionServer(gs, servElection)
 			v3lockpb.RegisterLockServer(gs, servLock)
-			if sctx.serviceRegister != nil {
-				sctx.serviceRegister(gs)
-			}
+			sctx.registerGRPCServices(gs)
 			defer func(gs *grpc.Server) {
 				if err != nil {
 					sctx.lg.Warn("stopping secure grpc server due to error", zap.Error(err))
@@ -302,6 +300,16 @@ func configureHTTPServer(srv *http.Server, cfg config.ServerConfig) error {
 
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
 // connections or otherHandler otherwise. Given in gRPC docs.
+// registerGRPCServices registers users' gRPC services on the grpc server.
+func (sctx *serveCtx) registerGRPCServices(gs *grpc.Server) {
+	if sctx.serviceRegister != nil {
+		sctx.serviceRegister(gs)
+	}
+	for _, svc := range sctx.grpcServices {
+		gs.RegisterService(svc.Desc, svc.Impl)
+	}
+}
+
 func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Handler {
 	if otherHandler == nil {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -359,6 +367,9 @@ func (sctx *serveCtx) registerGateway(dial func(ctx context.Context) (*grpc.Clie
 			return nil, err
 		}
 	}
+	if err := registerSnapshotDownload(sctx.lg, gwmux, pb.NewMaintenanceClient(conn)); err != nil {
+		return nil, err
+	}
 	sctx.startHandler(nil, func() error {
 		<-ctx.Done()
 		if cerr := conn.Close(); cerr != nil {
@@ -0,0 +1,105 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	gw "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+// snapshotDownloadPath is the gateway path streaming the snapshot of the
+// backend as a plain file, the database followed by its sha256 checksum, as
+// saved by "etcdctl snapshot save". Unlike /v3/maintenance/snapshot, which
+// streams the chunks as base64 encoded JSON messages, it can be downloaded
+// with any HTTP client, e.g.
+//
+//	curl -o snapshot.db http://127.0.0.1:2379/v3/maintenance/snapshot/download
+const snapshotDownloadPath = "/v3/maintenance/snapshot/download"
+
+// registerSnapshotDownload registers the snapshot download handler to gwmux for
+// both the GET and POST methods.
+func registerSnapshotDownload(lg *zap.Logger, gwmux *gw.ServeMux, mc pb.MaintenanceClient) error {
+	h := snapshotDownloadHandler(lg, gwmux, mc)
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		if err := gwmux.HandlePath(method, snapshotDownloadPath, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func snapshotDownloadHandler(lg *zap.Logger, gwmux *gw.ServeMux, mc pb.MaintenanceClient) gw.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := r.Context()
+		// forward the auth token the same way the generated handlers do
+		if token := r.Header.Get("Authorization"); token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, rpctypes.TokenFieldNameSwagger, token)
+		}
+		stream, err := mc.Snapshot(ctx, &pb.SnapshotRequest{})
+		if err == nil {
+			var resp *pb.SnapshotResponse
+			if resp, err = stream.Recv(); err == nil {
+				writeSnapshot(lg, w, stream, resp)
+				return
+			}
+		}
+		_, outbound := gw.MarshalerForRequest(gwmux, r)
+		gw.HTTPError(ctx, gwmux, outbound, w, r, err)
+	}
+}
+
+// writeSnapshot writes the snapshot streamed by the server, starting with its
+// first response. The status is already sent when the stream fails, so the
+// error is only logged and the client sees a short body.
+func writeSnapshot(lg *zap.Logger, w http.ResponseWriter, stream pb.Maintenance_SnapshotClient, resp *pb.SnapshotResponse) {
+	// the first response carries the size of the whole database, the server
+	// then sends its checksum in a last response
+	size := resp.RemainingBytes + uint64(len(resp.Blob)) + sha256.Size
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="snapshot.db"`)
+	w.Header().Set("Content-Length", strconv.FormatUint(size, 10))
+	if resp.Version != "" {
+		w.Header().Set("X-Etcd-Storage-Version", resp.Version)
+	}
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	for {
+		if _, err := w.Write(resp.Blob); err != nil {
+			lg.Warn("failed to write snapshot download", zap.Error(err))
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		var err error
+		if resp, err = stream.Recv(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				lg.Warn("failed to receive snapshot for download", zap.Error(err))
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gw "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+type fakeMaintenanceClient struct {
+	pb.MaintenanceClient
+	resps []*pb.SnapshotResponse
+	err   error
+	token string
+}
+
+func (c *fakeMaintenanceClient) Snapshot(ctx context.Context, _ *pb.SnapshotRequest, _ ...grpc.CallOption) (pb.Maintenance_SnapshotClient, error) {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if ts := md.Get(rpctypes.TokenFieldNameSwagger); len(ts) > 0 {
+			c.token = ts[0]
+		}
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &fakeSnapshotClient{resps: c.resps}, nil
+}
+
+type fakeSnapshotClient struct {
+	grpc.ClientStream
+	resps []*pb.SnapshotResponse
+}
+
+func (c *fakeSnapshotClient) Recv() (*pb.SnapshotResponse, error) {
+	if len(c.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := c.resps[0]
+	c.resps = c.resps[1:]
+	return resp, nil
+}
+
+func TestSnapshotDownload(t *testing.T) {
+	checksum := strings.Repeat("s", 32)
+	mc := &fakeMaintenanceClient{resps: []*pb.SnapshotResponse{
+		{RemainingBytes: 4, Blob: []byte("db-"), Version: "3.6.0"},
+		{RemainingBytes: 0, Blob: []byte("data"), Version: "3.6.0"},
+		{RemainingBytes: 0, Blob: []byte(checksum), Version: "3.6.0"},
+	}}
+	gwmux := gw.NewServeMux()
+	require.NoError(t, registerSnapshotDownload(zaptest.NewLogger(t), gwmux, mc))
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		t.Run(method, func(t *testing.T) {
+			req := httptest.NewRequest(method, snapshotDownloadPath, nil)
+			req.Header.Set("Authorization", "token1")
+			rec := httptest.NewRecorder()
+			gwmux.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "db-data"+checksum, rec.Body.String())
+			assert.Equal(t, "39", rec.Header().Get("Content-Length"))
+			assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+			assert.Equal(t, "3.6.0", rec.Header().Get("X-Etcd-Storage-Version"))
+			assert.Equal(t, "token1", mc.token)
+		})
+	}
+}
+
+func TestSnapshotDownloadError(t *testing.T) {
+	mc := &fakeMaintenanceClient{err: rpctypes.ErrGRPCPermissionDenied}
+	gwmux := gw.NewServeMux()
+	require.NoError(t, registerSnapshotDownload(zaptest.NewLogger(t), gwmux, mc))
+
+	rec := httptest.NewRecorder()
+	gwmux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, snapshotDownloadPath, nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "etcdserver: permission denied")
+}
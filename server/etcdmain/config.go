@@ -56,13 +56,6 @@ var (
 		"test.coverprofile",
 		"test.outputdir",
 	}
-
-	deprecatedFlags = map[string]string{
-		// TODO: remove in 3.7.
-		"snapshot-count": "--snapshot-count is deprecated in 3.6 and will be decommissioned in 3.7.",
-		"max-snapshots":  "--max-snapshots is deprecated in 3.6 and will be decommissioned in 3.7.",
-		"v2-deprecation": "--v2-deprecation is deprecated and scheduled for removal in v3.8. The default value is enforced, ignoring user input.",
-	}
 )
 
 // config holds the config for a command line invocation of etcd
@@ -172,10 +165,8 @@ func (cfg *config) parse(arguments []string) error {
 
 	// Check for deprecated options from both command line and config file
 	var warningsForDeprecatedOpts []string
-	for flagName := range cfg.ec.FlagsExplicitlySet {
-		if msg, ok := deprecatedFlags[flagName]; ok {
-			warningsForDeprecatedOpts = append(warningsForDeprecatedOpts, msg)
-		}
+	for _, f := range cfg.ec.DeprecatedFlagsSet() {
+		warningsForDeprecatedOpts = append(warningsForDeprecatedOpts, f.Message)
 	}
 
 	// Log warnings if any deprecated options were found
@@ -239,6 +230,10 @@ func (cfg *config) configFromCmdLine() error {
 	cfg.ec.PeerTLSInfo.AllowedHostnames = flags.StringsFromFlag(cfg.cf.flagSet, "peer-cert-allowed-hostname")
 
 	cfg.ec.CipherSuites = flags.StringsFromFlag(cfg.cf.flagSet, "cipher-suites")
+	cfg.ec.WebhookURLs = flags.StringsFromFlag(cfg.cf.flagSet, "webhook-urls")
+	cfg.ec.MaxKeysPerPrefix = flags.StringsFromFlag(cfg.cf.flagSet, "max-keys-per-prefix")
+	cfg.ec.ValueValidators = flags.StringsFromFlag(cfg.cf.flagSet, "value-validators")
+	cfg.ec.AuthTokenRoleTTLs = flags.StringsFromFlag(cfg.cf.flagSet, "auth-token-role-ttl")
 
 	cfg.ec.MaxConcurrentStreams = flags.Uint32FromFlag(cfg.cf.flagSet, "max-concurrent-streams")
 
@@ -586,10 +586,8 @@ func TestConfigFileDeprecatedOptions(t *testing.T) {
 
 			// Check which flags were set and marked as deprecated
 			foundFlags := make(map[string]struct{})
-			for flagName := range cfg.ec.FlagsExplicitlySet {
-				if _, ok := deprecatedFlags[flagName]; ok {
-					foundFlags[flagName] = struct{}{}
-				}
+			for _, f := range cfg.ec.DeprecatedFlagsSet() {
+				foundFlags[f.Name] = struct{}{}
 			}
 
 			// Compare sets of flags
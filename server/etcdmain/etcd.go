@@ -109,6 +109,13 @@ func startEtcdOrProxyV2(args []string) {
 		)
 		switch which {
 		case dirMember:
+			if cfg.ec.RecoveryMode {
+				stopped, errc, err = startRecovery(&cfg.ec)
+				if err != nil {
+					lg.Fatal("failed to start in recovery mode", zap.Error(err))
+				}
+				break
+			}
 			stopped, errc, err = startEtcd(&cfg.ec)
 		case dirProxy:
 			lg.Panic("v2 http proxy has already been deprecated in 3.6", zap.String("dir-type", string(which)))
@@ -119,6 +126,9 @@ func startEtcdOrProxyV2(args []string) {
 			)
 		}
 	} else {
+		if cfg.ec.RecoveryMode {
+			lg.Fatal("no member to recover in data dir", zap.String("data-dir", cfg.ec.Dir))
+		}
 		lg.Info(
 			"Initialize and start etcd server",
 			zap.String("data-dir", cfg.ec.Dir),
@@ -190,6 +200,16 @@ func startEtcd(cfg *embed.Config) (<-chan struct{}, <-chan error, error) {
 	return e.Server.StopNotify(), e.Err(), nil
 }
 
+// startRecovery runs StartRecovery in addition to hooks needed for standalone etcd.
+func startRecovery(cfg *embed.Config) (<-chan struct{}, <-chan error, error) {
+	r, err := embed.StartRecovery(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	osutil.RegisterInterruptHandler(r.Close)
+	return r.StopNotify(), r.Err(), nil
+}
+
 // identifyDataDirOrDie returns the type of the data dir.
 // Dies if the datadir is invalid.
 func identifyDataDirOrDie(lg *zap.Logger, dir string) dirType {
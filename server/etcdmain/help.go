@@ -75,20 +75,34 @@ Member:
     Maximum number of snapshot files to retain (0 is unlimited). Deprecated in v3.6 and will be decommissioned in v3.7.
   --max-wals '` + strconv.Itoa(embed.DefaultMaxWALs) + `'
     Maximum number of wal files to retain (0 is unlimited).
+  --max-wal-bytes '0'
+    Maximum total size in bytes of wal files to retain (0 is unlimited). Files needed to recover from the latest snapshot are always retained.
+  --max-wal-age '0s'
+    Maximum age of wal files to retain (0 is unlimited). Files needed to recover from the latest snapshot are always retained.
   --memory-mlock
     Enable to enforce etcd pages (in particular bbolt) to stay in RAM.
   --quota-backend-bytes '0'
     Raise alarms when backend size exceeds the given quota (0 defaults to low space quota).
+  --quota-backend-warning-ratio '0'
+    Add a quota warning header to the responses of mutating requests once the backend size exceeds the given ratio of the quota (0 disables the warning).
   --backend-bbolt-freelist-type 'map'
     BackendFreelistType specifies the type of freelist that boltdb backend uses(array and map are supported types).
   --backend-batch-interval ''
     BackendBatchInterval is the maximum time before commit the backend transaction.
   --backend-batch-limit '0'
     BackendBatchLimit is the maximum operations before commit the backend transaction.
+  --backend-snapshot-spool 'false'
+    Spool backend snapshots to a temporary file so that slow snapshot transfers do not hold the backend read transaction open.
   --max-txn-ops '128'
     Maximum number of operations permitted in a transaction.
   --max-request-bytes '1572864'
     Maximum client request size in bytes the server will accept.
+  --warning-value-size-bytes '0'
+    Add a value size warning header to the responses of the requests writing values larger than the given size in bytes, and count them per key prefix (0 disables the warning).
+  --max-keys-per-prefix ''
+    Comma-separated list of prefix=limit, the maximum numbers of keys under the prefixes past which the requests creating keys under them are rejected. All the members must be configured with the same limits.
+  --value-validators ''
+    Comma-separated list of prefix=validator, the validators of the values written under the prefixes: 'json' for JSON values, or the http(s) URL of a webhook posted the key and the value. The writes of invalid values are rejected.
   --max-concurrent-streams 'math.MaxUint32'
     Maximum concurrent streams that each client can open at a time.
   --grpc-keepalive-min-time '5s'
@@ -157,9 +171,11 @@ Clustering:
   --discovery-proxy ''
     HTTP proxy to use for traffic to discovery service. Will be deprecated in v3.7, and be decommissioned in v3.8.
   --discovery-srv ''
-    DNS srv domain used to bootstrap the cluster.
+    Comma separated DNS srv domains used to bootstrap the cluster. SRV record priorities and weights are honored.
   --discovery-srv-name ''
     Suffix to the dns srv name queried when bootstrapping.
+  --discovery-srv-retry-timeout '0s'
+    How long to re-resolve the SRV records when none is found or when they do not describe the local member yet. 0 disables the retries.
   --strict-reconfig-check '` + strconv.FormatBool(embed.DefaultStrictReconfigCheck) + `'
     Reject reconfiguration requests that would cause quorum loss.
   --pre-vote 'true'
@@ -227,6 +243,8 @@ Security:
     Minimum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3.
   --tls-max-version ''
     Maximum TLS version supported by etcd. Possible values: TLS1.2, TLS1.3 (empty will be auto-populated by Go).
+  --fips 'false'
+    Restrict TLS to the FIPS 140-3 approved cipher suites and curves and reject non-compliant configuration. Requires etcd to be built with a FIPS 140 validated crypto module.
 
 Auth:
   --auth-token 'simple'
@@ -235,6 +253,8 @@ Auth:
     Specify the cost / strength of the bcrypt algorithm for hashing auth passwords. Valid values are between ` + fmt.Sprintf("%d", bcrypt.MinCost) + ` and ` + fmt.Sprintf("%d", bcrypt.MaxCost) + `.
   --auth-token-ttl 300
     Time (in seconds) of the auth-token-ttl.
+  --auth-token-role-ttl ''
+    Comma-separated list of role=duration, the lifetimes of the auth tokens of the users having the roles, which expire even if they are used and are refreshed with AuthTokenRefresh.
 
 Profiling and Monitoring:
   --enable-pprof 'false'
@@ -243,6 +263,10 @@ Profiling and Monitoring:
     Set level of detail for exported metrics, specify 'extensive' to include server side grpc histogram metrics.
   --listen-metrics-urls ''
     List of URLs to listen on for the /metrics and /health endpoints. For https, the client URL TLS info is used.
+  --apply-digest-log ''
+    Record a digest of every applied entry into the given ring file, to find the entry the state machines of the members diverged at with 'etcdutl apply-digest diff'. Empty disables the recording.
+  --apply-digest-log-entries 100000
+    The number of digests of the last applied entries kept by the apply digest log.
 
 Logging:
   --logger 'zap'
@@ -277,18 +301,42 @@ Features:
     Duration of time between cluster corruption check passes.
   --compact-hash-check-time '1m'
     Duration of time between leader checks followers compaction hashes.
+  --defrag-schedule ''
+    Cron expression (e.g. '0 3 * * *') of the times the leader defragments the members of the cluster, one at a time and itself last. Empty disables the scheduled defragmentation, and the leader cannot defragment this member.
+  --defrag-free-ratio-threshold '0.5'
+    Ratio of its backend size a member must free to be defragmented by the scheduled defragmentation.
+  --request-fingerprint-window '0s'
+    Window of the detection of the shifts of the request patterns of the clients (e.g. full keyspace scans from a new user), logged and counted. 0 disables the detection.
+  --request-fingerprint-min-requests '` + strconv.Itoa(embed.DefaultRequestFingerprintMinRequests) + `'
+    Number of requests of a new pattern of a client in a window from which it is reported.
+  --webhook-urls ''
+    Comma-separated list of webhooks to post Kubernetes-style events to on alarms raised, leader changes, corruption detected and backend quota nearly exceeded.
+  --webhook-secret-file ''
+    Path to the file holding the secret signing the requests posted to the webhooks, in the X-Etcd-Signature header.
+  --webhook-quota-threshold '0.9'
+    Ratio of the backend quota used from which a quota nearly exceeded event is posted to the webhooks.
+  --learner-serve-watch 'false'
+    Serve Watch requests while the member is a learner, in addition to serializable Range requests, to offload the watch fan-out from the voting members.
   --compaction-batch-limit 1000
     CompactionBatchLimit sets the maximum revisions deleted in each compaction batch.
   --peer-skip-client-san-verification 'false'
     Skip verification of SAN field in client certificate for peer connections.
   --watch-progress-notify-interval '10m'
     Duration of periodical watch progress notification.
+  --watch-skip-index-block-size 1024
+    Number of revisions summarized by each block of the index letting watchers catching up from an old revision skip the revisions without events on their keys. 0 disables the index.
+  --heartbeat-protection 'false'
+    Pause the sync of the watchers catching up from an old revision and reject the metrics scrapes while the raft loop falls behind its ticks, to protect the heartbeats.
   --warning-apply-duration '100ms'
     Warning is generated if requests take more than this duration.
   --bootstrap-defrag-threshold-megabytes
     Enable the defrag during etcd server bootstrap on condition that it will free at least the provided threshold of disk space. Needs to be set to non-zero value to take effect.
   --max-learners '1'
     Set the max number of learner members allowed in the cluster membership.
+  --large-cluster 'false'
+    Allow up to 11 voting members instead of 7. Unless set explicitly, the heartbeat interval and election timeout become 200 and 2000 and at most 2 snapshots are sent at the same time.
+  --max-concurrent-snapshot-sends '0'
+    Maximum number of snapshots the leader sends to followers at the same time. 0 means no limit.
   --compaction-sleep-interval
     Sets the sleep interval between each compaction batch.
   --downgrade-check-time
@@ -301,6 +349,8 @@ Unsafe feature:
     Force to create a new one-member cluster.
   --unsafe-no-fsync 'false'
     Disables fsync, unsafe, will cause data loss.
+  --recovery-mode 'false'
+    Start without joining the cluster, serving a read-only view of the backend (Range, and the Hash, HashKV, Status and Snapshot maintenance RPCs) on the client URLs, which must be unix sockets, loopback addresses, or https with --client-cert-auth.
 
 CAUTIOUS with unsafe flag! It may break the guarantees given by the consensus protocol!
 `
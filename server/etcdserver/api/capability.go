@@ -27,8 +27,9 @@ import (
 type Capability string
 
 const (
-	AuthCapability  Capability = "auth"
-	V3rpcCapability Capability = "v3rpc"
+	AuthCapability              Capability = "auth"
+	V3rpcCapability             Capability = "v3rpc"
+	CompactionBarrierCapability Capability = "compactionBarrier"
 )
 
 var (
@@ -41,7 +42,7 @@ var (
 		"3.4.0": {AuthCapability: true, V3rpcCapability: true},
 		"3.5.0": {AuthCapability: true, V3rpcCapability: true},
 		"3.6.0": {AuthCapability: true, V3rpcCapability: true},
-		"3.7.0": {AuthCapability: true, V3rpcCapability: true},
+		"3.7.0": {AuthCapability: true, V3rpcCapability: true, CompactionBarrierCapability: true},
 	}
 
 	enableMapMu sync.RWMutex
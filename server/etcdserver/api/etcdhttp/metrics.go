@@ -17,6 +17,7 @@ package etcdhttp
 import (
 	"net/http"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -29,3 +30,28 @@ const (
 func HandleMetrics(mux *http.ServeMux) {
 	mux.Handle(PathMetrics, promhttp.Handler())
 }
+
+// HandleMetricsGatherer registers a handler on '/metrics' serving the metrics
+// of the gatherer.
+func HandleMetricsGatherer(mux *http.ServeMux, g prometheus.Gatherer) {
+	mux.Handle(PathMetrics, promhttp.HandlerFor(g, promhttp.HandlerOpts{}))
+}
+
+// HandleMetricsShedding registers prometheus handler on '/metrics', serving
+// the metrics of the gatherer, or of the default one if nil, which responds
+// with 503 Service Unavailable instead of gathering the metrics while shed
+// returns true.
+func HandleMetricsShedding(mux *http.ServeMux, g prometheus.Gatherer, shed func() bool) {
+	h := promhttp.Handler()
+	if g != nil {
+		h = promhttp.HandlerFor(g, promhttp.HandlerOpts{})
+	}
+	mux.HandleFunc(PathMetrics, func(w http.ResponseWriter, r *http.Request) {
+		if shed() {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "metrics scrapes are shed to protect the heartbeats", http.StatusServiceUnavailable)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
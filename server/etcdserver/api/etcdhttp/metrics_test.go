@@ -0,0 +1,42 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleMetricsShedding(t *testing.T) {
+	shed := true
+	mux := http.NewServeMux()
+	HandleMetricsShedding(mux, nil, func() bool { return shed })
+
+	for _, tt := range []struct {
+		shed  bool
+		wcode int
+	}{
+		{shed: true, wcode: http.StatusServiceUnavailable},
+		{shed: false, wcode: http.StatusOK},
+	} {
+		shed = tt.shed
+		rw := httptest.NewRecorder()
+		mux.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, PathMetrics, nil))
+		if rw.Code != tt.wcode {
+			t.Errorf("shed=%v: code=%d, want %d", tt.shed, rw.Code, tt.wcode)
+		}
+	}
+}
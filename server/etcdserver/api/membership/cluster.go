@@ -56,9 +56,12 @@ type RaftCluster struct {
 	// removed id cannot be reused.
 	removed map[types.ID]bool
 
-	downgradeInfo  *serverversion.DowngradeInfo
-	maxLearners    int
-	versionChanged *notify.Notifier
+	downgradeInfo *serverversion.DowngradeInfo
+	maxLearners   int
+	// maxVotingMembers is the voting member ceiling enforced on membership
+	// changes, zero means no limit.
+	maxVotingMembers int
+	versionChanged   *notify.Notifier
 }
 
 // ConfigChangeContext represents a context for confChange.
@@ -111,12 +114,13 @@ func NewCluster(lg *zap.Logger, opts ...ClusterOption) *RaftCluster {
 	clOpts := newClusterOpts(opts...)
 
 	return &RaftCluster{
-		lg:            lg,
-		members:       make(map[types.ID]*Member),
-		removed:       make(map[types.ID]bool),
-		downgradeInfo: &serverversion.DowngradeInfo{Enabled: false},
-		maxLearners:   clOpts.maxLearners,
-		v2store:       v2store.New(),
+		lg:               lg,
+		members:          make(map[types.ID]*Member),
+		removed:          make(map[types.ID]bool),
+		downgradeInfo:    &serverversion.DowngradeInfo{Enabled: false},
+		maxLearners:      clOpts.maxLearners,
+		maxVotingMembers: clOpts.maxVotingMembers,
+		v2store:          v2store.New(),
 	}
 }
 
@@ -334,6 +338,10 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange, shouldAp
 			if !membersMap[id].IsLearner {
 				return ErrMemberNotLearner
 			}
+			scaleUpVotingMembers := true
+			if err := ValidateMaxVotingMemberConfig(c.maxVotingMembers, membersOf(membersMap), scaleUpVotingMembers); err != nil {
+				return err
+			}
 		} else { // adding a new member
 			if membersMap[id] != nil {
 				return ErrIDExists
@@ -358,6 +366,11 @@ func (c *RaftCluster) ValidateConfigurationChange(cc raftpb.ConfChange, shouldAp
 				if err := ValidateMaxLearnerConfig(c.maxLearners, members, scaleUpLearners); err != nil {
 					return err
 				}
+			} else {
+				scaleUpVotingMembers := true
+				if err := ValidateMaxVotingMemberConfig(c.maxVotingMembers, members, scaleUpVotingMembers); err != nil {
+					return err
+				}
 			}
 		}
 	case raftpb.ConfChangeRemoveNode:
@@ -586,6 +599,42 @@ func (c *RaftCluster) UpdateRaftAttributes(id types.ID, raftAttr RaftAttributes,
 	}
 }
 
+// UpdateBalancingAttributes replaces the balancing attributes of the member,
+// zero attributes remove them. They are only kept in the v3 backend.
+func (c *RaftCluster) UpdateBalancingAttributes(id types.ID, attr BalancingAttributes, shouldApplyV3 ShouldApplyV3) {
+	c.Lock()
+	defer c.Unlock()
+
+	m, ok := c.members[id]
+	if !ok {
+		c.lg.Info("Skipped updating balancing attributes of non-existent member",
+			zap.String("cluster-id", c.cid.String()),
+			zap.String("local-member-id", c.localID.String()),
+			zap.String("updated-remote-peer-id", id.String()),
+		)
+		return
+	}
+	if !shouldApplyV3 {
+		return
+	}
+	if attr == (BalancingAttributes{}) {
+		m.Balancing = nil
+	} else {
+		m.Balancing = &attr
+	}
+	c.be.MustSaveMemberToBackend(m)
+
+	c.lg.Info(
+		"updated member balancing attributes",
+		zap.String("cluster-id", c.cid.String()),
+		zap.String("local-member-id", c.localID.String()),
+		zap.String("updated-remote-peer-id", id.String()),
+		zap.String("zone", attr.Zone),
+		zap.Uint32("weight", attr.Weight),
+		zap.Bool("maintenance", attr.Maintenance),
+	)
+}
+
 func (c *RaftCluster) Version() *semver.Version {
 	c.Lock()
 	defer c.Unlock()
@@ -903,6 +952,37 @@ func ValidateMaxLearnerConfig(maxLearners int, members []*Member, scaleUpLearner
 	return nil
 }
 
+// ValidateMaxVotingMemberConfig verifies the existing voting members in the cluster membership and an optional N+1
+// voting member scale up are not more than maxVotingMembers. A zero maxVotingMembers means no limit.
+func ValidateMaxVotingMemberConfig(maxVotingMembers int, members []*Member, scaleUpVotingMembers bool) error {
+	if maxVotingMembers == 0 {
+		return nil
+	}
+	numVotingMembers := 0
+	for _, m := range members {
+		if !m.IsLearner {
+			numVotingMembers++
+		}
+	}
+	if scaleUpVotingMembers {
+		numVotingMembers++
+	}
+
+	if numVotingMembers > maxVotingMembers {
+		return ErrTooManyVotingMembers
+	}
+
+	return nil
+}
+
+func membersOf(membersMap map[types.ID]*Member) []*Member {
+	members := make([]*Member, 0, len(membersMap))
+	for _, m := range membersMap {
+		members = append(members, m)
+	}
+	return members
+}
+
 func (c *RaftCluster) Store(store v2store.Store) {
 	c.Lock()
 	defer c.Unlock()
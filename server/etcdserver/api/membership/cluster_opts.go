@@ -16,8 +16,18 @@ package membership
 
 const DefaultMaxLearners = 1
 
+const (
+	// DefaultMaxVotingMembers is the maximum number of voting members of a
+	// cluster, beyond which each write waits on an ever larger quorum.
+	DefaultMaxVotingMembers = 7
+	// LargeClusterMaxVotingMembers is the maximum number of voting members of
+	// a cluster running in large-cluster mode.
+	LargeClusterMaxVotingMembers = 11
+)
+
 type ClusterOptions struct {
-	maxLearners int
+	maxLearners      int
+	maxVotingMembers int
 }
 
 // ClusterOption are options which can be applied to the raft cluster.
@@ -41,3 +51,11 @@ func WithMaxLearners(max int) ClusterOption {
 		co.maxLearners = max
 	}
 }
+
+// WithMaxVotingMembers sets the maximum number of voting members that can exist
+// in the cluster membership. Zero means no limit.
+func WithMaxVotingMembers(max int) ClusterOption {
+	return func(co *ClusterOptions) {
+		co.maxVotingMembers = max
+	}
+}
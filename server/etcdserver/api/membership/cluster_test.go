@@ -474,6 +474,85 @@ func testClusterValidateConfigurationChange(t *testing.T, shouldApplyV3 ShouldAp
 	}
 }
 
+func TestClusterValidateConfigurationChangeMaxVotingMembers(t *testing.T) {
+	cl := NewCluster(zaptest.NewLogger(t), WithMaxLearners(2), WithMaxVotingMembers(3))
+	cl.SetBackend(newMembershipBackend())
+	cl.SetStore(v2store.New())
+	for i := 1; i <= 4; i++ {
+		attr := RaftAttributes{PeerURLs: []string{fmt.Sprintf("http://127.0.0.1:%d", i)}, IsLearner: i == 4}
+		cl.AddMember(&Member{ID: types.ID(i), RaftAttributes: attr}, true)
+	}
+
+	mustContext := func(ccc ConfigChangeContext) []byte {
+		ctx, err := json.Marshal(&ccc)
+		require.NoError(t, err)
+		return ctx
+	}
+	tests := []struct {
+		name string
+		cc   raftpb.ConfChange
+		werr error
+	}{
+		{
+			name: "add voting member",
+			cc: raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddNode,
+				NodeID:  5,
+				Context: mustContext(ConfigChangeContext{Member: Member{ID: 5, RaftAttributes: RaftAttributes{PeerURLs: []string{"http://127.0.0.1:5"}}}}),
+			},
+			werr: ErrTooManyVotingMembers,
+		},
+		{
+			name: "promote learner",
+			cc: raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddNode,
+				NodeID:  4,
+				Context: mustContext(ConfigChangeContext{Member: Member{ID: 4}, IsPromote: true}),
+			},
+			werr: ErrTooManyVotingMembers,
+		},
+		{
+			name: "add learner",
+			cc: raftpb.ConfChange{
+				Type:    raftpb.ConfChangeAddLearnerNode,
+				NodeID:  5,
+				Context: mustContext(ConfigChangeContext{Member: Member{ID: 5, RaftAttributes: RaftAttributes{PeerURLs: []string{"http://127.0.0.1:5"}, IsLearner: true}}}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cl.ValidateConfigurationChange(tt.cc, true)
+			require.ErrorIs(t, err, tt.werr)
+		})
+	}
+}
+
+func TestValidateMaxVotingMemberConfig(t *testing.T) {
+	members := []*Member{
+		{ID: 1},
+		{ID: 2},
+		{ID: 3, RaftAttributes: RaftAttributes{IsLearner: true}},
+	}
+	tests := []struct {
+		name             string
+		maxVotingMembers int
+		scaleUp          bool
+		werr             error
+	}{
+		{name: "no limit", maxVotingMembers: 0, scaleUp: true},
+		{name: "at limit", maxVotingMembers: 2},
+		{name: "scale up beyond limit", maxVotingMembers: 2, scaleUp: true, werr: ErrTooManyVotingMembers},
+		{name: "beyond limit", maxVotingMembers: 1, werr: ErrTooManyVotingMembers},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMaxVotingMemberConfig(tt.maxVotingMembers, members, tt.scaleUp)
+			require.ErrorIs(t, err, tt.werr)
+		})
+	}
+}
+
 func TestClusterGenID(t *testing.T) {
 	cs := newTestCluster(t, []*Member{
 		newTestMember(1, nil, "", nil),
@@ -1110,6 +1189,56 @@ func TestUpdateRaftAttributes(t *testing.T) {
 	}
 }
 
+func TestUpdateBalancingAttributes(t *testing.T) {
+	attr := BalancingAttributes{Zone: "us-east-1a", Weight: 2, Maintenance: true}
+	testCases := []struct {
+		name           string
+		balancing      *BalancingAttributes
+		attr           BalancingAttributes
+		shouldApplyV3  ShouldApplyV3
+		wantBalancing  *BalancingAttributes
+		updateMemberID types.ID
+	}{
+		{
+			name:           "set attributes",
+			attr:           attr,
+			shouldApplyV3:  true,
+			wantBalancing:  &attr,
+			updateMemberID: 1,
+		},
+		{
+			name:           "remove attributes",
+			balancing:      &attr,
+			shouldApplyV3:  true,
+			updateMemberID: 1,
+		},
+		{
+			name:           "already applied to the backend",
+			attr:           attr,
+			updateMemberID: 1,
+		},
+		{
+			name:           "update a non-exist member",
+			attr:           attr,
+			shouldApplyV3:  true,
+			updateMemberID: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newTestMember(1, []string{"http://127.0.0.1:2380"}, "1", nil)
+			m.Balancing = tc.balancing
+			c := newTestCluster(t, []*Member{m})
+
+			c.UpdateBalancingAttributes(tc.updateMemberID, tc.attr, tc.shouldApplyV3)
+
+			require.Equal(t, tc.wantBalancing, c.Member(1).Balancing)
+			require.Len(t, c.Members(), 1)
+		})
+	}
+}
+
 func TestClusterStore(t *testing.T) {
 	name := "etcd"
 	clientURLs := []string{"http://127.0.0.1:4001"}
@@ -27,6 +27,8 @@ var (
 	ErrPeerURLexists    = errors.New("membership: peerURL exists")
 	ErrMemberNotLearner = errors.New("membership: can only promote a learner member")
 	ErrTooManyLearners  = errors.New("membership: too many learner members in cluster")
+
+	ErrTooManyVotingMembers = errors.New("membership: too many voting members in cluster")
 )
 
 func isKeyNotFound(err error) bool {
@@ -40,10 +40,20 @@ type Attributes struct {
 	ClientURLs []string `json:"clientURLs,omitempty"`
 }
 
+// BalancingAttributes represents the attributes set on an etcd member by
+// MemberUpdate for client-side balancing. They are not used by the server.
+type BalancingAttributes struct {
+	Zone        string `json:"zone,omitempty"`
+	Weight      uint32 `json:"weight,omitempty"`
+	Maintenance bool   `json:"maintenance,omitempty"`
+}
+
 type Member struct {
 	ID types.ID `json:"id"`
 	RaftAttributes
 	Attributes
+	// Balancing is nil if no balancing attributes are set on the member.
+	Balancing *BalancingAttributes `json:"balancing,omitempty"`
 }
 
 // NewMember creates a Member without an ID and generates one based on the
@@ -108,6 +118,10 @@ func (m *Member) Clone() *Member {
 		mm.ClientURLs = make([]string, len(m.ClientURLs))
 		copy(mm.ClientURLs, m.ClientURLs)
 	}
+	if m.Balancing != nil {
+		b := *m.Balancing
+		mm.Balancing = &b
+	}
 	return mm
 }
 
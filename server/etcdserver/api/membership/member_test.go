@@ -61,6 +61,7 @@ func TestMemberClone(t *testing.T) {
 		newTestMember(1, []string{"http://a"}, "abc", nil),
 		newTestMember(1, nil, "abc", []string{"http://b"}),
 		newTestMember(1, []string{"http://a"}, "abc", []string{"http://b"}),
+		{ID: 1, Balancing: &BalancingAttributes{Zone: "a", Weight: 2, Maintenance: true}},
 	}
 	for i, tt := range tests {
 		nm := tt.Clone()
@@ -70,6 +71,9 @@ func TestMemberClone(t *testing.T) {
 		if !reflect.DeepEqual(nm, tt) {
 			t.Errorf("#%d: member = %+v, want %+v", i, nm, tt)
 		}
+		if nm.Balancing != nil && nm.Balancing == tt.Balancing {
+			t.Errorf("#%d: the balancing attributes are shared", i)
+		}
 	}
 }
 
@@ -0,0 +1,117 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rafthttp
+
+import (
+	"crypto/x509"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+)
+
+// PeerCertificate is a TLS certificate a peer presented on a raft stream.
+type PeerCertificate struct {
+	PeerID types.ID
+	// Stream is the type of the stream, "message" or "msgappv2".
+	Stream string
+	// Inbound is set if the stream was opened by the peer, which presented
+	// its client certificate, rather than dialed by the local member.
+	Inbound  bool
+	Subject  string
+	NotAfter time.Time
+}
+
+func newPeerCertificate(id types.ID, t streamType, inbound bool, cert *x509.Certificate) PeerCertificate {
+	return PeerCertificate{
+		PeerID:   id,
+		Stream:   string(t),
+		Inbound:  inbound,
+		Subject:  cert.Subject.String(),
+		NotAfter: cert.NotAfter,
+	}
+}
+
+func observePeerCertificate(id types.ID, cert *x509.Certificate) {
+	if cert == nil {
+		return
+	}
+	peerCertificateExpiry.WithLabelValues(id.String()).Set(float64(cert.NotAfter.Unix()))
+}
+
+// PeerCertificates returns the certificates the peers presented on the
+// working raft streams, ordered by peer.
+func (t *Transport) PeerCertificates() []PeerCertificate {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var certs []PeerCertificate
+	for _, p := range t.peers {
+		certs = append(certs, p.certificates()...)
+	}
+	sort.SliceStable(certs, func(i, j int) bool { return certs[i].PeerID < certs[j].PeerID })
+	return certs
+}
+
+// ReconnectPeers establishes again the connections with the peers, so that
+// they perform a new TLS handshake with the current certificates, once the
+// peer certificate of the local member has been rotated. TLS 1.3 does not
+// support renegotiation.
+//
+// The raft streams are closed one peer at a time, waiting interval between
+// two peers. The messages to a peer are sent through its pipeline while its
+// streams are established again, so that the heartbeats keep flowing and no
+// election is triggered. It returns early once stopc is closed.
+func (t *Transport) ReconnectPeers(stopc <-chan struct{}, interval time.Duration) {
+	for _, rt := range []http.RoundTripper{t.pipelineRt, t.streamRt} {
+		if tr, ok := rt.(*http.Transport); ok {
+			tr.CloseIdleConnections()
+		}
+	}
+
+	t.mu.RLock()
+	ids := make([]types.ID, 0, len(t.peers))
+	for id := range t.peers {
+		ids = append(ids, id)
+	}
+	t.mu.RUnlock()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for i, id := range ids {
+		if i > 0 {
+			select {
+			case <-time.After(interval):
+			case <-stopc:
+				return
+			}
+		}
+		t.mu.RLock()
+		p, ok := t.peers[id]
+		t.mu.RUnlock()
+		if !ok {
+			continue
+		}
+		if t.Logger != nil {
+			t.Logger.Info(
+				"reconnecting remote peer",
+				zap.String("local-member-id", t.ID.String()),
+				zap.String("remote-peer-id", id.String()),
+			)
+		}
+		p.reconnect()
+	}
+}
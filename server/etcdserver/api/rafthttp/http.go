@@ -449,6 +449,9 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		localID: h.tr.ID,
 		peerID:  from,
 	}
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		conn.cert = r.TLS.PeerCertificates[0]
+	}
 	p.attachOutgoingConn(conn)
 	<-c.closeNotify()
 }
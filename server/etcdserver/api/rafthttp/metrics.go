@@ -178,6 +178,16 @@ var (
 		},
 		[]string{"To"},
 	)
+
+	peerCertificateExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "etcd",
+			Subsystem: "network",
+			Name:      "peer_certificate_expiry_timestamp_seconds",
+			Help:      "The expiry time, in seconds since the Unix epoch, of the TLS certificate last presented by the peer on a raft stream.",
+		},
+		[]string{"Remote"},
+	)
 )
 
 func init() {
@@ -198,4 +208,5 @@ func init() {
 	prometheus.MustRegister(snapshotReceiveSeconds)
 
 	prometheus.MustRegister(rttSec)
+	prometheus.MustRegister(peerCertificateExpiry)
 }
@@ -82,6 +82,12 @@ type Peer interface {
 	// activeSince returns the time that the connection with the
 	// peer becomes active.
 	activeSince() time.Time
+	// certificates returns the certificates the remote peer presented on
+	// the working streams.
+	certificates() []PeerCertificate
+	// reconnect closes the working streams so that they are established
+	// again with a new TLS handshake, without deactivating the peer.
+	reconnect()
 	// stop performs any necessary finalization and terminates the peer
 	// elegantly.
 	stop()
@@ -292,6 +298,28 @@ func (p *peer) attachOutgoingConn(conn *outgoingConn) {
 
 func (p *peer) activeSince() time.Time { return p.status.activeSince() }
 
+func (p *peer) certificates() []PeerCertificate {
+	var certs []PeerCertificate
+	for _, w := range []*streamWriter{p.writer, p.msgAppV2Writer} {
+		if t, cert := w.certificate(); cert != nil {
+			certs = append(certs, newPeerCertificate(p.id, t, true, cert))
+		}
+	}
+	for _, r := range []*streamReader{p.msgAppReader, p.msgAppV2Reader} {
+		if cert := r.certificate(); cert != nil {
+			certs = append(certs, newPeerCertificate(p.id, r.typ, false, cert))
+		}
+	}
+	return certs
+}
+
+func (p *peer) reconnect() {
+	p.writer.reconnect()
+	p.msgAppV2Writer.reconnect()
+	p.msgAppReader.reconnect()
+	p.msgAppV2Reader.reconnect()
+}
+
 // Pause pauses the peer. The peer will simply drops all incoming
 // messages without returning an error.
 func (p *peer) Pause() {
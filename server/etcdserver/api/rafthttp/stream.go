@@ -16,6 +16,7 @@ package rafthttp
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
@@ -108,6 +109,9 @@ type outgoingConn struct {
 
 	localID types.ID
 	peerID  types.ID
+	// cert is the client certificate the peer presented opening the stream,
+	// or nil without TLS.
+	cert *x509.Certificate
 }
 
 // streamWriter writes messages to the attached outgoingConn.
@@ -121,14 +125,17 @@ type streamWriter struct {
 	fs     *stats.FollowerStats
 	r      Raft
 
-	mu      sync.Mutex // guard field working and closer
+	mu      sync.Mutex // guard field working, closer, typ and cert
 	closer  io.Closer
 	working bool
-
-	msgc  chan raftpb.Message
-	connc chan *outgoingConn
-	stopc chan struct{}
-	done  chan struct{}
+	typ     streamType
+	cert    *x509.Certificate
+
+	msgc       chan raftpb.Message
+	connc      chan *outgoingConn
+	reconnectc chan struct{}
+	stopc      chan struct{}
+	done       chan struct{}
 }
 
 // startStreamWriter creates a streamWrite and starts a long running go-routine that accepts
@@ -147,6 +154,8 @@ func startStreamWriter(lg *zap.Logger, local, id types.ID, status *peerStatus, f
 		connc:  make(chan *outgoingConn),
 		stopc:  make(chan struct{}),
 		done:   make(chan struct{}),
+
+		reconnectc: make(chan struct{}),
 	}
 	go w.run()
 	return w
@@ -258,7 +267,9 @@ func (cw *streamWriter) run() {
 			cw.status.activate()
 			cw.closer = conn.Closer
 			cw.working = true
+			cw.typ, cw.cert = conn.t, conn.cert
 			cw.mu.Unlock()
+			observePeerCertificate(cw.peerID, conn.cert)
 
 			if closed {
 				if cw.lg != nil {
@@ -280,6 +291,21 @@ func (cw *streamWriter) run() {
 			}
 			heartbeatc, msgc = tickc.C, cw.msgc
 
+		case <-cw.reconnectc:
+			// the peer dials the stream again, meanwhile the messages are
+			// sent through the pipeline without deactivating the peer.
+			if cw.close() {
+				if cw.lg != nil {
+					cw.lg.Info(
+						"closed TCP streaming connection with remote peer to reconnect",
+						zap.String("stream-writer-type", t.String()),
+						zap.String("local-member-id", cw.localID.String()),
+						zap.String("remote-peer-id", cw.peerID.String()),
+					)
+				}
+			}
+			heartbeatc, msgc = nil, nil
+
 		case <-cw.stopc:
 			if cw.close() {
 				if cw.lg != nil {
@@ -333,9 +359,27 @@ func (cw *streamWriter) closeUnlocked() bool {
 	}
 	cw.msgc = make(chan raftpb.Message, streamBufSize)
 	cw.working = false
+	cw.cert = nil
 	return true
 }
 
+// certificate returns the type of the working stream and the certificate
+// the peer presented opening it, or a nil certificate if there is none.
+func (cw *streamWriter) certificate() (streamType, *x509.Certificate) {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	return cw.typ, cw.cert
+}
+
+// reconnect closes the working stream so that the peer opens it again,
+// performing a new TLS handshake.
+func (cw *streamWriter) reconnect() {
+	select {
+	case cw.reconnectc <- struct{}{}:
+	case <-cw.done:
+	}
+}
+
 func (cw *streamWriter) attach(conn *outgoingConn) bool {
 	select {
 	case cw.connc <- conn:
@@ -371,6 +415,12 @@ type streamReader struct {
 	mu     sync.Mutex
 	paused bool
 	closer io.Closer
+	// cert is the server certificate the peer presented on the dial of the
+	// working stream, or nil without TLS.
+	cert *x509.Certificate
+	// reconnecting is set while the stream is closed by reconnect, so that
+	// the peer is not deactivated.
+	reconnecting bool
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -417,22 +467,37 @@ func (cr *streamReader) run() {
 				)
 			}
 			err = cr.decodeLoop(rc, t)
-			if cr.lg != nil {
-				cr.lg.Warn(
-					"lost TCP streaming connection with remote peer",
-					zap.String("stream-reader-type", cr.typ.String()),
-					zap.String("local-member-id", cr.tr.ID.String()),
-					zap.String("remote-peer-id", cr.peerID.String()),
-					zap.Error(err),
-				)
-			}
-			switch {
-			// all data is read out
-			case errors.Is(err, io.EOF):
-			// connection is closed by the remote
-			case transport.IsClosedConnError(err):
-			default:
-				cr.status.deactivate(failureType{source: t.String(), action: "read"}, err.Error())
+			cr.mu.Lock()
+			reconnecting := cr.reconnecting
+			cr.reconnecting = false
+			cr.mu.Unlock()
+			if reconnecting {
+				if cr.lg != nil {
+					cr.lg.Info(
+						"closed TCP streaming connection with remote peer to reconnect",
+						zap.String("stream-reader-type", cr.typ.String()),
+						zap.String("local-member-id", cr.tr.ID.String()),
+						zap.String("remote-peer-id", cr.peerID.String()),
+					)
+				}
+			} else {
+				if cr.lg != nil {
+					cr.lg.Warn(
+						"lost TCP streaming connection with remote peer",
+						zap.String("stream-reader-type", cr.typ.String()),
+						zap.String("local-member-id", cr.tr.ID.String()),
+						zap.String("remote-peer-id", cr.peerID.String()),
+						zap.Error(err),
+					)
+				}
+				switch {
+				// all data is read out
+				case errors.Is(err, io.EOF):
+				// connection is closed by the remote
+				case transport.IsClosedConnError(err):
+				default:
+					cr.status.deactivate(failureType{source: t.String(), action: "read"}, err.Error())
+				}
 			}
 		}
 		// Wait for a while before new dial attempt
@@ -620,6 +685,13 @@ func (cr *streamReader) dial(t streamType) (io.ReadCloser, error) {
 		return nil, errMemberRemoved
 
 	case http.StatusOK:
+		if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+			cert := resp.TLS.PeerCertificates[0]
+			cr.mu.Lock()
+			cr.cert = cert
+			cr.mu.Unlock()
+			observePeerCertificate(cr.peerID, cert)
+		}
 		return resp.Body, nil
 
 	case http.StatusNotFound:
@@ -686,6 +758,27 @@ func (cr *streamReader) close() {
 		}
 	}
 	cr.closer = nil
+	cr.cert = nil
+}
+
+// certificate returns the certificate the peer presented on the dial of the
+// working stream, or nil if there is none.
+func (cr *streamReader) certificate() *x509.Certificate {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	return cr.cert
+}
+
+// reconnect closes the working stream so that the stream reader dials it
+// again, performing a new TLS handshake.
+func (cr *streamReader) reconnect() {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if cr.closer == nil {
+		return
+	}
+	cr.reconnecting = true
+	cr.close()
 }
 
 func (cr *streamReader) pause() {
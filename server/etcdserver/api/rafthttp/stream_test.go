@@ -15,12 +15,15 @@
 package rafthttp
 
 import (
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -108,6 +111,38 @@ func TestStreamWriterAttachBadOutgoingConn(t *testing.T) {
 	}
 }
 
+// TestStreamWriterReconnect tests that streamWriter closes the attached
+// outgoingConn on reconnect without deactivating the peer, and reports the
+// certificate the peer presented on it until then.
+func TestStreamWriterReconnect(t *testing.T) {
+	status := newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1))
+	sw := startStreamWriter(zaptest.NewLogger(t), types.ID(0), types.ID(1), status, &stats.FollowerStats{}, &fakeRaft{})
+	defer sw.stop()
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "peer"}, NotAfter: time.Now().Add(time.Hour)}
+	wfc := newFakeWriteFlushCloser(nil)
+	sw.attach(&outgoingConn{t: streamTypeMsgAppV2, Writer: wfc, Flusher: wfc, Closer: wfc, cert: cert})
+
+	if typ, c := sw.certificate(); typ != streamTypeMsgAppV2 || c != cert {
+		t.Fatalf("certificate = %v, %v, want %v, %v", typ, c, streamTypeMsgAppV2, cert)
+	}
+
+	sw.reconnect()
+	select {
+	case <-wfc.closed:
+	case <-time.After(time.Second):
+		t.Fatal("failed to close the underlying connection in time")
+	}
+	if _, ok := sw.writec(); ok {
+		t.Errorf("working = %v, want false", ok)
+	}
+	if _, c := sw.certificate(); c != nil {
+		t.Errorf("certificate = %v, want nil", c)
+	}
+	if !status.isActive() {
+		t.Error("peer deactivated by reconnect")
+	}
+}
+
 func TestStreamReaderDialRequest(t *testing.T) {
 	for i, tt := range []streamType{streamTypeMessage, streamTypeMsgAppV2} {
 		tr := &roundTripperRecorder{rec: &testutil.RecorderBuffered{}}
@@ -347,6 +382,74 @@ func TestStream(t *testing.T) {
 	}
 }
 
+// TestStreamReaderReconnect tests that streamReader dials the stream again
+// on reconnect without deactivating the peer, and reports the certificate
+// the peer presented on the dial.
+func TestStreamReaderReconnect(t *testing.T) {
+	recvc := make(chan raftpb.Message, streamBufSize)
+	h := &fakeStreamHandler{t: streamTypeMessage}
+	var dials atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		dials.Add(1)
+		h.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	sw := startStreamWriter(zaptest.NewLogger(t), types.ID(0), types.ID(1), newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(1)), &stats.FollowerStats{}, &fakeRaft{})
+	defer sw.stop()
+	h.sw = sw
+
+	status := newPeerStatus(zaptest.NewLogger(t), types.ID(0), types.ID(2))
+	sr := &streamReader{
+		peerID: types.ID(2),
+		typ:    streamTypeMessage,
+		tr:     &Transport{streamRt: srv.Client().Transport, ClusterID: types.ID(1)},
+		picker: mustNewURLPicker(t, []string{srv.URL}),
+		status: status,
+		recvc:  recvc,
+		propc:  make(chan raftpb.Message, streamBufSize),
+		rl:     rate.NewLimiter(rate.Every(100*time.Millisecond), 1),
+	}
+	sr.start()
+	defer sr.stop()
+
+	waitDial := func(n int32) {
+		for dials.Load() < n || sr.certificate() == nil {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	waitDial(1)
+	if cert := sr.certificate(); !cert.Equal(srv.Certificate()) {
+		t.Fatalf("certificate = %v, want %v", cert.Subject, srv.Certificate().Subject)
+	}
+
+	sr.reconnect()
+	waitDial(2)
+	if !status.isActive() {
+		t.Error("peer deactivated by reconnect")
+	}
+
+	// the messages are received on the new stream, once the writer attached
+	// it in place of the one closed by the reader
+	m := raftpb.Message{Type: raftpb.MsgApp, From: 2, To: 1}
+	timeout := time.After(time.Second)
+	for {
+		if writec, ok := sw.writec(); ok {
+			writec <- m
+		}
+		select {
+		case got := <-recvc:
+			if !reflect.DeepEqual(got, m) {
+				t.Errorf("message = %+v, want %+v", got, m)
+			}
+			return
+		case <-time.After(10 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("failed to receive message from the channel")
+		}
+	}
+}
+
 func TestCheckStreamSupport(t *testing.T) {
 	tests := []struct {
 		v *semver.Version
@@ -347,6 +347,7 @@ func (t *Transport) removePeer(id types.ID) {
 		delete(t.LeaderStats.Followers, id.String())
 		t.pipelineProber.Remove(id.String())
 		t.streamProber.Remove(id.String())
+		peerCertificateExpiry.DeleteLabelValues(id.String())
 	}
 
 	if t.Logger != nil {
@@ -62,6 +62,14 @@ func (as *AuthServer) Authenticate(ctx context.Context, r *pb.AuthenticateReques
 	return resp, nil
 }
 
+func (as *AuthServer) AuthTokenRefresh(ctx context.Context, r *pb.AuthTokenRefreshRequest) (*pb.AuthTokenRefreshResponse, error) {
+	resp, err := as.authenticator.AuthTokenRefresh(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
 func (as *AuthServer) RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	resp, err := as.authenticator.RoleAdd(ctx, r)
 	if err != nil {
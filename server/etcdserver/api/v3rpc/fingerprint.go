@@ -0,0 +1,269 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver"
+)
+
+const (
+	// shiftMaxBaselineShare is the share of the requests of a principal in
+	// the past windows below which a fingerprint reaching the minimum number
+	// of requests in the current window is reported as a pattern shift.
+	shiftMaxBaselineShare = 0.05
+	// baselineDecay is the factor the request counts of the past windows are
+	// multiplied by at the end of each window.
+	baselineDecay = 0.5
+	// maxIdleWindows is the number of windows without request after which a
+	// principal is forgotten.
+	maxIdleWindows = 10
+	// maxPrincipals bounds the number of principals tracked by the detector.
+	maxPrincipals = 10000
+)
+
+func newFingerprintUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
+	d := newPatternShiftDetector(s.Logger(), s.Cfg.RequestFingerprintWindow, s.Cfg.RequestFingerprintMinRequests)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		d.observe(requestPrincipal(ctx, s), requestFingerprint(info.FullMethod, req))
+		return handler(ctx, req)
+	}
+}
+
+// requestPrincipal returns the authenticated user of the request, or its
+// remote host if it is not authenticated.
+func requestPrincipal(ctx context.Context, s *etcdserver.EtcdServer) string {
+	if ai, err := s.AuthInfoFromCtx(ctx); err == nil && ai != nil && ai.Username != "" {
+		return "user:" + ai.Username
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		return "remote:" + host
+	}
+	return "unknown"
+}
+
+// requestFingerprint returns the shape of a request: its method and, for the
+// key-value requests, the shapes of their key ranges and the mix of the
+// operations of transactions, e.g. "Range:prefix" or "Txn:Put:key,Range:all".
+func requestFingerprint(fullMethod string, req any) string {
+	method := path.Base(fullMethod)
+	switch r := req.(type) {
+	case *pb.RangeRequest:
+		return method + ":" + rangeShape(r.Key, r.RangeEnd)
+	case *pb.DeleteRangeRequest:
+		return method + ":" + rangeShape(r.Key, r.RangeEnd)
+	case *pb.PutRequest:
+		return method + ":key"
+	case *pb.TxnRequest:
+		return method + ":" + txnOpMix(r)
+	default:
+		return method
+	}
+}
+
+// txnOpMix returns the sorted distinct shapes of the operations of the
+// transaction.
+func txnOpMix(r *pb.TxnRequest) string {
+	var ops []string
+	for _, reqs := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range reqs {
+			var s string
+			switch o := op.Request.(type) {
+			case *pb.RequestOp_RequestRange:
+				s = "Range:" + rangeShape(o.RequestRange.Key, o.RequestRange.RangeEnd)
+			case *pb.RequestOp_RequestPut:
+				s = "Put:key"
+			case *pb.RequestOp_RequestDeleteRange:
+				s = "DeleteRange:" + rangeShape(o.RequestDeleteRange.Key, o.RequestDeleteRange.RangeEnd)
+			case *pb.RequestOp_RequestTxn:
+				s = "Txn"
+			}
+			if s != "" && !slices.Contains(ops, s) {
+				ops = append(ops, s)
+			}
+		}
+	}
+	if len(ops) == 0 {
+		return "empty"
+	}
+	slices.Sort(ops)
+	return strings.Join(ops, ",")
+}
+
+// rangeShape returns "key" for a single key, "all" for the whole keyspace,
+// "from-key" for the keys greater than or equal to a key, "prefix" for the
+// keys having a prefix and "range" otherwise.
+func rangeShape(key, end []byte) string {
+	switch {
+	case len(end) == 0:
+		return "key"
+	case bytes.Equal(end, []byte{0}) && (len(key) == 0 || bytes.Equal(key, []byte{0})):
+		return "all"
+	case bytes.Equal(end, []byte{0}):
+		return "from-key"
+	case isPrefixRangeEnd(key, end):
+		return "prefix"
+	default:
+		return "range"
+	}
+}
+
+func isPrefixRangeEnd(key, end []byte) bool {
+	for i := len(key) - 1; i >= 0; i-- {
+		if key[i] < 0xff {
+			return len(end) == i+1 && bytes.Equal(end[:i], key[:i]) && end[i] == key[i]+1
+		}
+	}
+	return false
+}
+
+// patternShiftDetector reports the fingerprints of the requests of a principal
+// that account for at least minRequests requests in a window but for a
+// negligible share of its requests in the past windows, such as full keyspace
+// scans from a new client. Nothing is reported during the first window, whose
+// requests make the initial baselines.
+type patternShiftDetector struct {
+	lg          *zap.Logger
+	window      time.Duration
+	minRequests int
+	now         func() time.Time
+
+	mu         sync.Mutex
+	start      time.Time
+	warm       bool
+	principals map[string]*principalRequests
+}
+
+type principalRequests struct {
+	// baseline is the decayed number of requests per fingerprint of the
+	// past windows.
+	baseline      map[string]float64
+	baselineTotal float64
+	current       map[string]int
+	reported      map[string]struct{}
+	idleWindows   int
+}
+
+func newPatternShiftDetector(lg *zap.Logger, window time.Duration, minRequests int) *patternShiftDetector {
+	return &patternShiftDetector{
+		lg:          lg,
+		window:      window,
+		minRequests: minRequests,
+		now:         time.Now,
+		start:       time.Now(),
+		principals:  make(map[string]*principalRequests),
+	}
+}
+
+func (d *patternShiftDetector) observe(principal, fingerprint string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.rotate()
+
+	p, ok := d.principals[principal]
+	if !ok {
+		if len(d.principals) >= maxPrincipals {
+			return
+		}
+		p = &principalRequests{
+			baseline: make(map[string]float64),
+			current:  make(map[string]int),
+			reported: make(map[string]struct{}),
+		}
+		d.principals[principal] = p
+	}
+	p.current[fingerprint]++
+	if !d.warm || p.current[fingerprint] < d.minRequests {
+		return
+	}
+	if _, ok := p.reported[fingerprint]; ok {
+		return
+	}
+	share := 0.0
+	if p.baselineTotal > 0 {
+		share = p.baseline[fingerprint] / p.baselineTotal
+	}
+	if share >= shiftMaxBaselineShare {
+		return
+	}
+	p.reported[fingerprint] = struct{}{}
+	requestPatternShifts.WithLabelValues(fingerprint).Inc()
+	d.lg.Warn(
+		"detected client request pattern shift",
+		zap.String("principal", principal),
+		zap.String("fingerprint", fingerprint),
+		zap.Int("window-requests", p.current[fingerprint]),
+		zap.Float64("baseline-share", share),
+		zap.Bool("new-principal", p.baselineTotal == 0),
+		zap.Duration("window", d.window),
+	)
+}
+
+// rotate folds the requests of the elapsed windows into the baselines.
+func (d *patternShiftDetector) rotate() {
+	now := d.now()
+	for i := 0; now.Sub(d.start) >= d.window; i++ {
+		d.start = d.start.Add(d.window)
+		d.warm = true
+		if i == maxIdleWindows {
+			// all principals are idle since then
+			d.principals = make(map[string]*principalRequests)
+			d.start = now
+			return
+		}
+		for name, p := range d.principals {
+			if len(p.current) == 0 {
+				if p.idleWindows++; p.idleWindows >= maxIdleWindows {
+					delete(d.principals, name)
+					continue
+				}
+			} else {
+				p.idleWindows = 0
+			}
+			p.baselineTotal = 0
+			for fp, n := range p.baseline {
+				if n *= baselineDecay; n < 0.01 {
+					delete(p.baseline, fp)
+					continue
+				}
+				p.baseline[fp] = n
+				p.baselineTotal += n
+			}
+			for fp, n := range p.current {
+				p.baseline[fp] += float64(n)
+				p.baselineTotal += float64(n)
+			}
+			clear(p.current)
+			clear(p.reported)
+		}
+	}
+}
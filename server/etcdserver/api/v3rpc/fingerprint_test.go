@@ -0,0 +1,110 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestRequestFingerprint(t *testing.T) {
+	tcs := []struct {
+		method string
+		req    any
+		want   string
+	}{
+		{"/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("foo")}, "Range:key"},
+		{"/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte{0}, RangeEnd: []byte{0}}, "Range:all"},
+		{"/etcdserverpb.KV/Range", &pb.RangeRequest{RangeEnd: []byte{0}}, "Range:all"},
+		{"/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte{0}}, "Range:from-key"},
+		{"/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop")}, "Range:prefix"},
+		{"/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("fo\xff"), RangeEnd: []byte("fp")}, "Range:prefix"},
+		{"/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("foo"), RangeEnd: []byte("foz")}, "Range:range"},
+		{"/etcdserverpb.KV/DeleteRange", &pb.DeleteRangeRequest{Key: []byte("foo"), RangeEnd: []byte("fop")}, "DeleteRange:prefix"},
+		{"/etcdserverpb.KV/Put", &pb.PutRequest{Key: []byte("foo")}, "Put:key"},
+		{"/etcdserverpb.KV/Txn", &pb.TxnRequest{}, "Txn:empty"},
+		{
+			"/etcdserverpb.KV/Txn",
+			&pb.TxnRequest{
+				Success: []*pb.RequestOp{
+					{Request: &pb.RequestOp_RequestRange{RequestRange: &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte{0}}}},
+					{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("a")}}},
+				},
+				Failure: []*pb.RequestOp{
+					{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("b")}}},
+				},
+			},
+			"Txn:Put:key,Range:from-key",
+		},
+		{"/etcdserverpb.Lease/LeaseGrant", &pb.LeaseGrantRequest{}, "LeaseGrant"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.want, func(t *testing.T) {
+			assert.Equal(t, tc.want, requestFingerprint(tc.method, tc.req))
+		})
+	}
+}
+
+func TestPatternShiftDetector(t *testing.T) {
+	now := time.Unix(0, 0)
+	d := newPatternShiftDetector(zaptest.NewLogger(t), time.Minute, 3)
+	d.now = func() time.Time { return now }
+	d.start = now
+
+	observe := func(principal, fingerprint string, n int) {
+		for i := 0; i < n; i++ {
+			d.observe(principal, fingerprint)
+		}
+	}
+	shifts := func(fingerprint string) float64 {
+		return testutil.ToFloat64(requestPatternShifts.WithLabelValues(fingerprint))
+	}
+	base, deleteBase := shifts("Range:all"), shifts("DeleteRange:all")
+
+	// the requests of the first window make the baselines
+	observe("user:alice", "Range:key", 100)
+	observe("user:alice", "Range:all", 10)
+	assert.InDelta(t, base, shifts("Range:all"), 0)
+
+	now = now.Add(time.Minute)
+	// a pattern of the baseline is not reported
+	observe("user:alice", "Range:all", 10)
+	assert.InDelta(t, base, shifts("Range:all"), 0)
+	// nor patterns with less than the minimum number of requests
+	observe("user:bob", "Range:all", 2)
+	assert.InDelta(t, base, shifts("Range:all"), 0)
+	// a new principal scanning the keyspace is reported once per window
+	observe("user:bob", "Range:all", 10)
+	assert.InDelta(t, base+1, shifts("Range:all"), 0)
+
+	now = now.Add(time.Minute)
+	// the pattern is part of the baseline of the principal from now on
+	observe("user:bob", "Range:all", 10)
+	assert.InDelta(t, base+1, shifts("Range:all"), 0)
+	// a pattern shift of a known principal is reported
+	observe("user:alice", "DeleteRange:all", 3)
+	assert.InDelta(t, deleteBase+1, shifts("DeleteRange:all"), 0)
+
+	// idle principals are forgotten
+	now = now.Add((maxIdleWindows + 1) * time.Minute)
+	d.observe("user:carol", "Put:key")
+	assert.Len(t, d.principals, 1)
+}
@@ -47,7 +47,11 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 		mopts = append(mopts, grpc_prometheus.WithServerHandlingTimeHistogram())
 	}
 	serverMetrics := grpc_prometheus.NewServerMetrics(mopts...)
-	err := prometheus.Register(serverMetrics)
+	reg := s.Cfg.MetricsRegisterer
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+	err := reg.Register(serverMetrics)
 	if err != nil {
 		s.Cfg.Logger.Warn("etcdserver: failed to register grpc metrics", zap.Error(err))
 	}
@@ -57,6 +61,9 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, interceptor grpc.UnarySer
 		newUnaryInterceptor(s),
 		serverMetrics.UnaryServerInterceptor(),
 	}
+	if s.Cfg.RequestFingerprintWindow > 0 {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, newFingerprintUnaryInterceptor(s))
+	}
 	if interceptor != nil {
 		chainUnaryInterceptors = append(chainUnaryInterceptors, interceptor)
 	}
@@ -16,6 +16,7 @@ package v3rpc
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 	"unicode/utf8"
@@ -28,6 +29,7 @@ import (
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/auth"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 	"go.etcd.io/etcd/server/v3/etcdserver/api"
 	"go.etcd.io/raft/v3"
@@ -36,6 +38,7 @@ import (
 const (
 	maxNoLeaderCnt = 3
 	snapshotMethod = "/etcdserverpb.Maintenance/Snapshot"
+	watchMethod    = "/etcdserverpb.Watch/Watch"
 )
 
 type streamsMap struct {
@@ -220,7 +223,7 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 			return rpctypes.ErrGRPCNotCapable
 		}
 
-		if s.IsMemberExist(s.MemberID()) && s.IsLearner() && info.FullMethod != snapshotMethod { // learner does not support stream RPC except Snapshot
+		if s.IsMemberExist(s.MemberID()) && s.IsLearner() && !isStreamSupportedForLearner(info.FullMethod, s.Cfg.LearnerServeWatch) {
 			return rpctypes.ErrGRPCNotSupportedForLearner
 		}
 
@@ -351,3 +354,67 @@ func monitorLeader(s *etcdserver.EtcdServer) *streamsMap {
 
 	return smap
 }
+
+type authInfoKey struct{}
+
+// AuthInfoFromContext returns the etcd user authenticated by the interceptors
+// returned by NewServiceAuthInterceptors. It returns false if authentication
+// is disabled.
+func AuthInfoFromContext(ctx context.Context) (*auth.AuthInfo, bool) {
+	ai, ok := ctx.Value(authInfoKey{}).(*auth.AuthInfo)
+	return ai, ok
+}
+
+// NewServiceAuthInterceptors returns the interceptors authenticating the calls
+// to the given gRPC services with the etcd token or client certificate they
+// carry, like the calls to etcd's own services. When authentication is
+// enabled, unauthenticated calls are rejected and the authenticated user is
+// stored in the context of the call. The calls to the other services are left
+// untouched.
+func NewServiceAuthInterceptors(s *etcdserver.EtcdServer, services []string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	names := make(map[string]struct{}, len(services))
+	for _, name := range services {
+		names[name] = struct{}{}
+	}
+	authenticate := func(ctx context.Context, fullMethod string) (context.Context, error) {
+		// fullMethod is in the "/service/method" form
+		service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+		if _, ok := names[service]; !ok {
+			return ctx, nil
+		}
+		ai, err := s.AuthInfoFromCtx(ctx)
+		if err != nil {
+			return nil, togRPCError(err)
+		}
+		if ai == nil {
+			if s.AuthStore().IsAuthEnabled() {
+				return nil, rpctypes.ErrGRPCUserEmpty
+			}
+			return ctx, nil
+		}
+		return context.WithValue(ctx, authInfoKey{}, ai), nil
+	}
+
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, authServerStream{ServerStream: ss, ctx: ctx})
+	}
+	return unary, stream
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss authServerStream) Context() context.Context { return ss.ctx }
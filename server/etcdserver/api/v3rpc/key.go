@@ -32,10 +32,13 @@ type kvServer struct {
 	// Txn.Success can have at most 128 operations,
 	// and Txn.Failure can have at most 128 operations.
 	maxTxnOps uint
+	// warningValueSize is the value size past which the responses carry
+	// the value size warning header. Zero disables the warning.
+	warningValueSize uint
 }
 
 func NewKVServer(s *etcdserver.EtcdServer) pb.KVServer {
-	return &kvServer{hdr: newHeader(s), kv: s, maxTxnOps: s.Cfg.MaxTxnOps}
+	return &kvServer{hdr: newHeader(s), kv: s, maxTxnOps: s.Cfg.MaxTxnOps, warningValueSize: s.Cfg.WarningValueSizeBytes}
 }
 
 func (s *kvServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
@@ -61,6 +64,7 @@ func (s *kvServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse,
 	if err != nil {
 		return nil, togRPCError(err)
 	}
+	warnOversizedValues(ctx, s.warningValueSize, []*pb.PutRequest{r})
 
 	s.hdr.fill(resp.Header)
 	return resp, nil
@@ -96,6 +100,7 @@ func (s *kvServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse,
 	if err != nil {
 		return nil, togRPCError(err)
 	}
+	warnTxnOversizedValues(ctx, s.warningValueSize, r, resp)
 
 	s.hdr.fill(resp.Header)
 	return resp, nil
@@ -29,6 +29,7 @@ import (
 	"go.etcd.io/etcd/api/v3/version"
 	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/etcdserver"
+	"go.etcd.io/etcd/server/v3/etcdserver/api"
 	"go.etcd.io/etcd/server/v3/etcdserver/apply"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	serverversion "go.etcd.io/etcd/server/v3/etcdserver/version"
@@ -57,6 +58,18 @@ type Downgrader interface {
 	Downgrade(ctx context.Context, dr *pb.DowngradeRequest) (*pb.DowngradeResponse, error)
 }
 
+type CompactionBarrierer interface {
+	CompactionBarrier(ctx context.Context, r *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error)
+}
+
+type ConfigReporter interface {
+	ConfigReport(ctx context.Context) (*pb.ConfigReportResponse, error)
+}
+
+type PeerCertificateReporter interface {
+	PeerCertificates(ctx context.Context) (*pb.PeerCertificatesResponse, error)
+}
+
 type LeaderTransferrer interface {
 	MoveLeader(ctx context.Context, lead, target uint64) error
 }
@@ -73,12 +86,16 @@ type maintenanceServer struct {
 	lg     *zap.Logger
 	rg     apply.RaftStatusGetter
 	hasher mvcc.HashStorage
+	kg     KVGetter
 	bg     BackendGetter
 	a      Alarmer
 	lt     LeaderTransferrer
 	hdr    header
 	cs     ClusterStatusGetter
 	d      Downgrader
+	cb     CompactionBarrierer
+	cr     ConfigReporter
+	pcr    PeerCertificateReporter
 	vs     serverversion.Server
 	cg     ConfigGetter
 
@@ -90,12 +107,16 @@ func NewMaintenanceServer(s *etcdserver.EtcdServer, healthNotifier notifier) pb.
 		lg:             s.Cfg.Logger,
 		rg:             s,
 		hasher:         s.KV().HashStorage(),
+		kg:             s,
 		bg:             s,
 		a:              s,
 		lt:             s,
 		hdr:            newHeader(s),
 		cs:             s,
 		d:              s,
+		cb:             s,
+		cr:             s,
+		pcr:            s,
 		vs:             etcdserver.NewServerVersionAdapter(s),
 		healthNotifier: healthNotifier,
 		cg:             s,
@@ -264,6 +285,7 @@ func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (
 		DbSizeQuota:      ms.cg.Config().QuotaBackendBytes,
 		DowngradeInfo:    &pb.DowngradeInfo{Enabled: false},
 	}
+	resp.CompactionProgress = compactionProgressToPB(ms.kg.KV().CompactionProgress())
 	if storageVersion := ms.vs.GetStorageVersion(); storageVersion != nil {
 		resp.StorageVersion = storageVersion.String()
 	}
@@ -303,6 +325,62 @@ func (ms *maintenanceServer) Downgrade(ctx context.Context, r *pb.DowngradeReque
 	return resp, nil
 }
 
+func (ms *maintenanceServer) CompactionBarrier(ctx context.Context, r *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error) {
+	if !api.IsCapabilityEnabled(api.CompactionBarrierCapability) {
+		return nil, rpctypes.ErrGRPCNotCapable
+	}
+	resp, err := ms.cb.CompactionBarrier(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
+func (ms *maintenanceServer) ConfigReport(ctx context.Context, r *pb.ConfigReportRequest) (*pb.ConfigReportResponse, error) {
+	resp, err := ms.cr.ConfigReport(ctx)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	resp.Header = &pb.ResponseHeader{}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) PeerCertificates(ctx context.Context, r *pb.PeerCertificatesRequest) (*pb.PeerCertificatesResponse, error) {
+	resp, err := ms.pcr.PeerCertificates(ctx)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	resp.Header = &pb.ResponseHeader{}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (ms *maintenanceServer) CompactionControl(ctx context.Context, r *pb.CompactionControlRequest) (*pb.CompactionControlResponse, error) {
+	kv := ms.kg.KV()
+	if r.Pause {
+		kv.PauseCompaction()
+	} else {
+		kv.ResumeCompaction()
+	}
+	resp := &pb.CompactionControlResponse{
+		Header:   &pb.ResponseHeader{},
+		Progress: compactionProgressToPB(kv.CompactionProgress()),
+	}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func compactionProgressToPB(p mvcc.CompactionProgress) *pb.CompactionProgress {
+	return &pb.CompactionProgress{
+		Running:            p.Running,
+		Paused:             p.Paused,
+		Revision:           p.Revision,
+		RevisionsProcessed: p.RevisionsProcessed,
+		RevisionsTotal:     p.RevisionsTotal,
+	}
+}
+
 type authMaintenanceServer struct {
 	*maintenanceServer
 	*AuthAdmin
@@ -362,3 +440,35 @@ func (ams *authMaintenanceServer) Downgrade(ctx context.Context, r *pb.Downgrade
 
 	return ams.maintenanceServer.Downgrade(ctx, r)
 }
+
+func (ams *authMaintenanceServer) CompactionBarrier(ctx context.Context, r *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.CompactionBarrier(ctx, r)
+}
+
+func (ams *authMaintenanceServer) ConfigReport(ctx context.Context, r *pb.ConfigReportRequest) (*pb.ConfigReportResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.ConfigReport(ctx, r)
+}
+
+func (ams *authMaintenanceServer) PeerCertificates(ctx context.Context, r *pb.PeerCertificatesRequest) (*pb.PeerCertificatesResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.PeerCertificates(ctx, r)
+}
+
+func (ams *authMaintenanceServer) CompactionControl(ctx context.Context, r *pb.CompactionControlRequest) (*pb.CompactionControlResponse, error) {
+	if err := ams.isPermitted(ctx); err != nil {
+		return nil, togRPCError(err)
+	}
+
+	return ams.maintenanceServer.CompactionControl(ctx, r)
+}
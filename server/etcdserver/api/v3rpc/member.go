@@ -80,6 +80,9 @@ func (cs *ClusterServer) MemberUpdate(ctx context.Context, r *pb.MemberUpdateReq
 		ID:             types.ID(r.ID),
 		RaftAttributes: membership.RaftAttributes{PeerURLs: r.PeerURLs},
 	}
+	if a := r.Attributes; a != nil {
+		m.Balancing = &membership.BalancingAttributes{Zone: a.Zone, Weight: a.Weight, Maintenance: a.Maintenance}
+	}
 	membs, err := cs.server.UpdateMember(ctx, m)
 	if err != nil {
 		return nil, togRPCError(err)
@@ -94,7 +97,11 @@ func (cs *ClusterServer) MemberList(ctx context.Context, r *pb.MemberListRequest
 		}
 	}
 	membs := membersToProtoMembers(cs.cluster.Members())
-	return &pb.MemberListResponse{Header: cs.header(), Members: membs}, nil
+	resp := &pb.MemberListResponse{Header: cs.header(), Members: membs}
+	if r.Verbose {
+		resp.Details = memberDetailsToProto(cs.server.MemberDetails(ctx))
+	}
+	return resp, nil
 }
 
 func (cs *ClusterServer) MemberPromote(ctx context.Context, r *pb.MemberPromoteRequest) (*pb.MemberPromoteResponse, error) {
@@ -109,6 +116,23 @@ func (cs *ClusterServer) header() *pb.ResponseHeader {
 	return &pb.ResponseHeader{ClusterId: uint64(cs.cluster.ID()), MemberId: uint64(cs.server.MemberID()), RaftTerm: cs.server.Term()}
 }
 
+func memberDetailsToProto(details []etcdserver.MemberDetail) []*pb.MemberDetail {
+	protoDetails := make([]*pb.MemberDetail, len(details))
+	for i, d := range details {
+		protoDetails[i] = &pb.MemberDetail{ID: uint64(d.ID)}
+		if d.Err != nil {
+			protoDetails[i].Errors = []string{d.Err.Error()}
+			continue
+		}
+		protoDetails[i].Healthy = len(d.Status.Errors) == 0
+		protoDetails[i].Version = d.Status.Version
+		protoDetails[i].DbSize = d.Status.DBSize
+		protoDetails[i].RaftAppliedIndex = d.Status.RaftAppliedIndex
+		protoDetails[i].Errors = d.Status.Errors
+	}
+	return protoDetails
+}
+
 func membersToProtoMembers(membs []*membership.Member) []*pb.Member {
 	protoMembs := make([]*pb.Member, len(membs))
 	for i := range membs {
@@ -119,6 +143,9 @@ func membersToProtoMembers(membs []*membership.Member) []*pb.Member {
 			ClientURLs: membs[i].ClientURLs,
 			IsLearner:  membs[i].IsLearner,
 		}
+		if b := membs[i].Balancing; b != nil {
+			protoMembs[i].Attributes = &pb.MemberAttributes{Zone: b.Zone, Weight: b.Weight, Maintenance: b.Maintenance}
+		}
 	}
 	return protoMembs
 }
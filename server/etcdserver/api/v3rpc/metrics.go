@@ -52,6 +52,26 @@ var (
 		},
 		[]string{"type", "client_api_version"},
 	)
+
+	requestPatternShifts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "client_request_pattern_shifts_total",
+			Help:      "The total number of shifts of the request patterns of clients detected per request fingerprint.",
+		},
+		[]string{"fingerprint"},
+	)
+
+	oversizedValues = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "oversized_values_total",
+			Help:      "The total number of values written larger than the value size warning threshold, per key prefix.",
+		},
+		[]string{"prefix"},
+	)
 )
 
 func init() {
@@ -59,4 +79,6 @@ func init() {
 	prometheus.MustRegister(receivedBytes)
 	prometheus.MustRegister(streamFailures)
 	prometheus.MustRegister(clientRequests)
+	prometheus.MustRegister(requestPatternShifts)
+	prometheus.MustRegister(oversizedValues)
 }
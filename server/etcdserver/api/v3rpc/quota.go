@@ -16,6 +16,11 @@ package v3rpc
 
 import (
 	"context"
+	"fmt"
+
+	humanize "github.com/dustin/go-humanize"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
@@ -33,12 +38,16 @@ type quotaAlarmer struct {
 	q  storage.Quota
 	a  Alarmer
 	id types.ID
+	// warningRatio is the ratio of the quota past which the responses carry
+	// the quota warning header. Zero disables the warning.
+	warningRatio float64
 }
 
 // check whether request satisfies the quota. If there is not enough space,
 // ignore request and raise the free space alarm.
 func (qa *quotaAlarmer) check(ctx context.Context, r any) error {
 	if qa.q.Available(r) {
+		qa.warn(ctx, r)
 		return nil
 	}
 	req := &pb.AlarmRequest{
@@ -47,13 +56,44 @@ func (qa *quotaAlarmer) check(ctx context.Context, r any) error {
 		Alarm:    pb.AlarmType_NOSPACE,
 	}
 	qa.a.Alarm(ctx, req)
+	if bq, ok := qa.q.(*storage.BackendQuota); ok {
+		u := bq.Usage()
+		return rpctypes.NoSpaceErrorWithDetails(rpctypes.NoSpaceDetails{
+			DBSize:      u.Size,
+			DBSizeInUse: u.SizeInUse,
+			Quota:       u.Quota,
+			RequestCost: int64(bq.Cost(r)),
+		})
+	}
 	return rpctypes.ErrGRPCNoSpace
 }
 
+// warn sets the quota warning header of the response if the request brings
+// the backend database past the warning ratio of the quota.
+func (qa *quotaAlarmer) warn(ctx context.Context, r any) {
+	bq, ok := qa.q.(*storage.BackendQuota)
+	if !ok || qa.warningRatio <= 0 {
+		return
+	}
+	cost := bq.Cost(r)
+	if cost == 0 {
+		return
+	}
+	u := bq.Usage()
+	ratio := float64(u.Size+int64(cost)) / float64(u.Quota)
+	if ratio < qa.warningRatio {
+		return
+	}
+	msg := fmt.Sprintf("database size %s is %.0f%% of the %s space quota, %s in use",
+		humanize.Bytes(uint64(u.Size)), 100*ratio, humanize.Bytes(uint64(u.Quota)), humanize.Bytes(uint64(u.SizeInUse)))
+	// the header cannot be set outside of a gRPC call, e.g. through the proxy adapters
+	_ = grpc.SetHeader(ctx, metadata.Pairs(rpctypes.MetadataQuotaWarningKey, msg))
+}
+
 func NewQuotaKVServer(s *etcdserver.EtcdServer) pb.KVServer {
 	return &quotaKVServer{
 		NewKVServer(s),
-		quotaAlarmer{newBackendQuota(s, "kv"), s, s.MemberID()},
+		newQuotaAlarmer(s, "kv"),
 	}
 }
 
@@ -86,10 +126,15 @@ func (s *quotaLeaseServer) LeaseGrant(ctx context.Context, cr *pb.LeaseGrantRequ
 func NewQuotaLeaseServer(s *etcdserver.EtcdServer) pb.LeaseServer {
 	return &quotaLeaseServer{
 		NewLeaseServer(s),
-		quotaAlarmer{newBackendQuota(s, "lease"), s, s.MemberID()},
+		newQuotaAlarmer(s, "lease"),
 	}
 }
 
-func newBackendQuota(s *etcdserver.EtcdServer, name string) storage.Quota {
-	return storage.NewBackendQuota(s.Logger(), s.Cfg.QuotaBackendBytes, s.Backend(), name)
+func newQuotaAlarmer(s *etcdserver.EtcdServer, name string) quotaAlarmer {
+	return quotaAlarmer{
+		q:            storage.NewBackendQuota(s.Logger(), s.Cfg.QuotaBackendBytes, s.Backend(), name),
+		a:            s,
+		id:           s.MemberID(),
+		warningRatio: s.Cfg.QuotaBackendWarningRatio,
+	}
 }
@@ -0,0 +1,187 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"crypto/tls"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/client/v3/credentials"
+	"go.etcd.io/etcd/server/v3/etcdserver/txn"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// recoveryModeStatusError is the error of the status of a member in recovery
+// mode, which has no leader.
+const recoveryModeStatusError = "etcdserver: member in recovery mode, not part of the cluster"
+
+// RecoveryServer returns the gRPC server of a member started in recovery mode
+// without joining the cluster. It serves the ranges of the key-value store kv
+// of the backend be, and the maintenance RPCs reading them: Hash, HashKV,
+// Status and Snapshot, which exports the backend. All the other RPCs fail with
+// rpctypes.ErrGRPCRecoveryMode.
+func RecoveryServer(lg *zap.Logger, be backend.Backend, kv mvcc.KV, memberID types.ID, tls *tls.Config, gopts ...grpc.ServerOption) *grpc.Server {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	var opts []grpc.ServerOption
+	opts = append(opts, grpc.CustomCodec(&codec{}))
+	if tls != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTransportCredential(tls)))
+	}
+	opts = append(opts, grpc.MaxSendMsgSize(maxSendBytes))
+
+	grpcServer := grpc.NewServer(append(opts, gopts...)...)
+
+	rs := newRecoveryStatus(be, memberID)
+	hdr := header{memberID: int64(memberID), sg: rs, rev: kv.Rev}
+	pb.RegisterKVServer(grpcServer, &recoveryKVServer{lg: lg, kv: kv, hdr: hdr})
+	pb.RegisterMaintenanceServer(grpcServer, &recoveryMaintenanceServer{
+		maintenanceServer: &maintenanceServer{lg: lg, rg: rs, hasher: kv.HashStorage(), bg: rs, hdr: hdr},
+	})
+
+	hsrv := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, hsrv)
+	return grpcServer
+}
+
+// recoveryStatus is the raft status of a member in recovery mode: the
+// consistent index and term of its backend, which are not modified.
+type recoveryStatus struct {
+	be       backend.Backend
+	memberID types.ID
+	index    uint64
+	term     uint64
+}
+
+func newRecoveryStatus(be backend.Backend, memberID types.ID) *recoveryStatus {
+	index, term := schema.ReadConsistentIndex(be.ReadTx())
+	return &recoveryStatus{be: be, memberID: memberID, index: index, term: term}
+}
+
+func (rs *recoveryStatus) MemberID() types.ID       { return rs.memberID }
+func (rs *recoveryStatus) Leader() types.ID         { return types.ID(0) }
+func (rs *recoveryStatus) CommittedIndex() uint64   { return rs.index }
+func (rs *recoveryStatus) AppliedIndex() uint64     { return rs.index }
+func (rs *recoveryStatus) Term() uint64             { return rs.term }
+func (rs *recoveryStatus) Backend() backend.Backend { return rs.be }
+
+// recoveryKVServer serves the ranges of the key-value store of a member in
+// recovery mode, failing the requests modifying it.
+type recoveryKVServer struct {
+	lg  *zap.Logger
+	kv  mvcc.KV
+	hdr header
+}
+
+func (s *recoveryKVServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
+	if err := checkRangeRequest(r); err != nil {
+		return nil, err
+	}
+
+	resp, _, err := txn.Range(ctx, s.lg, s.kv, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+
+	s.hdr.fill(resp.Header)
+	return resp, nil
+}
+
+func (s *recoveryKVServer) Put(context.Context, *pb.PutRequest) (*pb.PutResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (s *recoveryKVServer) DeleteRange(context.Context, *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (s *recoveryKVServer) Txn(context.Context, *pb.TxnRequest) (*pb.TxnResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (s *recoveryKVServer) Compact(context.Context, *pb.CompactionRequest) (*pb.CompactionResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+// recoveryMaintenanceServer serves the maintenance RPCs of a member in
+// recovery mode reading its backend.
+type recoveryMaintenanceServer struct {
+	*maintenanceServer
+}
+
+func (ms *recoveryMaintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (*pb.StatusResponse, error) {
+	hdr := &pb.ResponseHeader{}
+	ms.hdr.fill(hdr)
+	be := ms.bg.Backend()
+	resp := &pb.StatusResponse{
+		Header:           hdr,
+		Version:          version.Version,
+		RaftIndex:        ms.rg.CommittedIndex(),
+		RaftAppliedIndex: ms.rg.AppliedIndex(),
+		RaftTerm:         ms.rg.Term(),
+		DbSize:           be.Size(),
+		DbSizeInUse:      be.SizeInUse(),
+		DowngradeInfo:    &pb.DowngradeInfo{Enabled: false},
+		Errors:           []string{recoveryModeStatusError},
+	}
+	if storageVersion := schema.ReadStorageVersion(be.ReadTx()); storageVersion != nil {
+		resp.StorageVersion = storageVersion.String()
+	}
+	return resp, nil
+}
+
+func (ms *recoveryMaintenanceServer) Defragment(context.Context, *pb.DefragmentRequest) (*pb.DefragmentResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (ms *recoveryMaintenanceServer) Alarm(context.Context, *pb.AlarmRequest) (*pb.AlarmResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (ms *recoveryMaintenanceServer) MoveLeader(context.Context, *pb.MoveLeaderRequest) (*pb.MoveLeaderResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (ms *recoveryMaintenanceServer) Downgrade(context.Context, *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (ms *recoveryMaintenanceServer) CompactionBarrier(context.Context, *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (ms *recoveryMaintenanceServer) ConfigReport(context.Context, *pb.ConfigReportRequest) (*pb.ConfigReportResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (ms *recoveryMaintenanceServer) PeerCertificates(context.Context, *pb.PeerCertificatesRequest) (*pb.PeerCertificatesResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
+
+func (ms *recoveryMaintenanceServer) CompactionControl(context.Context, *pb.CompactionControlRequest) (*pb.CompactionControlResponse, error) {
+	return nil, rpctypes.ErrGRPCRecoveryMode
+}
@@ -33,14 +33,15 @@ import (
 )
 
 var toGRPCErrorMap = map[error]error{
-	membership.ErrIDRemoved:           rpctypes.ErrGRPCMemberNotFound,
-	membership.ErrIDNotFound:          rpctypes.ErrGRPCMemberNotFound,
-	membership.ErrIDExists:            rpctypes.ErrGRPCMemberExist,
-	membership.ErrPeerURLexists:       rpctypes.ErrGRPCPeerURLExist,
-	membership.ErrMemberNotLearner:    rpctypes.ErrGRPCMemberNotLearner,
-	membership.ErrTooManyLearners:     rpctypes.ErrGRPCTooManyLearners,
-	errors.ErrNotEnoughStartedMembers: rpctypes.ErrMemberNotEnoughStarted,
-	errors.ErrLearnerNotReady:         rpctypes.ErrGRPCLearnerNotReady,
+	membership.ErrIDRemoved:            rpctypes.ErrGRPCMemberNotFound,
+	membership.ErrIDNotFound:           rpctypes.ErrGRPCMemberNotFound,
+	membership.ErrIDExists:             rpctypes.ErrGRPCMemberExist,
+	membership.ErrPeerURLexists:        rpctypes.ErrGRPCPeerURLExist,
+	membership.ErrMemberNotLearner:     rpctypes.ErrGRPCMemberNotLearner,
+	membership.ErrTooManyLearners:      rpctypes.ErrGRPCTooManyLearners,
+	membership.ErrTooManyVotingMembers: rpctypes.ErrGRPCTooManyVotingMembers,
+	errors.ErrNotEnoughStartedMembers:  rpctypes.ErrMemberNotEnoughStarted,
+	errors.ErrLearnerNotReady:          rpctypes.ErrGRPCLearnerNotReady,
 
 	mvcc.ErrCompacted:         rpctypes.ErrGRPCCompacted,
 	mvcc.ErrFutureRev:         rpctypes.ErrGRPCFutureRev,
@@ -60,6 +61,10 @@ var toGRPCErrorMap = map[error]error{
 	errors.ErrKeyNotFound:                rpctypes.ErrGRPCKeyNotFound,
 	errors.ErrCorrupt:                    rpctypes.ErrGRPCCorrupt,
 	errors.ErrBadLeaderTransferee:        rpctypes.ErrGRPCBadLeaderTransferee,
+	errors.ErrCompactionBarrier:          rpctypes.ErrGRPCCompactionBarrier,
+	errors.ErrTooManyKeysInPrefix:        rpctypes.ErrGRPCTooManyKeysInPrefix,
+	errors.ErrInvalidValue:               rpctypes.ErrGRPCInvalidValue,
+	errors.ErrValidatorUnavailable:       rpctypes.ErrGRPCValidatorUnavailable,
 
 	errors.ErrClusterVersionUnavailable:      rpctypes.ErrGRPCClusterVersionUnavailable,
 	errors.ErrWrongDowngradeVersionFormat:    rpctypes.ErrGRPCWrongDowngradeVersionFormat,
@@ -149,3 +154,16 @@ func isRPCSupportedForLearner(req any) bool {
 		return false
 	}
 }
+
+// learner does not support stream RPC except Snapshot, and Watch if the
+// learner is configured to serve it
+func isStreamSupportedForLearner(method string, serveWatch bool) bool {
+	switch method {
+	case snapshotMethod:
+		return true
+	case watchMethod:
+		return serveWatch
+	default:
+		return false
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"fmt"
+
+	humanize "github.com/dustin/go-humanize"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+)
+
+// valueSizePrefixDepth is the number of "/" ending the prefix of a key the
+// oversized values are counted per, not counting a leading one, e.g.
+// "/registry/pods/".
+const valueSizePrefixDepth = 2
+
+// warnOversizedValues sets the value size warning header of the response,
+// and counts the values per key prefix, for the puts that wrote values
+// larger than the warning size. Zero disables the warning.
+func warnOversizedValues(ctx context.Context, warningSize uint, puts []*pb.PutRequest) {
+	if warningSize == 0 {
+		return
+	}
+	var md metadata.MD
+	for _, p := range puts {
+		if uint(len(p.Value)) <= warningSize {
+			continue
+		}
+		oversizedValues.WithLabelValues(valueSizePrefix(string(p.Key))).Inc()
+		msg := fmt.Sprintf("value of key %q is %s, larger than the %s value size warning threshold",
+			p.Key, humanize.Bytes(uint64(len(p.Value))), humanize.Bytes(uint64(warningSize)))
+		if md == nil {
+			md = metadata.MD{}
+		}
+		md.Append(rpctypes.MetadataValueSizeWarningKey, msg)
+	}
+	if md != nil {
+		// the header cannot be set outside of a gRPC call, e.g. through the proxy adapters
+		_ = grpc.SetHeader(ctx, md)
+	}
+}
+
+// valueSizePrefix returns the prefix of the key the oversized values are
+// counted per: the key up to its valueSizePrefixDepth-th "/", or up to its
+// last one if it has fewer, so that the keys without any do not each have
+// their own count.
+func valueSizePrefix(key string) string {
+	end, n := 0, 0
+	for i := 0; i < len(key) && n < valueSizePrefixDepth; i++ {
+		if key[i] != '/' {
+			continue
+		}
+		end = i + 1
+		if i > 0 {
+			n++
+		}
+	}
+	return key[:end]
+}
+
+// executedTxnPuts appends the puts of the branches of the txn, and of its
+// nested txns, executed according to its response.
+func executedTxnPuts(puts []*pb.PutRequest, r *pb.TxnRequest, resp *pb.TxnResponse) []*pb.PutRequest {
+	ops := r.Failure
+	if resp.Succeeded {
+		ops = r.Success
+	}
+	for i, op := range ops {
+		switch tv := op.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			puts = append(puts, tv.RequestPut)
+		case *pb.RequestOp_RequestTxn:
+			if i < len(resp.Responses) {
+				if nested := resp.Responses[i].GetResponseTxn(); nested != nil {
+					puts = executedTxnPuts(puts, tv.RequestTxn, nested)
+				}
+			}
+		}
+	}
+	return puts
+}
+
+// warnTxnOversizedValues warns of the oversized values written by the
+// executed branches of the txn.
+func warnTxnOversizedValues(ctx context.Context, warningSize uint, r *pb.TxnRequest, resp *pb.TxnResponse) {
+	if warningSize == 0 {
+		return
+	}
+	warnOversizedValues(ctx, warningSize, executedTxnPuts(nil, r, resp))
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+func TestValueSizePrefix(t *testing.T) {
+	for key, prefix := range map[string]string{
+		"/registry/pods/default/web-0": "/registry/pods/",
+		"/registry/pods":               "/registry/",
+		"app/config/db":                "app/config/",
+		"app/config":                   "app/",
+		"/foo":                         "/",
+		"foo":                          "",
+		"":                             "",
+	} {
+		assert.Equalf(t, prefix, valueSizePrefix(key), "key %q", key)
+	}
+}
+
+func TestExecutedTxnPuts(t *testing.T) {
+	put := func(key string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(key)}}}
+	}
+	nested := &pb.TxnRequest{Success: []*pb.RequestOp{put("nested-success")}, Failure: []*pb.RequestOp{put("nested-failure")}}
+	r := &pb.TxnRequest{
+		Success: []*pb.RequestOp{put("success"), {Request: &pb.RequestOp_RequestTxn{RequestTxn: nested}}},
+		Failure: []*pb.RequestOp{put("failure")},
+	}
+	resp := &pb.TxnResponse{
+		Succeeded: true,
+		Responses: []*pb.ResponseOp{
+			{Response: &pb.ResponseOp_ResponsePut{ResponsePut: &pb.PutResponse{}}},
+			{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: &pb.TxnResponse{Succeeded: false}}},
+		},
+	}
+
+	var keys []string
+	for _, p := range executedTxnPuts(nil, r, resp) {
+		keys = append(keys, string(p.Key))
+	}
+	assert.Equal(t, []string{"success", "nested-failure"}, keys)
+
+	keys = nil
+	for _, p := range executedTxnPuts(nil, r, &pb.TxnResponse{}) {
+		keys = append(keys, string(p.Key))
+	}
+	assert.Equal(t, []string{"failure"}, keys)
+}
@@ -136,7 +136,7 @@ type serverWatchStream struct {
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
 
-	// mu protects progress, prevKV, fragment
+	// mu protects progress, prevKV, fragment, ranges, queued, queuedCanceled
 	mu sync.RWMutex
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
@@ -145,12 +145,24 @@ type serverWatchStream struct {
 	prevKV map[mvcc.WatchID]bool
 	// records fragmented watch IDs
 	fragment map[mvcc.WatchID]bool
+	// records the key range hashes bound in the resume tokens of the watchers
+	ranges map[mvcc.WatchID]uint64
+	// queued are the create requests queued behind one waiting for the
+	// history of its resume token, answered in order by createQueued
+	queued []*pb.WatchCreateRequest
+	// queuedCanceled records the IDs of the queued watchers canceled before
+	// being created
+	queuedCanceled map[mvcc.WatchID]struct{}
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
+	// recvDone is closed once the receive loop returns.
+	recvDone chan struct{}
 
 	// wg waits for the send loop to complete
 	wg sync.WaitGroup
+	// createWg waits for createQueued to complete
+	createWg sync.WaitGroup
 }
 
 func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
@@ -174,8 +186,12 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		progress: make(map[mvcc.WatchID]bool),
 		prevKV:   make(map[mvcc.WatchID]bool),
 		fragment: make(map[mvcc.WatchID]bool),
+		ranges:   make(map[mvcc.WatchID]uint64),
 
-		closec: make(chan struct{}),
+		queuedCanceled: make(map[mvcc.WatchID]struct{}),
+
+		closec:   make(chan struct{}),
+		recvDone: make(chan struct{}),
 	}
 
 	sws.wg.Add(1)
@@ -240,6 +256,11 @@ func (sws *serverWatchStream) isWatchPermitted(wcr *pb.WatchCreateRequest) error
 }
 
 func (sws *serverWatchStream) recvLoop() error {
+	defer func() {
+		// the control stream is closed once the receive loop returns
+		close(sws.recvDone)
+		sws.createWg.Wait()
+	}()
 	for {
 		req, err := sws.gRPCStream.Recv()
 		if errors.Is(err, io.EOF) {
@@ -270,99 +291,21 @@ func (sws *serverWatchStream) recvLoop() error {
 				creq.RangeEnd = []byte{}
 			}
 
-			err := sws.isWatchPermitted(creq)
-			if err != nil {
-				var cancelReason string
-				switch {
-				case errors.Is(err, auth.ErrInvalidAuthToken):
-					cancelReason = rpctypes.ErrGRPCInvalidAuthToken.Error()
-				case errors.Is(err, auth.ErrAuthOldRevision):
-					cancelReason = rpctypes.ErrGRPCAuthOldRevision.Error()
-				case errors.Is(err, auth.ErrUserEmpty):
-					cancelReason = rpctypes.ErrGRPCUserEmpty.Error()
-				default:
-					if !errors.Is(err, auth.ErrPermissionDenied) {
-						sws.lg.Error("unexpected error code", zap.Error(err))
-					}
-					cancelReason = rpctypes.ErrGRPCPermissionDenied.Error()
-				}
-
-				wr := &pb.WatchResponse{
-					Header:       sws.newResponseHeader(sws.watchStream.Rev()),
-					WatchId:      clientv3.InvalidWatchID,
-					Canceled:     true,
-					Created:      true,
-					CancelReason: cancelReason,
-				}
-
-				select {
-				case sws.ctrlStream <- wr:
-					continue
-				case <-sws.closec:
-					return nil
-				}
-			}
-
-			filters := FiltersFromRequest(creq)
-
-			wsrev := sws.watchStream.Rev()
-			rev := creq.StartRevision
-			if rev == 0 {
-				rev = wsrev + 1
-			}
-			id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
-			if err == nil {
-				sws.mu.Lock()
-				if creq.ProgressNotify {
-					sws.progress[id] = true
-				}
-				if creq.PrevKv {
-					sws.prevKV[id] = true
-				}
-				if creq.Fragment {
-					sws.fragment[id] = true
-				}
-				sws.mu.Unlock()
-			} else {
-				id = clientv3.InvalidWatchID
-			}
-
-			wr := &pb.WatchResponse{
-				Header:   sws.newResponseHeader(wsrev),
-				WatchId:  int64(id),
-				Created:  true,
-				Canceled: err != nil,
-			}
-			if err != nil {
-				wr.CancelReason = err.Error()
+			if sws.queueCreate(creq) {
+				continue
 			}
-			select {
-			case sws.ctrlStream <- wr:
-			case <-sws.closec:
+			if !sws.createWatch(creq) {
 				return nil
 			}
 
 		case *pb.WatchRequest_CancelRequest:
 			if uv.CancelRequest != nil {
 				id := uv.CancelRequest.WatchId
-				err := sws.watchStream.Cancel(mvcc.WatchID(id))
-				if err == nil {
-					wr := &pb.WatchResponse{
-						Header:   sws.newResponseHeader(sws.watchStream.Rev()),
-						WatchId:  id,
-						Canceled: true,
-					}
-					select {
-					case sws.ctrlStream <- wr:
-					case <-sws.closec:
-						return nil
-					}
-
-					sws.mu.Lock()
-					delete(sws.progress, mvcc.WatchID(id))
-					delete(sws.prevKV, mvcc.WatchID(id))
-					delete(sws.fragment, mvcc.WatchID(id))
-					sws.mu.Unlock()
+				if sws.cancelQueued(id) {
+					continue
+				}
+				if !sws.cancelWatch(id) {
+					return nil
 				}
 			}
 		case *pb.WatchRequest_ProgressRequest:
@@ -381,6 +324,122 @@ func (sws *serverWatchStream) recvLoop() error {
 	}
 }
 
+// createWatch creates the watcher of a create request and sends the response.
+// It returns false if the stream is closed.
+func (sws *serverWatchStream) createWatch(creq *pb.WatchCreateRequest) bool {
+	err := sws.isWatchPermitted(creq)
+	if err != nil {
+		var cancelReason string
+		switch {
+		case errors.Is(err, auth.ErrInvalidAuthToken):
+			cancelReason = rpctypes.ErrGRPCInvalidAuthToken.Error()
+		case errors.Is(err, auth.ErrAuthOldRevision):
+			cancelReason = rpctypes.ErrGRPCAuthOldRevision.Error()
+		case errors.Is(err, auth.ErrUserEmpty):
+			cancelReason = rpctypes.ErrGRPCUserEmpty.Error()
+		default:
+			if !errors.Is(err, auth.ErrPermissionDenied) {
+				sws.lg.Error("unexpected error code", zap.Error(err))
+			}
+			cancelReason = rpctypes.ErrGRPCPermissionDenied.Error()
+		}
+
+		wr := &pb.WatchResponse{
+			Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+			WatchId:      clientv3.InvalidWatchID,
+			Canceled:     true,
+			Created:      true,
+			CancelReason: cancelReason,
+		}
+		return sws.sendCtrl(wr)
+	}
+
+	filters := FiltersFromRequest(creq)
+
+	rev := creq.StartRevision
+	if len(creq.ResumeToken) != 0 {
+		if rev, err = sws.resumeRevision(creq); err != nil {
+			wr := &pb.WatchResponse{
+				Header:       sws.newResponseHeader(sws.watchStream.Rev()),
+				WatchId:      clientv3.InvalidWatchID,
+				Canceled:     true,
+				Created:      true,
+				CancelReason: rpctypes.ErrorDesc(err),
+			}
+			return sws.sendCtrl(wr)
+		}
+	}
+
+	wsrev := sws.watchStream.Rev()
+	if rev == 0 {
+		rev = wsrev + 1
+	}
+	id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
+	if err == nil {
+		sws.mu.Lock()
+		sws.ranges[id] = watchRangeHash(creq.Key, creq.RangeEnd)
+		if creq.ProgressNotify {
+			sws.progress[id] = true
+		}
+		if creq.PrevKv {
+			sws.prevKV[id] = true
+		}
+		if creq.Fragment {
+			sws.fragment[id] = true
+		}
+		sws.mu.Unlock()
+	} else {
+		id = clientv3.InvalidWatchID
+	}
+
+	wr := &pb.WatchResponse{
+		Header:   sws.newResponseHeader(wsrev),
+		WatchId:  int64(id),
+		Created:  true,
+		Canceled: err != nil,
+	}
+	if err != nil {
+		wr.CancelReason = err.Error()
+	}
+	return sws.sendCtrl(wr)
+}
+
+// cancelWatch cancels a watcher and sends the response. It returns false if
+// the stream is closed.
+func (sws *serverWatchStream) cancelWatch(id int64) bool {
+	if err := sws.watchStream.Cancel(mvcc.WatchID(id)); err != nil {
+		return true
+	}
+	wr := &pb.WatchResponse{
+		Header:   sws.newResponseHeader(sws.watchStream.Rev()),
+		WatchId:  id,
+		Canceled: true,
+	}
+	if !sws.sendCtrl(wr) {
+		return false
+	}
+
+	sws.mu.Lock()
+	delete(sws.progress, mvcc.WatchID(id))
+	delete(sws.prevKV, mvcc.WatchID(id))
+	delete(sws.fragment, mvcc.WatchID(id))
+	delete(sws.ranges, mvcc.WatchID(id))
+	sws.mu.Unlock()
+	return true
+}
+
+// sendCtrl sends a control response, returning false if the stream is closed.
+func (sws *serverWatchStream) sendCtrl(wr *pb.WatchResponse) bool {
+	select {
+	case sws.ctrlStream <- wr:
+		return true
+	case <-sws.closec:
+		return false
+	case <-sws.recvDone:
+		return false
+	}
+}
+
 func (sws *serverWatchStream) sendLoop() {
 	// watch ids that are currently active
 	ids := make(map[mvcc.WatchID]struct{})
@@ -437,6 +496,17 @@ func (sws *serverWatchStream) sendLoop() {
 				CompactRevision: wresp.CompactRevision,
 				Canceled:        canceled,
 			}
+			if len(evs) == 0 && !canceled {
+				// progress notifications carry a token to resume the
+				// watchers from the revision they announce
+				var rangeHash uint64
+				if wresp.WatchID != clientv3.InvalidWatchID {
+					sws.mu.RLock()
+					rangeHash = sws.ranges[wresp.WatchID]
+					sws.mu.RUnlock()
+				}
+				wr.ResumeToken = sws.newResumeToken(wresp.Revision, rangeHash)
+			}
 
 			// Progress notifications can have WatchID -1
 			// if they announce on behalf of multiple watchers
@@ -0,0 +1,252 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"hash/fnv"
+	"time"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+const (
+	// watchResumeTokenVersion is the version of the encoding of the resume tokens.
+	watchResumeTokenVersion = 1
+	// watchResumeTokenSize is the size of an encoded resume token: its version,
+	// five 8-byte fields and a CRC-32 checksum.
+	watchResumeTokenSize = 1 + 5*8 + 4
+)
+
+var (
+	// resumeTokenWaitTimeout bounds how long the creation of a watcher waits
+	// for a member lagging behind the history of a resume token to catch up,
+	// before the history is considered not to match.
+	resumeTokenWaitTimeout = 5 * time.Second
+	// resumeTokenPollInterval is how often the member state is compared to the
+	// history of the resume token while waiting.
+	resumeTokenPollInterval = 100 * time.Millisecond
+
+	errMalformedWatchResumeToken = errors.New("malformed watch resume token")
+)
+
+// watchResumeToken is the content of the opaque resume tokens set on progress
+// notifications.
+//
+// A revision alone does not identify a point in the history of the keys: after
+// a snapshot restore the same revisions may be reused by different changes.
+// The token records the cluster ID, the raft term and the compacted revision
+// along with the revision, none of which can decrease in the history of a
+// cluster, so that watchers resumed against another history are canceled
+// instead of silently missing or repeating events.
+type watchResumeToken struct {
+	clusterID uint64
+	term      uint64
+	// revision is the revision the watcher resumes from.
+	revision int64
+	// compactRevision is the compacted revision when the token was issued.
+	compactRevision int64
+	// rangeHash identifies the key range of the watcher the token was issued
+	// for. It is 0 for the tokens issued for all the watchers of a stream.
+	rangeHash uint64
+}
+
+func (t watchResumeToken) encode() []byte {
+	b := make([]byte, watchResumeTokenSize)
+	b[0] = watchResumeTokenVersion
+	binary.BigEndian.PutUint64(b[1:], t.clusterID)
+	binary.BigEndian.PutUint64(b[9:], t.term)
+	binary.BigEndian.PutUint64(b[17:], uint64(t.revision))
+	binary.BigEndian.PutUint64(b[25:], uint64(t.compactRevision))
+	binary.BigEndian.PutUint64(b[33:], t.rangeHash)
+	binary.BigEndian.PutUint32(b[41:], crc32.ChecksumIEEE(b[:41]))
+	return b
+}
+
+func decodeWatchResumeToken(b []byte) (watchResumeToken, error) {
+	if len(b) != watchResumeTokenSize || b[0] != watchResumeTokenVersion {
+		return watchResumeToken{}, errMalformedWatchResumeToken
+	}
+	if crc32.ChecksumIEEE(b[:41]) != binary.BigEndian.Uint32(b[41:]) {
+		return watchResumeToken{}, errMalformedWatchResumeToken
+	}
+	return watchResumeToken{
+		clusterID:       binary.BigEndian.Uint64(b[1:]),
+		term:            binary.BigEndian.Uint64(b[9:]),
+		revision:        int64(binary.BigEndian.Uint64(b[17:])),
+		compactRevision: int64(binary.BigEndian.Uint64(b[25:])),
+		rangeHash:       binary.BigEndian.Uint64(b[33:]),
+	}, nil
+}
+
+// watchRangeHash returns a non-zero hash of the key range of a watcher. A nil
+// range end (a single key) and an empty one (all the keys from key) hash
+// differently.
+func watchRangeHash(key, rangeEnd []byte) uint64 {
+	h := fnv.New64a()
+	var n [binary.MaxVarintLen64]byte
+	h.Write(n[:binary.PutUvarint(n[:], uint64(len(key)))])
+	h.Write(key)
+	if rangeEnd != nil {
+		h.Write([]byte{1})
+		h.Write(rangeEnd)
+	}
+	if sum := h.Sum64(); sum != 0 {
+		return sum
+	}
+	return 1
+}
+
+// newResumeToken returns the resume token of a progress notification at rev
+// for the watchers with the given range hash.
+func (sws *serverWatchStream) newResumeToken(rev int64, rangeHash uint64) []byte {
+	return watchResumeToken{
+		clusterID:       uint64(sws.clusterID),
+		term:            sws.sg.Term(),
+		revision:        rev + 1,
+		compactRevision: sws.watchable.FirstRev(),
+		rangeHash:       rangeHash,
+	}.encode()
+}
+
+// resumeRevision validates the resume token of a watch create request and
+// returns the revision the watcher resumes from.
+func (sws *serverWatchStream) resumeRevision(creq *pb.WatchCreateRequest) (int64, error) {
+	t, err := decodeWatchResumeToken(creq.ResumeToken)
+	if err != nil {
+		return 0, rpctypes.ErrGRPCInvalidWatchResumeToken
+	}
+	if t.rangeHash != 0 && t.rangeHash != watchRangeHash(creq.Key, creq.RangeEnd) {
+		return 0, rpctypes.ErrGRPCInvalidWatchResumeToken
+	}
+	if t.clusterID != uint64(sws.clusterID) || !sws.waitResumeTokenHistory(t) {
+		return 0, rpctypes.ErrGRPCWatchResumeTokenMismatch
+	}
+	return max(t.revision, creq.StartRevision), nil
+}
+
+// resumeTokenHistoryReached reports whether the member reached the history of
+// the token.
+func (sws *serverWatchStream) resumeTokenHistoryReached(t watchResumeToken) bool {
+	return sws.sg.Term() >= t.term &&
+		sws.watchable.FirstRev() >= t.compactRevision &&
+		sws.watchStream.Rev() >= t.revision-1
+}
+
+// waitResumeTokenHistory waits for the member to reach the history of the
+// token, and reports whether it did. A member behind the token is either
+// lagging, in which case it catches up shortly, or serves a different history,
+// for instance after the cluster was restored from an older snapshot.
+func (sws *serverWatchStream) waitResumeTokenHistory(t watchResumeToken) bool {
+	reached := func() bool { return sws.resumeTokenHistoryReached(t) }
+	if reached() {
+		return true
+	}
+	timeout := time.NewTimer(resumeTokenWaitTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(resumeTokenPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if reached() {
+				return true
+			}
+		case <-timeout.C:
+			return false
+		case <-sws.closec:
+			return false
+		case <-sws.recvDone:
+			return false
+		}
+	}
+}
+
+// queueCreate queues a create request resuming from a token whose history the
+// member has not reached yet, so that createQueued waits for it off the
+// receive loop and the other requests of the stream are not held up. The
+// create requests following a queued one are queued behind it, for the
+// creations to be answered in order. It returns false if the request is to be
+// served at once.
+func (sws *serverWatchStream) queueCreate(creq *pb.WatchCreateRequest) bool {
+	behind := false
+	if len(creq.ResumeToken) != 0 {
+		// a malformed token is rejected at once
+		t, err := decodeWatchResumeToken(creq.ResumeToken)
+		behind = err == nil && !sws.resumeTokenHistoryReached(t)
+	}
+	sws.mu.Lock()
+	defer sws.mu.Unlock()
+	if !behind && len(sws.queued) == 0 {
+		return false
+	}
+	sws.queued = append(sws.queued, creq)
+	if len(sws.queued) == 1 {
+		sws.createWg.Add(1)
+		go sws.createQueued()
+	}
+	return true
+}
+
+// createQueued creates the queued watchers in order until the queue is empty,
+// canceling the ones canceled while queued once created.
+func (sws *serverWatchStream) createQueued() {
+	defer sws.createWg.Done()
+	for {
+		sws.mu.RLock()
+		creq := sws.queued[0]
+		sws.mu.RUnlock()
+		if !sws.createWatch(creq) {
+			return
+		}
+
+		id := mvcc.WatchID(creq.WatchId)
+		sws.mu.Lock()
+		sws.queued = sws.queued[1:]
+		_, canceled := sws.queuedCanceled[id]
+		delete(sws.queuedCanceled, id)
+		empty := len(sws.queued) == 0
+		sws.mu.Unlock()
+		if canceled && !sws.cancelWatch(creq.WatchId) {
+			return
+		}
+		if empty {
+			return
+		}
+	}
+}
+
+// cancelQueued records the cancel request of a queued watcher, with an ID set
+// by the client, for createQueued to cancel it once created. It returns false
+// if no queued watcher has the ID.
+func (sws *serverWatchStream) cancelQueued(id int64) bool {
+	if id == clientv3.AutoWatchID {
+		return false
+	}
+	sws.mu.Lock()
+	defer sws.mu.Unlock()
+	for _, creq := range sws.queued {
+		if creq.WatchId == id {
+			sws.queuedCanceled[mvcc.WatchID(id)] = struct{}{}
+			return true
+		}
+	}
+	return false
+}
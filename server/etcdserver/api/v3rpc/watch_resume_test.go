@@ -0,0 +1,230 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/auth"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+func TestWatchResumeTokenEncoding(t *testing.T) {
+	tok := watchResumeToken{clusterID: 1, term: 2, revision: 3, compactRevision: -1, rangeHash: watchRangeHash([]byte("foo"), nil)}
+	b := tok.encode()
+	decoded, err := decodeWatchResumeToken(b)
+	require.NoError(t, err)
+	assert.Equal(t, tok, decoded)
+
+	corrupted := append([]byte(nil), b...)
+	corrupted[17]++
+	_, err = decodeWatchResumeToken(corrupted)
+	require.ErrorIs(t, err, errMalformedWatchResumeToken)
+	_, err = decodeWatchResumeToken(b[:len(b)-1])
+	require.ErrorIs(t, err, errMalformedWatchResumeToken)
+
+	assert.NotEqual(t, watchRangeHash([]byte("foo"), nil), watchRangeHash([]byte("foo"), []byte{}))
+	assert.NotEqual(t, watchRangeHash([]byte("foo"), []byte("fop")), watchRangeHash([]byte("fo"), []byte("ofop")))
+}
+
+type fakeRaftStatus struct{ term atomic.Uint64 }
+
+func (s *fakeRaftStatus) MemberID() types.ID     { return 1 }
+func (s *fakeRaftStatus) Leader() types.ID       { return 1 }
+func (s *fakeRaftStatus) CommittedIndex() uint64 { return 0 }
+func (s *fakeRaftStatus) AppliedIndex() uint64   { return 0 }
+func (s *fakeRaftStatus) Term() uint64           { return s.term.Load() }
+
+func TestWatchResumeRevision(t *testing.T) {
+	defer func(d time.Duration) { resumeTokenWaitTimeout = d }(resumeTokenWaitTimeout)
+	resumeTokenWaitTimeout = 200 * time.Millisecond
+
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	kv := mvcc.New(zaptest.NewLogger(t), be, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer kv.Close()
+	for i := 0; i < 5; i++ {
+		kv.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	}
+	_, err := kv.Compact(traceutil.TODO(), 3)
+	require.NoError(t, err)
+
+	sg := &fakeRaftStatus{}
+	sg.term.Store(2)
+	sws := &serverWatchStream{clusterID: 1, sg: sg, watchable: kv, watchStream: kv.NewWatchStream(), closec: make(chan struct{})}
+	defer sws.watchStream.Close()
+
+	foo := watchRangeHash([]byte("foo"), nil)
+	creq := &pb.WatchCreateRequest{Key: []byte("foo"), ResumeToken: sws.newResumeToken(4, foo)}
+	rev, err := sws.resumeRevision(creq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), rev)
+
+	creq.StartRevision = 6
+	rev, err = sws.resumeRevision(creq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), rev)
+
+	creq = &pb.WatchCreateRequest{Key: []byte("foo"), ResumeToken: sws.newResumeToken(4, 0)}
+	rev, err = sws.resumeRevision(creq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), rev, "tokens issued for all the watchers of a stream apply to any range")
+
+	tests := []struct {
+		name    string
+		key     string
+		token   watchResumeToken
+		wantErr error
+	}{
+		{"other range", "bar", watchResumeToken{clusterID: 1, term: 2, revision: 5, compactRevision: 3, rangeHash: foo}, rpctypes.ErrGRPCInvalidWatchResumeToken},
+		{"other cluster", "foo", watchResumeToken{clusterID: 2, term: 2, revision: 5, compactRevision: 3, rangeHash: foo}, rpctypes.ErrGRPCWatchResumeTokenMismatch},
+		{"later term", "foo", watchResumeToken{clusterID: 1, term: 3, revision: 5, compactRevision: 3, rangeHash: foo}, rpctypes.ErrGRPCWatchResumeTokenMismatch},
+		{"later compaction", "foo", watchResumeToken{clusterID: 1, term: 2, revision: 5, compactRevision: 4, rangeHash: foo}, rpctypes.ErrGRPCWatchResumeTokenMismatch},
+		{"later revision", "foo", watchResumeToken{clusterID: 1, term: 2, revision: 10, compactRevision: 3, rangeHash: foo}, rpctypes.ErrGRPCWatchResumeTokenMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sws.resumeRevision(&pb.WatchCreateRequest{Key: []byte(tt.key), ResumeToken: tt.token.encode()})
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err = sws.resumeRevision(&pb.WatchCreateRequest{Key: []byte("foo"), ResumeToken: []byte("garbage")})
+	require.ErrorIs(t, err, rpctypes.ErrGRPCInvalidWatchResumeToken)
+
+	// a lagging member catches up with the history of the token
+	creq = &pb.WatchCreateRequest{Key: []byte("foo"), ResumeToken: watchResumeToken{clusterID: 1, term: 3, revision: 5, compactRevision: 3, rangeHash: foo}.encode()}
+	time.AfterFunc(50*time.Millisecond, func() { sg.term.Store(3) })
+	rev, err = sws.resumeRevision(creq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), rev)
+}
+
+type fakeWatchServer struct {
+	pb.Watch_WatchServer
+	reqc chan *pb.WatchRequest
+}
+
+func (s *fakeWatchServer) Context() context.Context { return context.Background() }
+
+func (s *fakeWatchServer) Recv() (*pb.WatchRequest, error) {
+	req, ok := <-s.reqc
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+type fakeAuthGetter struct{ as auth.AuthStore }
+
+func (fakeAuthGetter) AuthInfoFromCtx(context.Context) (*auth.AuthInfo, error) { return nil, nil }
+func (ag fakeAuthGetter) AuthStore() auth.AuthStore                            { return ag.as }
+
+// TestWatchResumeQueued ensures that a create request waiting for a lagging
+// member to reach the history of its resume token does not hold up the other
+// requests of the stream, and that the creations are answered in order.
+func TestWatchResumeQueued(t *testing.T) {
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	kv := mvcc.New(zaptest.NewLogger(t), be, &lease.FakeLessor{}, mvcc.StoreConfig{})
+	defer kv.Close()
+	kv.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	lg := zaptest.NewLogger(t)
+	sg := &fakeRaftStatus{}
+	sg.term.Store(2)
+	reqc := make(chan *pb.WatchRequest)
+	sws := &serverWatchStream{
+		lg:             lg,
+		clusterID:      1,
+		sg:             sg,
+		watchable:      kv,
+		ag:             fakeAuthGetter{as: auth.NewAuthStore(lg, schema.NewAuthBackend(lg, be), nil, 0)},
+		gRPCStream:     &fakeWatchServer{reqc: reqc},
+		watchStream:    kv.NewWatchStream(),
+		ctrlStream:     make(chan *pb.WatchResponse, ctrlStreamBufLen),
+		progress:       make(map[mvcc.WatchID]bool),
+		prevKV:         make(map[mvcc.WatchID]bool),
+		fragment:       make(map[mvcc.WatchID]bool),
+		ranges:         make(map[mvcc.WatchID]uint64),
+		queuedCanceled: make(map[mvcc.WatchID]struct{}),
+		closec:         make(chan struct{}),
+		recvDone:       make(chan struct{}),
+	}
+	defer sws.watchStream.Close()
+	errc := make(chan error, 1)
+	go func() { errc <- sws.recvLoop() }()
+
+	create := func(id int64, token []byte) {
+		reqc <- &pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: &pb.WatchCreateRequest{Key: []byte("foo"), WatchId: id, ResumeToken: token}}}
+	}
+	cancel := func(id int64) {
+		reqc <- &pb.WatchRequest{RequestUnion: &pb.WatchRequest_CancelRequest{CancelRequest: &pb.WatchCancelRequest{WatchId: id}}}
+	}
+	next := func() *pb.WatchResponse {
+		t.Helper()
+		select {
+		case wr := <-sws.ctrlStream:
+			return wr
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for a control response")
+			return nil
+		}
+	}
+
+	create(1, nil)
+	wr := next()
+	assert.True(t, wr.Created)
+	assert.Equal(t, int64(1), wr.WatchId)
+
+	// the member is behind the term of the token
+	create(2, watchResumeToken{clusterID: 1, term: 3, revision: 2, compactRevision: -1, rangeHash: watchRangeHash([]byte("foo"), nil)}.encode())
+	create(3, nil)
+	cancel(2)
+	cancel(1)
+	wr = next()
+	assert.True(t, wr.Canceled)
+	assert.Equal(t, int64(1), wr.WatchId, "cancel served while a creation is queued")
+
+	sg.term.Store(3)
+	wr = next()
+	assert.True(t, wr.Created)
+	assert.False(t, wr.Canceled)
+	assert.Equal(t, int64(2), wr.WatchId)
+	wr = next()
+	assert.True(t, wr.Canceled)
+	assert.Equal(t, int64(2), wr.WatchId, "watcher canceled while queued")
+	wr = next()
+	assert.True(t, wr.Created)
+	assert.Equal(t, int64(3), wr.WatchId)
+
+	close(reqc)
+	require.NoError(t, <-errc)
+}
@@ -38,6 +38,7 @@ import (
 	serverstorage "go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
 const (
@@ -82,6 +83,8 @@ type applierV3 interface {
 
 	LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.LeaseCheckpointResponse, error)
 
+	CompactionBarrier(cb *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error)
+
 	Alarm(*pb.AlarmRequest) (*pb.AlarmResponse, error)
 
 	Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error)
@@ -121,6 +124,7 @@ type ApplierOptions struct {
 	TxnModeWriteWithSharedBuffer bool
 	Backend                      backend.Backend
 	QuotaBackendBytesCfg         int64
+	MaxKeysPerPrefix             map[string]int64
 	WarningApplyDuration         time.Duration
 }
 
@@ -166,6 +170,9 @@ func (a *applierV3backend) Compaction(compaction *pb.CompactionRequest) (*pb.Com
 		traceutil.Field{Key: "revision", Value: compaction.Revision},
 	)
 
+	if barrier, ok := a.compactionBarrier(); ok && compaction.Revision > barrier {
+		return nil, nil, nil, errors.ErrCompactionBarrier
+	}
 	ch, err := a.options.KV.Compact(trace, compaction.Revision)
 	if err != nil {
 		return nil, ch, nil, err
@@ -202,6 +209,71 @@ func (a *applierV3backend) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.L
 	return &pb.LeaseCheckpointResponse{Header: a.newHeader()}, nil
 }
 
+// CompactionBarrier sets the barrier held by the given lease when a revision is
+// given, releases it otherwise, and reports the lowest barrier still in place.
+func (a *applierV3backend) CompactionBarrier(cb *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error) {
+	if cb.Revision != 0 {
+		if cb.Lease == 0 || a.options.Lessor.Lookup(lease.LeaseID(cb.Lease)) == nil {
+			return nil, lease.ErrLeaseNotFound
+		}
+		if cb.Revision < a.options.KV.FirstRev() {
+			return nil, mvcc.ErrCompacted
+		}
+		if cb.Revision > a.options.KV.Rev() {
+			return nil, mvcc.ErrFutureRev
+		}
+	}
+
+	tx := a.options.Backend.BatchTx()
+	tx.LockInsideApply()
+	switch {
+	case cb.Revision != 0:
+		schema.UnsafeCreateCompactionBarrierBucket(tx)
+		schema.UnsafePutCompactionBarrier(tx, cb.Lease, cb.Revision)
+	case cb.Lease != 0:
+		if _, ok := schema.MustUnsafeGetAllCompactionBarriers(tx)[cb.Lease]; ok {
+			schema.UnsafeDeleteCompactionBarrier(tx, cb.Lease)
+		}
+	}
+	barrier, ok := a.unsafeCompactionBarrier(tx)
+	tx.Unlock()
+
+	resp := &pb.CompactionBarrierResponse{
+		Header:          a.newHeader(),
+		CompactRevision: max(a.options.KV.FirstRev(), 0),
+	}
+	if ok {
+		resp.BarrierRevision = barrier
+	}
+	return resp, nil
+}
+
+// compactionBarrier returns the lowest revision held back by a compaction
+// barrier, if any.
+func (a *applierV3backend) compactionBarrier() (int64, bool) {
+	tx := a.options.Backend.BatchTx()
+	tx.LockInsideApply()
+	defer tx.Unlock()
+	return a.unsafeCompactionBarrier(tx)
+}
+
+// unsafeCompactionBarrier returns the lowest revision held back by a
+// compaction barrier, if any. The barriers of the leases that no longer
+// exist are removed, so that a barrier is released whenever its lease is
+// revoked or expires.
+func (a *applierV3backend) unsafeCompactionBarrier(tx backend.BatchTx) (rev int64, ok bool) {
+	for id, r := range schema.MustUnsafeGetAllCompactionBarriers(tx) {
+		if a.options.Lessor.Lookup(lease.LeaseID(id)) == nil {
+			schema.UnsafeDeleteCompactionBarrier(tx, id)
+			continue
+		}
+		if !ok || r < rev {
+			rev, ok = r, true
+		}
+	}
+	return rev, ok
+}
+
 func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error) {
 	resp := &pb.AlarmResponse{}
 
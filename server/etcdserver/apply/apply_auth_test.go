@@ -276,6 +276,11 @@ func TestAuthApplierV3_AdminPermission(t *testing.T) {
 			request:               &pb.InternalRaftRequest{LeaseCheckpoint: &pb.LeaseCheckpointRequest{}},
 			adminPermissionNeeded: false,
 		},
+		{
+			name:                  "CompactionBarrier does not need admin permission",
+			request:               &pb.InternalRaftRequest{CompactionBarrier: &pb.CompactionBarrierRequest{}},
+			adminPermissionNeeded: false,
+		},
 		{
 			name:                  "Authenticate does not need admin permission",
 			request:               &pb.InternalRaftRequest{Authenticate: &pb.InternalAuthenticateRequest{}},
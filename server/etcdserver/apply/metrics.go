@@ -26,6 +26,17 @@ var alarms = prometheus.NewGaugeVec(
 	[]string{"server_id", "alarm_type"},
 )
 
+var prefixKeyLimitRejections = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "prefix_key_limit_rejections_total",
+		Help:      "The total number of requests rejected for creating keys past the maximum number of keys of a prefix.",
+	},
+	[]string{"prefix"},
+)
+
 func init() {
 	prometheus.MustRegister(alarms)
+	prometheus.MustRegister(prefixKeyLimitRejections)
 }
@@ -0,0 +1,121 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/etcdserver/txn"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// prefixLimitApplierV3 rejects the puts and txns creating keys under a prefix
+// of MaxKeysPerPrefix past its limit. Only the keys that do not exist yet count
+// against the limits, which are checked against the numbers of keys the store
+// keeps for the prefixes as the requests are applied. The members must
+// therefore all be configured with the same limits.
+type prefixLimitApplierV3 struct {
+	applierV3
+	lg     *zap.Logger
+	kv     mvcc.KV
+	limits map[string]int64
+}
+
+func newPrefixLimitApplierV3(lg *zap.Logger, kv mvcc.KV, limits map[string]int64, app applierV3) applierV3 {
+	if len(limits) == 0 {
+		return app
+	}
+	return &prefixLimitApplierV3{app, lg, kv, limits}
+}
+
+func (a *prefixLimitApplierV3) Put(p *pb.PutRequest) (*pb.PutResponse, *traceutil.Trace, error) {
+	rv := a.kv.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+	err := a.checkPuts(rv, []*pb.PutRequest{p})
+	rv.End()
+	if err != nil {
+		return nil, nil, err
+	}
+	return a.applierV3.Put(p)
+}
+
+// Txn checks the limits against the puts of the branches the compares of the
+// txn, and of its nested txns, select.
+func (a *prefixLimitApplierV3) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, *traceutil.Trace, error) {
+	rv := a.kv.Read(mvcc.ConcurrentReadTxMode, traceutil.TODO())
+	err := a.checkPuts(rv, txn.ExecutedPuts(rv, rt))
+	rv.End()
+	if err != nil {
+		return nil, nil, err
+	}
+	return a.applierV3.Txn(rt)
+}
+
+// checkPuts returns ErrTooManyKeysInPrefix if the puts would create keys under
+// a prefix past its limit.
+func (a *prefixLimitApplierV3) checkPuts(rv mvcc.ReadView, puts []*pb.PutRequest) error {
+	if len(puts) == 0 {
+		return nil
+	}
+
+	created := make(map[string]int64)
+	seen := make(map[string]struct{}, len(puts))
+	for _, p := range puts {
+		if _, ok := seen[string(p.Key)]; ok {
+			continue
+		}
+		seen[string(p.Key)] = struct{}{}
+		var prefixes []string
+		for prefix := range a.limits {
+			if strings.HasPrefix(string(p.Key), prefix) {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		if len(prefixes) == 0 {
+			continue
+		}
+		rr, err := rv.Range(context.TODO(), p.Key, nil, mvcc.RangeOptions{Count: true})
+		if err != nil {
+			return err
+		}
+		if rr.Count > 0 {
+			continue
+		}
+		for _, prefix := range prefixes {
+			created[prefix]++
+		}
+	}
+
+	for prefix, n := range created {
+		count, _ := a.kv.PrefixKeyCount(prefix)
+		if limit := a.limits[prefix]; count+n > limit {
+			prefixKeyLimitRejections.WithLabelValues(prefix).Inc()
+			a.lg.Warn(
+				"rejected request creating keys past the maximum number of keys of a prefix",
+				zap.String("prefix", prefix),
+				zap.Int64("max-keys", limit),
+				zap.Int64("keys", count),
+				zap.Int64("new-keys", n),
+			)
+			return errors.ErrTooManyKeysInPrefix
+		}
+	}
+	return nil
+}
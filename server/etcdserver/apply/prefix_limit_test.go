@@ -0,0 +1,76 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+)
+
+// TestPrefixLimitApplier tests that the puts and txns creating keys past the
+// limit of a prefix are rejected when applied, against the number of keys
+// created by the requests applied before them.
+func TestPrefixLimitApplier(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	kv := mvcc.NewStore(lg, be, &lease.FakeLessor{}, mvcc.StoreConfig{CountedKeyPrefixes: []string{"/limited/"}})
+	defer kv.Close()
+	opts := ApplierOptions{Logger: lg, KV: kv, Lessor: &lease.FakeLessor{}}
+	a := newPrefixLimitApplierV3(lg, kv, map[string]int64{"/limited/": 2}, newApplierV3Backend(opts))
+
+	put := func(key string) *pb.PutRequest { return &pb.PutRequest{Key: []byte(key), Value: []byte("v")} }
+	putOp := func(key string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: put(key)}}
+	}
+
+	_, _, err := a.Put(put("/limited/a"))
+	require.NoError(t, err)
+	// requests proposed concurrently are checked one after the other
+	_, _, err = a.Txn(&pb.TxnRequest{Success: []*pb.RequestOp{putOp("/limited/b"), putOp("/other")}})
+	require.NoError(t, err)
+	_, _, err = a.Put(put("/limited/c"))
+	require.ErrorIs(t, err, errors.ErrTooManyKeysInPrefix)
+	_, _, err = a.Txn(&pb.TxnRequest{Failure: []*pb.RequestOp{putOp("/limited/c")}})
+	require.NoError(t, err, "the branch creating the key does not execute")
+
+	// only the branches the compares select count, in the nested txns too
+	exists := &pb.Compare{Key: []byte("/limited/a"), Target: pb.Compare_VERSION, Result: pb.Compare_GREATER, TargetUnion: &pb.Compare_Version{Version: 0}}
+	missing := &pb.Compare{Key: []byte("/limited/c"), Target: pb.Compare_VERSION, Result: pb.Compare_GREATER, TargetUnion: &pb.Compare_Version{Version: 0}}
+	_, _, err = a.Txn(&pb.TxnRequest{Compare: []*pb.Compare{missing}, Success: []*pb.RequestOp{putOp("/limited/c")}, Failure: []*pb.RequestOp{putOp("/limited/a")}})
+	require.NoError(t, err)
+	_, _, err = a.Txn(&pb.TxnRequest{Compare: []*pb.Compare{missing}, Failure: []*pb.RequestOp{putOp("/limited/c")}})
+	require.ErrorIs(t, err, errors.ErrTooManyKeysInPrefix)
+	nested := &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{Compare: []*pb.Compare{exists}, Success: []*pb.RequestOp{putOp("/limited/a")}, Failure: []*pb.RequestOp{putOp("/limited/c")}}}}
+	_, _, err = a.Txn(&pb.TxnRequest{Success: []*pb.RequestOp{nested}})
+	require.NoError(t, err)
+
+	// updating an existing key is allowed
+	_, _, err = a.Put(put("/limited/a"))
+	require.NoError(t, err)
+
+	_, _, err = a.DeleteRange(&pb.DeleteRangeRequest{Key: []byte("/limited/a")})
+	require.NoError(t, err)
+	_, _, err = a.Put(put("/limited/c"))
+	require.NoError(t, err)
+}
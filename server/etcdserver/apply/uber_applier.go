@@ -62,7 +62,8 @@ func newApplierV3(opts ApplierOptions) applierV3 {
 	applierBackend := newApplierV3Backend(opts)
 	return newAuthApplierV3(
 		opts.AuthStore,
-		newQuotaApplierV3(opts.Logger, opts.QuotaBackendBytesCfg, opts.Backend, applierBackend),
+		newPrefixLimitApplierV3(opts.Logger, opts.KV, opts.MaxKeysPerPrefix,
+			newQuotaApplierV3(opts.Logger, opts.QuotaBackendBytesCfg, opts.Backend, applierBackend)),
 		opts.Lessor,
 	)
 }
@@ -81,7 +82,7 @@ func (a *uberApplier) restoreAlarms() {
 
 func (a *uberApplier) Apply(r *pb.InternalRaftRequest, shouldApplyV3 membership.ShouldApplyV3) *Result {
 	// We first execute chain of Apply() calls down the hierarchy:
-	// (i.e. CorruptApplier -> CappedApplier -> Auth -> Quota -> Backend),
+	// (i.e. CorruptApplier -> CappedApplier -> Auth -> PrefixLimit -> Quota -> Backend),
 	// then dispatch() unpacks the request to a specific method (like Put),
 	// that gets executed down the hierarchy again:
 	// i.e. CorruptApplier.Put(CappedApplier.Put(...(BackendApplier.Put(...)))).
@@ -152,6 +153,9 @@ func (a *uberApplier) dispatch(r *pb.InternalRaftRequest, shouldApplyV3 membersh
 	case r.LeaseCheckpoint != nil:
 		op = "LeaseCheckpoint"
 		ar.Resp, ar.Err = a.applyV3.LeaseCheckpoint(r.LeaseCheckpoint)
+	case r.CompactionBarrier != nil:
+		op = "CompactionBarrier"
+		ar.Resp, ar.Err = a.applyV3.CompactionBarrier(r.CompactionBarrier)
 	case r.Alarm != nil:
 		op = "Alarm"
 		ar.Resp, ar.Err = a.Alarm(r.Alarm)
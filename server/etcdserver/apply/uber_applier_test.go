@@ -279,3 +279,65 @@ func TestUberApplier_Alarm_Deactivate(t *testing.T) {
 	require.NotNil(t, result)
 	assert.NoError(t, result.Err)
 }
+
+// TestUberApplier_CompactionBarrier tests the compactions past a compaction
+// barrier are rejected until the barrier is released or its lease is revoked.
+func TestUberApplier_CompactionBarrier(t *testing.T) {
+	ua := defaultUberApplier(t)
+	for i := 0; i < 5; i++ {
+		result := ua.Apply(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte(key)}}, membership.ApplyBoth)
+		require.NoError(t, result.Err)
+	}
+	for _, id := range []int64{1, 2} {
+		result := ua.Apply(&pb.InternalRaftRequest{LeaseGrant: &pb.LeaseGrantRequest{ID: id, TTL: 60}}, membership.ApplyBoth)
+		require.NoError(t, result.Err)
+	}
+
+	barrier := func(rev, leaseID int64) *Result {
+		return ua.Apply(&pb.InternalRaftRequest{CompactionBarrier: &pb.CompactionBarrierRequest{Revision: rev, Lease: leaseID}}, membership.ApplyBoth)
+	}
+	compact := func(rev int64) error {
+		result := ua.Apply(&pb.InternalRaftRequest{Compaction: &pb.CompactionRequest{Revision: rev}}, membership.ApplyBoth)
+		if result.Physc != nil {
+			<-result.Physc
+		}
+		return result.Err
+	}
+
+	require.Equal(t, lease.ErrLeaseNotFound, barrier(3, 0).Err)
+	require.Equal(t, lease.ErrLeaseNotFound, barrier(3, 3).Err)
+	require.Equal(t, mvcc.ErrFutureRev, barrier(100, 1).Err)
+
+	result := barrier(3, 1)
+	require.NoError(t, result.Err)
+	assert.Equal(t, int64(0), result.Resp.(*pb.CompactionBarrierResponse).CompactRevision)
+	assert.Equal(t, int64(3), result.Resp.(*pb.CompactionBarrierResponse).BarrierRevision)
+
+	result = barrier(5, 2)
+	require.NoError(t, result.Err)
+	assert.Equal(t, int64(3), result.Resp.(*pb.CompactionBarrierResponse).BarrierRevision)
+
+	require.Equal(t, errors.ErrCompactionBarrier, compact(4))
+	require.NoError(t, compact(3))
+	require.Equal(t, mvcc.ErrCompacted, barrier(2, 1).Err)
+
+	result = barrier(0, 0)
+	require.NoError(t, result.Err)
+	assert.Equal(t, int64(3), result.Resp.(*pb.CompactionBarrierResponse).CompactRevision)
+	assert.Equal(t, int64(3), result.Resp.(*pb.CompactionBarrierResponse).BarrierRevision)
+
+	// Releasing the barrier of lease 1 leaves the one of lease 2 in place.
+	result = barrier(0, 1)
+	require.NoError(t, result.Err)
+	assert.Equal(t, int64(5), result.Resp.(*pb.CompactionBarrierResponse).BarrierRevision)
+	require.Equal(t, errors.ErrCompactionBarrier, compact(6))
+	require.NoError(t, compact(5))
+
+	// Revoking lease 2 releases its barrier.
+	result = ua.Apply(&pb.InternalRaftRequest{LeaseRevoke: &pb.LeaseRevokeRequest{ID: 2}}, membership.ApplyBoth)
+	require.NoError(t, result.Err)
+	require.NoError(t, compact(6))
+	result = barrier(0, 0)
+	require.NoError(t, result.Err)
+	assert.Equal(t, int64(0), result.Resp.(*pb.CompactionBarrierResponse).BarrierRevision)
+}
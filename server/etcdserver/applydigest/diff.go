@@ -0,0 +1,119 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applydigest
+
+import "slices"
+
+// Divergence is the first entry the logs of the members disagree on.
+type Divergence struct {
+	Index uint64 `json:"index"`
+	// Records are the records of the logs at Index, in the order of the
+	// logs, nil for the logs missing it.
+	Records []*Record `json:"records"`
+	// Reason is the first difference found between the records, checked in
+	// order: missing if a log misses the record, request if the entries
+	// differ, response if their apply responses differ and revision if the
+	// revisions they resulted in differ.
+	Reason string `json:"reason"`
+}
+
+// DiffResult is the result of comparing the logs of several members.
+type DiffResult struct {
+	// First and Last are the first and last indexes recorded by all the
+	// logs. Only the records in this range are compared, as the logs keep
+	// the digests of the last entries applied by each member.
+	First uint64 `json:"first"`
+	Last  uint64 `json:"last"`
+	// Compared is the number of indexes compared.
+	Compared int `json:"compared"`
+	// Divergence is the first entry the logs disagree on, or nil if they
+	// agree on all the compared entries.
+	Divergence *Divergence `json:"divergence,omitempty"`
+}
+
+// Diff compares the records, sorted by index, of the logs of several members
+// over the range of indexes recorded by all of them. Compared is zero if the
+// logs do not overlap.
+func Diff(logs ...[]Record) DiffResult {
+	var result DiffResult
+	for i, records := range logs {
+		if len(records) == 0 {
+			return DiffResult{}
+		}
+		first, last := records[0].Index, records[len(records)-1].Index
+		if i == 0 || first > result.First {
+			result.First = first
+		}
+		if i == 0 || last < result.Last {
+			result.Last = last
+		}
+	}
+	if result.First > result.Last {
+		return DiffResult{}
+	}
+
+	// the records of the logs in the range, by index
+	byIndex := make([]map[uint64]*Record, len(logs))
+	var indexes []uint64
+	for i, records := range logs {
+		byIndex[i] = make(map[uint64]*Record)
+		for j := range records {
+			r := &records[j]
+			if r.Index < result.First || r.Index > result.Last {
+				continue
+			}
+			indexes = append(indexes, r.Index)
+			byIndex[i][r.Index] = r
+		}
+	}
+	slices.Sort(indexes)
+	indexes = slices.Compact(indexes)
+
+	for _, index := range indexes {
+		result.Compared++
+		records := make([]*Record, len(logs))
+		for i := range logs {
+			records[i] = byIndex[i][index]
+		}
+		if reason := diffRecords(records); reason != "" {
+			result.Divergence = &Divergence{Index: index, Records: records, Reason: reason}
+			return result
+		}
+	}
+	return result
+}
+
+func diffRecords(records []*Record) string {
+	for _, r := range records {
+		if r == nil {
+			return "missing"
+		}
+	}
+	for _, check := range []struct {
+		reason string
+		differ func(a, b *Record) bool
+	}{
+		{"request", func(a, b *Record) bool { return a.RequestHash != b.RequestHash }},
+		{"response", func(a, b *Record) bool { return a.ResponseHash != b.ResponseHash }},
+		{"revision", func(a, b *Record) bool { return a.Revision != b.Revision }},
+	} {
+		for _, r := range records[1:] {
+			if check.differ(records[0], r) {
+				return check.reason
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,228 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applydigest
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/gogo/protobuf/proto"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+)
+
+const (
+	// DefaultEntries is the default number of digests kept by a log.
+	DefaultEntries = 100000
+
+	magic      = "etcdapd1"
+	headerSize = 16
+	recordSize = 32
+)
+
+var ErrInvalidLog = errors.New("applydigest: invalid apply digest log")
+
+// Record is the digest of an applied entry.
+type Record struct {
+	Index uint64 `json:"index"`
+	// RequestHash is the hash of the entry data.
+	RequestHash uint64 `json:"requestHash"`
+	// ResponseHash is the hash of the response and error of the apply. It is
+	// zero for the requests without side effects, like ranges, whose response
+	// is only computed by the member serving them.
+	ResponseHash uint64 `json:"responseHash"`
+	// Revision is the revision of the key-value store once the entry is
+	// applied.
+	Revision int64 `json:"revision"`
+}
+
+// RequestHash returns the hash of the data of an entry.
+func RequestHash(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	return h.Sum64()
+}
+
+// ResponseHash returns the hash of the response and error of an apply. The
+// range responses of transactions are left out, as only the member serving a
+// transaction computes them.
+func ResponseHash(resp proto.Message, err error) uint64 {
+	h := fnv.New64a()
+	if txn, ok := resp.(*pb.TxnResponse); ok {
+		resp = withoutRanges(txn)
+	}
+	if resp != nil {
+		if b, merr := proto.Marshal(resp); merr == nil {
+			h.Write(b)
+		}
+	}
+	if err != nil {
+		h.Write([]byte(err.Error()))
+	}
+	return h.Sum64()
+}
+
+// withoutRanges returns a copy of the transaction response with empty range
+// responses.
+func withoutRanges(txn *pb.TxnResponse) *pb.TxnResponse {
+	if txn == nil {
+		return nil
+	}
+	c := &pb.TxnResponse{Header: txn.Header, Succeeded: txn.Succeeded, Responses: make([]*pb.ResponseOp, len(txn.Responses))}
+	for i, op := range txn.Responses {
+		switch r := op.Response.(type) {
+		case *pb.ResponseOp_ResponseRange:
+			c.Responses[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseRange{ResponseRange: &pb.RangeResponse{}}}
+		case *pb.ResponseOp_ResponseTxn:
+			c.Responses[i] = &pb.ResponseOp{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: withoutRanges(r.ResponseTxn)}}
+		default:
+			c.Responses[i] = op
+		}
+	}
+	return c
+}
+
+// Log is a ring file of apply digests. It is not safe for concurrent use.
+type Log struct {
+	f       *os.File
+	entries uint64
+	buf     [recordSize]byte
+}
+
+// Open opens the log of the given file, creating it with the given number of
+// slots if it does not exist. An existing log of a different number of slots
+// is recreated.
+func Open(path string, entries int) (*Log, error) {
+	if entries <= 0 {
+		return nil, fmt.Errorf("applydigest: invalid number of entries %d", entries)
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, fileutil.PrivateFileMode)
+	if err != nil {
+		return nil, err
+	}
+	l := &Log{f: f, entries: uint64(entries)}
+	if n, rerr := readHeader(f); rerr == nil && n == l.entries {
+		return l, nil
+	}
+	if err = l.reset(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// reset truncates the file to an empty log.
+func (l *Log) reset() error {
+	if err := l.f.Truncate(0); err != nil {
+		return err
+	}
+	var hdr [headerSize]byte
+	copy(hdr[:], magic)
+	binary.BigEndian.PutUint64(hdr[8:], l.entries)
+	if _, err := l.f.WriteAt(hdr[:], 0); err != nil {
+		return err
+	}
+	return l.f.Truncate(headerSize + int64(l.entries)*recordSize)
+}
+
+func readHeader(r io.ReaderAt) (uint64, error) {
+	var hdr [headerSize]byte
+	if _, err := r.ReadAt(hdr[:], 0); err != nil {
+		return 0, err
+	}
+	if string(hdr[:8]) != magic {
+		return 0, ErrInvalidLog
+	}
+	n := binary.BigEndian.Uint64(hdr[8:])
+	if n == 0 {
+		return 0, ErrInvalidLog
+	}
+	return n, nil
+}
+
+// Append writes the record at the slot of its index. The record is not synced
+// to disk.
+func (l *Log) Append(r Record) error {
+	binary.BigEndian.PutUint64(l.buf[0:], r.Index)
+	binary.BigEndian.PutUint64(l.buf[8:], r.RequestHash)
+	binary.BigEndian.PutUint64(l.buf[16:], r.ResponseHash)
+	binary.BigEndian.PutUint64(l.buf[24:], uint64(r.Revision))
+	_, err := l.f.WriteAt(l.buf[:], headerSize+int64(r.Index%l.entries)*recordSize)
+	return err
+}
+
+// DiscardAfter clears the records of the entries after the given index, e.g.
+// the records left by the entries of a previous cluster, as the entries after
+// the applied index of a member are applied again when it restarts.
+func (l *Log) DiscardAfter(index uint64) error {
+	b := make([]byte, l.entries*recordSize)
+	if _, err := l.f.ReadAt(b, headerSize); err != nil {
+		return err
+	}
+	var empty [recordSize]byte
+	for slot := int64(0); len(b) > 0; slot, b = slot+1, b[recordSize:] {
+		if binary.BigEndian.Uint64(b) > index {
+			if _, err := l.f.WriteAt(empty[:], headerSize+slot*recordSize); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close closes the file of the log.
+func (l *Log) Close() error { return l.f.Close() }
+
+// ReadFile reads the records of the log of the given file, sorted by index.
+func ReadFile(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	n, err := readHeader(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	b, err := io.ReadAll(io.NewSectionReader(f, headerSize, int64(n)*recordSize))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(b)) != n*recordSize {
+		return nil, fmt.Errorf("%s: %w: truncated", path, ErrInvalidLog)
+	}
+	var records []Record
+	for ; len(b) > 0; b = b[recordSize:] {
+		r := Record{
+			Index:        binary.BigEndian.Uint64(b[0:]),
+			RequestHash:  binary.BigEndian.Uint64(b[8:]),
+			ResponseHash: binary.BigEndian.Uint64(b[16:]),
+			Revision:     int64(binary.BigEndian.Uint64(b[24:])),
+		}
+		// the empty slots hold index zero, which no entry has
+		if r.Index != 0 {
+			records = append(records, r)
+		}
+	}
+	slices.SortFunc(records, func(a, b Record) int { return cmp.Compare(a.Index, b.Index) })
+	return records, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applydigest
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func TestLog(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "apply-digest")
+	l, err := Open(p, 4)
+	require.NoError(t, err)
+	for i := uint64(1); i <= 6; i++ {
+		require.NoError(t, l.Append(Record{Index: i, RequestHash: i * 10, ResponseHash: i * 100, Revision: int64(i)}))
+	}
+	require.NoError(t, l.Close())
+
+	// the ring keeps the last 4 entries
+	want := []Record{
+		{Index: 3, RequestHash: 30, ResponseHash: 300, Revision: 3},
+		{Index: 4, RequestHash: 40, ResponseHash: 400, Revision: 4},
+		{Index: 5, RequestHash: 50, ResponseHash: 500, Revision: 5},
+		{Index: 6, RequestHash: 60, ResponseHash: 600, Revision: 6},
+	}
+	records, err := ReadFile(p)
+	require.NoError(t, err)
+	assert.Equal(t, want, records)
+
+	// reopening keeps the records, except the discarded ones
+	l, err = Open(p, 4)
+	require.NoError(t, err)
+	require.NoError(t, l.DiscardAfter(4))
+	require.NoError(t, l.Close())
+	records, err = ReadFile(p)
+	require.NoError(t, err)
+	assert.Equal(t, want[:2], records)
+
+	// reopening with a different size resets the log
+	l, err = Open(p, 8)
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+	records, err = ReadFile(p)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	require.NoError(t, os.WriteFile(p, []byte("not a digest log"), 0o600))
+	_, err = ReadFile(p)
+	require.ErrorIs(t, err, ErrInvalidLog)
+}
+
+func TestResponseHash(t *testing.T) {
+	put := &pb.PutResponse{Header: &pb.ResponseHeader{Revision: 2}}
+	assert.Equal(t, ResponseHash(put, nil), ResponseHash(&pb.PutResponse{Header: &pb.ResponseHeader{Revision: 2}}, nil))
+	assert.NotEqual(t, ResponseHash(put, nil), ResponseHash(&pb.PutResponse{Header: &pb.ResponseHeader{Revision: 3}}, nil))
+	assert.NotEqual(t, ResponseHash(put, nil), ResponseHash(put, errors.New("failed")))
+
+	// only the member serving a transaction computes its ranges
+	txn := func(kvs []*mvccpb.KeyValue) *pb.TxnResponse {
+		return &pb.TxnResponse{Succeeded: true, Responses: []*pb.ResponseOp{
+			{Response: &pb.ResponseOp_ResponsePut{ResponsePut: put}},
+			{Response: &pb.ResponseOp_ResponseTxn{ResponseTxn: &pb.TxnResponse{Responses: []*pb.ResponseOp{
+				{Response: &pb.ResponseOp_ResponseRange{ResponseRange: &pb.RangeResponse{Kvs: kvs, Count: int64(len(kvs))}}},
+			}}}},
+		}}
+	}
+	served := txn([]*mvccpb.KeyValue{{Key: []byte("foo")}})
+	assert.Equal(t, ResponseHash(txn(nil), nil), ResponseHash(served, nil))
+	assert.Len(t, served.Responses[1].GetResponseTxn().Responses[0].GetResponseRange().Kvs, 1, "the response must not be modified")
+}
+
+func TestDiff(t *testing.T) {
+	records := func(first, last uint64, change func(*Record)) []Record {
+		var rs []Record
+		for i := first; i <= last; i++ {
+			r := Record{Index: i, RequestHash: i, ResponseHash: i, Revision: int64(i)}
+			if change != nil {
+				change(&r)
+			}
+			rs = append(rs, r)
+		}
+		return rs
+	}
+	tcs := []struct {
+		name   string
+		logs   [][]Record
+		first  uint64
+		last   uint64
+		want   int
+		reason string
+		index  uint64
+	}{
+		{name: "identical", logs: [][]Record{records(1, 10, nil), records(1, 10, nil)}, first: 1, last: 10, want: 10},
+		{name: "overlapping", logs: [][]Record{records(1, 10, nil), records(5, 20, nil), records(3, 8, nil)}, first: 5, last: 8, want: 4},
+		{name: "disjoint", logs: [][]Record{records(1, 4, nil), records(5, 8, nil)}},
+		{
+			name:  "response",
+			logs:  [][]Record{records(1, 10, nil), records(1, 10, func(r *Record) { r.ResponseHash += r.Index / 7 })},
+			first: 1, last: 10, want: 7, reason: "response", index: 7,
+		},
+		{
+			name: "request before revision",
+			logs: [][]Record{records(1, 10, nil), records(1, 10, func(r *Record) {
+				if r.Index >= 4 {
+					r.RequestHash++
+					r.Revision++
+				}
+			})},
+			first: 1, last: 10, want: 4, reason: "request", index: 4,
+		},
+		{
+			name:  "missing",
+			logs:  [][]Record{records(1, 10, nil), append(records(1, 2, nil), records(4, 10, nil)...)},
+			first: 1, last: 10, want: 3, reason: "missing", index: 3,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Diff(tc.logs...)
+			assert.Equal(t, tc.first, result.First)
+			assert.Equal(t, tc.last, result.Last)
+			assert.Equal(t, tc.want, result.Compared)
+			if tc.reason == "" {
+				assert.Nil(t, result.Divergence)
+				return
+			}
+			require.NotNil(t, result.Divergence)
+			assert.Equal(t, tc.reason, result.Divergence.Reason)
+			assert.Equal(t, tc.index, result.Divergence.Index)
+			assert.Len(t, result.Divergence.Records, len(tc.logs))
+		})
+	}
+}
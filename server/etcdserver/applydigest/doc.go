@@ -0,0 +1,24 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package applydigest records a compact digest of every entry applied by a
+// member into a ring file, so that the digests of the members can be compared
+// to find the first entry their state machines diverged at.
+//
+// The digest of an entry holds its index, a hash of the request, a hash of the
+// response of the apply and the revision of the key-value store once it is
+// applied. The records are written at the slot of their index modulo the
+// number of slots of the file, so the file keeps the digests of the last
+// entries applied and the digests of different members line up by index.
+package applydigest
@@ -33,6 +33,7 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+	"go.etcd.io/etcd/server/v3/etcdserver/webhook"
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
@@ -437,6 +438,8 @@ func (s *EtcdServer) triggerCorruptAlarm(id types.ID) {
 		Action:   pb.AlarmRequest_ACTIVATE,
 		Alarm:    pb.AlarmType_CORRUPT,
 	}
+	s.notify(webhook.EventTypeWarning, webhook.ReasonCorruptionDetected,
+		fmt.Sprintf("member %s detected a data inconsistency of member %s", s.MemberID(), id))
 	s.GoAttach(func() {
 		s.raftRequest(s.ctx, pb.InternalRaftRequest{Alarm: a})
 	})
@@ -0,0 +1,312 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	errorspkg "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/schedule"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+)
+
+// PeerDefragPath is the peer endpoint the leader running the scheduled
+// defragmentation reads the backend sizes of a member from (GET), and
+// defragments its backend through (POST).
+const PeerDefragPath = "/members/defrag"
+
+// defragRequestTimeout bounds the defragmentation of a member requested by the
+// leader, which takes a while for large backends.
+const defragRequestTimeout = 30 * time.Minute
+
+var (
+	errDefragInProgress = errorspkg.New("etcdserver: scheduled defragmentation in progress")
+	errDefragDisabled   = errorspkg.New("etcdserver: scheduled defragmentation disabled")
+)
+
+// DefragStatus is the size of the backend of a member.
+type DefragStatus struct {
+	DBSize      int64 `json:"db_size"`
+	DBSizeInUse int64 `json:"db_size_in_use"`
+}
+
+// FreeRatio returns the ratio of the backend size the defragmentation frees.
+func (st DefragStatus) FreeRatio() float64 {
+	if st.DBSize <= 0 {
+		return 0
+	}
+	return float64(st.DBSize-st.DBSizeInUse) / float64(st.DBSize)
+}
+
+func (s *EtcdServer) defragStatus() DefragStatus {
+	return DefragStatus{DBSize: s.be.Size(), DBSizeInUse: s.be.SizeInUse()}
+}
+
+// scheduledDefrag defragments the backend for the defragmentation scheduler,
+// unless it is already doing so.
+func (s *EtcdServer) scheduledDefrag() (DefragStatus, error) {
+	if !atomic.CompareAndSwapInt32(&s.defragging, 0, 1) {
+		return DefragStatus{}, errDefragInProgress
+	}
+	defer atomic.StoreInt32(&s.defragging, 0)
+
+	lg := s.Logger()
+	lg.Info("starting scheduled defragment")
+	if err := s.be.Defrag(); err != nil {
+		lg.Warn("failed to defragment", zap.Error(err))
+		return DefragStatus{}, err
+	}
+	st := s.defragStatus()
+	lg.Info("finished scheduled defragment", zap.String("db-size", humanize.Bytes(uint64(st.DBSize))))
+	return st, nil
+}
+
+// monitorDefragSchedule defragments the members of the cluster at the times of
+// the DefragSchedule cron expression, when this member is the leader.
+func (s *EtcdServer) monitorDefragSchedule() {
+	if s.Cfg.DefragSchedule == "" {
+		return
+	}
+	lg := s.Logger()
+	cron, err := schedule.ParseCron(s.Cfg.DefragSchedule)
+	if err != nil {
+		lg.Warn("invalid defrag schedule; scheduled defragmentation is disabled", zap.Error(err))
+		return
+	}
+	lg.Info(
+		"enabled scheduled defragmentation",
+		zap.String("local-member-id", s.MemberID().String()),
+		zap.String("schedule", cron.String()),
+		zap.Float64("free-ratio-threshold", s.Cfg.DefragFreeRatioThreshold),
+	)
+	for {
+		next := cron.Next(time.Now())
+		if next.IsZero() {
+			lg.Warn("defrag schedule never matches; scheduled defragmentation is disabled", zap.String("schedule", cron.String()))
+			return
+		}
+		select {
+		case <-time.After(time.Until(next)):
+		case <-s.stopping:
+			lg.Info("server has stopped; stopping defrag scheduler")
+			return
+		}
+		if !s.isLeader() {
+			continue
+		}
+		s.runScheduledDefrag()
+	}
+}
+
+// runScheduledDefrag defragments, one after the other, the members whose free
+// ratio of their backend exceeds DefragFreeRatioThreshold. The leader is
+// defragmented last, and the run stops if this member loses the leadership, so
+// that at most one member defragments at a time.
+func (s *EtcdServer) runScheduledDefrag() {
+	lg := s.Logger()
+	lg.Info("starting scheduled defragmentation of the cluster", zap.String("local-member-id", s.MemberID().String()))
+
+	cc := &http.Client{
+		Transport: s.peerRt,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	for _, m := range s.cluster.Members() {
+		if m.ID == s.MemberID() {
+			continue
+		}
+		if !s.isLeader() {
+			lg.Info("lost leadership; stopping scheduled defragmentation of the cluster")
+			return
+		}
+		s.defragPeer(cc, m)
+	}
+	if !s.isLeader() {
+		lg.Info("lost leadership; stopping scheduled defragmentation of the cluster")
+		return
+	}
+	st := s.defragStatus()
+	if !s.shouldDefrag(s.MemberID(), st) {
+		return
+	}
+	if _, err := s.scheduledDefrag(); err != nil {
+		scheduledDefrags.WithLabelValues("failed").Inc()
+		return
+	}
+	scheduledDefrags.WithLabelValues("defragmented").Inc()
+}
+
+// shouldDefrag returns if the backend of the member with status st frees
+// enough space to be defragmented.
+func (s *EtcdServer) shouldDefrag(id types.ID, st DefragStatus) bool {
+	if st.FreeRatio() > s.Cfg.DefragFreeRatioThreshold {
+		return true
+	}
+	s.Logger().Info(
+		"skipping scheduled defragment",
+		zap.String("member-id", id.String()),
+		zap.Int64("current-db-size-bytes", st.DBSize),
+		zap.Int64("current-db-size-in-use-bytes", st.DBSizeInUse),
+		zap.Float64("free-ratio", st.FreeRatio()),
+		zap.Float64("free-ratio-threshold", s.Cfg.DefragFreeRatioThreshold),
+	)
+	scheduledDefrags.WithLabelValues("skipped").Inc()
+	return false
+}
+
+// defragPeer defragments the member m if its backend frees enough space,
+// through the first of its peer URLs answering.
+func (s *EtcdServer) defragPeer(cc *http.Client, m *membership.Member) {
+	lg := s.Logger()
+	if !m.IsStarted() {
+		return
+	}
+	for _, ep := range m.PeerURLs {
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		st, err := DefragStatusHTTP(ctx, s.cluster.ID(), cc, ep)
+		cancel()
+		if err != nil {
+			lg.Warn("failed to get defrag status", zap.String("remote-peer-endpoint", ep), zap.Error(err))
+			continue
+		}
+		if !s.shouldDefrag(m.ID, *st) {
+			return
+		}
+
+		lg.Info("requesting scheduled defragment", zap.String("member-id", m.ID.String()), zap.String("remote-peer-endpoint", ep))
+		ctx, cancel = context.WithTimeout(s.ctx, defragRequestTimeout)
+		st, err = DefragHTTP(ctx, s.cluster.ID(), cc, ep)
+		cancel()
+		if err != nil {
+			lg.Warn("failed scheduled defragment", zap.String("member-id", m.ID.String()), zap.String("remote-peer-endpoint", ep), zap.Error(err))
+			scheduledDefrags.WithLabelValues("failed").Inc()
+			return
+		}
+		lg.Info("member finished scheduled defragment", zap.String("member-id", m.ID.String()), zap.String("db-size", humanize.Bytes(uint64(st.DBSize))))
+		scheduledDefrags.WithLabelValues("defragmented").Inc()
+		return
+	}
+	scheduledDefrags.WithLabelValues("failed").Inc()
+}
+
+type defragHandler struct {
+	lg     *zap.Logger
+	server *EtcdServer
+}
+
+func (s *EtcdServer) DefragHandler() http.Handler {
+	return &defragHandler{lg: s.Logger(), server: s}
+}
+
+func (h *defragHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPost}, ","))
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path != PeerDefragPath {
+		http.Error(w, "bad path", http.StatusBadRequest)
+		return
+	}
+	if gcid := r.Header.Get("X-Etcd-Cluster-ID"); gcid != h.server.cluster.ID().String() {
+		http.Error(w, rafthttp.ErrClusterIDMismatch.Error(), http.StatusPreconditionFailed)
+		return
+	}
+	// only the members with the scheduled defragmentation enabled can be
+	// defragmented by the leader
+	if r.Method == http.MethodPost && h.server.Cfg.DefragSchedule == "" {
+		http.Error(w, errDefragDisabled.Error(), http.StatusForbidden)
+		return
+	}
+
+	st := h.server.defragStatus()
+	if r.Method == http.MethodPost {
+		var err error
+		if st, err = h.server.scheduledDefrag(); err != nil {
+			code := http.StatusInternalServerError
+			if errorspkg.Is(err, errDefragInProgress) {
+				code = http.StatusConflict
+			}
+			http.Error(w, err.Error(), code)
+			return
+		}
+	}
+	w.Header().Set("X-Etcd-Cluster-ID", h.server.Cluster().ID().String())
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(st); err != nil {
+		h.lg.Warn("failed to encode defrag status", zap.Error(err))
+	}
+}
+
+// DefragStatusHTTP fetches the backend size of the member serving the peer url.
+func DefragStatusHTTP(ctx context.Context, cid types.ID, cc *http.Client, url string) (*DefragStatus, error) {
+	return defragRequest(ctx, http.MethodGet, cid, cc, url)
+}
+
+// DefragHTTP defragments the backend of the member serving the peer url, and
+// returns its new size.
+func DefragHTTP(ctx context.Context, cid types.ID, cc *http.Client, url string) (*DefragStatus, error) {
+	return defragRequest(ctx, http.MethodPost, cid, cc, url)
+}
+
+func defragRequest(ctx context.Context, method string, cid types.ID, cc *http.Client, url string) (*DefragStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url+PeerDefragPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Etcd-Cluster-ID", cid.String())
+
+	resp, err := cc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusConflict:
+		return nil, errDefragInProgress
+	case http.StatusForbidden:
+		return nil, errDefragDisabled
+	case http.StatusPreconditionFailed:
+		if strings.Contains(string(b), rafthttp.ErrClusterIDMismatch.Error()) {
+			return nil, rafthttp.ErrClusterIDMismatch
+		}
+		fallthrough
+	default:
+		return nil, fmt.Errorf("unknown error: %s", strings.TrimSpace(string(b)))
+	}
+	st := &DefragStatus{}
+	if err := json.Unmarshal(b, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/config"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+)
+
+const testDefragClusterID = types.ID(0xcafe)
+
+// fakeDefragBackend records the defragmentations in defrags.
+type fakeDefragBackend struct {
+	backend.Backend
+	id          types.ID
+	size, inUse int64
+
+	mu      *sync.Mutex
+	defrags *[]types.ID
+}
+
+func (b *fakeDefragBackend) Size() int64      { return b.size }
+func (b *fakeDefragBackend) SizeInUse() int64 { return b.inUse }
+
+func (b *fakeDefragBackend) Defrag() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	*b.defrags = append(*b.defrags, b.id)
+	b.size = b.inUse
+	return nil
+}
+
+func newDefragTestServer(t *testing.T, id types.ID, members []*membership.Member, be *fakeDefragBackend) *EtcdServer {
+	tbe, _ := betesting.NewDefaultTmpBackend(t)
+	t.Cleanup(func() { betesting.Close(t, tbe) })
+	cl := newTestClusterWithBackend(t, members, tbe)
+	cl.SetID(id, testDefragClusterID)
+	return &EtcdServer{
+		lgMu:     new(sync.RWMutex),
+		lg:       zaptest.NewLogger(t),
+		memberID: id,
+		cluster:  cl,
+		be:       be,
+		ctx:      context.Background(),
+		peerRt:   http.DefaultTransport,
+		Cfg:      config.ServerConfig{TickMs: 1, ElectionTicks: 1, DefragSchedule: "0 3 * * *", DefragFreeRatioThreshold: 0.5},
+	}
+}
+
+func TestDefragFreeRatio(t *testing.T) {
+	assert.InDelta(t, 0.75, DefragStatus{DBSize: 100, DBSizeInUse: 25}.FreeRatio(), 1e-9)
+	assert.Zero(t, DefragStatus{}.FreeRatio())
+}
+
+func TestDefragHandler(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		defrags []types.ID
+	)
+	be := &fakeDefragBackend{id: 1, size: 100, inUse: 40, mu: &mu, defrags: &defrags}
+	s := newDefragTestServer(t, 1, nil, be)
+	srv := httptest.NewServer(s.DefragHandler())
+	defer srv.Close()
+	cc := &http.Client{}
+
+	st, err := DefragStatusHTTP(context.Background(), testDefragClusterID, cc, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, DefragStatus{DBSize: 100, DBSizeInUse: 40}, *st)
+	assert.Empty(t, defrags)
+
+	st, err = DefragHTTP(context.Background(), testDefragClusterID, cc, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, DefragStatus{DBSize: 40, DBSizeInUse: 40}, *st)
+	assert.Equal(t, []types.ID{1}, defrags)
+
+	_, err = DefragHTTP(context.Background(), types.ID(0xbeef), cc, srv.URL)
+	require.ErrorIs(t, err, rafthttp.ErrClusterIDMismatch)
+	_, err = DefragStatusHTTP(context.Background(), types.ID(0xbeef), cc, srv.URL)
+	require.ErrorIs(t, err, rafthttp.ErrClusterIDMismatch)
+
+	// the requests without the cluster ID are rejected
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		req, err := http.NewRequest(method, srv.URL+PeerDefragPath, nil)
+		require.NoError(t, err)
+		resp, err := cc.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equalf(t, http.StatusPreconditionFailed, resp.StatusCode, "%s without cluster ID", method)
+	}
+	assert.Equal(t, []types.ID{1}, defrags)
+
+	s.defragging = 1
+	_, err = DefragHTTP(context.Background(), testDefragClusterID, cc, srv.URL)
+	require.ErrorIs(t, err, errDefragInProgress)
+
+	resp, err := http.Post(srv.URL+"/members/other", "", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestDefragHandlerScheduleDisabled tests that the members without a defrag
+// schedule report their status, but cannot be defragmented.
+func TestDefragHandlerScheduleDisabled(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		defrags []types.ID
+	)
+	be := &fakeDefragBackend{id: 1, size: 100, inUse: 40, mu: &mu, defrags: &defrags}
+	s := newDefragTestServer(t, 1, nil, be)
+	s.Cfg.DefragSchedule = ""
+	srv := httptest.NewServer(s.DefragHandler())
+	defer srv.Close()
+	cc := &http.Client{}
+
+	st, err := DefragStatusHTTP(context.Background(), testDefragClusterID, cc, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, DefragStatus{DBSize: 100, DBSizeInUse: 40}, *st)
+
+	_, err = DefragHTTP(context.Background(), testDefragClusterID, cc, srv.URL)
+	require.ErrorIs(t, err, errDefragDisabled)
+	assert.Empty(t, defrags)
+}
+
+func TestRunScheduledDefrag(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		defrags []types.ID
+	)
+	// the leader and member 2 free enough space, member 3 does not
+	backends := map[types.ID]*fakeDefragBackend{
+		1: {id: 1, size: 100, inUse: 10, mu: &mu, defrags: &defrags},
+		2: {id: 2, size: 100, inUse: 20, mu: &mu, defrags: &defrags},
+		3: {id: 3, size: 100, inUse: 90, mu: &mu, defrags: &defrags},
+		4: {id: 4, size: 100, inUse: 10, mu: &mu, defrags: &defrags},
+	}
+	var members []*membership.Member
+	for _, id := range []types.ID{1, 2, 3, 4} {
+		m := &membership.Member{ID: id, Attributes: membership.Attributes{Name: id.String()}}
+		if id == 4 {
+			// not started yet
+			m.Name = ""
+		}
+		members = append(members, m)
+	}
+	for _, m := range members[1:] {
+		srv := httptest.NewServer(newDefragTestServer(t, m.ID, nil, backends[m.ID]).DefragHandler())
+		t.Cleanup(srv.Close)
+		m.PeerURLs = []string{srv.URL}
+	}
+
+	leader := newDefragTestServer(t, 1, members, backends[1])
+	leader.lead = 1
+	leader.runScheduledDefrag()
+	assert.Equal(t, []types.ID{2, 1}, defrags)
+
+	// a follower does not defragment itself
+	defrags = nil
+	backends[2].size = 100
+	leader.lead = 2
+	leader.runScheduledDefrag()
+	assert.Empty(t, defrags)
+}
@@ -42,6 +42,10 @@ var (
 	ErrClusterVersionUnavailable   = errors.New("etcdserver: cluster version not found during downgrade")
 	ErrWrongDowngradeVersionFormat = errors.New("etcdserver: wrong downgrade target version format")
 	ErrKeyNotFound                 = errors.New("etcdserver: key not found")
+	ErrCompactionBarrier           = errors.New("etcdserver: compaction blocked by a compaction barrier")
+	ErrTooManyKeysInPrefix         = errors.New("etcdserver: too many keys under prefix")
+	ErrInvalidValue                = errors.New("etcdserver: value rejected by the validator of its prefix")
+	ErrValidatorUnavailable        = errors.New("etcdserver: validator of the prefix unavailable")
 )
 
 type DiscoveryError struct {
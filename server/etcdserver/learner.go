@@ -0,0 +1,55 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"time"
+)
+
+// learnerStalenessCheckInterval is the interval the staleness of a learner
+// serving Watch requests is measured at.
+var learnerStalenessCheckInterval = time.Second
+
+// monitorLearnerStaleness measures, while the member is a learner serving
+// Watch requests, how far its applied state lags behind the entries it knows
+// committed, as the watchers it serves see the changes only once applied.
+// The lag behind the leader of a learner partitioned from it is not known;
+// etcd_server_has_leader reports it.
+func (s *EtcdServer) monitorLearnerStaleness() {
+	if !s.Cfg.LearnerServeWatch {
+		return
+	}
+	caughtUp := time.Now()
+	for {
+		select {
+		case <-time.After(learnerStalenessCheckInterval):
+		case <-s.stopping:
+			return
+		}
+		now := time.Now()
+		if !s.IsLearner() {
+			caughtUp = now
+			learnerApplyLag.Set(0)
+			learnerStaleness.Set(0)
+			continue
+		}
+		committed, applied := s.getCommittedIndex(), s.getAppliedIndex()
+		if applied >= committed {
+			caughtUp = now
+		}
+		learnerApplyLag.Set(float64(committed - min(applied, committed)))
+		learnerStaleness.Set(now.Sub(caughtUp).Seconds())
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"encoding/json"
+	errorspkg "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/raft/v3"
+)
+
+// PeerMemberStatusPath is the peer endpoint the member serving a verbose
+// MemberList reads the status of the other members from.
+const PeerMemberStatusPath = "/members/status"
+
+var errMemberNotStarted = errorspkg.New("etcdserver: member not started")
+
+// MemberStatus is the status of a member reported to the other members.
+type MemberStatus struct {
+	Version          string `json:"version"`
+	DBSize           int64  `json:"db_size"`
+	RaftAppliedIndex uint64 `json:"raft_applied_index"`
+	// Errors are the errors of the member, like the alarms raised on it.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// MemberDetail is the status of a member, or the error fetching it.
+type MemberDetail struct {
+	ID     types.ID
+	Status *MemberStatus
+	Err    error
+}
+
+func (s *EtcdServer) memberStatus() MemberStatus {
+	st := MemberStatus{
+		Version:          version.Version,
+		DBSize:           s.be.Size(),
+		RaftAppliedIndex: s.getAppliedIndex(),
+	}
+	if s.getLead() == raft.None {
+		st.Errors = append(st.Errors, errors.ErrNoLeader.Error())
+	}
+	for _, a := range s.Alarms() {
+		if types.ID(a.MemberID) == s.MemberID() {
+			st.Errors = append(st.Errors, a.String())
+		}
+	}
+	return st
+}
+
+// MemberDetails returns the details of the members, in the order of
+// Cluster().Members(). The statuses of the other members are fetched
+// concurrently through their peer URLs, each bounded by the request timeout.
+func (s *EtcdServer) MemberDetails(ctx context.Context) []MemberDetail {
+	cc := &http.Client{
+		Transport: s.peerRt,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	members := s.cluster.Members()
+	details := make([]MemberDetail, len(members))
+	var wg sync.WaitGroup
+	for i, m := range members {
+		details[i].ID = m.ID
+		if m.ID == s.MemberID() {
+			st := s.memberStatus()
+			details[i].Status = &st
+			continue
+		}
+		wg.Add(1)
+		go func(d *MemberDetail, m *membership.Member) {
+			defer wg.Done()
+			d.Status, d.Err = s.fetchMemberStatus(ctx, cc, m)
+		}(&details[i], m)
+	}
+	wg.Wait()
+	return details
+}
+
+// fetchMemberStatus fetches the status of the member m through the first of
+// its peer URLs answering.
+func (s *EtcdServer) fetchMemberStatus(ctx context.Context, cc *http.Client, m *membership.Member) (*MemberStatus, error) {
+	if !m.IsStarted() {
+		return nil, errMemberNotStarted
+	}
+	var lastErr error
+	for _, ep := range m.PeerURLs {
+		ctx, cancel := context.WithTimeout(ctx, s.Cfg.ReqTimeout())
+		st, err := MemberStatusHTTP(ctx, s.cluster.ID(), cc, ep)
+		cancel()
+		if err == nil {
+			return st, nil
+		}
+		s.Logger().Warn(
+			"failed to get member status",
+			zap.String("member-id", m.ID.String()),
+			zap.String("remote-peer-endpoint", ep),
+			zap.Error(err),
+		)
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+type memberStatusHandler struct {
+	lg     *zap.Logger
+	server *EtcdServer
+}
+
+func (s *EtcdServer) MemberStatusHandler() http.Handler {
+	return &memberStatusHandler{lg: s.Logger(), server: s}
+}
+
+func (h *memberStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path != PeerMemberStatusPath {
+		http.Error(w, "bad path", http.StatusBadRequest)
+		return
+	}
+	if gcid := r.Header.Get("X-Etcd-Cluster-ID"); gcid != "" && gcid != h.server.cluster.ID().String() {
+		http.Error(w, rafthttp.ErrClusterIDMismatch.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	w.Header().Set("X-Etcd-Cluster-ID", h.server.Cluster().ID().String())
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(h.server.memberStatus()); err != nil {
+		h.lg.Warn("failed to encode member status", zap.Error(err))
+	}
+}
+
+// MemberStatusHTTP fetches the status of the member serving the peer url.
+func MemberStatusHTTP(ctx context.Context, cid types.ID, cc *http.Client, url string) (*MemberStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+PeerMemberStatusPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Etcd-Cluster-ID", cid.String())
+
+	resp, err := cc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusPreconditionFailed:
+		if strings.Contains(string(b), rafthttp.ErrClusterIDMismatch.Error()) {
+			return nil, rafthttp.ErrClusterIDMismatch
+		}
+		fallthrough
+	default:
+		return nil, fmt.Errorf("unknown error: %s", strings.TrimSpace(string(b)))
+	}
+	st := &MemberStatus{}
+	if err := json.Unmarshal(b, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
@@ -53,6 +53,42 @@ var (
 		},
 		[]string{"Reason"},
 	)
+	scheduledDefrags = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "scheduled_defrags_total",
+			Help:      "The total number of members defragmented, skipped or failed to defragment by the scheduled defragmentation while this member is leader.",
+		},
+		[]string{"result"},
+	)
+	valueValidationRejections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "value_validation_rejections_total",
+			Help:      "The total number of requests rejected by the validators of the values of the prefixes, as the value is invalid or the validator unavailable.",
+		},
+		[]string{"prefix", "reason"},
+	)
+	learnerApplyLag = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "learner_apply_lag_entries",
+		Help:      "The number of entries committed but not yet applied while this member is a learner serving Watch requests.",
+	})
+	learnerStaleness = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "learner_staleness_seconds",
+		Help:      "The time since this member, a learner serving Watch requests, last applied all the entries it knows committed.",
+	})
+	peerCertificateExpiry = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "peer_certificate_expiry_timestamp_seconds",
+		Help:      "The expiry time, in seconds since the Unix epoch, of the peer TLS certificate of this member.",
+	})
 	learnerPromoteSucceed = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: "etcd",
 		Subsystem: "server",
@@ -65,6 +101,31 @@ var (
 		Name:      "heartbeat_send_failures_total",
 		Help:      "The total number of leader heartbeat send failures (likely overloaded from slow disk).",
 	})
+	raftTickDelaySec = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "raft_tick_delay_seconds",
+		Help:      "The delays of the raft loop to receive its ticks.",
+		// lowest bucket start of upper bound 0.001 sec (1 ms) with factor 2
+		// highest bucket start of 0.001 sec * 2^12 == 4.096 sec
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 13),
+	})
+	schedulerDelaySec = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd",
+		Subsystem: "server",
+		Name:      "scheduler_delay_seconds",
+		Help:      "The delays of the Go scheduler to wake up a goroutine sleeping for a heartbeat interval.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 13),
+	})
+	raftTickStarvations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd",
+			Subsystem: "server",
+			Name:      "raft_tick_starvations_total",
+			Help:      "The total number of ticks or scheduler probes delayed by more than a heartbeat interval, by cause: the Go scheduler or the raft loop.",
+		},
+		[]string{"cause"},
+	)
 	applySnapshotInProgress = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: "etcd",
 		Subsystem: "server",
@@ -166,6 +227,9 @@ func init() {
 	prometheus.MustRegister(isLeader)
 	prometheus.MustRegister(leaderChanges)
 	prometheus.MustRegister(heartbeatSendFailures)
+	prometheus.MustRegister(raftTickDelaySec)
+	prometheus.MustRegister(schedulerDelaySec)
+	prometheus.MustRegister(raftTickStarvations)
 	prometheus.MustRegister(applySnapshotInProgress)
 	prometheus.MustRegister(proposalsCommitted)
 	prometheus.MustRegister(proposalsApplied)
@@ -180,6 +244,11 @@ func init() {
 	prometheus.MustRegister(serverFeatureEnabled)
 	prometheus.MustRegister(learnerPromoteSucceed)
 	prometheus.MustRegister(learnerPromoteFailed)
+	prometheus.MustRegister(scheduledDefrags)
+	prometheus.MustRegister(valueValidationRejections)
+	prometheus.MustRegister(learnerApplyLag)
+	prometheus.MustRegister(learnerStaleness)
+	prometheus.MustRegister(peerCertificateExpiry)
 	prometheus.MustRegister(fdUsed)
 	prometheus.MustRegister(fdLimit)
 
@@ -0,0 +1,112 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/rafthttp"
+)
+
+// peerCertificateCheckInterval is the interval the peer certificate file of
+// the member is checked for rotation at.
+var peerCertificateCheckInterval = 10 * time.Second
+
+// monitorPeerCertificate tracks the expiry of the peer certificate of the
+// member and, once the certificate file is rotated, reconnects the peers so
+// that the raft streams established with the previous certificate use the
+// new one. New connections use it as soon as it is rotated, since the
+// certificate is loaded on each TLS handshake.
+func (s *EtcdServer) monitorPeerCertificate() {
+	certFile, keyFile := s.Cfg.PeerTLSInfo.CertFile, s.Cfg.PeerTLSInfo.KeyFile
+	if certFile == "" {
+		return
+	}
+	lg := s.Logger()
+	var last *x509.Certificate
+	for {
+		cert, err := loadCertificate(certFile, keyFile)
+		switch {
+		case err != nil:
+			// the certificate and the key may be in the middle of a rotation
+			lg.Warn("failed to load peer certificate", zap.String("cert-file", certFile), zap.Error(err))
+		case last != nil && !bytes.Equal(cert.Raw, last.Raw):
+			lg.Info(
+				"peer certificate rotated, reconnecting peers",
+				zap.String("subject", cert.Subject.String()),
+				zap.Time("not-after", cert.NotAfter),
+			)
+			if tr, ok := s.r.transport.(*rafthttp.Transport); ok {
+				// the streams of a peer are established again well before
+				// the ones of the next peer are closed
+				tr.ReconnectPeers(s.stopping, s.Cfg.ElectionTimeout())
+			}
+			fallthrough
+		default:
+			peerCertificateExpiry.Set(float64(cert.NotAfter.Unix()))
+			last = cert
+		}
+
+		select {
+		case <-time.After(peerCertificateCheckInterval):
+		case <-s.stopping:
+			return
+		}
+	}
+}
+
+func loadCertificate(certFile, keyFile string) (*x509.Certificate, error) {
+	c, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(c.Certificate[0])
+}
+
+// PeerCertificates reports the peer certificate of the member and the
+// certificates its peers present on the raft streams.
+func (s *EtcdServer) PeerCertificates(ctx context.Context) (*pb.PeerCertificatesResponse, error) {
+	resp := &pb.PeerCertificatesResponse{}
+	if certFile := s.Cfg.PeerTLSInfo.CertFile; certFile != "" {
+		cert, err := loadCertificate(certFile, s.Cfg.PeerTLSInfo.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		resp.Local = &pb.PeerCertificate{
+			MemberId: uint64(s.MemberID()),
+			Subject:  cert.Subject.String(),
+			NotAfter: cert.NotAfter.Unix(),
+		}
+	}
+	if tr, ok := s.r.transport.(*rafthttp.Transport); ok {
+		for _, c := range tr.PeerCertificates() {
+			resp.Peers = append(resp.Peers, &pb.PeerCertificate{
+				MemberId: uint64(c.PeerID),
+				Stream:   c.Stream,
+				Inbound:  c.Inbound,
+				Subject:  c.Subject,
+				NotAfter: c.NotAfter.Unix(),
+			})
+		}
+	}
+	return resp, nil
+}
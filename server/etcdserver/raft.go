@@ -98,6 +98,8 @@ type raftNode struct {
 	ticker *time.Ticker
 	// contention detectors for raft heartbeat message
 	td *contention.TimeoutDetector
+	// starvation detects the raft loop falling behind its ticks
+	starvation *starvation
 
 	stopped chan struct{}
 	done    chan struct{}
@@ -140,6 +142,7 @@ func newRaftNode(cfg raftNodeConfig) *raftNode {
 		// set up contention detectors for raft heartbeat message.
 		// expect to send a heartbeat within 2 heartbeat intervals.
 		td:         contention.NewTimeoutDetector(2 * cfg.heartbeat),
+		starvation: newStarvation(cfg.lg, cfg.heartbeat),
 		readStateC: make(chan raft.ReadState, 1),
 		msgSnapC:   make(chan raftpb.Message, maxInFlightMsgSnap),
 		applyc:     make(chan toApply),
@@ -173,14 +176,24 @@ func (r *raftNode) getLatestTickTs() time.Time {
 func (r *raftNode) start(rh *raftReadyHandler) {
 	internalTimeout := time.Second
 
+	if r.heartbeat != 0 {
+		go r.starvation.probe(r.done)
+	}
+
 	go func() {
 		defer r.onStop()
 		islead := false
+		// the first tick may have been sent before the raft loop started
+		ticked := false
 
 		for {
 			select {
-			case <-r.ticker.C:
+			case ts := <-r.ticker.C:
 				r.tick()
+				if ticked {
+					r.starvation.observeTick(ts, time.Now())
+				}
+				ticked = true
 			case rd := <-r.Ready():
 				if rd.SoftState != nil {
 					newLeader := rd.SoftState.Lead != raft.None && rh.getLead() != rd.SoftState.Lead
@@ -390,6 +403,7 @@ func (r *raftNode) processMessages(ms []raftpb.Message) []raftpb.Message {
 					zap.Duration("heartbeat-interval", r.heartbeat),
 					zap.Duration("expected-duration", 2*r.heartbeat),
 					zap.Duration("exceeded-duration", exceed),
+					zap.String("starvation-cause", r.starvation.cause()),
 				)
 				heartbeatSendFailures.Inc()
 			}
@@ -60,9 +60,11 @@ import (
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3alarm"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.etcd.io/etcd/server/v3/etcdserver/apply"
+	"go.etcd.io/etcd/server/v3/etcdserver/applydigest"
 	"go.etcd.io/etcd/server/v3/etcdserver/cindex"
 	"go.etcd.io/etcd/server/v3/etcdserver/errors"
 	serverversion "go.etcd.io/etcd/server/v3/etcdserver/version"
+	"go.etcd.io/etcd/server/v3/etcdserver/webhook"
 	"go.etcd.io/etcd/server/v3/features"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/lease/leasehttp"
@@ -208,11 +210,13 @@ type Server interface {
 // EtcdServer is the production implementation of the Server interface
 type EtcdServer struct {
 	// inflightSnapshots holds count the number of snapshots currently inflight.
-	inflightSnapshots int64  // must use atomic operations to access; keep 64-bit aligned.
-	appliedIndex      uint64 // must use atomic operations to access; keep 64-bit aligned.
-	committedIndex    uint64 // must use atomic operations to access; keep 64-bit aligned.
-	term              uint64 // must use atomic operations to access; keep 64-bit aligned.
-	lead              uint64 // must use atomic operations to access; keep 64-bit aligned.
+	inflightSnapshots int64 // must use atomic operations to access; keep 64-bit aligned.
+	// sendingSnapshots holds count the number of snapshots currently being transmitted.
+	sendingSnapshots int64  // must use atomic operations to access; keep 64-bit aligned.
+	appliedIndex     uint64 // must use atomic operations to access; keep 64-bit aligned.
+	committedIndex   uint64 // must use atomic operations to access; keep 64-bit aligned.
+	term             uint64 // must use atomic operations to access; keep 64-bit aligned.
+	lead             uint64 // must use atomic operations to access; keep 64-bit aligned.
 
 	consistIndex cindex.ConsistentIndexer // consistIndex is used to get/set/save consistentIndex
 	r            raftNode                 // uses 64-bit atomics; keep 64-bit aligned.
@@ -252,6 +256,12 @@ type EtcdServer struct {
 	snapshotter *snap.Snapshotter
 
 	uberApply apply.UberApplier
+	// applyDigest records the digests of the applied entries if
+	// Cfg.ApplyDigestLog is set. It is only used by the apply loop.
+	applyDigest *applydigest.Log
+	// webhook posts the events on the critical conditions of the member if
+	// Cfg.WebhookURLs is set.
+	webhook *webhook.Sender
 
 	applyWait wait.WaitTime
 
@@ -269,6 +279,10 @@ type EtcdServer struct {
 	// compactor is used to auto-compact the KV.
 	compactor v3compactor.Compactor
 
+	// defragging is 1 while the defragmentation scheduler defragments the
+	// backend; must use atomic operations to access.
+	defragging int32
+
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
 	reqIDGen *idutil.Generator
@@ -287,6 +301,9 @@ type EtcdServer struct {
 	leadTimeMu      sync.RWMutex
 	leadElectedTime time.Time
 
+	// leaderContacts bounds the staleness of the applied data of a follower.
+	leaderContacts leaderContacts
+
 	firstCommitInTerm     *notify.Notifier
 	clusterVersionChanged *notify.Notifier
 
@@ -371,11 +388,19 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	mvccStoreConfig := mvcc.StoreConfig{
 		CompactionBatchLimit:    cfg.CompactionBatchLimit,
 		CompactionSleepInterval: cfg.CompactionSleepInterval,
+		WatchSkipIndexBlockSize: cfg.WatchSkipIndexBlockSize,
+	}
+	for prefix := range cfg.MaxKeysPerPrefix {
+		mvccStoreConfig.CountedKeyPrefixes = append(mvccStoreConfig.CountedKeyPrefixes, prefix)
+	}
+	if cfg.HeartbeatProtection {
+		mvccStoreConfig.PauseWatchSync = srv.HeartbeatStarved
 	}
 	srv.kv = mvcc.New(srv.Logger(), srv.be, srv.lessor, mvccStoreConfig)
 	srv.corruptionChecker = newCorruptionChecker(cfg.Logger, srv, srv.kv.HashStorage())
 
-	srv.authStore = auth.NewAuthStore(srv.Logger(), schema.NewAuthBackend(srv.Logger(), srv.be), tp, int(cfg.BcryptCost))
+	srv.authStore = auth.NewAuthStore(srv.Logger(), schema.NewAuthBackend(srv.Logger(), srv.be), tp, int(cfg.BcryptCost),
+		auth.WithRoleTokenTTLs(cfg.RoleTokenTTLs))
 
 	newSrv := srv // since srv == nil in defer if srv is returned as nil
 	defer func() {
@@ -398,6 +423,15 @@ func NewServer(cfg config.ServerConfig) (srv *EtcdServer, err error) {
 	}
 	srv.uberApply = srv.NewUberApplier()
 
+	if cfg.ApplyDigestLog != "" {
+		if srv.applyDigest, err = openApplyDigest(cfg, srv.consistIndex.ConsistentIndex()); err != nil {
+			return nil, err
+		}
+	}
+	if len(cfg.WebhookURLs) != 0 {
+		srv.webhook = newWebhookSender(srv)
+	}
+
 	if srv.FeatureEnabled(features.LeaseCheckpoint) {
 		// setting checkpointer enables lease checkpoint feature.
 		srv.lessor.SetCheckpointer(func(ctx context.Context, cp *pb.LeaseCheckpointRequest) error {
@@ -547,6 +581,10 @@ func (s *EtcdServer) Start() {
 	s.GoAttach(s.monitorKVHash)
 	s.GoAttach(s.monitorCompactHash)
 	s.GoAttach(s.monitorDowngrade)
+	s.GoAttach(s.monitorDefragSchedule)
+	s.GoAttach(s.monitorWebhookQuota)
+	s.GoAttach(s.monitorLearnerStaleness)
+	s.GoAttach(s.monitorPeerCertificate)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -612,8 +650,11 @@ func (s *EtcdServer) purgeFile() {
 		dbdonec, dberrc = fileutil.PurgeFileWithoutFlock(lg, s.Cfg.SnapDir(), "snap.db", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
 		sdonec, serrc = fileutil.PurgeFileWithoutFlock(lg, s.Cfg.SnapDir(), "snap", s.Cfg.MaxSnapFiles, purgeFileInterval, s.stopping)
 	}
-	if s.Cfg.MaxWALFiles > 0 {
-		wdonec, werrc = fileutil.PurgeFileWithDoneNotify(lg, s.Cfg.WALDir(), "wal", s.Cfg.MaxWALFiles, purgeFileInterval, s.stopping)
+	if s.Cfg.MaxWALFiles > 0 || s.Cfg.MaxWALBytes > 0 || s.Cfg.MaxWALAge > 0 {
+		// WAL files needed after the latest snapshot stay locked by the WAL,
+		// so the purge routine never removes them regardless of the limits.
+		limits := fileutil.PurgeLimits{MaxFiles: s.Cfg.MaxWALFiles, MaxBytes: s.Cfg.MaxWALBytes, MaxAge: s.Cfg.MaxWALAge}
+		wdonec, werrc = fileutil.PurgeFileWithLimits(lg, s.Cfg.WALDir(), "wal", limits, purgeFileInterval, s.stopping)
 	}
 
 	select {
@@ -660,6 +701,8 @@ type ServerPeerV2 interface {
 	ServerPeer
 	HashKVHandler() http.Handler
 	DowngradeEnabledHandler() http.Handler
+	DefragHandler() http.Handler
+	MemberStatusHandler() http.Handler
 }
 
 func (s *EtcdServer) DowngradeInfo() *serverversion.DowngradeInfo { return s.cluster.DowngradeInfo() }
@@ -795,6 +838,10 @@ func (s *EtcdServer) run() {
 			}
 			if newLeader {
 				s.leaderChanged.Notify()
+				if s.isLeader() {
+					s.notify(webhook.EventTypeNormal, webhook.ReasonLeaderChanged,
+						fmt.Sprintf("member %s became the leader", s.MemberID()))
+				}
 			}
 			// TODO: remove the nil checking
 			// current test utility does not provide the stats
@@ -916,6 +963,12 @@ func (s *EtcdServer) isActive() bool {
 	return latestTickTs.Add(threshold).After(time.Now())
 }
 
+// HeartbeatStarved returns whether the raft loop of the member was recently
+// found falling behind its ticks, delaying its heartbeats.
+func (s *EtcdServer) HeartbeatStarved() bool {
+	return s.r.starvation.isStarved()
+}
+
 // ensureLeadership checks whether current member is still the leader.
 func (s *EtcdServer) ensureLeadership() bool {
 	lg := s.Logger()
@@ -966,6 +1019,12 @@ func (s *EtcdServer) Cleanup() {
 	if s.compactor != nil {
 		s.compactor.Stop()
 	}
+	if s.applyDigest != nil {
+		s.applyDigest.Close()
+	}
+	if s.webhook != nil {
+		s.webhook.Stop()
+	}
 }
 
 func (s *EtcdServer) applyAll(ep *etcdProgress, apply *toApply) {
@@ -985,6 +1044,17 @@ func (s *EtcdServer) applyAll(ep *etcdProgress, apply *toApply) {
 	select {
 	// snapshot requested via send()
 	case m := <-s.r.msgSnapC:
+		if s.tooManySnapshotSends() {
+			// let raft probe the follower again and retry the snapshot once
+			// one of the transmissions completes.
+			s.Logger().Warn(
+				"deferred sending merged snapshot; too many snapshots being sent",
+				zap.String("to", types.ID(m.To).String()),
+				zap.Int("max-concurrent-snapshot-sends", s.Cfg.MaxConcurrentSnapshotSends),
+			)
+			s.r.ReportSnapshot(m.To, raft.SnapshotFailure)
+			break
+		}
 		merged := s.createMergedSnapshotMessage(m, ep.appliedt, ep.appliedi, ep.confState)
 		s.sendMergedSnap(merged)
 	default:
@@ -1157,6 +1227,7 @@ func (s *EtcdServer) NewUberApplier() apply.UberApplier {
 		TxnModeWriteWithSharedBuffer: s.Cfg.ServerFeatureGate.Enabled(features.TxnModeWriteWithSharedBuffer),
 		Backend:                      s.be,
 		QuotaBackendBytesCfg:         s.Cfg.QuotaBackendBytes,
+		MaxKeysPerPrefix:             s.Cfg.MaxKeysPerPrefix,
 		WarningApplyDuration:         s.Cfg.WarningApplyDuration,
 	}
 	return apply.NewUberApplier(opts)
@@ -1646,6 +1717,14 @@ func (s *EtcdServer) mayRemoveMember(id types.ID) error {
 }
 
 func (s *EtcdServer) UpdateMember(ctx context.Context, memb membership.Member) ([]*membership.Member, error) {
+	// only the balancing attributes are updated, keep the raft attributes
+	if len(memb.PeerURLs) == 0 && memb.Balancing != nil {
+		m := s.cluster.Member(memb.ID)
+		if m == nil {
+			return nil, membership.ErrIDNotFound
+		}
+		memb.RaftAttributes = m.RaftAttributes
+	}
 	b, merr := json.Marshal(memb)
 	if merr != nil {
 		return nil, merr
@@ -1830,8 +1909,16 @@ func (s *EtcdServer) publishV3(timeout time.Duration) {
 	}
 }
 
+// tooManySnapshotSends reports whether sending another snapshot would exceed
+// the configured limit of concurrent snapshot transmissions.
+func (s *EtcdServer) tooManySnapshotSends() bool {
+	limit := s.Cfg.MaxConcurrentSnapshotSends
+	return limit > 0 && atomic.LoadInt64(&s.sendingSnapshots) >= int64(limit)
+}
+
 func (s *EtcdServer) sendMergedSnap(merged snap.Message) {
 	atomic.AddInt64(&s.inflightSnapshots, 1)
+	atomic.AddInt64(&s.sendingSnapshots, 1)
 
 	lg := s.Logger()
 	fields := []zap.Field{
@@ -1848,6 +1935,7 @@ func (s *EtcdServer) sendMergedSnap(merged snap.Message) {
 	s.GoAttach(func() {
 		select {
 		case ok := <-merged.CloseNotify():
+			atomic.AddInt64(&s.sendingSnapshots, -1)
 			// delay releasing inflight snapshot for another 30 seconds to
 			// block log compaction.
 			// If the follower still fails to catch up, it is probably just too slow
@@ -1988,9 +2076,14 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry, shouldApplyV3 membership.
 		return
 	}
 
+	if s.applyDigest != nil {
+		s.recordApplyDigest(e, &raftReq, ar)
+	}
+
 	if ar == nil {
 		return
 	}
+	s.notifyAlarms(&raftReq, ar)
 
 	if !errorspkg.Is(ar.Err, errors.ErrNoSpace) || len(s.alarmStore.Get(pb.AlarmType_NOSPACE)) > 0 {
 		s.w.Trigger(id, ar)
@@ -2016,6 +2109,40 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry, shouldApplyV3 membership.
 	})
 }
 
+// openApplyDigest opens the apply digest log, discarding the digests of the
+// entries after the applied index, which are applied again.
+func openApplyDigest(cfg config.ServerConfig, appliedIndex uint64) (*applydigest.Log, error) {
+	l, err := applydigest.Open(cfg.ApplyDigestLog, cfg.ApplyDigestLogEntries)
+	if err != nil {
+		cfg.Logger.Warn("failed to open apply digest log", zap.String("path", cfg.ApplyDigestLog), zap.Error(err))
+		return nil, err
+	}
+	if err = l.DiscardAfter(appliedIndex); err != nil {
+		l.Close()
+		cfg.Logger.Warn("failed to open apply digest log", zap.String("path", cfg.ApplyDigestLog), zap.Error(err))
+		return nil, err
+	}
+	cfg.Logger.Info("recording apply digests", zap.String("path", cfg.ApplyDigestLog), zap.Int("entries", cfg.ApplyDigestLogEntries))
+	return l, nil
+}
+
+// recordApplyDigest records the digest of the applied entry. The response of
+// the requests without side effects is not hashed, as only the member serving
+// them computes it.
+func (s *EtcdServer) recordApplyDigest(e *raftpb.Entry, r *pb.InternalRaftRequest, ar *apply.Result) {
+	rec := applydigest.Record{
+		Index:       e.Index,
+		RequestHash: applydigest.RequestHash(e.Data),
+		Revision:    s.KV().Rev(),
+	}
+	if ar != nil && !noSideEffect(r) {
+		rec.ResponseHash = applydigest.ResponseHash(ar.Resp, ar.Err)
+	}
+	if err := s.applyDigest.Append(rec); err != nil {
+		s.Logger().Warn("failed to record apply digest", zap.Uint64("index", e.Index), zap.Error(err))
+	}
+}
+
 func noSideEffect(r *pb.InternalRaftRequest) bool {
 	return r.Range != nil || r.AuthUserGet != nil || r.AuthRoleGet != nil || r.AuthStatus != nil
 }
@@ -2102,6 +2229,9 @@ func (s *EtcdServer) applyConfChange(cc raftpb.ConfChange, confState *raftpb.Con
 			)
 		}
 		s.cluster.UpdateRaftAttributes(m.ID, m.RaftAttributes, shouldApplyV3)
+		if m.Balancing != nil {
+			s.cluster.UpdateBalancingAttributes(m.ID, *m.Balancing, shouldApplyV3)
+		}
 		if m.ID != s.MemberID() {
 			s.r.transport.UpdatePeer(m.ID, m.PeerURLs)
 		}
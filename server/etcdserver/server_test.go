@@ -840,6 +840,28 @@ func TestSnapshotOrdering(t *testing.T) {
 
 // TestConcurrentApplyAndSnapshotV3 will send out snapshots concurrently with
 // proposals.
+func TestTooManySnapshotSends(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   int
+		sending int64
+		want    bool
+	}{
+		{name: "no limit", limit: 0, sending: 16, want: false},
+		{name: "below limit", limit: 2, sending: 1, want: false},
+		{name: "at limit", limit: 2, sending: 2, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &EtcdServer{
+				Cfg:              config.ServerConfig{MaxConcurrentSnapshotSends: tt.limit},
+				sendingSnapshots: tt.sending,
+			}
+			assert.Equal(t, tt.want, s.tooManySnapshotSends())
+		})
+	}
+}
+
 func TestConcurrentApplyAndSnapshotV3(t *testing.T) {
 	// Ignore the snapshot index verification in unit test, because
 	// it doesn't follow the e2e applying logic.
@@ -0,0 +1,97 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sync"
+	"time"
+
+	"go.etcd.io/raft/v3"
+)
+
+// leaderContactHistory is the number of distinct read indexes kept by
+// leaderContacts.
+const leaderContactHistory = 16
+
+// leaderContact records that every entry committed before at has an index no
+// greater than commit.
+type leaderContact struct {
+	commit uint64
+	at     time.Time
+}
+
+// leaderContacts tracks the read indexes confirmed by the raft leader, so that
+// a member can bound how far its applied data lags behind the leader. A read
+// index confirmed for a request sent at a given time covers every entry
+// committed before that time, so the time the request was sent bounds the
+// staleness of the data applied up to the read index. Heartbeats and appends
+// give no such bound: the commit index of a heartbeat is capped by the match
+// index of the follower, and the time an append is received is only an upper
+// bound of the time the leader sent it.
+type leaderContacts struct {
+	mu sync.Mutex
+	// contacts are ordered by increasing commit index and time.
+	contacts []leaderContact
+}
+
+// confirm records the read index confirmed by the leader for a read index
+// request sent at the given time.
+func (lc *leaderContacts) confirm(commit uint64, sent time.Time) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if n := len(lc.contacts); n > 0 {
+		last := &lc.contacts[n-1]
+		if commit < last.commit || !sent.After(last.at) {
+			return
+		}
+		if commit == last.commit {
+			last.at = sent
+			return
+		}
+	}
+	if len(lc.contacts) == leaderContactHistory {
+		lc.contacts = append(lc.contacts[:0], lc.contacts[1:]...)
+	}
+	lc.contacts = append(lc.contacts, leaderContact{commit: commit, at: sent})
+}
+
+// staleness returns how long ago the last read index no greater than applied
+// was requested, which bounds how far the data applied up to that index lags
+// behind the leader. It returns false if no such read index is known.
+func (lc *leaderContacts) staleness(applied uint64, now time.Time) (time.Duration, bool) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	for i := len(lc.contacts) - 1; i >= 0; i-- {
+		if lc.contacts[i].commit <= applied {
+			return now.Sub(lc.contacts[i].at), true
+		}
+	}
+	return 0, false
+}
+
+// boundedStaleness returns the staleness of the data applied by the member if
+// it is within the bound of the request. Only followers serve such reads; the
+// leader always falls back to a linearizable read.
+func (s *EtcdServer) boundedStaleness(maxStalenessMs int64) (time.Duration, bool) {
+	lead := s.getLead()
+	if maxStalenessMs <= 0 || lead == raft.None || lead == uint64(s.MemberID()) {
+		return 0, false
+	}
+	staleness, ok := s.leaderContacts.staleness(s.getAppliedIndex(), time.Now())
+	if !ok || staleness > time.Duration(maxStalenessMs)*time.Millisecond {
+		return 0, false
+	}
+	return staleness, true
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v2store"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestLeaderContactsStaleness(t *testing.T) {
+	var lc leaderContacts
+	start := time.Now()
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+
+	_, ok := lc.staleness(10, at(0))
+	assert.False(t, ok, "no read index")
+
+	lc.confirm(10, at(0))
+	lc.confirm(12, at(100))
+	// a later request confirming the same read index covers more entries
+	lc.confirm(12, at(200))
+	// a read index older than the known ones is ignored
+	lc.confirm(11, at(300))
+
+	tests := []struct {
+		name      string
+		applied   uint64
+		wok       bool
+		staleness time.Duration
+	}{
+		{name: "up to date", applied: 12, wok: true, staleness: 300 * time.Millisecond},
+		{name: "lagging", applied: 11, wok: true, staleness: 500 * time.Millisecond},
+		{name: "behind all read indexes", applied: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staleness, ok := lc.staleness(tt.applied, at(500))
+			assert.Equal(t, tt.wok, ok)
+			assert.Equal(t, tt.staleness, staleness)
+		})
+	}
+}
+
+func TestLeaderContactsHistory(t *testing.T) {
+	var lc leaderContacts
+	start := time.Now()
+	for i := uint64(1); i <= 2*leaderContactHistory; i++ {
+		lc.confirm(i, start.Add(time.Duration(i)*time.Millisecond))
+	}
+	assert.Len(t, lc.contacts, leaderContactHistory)
+	_, ok := lc.staleness(leaderContactHistory, start)
+	assert.False(t, ok, "read index evicted from history")
+	_, ok = lc.staleness(leaderContactHistory+1, start)
+	assert.True(t, ok)
+}
+
+// TestBoundedStalenessLaggingFollower ensures that the heartbeats received by
+// a follower lagging behind the leader do not refresh the staleness bound of
+// its applied data.
+func TestBoundedStalenessLaggingFollower(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	cl := newTestCluster(t)
+	st := v2store.New()
+	cl.SetStore(st)
+	be, _ := betesting.NewDefaultTmpBackend(t)
+	defer betesting.Close(t, be)
+	cl.SetBackend(schema.NewMembershipBackend(lg, be))
+	for id := uint64(1); id <= 3; id++ {
+		cl.AddMember(&membership.Member{ID: types.ID(id)}, true)
+	}
+	r := realisticRaftNode(lg, 1, &raftpb.Snapshot{
+		Metadata: raftpb.SnapshotMetadata{
+			Index:     11,
+			Term:      11,
+			ConfState: raftpb.ConfState{Voters: []uint64{1, 2, 3}},
+		},
+	})
+	defer r.raftNodeConfig.Stop()
+	s := &EtcdServer{
+		lgMu:     new(sync.RWMutex),
+		lg:       lg,
+		memberID: 1,
+		r:        *r,
+		v2store:  st,
+		cluster:  cl,
+	}
+	s.setLead(3)
+	s.setTerm(11)
+	s.setAppliedIndex(11)
+
+	// the follower confirmed a read index once, then lags behind the leader
+	// which committed far past its match index
+	s.leaderContacts.confirm(11, time.Now())
+	var prev time.Duration
+	for i := 0; i < 3; i++ {
+		time.Sleep(20 * time.Millisecond)
+		// the commit index of a heartbeat is capped by the match index
+		err := s.Process(t.Context(), raftpb.Message{Type: raftpb.MsgHeartbeat, From: 3, To: 1, Term: 11, Commit: 11})
+		require.NoError(t, err)
+		staleness, ok := s.boundedStaleness(time.Minute.Milliseconds())
+		require.True(t, ok)
+		assert.Greater(t, staleness, prev)
+		prev = staleness
+	}
+	_, ok := s.boundedStaleness(prev.Milliseconds())
+	assert.False(t, ok, "staleness past the bound")
+}
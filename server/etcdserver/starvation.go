@@ -0,0 +1,137 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// starvationCauseScheduler is the cause of the starvations during which
+	// the Go scheduler is late to run the goroutines, usually as the member
+	// is short of CPU.
+	starvationCauseScheduler = "scheduler"
+	// starvationCauseRaftLoop is the cause of the starvations during which
+	// the raft loop is busy, usually blocked by a slow disk.
+	starvationCauseRaftLoop = "raft-loop"
+
+	// starvationHoldTicks is the number of heartbeat intervals a member is
+	// considered starved for after a starvation is detected.
+	starvationHoldTicks = 10
+)
+
+// starvation detects the raft loop falling behind its ticks, which delays the
+// heartbeats of the leader, and tells whether the Go scheduler or the raft
+// loop itself is to blame. The delays caused by the network are not local,
+// and so are not detected: they are reported by the peer metrics.
+type starvation struct {
+	lg        *zap.Logger
+	heartbeat time.Duration
+
+	// schedulerDelay is the latest delay of the scheduler probe.
+	schedulerDelay atomic.Int64
+	// starvedUntil is the time, in Unix nanoseconds, until which the member
+	// is considered starved.
+	starvedUntil atomic.Int64
+}
+
+func newStarvation(lg *zap.Logger, heartbeat time.Duration) *starvation {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	return &starvation{lg: lg, heartbeat: heartbeat}
+}
+
+// probe measures the delay of the Go scheduler to wake up a goroutine after
+// every heartbeat interval, until stop is closed.
+func (s *starvation) probe(stop <-chan struct{}) {
+	t := time.NewTimer(s.heartbeat)
+	defer t.Stop()
+	start := time.Now()
+	for {
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+		now := time.Now()
+		delay := max(now.Sub(start)-s.heartbeat, 0)
+		s.schedulerDelay.Store(int64(delay))
+		schedulerDelaySec.Observe(delay.Seconds())
+		if delay > s.heartbeat {
+			s.starved(now, starvationCauseScheduler, delay)
+		}
+		start = now
+		t.Reset(s.heartbeat)
+	}
+}
+
+// observeTick observes a tick sent by the ticker at ts, and received by the
+// raft loop at now.
+func (s *starvation) observeTick(ts, now time.Time) {
+	delay := max(now.Sub(ts), 0)
+	raftTickDelaySec.Observe(delay.Seconds())
+	if delay <= s.heartbeat {
+		return
+	}
+	// the scheduler probe waking up late too means that the raft loop is not
+	// to blame
+	cause := starvationCauseRaftLoop
+	if time.Duration(s.schedulerDelay.Load()) > s.heartbeat/2 {
+		cause = starvationCauseScheduler
+	}
+	s.starved(now, cause, delay)
+}
+
+// starved records a starvation detected at now. It is logged only if the
+// member was not already starved, to not flood the logs.
+func (s *starvation) starved(now time.Time, cause string, delay time.Duration) {
+	raftTickStarvations.WithLabelValues(cause).Inc()
+	wasStarved := s.isStarved()
+	s.starvedUntil.Store(now.Add(starvationHoldTicks * s.heartbeat).UnixNano())
+	if wasStarved {
+		return
+	}
+	msg := "raft loop is blocked, likely by a slow disk; heartbeats are delayed"
+	if cause == starvationCauseScheduler {
+		msg = "go scheduler is starved, likely of CPU; heartbeats are delayed"
+	}
+	s.lg.Warn(
+		msg,
+		zap.String("cause", cause),
+		zap.Duration("heartbeat-interval", s.heartbeat),
+		zap.Duration("delay", delay),
+	)
+}
+
+// isStarved returns whether a starvation was detected in the latest
+// starvationHoldTicks heartbeat intervals.
+func (s *starvation) isStarved() bool {
+	return time.Now().UnixNano() < s.starvedUntil.Load()
+}
+
+// cause returns the cause of the current starvation, or "none".
+func (s *starvation) cause() string {
+	if !s.isStarved() {
+		return "none"
+	}
+	if time.Duration(s.schedulerDelay.Load()) > s.heartbeat/2 {
+		return starvationCauseScheduler
+	}
+	return starvationCauseRaftLoop
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStarvationObserveTick(t *testing.T) {
+	heartbeat := 100 * time.Millisecond
+	tests := []struct {
+		name           string
+		tickDelay      time.Duration
+		schedulerDelay time.Duration
+
+		wstarved bool
+		wcause   string
+	}{
+		{
+			name:      "tick on time",
+			tickDelay: 10 * time.Millisecond,
+			wcause:    "none",
+		},
+		{
+			name:      "raft loop blocked",
+			tickDelay: 3 * heartbeat,
+			wstarved:  true,
+			wcause:    starvationCauseRaftLoop,
+		},
+		{
+			name:           "scheduler starved",
+			tickDelay:      3 * heartbeat,
+			schedulerDelay: heartbeat,
+			wstarved:       true,
+			wcause:         starvationCauseScheduler,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newStarvation(zaptest.NewLogger(t), heartbeat)
+			s.schedulerDelay.Store(int64(tt.schedulerDelay))
+			before := testutil.ToFloat64(raftTickStarvations.WithLabelValues(tt.wcause))
+
+			now := time.Now()
+			s.observeTick(now.Add(-tt.tickDelay), now)
+			assert.Equal(t, tt.wstarved, s.isStarved())
+			assert.Equal(t, tt.wcause, s.cause())
+			if tt.wstarved {
+				assert.InDelta(t, before+1, testutil.ToFloat64(raftTickStarvations.WithLabelValues(tt.wcause)), 0)
+			}
+		})
+	}
+}
+
+func TestStarvationHold(t *testing.T) {
+	heartbeat := time.Millisecond
+	s := newStarvation(zaptest.NewLogger(t), heartbeat)
+	now := time.Now()
+	s.observeTick(now.Add(-time.Second), now)
+	assert.True(t, s.isStarved())
+	assert.Eventually(t, func() bool { return !s.isStarved() }, time.Second, heartbeat)
+}
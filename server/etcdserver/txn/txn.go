@@ -352,6 +352,43 @@ func IsTxnReadonly(r *pb.TxnRequest) bool {
 	return true
 }
 
+// Puts appends the puts of the ops to puts, with the puts of both branches of
+// their nested txns.
+func Puts(puts []*pb.PutRequest, ops []*pb.RequestOp) []*pb.PutRequest {
+	for _, op := range ops {
+		switch tv := op.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			puts = append(puts, tv.RequestPut)
+		case *pb.RequestOp_RequestTxn:
+			puts = Puts(puts, tv.RequestTxn.Success)
+			puts = Puts(puts, tv.RequestTxn.Failure)
+		}
+	}
+	return puts
+}
+
+// ExecutedPuts returns the puts of the branches of the txn, and of its nested
+// txns, that their compares select against the read view.
+func ExecutedPuts(rv mvcc.ReadView, rt *pb.TxnRequest) []*pb.PutRequest {
+	return executedPuts(nil, rv, rt)
+}
+
+func executedPuts(puts []*pb.PutRequest, rv mvcc.ReadView, rt *pb.TxnRequest) []*pb.PutRequest {
+	ops := rt.Success
+	if !applyCompares(rv, rt.Compare) {
+		ops = rt.Failure
+	}
+	for _, op := range ops {
+		switch tv := op.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			puts = append(puts, tv.RequestPut)
+		case *pb.RequestOp_RequestTxn:
+			puts = executedPuts(puts, rv, tv.RequestTxn)
+		}
+	}
+	return puts
+}
+
 func CheckTxnAuth(as auth.AuthStore, ai *auth.AuthInfo, rt *pb.TxnRequest) error {
 	for _, c := range rt.Compare {
 		if err := as.IsRangePermitted(ai, c.Key, c.RangeEnd); err != nil {
@@ -86,6 +86,7 @@ type Authenticator interface {
 	AuthDisable(ctx context.Context, r *pb.AuthDisableRequest) (*pb.AuthDisableResponse, error)
 	AuthStatus(ctx context.Context, r *pb.AuthStatusRequest) (*pb.AuthStatusResponse, error)
 	Authenticate(ctx context.Context, r *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error)
+	AuthTokenRefresh(ctx context.Context, r *pb.AuthTokenRefreshRequest) (*pb.AuthTokenRefreshResponse, error)
 	UserAdd(ctx context.Context, r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error)
 	UserDelete(ctx context.Context, r *pb.AuthUserDeleteRequest) (*pb.AuthUserDeleteResponse, error)
 	UserChangePassword(ctx context.Context, r *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error)
@@ -122,7 +123,12 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 		trace.LogIfLong(traceThreshold)
 	}(time.Now())
 
+	var staleness time.Duration
+	var stale bool
 	if !r.Serializable {
+		staleness, stale = s.boundedStaleness(r.MaxStalenessMs)
+	}
+	if !r.Serializable && !stale {
 		err = s.linearizableReadNotify(ctx)
 		trace.Step("agreement among raft nodes before linearized reading")
 		if err != nil {
@@ -138,10 +144,16 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 		err = serr
 		return nil, err
 	}
+	if stale && resp != nil {
+		resp.Header.StalenessMs = staleness.Milliseconds()
+	}
 	return resp, err
 }
 
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if err := s.validateValues(ctx, []*pb.PutRequest{r}); err != nil {
+		return nil, err
+	}
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey{}, time.Now())
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Put: r})
 	if err != nil {
@@ -192,6 +204,10 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 		return resp, err
 	}
 
+	if err := s.validateTxnValues(ctx, r); err != nil {
+		return nil, err
+	}
+
 	ctx = context.WithValue(ctx, traceutil.StartTimeKey{}, time.Now())
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Txn: r})
 	if err != nil {
@@ -258,6 +274,17 @@ func (s *EtcdServer) LeaseGrant(ctx context.Context, r *pb.LeaseGrantRequest) (*
 	return resp.(*pb.LeaseGrantResponse), nil
 }
 
+// CompactionBarrier sets, releases or queries the compaction barrier held by
+// the lease of the request. The barrier is replicated through raft, so that
+// every member rejects the compactions past it.
+func (s *EtcdServer) CompactionBarrier(ctx context.Context, r *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error) {
+	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{CompactionBarrier: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.CompactionBarrierResponse), nil
+}
+
 func (s *EtcdServer) waitAppliedIndex() error {
 	select {
 	case <-s.ApplyWait():
@@ -565,6 +592,58 @@ func (s *EtcdServer) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 	return resp.(*pb.AuthenticateResponse), nil
 }
 
+// AuthTokenRefresh assigns a new token to the user of the token of the
+// request. Unlike Authenticate, the password of the user is not needed: the
+// token proves the user authenticated, as long as the auth store did not
+// change since it was assigned. The token of the request stays valid until it
+// expires.
+func (s *EtcdServer) AuthTokenRefresh(ctx context.Context, r *pb.AuthTokenRefreshRequest) (*pb.AuthTokenRefreshResponse, error) {
+	if err := s.linearizableReadNotify(ctx); err != nil {
+		return nil, err
+	}
+
+	// the user is the one of the token, not one impersonated or of a TLS
+	// certificate, which are not refreshed
+	authInfo, err := s.AuthStore().AuthInfoFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if authInfo == nil {
+		if !s.AuthStore().IsAuthEnabled() {
+			return nil, auth.ErrAuthNotEnabled
+		}
+		return nil, auth.ErrInvalidAuthToken
+	}
+	if authInfo.Revision < s.AuthStore().Revision() {
+		return nil, auth.ErrAuthOldRevision
+	}
+
+	st, err := s.AuthStore().GenTokenPrefix()
+	if err != nil {
+		return nil, err
+	}
+	internalReq := &pb.InternalAuthenticateRequest{
+		Name:        authInfo.Username,
+		SimpleToken: st,
+	}
+	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{Authenticate: internalReq})
+	if err != nil {
+		return nil, err
+	}
+	// the user may have been deleted, or lost its roles, while the token was
+	// assigned
+	if authInfo.Revision != s.AuthStore().Revision() {
+		return nil, auth.ErrAuthOldRevision
+	}
+	ar := resp.(*pb.AuthenticateResponse)
+	s.Logger().Debug("refreshed auth token", zap.String("user", authInfo.Username))
+	return &pb.AuthTokenRefreshResponse{
+		Header: ar.Header,
+		Token:  ar.Token,
+		Ttl:    int64(s.AuthStore().TokenTTL(authInfo.Username).Seconds()),
+	}, nil
+}
+
 func (s *EtcdServer) UserAdd(ctx context.Context, r *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error) {
 	if r.Options == nil || !r.Options.NoPassword {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(r.Password), s.authStore.BcryptCost())
@@ -824,6 +903,7 @@ func (s *EtcdServer) linearizableReadLoop() {
 		s.readNotifier = nextnr
 		s.readMu.Unlock()
 
+		sent := time.Now()
 		confirmedIndex, err := s.requestCurrentIndex(leaderChangedNotifier, requestID)
 		if isStopped(err) {
 			return
@@ -832,6 +912,7 @@ func (s *EtcdServer) linearizableReadLoop() {
 			nr.notify(err)
 			continue
 		}
+		s.leaderContacts.confirm(confirmedIndex, sent)
 
 		trace.Step("read index received")
 
@@ -984,14 +1065,33 @@ func (s *EtcdServer) linearizableReadNotify(ctx context.Context) error {
 
 func (s *EtcdServer) AuthInfoFromCtx(ctx context.Context) (*auth.AuthInfo, error) {
 	authInfo, err := s.AuthStore().AuthInfoFromCtx(ctx)
-	if authInfo != nil || err != nil {
-		return authInfo, err
+	if err != nil {
+		return nil, err
+	}
+	if authInfo == nil && s.Cfg.ClientCertAuthEnabled {
+		authInfo = s.AuthStore().AuthInfoFromTLS(ctx)
 	}
-	if !s.Cfg.ClientCertAuthEnabled {
+	if authInfo == nil {
 		return nil, nil
 	}
-	authInfo = s.AuthStore().AuthInfoFromTLS(ctx)
-	return authInfo, nil
+	return s.AuthStore().Impersonate(ctx, authInfo)
+}
+
+// ConfigReport reports the deprecated flags the member was started with and
+// whether its v2 store holds content other than the cluster membership.
+func (s *EtcdServer) ConfigReport(ctx context.Context) (*pb.ConfigReportResponse, error) {
+	metaOnly, err := membership.IsMetaStoreOnly(s.v2store)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ConfigReportResponse{
+		V2Deprecation:        string(s.Cfg.V2Deprecation),
+		V2StoreCustomContent: !metaOnly,
+	}
+	for _, f := range s.Cfg.DeprecatedFlags {
+		resp.DeprecatedFlags = append(resp.DeprecatedFlags, &pb.DeprecatedFlag{Name: f.Name, Message: f.Message, RemovedIn: f.RemovedIn})
+	}
+	return resp, nil
 }
 
 func (s *EtcdServer) Downgrade(ctx context.Context, r *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
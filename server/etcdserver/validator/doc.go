@@ -0,0 +1,23 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validator checks the values written under the prefixes of the
+// keyspace, so that a client cannot store values breaking the consumers of a
+// prefix.
+//
+// A validator is either built in, such as "json" accepting the JSON values, or
+// the http(s) URL of a webhook. A webhook is posted a JSON encoded Review of
+// the key and the value, and responds with the Review with Allowed set, and
+// Reason if not allowed.
+package validator
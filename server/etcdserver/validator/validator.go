@@ -0,0 +1,125 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// JSON is the validator accepting the JSON values.
+	JSON = "json"
+
+	// DefaultTimeout is the timeout of a request to a webhook.
+	DefaultTimeout = 2 * time.Second
+	// maxResponseBytes is the maximum size of the response of a webhook.
+	maxResponseBytes = 64 * 1024
+)
+
+// ErrUnavailable is returned when a webhook cannot tell whether a value is
+// valid.
+var ErrUnavailable = errors.New("validator: unavailable")
+
+// Validator checks the values written under a prefix.
+type Validator interface {
+	// Validate returns nil if the value of the key is valid, ErrUnavailable
+	// wrapped if its validity is not known, and the reason it is invalid
+	// otherwise.
+	Validate(ctx context.Context, key, value []byte) error
+	// String returns the spec of the validator.
+	String() string
+}
+
+// Parse returns the validator of the spec, the name of a built-in validator
+// or the http(s) URL of a webhook.
+func Parse(spec string) (Validator, error) {
+	if spec == JSON {
+		return jsonValidator{}, nil
+	}
+	u, err := url.Parse(spec)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("validator %q is neither %q nor a http(s) URL", spec, JSON)
+	}
+	return &webhook{url: spec, client: http.DefaultClient, timeout: DefaultTimeout}, nil
+}
+
+type jsonValidator struct{}
+
+func (jsonValidator) Validate(_ context.Context, _, value []byte) error {
+	if !json.Valid(value) {
+		return errors.New("value is not valid JSON")
+	}
+	return nil
+}
+
+func (jsonValidator) String() string { return JSON }
+
+// Review is posted to a webhook with the key and the value to validate, and
+// returned by it with Allowed set, and Reason if not allowed.
+type Review struct {
+	Key     []byte `json:"key"`
+	Value   []byte `json:"value"`
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+type webhook struct {
+	url     string
+	client  *http.Client
+	timeout time.Duration
+}
+
+func (w *webhook) Validate(ctx context.Context, key, value []byte) error {
+	body, err := json.Marshal(Review{Key: key, Value: value})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: webhook responded %s", ErrUnavailable, resp.Status)
+	}
+	var r Review
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&r); err != nil {
+		return fmt.Errorf("%w: invalid webhook response: %w", ErrUnavailable, err)
+	}
+	if !r.Allowed {
+		if r.Reason == "" {
+			return errors.New("value rejected by webhook")
+		}
+		return errors.New(r.Reason)
+	}
+	return nil
+}
+
+func (w *webhook) String() string { return w.url }
@@ -0,0 +1,74 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, spec := range []string{"json", "http://localhost:8080/validate", "https://validator.example.com/?prefix=a"} {
+		v, err := Parse(spec)
+		require.NoError(t, err)
+		assert.Equal(t, spec, v.String())
+	}
+	for _, spec := range []string{"", "yaml", "ftp://localhost", "http://"} {
+		_, err := Parse(spec)
+		require.Errorf(t, err, "spec %q", spec)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	v, err := Parse(JSON)
+	require.NoError(t, err)
+	require.NoError(t, v.Validate(t.Context(), []byte("k"), []byte(`{"a":1}`)))
+	require.Error(t, v.Validate(t.Context(), []byte("k"), []byte(`{"a":`)))
+}
+
+func TestWebhook(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var review Review
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch string(review.Value) {
+		case "down":
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		case "ok":
+			review.Allowed = true
+		default:
+			review.Reason = "value of " + string(review.Key) + " must be ok"
+		}
+		json.NewEncoder(w).Encode(review)
+	}))
+	defer srv.Close()
+
+	v, err := Parse(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, v.Validate(t.Context(), []byte("k"), []byte("ok")))
+	require.EqualError(t, v.Validate(t.Context(), []byte("k"), []byte("ko")), "value of k must be ok")
+	require.ErrorIs(t, v.Validate(t.Context(), []byte("k"), []byte("down")), ErrUnavailable)
+
+	srv.Close()
+	require.ErrorIs(t, v.Validate(t.Context(), []byte("k"), []byte("ok")), ErrUnavailable)
+}
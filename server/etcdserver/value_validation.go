@@ -0,0 +1,87 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	errorspkg "errors"
+	"strings"
+
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/etcdserver/errors"
+	"go.etcd.io/etcd/server/v3/etcdserver/txn"
+	"go.etcd.io/etcd/server/v3/etcdserver/validator"
+)
+
+// validateValues returns ErrInvalidValue if a put writes a value rejected by
+// the validator of a prefix of its key in Cfg.ValueValidators, and
+// ErrValidatorUnavailable if a validator cannot tell.
+//
+// The values are validated by the member serving the request before it is
+// proposed, and not when it is applied, so that the members never diverge on
+// the answers of a webhook. The validators must be configured alike on all the
+// members to reject the invalid values cluster-wide.
+func (s *EtcdServer) validateValues(ctx context.Context, puts []*pb.PutRequest) error {
+	validators := s.Cfg.ValueValidators
+	if len(validators) == 0 {
+		return nil
+	}
+	for _, p := range puts {
+		if p.IgnoreValue {
+			continue
+		}
+		for prefix, v := range validators {
+			if !strings.HasPrefix(string(p.Key), prefix) {
+				continue
+			}
+			err := v.Validate(ctx, p.Key, p.Value)
+			if err == nil {
+				continue
+			}
+			if errorspkg.Is(err, validator.ErrUnavailable) {
+				valueValidationRejections.WithLabelValues(prefix, "unavailable").Inc()
+				s.Logger().Warn(
+					"rejected request as the validator of a prefix is unavailable",
+					zap.String("prefix", prefix),
+					zap.Stringer("validator", v),
+					zap.Error(err),
+				)
+				return errors.ErrValidatorUnavailable
+			}
+			valueValidationRejections.WithLabelValues(prefix, "invalid").Inc()
+			s.Logger().Warn(
+				"rejected request writing an invalid value",
+				zap.String("prefix", prefix),
+				zap.Stringer("validator", v),
+				zap.String("key", string(p.Key)),
+				zap.String("reason", err.Error()),
+			)
+			return errors.ErrInvalidValue
+		}
+	}
+	return nil
+}
+
+// validateTxnValues validates the values of the puts of both branches of the
+// txn, and of its nested txns.
+func (s *EtcdServer) validateTxnValues(ctx context.Context, rt *pb.TxnRequest) error {
+	if len(s.Cfg.ValueValidators) == 0 {
+		return nil
+	}
+	puts := txn.Puts(nil, rt.Success)
+	return s.validateValues(ctx, txn.Puts(puts, rt.Failure))
+}
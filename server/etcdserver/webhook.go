@@ -0,0 +1,114 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/apply"
+	"go.etcd.io/etcd/server/v3/etcdserver/webhook"
+	serverstorage "go.etcd.io/etcd/server/v3/storage"
+)
+
+// webhookQuotaCheckInterval is the interval the backend size is checked
+// against the quota at.
+var webhookQuotaCheckInterval = 30 * time.Second
+
+func newWebhookSender(s *EtcdServer) *webhook.Sender {
+	host, _ := os.Hostname()
+	s.Cfg.Logger.Info(
+		"posting events to webhooks",
+		zap.Strings("urls", s.Cfg.WebhookURLs),
+		zap.Bool("signed", len(s.Cfg.WebhookSecret) != 0),
+	)
+	return webhook.NewSender(s.Cfg.Logger, webhook.Config{
+		URLs:       s.Cfg.WebhookURLs,
+		Secret:     s.Cfg.WebhookSecret,
+		MemberName: s.Cfg.Name,
+		MemberID:   s.MemberID().String(),
+		ClusterID:  s.cluster.ID().String(),
+		Host:       host,
+	})
+}
+
+// notify posts an event to the webhooks, if any.
+func (s *EtcdServer) notify(eventType, reason, message string) {
+	if s.webhook == nil {
+		return
+	}
+	s.webhook.Notify(eventType, reason, message)
+}
+
+// notifyAlarms posts an event for each alarm activated by the applied request.
+// Only the leader posts them, as all the members apply the request.
+func (s *EtcdServer) notifyAlarms(r *pb.InternalRaftRequest, ar *apply.Result) {
+	if s.webhook == nil || r.Alarm == nil || r.Alarm.Action != pb.AlarmRequest_ACTIVATE || ar.Err != nil || !s.isLeader() {
+		return
+	}
+	resp, ok := ar.Resp.(*pb.AlarmResponse)
+	if !ok {
+		return
+	}
+	for _, a := range resp.Alarms {
+		s.notify(webhook.EventTypeWarning, webhook.ReasonAlarmRaised,
+			fmt.Sprintf("alarm %s raised for member %s", a.Alarm, types.ID(a.MemberID)))
+	}
+}
+
+// monitorWebhookQuota posts an event when the backend size of the member grows
+// past the WebhookQuotaThreshold ratio of its quota, once until it shrinks
+// back under it.
+func (s *EtcdServer) monitorWebhookQuota() {
+	if s.webhook == nil || s.Cfg.QuotaBackendBytes < 0 {
+		return
+	}
+	quota := s.Cfg.QuotaBackendBytes
+	if quota == 0 {
+		quota = serverstorage.DefaultQuotaBytes
+	}
+	threshold := int64(float64(quota) * s.Cfg.WebhookQuotaThreshold)
+	exceeded := false
+	for {
+		select {
+		case <-time.After(webhookQuotaCheckInterval):
+		case <-s.stopping:
+			return
+		}
+		size := s.Backend().Size()
+		if size < threshold {
+			exceeded = false
+			continue
+		}
+		if exceeded {
+			continue
+		}
+		exceeded = true
+		s.Logger().Warn(
+			"backend quota nearly exceeded",
+			zap.Int64("size-bytes", size),
+			zap.Int64("quota-size-bytes", quota),
+			zap.Float64("threshold", s.Cfg.WebhookQuotaThreshold),
+		)
+		s.notify(webhook.EventTypeWarning, webhook.ReasonQuotaNearlyExceeded,
+			fmt.Sprintf("backend size %s of member %s is %.0f%% of its quota %s", humanize.Bytes(uint64(size)), s.MemberID(), 100*float64(size)/float64(quota), humanize.Bytes(uint64(quota))))
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook posts events on the critical conditions of a member, such as
+// a raised alarm or a leader change, to external webhooks.
+//
+// The events are JSON encoded subsets of the Kubernetes core/v1 Event, so that
+// the receivers already handling the events of a Kubernetes cluster can handle
+// them too. Each webhook is delivered in order by its own goroutine, retrying
+// on the network errors and the 5xx and 429 responses. If a secret is set, the
+// requests are signed by an HMAC-SHA256 of the timestamp and the body, see
+// Sign.
+package webhook
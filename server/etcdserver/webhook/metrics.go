@@ -0,0 +1,34 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	resultSent    = "sent"
+	resultFailed  = "failed"
+	resultDropped = "dropped"
+)
+
+var notifications = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "etcd",
+	Subsystem: "server",
+	Name:      "webhook_events_total",
+	Help:      "The total number of events to post to the webhooks, by result: sent, failed after the retries or dropped as too many were pending.",
+}, []string{"result"})
+
+func init() {
+	prometheus.MustRegister(notifications)
+}
@@ -0,0 +1,339 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	EventTypeNormal  = "Normal"
+	EventTypeWarning = "Warning"
+)
+
+// The reasons of the events posted by the members.
+const (
+	ReasonAlarmRaised         = "AlarmRaised"
+	ReasonLeaderChanged       = "LeaderChanged"
+	ReasonCorruptionDetected  = "CorruptionDetected"
+	ReasonQuotaNearlyExceeded = "QuotaNearlyExceeded"
+)
+
+const (
+	// TimestampHeader is the header holding the unix time in seconds the
+	// request was sent at.
+	TimestampHeader = "X-Etcd-Timestamp"
+	// SignatureHeader is the header holding the signature of the request if
+	// a secret is set.
+	SignatureHeader = "X-Etcd-Signature"
+
+	// AnnotationClusterID and AnnotationMemberID are the annotations of the
+	// events holding the IDs of the cluster and of the member posting them.
+	AnnotationClusterID = "etcd.io/cluster-id"
+	AnnotationMemberID  = "etcd.io/member-id"
+
+	involvedObjectKind = "EtcdMember"
+	sourceComponent    = "etcd"
+)
+
+const (
+	DefaultTimeout     = 5 * time.Second
+	DefaultMaxAttempts = 5
+	DefaultBackoff     = time.Second
+	DefaultQueueSize   = 64
+
+	maxBackoff = 30 * time.Second
+)
+
+// Event is the subset of the Kubernetes core/v1 Event posted to the webhooks.
+type Event struct {
+	APIVersion     string          `json:"apiVersion"`
+	Kind           string          `json:"kind"`
+	Metadata       ObjectMeta      `json:"metadata"`
+	InvolvedObject ObjectReference `json:"involvedObject"`
+	Reason         string          `json:"reason"`
+	Message        string          `json:"message"`
+	Type           string          `json:"type"`
+	Source         EventSource     `json:"source"`
+	FirstTimestamp time.Time       `json:"firstTimestamp"`
+	LastTimestamp  time.Time       `json:"lastTimestamp"`
+	Count          int32           `json:"count"`
+}
+
+type ObjectMeta struct {
+	Name              string            `json:"name"`
+	CreationTimestamp time.Time         `json:"creationTimestamp"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
+}
+
+type ObjectReference struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	UID  string `json:"uid"`
+}
+
+type EventSource struct {
+	Component string `json:"component"`
+	Host      string `json:"host,omitempty"`
+}
+
+// Config is the configuration of a Sender.
+type Config struct {
+	// URLs are the webhooks the events are posted to.
+	URLs []string
+	// Secret signs the requests if not empty.
+	Secret []byte
+	// MemberName, MemberID and ClusterID identify the member the events
+	// are about.
+	MemberName string
+	MemberID   string
+	ClusterID  string
+	// Host is the host name of the member.
+	Host string
+
+	// Timeout is the timeout of a request, DefaultTimeout if zero.
+	Timeout time.Duration
+	// MaxAttempts is the number of times an event is posted before it is
+	// given up, DefaultMaxAttempts if zero.
+	MaxAttempts int
+	// Backoff is the wait before the first retry, doubled on each retry up
+	// to 30 seconds. DefaultBackoff if zero.
+	Backoff time.Duration
+	// QueueSize is the number of events waiting to be posted to a webhook
+	// past which the new events are dropped, DefaultQueueSize if zero.
+	QueueSize int
+
+	// Client posts the events, http.DefaultClient if nil.
+	Client *http.Client
+}
+
+// Sender posts the events to the webhooks. The events are posted by a
+// goroutine per webhook, so a slow or down webhook does not delay the others
+// nor the caller.
+type Sender struct {
+	lg  *zap.Logger
+	cfg Config
+
+	queues []chan []byte
+	// ctx is canceled by Stop, aborting the requests in flight.
+	ctx    context.Context
+	cancel context.CancelFunc
+	donec  chan struct{}
+}
+
+// NewSender returns a Sender posting to the webhooks of the config, started.
+func NewSender(lg *zap.Logger, cfg Config) *Sender {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.Backoff == 0 {
+		cfg.Backoff = DefaultBackoff
+	}
+	if cfg.QueueSize == 0 {
+		cfg.QueueSize = DefaultQueueSize
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	s := &Sender{
+		lg:     lg,
+		cfg:    cfg,
+		queues: make([]chan []byte, len(cfg.URLs)),
+		donec:  make(chan struct{}),
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	done := make(chan struct{}, len(cfg.URLs))
+	for i, u := range cfg.URLs {
+		s.queues[i] = make(chan []byte, cfg.QueueSize)
+		go func(url string, q <-chan []byte) {
+			defer func() { done <- struct{}{} }()
+			s.run(url, q)
+		}(u, s.queues[i])
+	}
+	go func() {
+		for range cfg.URLs {
+			<-done
+		}
+		close(s.donec)
+	}()
+	return s
+}
+
+// NewEvent returns an event about the member of the sender.
+func (s *Sender) NewEvent(eventType, reason, message string) Event {
+	now := time.Now().UTC()
+	annotations := map[string]string{AnnotationMemberID: s.cfg.MemberID}
+	if s.cfg.ClusterID != "" {
+		annotations[AnnotationClusterID] = s.cfg.ClusterID
+	}
+	return Event{
+		APIVersion: "v1",
+		Kind:       "Event",
+		Metadata: ObjectMeta{
+			// named after the object and the time, as the Kubernetes events are
+			Name:              fmt.Sprintf("%s.%x", s.cfg.MemberName, now.UnixNano()),
+			CreationTimestamp: now,
+			Annotations:       annotations,
+		},
+		InvolvedObject: ObjectReference{
+			Kind: involvedObjectKind,
+			Name: s.cfg.MemberName,
+			UID:  s.cfg.MemberID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         EventSource{Component: sourceComponent, Host: s.cfg.Host},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+}
+
+// Notify queues a new event to be posted to the webhooks. It never blocks:
+// the event is dropped for the webhooks whose queue is full.
+func (s *Sender) Notify(eventType, reason, message string) {
+	s.Send(s.NewEvent(eventType, reason, message))
+}
+
+// Send queues the event to be posted to the webhooks, see Notify.
+func (s *Sender) Send(ev Event) {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		s.lg.Warn("failed to marshal webhook event", zap.String("reason", ev.Reason), zap.Error(err))
+		return
+	}
+	for i, q := range s.queues {
+		select {
+		case <-s.ctx.Done():
+			return
+		case q <- body:
+		default:
+			notifications.WithLabelValues(resultDropped).Inc()
+			s.lg.Warn(
+				"dropped webhook event; too many events pending",
+				zap.String("url", s.cfg.URLs[i]),
+				zap.String("reason", ev.Reason),
+			)
+		}
+	}
+}
+
+// Stop stops posting the events and waits for the goroutines to exit. The
+// requests in flight are aborted and the events still queued are dropped.
+func (s *Sender) Stop() {
+	s.cancel()
+	<-s.donec
+}
+
+func (s *Sender) run(url string, q <-chan []byte) {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case body := <-q:
+			if s.post(url, body) {
+				notifications.WithLabelValues(resultSent).Inc()
+			} else {
+				notifications.WithLabelValues(resultFailed).Inc()
+			}
+		}
+	}
+}
+
+// post posts the body to the webhook, retrying until it succeeds, fails with
+// an error not worth retrying, runs out of attempts or the sender is stopped.
+func (s *Sender) post(url string, body []byte) bool {
+	backoff := s.cfg.Backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := s.postOnce(url, body)
+		if err == nil {
+			return true
+		}
+		if !retry || attempt >= s.cfg.MaxAttempts {
+			s.lg.Warn(
+				"failed to post webhook event",
+				zap.String("url", url),
+				zap.Int("attempts", attempt),
+				zap.Error(err),
+			)
+			return false
+		}
+		s.lg.Debug("retrying to post webhook event", zap.String("url", url), zap.Int("attempt", attempt), zap.Error(err))
+		select {
+		case <-s.ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+// postOnce posts the body once, returning if a failure is worth retrying.
+func (s *Sender) postOnce(url string, body []byte) (retry bool, err error) {
+	ctx, cancel := context.WithTimeout(s.ctx, s.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	if len(s.cfg.Secret) != 0 {
+		req.Header.Set(SignatureHeader, Sign(s.cfg.Secret, timestamp, body))
+	}
+	resp, err := s.cfg.Client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("unexpected response status %q", resp.Status)
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+// Sign returns the signature of a request posted at the timestamp with the
+// body, "sha256=" followed by the hex encoded HMAC-SHA256 of the timestamp, a
+// dot and the body. The receivers verify the requests by comparing the
+// signature header with the signature they compute from the timestamp
+// header, and reject the requests with a timestamp too old to prevent them
+// from being replayed.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
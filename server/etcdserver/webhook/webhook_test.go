@@ -0,0 +1,138 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSenderSignsEvents(t *testing.T) {
+	secret := []byte("secret")
+	events := make(chan Event, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, Sign(secret, r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+		var ev Event
+		assert.NoError(t, json.Unmarshal(body, &ev))
+		events <- ev
+	}))
+	defer srv.Close()
+
+	s := NewSender(zaptest.NewLogger(t), Config{
+		URLs:       []string{srv.URL},
+		Secret:     secret,
+		MemberName: "infra1",
+		MemberID:   "8e9e05c52164694d",
+		ClusterID:  "cdf818194e3a8c32",
+		Host:       "host1",
+	})
+	defer s.Stop()
+	s.Notify(EventTypeWarning, ReasonAlarmRaised, "alarm NOSPACE raised")
+
+	select {
+	case ev := <-events:
+		assert.Equal(t, "v1", ev.APIVersion)
+		assert.Equal(t, "Event", ev.Kind)
+		assert.Equal(t, EventTypeWarning, ev.Type)
+		assert.Equal(t, ReasonAlarmRaised, ev.Reason)
+		assert.Equal(t, "alarm NOSPACE raised", ev.Message)
+		assert.Equal(t, ObjectReference{Kind: involvedObjectKind, Name: "infra1", UID: "8e9e05c52164694d"}, ev.InvolvedObject)
+		assert.Equal(t, EventSource{Component: sourceComponent, Host: "host1"}, ev.Source)
+		assert.Equal(t, "cdf818194e3a8c32", ev.Metadata.Annotations[AnnotationClusterID])
+		assert.Equal(t, "8e9e05c52164694d", ev.Metadata.Annotations[AnnotationMemberID])
+		assert.Equal(t, int32(1), ev.Count)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+}
+
+func TestSenderRetries(t *testing.T) {
+	tcs := []struct {
+		name     string
+		statuses []int
+		attempts int32
+		sent     bool
+	}{
+		{name: "server error", statuses: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK}, attempts: 3, sent: true},
+		{name: "too many requests", statuses: []int{http.StatusTooManyRequests, http.StatusOK}, attempts: 2, sent: true},
+		{name: "out of attempts", statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, attempts: 3},
+		{name: "client error", statuses: []int{http.StatusBadRequest, http.StatusOK}, attempts: 1},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statuses[attempts.Add(1)-1])
+			}))
+			defer srv.Close()
+
+			sent := testutil.ToFloat64(notifications.WithLabelValues(resultSent))
+			failed := testutil.ToFloat64(notifications.WithLabelValues(resultFailed))
+			s := NewSender(zaptest.NewLogger(t), Config{URLs: []string{srv.URL}, MaxAttempts: 3, Backoff: time.Millisecond})
+			defer s.Stop()
+			s.Notify(EventTypeNormal, ReasonLeaderChanged, "elected")
+
+			require.Eventually(t, func() bool {
+				return testutil.ToFloat64(notifications.WithLabelValues(resultSent))+testutil.ToFloat64(notifications.WithLabelValues(resultFailed)) == sent+failed+1
+			}, 10*time.Second, 10*time.Millisecond)
+			assert.Equal(t, tc.attempts, attempts.Load())
+			if tc.sent {
+				assert.InDelta(t, sent+1, testutil.ToFloat64(notifications.WithLabelValues(resultSent)), 0)
+			} else {
+				assert.InDelta(t, failed+1, testutil.ToFloat64(notifications.WithLabelValues(resultFailed)), 0)
+			}
+		})
+	}
+}
+
+func TestSenderDropsWhenQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	dropped := testutil.ToFloat64(notifications.WithLabelValues(resultDropped))
+	s := NewSender(zaptest.NewLogger(t), Config{URLs: []string{srv.URL}, QueueSize: 1})
+	defer s.Stop()
+	// the first event is being posted, the second is queued
+	s.Notify(EventTypeNormal, ReasonLeaderChanged, "1")
+	require.Eventually(t, func() bool { return len(s.queues[0]) == 0 }, 10*time.Second, 10*time.Millisecond)
+	s.Notify(EventTypeNormal, ReasonLeaderChanged, "2")
+	s.Notify(EventTypeNormal, ReasonLeaderChanged, "3")
+	assert.InDelta(t, dropped+1, testutil.ToFloat64(notifications.WithLabelValues(resultDropped)), 0)
+}
+
+func TestSign(t *testing.T) {
+	sig := Sign([]byte("secret"), "1700000000", []byte(`{"kind":"Event"}`))
+	assert.Equal(t, sig, Sign([]byte("secret"), "1700000000", []byte(`{"kind":"Event"}`)))
+	assert.NotEqual(t, sig, Sign([]byte("other"), "1700000000", []byte(`{"kind":"Event"}`)))
+	assert.NotEqual(t, sig, Sign([]byte("secret"), "1700000001", []byte(`{"kind":"Event"}`)))
+	assert.Regexp(t, "^sha256=[0-9a-f]{64}$", sig)
+}
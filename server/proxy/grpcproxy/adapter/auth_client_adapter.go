@@ -44,6 +44,10 @@ func (s *as2ac) Authenticate(ctx context.Context, in *pb.AuthenticateRequest, op
 	return s.as.Authenticate(ctx, in)
 }
 
+func (s *as2ac) AuthTokenRefresh(ctx context.Context, in *pb.AuthTokenRefreshRequest, opts ...grpc.CallOption) (*pb.AuthTokenRefreshResponse, error) {
+	return s.as.AuthTokenRefresh(ctx, in)
+}
+
 func (s *as2ac) RoleAdd(ctx context.Context, in *pb.AuthRoleAddRequest, opts ...grpc.CallOption) (*pb.AuthRoleAddResponse, error) {
 	return s.as.RoleAdd(ctx, in)
 }
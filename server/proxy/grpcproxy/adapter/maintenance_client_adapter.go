@@ -56,6 +56,22 @@ func (s *mts2mtc) Downgrade(ctx context.Context, r *pb.DowngradeRequest, opts ..
 	return s.mts.Downgrade(ctx, r)
 }
 
+func (s *mts2mtc) CompactionBarrier(ctx context.Context, r *pb.CompactionBarrierRequest, opts ...grpc.CallOption) (*pb.CompactionBarrierResponse, error) {
+	return s.mts.CompactionBarrier(ctx, r)
+}
+
+func (s *mts2mtc) ConfigReport(ctx context.Context, r *pb.ConfigReportRequest, opts ...grpc.CallOption) (*pb.ConfigReportResponse, error) {
+	return s.mts.ConfigReport(ctx, r)
+}
+
+func (s *mts2mtc) PeerCertificates(ctx context.Context, r *pb.PeerCertificatesRequest, opts ...grpc.CallOption) (*pb.PeerCertificatesResponse, error) {
+	return s.mts.PeerCertificates(ctx, r)
+}
+
+func (s *mts2mtc) CompactionControl(ctx context.Context, r *pb.CompactionControlRequest, opts ...grpc.CallOption) (*pb.CompactionControlResponse, error) {
+	return s.mts.CompactionControl(ctx, r)
+}
+
 func (s *mts2mtc) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...grpc.CallOption) (pb.Maintenance_SnapshotClient, error) {
 	cs := newPipeStream(ctx, func(ss chanServerStream) error {
 		return s.mts.Snapshot(in, &ss2scServerStream{ss})
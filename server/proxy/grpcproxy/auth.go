@@ -45,6 +45,10 @@ func (ap *AuthProxy) Authenticate(ctx context.Context, r *pb.AuthenticateRequest
 	return ap.authClient.Authenticate(ctx, r)
 }
 
+func (ap *AuthProxy) AuthTokenRefresh(ctx context.Context, r *pb.AuthTokenRefreshRequest) (*pb.AuthTokenRefreshResponse, error) {
+	return ap.authClient.AuthTokenRefresh(ctx, r)
+}
+
 func (ap *AuthProxy) RoleAdd(ctx context.Context, r *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
 	return ap.authClient.RoleAdd(ctx, r)
 }
@@ -183,6 +183,9 @@ func RangeRequestToOp(r *pb.RangeRequest) clientv3.Op {
 	if r.KeysOnly {
 		opts = append(opts, clientv3.WithKeysOnly())
 	}
+	if r.Tombstones {
+		opts = append(opts, clientv3.WithTombstones())
+	}
 	if r.Serializable {
 		opts = append(opts, clientv3.WithSerializable())
 	}
@@ -86,3 +86,19 @@ func (mp *maintenanceProxy) MoveLeader(ctx context.Context, r *pb.MoveLeaderRequ
 func (mp *maintenanceProxy) Downgrade(ctx context.Context, r *pb.DowngradeRequest) (*pb.DowngradeResponse, error) {
 	return mp.maintenanceClient.Downgrade(ctx, r)
 }
+
+func (mp *maintenanceProxy) CompactionBarrier(ctx context.Context, r *pb.CompactionBarrierRequest) (*pb.CompactionBarrierResponse, error) {
+	return mp.maintenanceClient.CompactionBarrier(ctx, r)
+}
+
+func (mp *maintenanceProxy) ConfigReport(ctx context.Context, r *pb.ConfigReportRequest) (*pb.ConfigReportResponse, error) {
+	return mp.maintenanceClient.ConfigReport(ctx, r)
+}
+
+func (mp *maintenanceProxy) PeerCertificates(ctx context.Context, r *pb.PeerCertificatesRequest) (*pb.PeerCertificatesResponse, error) {
+	return mp.maintenanceClient.PeerCertificates(ctx, r)
+}
+
+func (mp *maintenanceProxy) CompactionControl(ctx context.Context, r *pb.CompactionControlRequest) (*pb.CompactionControlResponse, error) {
+	return mp.maintenanceClient.CompactionControl(ctx, r)
+}
@@ -101,8 +101,12 @@ type backend struct {
 	commits int64
 	// openReadTxN is the number of currently open read transactions in the backend
 	openReadTxN int64
+	// openSnapshotViewN is the number of bolt read transactions currently held by snapshots
+	openSnapshotViewN int64
 	// mlock prevents backend database file to be swapped
 	mlock bool
+	// snapshotSpool makes snapshots copy their read view to a temporary file
+	snapshotSpool bool
 
 	mu    sync.RWMutex
 	bopts *bolt.Options
@@ -147,6 +151,10 @@ type BackendConfig struct {
 	UnsafeNoFsync bool `json:"unsafe-no-fsync"`
 	// Mlock prevents backend database file to be swapped
 	Mlock bool
+	// SnapshotSpool makes snapshots copy their bolt read transaction to a
+	// temporary file next to the backend file and close it once copied,
+	// instead of holding it open until the snapshot is fully transmitted.
+	SnapshotSpool bool
 
 	// Hooks are getting executed during lifecycle of Backend's transactions.
 	Hooks Hooks
@@ -204,6 +212,9 @@ func newBackend(bcfg BackendConfig) *backend {
 	if err != nil {
 		bcfg.Logger.Panic("failed to open database", zap.String("path", bcfg.Path), zap.Error(err))
 	}
+	if bcfg.SnapshotSpool {
+		removeSpoolFiles(bcfg.Logger, filepath.Dir(bcfg.Path))
+	}
 
 	// In future, may want to make buffering optional for low-concurrency systems
 	// or dynamically swap between buffered/non-buffered depending on workload.
@@ -214,6 +225,7 @@ func newBackend(bcfg BackendConfig) *backend {
 		batchInterval: bcfg.BatchInterval,
 		batchLimit:    bcfg.BatchLimit,
 		mlock:         bcfg.Mlock,
+		snapshotSpool: bcfg.SnapshotSpool,
 
 		readTx: &readTx{
 			baseReadTx: baseReadTx{
@@ -355,6 +367,7 @@ func (b *backend) Snapshot() Snapshot {
 	if err != nil {
 		b.lg.Fatal("failed to begin tx", zap.Error(err))
 	}
+	view := b.openSnapshotView(tx)
 
 	stopc, donec := make(chan struct{}), make(chan struct{})
 	dbBytes := tx.Size()
@@ -387,7 +400,14 @@ func (b *backend) Snapshot() Snapshot {
 		}
 	}()
 
-	return &snapshot{tx, stopc, donec}
+	if b.snapshotSpool {
+		s, err := newSpooledSnapshot(b.spoolDir(), view, stopc, donec)
+		if err == nil {
+			return s
+		}
+		b.lg.Warn("failed to spool snapshot, streaming it from the read transaction", zap.Error(err))
+	}
+	return &snapshot{view, stopc, donec}
 }
 
 func (b *backend) Hash(ignores func(bucketName, keyName []byte) bool) (uint32, error) {
@@ -695,7 +715,7 @@ func (b *backend) OpenReadTxN() int64 {
 }
 
 type snapshot struct {
-	*bolt.Tx
+	*snapshotView
 	stopc chan struct{}
 	donec chan struct{}
 }
@@ -703,7 +723,7 @@ type snapshot struct {
 func (s *snapshot) Close() error {
 	close(s.stopc)
 	<-s.donec
-	return s.Tx.Rollback()
+	return s.snapshotView.close()
 }
 
 func newBoltLoggerZap(bcfg BackendConfig) bolt.Logger {
@@ -17,6 +17,7 @@ package backend_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -88,6 +89,68 @@ func TestBackendSnapshot(t *testing.T) {
 	newTx.Unlock()
 }
 
+func TestBackendSnapshotSpool(t *testing.T) {
+	bcfg := backend.DefaultBackendConfig(zaptest.NewLogger(t))
+	bcfg.BatchInterval, bcfg.BatchLimit = time.Hour, 10000
+	bcfg.SnapshotSpool = true
+	b, path := betesting.NewTmpBackendFromCfg(t, bcfg)
+	defer betesting.Close(t, b)
+
+	tx := b.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(schema.Test)
+	tx.UnsafePut(schema.Test, []byte("foo"), []byte("bar"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	snap := b.Snapshot()
+	// the read view is released once spooled, before the snapshot is read
+	require.Eventually(t, func() bool { return backend.OpenSnapshotViewsForTest(b) == 0 }, 5*time.Second, 10*time.Millisecond)
+	spools, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.spool"))
+	require.NoError(t, err)
+	require.Len(t, spools, 1)
+
+	// writes after the snapshot are not part of it
+	tx.Lock()
+	tx.UnsafePut(schema.Test, []byte("foo"), []byte("baz"))
+	tx.Unlock()
+	b.ForceCommit()
+
+	f, err := os.CreateTemp(t.TempDir(), "etcd_backend_test")
+	require.NoError(t, err)
+	n, err := snap.WriteTo(f)
+	require.NoError(t, err)
+	require.Equal(t, snap.Size(), n)
+	require.NoError(t, f.Close())
+	require.NoError(t, snap.Close())
+	_, err = os.Stat(spools[0])
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	sbcfg := backend.DefaultBackendConfig(zaptest.NewLogger(t))
+	sbcfg.Path, sbcfg.BatchInterval, sbcfg.BatchLimit = f.Name(), time.Hour, 10000
+	nb := backend.New(sbcfg)
+	defer betesting.Close(t, nb)
+
+	newTx := nb.BatchTx()
+	newTx.Lock()
+	_, vs := newTx.UnsafeRange(schema.Test, []byte("foo"), nil, 0)
+	newTx.Unlock()
+	require.Equal(t, [][]byte{[]byte("bar")}, vs)
+}
+
+func TestBackendSnapshotSpoolCloseBeforeRead(t *testing.T) {
+	bcfg := backend.DefaultBackendConfig(zaptest.NewLogger(t))
+	bcfg.SnapshotSpool = true
+	b, path := betesting.NewTmpBackendFromCfg(t, bcfg)
+	defer betesting.Close(t, b)
+
+	require.NoError(t, b.Snapshot().Close())
+	require.Equal(t, int64(0), backend.OpenSnapshotViewsForTest(b))
+	spools, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.spool"))
+	require.NoError(t, err)
+	require.Empty(t, spools)
+}
+
 func TestBackendBatchIntervalCommit(t *testing.T) {
 	// start backend with super short batch interval so
 	// we do not need to wait long before commit to happen.
@@ -274,7 +274,11 @@ func (t *batchTx) commit(stop bool) {
 		rebalanceSec.Observe(t.tx.Stats().RebalanceTime.Seconds())
 		spillSec.Observe(t.tx.Stats().SpillTime.Seconds())
 		writeSec.Observe(t.tx.Stats().WriteTime.Seconds())
-		commitSec.Observe(time.Since(start).Seconds())
+		took := time.Since(start)
+		commitSec.Observe(took.Seconds())
+		if t.backend.snapshotViewOpen() {
+			commitDuringSnapshotSec.Observe(took.Seconds())
+		}
 		atomic.AddInt64(&t.backend.commits, 1)
 
 		t.pending = 0
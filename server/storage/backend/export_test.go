@@ -14,7 +14,11 @@
 
 package backend
 
-import bolt "go.etcd.io/bbolt"
+import (
+	"sync/atomic"
+
+	bolt "go.etcd.io/bbolt"
+)
 
 func DbFromBackendForTest(b Backend) *bolt.DB {
 	return b.(*backend).db
@@ -27,3 +31,7 @@ func DefragLimitForTest() int {
 func CommitsForTest(b Backend) int64 {
 	return b.(*backend).Commits()
 }
+
+func OpenSnapshotViewsForTest(b Backend) int64 {
+	return atomic.LoadInt64(&b.(*backend).openSnapshotViewN)
+}
@@ -84,6 +84,35 @@ var (
 		Buckets: prometheus.ExponentialBuckets(.01, 2, 17),
 	})
 
+	snapshotViewSec = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "disk",
+		Name:      "backend_snapshot_read_view_duration_seconds",
+		Help:      "The distribution of how long backend snapshots hold their boltdb read transaction open.",
+
+		// lowest bucket start of upper bound 0.01 sec (10 ms) with factor 2
+		// highest bucket start of 0.01 sec * 2^16 == 655.36 sec
+		Buckets: prometheus.ExponentialBuckets(.01, 2, 17),
+	})
+
+	snapshotViews = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "disk",
+		Name:      "backend_snapshot_read_views",
+		Help:      "The number of boltdb read transactions currently held open by backend snapshots.",
+	})
+
+	commitDuringSnapshotSec = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "disk",
+		Name:      "backend_commit_during_snapshot_duration_seconds",
+		Help:      "The latency distributions of commit called by backend while a snapshot holds a boltdb read transaction open.",
+
+		// lowest bucket start of upper bound 0.001 sec (1 ms) with factor 2
+		// highest bucket start of 0.001 sec * 2^13 == 8.192 sec
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+	})
+
 	isDefragActive = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: "etcd",
 		Subsystem: "disk",
@@ -99,5 +128,8 @@ func init() {
 	prometheus.MustRegister(writeSec)
 	prometheus.MustRegister(defragSec)
 	prometheus.MustRegister(snapshotTransferSec)
+	prometheus.MustRegister(snapshotViewSec)
+	prometheus.MustRegister(snapshotViews)
+	prometheus.MustRegister(commitDuringSnapshotSec)
 	prometheus.MustRegister(isDefragActive)
 }
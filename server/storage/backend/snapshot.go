@@ -0,0 +1,207 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// spoolFilePattern is the name pattern of the temporary files snapshots
+	// are spooled to.
+	spoolFilePattern = "snapshot-*.spool"
+
+	// spoolChunkSize is the size of the chunks read from the spool file.
+	spoolChunkSize = 1024 * 1024
+)
+
+var errSnapshotClosed = errors.New("backend: snapshot closed")
+
+// snapshotView is the consistent bolt read transaction of a snapshot.
+//
+// While the view is open bolt cannot reuse the pages freed by later commits,
+// and a commit growing the database beyond the mmapped region waits for the
+// view to be closed before remapping, which stalls the apply loop.
+type snapshotView struct {
+	*bolt.Tx
+	b      *backend
+	opened time.Time
+	once   sync.Once
+}
+
+func (b *backend) openSnapshotView(tx *bolt.Tx) *snapshotView {
+	snapshotViews.Set(float64(atomic.AddInt64(&b.openSnapshotViewN, 1)))
+	return &snapshotView{Tx: tx, b: b, opened: time.Now()}
+}
+
+// close rolls back the read transaction. It is safe to call it more than once.
+func (v *snapshotView) close() (err error) {
+	v.once.Do(func() {
+		err = v.Tx.Rollback()
+		snapshotViewSec.Observe(time.Since(v.opened).Seconds())
+		snapshotViews.Set(float64(atomic.AddInt64(&v.b.openSnapshotViewN, -1)))
+	})
+	return err
+}
+
+func (b *backend) snapshotViewOpen() bool {
+	return atomic.LoadInt64(&b.openSnapshotViewN) > 0
+}
+
+func (b *backend) spoolDir() string {
+	return filepath.Dir(b.db.Path())
+}
+
+// removeSpoolFiles removes the spool files left behind by a previous process.
+func removeSpoolFiles(lg *zap.Logger, dir string) {
+	files, err := filepath.Glob(filepath.Join(dir, spoolFilePattern))
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		if err := os.Remove(f); err != nil {
+			lg.Warn("failed to remove snapshot spool file", zap.String("path", f), zap.Error(err))
+		}
+	}
+}
+
+// spooledSnapshot copies its view to a temporary file in the background and
+// closes the view as soon as the copy completes, so that transmitting the
+// snapshot to a slow receiver does not keep the read transaction open. WriteTo
+// streams the chunks already copied while the copy proceeds.
+type spooledSnapshot struct {
+	f     *os.File
+	size  int64
+	stopc chan struct{}
+	donec chan struct{}
+	// copyc is closed once the view is copied and closed.
+	copyc chan struct{}
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	written int64
+	copied  bool
+	closed  bool
+	err     error
+}
+
+func newSpooledSnapshot(dir string, view *snapshotView, stopc, donec chan struct{}) (*spooledSnapshot, error) {
+	f, err := os.CreateTemp(dir, spoolFilePattern)
+	if err != nil {
+		return nil, err
+	}
+	s := &spooledSnapshot{
+		f:     f,
+		size:  view.Size(),
+		stopc: stopc,
+		donec: donec,
+		copyc: make(chan struct{}),
+	}
+	s.cond = sync.NewCond(&s.mu)
+	go s.copy(view)
+	return s, nil
+}
+
+func (s *spooledSnapshot) copy(view *snapshotView) {
+	defer close(s.copyc)
+	_, err := view.WriteTo(spoolWriter{s})
+	if cerr := view.close(); err == nil {
+		err = cerr
+	}
+	s.mu.Lock()
+	s.copied, s.err = true, err
+	s.cond.Broadcast()
+	s.mu.Unlock()
+}
+
+// spoolWriter appends the data written by the view to the spool file.
+type spoolWriter struct{ s *spooledSnapshot }
+
+func (w spoolWriter) Write(p []byte) (int, error) {
+	s := w.s
+	s.mu.Lock()
+	closed := s.closed
+	s.mu.Unlock()
+	if closed {
+		return 0, errSnapshotClosed
+	}
+	n, err := s.f.Write(p)
+	s.mu.Lock()
+	s.written += int64(n)
+	s.cond.Broadcast()
+	s.mu.Unlock()
+	return n, err
+}
+
+func (s *spooledSnapshot) Size() int64 { return s.size }
+
+func (s *spooledSnapshot) WriteTo(w io.Writer) (n int64, err error) {
+	buf := make([]byte, spoolChunkSize)
+	for {
+		s.mu.Lock()
+		for s.written == n && !s.copied && !s.closed {
+			s.cond.Wait()
+		}
+		written, copied, closed, cerr := s.written, s.copied, s.closed, s.err
+		s.mu.Unlock()
+		if closed {
+			return n, errSnapshotClosed
+		}
+		if written == n && copied {
+			return n, cerr
+		}
+
+		for n < written {
+			rn, rerr := s.f.ReadAt(buf[:min(int64(len(buf)), written-n)], n)
+			if rn == 0 && rerr != nil {
+				return n, rerr
+			}
+			wn, werr := w.Write(buf[:rn])
+			n += int64(wn)
+			if werr != nil {
+				return n, werr
+			}
+		}
+	}
+}
+
+// Close stops the copy if it is still in progress and removes the spool file.
+// Copy errors are returned by WriteTo.
+func (s *spooledSnapshot) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	s.cond.Broadcast()
+	s.mu.Unlock()
+	<-s.copyc
+
+	close(s.stopc)
+	<-s.donec
+
+	err := s.f.Close()
+	if rerr := os.Remove(s.f.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
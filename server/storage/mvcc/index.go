@@ -26,6 +26,7 @@ type index interface {
 	Range(key, end []byte, atRev int64) ([][]byte, []Revision)
 	Revisions(key, end []byte, atRev int64, limit int) ([]Revision, int)
 	CountRevisions(key, end []byte, atRev int64) int
+	Tombstones(key, end []byte, atRev int64, limit int) ([][]byte, []Revision, int)
 	Put(key []byte, rev Revision)
 	Tombstone(key []byte, rev Revision) error
 	Compact(rev int64) map[Revision]struct{}
@@ -155,6 +156,34 @@ func (ti *treeIndex) CountRevisions(key, end []byte, atRev int64) int {
 	return total
 }
 
+// Tombstones returns limited number of the tombstones from key(included) to end(excluded)
+// at the given rev, with their keys. The returned slices are sorted in the order of key,
+// then of revision. There is no limit if limit <= 0. The third return parameter isn't
+// capped by the limit and reflects the total number of tombstones.
+func (ti *treeIndex) Tombstones(key, end []byte, atRev int64, limit int) (keys [][]byte, revs []Revision, total int) {
+	ti.RLock()
+	defer ti.RUnlock()
+
+	f := func(ki *keyIndex) bool {
+		for _, rev := range ki.tombstones(atRev) {
+			if limit <= 0 || len(revs) < limit {
+				keys = append(keys, ki.key)
+				revs = append(revs, rev)
+			}
+			total++
+		}
+		return true
+	}
+	if end == nil {
+		if keyi := ti.keyIndex(&keyIndex{key: key}); keyi != nil {
+			f(keyi)
+		}
+		return keys, revs, total
+	}
+	ti.unsafeVisit(key, end, f)
+	return keys, revs, total
+}
+
 func (ti *treeIndex) Range(key, end []byte, atRev int64) (keys [][]byte, revs []Revision) {
 	ti.RLock()
 	defer ti.RUnlock()
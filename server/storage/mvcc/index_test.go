@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 )
@@ -140,6 +141,42 @@ func TestIndexTombstone(t *testing.T) {
 	}
 }
 
+func TestIndexTombstones(t *testing.T) {
+	ti := newTreeIndex(zaptest.NewLogger(t))
+	ti.Put([]byte("foo"), Revision{Main: 1})
+	ti.Put([]byte("foo1"), Revision{Main: 2})
+	require.NoError(t, ti.Tombstone([]byte("foo"), Revision{Main: 3}))
+	ti.Put([]byte("foo"), Revision{Main: 4})
+	require.NoError(t, ti.Tombstone([]byte("foo1"), Revision{Main: 5}))
+	require.NoError(t, ti.Tombstone([]byte("foo"), Revision{Main: 6}))
+	ti.Put([]byte("foo2"), Revision{Main: 7})
+
+	tests := []struct {
+		key, end []byte
+		atRev    int64
+		limit    int
+
+		wkeys  [][]byte
+		wrevs  []Revision
+		wtotal int
+	}{
+		{[]byte("foo"), nil, 7, 0, [][]byte{[]byte("foo"), []byte("foo")}, []Revision{{Main: 3}, {Main: 6}}, 2},
+		{[]byte("foo2"), nil, 7, 0, nil, nil, 0},
+		{[]byte("foo3"), nil, 7, 0, nil, nil, 0},
+		{[]byte("foo"), []byte("fop"), 7, 0, [][]byte{[]byte("foo"), []byte("foo"), []byte("foo1")}, []Revision{{Main: 3}, {Main: 6}, {Main: 5}}, 3},
+		{[]byte("foo"), []byte("fop"), 5, 0, [][]byte{[]byte("foo"), []byte("foo1")}, []Revision{{Main: 3}, {Main: 5}}, 2},
+		{[]byte("foo"), []byte("fop"), 7, 2, [][]byte{[]byte("foo"), []byte("foo")}, []Revision{{Main: 3}, {Main: 6}}, 3},
+		{[]byte("foo1"), []byte("fop"), 7, 0, [][]byte{[]byte("foo1")}, []Revision{{Main: 5}}, 1},
+		{[]byte("foo"), []byte("fop"), 2, 0, nil, nil, 0},
+	}
+	for i, tt := range tests {
+		keys, revs, total := ti.Tombstones(tt.key, tt.end, tt.atRev, tt.limit)
+		assert.Equalf(t, tt.wkeys, keys, "#%d: keys", i)
+		assert.Equalf(t, tt.wrevs, revs, "#%d: revs", i)
+		assert.Equalf(t, tt.wtotal, total, "#%d: total", i)
+	}
+}
+
 func TestIndexRevision(t *testing.T) {
 	allKeys := [][]byte{[]byte("foo"), []byte("foo1"), []byte("foo2"), []byte("foo2"), []byte("foo1"), []byte("foo")}
 	allRevs := []Revision{{Main: 1}, {Main: 2}, {Main: 3}, {Main: 4}, {Main: 5}, {Main: 6}}
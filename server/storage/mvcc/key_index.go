@@ -209,6 +209,23 @@ func (ki *keyIndex) since(lg *zap.Logger, rev int64) []Revision {
 	return revs
 }
 
+// tombstones returns the revisions of the tombstones of the key, smaller than
+// or equal to the given atRev, in ascending order. The tombstones removed by
+// compaction are not returned.
+func (ki *keyIndex) tombstones(atRev int64) []Revision {
+	var revs []Revision
+	// every generation but the last one ends with a tombstone
+	for _, g := range ki.generations[:len(ki.generations)-1] {
+		if g.isEmpty() {
+			continue
+		}
+		if tomb := g.revs[len(g.revs)-1]; tomb.Main <= atRev {
+			revs = append(revs, tomb)
+		}
+	}
+	return revs
+}
+
 // compact compacts a keyIndex by removing the versions with smaller or equal
 // revision than the given atRev except the largest one.
 // If a generation becomes empty during compaction, it will be removed.
@@ -177,6 +177,40 @@ func TestKeyIndexSince(t *testing.T) {
 	}
 }
 
+func TestKeyIndexTombstones(t *testing.T) {
+	ki := newTestKeyIndex(zaptest.NewLogger(t))
+
+	tests := []struct {
+		rev int64
+
+		wrevs []Revision
+	}{
+		{17, []Revision{{Main: 6}, {Main: 12}, {Main: 16}}},
+		{16, []Revision{{Main: 6}, {Main: 12}, {Main: 16}}},
+		{15, []Revision{{Main: 6}, {Main: 12}}},
+		{12, []Revision{{Main: 6}, {Main: 12}}},
+		{11, []Revision{{Main: 6}}},
+		{6, []Revision{{Main: 6}}},
+		{5, nil},
+	}
+	for i, tt := range tests {
+		revs := ki.tombstones(tt.rev)
+		if !reflect.DeepEqual(revs, tt.wrevs) {
+			t.Errorf("#%d: revs = %+v, want %+v", i, revs, tt.wrevs)
+		}
+	}
+
+	// the tombstones removed by compaction are not returned
+	ki.compact(zaptest.NewLogger(t), 7, make(map[Revision]struct{}))
+	if revs, wrevs := ki.tombstones(17), []Revision{{Main: 12}, {Main: 16}}; !reflect.DeepEqual(revs, wrevs) {
+		t.Errorf("revs = %+v, want %+v", revs, wrevs)
+	}
+	ki.compact(zaptest.NewLogger(t), 12, make(map[Revision]struct{}))
+	if revs, wrevs := ki.tombstones(17), []Revision{{Main: 12}, {Main: 16}}; !reflect.DeepEqual(revs, wrevs) {
+		t.Errorf("revs = %+v, want %+v", revs, wrevs)
+	}
+}
+
 func TestKeyIndexPut(t *testing.T) {
 	ki := &keyIndex{key: []byte("foo")}
 	ki.put(zaptest.NewLogger(t), 5, 0)
@@ -27,6 +27,8 @@ type RangeOptions struct {
 	Limit int64
 	Rev   int64
 	Count bool
+	// Tombstones ranges over the tombstones of the keys instead of the keys.
+	Tombstones bool
 }
 
 type RangeResult struct {
@@ -125,6 +127,22 @@ type KV interface {
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(trace *traceutil.Trace, rev int64) (<-chan struct{}, error)
 
+	// CompactionProgress returns the progress of the scheduled compactions.
+	CompactionProgress() CompactionProgress
+
+	// PauseCompaction pauses the scheduled compactions, between two batches
+	// of deletes, until ResumeCompaction is called. The compactions scheduled
+	// meanwhile wait for it.
+	PauseCompaction()
+
+	// ResumeCompaction resumes the scheduled compactions paused by
+	// PauseCompaction.
+	ResumeCompaction()
+
+	// PrefixKeyCount returns the number of keys under a prefix of
+	// StoreConfig.CountedKeyPrefixes, or false if the prefix is not counted.
+	PrefixKeyCount(prefix string) (int64, bool)
+
 	// Commit commits outstanding txns into the underlying backend.
 	Commit()
 
@@ -47,6 +47,21 @@ var (
 type StoreConfig struct {
 	CompactionBatchLimit    int
 	CompactionSleepInterval time.Duration
+	// WatchSkipIndexBlockSize is the number of revisions summarized by each
+	// block of the skip index of the watchable store, which lets unsynced
+	// watchers skip the blocks without events on their keys. Zero disables
+	// the index.
+	WatchSkipIndexBlockSize int64
+	// PauseWatchSync, if set, pauses the sync of the unsynced watchers of
+	// the watchable store while it returns true.
+	PauseWatchSync func() bool
+	// SkipScheduledCompaction, if set, does not finish the compaction
+	// scheduled but not finished when the store is restored, for a store
+	// only read from, like the one of a member in recovery mode.
+	SkipScheduledCompaction bool
+	// CountedKeyPrefixes are the prefixes the store keeps count of the keys
+	// under, as they are created and deleted.
+	CountedKeyPrefixes []string
 }
 
 type store struct {
@@ -74,6 +89,19 @@ type store struct {
 
 	fifoSched schedule.Scheduler
 
+	// compactionMu protects compactionProgress and compactionResumec.
+	compactionMu       sync.Mutex
+	compactionProgress CompactionProgress
+	// compactionResumec is closed once the paused compactions are resumed,
+	// or nil if they are not paused.
+	compactionResumec chan struct{}
+
+	// prefixKeysMu protects prefixKeys.
+	prefixKeysMu sync.Mutex
+	// prefixKeys are the numbers of keys under the prefixes of
+	// cfg.CountedKeyPrefixes.
+	prefixKeys map[string]int64
+
 	stopc chan struct{}
 
 	lg     *zap.Logger
@@ -384,8 +412,9 @@ func (s *store) restore() error {
 		}
 		s.revMu.Unlock()
 	}
+	s.countPrefixKeys()
 
-	if scheduledCompact <= s.compactMainRev {
+	if scheduledCompact <= s.compactMainRev || s.cfg.SkipScheduledCompaction {
 		scheduledCompact = 0
 	}
 
@@ -25,7 +25,91 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/schema"
 )
 
+// CompactionProgress is the progress of the scheduled compactions of the
+// store.
+type CompactionProgress struct {
+	// Running is set while a scheduled compaction deletes the compacted
+	// revisions from the backend.
+	Running bool
+	// Paused is set while the scheduled compactions are paused.
+	Paused bool
+	// Revision is the revision the running compaction, or the last one,
+	// compacts up to.
+	Revision int64
+	// RevisionsProcessed is the number of revisions processed by the running
+	// compaction, or the last one, out of RevisionsTotal.
+	RevisionsProcessed int64
+	// RevisionsTotal is the number of revisions since the previous compaction.
+	RevisionsTotal int64
+}
+
+func (s *store) CompactionProgress() CompactionProgress {
+	s.compactionMu.Lock()
+	defer s.compactionMu.Unlock()
+	p := s.compactionProgress
+	p.Paused = s.compactionResumec != nil
+	return p
+}
+
+func (s *store) PauseCompaction() {
+	s.compactionMu.Lock()
+	defer s.compactionMu.Unlock()
+	if s.compactionResumec != nil {
+		return
+	}
+	s.compactionResumec = make(chan struct{})
+	compactionPaused.Set(1)
+	s.lg.Info("paused scheduled compactions")
+}
+
+func (s *store) ResumeCompaction() {
+	s.compactionMu.Lock()
+	defer s.compactionMu.Unlock()
+	if s.compactionResumec == nil {
+		return
+	}
+	close(s.compactionResumec)
+	s.compactionResumec = nil
+	compactionPaused.Set(0)
+	s.lg.Info("resumed scheduled compactions")
+}
+
+// waitCompactionResumed waits for the paused compactions to be resumed. It
+// returns false if the store is stopped meanwhile.
+func (s *store) waitCompactionResumed() bool {
+	s.compactionMu.Lock()
+	resumec := s.compactionResumec
+	s.compactionMu.Unlock()
+	if resumec == nil {
+		return true
+	}
+	select {
+	case <-resumec:
+		return true
+	case <-s.stopc:
+		return false
+	}
+}
+
+func (s *store) setCompactionProgress(p CompactionProgress) {
+	s.compactionMu.Lock()
+	defer s.compactionMu.Unlock()
+	s.compactionProgress = p
+	compactionRevisionsProcessed.Set(float64(p.RevisionsProcessed))
+	compactionRevisionsTotal.Set(float64(p.RevisionsTotal))
+}
+
 func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyValueHash, error) {
+	// only the revisions since the previous compaction are counted, the ones
+	// before it being mostly deleted already
+	startRev := max(prevCompactRev, 0)
+	progress := CompactionProgress{Running: true, Revision: compactMainRev, RevisionsTotal: compactMainRev - startRev}
+	s.setCompactionProgress(progress)
+	defer func() {
+		progress.Running = false
+		s.setCompactionProgress(progress)
+	}()
+
 	totalStart := time.Now()
 	keep := s.kvindex.Compact(compactMainRev)
 	indexCompactionPauseMs.Observe(float64(time.Since(totalStart) / time.Millisecond))
@@ -45,6 +129,9 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 	for {
 		var rev Revision
 
+		if !s.waitCompactionResumed() {
+			return KeyValueHash{}, fmt.Errorf("interrupted due to stop signal")
+		}
 		start := time.Now()
 
 		tx := s.b.BatchTx()
@@ -66,6 +153,7 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 			tx.Unlock()
 			dbCompactionPauseMs.Observe(float64(time.Since(start) / time.Millisecond))
 			// gofail: var compactAfterSetFinishedCompact struct{}
+			progress.RevisionsProcessed = progress.RevisionsTotal
 			hash := h.Hash()
 			size, sizeInUse := s.b.Size(), s.b.SizeInUse()
 			s.lg.Info(
@@ -82,6 +170,8 @@ func (s *store) scheduleCompaction(compactMainRev, prevCompactRev int64) (KeyVal
 		}
 
 		tx.Unlock()
+		progress.RevisionsProcessed = min(max(rev.Main-startRev, 0), progress.RevisionsTotal)
+		s.setCompactionProgress(progress)
 		// update last
 		last = RevToBytes(Revision{Main: rev.Main, Sub: rev.Sub + 1}, last)
 		// Immediately commit the compaction deletes instead of letting them accumulate in the write buffer
@@ -147,3 +147,43 @@ func TestCompactAllAndRestore(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestPauseResumeCompaction(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{})
+	defer cleanup(s, b)
+
+	for i := 0; i < 10; i++ {
+		s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	}
+	rev := s.Rev()
+
+	s.PauseCompaction()
+	if p := s.CompactionProgress(); !p.Paused {
+		t.Fatalf("progress = %+v, want paused", p)
+	}
+	done, err := s.Compact(traceutil.TODO(), rev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+		t.Fatal("paused compaction finished")
+	case <-time.After(100 * time.Millisecond):
+	}
+	want := CompactionProgress{Running: true, Paused: true, Revision: rev, RevisionsTotal: rev}
+	if p := s.CompactionProgress(); p != want {
+		t.Errorf("progress = %+v, want %+v", p, want)
+	}
+
+	s.ResumeCompaction()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for compaction to finish")
+	}
+	want = CompactionProgress{Revision: rev, RevisionsProcessed: rev, RevisionsTotal: rev}
+	if p := s.CompactionProgress(); p != want {
+		t.Errorf("progress = %+v, want %+v", p, want)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+func (s *store) PrefixKeyCount(prefix string) (int64, bool) {
+	s.prefixKeysMu.Lock()
+	defer s.prefixKeysMu.Unlock()
+	n, ok := s.prefixKeys[prefix]
+	return n, ok
+}
+
+// countPrefixKeys counts the keys under the prefixes of
+// StoreConfig.CountedKeyPrefixes at the current revision.
+func (s *store) countPrefixKeys() {
+	if len(s.cfg.CountedKeyPrefixes) == 0 {
+		return
+	}
+	counts := make(map[string]int64, len(s.cfg.CountedKeyPrefixes))
+	for _, prefix := range s.cfg.CountedKeyPrefixes {
+		counts[prefix] = int64(s.kvindex.CountRevisions([]byte(prefix), prefixRangeEnd(prefix), s.currentRev))
+	}
+	s.prefixKeysMu.Lock()
+	s.prefixKeys = counts
+	s.prefixKeysMu.Unlock()
+}
+
+// updatePrefixKeyCounts updates the numbers of keys under the counted
+// prefixes with the keys created and deleted by the changes of a txn.
+func (s *store) updatePrefixKeyCounts(changes []mvccpb.KeyValue) {
+	if len(s.cfg.CountedKeyPrefixes) == 0 {
+		return
+	}
+	s.prefixKeysMu.Lock()
+	defer s.prefixKeysMu.Unlock()
+	for _, kv := range changes {
+		var delta int64
+		switch kv.Version {
+		case 0:
+			// tombstone
+			delta = -1
+		case 1:
+			delta = 1
+		default:
+			continue
+		}
+		for prefix := range s.prefixKeys {
+			if strings.HasPrefix(string(kv.Key), prefix) {
+				s.prefixKeys[prefix] += delta
+			}
+		}
+	}
+}
+
+// prefixRangeEnd returns the end of the range of the keys with the given
+// prefix.
+func prefixRangeEnd(prefix string) []byte {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// the prefix is empty or all 0xff bytes, the range goes to the end of the keys
+	return []byte{}
+}
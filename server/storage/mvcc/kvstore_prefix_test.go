@@ -0,0 +1,68 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/pkg/v3/traceutil"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+)
+
+func TestStorePrefixKeyCount(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	defer b.Close()
+	cfg := StoreConfig{CountedKeyPrefixes: []string{"a/", "a/b/", "\xff", ""}}
+	s := NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+
+	s.Put([]byte("a/1"), []byte("v"), lease.NoLease)
+	s.Put([]byte("a/b/1"), []byte("v"), lease.NoLease)
+	s.Put([]byte("a/b/2"), []byte("v"), lease.NoLease)
+	s.Put([]byte("a/b/2"), []byte("v2"), lease.NoLease)
+	s.Put([]byte("\xff\xff"), []byte("v"), lease.NoLease)
+	s.Put([]byte("c"), []byte("v"), lease.NoLease)
+	s.DeleteRange([]byte("a/b/1"), nil)
+	s.DeleteRange([]byte("nokey"), nil)
+
+	// a key deleted and created again in the same txn is still counted once
+	txn := s.Write(traceutil.TODO())
+	txn.DeleteRange([]byte("a/1"), nil)
+	txn.Put([]byte("a/1"), []byte("v"), lease.NoLease)
+	txn.Put([]byte("a/2"), []byte("v"), lease.NoLease)
+	txn.End()
+
+	want := map[string]int64{"a/": 3, "a/b/": 1, "\xff": 1, "": 5}
+	check := func(s *store) {
+		t.Helper()
+		for prefix, n := range want {
+			if got, ok := s.PrefixKeyCount(prefix); !ok || got != n {
+				t.Errorf("PrefixKeyCount(%q) = %d, %v, want %d, true", prefix, got, ok, n)
+			}
+		}
+		if _, ok := s.PrefixKeyCount("c"); ok {
+			t.Error("PrefixKeyCount(\"c\") counted, want not counted")
+		}
+	}
+	check(s)
+	s.Close()
+
+	// the counts are restored with the store
+	s = NewStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, cfg)
+	defer s.Close()
+	check(s)
+}
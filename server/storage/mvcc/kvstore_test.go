@@ -1040,6 +1040,10 @@ func (i *fakeIndex) CountRevisions(key, end []byte, atRev int64) int {
 	return len(rev)
 }
 
+func (i *fakeIndex) Tombstones(key, end []byte, atRev int64, limit int) ([][]byte, []Revision, int) {
+	return nil, nil, 0
+}
+
 func (i *fakeIndex) Get(key []byte, atRev int64) (rev, created Revision, ver int64, err error) {
 	i.Recorder.Record(testutil.Action{Name: "get", Params: []any{key, atRev}})
 	r := <-i.indexGetRespc
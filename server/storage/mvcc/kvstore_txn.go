@@ -80,6 +80,9 @@ func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev
 	if rev < tr.s.compactMainRev {
 		return &RangeResult{KVs: nil, Count: -1, Rev: 0}, ErrCompacted
 	}
+	if ro.Tombstones {
+		return tr.rangeTombstones(key, end, curRev, rev, ro)
+	}
 	if ro.Count {
 		total := tr.s.kvindex.CountRevisions(key, end, rev)
 		tr.trace.Step("count revisions from in-memory index tree")
@@ -131,6 +134,22 @@ func (tr *storeTxnCommon) rangeKeys(ctx context.Context, key, end []byte, curRev
 	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
 }
 
+// rangeTombstones returns the tombstones of the keys at rev as key-values
+// holding only the key and, as mod revision, the revision of the tombstone.
+// They are read from the in-memory index, without reading the backend.
+func (tr *storeTxnCommon) rangeTombstones(key, end []byte, curRev, rev int64, ro RangeOptions) (*RangeResult, error) {
+	keys, revs, total := tr.s.kvindex.Tombstones(key, end, rev, int(ro.Limit))
+	tr.trace.Step("range tombstones from in-memory index tree")
+	if ro.Count {
+		return &RangeResult{KVs: nil, Count: total, Rev: curRev}, nil
+	}
+	kvs := make([]mvccpb.KeyValue, len(revs))
+	for i := range revs {
+		kvs[i] = mvccpb.KeyValue{Key: keys[i], ModRevision: revs[i].Main}
+	}
+	return &RangeResult{KVs: kvs, Count: total, Rev: curRev}, nil
+}
+
 func (tr *storeTxnRead) End() {
 	tr.tx.RUnlock() // RUnlock signals the end of concurrentReadTx.
 	tr.s.mu.RUnlock()
@@ -182,6 +201,7 @@ func (tw *storeTxnWrite) Put(key, value []byte, lease lease.LeaseID) int64 {
 func (tw *storeTxnWrite) End() {
 	// only update index if the txn modifies the mvcc state.
 	if len(tw.changes) != 0 {
+		tw.s.updatePrefixKeyCounts(tw.changes)
 		// hold revMu lock to prevent new read txns from opening until writeback.
 		tw.s.revMu.Lock()
 		tw.s.currentRev++
@@ -111,6 +111,15 @@ var (
 		},
 	)
 
+	watchSkippedRevisionsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "watch_skipped_revisions_total",
+			Help:      "Total number of revisions not read from the backend when syncing unsynced watchers, skipped with the watch skip index.",
+		},
+	)
+
 	indexCompactionPauseMs = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "etcd_debugging",
@@ -168,6 +177,33 @@ var (
 		},
 	)
 
+	compactionRevisionsProcessed = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd",
+			Subsystem: "mvcc",
+			Name:      "compaction_revisions_processed",
+			Help:      "The number of revisions processed by the running compaction, or the last one.",
+		},
+	)
+
+	compactionRevisionsTotal = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd",
+			Subsystem: "mvcc",
+			Name:      "compaction_revisions_total",
+			Help:      "The number of revisions since the previous compaction to process by the running compaction, or the last one.",
+		},
+	)
+
+	compactionPaused = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd",
+			Subsystem: "mvcc",
+			Name:      "compaction_paused",
+			Help:      "Whether the scheduled compactions are paused (1) or not (0).",
+		},
+	)
+
 	dbTotalSize = prometheus.NewGaugeFunc(
 		prometheus.GaugeOpts{
 			Namespace: "etcd",
@@ -297,11 +333,15 @@ func init() {
 	prometheus.MustRegister(slowWatcherGauge)
 	prometheus.MustRegister(totalEventsCounter)
 	prometheus.MustRegister(pendingEventsGauge)
+	prometheus.MustRegister(watchSkippedRevisionsCounter)
 	prometheus.MustRegister(indexCompactionPauseMs)
 	prometheus.MustRegister(dbCompactionPauseMs)
 	prometheus.MustRegister(dbCompactionTotalMs)
 	prometheus.MustRegister(dbCompactionLast)
 	prometheus.MustRegister(dbCompactionKeysCounter)
+	prometheus.MustRegister(compactionRevisionsProcessed)
+	prometheus.MustRegister(compactionRevisionsTotal)
+	prometheus.MustRegister(compactionPaused)
 	prometheus.MustRegister(dbTotalSize)
 	prometheus.MustRegister(dbTotalSizeInUse)
 	prometheus.MustRegister(dbOpenReadTxN)
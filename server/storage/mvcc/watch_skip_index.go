@@ -0,0 +1,243 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"slices"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/pkg/v3/adt"
+)
+
+const (
+	// skipBlockBloomWords is the size of the bloom filter of a block, in
+	// 64 bit words.
+	skipBlockBloomWords = 64
+	// skipBlockBloomHashes is the number of bits set in the bloom filter of
+	// a block for each key.
+	skipBlockBloomHashes = 3
+	// maxSkipBlocks bounds the memory of the index when the store is not
+	// compacted. The oldest blocks are dropped beyond it.
+	maxSkipBlocks = 16 * 1024
+)
+
+// revRange is the range of main revisions [from, to).
+type revRange struct {
+	from, to int64
+}
+
+// watchSkipIndex is a sparse index over the main revisions of the store. It
+// summarizes the keys written in each block of blockSize revisions, so that
+// unsynced watchers catching up from an old revision only read the blocks
+// that may hold events on the keys they watch from the backend, instead of
+// all the revisions since their start revision.
+//
+// The index only covers the revisions written since the store was opened or
+// restored; the revisions before are always read.
+type watchSkipIndex struct {
+	blockSize int64
+	// first is the first revision covered by the index.
+	first int64
+	// next is the next revision expected to be added.
+	next int64
+	// blocks[i] summarizes the revisions of the block number base+i, or is
+	// nil if none of them was written since first.
+	base   int64
+	blocks []*skipBlock
+}
+
+// skipBlock summarizes the keys written in a block of revisions: its
+// smallest and largest keys, and a bloom filter of the keys and of their
+// prefixes ending with '/'.
+type skipBlock struct {
+	minKey, maxKey []byte
+	bloom          [skipBlockBloomWords]uint64
+}
+
+func newWatchSkipIndex(blockSize, rev int64) *watchSkipIndex {
+	x := &watchSkipIndex{blockSize: blockSize}
+	x.reset(rev)
+	return x
+}
+
+// reset clears the index, which covers the revisions after rev from now on.
+func (x *watchSkipIndex) reset(rev int64) {
+	x.first, x.next = rev+1, rev+1
+	x.base, x.blocks = (rev+1)/x.blockSize, nil
+}
+
+// add records the events written at rev.
+func (x *watchSkipIndex) add(rev int64, evs []mvccpb.Event) {
+	if rev != x.next {
+		// a revision was written without being added to the index
+		x.reset(rev - 1)
+	}
+	x.next = rev + 1
+
+	n := rev/x.blockSize - x.base
+	for int64(len(x.blocks)) <= n {
+		x.blocks = append(x.blocks, nil)
+	}
+	b := x.blocks[n]
+	if b == nil {
+		b = &skipBlock{}
+		x.blocks[n] = b
+	}
+	for _, ev := range evs {
+		b.add(ev.Kv.Key)
+	}
+	if len(x.blocks) > maxSkipBlocks {
+		x.drop(len(x.blocks) - maxSkipBlocks)
+	}
+}
+
+// compact drops the blocks holding only revisions before compactRev.
+func (x *watchSkipIndex) compact(compactRev int64) {
+	n := 0
+	for n < len(x.blocks) && (x.base+int64(n)+1)*x.blockSize <= compactRev {
+		n++
+	}
+	x.drop(n)
+}
+
+func (x *watchSkipIndex) drop(n int) {
+	if n == 0 {
+		return
+	}
+	x.blocks = slices.Clone(x.blocks[n:])
+	x.base += int64(n)
+}
+
+// ranges returns the revision ranges in [minRev, maxRev) that may hold
+// events on the keys watched by wg, in ascending order. It returns the single
+// range [minRev, maxRev) if no revision can be skipped.
+func (x *watchSkipIndex) ranges(wg *watcherGroup, minRev, maxRev int64) []revRange {
+	var rs []revRange
+	for from := minRev; from < maxRev; {
+		n := from / x.blockSize
+		to := min((n+1)*x.blockSize, maxRev)
+		if x.needed(wg, n, from, to) {
+			if len(rs) > 0 && rs[len(rs)-1].to == from {
+				rs[len(rs)-1].to = to
+			} else {
+				rs = append(rs, revRange{from: from, to: to})
+			}
+		}
+		from = to
+	}
+	return rs
+}
+
+// needed reports whether the revisions [from, to) of the block number n may
+// hold events on the keys watched by wg.
+func (x *watchSkipIndex) needed(wg *watcherGroup, n, from, to int64) bool {
+	if from < x.first || n < x.base || n-x.base >= int64(len(x.blocks)) || to > x.next {
+		// the revisions are not covered by the index
+		return true
+	}
+	b := x.blocks[n-x.base]
+	if b == nil {
+		return false
+	}
+	return b.matches(wg)
+}
+
+func (b *skipBlock) add(key []byte) {
+	if b.minKey == nil || bytes.Compare(key, b.minKey) < 0 {
+		b.minKey = bytes.Clone(key)
+	}
+	if b.maxKey == nil || bytes.Compare(key, b.maxKey) > 0 {
+		b.maxKey = bytes.Clone(key)
+	}
+	// the FNV-1a hash of each prefix ending with '/' is the state of the
+	// hash of the key after its last byte
+	h := fnvOffset64
+	for _, c := range key {
+		h = (h ^ uint64(c)) * fnvPrime64
+		if c == '/' {
+			b.set(h)
+		}
+	}
+	b.set(h)
+}
+
+func (b *skipBlock) set(h uint64) {
+	for i := uint64(0); i < skipBlockBloomHashes; i++ {
+		bit := bloomBit(h, i)
+		b.bloom[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (b *skipBlock) has(key string) bool {
+	h := fnvOffset64
+	for i := 0; i < len(key); i++ {
+		h = (h ^ uint64(key[i])) * fnvPrime64
+	}
+	for i := uint64(0); i < skipBlockBloomHashes; i++ {
+		bit := bloomBit(h, i)
+		if b.bloom[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether the block may hold events on the keys watched by
+// wg.
+func (b *skipBlock) matches(wg *watcherGroup) bool {
+	for key := range wg.keyWatchers {
+		if key >= string(b.minKey) && key <= string(b.maxKey) && b.has(key) {
+			return true
+		}
+	}
+	matched := false
+	wg.ranges.Visit(adt.NewStringAffineInterval(string(b.minKey), string(b.maxKey)+"\x00"), func(iv *adt.IntervalValue) bool {
+		key := string(iv.Ivl.Begin.(adt.StringAffineComparable))
+		end := string(iv.Ivl.End.(adt.StringAffineComparable))
+		// the keys of the block under a prefix ending with '/' are in the
+		// bloom filter
+		if len(key) > 0 && key[len(key)-1] == '/' && end == prefixEnd(key) && !b.has(key) {
+			return true
+		}
+		matched = true
+		return false
+	})
+	return matched
+}
+
+const (
+	fnvOffset64 = uint64(14695981039346656037)
+	fnvPrime64  = uint64(1099511628211)
+)
+
+// bloomBit returns the i-th bit of the bloom filter set for the hash h,
+// derived from the two halves of the hash.
+func bloomBit(h, i uint64) uint64 {
+	return (h + i*(h>>32|1)) % (skipBlockBloomWords * 64)
+}
+
+// prefixEnd returns the end of the range of the keys with the given prefix.
+func prefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	// the prefix is all 0xff bytes, the range goes to the end of the keys
+	return ""
+}
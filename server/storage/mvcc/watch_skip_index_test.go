@@ -0,0 +1,128 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/lease"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+)
+
+func TestWatchSkipIndexRanges(t *testing.T) {
+	// revisions 1-40 write "a/<rev>", except the revisions 10 and 27
+	// writing "foo" and the revision 33 writing "pre/x"
+	x := newWatchSkipIndex(4, 0)
+	for rev := int64(1); rev <= 40; rev++ {
+		key := fmt.Sprintf("a/%d", rev)
+		switch rev {
+		case 10, 27:
+			key = "foo"
+		case 33:
+			key = "pre/x"
+		}
+		x.add(rev, []mvccpb.Event{{Kv: &mvccpb.KeyValue{Key: []byte(key)}}})
+	}
+
+	tcs := []struct {
+		name           string
+		key, end       string
+		minRev, maxRev int64
+		want           []revRange
+	}{
+		{name: "key", key: "foo", minRev: 1, maxRev: 41, want: []revRange{{8, 12}, {24, 28}}},
+		{name: "key from the middle of a block", key: "foo", minRev: 9, maxRev: 41, want: []revRange{{9, 12}, {24, 28}}},
+		{name: "prefix", key: "pre/", end: "pre0", minRev: 1, maxRev: 41, want: []revRange{{32, 36}}},
+		{name: "unwritten prefix", key: "b/", end: "b0", minRev: 1, maxRev: 41},
+		{name: "range", key: "a/", end: "b", minRev: 1, maxRev: 41, want: []revRange{{1, 41}}},
+		{name: "partial last block", key: "foo", minRev: 1, maxRev: 39, want: []revRange{{8, 12}, {24, 28}}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			wg := newWatcherGroup()
+			w := &watcher{key: []byte(tc.key), minRev: tc.minRev}
+			if tc.end != "" {
+				w.end = []byte(tc.end)
+			}
+			wg.add(w)
+			assert.Equal(t, tc.want, x.ranges(&wg, tc.minRev, tc.maxRev))
+		})
+	}
+
+	wg := newWatcherGroup()
+	wg.add(&watcher{key: []byte("foo")})
+	// the revisions written before the index was created are always read
+	x.reset(20)
+	for rev := int64(21); rev <= 30; rev++ {
+		x.add(rev, []mvccpb.Event{{Kv: &mvccpb.KeyValue{Key: []byte("a")}}})
+	}
+	assert.Equal(t, []revRange{{1, 24}}, x.ranges(&wg, 1, 31))
+	// so are the revisions after a revision missing from the index
+	x.add(35, []mvccpb.Event{{Kv: &mvccpb.KeyValue{Key: []byte("a")}}})
+	assert.Equal(t, []revRange{{1, 36}}, x.ranges(&wg, 1, 36))
+	// and the compacted blocks
+	x.reset(0)
+	for rev := int64(1); rev <= 20; rev++ {
+		x.add(rev, []mvccpb.Event{{Kv: &mvccpb.KeyValue{Key: []byte("a")}}})
+	}
+	x.compact(10)
+	assert.Equal(t, []revRange{{1, 8}}, x.ranges(&wg, 1, 21))
+}
+
+func TestWatchSkipIndexSyncWatchers(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	s := newWatchableStore(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{WatchSkipIndexBlockSize: 8})
+	defer cleanup(s, b)
+
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("other/%d", i)
+		switch i {
+		case 30:
+			key = "foo"
+		case 70:
+			key = "pre/x"
+		}
+		s.Put([]byte(key), []byte("v"), lease.NoLease)
+	}
+	s.DeleteRange([]byte("foo"), nil)
+
+	w := s.NewWatchStream()
+	defer w.Close()
+	fooID, err := w.Watch(0, []byte("foo"), nil, 1)
+	require.NoError(t, err)
+	preID, err := w.Watch(0, []byte("pre/"), []byte("pre0"), 1)
+	require.NoError(t, err)
+
+	skipped := testutil.ToFloat64(watchSkippedRevisionsCounter)
+	s.syncWatchers(nil)
+	assert.Greater(t, testutil.ToFloat64(watchSkippedRevisionsCounter), skipped)
+	assert.Equal(t, 0, s.unsynced.size())
+
+	got := make(map[WatchID][]int64)
+	for range 2 {
+		resp := <-w.Chan()
+		for _, ev := range resp.Events {
+			got[resp.WatchID] = append(got[resp.WatchID], ev.Kv.ModRevision)
+		}
+	}
+	assert.Equal(t, map[WatchID][]int64{fooID: {32, 102}, preID: {72}}, got)
+}
@@ -66,6 +66,14 @@ type watchableStore struct {
 	// The key of the map is the key that the watcher watches on.
 	synced watcherGroup
 
+	// skipIndex summarizes the keys written per block of revisions for the
+	// unsynced watchers, if enabled.
+	skipIndex *watchSkipIndex
+
+	// pauseSync pauses the sync of the unsynced watchers while it returns
+	// true, if set.
+	pauseSync func() bool
+
 	stopc chan struct{}
 	wg    sync.WaitGroup
 }
@@ -89,14 +97,18 @@ func newWatchableStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, cfg S
 		lg = zap.NewNop()
 	}
 	s := &watchableStore{
-		store:    NewStore(lg, b, le, cfg),
-		victimc:  make(chan struct{}, 1),
-		unsynced: newWatcherGroup(),
-		synced:   newWatcherGroup(),
-		stopc:    make(chan struct{}),
+		store:     NewStore(lg, b, le, cfg),
+		victimc:   make(chan struct{}, 1),
+		unsynced:  newWatcherGroup(),
+		synced:    newWatcherGroup(),
+		pauseSync: cfg.PauseWatchSync,
+		stopc:     make(chan struct{}),
 	}
 	s.store.ReadView = &readView{s}
 	s.store.WriteView = &writeView{s}
+	if cfg.WatchSkipIndexBlockSize > 0 {
+		s.skipIndex = newWatchSkipIndex(cfg.WatchSkipIndexBlockSize, s.store.currentRev)
+	}
 	if s.le != nil {
 		// use this store as the deleter so revokes trigger watch events
 		s.le.SetRangeDeleter(func() lease.TxnDelete { return s.Write(traceutil.TODO()) })
@@ -211,6 +223,9 @@ func (s *watchableStore) Restore(b backend.Backend) error {
 		s.unsynced.add(wa)
 	}
 	s.synced = newWatcherGroup()
+	if s.skipIndex != nil {
+		s.skipIndex.reset(s.store.currentRev)
+	}
 	return nil
 }
 
@@ -230,7 +245,7 @@ func (s *watchableStore) syncWatchersLoop() {
 		s.mu.RUnlock()
 
 		unsyncedWatchers := 0
-		if lastUnsyncedWatchers > 0 {
+		if lastUnsyncedWatchers > 0 && (s.pauseSync == nil || !s.pauseSync()) {
 			unsyncedWatchers, evs = s.syncWatchers(evs)
 		}
 		syncDuration := time.Since(st)
@@ -357,7 +372,7 @@ func (s *watchableStore) syncWatchers(evs []mvccpb.Event) (int, []mvccpb.Event)
 	compactionRev := s.store.compactMainRev
 
 	wg, minRev := s.unsynced.choose(maxWatchersPerSync, curRev, compactionRev)
-	evs = rangeEventsWithReuse(s.store.lg, s.store.b, evs, minRev, curRev+1)
+	evs, reuse := s.rangeWatchEvents(wg, evs, minRev, curRev+1, compactionRev)
 
 	victims := make(watcherBatch)
 	wb := newWatcherBatch(wg, evs)
@@ -406,9 +421,36 @@ func (s *watchableStore) syncWatchers(evs []mvccpb.Event) (int, []mvccpb.Event)
 	}
 	slowWatcherGauge.Set(float64(s.unsynced.size() + vsz))
 
+	if !reuse {
+		evs = nil
+	}
 	return s.unsynced.size(), evs
 }
 
+// rangeWatchEvents returns the events in range [minRev, maxRev) the watchers
+// of wg may receive. The revisions the skip index tells the watchers are not
+// interested in are not read, in which case the events cannot be reused by the
+// next sync and reuse is false.
+func (s *watchableStore) rangeWatchEvents(wg *watcherGroup, evs []mvccpb.Event, minRev, maxRev, compactionRev int64) (_ []mvccpb.Event, reuse bool) {
+	if s.skipIndex == nil || minRev >= maxRev {
+		return rangeEventsWithReuse(s.store.lg, s.store.b, evs, minRev, maxRev), true
+	}
+	s.skipIndex.compact(compactionRev)
+	rs := s.skipIndex.ranges(wg, minRev, maxRev)
+	if len(rs) == 1 && rs[0] == (revRange{from: minRev, to: maxRev}) {
+		return rangeEventsWithReuse(s.store.lg, s.store.b, evs, minRev, maxRev), true
+	}
+
+	skipped := maxRev - minRev
+	evs = nil
+	for _, r := range rs {
+		evs = append(evs, rangeEvents(s.store.lg, s.store.b, r.from, r.to)...)
+		skipped -= r.to - r.from
+	}
+	watchSkippedRevisionsCounter.Add(float64(skipped))
+	return evs, false
+}
+
 // rangeEventsWithReuse returns events in range [minRev, maxRev), while reusing already provided events.
 func rangeEventsWithReuse(lg *zap.Logger, b backend.Backend, evs []mvccpb.Event, minRev, maxRev int64) []mvccpb.Event {
 	if len(evs) == 0 {
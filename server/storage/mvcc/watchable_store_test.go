@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -335,6 +336,36 @@ func TestSyncWatchers(t *testing.T) {
 	}
 }
 
+func TestSyncWatchersPaused(t *testing.T) {
+	b, _ := betesting.NewDefaultTmpBackend(t)
+	var paused atomic.Bool
+	paused.Store(true)
+	s := New(zaptest.NewLogger(t), b, &lease.FakeLessor{}, StoreConfig{PauseWatchSync: paused.Load})
+	defer cleanup(s, b)
+
+	testKey := []byte("foo")
+	s.Put(testKey, []byte("bar"), lease.NoLease)
+	w := s.NewWatchStream()
+	defer w.Close()
+	_, err := w.Watch(0, testKey, nil, 1)
+	require.NoError(t, err)
+
+	select {
+	case resp := <-w.Chan():
+		t.Fatalf("unexpected response %+v while the sync is paused", resp)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	paused.Store(false)
+	select {
+	case resp := <-w.Chan():
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, testKey, resp.Events[0].Kv.Key)
+	case <-time.After(5 * time.Second):
+		t.Fatal("failed to receive the event after the sync is resumed")
+	}
+}
+
 func TestRangeEvents(t *testing.T) {
 	b, _ := betesting.NewDefaultTmpBackend(t)
 	lg := zaptest.NewLogger(t)
@@ -174,3 +174,18 @@ func costTxn(r *pb.TxnRequest) int {
 func (b *BackendQuota) Remaining() int64 {
 	return b.maxBackendBytes - b.be.Size()
 }
+
+// BackendQuotaUsage is the usage of a BackendQuota.
+type BackendQuotaUsage struct {
+	// Size is the size of the backend database file, in bytes.
+	Size int64
+	// SizeInUse is the size of the backend database actually in use, in bytes.
+	SizeInUse int64
+	// Quota is the maximum size of the backend database, in bytes.
+	Quota int64
+}
+
+// Usage returns the current usage of the quota.
+func (b *BackendQuota) Usage() BackendQuotaUsage {
+	return BackendQuotaUsage{Size: b.be.Size(), SizeInUse: b.be.SizeInUse(), Quota: b.maxBackendBytes}
+}
@@ -29,6 +29,8 @@ var (
 
 	clusterBucketName = []byte("cluster")
 
+	compactionBarrierBucketName = []byte("compactionBarrier")
+
 	membersBucketName        = []byte("members")
 	membersRemovedBucketName = []byte("members_removed")
 
@@ -46,6 +48,8 @@ var (
 	Alarm   = backend.Bucket(bucket{id: 4, name: alarmBucketName, safeRangeBucket: false})
 	Cluster = backend.Bucket(bucket{id: 5, name: clusterBucketName, safeRangeBucket: false})
 
+	CompactionBarrier = backend.Bucket(bucket{id: 6, name: compactionBarrierBucketName, safeRangeBucket: false})
+
 	Members        = backend.Bucket(bucket{id: 10, name: membersBucketName, safeRangeBucket: false})
 	MembersRemoved = backend.Bucket(bucket{id: 11, name: membersRemovedBucketName, safeRangeBucket: false})
 
@@ -55,7 +59,7 @@ var (
 
 	Test = backend.Bucket(bucket{id: 100, name: testBucketName, safeRangeBucket: false})
 
-	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
+	AllBuckets = []backend.Bucket{Key, Meta, Lease, Alarm, Cluster, CompactionBarrier, Members, MembersRemoved, Auth, AuthUsers, AuthRoles}
 )
 
 type bucket struct {
@@ -0,0 +1,55 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+)
+
+// UnsafeCreateCompactionBarrierBucket creates the bucket mapping the ID of the
+// lease holding a compaction barrier to the revision the compactions are not
+// allowed to advance past.
+func UnsafeCreateCompactionBarrierBucket(tx backend.UnsafeWriter) {
+	tx.UnsafeCreateBucket(CompactionBarrier)
+}
+
+func UnsafePutCompactionBarrier(tx backend.UnsafeWriter, leaseID int64, rev int64) {
+	val := make([]byte, 8)
+	binary.BigEndian.PutUint64(val, uint64(rev))
+	tx.UnsafePut(CompactionBarrier, leaseIDToBytes(leaseID), val)
+}
+
+func UnsafeDeleteCompactionBarrier(tx backend.UnsafeWriter, leaseID int64) {
+	tx.UnsafeDelete(CompactionBarrier, leaseIDToBytes(leaseID))
+}
+
+// MustUnsafeGetAllCompactionBarriers returns the barrier revisions by lease ID.
+func MustUnsafeGetAllCompactionBarriers(tx backend.UnsafeReader) map[int64]int64 {
+	barriers := make(map[int64]int64)
+	err := tx.UnsafeForEach(CompactionBarrier, func(k, v []byte) error {
+		if len(v) != 8 {
+			return fmt.Errorf("compaction barrier revision must be 8-byte; lease ID=%016x", bytesToLeaseID(k))
+		}
+		barriers[bytesToLeaseID(k)] = int64(binary.BigEndian.Uint64(v))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return barriers
+}
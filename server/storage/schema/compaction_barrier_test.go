@@ -0,0 +1,86 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/storage/backend"
+	betesting "go.etcd.io/etcd/server/v3/storage/backend/testing"
+)
+
+func TestCompactionBarrierBackend(t *testing.T) {
+	tcs := []struct {
+		name  string
+		setup func(tx backend.UnsafeWriter)
+		want  map[int64]int64
+	}{
+		{
+			name:  "Empty by default",
+			setup: func(tx backend.UnsafeWriter) {},
+			want:  map[int64]int64{},
+		},
+		{
+			name: "Returns data put before",
+			setup: func(tx backend.UnsafeWriter) {
+				UnsafePutCompactionBarrier(tx, 1, 10)
+				UnsafePutCompactionBarrier(tx, 2, 20)
+			},
+			want: map[int64]int64{1: 10, 2: 20},
+		},
+		{
+			name: "Overrides the revision of a lease",
+			setup: func(tx backend.UnsafeWriter) {
+				UnsafePutCompactionBarrier(tx, 1, 10)
+				UnsafePutCompactionBarrier(tx, 1, 15)
+			},
+			want: map[int64]int64{1: 15},
+		},
+		{
+			name: "Skips deleted",
+			setup: func(tx backend.UnsafeWriter) {
+				UnsafePutCompactionBarrier(tx, 1, 10)
+				UnsafePutCompactionBarrier(tx, 2, 20)
+				UnsafeDeleteCompactionBarrier(tx, 1)
+			},
+			want: map[int64]int64{2: 20},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			lg := zaptest.NewLogger(t)
+			be, tmpPath := betesting.NewTmpBackend(t, time.Microsecond, 10)
+			tx := be.BatchTx()
+			tx.Lock()
+			UnsafeCreateCompactionBarrierBucket(tx)
+			tc.setup(tx)
+			tx.Unlock()
+
+			be.ForceCommit()
+			be.Close()
+
+			be2 := backend.NewDefaultBackend(lg, tmpPath)
+			defer be2.Close()
+			barriers := MustUnsafeGetAllCompactionBarriers(be2.ReadTx())
+
+			assert.Equal(t, tc.want, barriers)
+		})
+	}
+}
@@ -16,6 +16,7 @@ package wal
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -27,6 +28,45 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
 )
 
+// RepairMode is how the corrupt tail of the last WAL file is removed.
+type RepairMode string
+
+const (
+	// RepairTruncate truncates the last WAL file at its first corrupt record,
+	// torn by a partial write or failing its CRC check.
+	RepairTruncate RepairMode = "truncate"
+	// RepairZeroFill zero-fills the torn tail of the last WAL file, from its
+	// first partially written record to its end, keeping its size as if the
+	// tail were preallocated space.
+	RepairZeroFill RepairMode = "zero-fill"
+)
+
+// RepairOptions configures RepairWithOptions.
+type RepairOptions struct {
+	// Mode is the way the corrupt tail is removed, RepairTruncate if empty.
+	Mode RepairMode
+	// DryRun reports the repair without changing the WAL files.
+	DryRun bool
+}
+
+// RepairReport is the result of RepairWithOptions.
+type RepairReport struct {
+	// Path is the path of the last WAL file.
+	Path string `json:"path"`
+	// Size is the size of the last WAL file before the repair.
+	Size int64 `json:"size"`
+	// Corruption is the error of the first corrupt record, empty if the last
+	// WAL file is not corrupt and nothing is repaired.
+	Corruption string `json:"corruption,omitempty"`
+	// Offset is the offset of the first corrupt record, from which the bytes
+	// are removed, and RemovedBytes their number.
+	Offset       int64 `json:"offset"`
+	RemovedBytes int64 `json:"removedBytes"`
+	// Backup is the path of the file the removed bytes are written to, empty
+	// if nothing is removed or on a dry run.
+	Backup string `json:"backup,omitempty"`
+}
+
 // Repair tries to repair ErrUnexpectedEOF in the
 // last wal file by truncating.
 func Repair(lg *zap.Logger, dirpath string) bool {
@@ -105,6 +145,141 @@ func Repair(lg *zap.Logger, dirpath string) bool {
 	}
 }
 
+// RepairWithOptions repairs the last WAL file, removing its bytes from its
+// first corrupt record as set by the mode. The removed bytes are written to
+// the backup file named after the WAL file with the ".broken" suffix. The
+// corruptions of the other WAL files cannot be repaired.
+//
+// Unlike Repair, run by the server on startup, it also truncates the WAL file
+// at the records failing their CRC check, dropping the records after them, so
+// it is only meant to be run explicitly by an operator.
+func RepairWithOptions(lg *zap.Logger, dirpath string, opts RepairOptions) (RepairReport, error) {
+	if lg == nil {
+		lg = zap.NewNop()
+	}
+	if opts.Mode == "" {
+		opts.Mode = RepairTruncate
+	}
+	if opts.Mode != RepairTruncate && opts.Mode != RepairZeroFill {
+		return RepairReport{}, fmt.Errorf("unknown repair mode %q", opts.Mode)
+	}
+	f, err := openLast(lg, dirpath)
+	if err != nil {
+		return RepairReport{}, err
+	}
+	defer f.Close()
+	r := RepairReport{Path: f.Name()}
+	fi, err := f.Stat()
+	if err != nil {
+		return r, err
+	}
+	r.Size = fi.Size()
+
+	lg.Info("repairing", zap.String("path", f.Name()), zap.String("mode", string(opts.Mode)), zap.Bool("dry-run", opts.DryRun))
+
+	r.Offset, err = firstCorruptRecord(f.File)
+	if errors.Is(err, io.EOF) {
+		lg.Info("repaired", zap.String("path", f.Name()), zap.Error(io.EOF))
+		r.Offset = r.Size
+		return r, nil
+	}
+	if err == nil {
+		return r, errors.New("unexpected end of the decoding")
+	}
+	r.Corruption = err.Error()
+	if !isRepairable(err, opts.Mode) {
+		return r, err
+	}
+	r.RemovedBytes = r.Size - r.Offset
+	if opts.DryRun {
+		return r, nil
+	}
+
+	r.Backup = f.Name() + ".broken"
+	if err = backupRemovedBytes(f.File, r.Backup, r.Offset); err != nil {
+		return r, err
+	}
+
+	switch opts.Mode {
+	case RepairTruncate:
+		err = f.Truncate(r.Offset)
+	case RepairZeroFill:
+		_, err = f.WriteAt(make([]byte, r.RemovedBytes), r.Offset)
+	}
+	if err != nil {
+		return r, err
+	}
+
+	start := time.Now()
+	if err = fileutil.Fsync(f.File); err != nil {
+		return r, err
+	}
+	walFsyncSec.Observe(time.Since(start).Seconds())
+
+	lg.Info("repaired",
+		zap.String("path", f.Name()),
+		zap.String("mode", string(opts.Mode)),
+		zap.Int64("offset", r.Offset),
+		zap.Int64("removed-bytes", r.RemovedBytes),
+		zap.String("backup", r.Backup),
+		zap.String("corruption", r.Corruption),
+	)
+	return r, nil
+}
+
+// firstCorruptRecord returns the offset of the first record of the WAL file
+// failing to decode, and its error, or io.EOF if all of them decode.
+func firstCorruptRecord(f *os.File) (int64, error) {
+	rec := &walpb.Record{}
+	decoder := NewDecoder(fileutil.NewFileReader(f))
+	for {
+		lastOffset := decoder.LastOffset()
+		err := decoder.Decode(rec)
+		if err != nil {
+			return lastOffset, err
+		}
+		// update crc of the decoder when necessary
+		if rec.Type == CrcType {
+			crc := decoder.LastCRC()
+			// current crc of decoder must match the crc of the record.
+			// do no need to match 0 crc, since the decoder is a new one at this case.
+			if crc != 0 {
+				if err := rec.Validate(crc); err != nil {
+					return lastOffset, err
+				}
+			}
+			decoder.UpdateCRC(rec.Crc)
+		}
+	}
+}
+
+// isRepairable returns whether the mode repairs the corruption: the torn
+// writes are repaired by all the modes, and the other corruptions by
+// truncating.
+func isRepairable(err error, mode RepairMode) bool {
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	return mode == RepairTruncate && !errors.Is(err, io.EOF)
+}
+
+// backupRemovedBytes writes the bytes of the file from the offset to the
+// backup file.
+func backupRemovedBytes(f *os.File, backup string, offset int64) error {
+	bf, err := createNewWALFile[*os.File](backup, true)
+	if err != nil {
+		return fmt.Errorf("failed to create backup file %q: %w", backup, err)
+	}
+	defer bf.Close()
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err = io.Copy(bf, f); err != nil {
+		return fmt.Errorf("failed to copy %q to %q: %w", f.Name(), backup, err)
+	}
+	return fileutil.Fsync(bf)
+}
+
 // openLast opens the last wal file for read and write.
 func openLast(lg *zap.Logger, dirpath string) (*fileutil.LockedFile, error) {
 	names, err := readWALNames(lg, dirpath)
@@ -208,3 +208,128 @@ func TestRepairFailDeleteDir(t *testing.T) {
 	os.RemoveAll(p)
 	require.Falsef(t, Repair(zaptest.NewLogger(t), p), "expect 'Repair' fail on unexpected directory deletion")
 }
+
+// TestRepairWithOptions repairs a torn write or a CRC mismatch in the last WAL
+// file with the repair modes.
+func TestRepairWithOptions(t *testing.T) {
+	tearf := func(f *os.File, offset int64) error {
+		return f.Truncate(offset - 4)
+	}
+	flipf := func(f *os.File, offset int64) error {
+		// flip a byte of the data of the last entry
+		b := make([]byte, 1)
+		if _, err := f.ReadAt(b, offset-64); err != nil {
+			return err
+		}
+		b[0] ^= 0xff
+		_, err := f.WriteAt(b, offset-64)
+		return err
+	}
+	tests := []struct {
+		name    string
+		corrupt func(*os.File, int64) error
+		mode    RepairMode
+		dryRun  bool
+		wantErr bool
+		// wantSize is true if the WAL file keeps its size
+		wantSize bool
+	}{
+		{name: "torn write truncated", corrupt: tearf, mode: RepairTruncate},
+		{name: "torn write zero-filled", corrupt: tearf, mode: RepairZeroFill, wantSize: true},
+		{name: "torn write dry run", corrupt: tearf, mode: RepairTruncate, dryRun: true, wantSize: true},
+		{name: "crc mismatch truncated", corrupt: flipf, mode: RepairTruncate},
+		{name: "crc mismatch zero-filled", corrupt: flipf, mode: RepairZeroFill, wantErr: true, wantSize: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lg := zaptest.NewLogger(t)
+			p := t.TempDir()
+			w, err := Create(lg, p, nil)
+			require.NoError(t, err)
+			for _, es := range makeEnts(9) {
+				require.NoError(t, w.Save(raftpb.HardState{}, es))
+			}
+			require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Index: 10, Data: make([]byte, 100)}}))
+			offset, err := w.tail().Seek(0, io.SeekCurrent)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			f, err := openLast(lg, p)
+			require.NoError(t, err)
+			require.NoError(t, tt.corrupt(f.File, offset))
+			fi, err := f.Stat()
+			require.NoError(t, err)
+			size := fi.Size()
+			require.NoError(t, f.Close())
+
+			r, err := RepairWithOptions(lg, p, RepairOptions{Mode: tt.mode, DryRun: tt.dryRun})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, size, r.Size)
+			assert.NotEmpty(t, r.Corruption)
+
+			fi, err = os.Stat(r.Path)
+			require.NoError(t, err)
+			if tt.wantSize {
+				assert.Equal(t, size, fi.Size())
+			} else {
+				assert.Equal(t, r.Offset, fi.Size())
+			}
+			if tt.wantErr || tt.dryRun {
+				assert.Empty(t, r.Backup)
+				assert.NoFileExists(t, r.Path+".broken")
+				return
+			}
+
+			// the removed bytes are backed up
+			assert.Equal(t, size-r.Offset, r.RemovedBytes)
+			b, err := os.ReadFile(r.Backup)
+			require.NoError(t, err)
+			assert.Len(t, b, int(r.RemovedBytes))
+
+			w, err = Open(lg, p, walpb.Snapshot{})
+			require.NoError(t, err)
+			_, _, walEnts, err := w.ReadAll()
+			require.NoError(t, err)
+			assert.Len(t, walEnts, 9)
+			require.NoError(t, w.Close())
+		})
+	}
+}
+
+// TestRepairCRCMismatch ensures that the repair run on startup does not drop
+// the records after a CRC mismatch, which only RepairWithOptions truncates.
+func TestRepairCRCMismatch(t *testing.T) {
+	lg := zaptest.NewLogger(t)
+	p := t.TempDir()
+	w, err := Create(lg, p, nil)
+	require.NoError(t, err)
+	for _, es := range makeEnts(9) {
+		require.NoError(t, w.Save(raftpb.HardState{}, es))
+	}
+	require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Index: 10, Data: make([]byte, 100)}}))
+	offset, err := w.tail().Seek(0, io.SeekCurrent)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	f, err := openLast(lg, p)
+	require.NoError(t, err)
+	b := make([]byte, 1)
+	_, err = f.ReadAt(b, offset-64)
+	require.NoError(t, err)
+	b[0] ^= 0xff
+	_, err = f.WriteAt(b, offset-64)
+	require.NoError(t, err)
+	fi, err := f.Stat()
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	require.False(t, Repair(lg, p))
+	after, err := os.Stat(filepath.Join(p, fi.Name()))
+	require.NoError(t, err)
+	assert.Equal(t, fi.Size(), after.Size())
+	assert.NoFileExists(t, filepath.Join(p, fi.Name()+".broken"))
+}
@@ -71,6 +71,39 @@ func MinimalEtcdVersion(ents []raftpb.Entry) *semver.Version {
 	return maxVer
 }
 
+// EntryVersion is the minimal etcd version able to interpret an entry of the
+// WAL, required by the newest message, field or enum value the entry uses.
+type EntryVersion struct {
+	Index   uint64          `json:"index"`
+	Term    uint64          `json:"term"`
+	Version *semver.Version `json:"version"`
+	// Field is the full name of the newest message, field or enum value.
+	Field string `json:"field"`
+}
+
+// EntriesNewerThan returns the versions of the entries that etcd of the
+// target version cannot interpret, as they use messages, fields or enum values
+// introduced after it.
+func EntriesNewerThan(ents []raftpb.Entry, target semver.Version) ([]EntryVersion, error) {
+	var newer []EntryVersion
+	for _, ent := range ents {
+		ev := EntryVersion{Index: ent.Index, Term: ent.Term}
+		err := visitEntry(ent, func(path protoreflect.FullName, ver *semver.Version) error {
+			if ver != nil && (ev.Version == nil || ev.Version.LessThan(*ver)) {
+				ev.Version, ev.Field = ver, string(path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("entry of index %d: %w", ent.Index, err)
+		}
+		if ev.Version != nil && target.LessThan(*ev.Version) {
+			newer = append(newer, ev)
+		}
+	}
+	return newer, nil
+}
+
 type Visitor func(path protoreflect.FullName, ver *semver.Version) error
 
 // VisitFileDescriptor calls visitor on each field and enum value with etcd version read from proto definition.
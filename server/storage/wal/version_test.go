@@ -116,6 +116,34 @@ func TestEtcdVersionFromEntry(t *testing.T) {
 	}
 }
 
+func TestEntriesNewerThan(t *testing.T) {
+	header := pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Header: &etcdserverpb.RequestHeader{AuthRevision: 1}})
+	v36 := pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{DowngradeVersionTest: &etcdserverpb.DowngradeVersionTestRequest{Ver: "3.6.0"}})
+	v37 := pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{DowngradeVersionTest: &etcdserverpb.DowngradeVersionTestRequest{Ver: "3.7.0"}})
+	ents := []raftpb.Entry{
+		{Term: 1, Index: 1, Type: raftpb.EntryNormal, Data: header},
+		{Term: 1, Index: 2, Type: raftpb.EntryNormal, Data: v36},
+		{Term: 2, Index: 3, Type: raftpb.EntryNormal, Data: v37},
+	}
+
+	newer, err := EntriesNewerThan(ents, version.V3_6)
+	require.NoError(t, err)
+	require.Len(t, newer, 1)
+	assert.Equal(t, uint64(3), newer[0].Index)
+	assert.Equal(t, uint64(2), newer[0].Term)
+	assert.Equal(t, &version.V3_7, newer[0].Version)
+	assert.NotEmpty(t, newer[0].Field)
+
+	newer, err = EntriesNewerThan(ents, version.V3_5)
+	require.NoError(t, err)
+	require.Len(t, newer, 2)
+	assert.Equal(t, uint64(2), newer[0].Index)
+
+	newer, err = EntriesNewerThan(ents, version.V3_7)
+	require.NoError(t, err)
+	assert.Empty(t, newer)
+}
+
 func TestEtcdVersionFromMessage(t *testing.T) {
 	tcs := []struct {
 		name   string
@@ -85,7 +85,7 @@ func Verify(cfg Config) (retErr error) {
 	// TODO: Perform validation of consistency of membership between
 	// backend/members & WAL confstate (and maybe storev2 if still exists).
 
-	return validateConsistentIndex(cfg, hardstate, snapshot, be)
+	return ValidateConsistentIndex(cfg, hardstate, snapshot, be)
 }
 
 // VerifyIfEnabled performs verification according to ETCD_VERIFY env settings.
@@ -108,7 +108,9 @@ func MustVerifyIfEnabled(cfg Config) {
 	}
 }
 
-func validateConsistentIndex(cfg Config, hardstate *raftpb.HardState, snapshot *walpb.Snapshot, be backend.Backend) error {
+// ValidateConsistentIndex checks the consistent index and term of the backend
+// against the hard state and the latest snapshot of the WAL.
+func ValidateConsistentIndex(cfg Config, hardstate *raftpb.HardState, snapshot *walpb.Snapshot, be backend.Backend) error {
 	index, term := schema.ReadConsistentIndex(be.ReadTx())
 	if cfg.ExactIndex && index != hardstate.Commit {
 		return fmt.Errorf("backend.ConsistentIndex (%v) expected == WAL.HardState.commit (%v)", index, hardstate.Commit)
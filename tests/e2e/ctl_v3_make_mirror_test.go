@@ -29,6 +29,7 @@ func TestCtlV3MakeMirror(t *testing.T)                 { testCtl(t, makeMirrorTe
 func TestCtlV3MakeMirrorModifyDestPrefix(t *testing.T) { testCtl(t, makeMirrorModifyDestPrefixTest) }
 func TestCtlV3MakeMirrorNoDestPrefix(t *testing.T)     { testCtl(t, makeMirrorNoDestPrefixTest) }
 func TestCtlV3MakeMirrorWithWatchRev(t *testing.T)     { testCtl(t, makeMirrorWithWatchRev) }
+func TestCtlV3MakeMirrorExcludeRewrite(t *testing.T)   { testCtl(t, makeMirrorExcludeRewriteTest) }
 
 func makeMirrorTest(cx ctlCtx) {
 	var (
@@ -75,6 +76,17 @@ func makeMirrorWithWatchRev(cx ctlCtx) {
 	testMirrorCommand(cx, flags, kvs, kvs2, srcprefix, destprefix)
 }
 
+func makeMirrorExcludeRewriteTest(cx ctlCtx) {
+	var (
+		flags      = []string{"--prefix", "o_", "--dest-prefix", "d_", "--exclude-prefix", "o_tmp", "--rewrite-key", `^d_key(\d)$=d_k$1`, "--rate-limit", "100"}
+		kvs        = []kv{{"o_key1", "val1"}, {"o_key2", "val2"}, {"o_key3", "val3"}, {"o_tmp1", "tmp1"}, {"o_tmp2", "tmp2"}}
+		kvs2       = []kvExec{{key: "d_k1", val: "val1"}, {key: "d_k2", val: "val2"}, {key: "d_k3", val: "val3"}}
+		srcprefix  = "o_"
+		destprefix = "d_"
+	)
+	testMirrorCommand(cx, flags, kvs, kvs2, srcprefix, destprefix)
+}
+
 func testMirrorCommand(cx ctlCtx, flags []string, sourcekvs []kv, destkvs []kvExec, srcprefix, destprefix string) {
 	// set up another cluster to mirror with
 	mirrorcfg := e2e.NewConfigAutoTLS()
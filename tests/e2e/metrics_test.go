@@ -177,6 +177,9 @@ func TestNoMetricsMissing(t *testing.T) {
 			"etcd_grpc_proxy_cache_misses_total",
 			"etcd_grpc_proxy_events_coalescing_total",
 			"etcd_grpc_proxy_watchers_coalescing_total",
+			"etcd_mvcc_compaction_paused",
+			"etcd_mvcc_compaction_revisions_processed",
+			"etcd_mvcc_compaction_revisions_total",
 			"etcd_mvcc_db_open_read_transactions",
 			"etcd_mvcc_db_total_size_in_bytes",
 			"etcd_mvcc_db_total_size_in_use_in_bytes",
@@ -16,11 +16,13 @@ package e2e
 
 import (
 	"math/rand"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/v3/snapshot"
 	"go.etcd.io/etcd/pkg/v3/expect"
 	"go.etcd.io/etcd/tests/v3/framework/e2e"
 )
@@ -129,6 +131,27 @@ func testCurlV3MaintenanceSnapshot(cx ctlCtx) {
 	}), "failed post maintenance snapshot request")
 }
 
+func TestCurlV3MaintenanceSnapshotDownload(t *testing.T) {
+	testCtl(t, testCurlV3MaintenanceSnapshotDownload, withCfg(*e2e.NewConfigNoTLS()))
+}
+
+func testCurlV3MaintenanceSnapshotDownload(cx ctlCtx) {
+	require.NoError(cx.t, ctlV3Put(cx, "foo", "bar", ""))
+
+	clus := cx.epc
+	dbPath := filepath.Join(cx.t.TempDir(), "snapshot.db")
+	args := e2e.CURLPrefixArgsCluster(clus.Cfg, clus.Procs[rand.Intn(clus.Cfg.ClusterSize)], "GET", e2e.CURLReq{
+		Endpoint:   "/v3/maintenance/snapshot/download",
+		OutputFile: dbPath,
+	})
+	_, err := e2e.RunUtilCompletion(args, nil)
+	require.NoError(cx.t, err)
+
+	// the downloaded file is a snapshot as saved by etcdctl, with its checksum
+	_, err = snapshot.Verify(dbPath)
+	require.NoError(cx.t, err)
+}
+
 func TestCurlV3MaintenanceMoveleader(t *testing.T) {
 	testCtl(t, testCurlV3MaintenanceMoveleader, withCfg(*e2e.NewConfigNoTLS()))
 }
@@ -140,6 +140,9 @@ type ClusterConfig struct {
 	ClientTLS *transport.TLSInfo
 
 	AuthToken string
+	// AuthTokenRoleTTLs are the lifetimes of the auth tokens of the users by
+	// role.
+	AuthTokenRoleTTLs map[string]time.Duration
 
 	QuotaBackendBytes    int64
 	BackendBatchInterval time.Duration
@@ -172,6 +175,7 @@ type ClusterConfig struct {
 
 	WatchProgressNotifyInterval time.Duration
 	MaxLearners                 int
+	MaxVotingMembers            int
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
 	Metrics                     string
@@ -263,6 +267,7 @@ func (c *Cluster) MustNewMember(t testutil.TB) *Member {
 			Name:                        fmt.Sprintf("m%v", memberNumber),
 			MemberNumber:                memberNumber,
 			AuthToken:                   c.Cfg.AuthToken,
+			AuthTokenRoleTTLs:           c.Cfg.AuthTokenRoleTTLs,
 			PeerTLS:                     c.Cfg.PeerTLS,
 			ClientTLS:                   c.Cfg.ClientTLS,
 			QuotaBackendBytes:           c.Cfg.QuotaBackendBytes,
@@ -285,6 +290,7 @@ func (c *Cluster) MustNewMember(t testutil.TB) *Member {
 			LeaseCheckpointPersist:      c.Cfg.LeaseCheckpointPersist,
 			WatchProgressNotifyInterval: c.Cfg.WatchProgressNotifyInterval,
 			MaxLearners:                 c.Cfg.MaxLearners,
+			MaxVotingMembers:            c.Cfg.MaxVotingMembers,
 			DisableStrictReconfigCheck:  c.Cfg.DisableStrictReconfigCheck,
 			CorruptCheckTime:            c.Cfg.CorruptCheckTime,
 			Metrics:                     c.Cfg.Metrics,
@@ -590,6 +596,7 @@ type MemberConfig struct {
 	PeerTLS                     *transport.TLSInfo
 	ClientTLS                   *transport.TLSInfo
 	AuthToken                   string
+	AuthTokenRoleTTLs           map[string]time.Duration
 	QuotaBackendBytes           int64
 	BackendBatchInterval        time.Duration
 	MaxTxnOps                   uint
@@ -610,6 +617,7 @@ type MemberConfig struct {
 	LeaseCheckpointPersist      bool
 	WatchProgressNotifyInterval time.Duration
 	MaxLearners                 int
+	MaxVotingMembers            int
 	DisableStrictReconfigCheck  bool
 	CorruptCheckTime            time.Duration
 	Metrics                     string
@@ -688,6 +696,7 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	if mcfg.AuthToken != "" {
 		m.AuthToken = mcfg.AuthToken
 	}
+	m.RoleTokenTTLs = mcfg.AuthTokenRoleTTLs
 
 	m.BcryptCost = uint(bcrypt.MinCost) // use min bcrypt cost to speedy up integration testing
 
@@ -725,6 +734,7 @@ func MustNewMember(t testutil.TB, mcfg MemberConfig) *Member {
 	if mcfg.MaxLearners != 0 {
 		m.MaxLearners = mcfg.MaxLearners
 	}
+	m.MaxVotingMembers = mcfg.MaxVotingMembers
 	m.Metrics = mcfg.Metrics
 	m.V2Deprecation = config.V2_DEPR_DEFAULT
 	m.GRPCServerRecorder = &grpctesting.GRPCRecorder{}
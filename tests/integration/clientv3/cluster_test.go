@@ -23,9 +23,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/types"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
 	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
 )
 
@@ -47,6 +51,44 @@ func TestMemberList(t *testing.T) {
 	}
 }
 
+func TestMemberListDetails(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.Client(0)
+	resp, err := capi.MemberList(t.Context(), clientv3.WithMemberDetails())
+	require.NoError(t, err)
+	require.Len(t, resp.Details, 3)
+	for i, d := range resp.Details {
+		assert.Equal(t, resp.Members[i].ID, d.ID)
+		assert.Truef(t, d.Healthy, "member %x: %v", d.ID, d.Errors)
+		assert.NotEmpty(t, d.Version)
+		assert.Positive(t, d.DbSize)
+		assert.Positive(t, d.RaftAppliedIndex)
+	}
+
+	stopped := clus.Members[2]
+	stopped.Stop(t)
+	resp, err = capi.MemberList(t.Context(), clientv3.WithMemberDetails())
+	require.NoError(t, err)
+	require.Len(t, resp.Details, 3)
+	for _, d := range resp.Details {
+		if d.ID == uint64(stopped.Server.MemberID()) {
+			assert.False(t, d.Healthy)
+			assert.NotEmpty(t, d.Errors)
+		} else {
+			assert.Truef(t, d.Healthy, "member %x: %v", d.ID, d.Errors)
+		}
+	}
+
+	// the details are only returned on request
+	resp, err = capi.MemberList(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, resp.Details)
+}
+
 func TestMemberAdd(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -156,6 +198,56 @@ func TestMemberUpdate(t *testing.T) {
 	}
 }
 
+func TestMemberUpdateAttributes(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	capi := clus.Client(1)
+	resp, err := capi.MemberList(t.Context())
+	require.NoError(t, err)
+	id, peerURLs := resp.Members[0].ID, resp.Members[0].PeerURLs
+
+	attrs := clientv3.MemberAttributes{Zone: "us-east-1a", Weight: 2, Maintenance: true}
+	uresp, err := capi.MemberUpdateAttributes(t.Context(), id, attrs)
+	require.NoError(t, err)
+	require.Equal(t, id, uresp.Members[0].ID)
+	require.Equal(t, "us-east-1a", uresp.Members[0].Attributes.Zone)
+
+	// the peer URLs are kept, and the attributes are kept by a peer URLs update
+	_, err = capi.MemberUpdate(t.Context(), id, peerURLs)
+	require.NoError(t, err)
+
+	// the attributes are persisted in the backend
+	for _, m := range clus.Members {
+		m.Stop(t)
+		require.NoError(t, m.Restart(t))
+	}
+	clus.WaitLeader(t)
+
+	resp, err = capi.MemberList(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, peerURLs, resp.Members[0].PeerURLs)
+	require.NotNil(t, resp.Members[0].Attributes)
+	assert.Equal(t, "us-east-1a", resp.Members[0].Attributes.Zone)
+	assert.Equal(t, uint32(2), resp.Members[0].Attributes.Weight)
+	assert.True(t, resp.Members[0].Attributes.Maintenance)
+	for _, m := range resp.Members[1:] {
+		assert.Nil(t, m.Attributes)
+	}
+
+	// zero attributes remove them
+	_, err = capi.MemberUpdateAttributes(t.Context(), id, clientv3.MemberAttributes{})
+	require.NoError(t, err)
+	resp, err = capi.MemberList(t.Context())
+	require.NoError(t, err)
+	assert.Nil(t, resp.Members[0].Attributes)
+
+	_, err = capi.MemberUpdateAttributes(t.Context(), 0xbad, attrs)
+	require.ErrorIs(t, err, rpctypes.ErrMemberNotFound)
+}
+
 func TestMemberAddUpdateWrongURLs(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -429,3 +521,24 @@ func TestMaxLearnerInCluster(t *testing.T) {
 		t.Errorf("failed to add member %v", err)
 	}
 }
+
+func TestMaxVotingMembersInCluster(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	// 1. start a large cluster at its voting member ceiling
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: membership.LargeClusterMaxVotingMembers, MaxVotingMembers: membership.LargeClusterMaxVotingMembers, DisableStrictReconfigCheck: true})
+	defer clus.Terminate(t)
+
+	leaderIdx := clus.WaitLeader(t)
+	capi := clus.Client(leaderIdx)
+	_, err := capi.Put(t.Context(), "foo", "bar")
+	require.NoError(t, err)
+
+	// 2. adding another voting member should fail
+	_, err = capi.MemberAdd(t.Context(), []string{"http://127.0.0.1:3453"})
+	require.ErrorIs(t, err, rpctypes.ErrTooManyVotingMembers)
+
+	// 3. adding a learner member should succeed
+	_, err = capi.MemberAddAsLearner(t.Context(), []string{"http://127.0.0.1:3454"})
+	require.NoError(t, err)
+}
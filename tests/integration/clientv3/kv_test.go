@@ -407,6 +407,109 @@ func TestKVCompactError(t *testing.T) {
 	}
 }
 
+// TestKVGetTombstones ensures the tombstones of the keys deleted since the
+// last compaction are returned with their deletion revisions.
+func TestKVGetTombstones(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	ctx := t.Context()
+
+	// revisions: 2 put a, 3 put b, 4 delete a, 5 put a, 6 delete a, 7 delete b, 8 put c
+	for _, op := range []clientv3.Op{
+		clientv3.OpPut("a", "1"),
+		clientv3.OpPut("b", "1"),
+		clientv3.OpDelete("a"),
+		clientv3.OpPut("a", "2"),
+		clientv3.OpDelete("a"),
+		clientv3.OpDelete("b"),
+		clientv3.OpPut("c", "1"),
+	} {
+		_, err := kv.Do(ctx, op)
+		require.NoError(t, err)
+	}
+
+	type tombstone struct {
+		key string
+		rev int64
+	}
+	get := func(key string, opts ...clientv3.OpOption) ([]tombstone, int64) {
+		resp, err := kv.Get(ctx, key, append(opts, clientv3.WithTombstones())...)
+		require.NoError(t, err)
+		var ts []tombstone
+		for _, kv := range resp.Kvs {
+			require.Empty(t, kv.Value)
+			require.Zero(t, kv.CreateRevision)
+			ts = append(ts, tombstone{string(kv.Key), kv.ModRevision})
+		}
+		return ts, resp.Count
+	}
+
+	ts, count := get("", clientv3.WithPrefix())
+	require.Equal(t, []tombstone{{"a", 4}, {"a", 6}, {"b", 7}}, ts)
+	require.Equal(t, int64(3), count)
+	ts, _ = get("a")
+	require.Equal(t, []tombstone{{"a", 4}, {"a", 6}}, ts)
+	ts, _ = get("", clientv3.WithPrefix(), clientv3.WithRev(5))
+	require.Equal(t, []tombstone{{"a", 4}}, ts)
+	ts, count = get("", clientv3.WithPrefix(), clientv3.WithLimit(1))
+	require.Equal(t, []tombstone{{"a", 4}}, ts)
+	require.Equal(t, int64(3), count)
+	_, count = get("", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.Equal(t, int64(3), count)
+	ts, _ = get("c")
+	require.Empty(t, ts)
+
+	// the tombstones before the compaction are removed
+	_, err := kv.Compact(ctx, 5)
+	require.NoError(t, err)
+	ts, _ = get("", clientv3.WithPrefix())
+	require.Equal(t, []tombstone{{"a", 6}, {"b", 7}}, ts)
+}
+
+func TestKVWaitFor(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := t.Context()
+
+	var kv *mvccpb.KeyValue
+	donec := make(chan error, 1)
+	go func() {
+		var err error
+		kv, err = clientv3.WaitFor(ctx, cli, cli, "foo", clientv3.KeyVersionAtLeast(3))
+		donec <- err
+	}()
+	for _, v := range []string{"1", "2", "3"} {
+		_, err := clus.RandClient().Put(ctx, "foo", v)
+		require.NoError(t, err)
+	}
+	select {
+	case err := <-donec:
+		require.NoError(t, err)
+		require.Equal(t, "3", string(kv.Value))
+		require.Equal(t, int64(3), kv.Version)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the key")
+	}
+
+	// the predicate is checked against the current key first
+	kv, err := clientv3.WaitFor(ctx, cli, cli, "foo", clientv3.KeyValueEquals("3"))
+	require.NoError(t, err)
+	require.Equal(t, "3", string(kv.Value))
+
+	tctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = clientv3.WaitFor(tctx, cli, cli, "foo", clientv3.KeyAbsent())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 func TestKVCompact(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -875,3 +978,81 @@ func TestBalancerSupportLearner(t *testing.T) {
 		t.Errorf("expect no error (balancer should retry when request to learner fails), got error: %v", err)
 	}
 }
+
+// TestLearnerServeWatch verifies that a learner started with LearnerServeWatch
+// serves the Watch and serializable Range requests sent to the learner
+// endpoints of a client.
+func TestLearnerServeWatch(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3, DisableStrictReconfigCheck: true})
+	defer clus.Terminate(t)
+
+	clus.AddAndLaunchLearnerMember(t)
+	learner := clus.Members[3]
+	learner.LearnerServeWatch = true
+	learner.Stop(t)
+	require.NoError(t, learner.Restart(t))
+	<-learner.ReadyNotify()
+
+	cfg := clientv3.Config{
+		Endpoints:        []string{clus.Members[0].GRPCURL},
+		LearnerEndpoints: []string{learner.GRPCURL},
+		DialTimeout:      5 * time.Second,
+		DialOptions:      []grpc.DialOption{grpc.WithBlock()},
+	}
+	cli, err := integration2.NewClient(t, cfg)
+	require.NoError(t, err)
+	defer cli.Close()
+	require.Equal(t, []string{learner.GRPCURL}, cli.LearnerEndpoints())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	wch := cli.Watch(ctx, "foo")
+	_, err = cli.Put(t.Context(), "foo", "bar")
+	require.NoError(t, err)
+	select {
+	case wresp := <-wch:
+		require.NoError(t, wresp.Err())
+		require.Len(t, wresp.Events, 1)
+		require.Equal(t, "bar", string(wresp.Events[0].Kv.Value))
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the watch event from the learner")
+	}
+
+	// the serializable Range is served by the learner, and the linearizable
+	// one by the voting members
+	resp, err := cli.Get(t.Context(), "foo", clientv3.WithSerializable())
+	require.NoError(t, err)
+	require.Equal(t, uint64(learner.ID()), resp.Header.MemberId)
+	resp, err = cli.Get(t.Context(), "foo")
+	require.NoError(t, err)
+	require.Equal(t, uint64(clus.Members[0].ID()), resp.Header.MemberId)
+}
+
+func TestKVGetWithMaxStaleness(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	lead := clus.WaitLeader(t)
+	follower := clus.Client((lead + 1) % 3)
+
+	// the put returns once the follower applied it
+	_, err := follower.Put(t.Context(), "foo", "bar")
+	require.NoError(t, err)
+
+	maxStaleness := 10 * time.Second
+	resp, err := follower.Get(t.Context(), "foo", clientv3.WithMaxStaleness(maxStaleness))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	require.Equal(t, "bar", string(resp.Kvs[0].Value))
+	require.LessOrEqual(t, resp.Header.StalenessMs, maxStaleness.Milliseconds())
+
+	// the leader always serves a linearizable read
+	resp, err = clus.Client(lead).Get(t.Context(), "foo", clientv3.WithMaxStaleness(maxStaleness))
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	require.Equal(t, int64(0), resp.Header.StalenessMs)
+}
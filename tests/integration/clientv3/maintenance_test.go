@@ -37,6 +37,7 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/api/v3/version"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/config"
 	"go.etcd.io/etcd/server/v3/lease"
 	"go.etcd.io/etcd/server/v3/storage"
 	"go.etcd.io/etcd/server/v3/storage/backend"
@@ -147,6 +148,123 @@ func TestMaintenanceMoveLeader(t *testing.T) {
 	}
 }
 
+// TestMaintenanceCompactionBarrier ensures that compactions past a compaction
+// barrier are rejected on every member until the lease holding it is revoked.
+func TestMaintenanceCompactionBarrier(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := t.Context()
+	for i := 0; i < 5; i++ {
+		_, err := cli.Put(ctx, "foo", fmt.Sprintf("bar%d", i))
+		require.NoError(t, err)
+	}
+
+	_, err := cli.CompactionBarrier(ctx, 3, clientv3.LeaseID(1))
+	require.ErrorIs(t, err, rpctypes.ErrLeaseNotFound)
+
+	lresp, err := cli.Grant(ctx, 60)
+	require.NoError(t, err)
+	resp, err := cli.CompactionBarrier(ctx, 3, lresp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.CompactRevision)
+	assert.Equal(t, int64(3), resp.BarrierRevision)
+
+	for i := range clus.Members {
+		_, err = clus.Client(i).Compact(ctx, 4)
+		require.ErrorIs(t, err, rpctypes.ErrCompactionBarrier)
+	}
+	_, err = cli.Compact(ctx, 3)
+	require.NoError(t, err)
+	_, err = cli.CompactionBarrier(ctx, 2, lresp.ID)
+	require.ErrorIs(t, err, rpctypes.ErrCompacted)
+
+	// The revisions at and after the barrier are still readable.
+	gresp, err := cli.Get(ctx, "foo", clientv3.WithRev(3))
+	require.NoError(t, err)
+	assert.Equal(t, "bar1", string(gresp.Kvs[0].Value))
+
+	_, err = cli.Revoke(ctx, lresp.ID)
+	require.NoError(t, err)
+	resp, err = cli.CompactionBarrier(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.CompactRevision)
+	assert.Equal(t, int64(0), resp.BarrierRevision)
+	_, err = cli.Compact(ctx, 5)
+	require.NoError(t, err)
+}
+
+// TestMaintenanceConfigReport ensures that every member reports its v2 store
+// deprecation stage and that its v2 store only holds the membership.
+func TestMaintenanceConfigReport(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	for i, m := range clus.Members {
+		resp, err := cli.ConfigReport(t.Context(), m.GRPCURL)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(m.ID()), resp.Header.MemberId, "member %d", i)
+		assert.Empty(t, resp.DeprecatedFlags)
+		assert.Equal(t, string(config.V2DeprDefault), resp.V2Deprecation)
+		assert.False(t, resp.V2StoreCustomContent)
+	}
+}
+
+// TestMaintenanceCompactionControl ensures that a paused compaction does not
+// delete the compacted revisions until it is resumed, and that its progress
+// is reported by Status.
+func TestMaintenanceCompactionControl(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := t.Context()
+	ep := clus.Members[0].GRPCURL
+	var rev int64
+	for i := 0; i < 10; i++ {
+		presp, err := cli.Put(ctx, "foo", fmt.Sprintf("bar%d", i))
+		require.NoError(t, err)
+		rev = presp.Header.Revision
+	}
+
+	resp, err := cli.CompactionControl(ctx, ep, true)
+	require.NoError(t, err)
+	assert.True(t, resp.Progress.Paused)
+	assert.False(t, resp.Progress.Running)
+
+	// the physical compaction does not finish while paused
+	cctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	_, err = cli.Compact(cctx, rev, clientv3.WithCompactPhysical())
+	cancel()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	sresp, err := cli.Status(ctx, ep)
+	require.NoError(t, err)
+	assert.True(t, sresp.CompactionProgress.Running)
+	assert.True(t, sresp.CompactionProgress.Paused)
+	assert.Equal(t, rev, sresp.CompactionProgress.Revision)
+	assert.Equal(t, rev, sresp.CompactionProgress.RevisionsTotal)
+
+	resp, err = cli.CompactionControl(ctx, ep, false)
+	require.NoError(t, err)
+	assert.False(t, resp.Progress.Paused)
+	require.Eventually(t, func() bool {
+		sresp, err = cli.Status(ctx, ep)
+		require.NoError(t, err)
+		return !sresp.CompactionProgress.Running
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.False(t, sresp.CompactionProgress.Paused)
+	assert.Equal(t, rev, sresp.CompactionProgress.Revision)
+	assert.Equal(t, rev, sresp.CompactionProgress.RevisionsProcessed)
+}
+
 // TestMaintenanceSnapshotCancel ensures that context cancel
 // before snapshot reading returns corresponding context errors.
 func TestMaintenanceSnapshotCancel(t *testing.T) {
@@ -66,6 +66,53 @@ func TestSaveSnapshotVersion(t *testing.T) {
 	require.Equalf(t, "3.7.0", ver, "expected snapshot version %s, got %s:", "3.7.0", ver)
 }
 
+// TestSaveSnapshotMetadata ensures that the snapshot is verified and its
+// metadata and download progress are reported.
+func TestSaveSnapshotMetadata(t *testing.T) {
+	testutil.SkipTestIfShortMode(t,
+		"Snapshot creation tests are depending on embedded etcd server so are integration-level tests.")
+
+	cfg := newEmbedConfig(t)
+	srv, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer srv.Close()
+	select {
+	case <-srv.Server.ReadyNotify():
+	case <-time.After(3 * time.Second):
+		t.Fatalf("failed to start embed.Etcd for creating snapshots")
+	}
+
+	ccfg := clientv3.Config{Endpoints: []string{cfg.AdvertiseClientUrls[0].String()}}
+	cli, err := integration2.NewClient(t, ccfg)
+	require.NoError(t, err)
+	defer cli.Close()
+	presp, err := cli.Put(t.Context(), "foo", "bar")
+	require.NoError(t, err)
+
+	var last snapshot.Progress
+	dbPath := filepath.Join(t.TempDir(), "snapshot.db")
+	md, err := snapshot.Save(t.Context(), zaptest.NewLogger(t), ccfg, dbPath, snapshot.WithProgress(func(p snapshot.Progress) { last = p }))
+	require.NoError(t, err)
+
+	dbInfo, err := os.Stat(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, dbInfo.Size(), md.Size)
+	require.Equal(t, md.Size, last.Bytes)
+	require.Equal(t, md.Size, last.TotalBytes)
+	require.Equal(t, uint64(srv.Server.MemberID()), md.MemberID)
+	require.GreaterOrEqual(t, md.Revision, presp.Header.Revision)
+
+	sum, err := snapshot.Verify(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, md.SHA256, sum)
+
+	mdPath := dbPath + ".json"
+	require.NoError(t, snapshot.WriteMetadata(mdPath, md))
+	rmd, err := snapshot.ReadMetadata(mdPath)
+	require.NoError(t, err)
+	require.NoError(t, snapshot.VerifyMetadata(dbPath, rmd))
+}
+
 type kv struct {
 	k, v string
 }
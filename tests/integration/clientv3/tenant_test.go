@@ -0,0 +1,121 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cluster_proxy
+
+package clientv3test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/tenant"
+	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
+)
+
+func TestTenantClient(t *testing.T) {
+	integration2.BeforeTest(t)
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	c := clus.Client(0)
+	require.NoError(t, tenant.Provision(t.Context(), c.Auth, "acme", "acmepw", ""))
+	require.NoError(t, tenant.Provision(t.Context(), c.Auth, "other", "otherpw", ""))
+	authSetupRoot(t, c.Auth)
+
+	newTenantClient := func(id, password string) *clientv3.Client {
+		cli, err := tenant.NewClient(tenant.Config{
+			Config: clientv3.Config{
+				Endpoints:   c.Endpoints(),
+				DialTimeout: 5 * time.Second,
+				DialOptions: []grpc.DialOption{grpc.WithBlock()},
+				Password:    password,
+			},
+			QPS:   1000,
+			Burst: 10,
+		}, id)
+		require.NoError(t, err)
+		t.Cleanup(func() { cli.Close() })
+		return cli
+	}
+	acme := newTenantClient("acme", "acmepw")
+	other := newTenantClient("other", "otherpw")
+
+	_, err := acme.Put(t.Context(), "abc", "123")
+	require.NoError(t, err)
+	resp, err := acme.Get(t.Context(), "abc")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "abc", string(resp.Kvs[0].Key))
+
+	// the key space of a tenant is not visible to another one
+	resp, err = other.Get(t.Context(), "abc")
+	require.NoError(t, err)
+	assert.Empty(t, resp.Kvs)
+
+	rootCli, err := integration2.NewClient(t, clientv3.Config{
+		Endpoints:   c.Endpoints(),
+		DialTimeout: 5 * time.Second,
+		DialOptions: []grpc.DialOption{grpc.WithBlock()},
+		Username:    "root",
+		Password:    "123",
+	})
+	require.NoError(t, err)
+	defer rootCli.Close()
+	resp, err = rootCli.Get(t.Context(), "/tenants/acme/abc")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "123", string(resp.Kvs[0].Value))
+
+	// the user of a tenant has no permissions outside of its key space
+	acmeRaw, err := integration2.NewClient(t, clientv3.Config{
+		Endpoints:   c.Endpoints(),
+		DialTimeout: 5 * time.Second,
+		DialOptions: []grpc.DialOption{grpc.WithBlock()},
+		Username:    "acme",
+		Password:    "acmepw",
+	})
+	require.NoError(t, err)
+	defer acmeRaw.Close()
+	_, err = acmeRaw.Get(t.Context(), "/tenants/other/abc")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(tenant.Metrics()...)
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+	var ranges float64
+	for _, mf := range mfs {
+		if mf.GetName() != "etcd_client_tenant_requests_total" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["tenant"] == "acme" && labels["grpc_method"] == "Range" && labels["grpc_code"] == "OK" {
+				ranges += m.GetCounter().GetValue()
+			}
+		}
+	}
+	assert.Positive(t, ranges)
+}
@@ -644,6 +644,178 @@ func TestWatchRequestProgress(t *testing.T) {
 	}
 }
 
+// TestWatchWaitBarrier ensures WaitBarrier returns only once the events up to
+// the barrier of a write have been received from the watch channel.
+func TestWatchWaitBarrier(t *testing.T) {
+	if integration2.ThroughProxy {
+		t.Skipf("grpc-proxy does not support WatchProgress yet")
+	}
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := t.Context()
+	wch := cli.Watch(ctx, "/", clientv3.WithPrefix())
+
+	resp, err := cli.Put(ctx, "/a", "1")
+	require.NoError(t, err)
+
+	donec := make(chan error, 1)
+	go func() { donec <- clientv3.WaitBarrier(ctx, cli, wch, clientv3.BarrierOf(resp.Header)) }()
+	select {
+	case err = <-donec:
+		t.Fatalf("barrier passed before the event was received (err: %v)", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	wresp := <-wch
+	require.Len(t, wresp.Events, 1)
+	select {
+	case err = <-donec:
+		require.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("barrier was not passed after the event was received")
+	}
+
+	// a write out of the watched range is passed through a progress notification
+	resp, err = cli.Put(ctx, "x", "1")
+	require.NoError(t, err)
+	go func() { donec <- clientv3.WaitBarrier(ctx, cli, wch, clientv3.BarrierOf(resp.Header)) }()
+	timeout := time.After(3 * time.Second)
+	for {
+		select {
+		case wresp = <-wch:
+			require.True(t, wresp.IsProgressNotify())
+			continue
+		case err = <-donec:
+			require.NoError(t, err)
+		case <-timeout:
+			t.Fatal("barrier was not passed through a progress notification")
+		}
+		break
+	}
+}
+
+// TestWatchChanStats ensures the stats of a watch channel account the events
+// sent on it until its subscriber catches up.
+func TestWatchChanStats(t *testing.T) {
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx := t.Context()
+	wch := cli.Watch(ctx, "/", clientv3.WithPrefix())
+	other := cli.Watch(ctx, "x")
+
+	var rev int64
+	for i := 0; i < 3; i++ {
+		resp, err := cli.Put(ctx, "/a", strconv.Itoa(i))
+		require.NoError(t, err)
+		rev = resp.Header.Revision
+	}
+
+	require.Eventually(t, func() bool {
+		st, err := clientv3.WatchChanStats(cli, wch)
+		require.NoError(t, err)
+		return st.HeadRevision == rev
+	}, 3*time.Second, 10*time.Millisecond)
+	st, err := clientv3.WatchChanStats(cli, wch)
+	require.NoError(t, err)
+	require.Equal(t, "/", st.Key)
+
+	var events int
+	for events < 3 {
+		select {
+		case wresp := <-wch:
+			events += len(wresp.Events)
+		case <-time.After(3 * time.Second):
+			t.Fatal("timed out waiting for the events")
+		}
+	}
+	require.Eventually(t, func() bool {
+		st, err = clientv3.WatchChanStats(cli, wch)
+		require.NoError(t, err)
+		return st.Lag() == 0
+	}, 3*time.Second, 10*time.Millisecond)
+	require.Equal(t, int64(3), st.Events)
+	require.Positive(t, st.Bytes)
+	require.Equal(t, int64(0), st.Pending)
+
+	// both watchers are multiplexed on the stream of the same context
+	streams, err := clientv3.WatchStreamsStats(cli)
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	require.Len(t, streams[0].Watchers, 2)
+	require.Equal(t, int64(3), streams[0].Events())
+	_, err = clientv3.WatchChanStats(cli, other)
+	require.NoError(t, err)
+}
+
+// TestWatchResumeToken ensures watchers resume from the resume token of a
+// progress notification and reject corrupted tokens.
+func TestWatchResumeToken(t *testing.T) {
+	if integration2.ThroughProxy {
+		t.Skipf("grpc-proxy does not support WatchProgress yet")
+	}
+	integration2.BeforeTest(t)
+
+	clus := integration2.NewCluster(t, &integration2.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	wch := cli.Watch(ctx, "/", clientv3.WithPrefix())
+	_, err := cli.Put(ctx, "/a", "1")
+	require.NoError(t, err)
+	resp := <-wch
+	require.Len(t, resp.Events, 1)
+
+	_, err = cli.Put(ctx, "x", "1")
+	require.NoError(t, err)
+	require.NoError(t, cli.RequestProgress(ctx))
+	resp = <-wch
+	require.True(t, resp.IsProgressNotify())
+	require.NotEmpty(t, resp.ResumeToken)
+	token := resp.ResumeToken
+
+	for _, key := range []string{"/b", "/c"} {
+		_, err = cli.Put(ctx, key, "1")
+		require.NoError(t, err)
+	}
+
+	rch := cli.Watch(ctx, "/", clientv3.WithPrefix(), clientv3.WithResumeToken(token))
+	var keys []string
+	for len(keys) < 2 {
+		select {
+		case resp = <-rch:
+			require.NoError(t, resp.Err())
+			for _, ev := range resp.Events {
+				keys = append(keys, string(ev.Kv.Key))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the events after the resume token, got %v", keys)
+		}
+	}
+	require.Equal(t, []string{"/b", "/c"}, keys)
+
+	// a corrupted token is rejected
+	invalid := append([]byte(nil), token...)
+	invalid[len(invalid)-1]++
+	rch = cli.Watch(clientv3.WithRequireLeader(ctx), "/", clientv3.WithPrefix(), clientv3.WithResumeToken(invalid))
+	select {
+	case resp = <-rch:
+		require.True(t, resp.Canceled)
+		require.ErrorIs(t, resp.Err(), rpctypes.ErrInvalidWatchResumeToken)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the invalid resume token to be rejected")
+	}
+}
+
 func TestWatchEventType(t *testing.T) {
 	integration2.BeforeTest(t)
 
@@ -20,7 +20,9 @@
 package embed_test
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -30,11 +32,16 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3rpc"
 	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
 	"go.etcd.io/etcd/tests/v3/framework/testutils"
 )
@@ -258,3 +265,172 @@ func TestEmbedEtcdStopDuringBootstrapping(t *testing.T) {
 		t.Error("timeout in bootstrapping etcd")
 	}
 }
+
+type echoServer struct{}
+
+// echo returns the key of the request and the name of the authenticated user.
+func (echoServer) echo(ctx context.Context, r *etcdserverpb.RangeRequest) (*etcdserverpb.RangeResponse, error) {
+	kv := &mvccpb.KeyValue{Key: r.Key}
+	if ai, ok := v3rpc.AuthInfoFromContext(ctx); ok {
+		kv.Value = []byte(ai.Username)
+	}
+	return &etcdserverpb.RangeResponse{Kvs: []*mvccpb.KeyValue{kv}}, nil
+}
+
+func newEchoServiceDesc(name string) *grpc.ServiceDesc {
+	return &grpc.ServiceDesc{
+		ServiceName: name,
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Echo",
+			Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				in := new(etcdserverpb.RangeRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req any) (any, error) {
+					return srv.(echoServer).echo(ctx, req.(*etcdserverpb.RangeRequest))
+				}
+				if interceptor == nil {
+					return handler(ctx, in)
+				}
+				return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + name + "/Echo"}, handler)
+			},
+		}},
+	}
+}
+
+func TestEmbedEtcdGRPCServices(t *testing.T) {
+	testutil.SkipTestIfShortMode(t, "Cannot start embedded cluster in --short tests")
+
+	cfg := embed.NewConfig()
+	urls := newEmbedURLs(false, 2)
+	setupEmbedCfg(cfg, []url.URL{urls[0]}, []url.URL{urls[1]})
+	cfg.Dir = filepath.Join(t.TempDir(), "embed-etcd")
+	cfg.GRPCServices = []embed.GRPCService{
+		{Desc: newEchoServiceDesc("embedtest.Public"), Impl: echoServer{}},
+		{Desc: newEchoServiceDesc("embedtest.Private"), Impl: echoServer{}, RequireAuth: true},
+	}
+
+	e, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	defer e.Close()
+	<-e.Server.ReadyNotify()
+
+	newClient := func(username, password string) *clientv3.Client {
+		cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{urls[0].String()}, Username: username, Password: password})
+		require.NoError(t, err)
+		t.Cleanup(func() { cli.Close() })
+		return cli
+	}
+	echo := func(cli *clientv3.Client, service string) (string, error) {
+		var resp etcdserverpb.RangeResponse
+		err := cli.ActiveConnection().Invoke(t.Context(), "/"+service+"/Echo", &etcdserverpb.RangeRequest{Key: []byte("foo")}, &resp)
+		if err != nil {
+			return "", err
+		}
+		require.Len(t, resp.Kvs, 1)
+		require.Equal(t, "foo", string(resp.Kvs[0].Key))
+		return string(resp.Kvs[0].Value), nil
+	}
+
+	cli := newClient("", "")
+	for _, service := range []string{"embedtest.Public", "embedtest.Private"} {
+		user, err := echo(cli, service)
+		require.NoError(t, err)
+		require.Empty(t, user)
+	}
+
+	_, err = cli.UserAdd(t.Context(), "root", "123")
+	require.NoError(t, err)
+	_, err = cli.UserGrantRole(t.Context(), "root", "root")
+	require.NoError(t, err)
+	_, err = cli.AuthEnable(t.Context())
+	require.NoError(t, err)
+
+	_, err = echo(cli, "embedtest.Public")
+	require.NoError(t, err)
+	_, err = echo(cli, "embedtest.Private")
+	require.ErrorIs(t, rpctypes.Error(err), rpctypes.ErrUserEmpty)
+
+	user, err := echo(newClient("root", "123"), "embedtest.Private")
+	require.NoError(t, err)
+	require.Equal(t, "root", user)
+}
+
+func TestEmbedEtcdRecoveryMode(t *testing.T) {
+	testutil.SkipTestIfShortMode(t, "Cannot start embedded cluster in --short tests")
+
+	cfg := embed.NewConfig()
+	urls := newEmbedURLs(false, 2)
+	setupEmbedCfg(cfg, []url.URL{urls[0]}, []url.URL{urls[1]})
+	cfg.Dir = filepath.Join(t.TempDir(), "embed-etcd")
+
+	e, err := embed.StartEtcd(cfg)
+	require.NoError(t, err)
+	<-e.Server.ReadyNotify()
+	memberID := uint64(e.Server.MemberID())
+	cli, err := integration2.NewClient(t, clientv3.Config{Endpoints: []string{urls[0].String()}})
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = cli.Put(t.Context(), fmt.Sprintf("foo%d", i), "bar")
+		require.NoError(t, err)
+	}
+	wresp, err := cli.Get(t.Context(), "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	whash, err := cli.HashKV(t.Context(), urls[0].String(), 0)
+	require.NoError(t, err)
+	cli.Close()
+	e.Close()
+
+	cfg.RecoveryMode = true
+	r, err := embed.StartRecovery(cfg)
+	require.NoError(t, err)
+	defer r.Close()
+
+	cli, err = integration2.NewClient(t, clientv3.Config{Endpoints: []string{urls[0].String()}})
+	require.NoError(t, err)
+	defer cli.Close()
+
+	resp, err := cli.Get(t.Context(), "foo", clientv3.WithPrefix())
+	require.NoError(t, err)
+	assert.Equal(t, wresp.Kvs, resp.Kvs)
+	assert.Equal(t, wresp.Header.Revision, resp.Header.Revision)
+	assert.Equal(t, memberID, resp.Header.MemberId)
+
+	_, err = cli.Put(t.Context(), "foo", "baz")
+	require.ErrorIs(t, rpctypes.Error(err), rpctypes.ErrRecoveryMode)
+	_, err = cli.Compact(t.Context(), resp.Header.Revision)
+	require.ErrorIs(t, rpctypes.Error(err), rpctypes.ErrRecoveryMode)
+
+	hash, err := cli.HashKV(t.Context(), urls[0].String(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, whash.Hash, hash.Hash)
+
+	status, err := cli.Status(t.Context(), urls[0].String())
+	require.NoError(t, err)
+	assert.Zero(t, status.Leader)
+	assert.NotZero(t, status.RaftIndex)
+	assert.NotEmpty(t, status.Errors)
+
+	rc, err := cli.Snapshot(t.Context())
+	require.NoError(t, err)
+	n, err := io.Copy(io.Discard, rc)
+	require.NoError(t, err)
+	assert.Positive(t, n)
+	rc.Close()
+}
+
+func TestEmbedEtcdRecoveryModeRejectsRemoteURLs(t *testing.T) {
+	cfg := embed.NewConfig()
+	urls := newEmbedURLs(false, 1)
+	setupEmbedCfg(cfg, []url.URL{urls[0]}, []url.URL{urls[0]})
+	cfg.Dir = t.TempDir()
+	u, err := url.Parse("http://10.0.0.1:2379")
+	require.NoError(t, err)
+	cfg.ListenClientUrls = []url.URL{*u}
+	cfg.RecoveryMode = true
+
+	_, err = embed.StartRecovery(cfg)
+	require.ErrorContains(t, err, "not allowed in recovery mode")
+}
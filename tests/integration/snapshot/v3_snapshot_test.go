@@ -30,6 +30,7 @@ import (
 
 	"go.etcd.io/etcd/client/pkg/v3/testutil"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	clientsnapshot "go.etcd.io/etcd/client/v3/snapshot"
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.etcd.io/etcd/server/v3/embed"
 	integration2 "go.etcd.io/etcd/tests/v3/framework/integration"
@@ -59,6 +60,7 @@ func TestSnapshotV3RestoreSingle(t *testing.T) {
 	for _, p := range pURLs {
 		pss = append(pss, p.String())
 	}
+	var progress clientsnapshot.Progress
 	err := sp.Restore(snapshot.RestoreConfig{
 		SnapshotPath:        dbPath,
 		Name:                cfg.Name,
@@ -66,8 +68,13 @@ func TestSnapshotV3RestoreSingle(t *testing.T) {
 		InitialCluster:      cfg.InitialCluster,
 		InitialClusterToken: cfg.InitialClusterToken,
 		PeerURLs:            pss,
+		Progress:            func(p clientsnapshot.Progress) { progress = p },
 	})
 	require.NoError(t, err)
+	dbInfo, err := os.Stat(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, dbInfo.Size(), progress.Bytes)
+	require.Equal(t, dbInfo.Size(), progress.TotalBytes)
 
 	srv, err := embed.StartEtcd(cfg)
 	require.NoError(t, err)
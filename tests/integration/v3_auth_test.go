@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 
 	"go.etcd.io/etcd/api/v3/authpb"
 	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
@@ -280,6 +281,101 @@ func TestV3AuthWithLeaseAttach(t *testing.T) {
 	}
 }
 
+func TestV3AuthImpersonate(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	users := []user{
+		{
+			name:     "user1",
+			password: "user1-123",
+			role:     "role1",
+			key:      "k1",
+			end:      "k2",
+		},
+	}
+	authSetupUsers(t, integration.ToGRPC(clus.Client(0)).Auth, users)
+
+	authSetupRoot(t, integration.ToGRPC(clus.Client(0)).Auth)
+
+	rootc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "root", Password: "123"})
+	require.NoError(t, cerr)
+	defer rootc.Close()
+
+	ctx := clientv3.WithImpersonatedUser(t.Context(), "user1")
+	_, err := rootc.Put(ctx, "k1", "val")
+	require.NoError(t, err)
+	// permission of k3 isn't granted to user1
+	_, err = rootc.Put(ctx, "k3", "val")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+	_, err = rootc.Put(t.Context(), "k3", "val")
+	require.NoError(t, err)
+
+	wresp := <-rootc.Watch(ctx, "k3", clientv3.WithRev(1))
+	require.Equal(t, rpctypes.ErrGRPCPermissionDenied.Error(), wresp.Err().Error())
+
+	_, err = rootc.Get(clientv3.WithImpersonatedUser(t.Context(), "nonexistent"), "k1")
+	require.ErrorIs(t, err, rpctypes.ErrUserNotFound)
+
+	// only root may impersonate other users
+	userc, cerr := integration.NewClient(t, clientv3.Config{Endpoints: clus.Client(0).Endpoints(), Username: "user1", Password: "user1-123"})
+	require.NoError(t, cerr)
+	defer userc.Close()
+	_, err = userc.Get(clientv3.WithImpersonatedUser(t.Context(), "root"), "k3")
+	require.ErrorIs(t, err, rpctypes.ErrPermissionDenied)
+}
+
+// TestV3AuthTokenRefresh ensures that the tokens of the users having a role
+// with a token TTL override expire after it even if they are used, and that
+// AuthTokenRefresh assigns new tokens without the password.
+func TestV3AuthTokenRefresh(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{
+		Size:              1,
+		AuthTokenRoleTTLs: map[string]time.Duration{"role1": 2 * time.Second},
+	})
+	defer clus.Terminate(t)
+
+	api := integration.ToGRPC(clus.Client(0))
+	authSetupUsers(t, api.Auth, []user{{name: "user1", password: "user1-123", role: "role1", key: "k1"}})
+	authSetupRoot(t, api.Auth)
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(t.Context(), rpctypes.TokenFieldNameGRPC, token)
+	}
+
+	_, err := api.Auth.AuthTokenRefresh(t.Context(), &pb.AuthTokenRefreshRequest{})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCInvalidAuthToken), "got %v, expected %v", err, rpctypes.ErrGRPCInvalidAuthToken)
+
+	rootResp, err := api.Auth.Authenticate(t.Context(), &pb.AuthenticateRequest{Name: "root", Password: "123"})
+	require.NoError(t, err)
+	refreshResp, err := api.Auth.AuthTokenRefresh(withToken(rootResp.Token), &pb.AuthTokenRefreshRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), refreshResp.Ttl)
+
+	authResp, err := api.Auth.Authenticate(t.Context(), &pb.AuthenticateRequest{Name: "user1", Password: "user1-123"})
+	require.NoError(t, err)
+	refreshResp, err = api.Auth.AuthTokenRefresh(withToken(authResp.Token), &pb.AuthTokenRefreshRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), refreshResp.Ttl)
+	require.NotEqual(t, authResp.Token, refreshResp.Token)
+	_, err = api.KV.Put(withToken(refreshResp.Token), &pb.PutRequest{Key: []byte("k1"), Value: []byte("v")})
+	require.NoError(t, err)
+
+	// the token expires even if it is used
+	start := time.Now()
+	for {
+		_, err = api.KV.Put(withToken(authResp.Token), &pb.PutRequest{Key: []byte("k1"), Value: []byte("v")})
+		if err != nil || time.Since(start) > 10*time.Second {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCInvalidAuthToken), "got %v, expected %v", err, rpctypes.ErrGRPCInvalidAuthToken)
+	_, err = api.Auth.AuthTokenRefresh(withToken(authResp.Token), &pb.AuthTokenRefreshRequest{})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCInvalidAuthToken), "got %v, expected %v", err, rpctypes.ErrGRPCInvalidAuthToken)
+}
+
 func authSetupUsers(t *testing.T, auth pb.AuthClient, users []user) {
 	for _, user := range users {
 		_, err := auth.UserAdd(t.Context(), &pb.AuthUserAddRequest{Name: user.name, Password: user.password, Options: &authpb.UserAddOptions{NoPassword: false}})
@@ -17,9 +17,12 @@ package integration
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
 	"strings"
@@ -36,6 +39,7 @@ import (
 	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	"go.etcd.io/etcd/client/pkg/v3/transport"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/etcdserver/validator"
 	"go.etcd.io/etcd/tests/v3/framework/config"
 	"go.etcd.io/etcd/tests/v3/framework/integration"
 )
@@ -1235,6 +1239,7 @@ func TestV3StorageQuotaAPI(t *testing.T) {
 
 	// Set a quota on one node
 	clus.Members[0].QuotaBackendBytes = quotasize
+	clus.Members[0].QuotaBackendWarningRatio = 0.1
 	clus.Members[0].Stop(t)
 	clus.Members[0].Restart(t)
 
@@ -1244,10 +1249,13 @@ func TestV3StorageQuotaAPI(t *testing.T) {
 
 	key := []byte("abc")
 
-	// test small put that fits in quota
+	// test small put that fits in quota, past the warning ratio
 	smallbuf := make([]byte, 512)
-	_, err := kvc.Put(t.Context(), &pb.PutRequest{Key: key, Value: smallbuf})
+	var header metadata.MD
+	_, err := kvc.Put(t.Context(), &pb.PutRequest{Key: key, Value: smallbuf}, grpc.Header(&header))
 	require.NoError(t, err)
+	require.Len(t, header.Get(rpctypes.MetadataQuotaWarningKey), 1)
+	require.Contains(t, header.Get(rpctypes.MetadataQuotaWarningKey)[0], "space quota")
 
 	// test big put
 	bigbuf := make([]byte, quotasize)
@@ -1255,6 +1263,12 @@ func TestV3StorageQuotaAPI(t *testing.T) {
 	if !eqErrGRPC(err, rpctypes.ErrGRPCNoSpace) {
 		t.Fatalf("big put got %v, expected %v", err, rpctypes.ErrGRPCNoSpace)
 	}
+	var nerr *rpctypes.NoSpaceError
+	require.ErrorAs(t, rpctypes.Error(err), &nerr)
+	require.Equal(t, quotasize, nerr.Details.Quota)
+	require.Positive(t, nerr.Details.DBSize)
+	require.Positive(t, nerr.Details.DBSizeInUse)
+	require.Greater(t, nerr.Details.RequestCost, quotasize)
 
 	// test big txn
 	puttxn := &pb.RequestOp{
@@ -1273,6 +1287,152 @@ func TestV3StorageQuotaAPI(t *testing.T) {
 	}
 }
 
+// TestV3ValueSizeWarning tests that the requests writing values larger than
+// the value size warning threshold succeed with a value size warning header.
+func TestV3ValueSizeWarning(t *testing.T) {
+	integration.BeforeTest(t)
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	clus.Members[0].WarningValueSizeBytes = 1024
+	clus.Members[0].Stop(t)
+	clus.Members[0].Restart(t)
+	clus.WaitMembersForLeader(t, clus.Members)
+	kvc := integration.ToGRPC(clus.Client(0)).KV
+	waitForRestart(t, kvc)
+
+	var header metadata.MD
+	_, err := kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/app/small"), Value: make([]byte, 1024)}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Empty(t, header.Get(rpctypes.MetadataValueSizeWarningKey))
+
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/app/big"), Value: make([]byte, 1025)}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Len(t, header.Get(rpctypes.MetadataValueSizeWarningKey), 1)
+	require.Contains(t, header.Get(rpctypes.MetadataValueSizeWarningKey)[0], `"/app/big"`)
+
+	// only the puts of the executed branch are warned of
+	big := func(key string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(key), Value: make([]byte, 2048)}}}
+	}
+	txn := &pb.TxnRequest{
+		Success: []*pb.RequestOp{big("/app/success-1"), big("/app/success-2")},
+		Failure: []*pb.RequestOp{big("/app/failure")},
+	}
+	_, err = kvc.Txn(t.Context(), txn, grpc.Header(&header))
+	require.NoError(t, err)
+	warnings := header.Get(rpctypes.MetadataValueSizeWarningKey)
+	require.Len(t, warnings, 2)
+	require.Contains(t, warnings[0], `"/app/success-1"`)
+	require.Contains(t, warnings[1], `"/app/success-2"`)
+}
+
+// TestV3PrefixKeyLimit tests that the requests creating keys past the maximum
+// number of keys of a prefix are rejected.
+func TestV3PrefixKeyLimit(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	clus.Members[0].MaxKeysPerPrefix = map[string]int64{"/limited/": 2, "/limited/one/": 1}
+	clus.Members[0].Stop(t)
+	clus.Members[0].Restart(t)
+	kvc := integration.ToGRPC(clus.Client(0)).KV
+	waitForRestart(t, kvc)
+
+	put := func(key string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(key), Value: []byte("v")}}}
+	}
+
+	_, err := kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/limited/one/a"), Value: []byte("v")})
+	require.NoError(t, err)
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/limited/one/b"), Value: []byte("v")})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCTooManyKeysInPrefix), "got %v, expected %v", err, rpctypes.ErrGRPCTooManyKeysInPrefix)
+
+	// updating existing keys and creating keys under other prefixes is allowed
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/limited/one/a"), Value: []byte("v2")})
+	require.NoError(t, err)
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/other"), Value: []byte("v")})
+	require.NoError(t, err)
+
+	// only the branch of a txn that executes counts
+	missing := &pb.Compare{Key: []byte("/limited/b"), Target: pb.Compare_VERSION, Result: pb.Compare_GREATER, TargetUnion: &pb.Compare_Version{Version: 0}}
+	_, err = kvc.Txn(t.Context(), &pb.TxnRequest{Compare: []*pb.Compare{missing}, Failure: []*pb.RequestOp{put("/limited/c"), put("/limited/d")}})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCTooManyKeysInPrefix), "got %v, expected %v", err, rpctypes.ErrGRPCTooManyKeysInPrefix)
+	_, err = kvc.Txn(t.Context(), &pb.TxnRequest{Success: []*pb.RequestOp{put("/limited/b"), put("/limited/one/a")}, Failure: []*pb.RequestOp{put("/limited/c"), put("/limited/d")}})
+	require.NoError(t, err)
+
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/limited/c"), Value: []byte("v")})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCTooManyKeysInPrefix), "got %v, expected %v", err, rpctypes.ErrGRPCTooManyKeysInPrefix)
+
+	// deleting a key makes room for a new one
+	_, err = kvc.DeleteRange(t.Context(), &pb.DeleteRangeRequest{Key: []byte("/limited/b")})
+	require.NoError(t, err)
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/limited/c"), Value: []byte("v")})
+	require.NoError(t, err)
+}
+
+func TestV3ValueValidators(t *testing.T) {
+	integration.BeforeTest(t)
+
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var review validator.Review
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if string(review.Value) == "down" {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		review.Allowed = strings.HasPrefix(string(review.Value), "v")
+		json.NewEncoder(w).Encode(review)
+	}))
+	defer webhook.Close()
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	jsonValidator, err := validator.Parse(validator.JSON)
+	require.NoError(t, err)
+	webhookValidator, err := validator.Parse(webhook.URL)
+	require.NoError(t, err)
+	clus.Members[0].ValueValidators = map[string]validator.Validator{"/config/": jsonValidator, "/app/": webhookValidator}
+	clus.Members[0].Stop(t)
+	clus.Members[0].Restart(t)
+	kvc := integration.ToGRPC(clus.Client(0)).KV
+	waitForRestart(t, kvc)
+
+	put := func(key, value string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(key), Value: []byte(value)}}}
+	}
+
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/config/a"), Value: []byte(`{"a":1}`)})
+	require.NoError(t, err)
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/config/a"), Value: []byte(`{"a":`)})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCInvalidValue), "got %v, expected %v", err, rpctypes.ErrGRPCInvalidValue)
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/other"), Value: []byte(`{"a":`)})
+	require.NoError(t, err)
+
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/app/a"), Value: []byte("v1")})
+	require.NoError(t, err)
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/app/a"), Value: []byte("x1")})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCInvalidValue), "got %v, expected %v", err, rpctypes.ErrGRPCInvalidValue)
+	_, err = kvc.Put(t.Context(), &pb.PutRequest{Key: []byte("/app/a"), Value: []byte("down")})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCValidatorUnavailable), "got %v, expected %v", err, rpctypes.ErrGRPCValidatorUnavailable)
+
+	// a txn is rejected if a put of one of its branches writes an invalid value
+	_, err = kvc.Txn(t.Context(), &pb.TxnRequest{Success: []*pb.RequestOp{put("/app/b", "v")}, Failure: []*pb.RequestOp{put("/config/b", "{")}})
+	require.Truef(t, eqErrGRPC(err, rpctypes.ErrGRPCInvalidValue), "got %v, expected %v", err, rpctypes.ErrGRPCInvalidValue)
+	_, err = kvc.Txn(t.Context(), &pb.TxnRequest{Success: []*pb.RequestOp{put("/app/b", "v")}, Failure: []*pb.RequestOp{put("/config/b", "{}")}})
+	require.NoError(t, err)
+
+	resp, err := kvc.Range(t.Context(), &pb.RangeRequest{Key: []byte("/app/a")})
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	require.Equal(t, "v1", string(resp.Kvs[0].Value))
+}
+
 func TestV3RangeRequest(t *testing.T) {
 	integration.BeforeTest(t)
 	tests := []struct {
@@ -17,6 +17,7 @@ package integration
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"testing"
 	"time"
@@ -142,3 +143,44 @@ func TestTLSMinMaxVersion(t *testing.T) {
 		})
 	}
 }
+
+// TestTLSPeerCertificates ensures that every member reports the
+// expiry of its peer certificate and of the ones its peers present on the
+// raft streams.
+func TestTLSPeerCertificates(t *testing.T) {
+	integration.BeforeTest(t)
+
+	clus := integration.NewCluster(t, &integration.ClusterConfig{Size: 3, PeerTLS: &integration.TestTLSInfo})
+	defer clus.Terminate(t)
+
+	pair, err := tls.LoadX509KeyPair(integration.TestTLSInfo.CertFile, integration.TestTLSInfo.KeyFile)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	require.NoError(t, err)
+
+	cli := clus.RandClient()
+	for i, m := range clus.Members {
+		var resp *clientv3.PeerCertificatesResponse
+		// the streams with the peers are established asynchronously
+		require.Eventually(t, func() bool {
+			resp, err = cli.PeerCertificates(t.Context(), m.GRPCURL)
+			require.NoError(t, err)
+			peers := make(map[uint64]int)
+			for _, c := range resp.Peers {
+				peers[c.MemberId]++
+			}
+			return len(peers) == len(clus.Members)-1
+		}, 10*time.Second, 100*time.Millisecond, "member %d", i)
+
+		assert.Equal(t, uint64(m.ID()), resp.Header.MemberId, "member %d", i)
+		require.NotNil(t, resp.Local, "member %d", i)
+		assert.Equal(t, uint64(m.ID()), resp.Local.MemberId, "member %d", i)
+		assert.Equal(t, cert.Subject.String(), resp.Local.Subject, "member %d", i)
+		assert.Equal(t, cert.NotAfter.Unix(), resp.Local.NotAfter, "member %d", i)
+		for _, c := range resp.Peers {
+			assert.NotEqual(t, uint64(m.ID()), c.MemberId, "member %d", i)
+			assert.Contains(t, []string{"message", "msgappv2"}, c.Stream, "member %d", i)
+			assert.Equal(t, cert.NotAfter.Unix(), c.NotAfter, "member %d", i)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go.etcd.io/etcd/pkg/v3/report"
+)
+
+var compareCmd = &cobra.Command{
+	Use:   "compare <old.json> <new.json>",
+	Short: "Compare the latencies of the reports of two runs output in JSON",
+	Long: `Compare the latencies of the percentiles of the reports of a baseline run and of a new run,
+both output with '--output json', matching the reports by name.
+
+A change is significant if the 95% confidence intervals of the latency in both runs do not
+overlap, and the change is above the threshold. Exits with a non-zero status if the latency
+of any percentile significantly regressed.`,
+	Args: cobra.ExactArgs(2),
+	Run:  compareFunc,
+}
+
+var compareThreshold float64
+
+func init() {
+	RootCmd.AddCommand(compareCmd)
+	compareCmd.Flags().Float64Var(&compareThreshold, "threshold", 5, "Minimum change of a latency, in percent, to be significant")
+}
+
+func compareFunc(_ *cobra.Command, args []string) {
+	old, err := readSummaries(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	cur, err := readSummaries(args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	cs := report.CompareSummaries(old, cur, compareThreshold)
+	if len(cs) == 0 {
+		fmt.Fprintln(os.Stderr, "no reports of the same name in both runs")
+		os.Exit(1)
+	}
+	regressed := false
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "REPORT\tPERCENTILE\tOLD\tNEW\tCHANGE\tRESULT")
+	for _, c := range cs {
+		result := ""
+		switch {
+		case c.Regression:
+			result = "regression"
+			regressed = true
+		case c.Improvement:
+			result = "improvement"
+		}
+		fmt.Fprintf(w, "%s\t%v%%\t%.4f secs\t%.4f secs\t%+.2f%%\t%s\n", c.Name, c.Percentile, c.Old, c.New, c.Change, result)
+	}
+	w.Flush()
+	if regressed {
+		os.Exit(1)
+	}
+}
+
+// readSummaries reads the summaries of the reports of a run output in JSON.
+func readSummaries(path string) ([]report.Summary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ss []report.Summary
+	dec := json.NewDecoder(f)
+	for {
+		var s report.Summary
+		err := dec.Decode(&s)
+		if errors.Is(err, io.EOF) {
+			return ss, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the reports of %s: %w", path, err)
+		}
+		ss = append(ss, s)
+	}
+}
@@ -16,7 +16,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/cheggaaa/pb/v3"
@@ -78,5 +77,5 @@ func leaseKeepaliveFunc(cmd *cobra.Command, _ []string) {
 	wg.Wait()
 	close(r.Results())
 	bar.Finish()
-	fmt.Printf("%s", <-rc)
+	printReport("%s", <-rc)
 }
@@ -131,5 +131,5 @@ func mvccPutFunc(cmd *cobra.Command, _ []string) {
 	}
 
 	close(r.Results())
-	fmt.Printf("%s", <-rc)
+	printReport("%s", <-rc)
 }
@@ -129,7 +129,7 @@ func putFunc(cmd *cobra.Command, _ []string) {
 	wg.Wait()
 	close(r.Results())
 	bar.Finish()
-	fmt.Println(<-rc)
+	printReport("%s\n", <-rc)
 
 	if checkHashkv {
 		hashKV(cmd, clients)
@@ -179,5 +179,5 @@ func hashKV(cmd *cobra.Command, clients []*v3.Client) {
 	rs += fmt.Sprintf("\tEndpoint: %s\n", host)
 	rs += fmt.Sprintf("\tTime taken to get hashkv: %v\n", time.Since(st))
 	rs += fmt.Sprintf("\tDB size: %s", humanize.Bytes(uint64(rt.DbSize)))
-	fmt.Println(rs)
+	fmt.Fprintln(infoWriter(), rs)
 }
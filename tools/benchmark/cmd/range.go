@@ -67,9 +67,9 @@ func rangeFunc(cmd *cobra.Command, args []string) {
 	}
 
 	if rangeConsistency == "l" {
-		fmt.Println("bench with linearizable range")
+		fmt.Fprintln(infoWriter(), "bench with linearizable range")
 	} else if rangeConsistency == "s" {
-		fmt.Println("bench with serializable range")
+		fmt.Fprintln(infoWriter(), "bench with serializable range")
 	} else {
 		fmt.Fprintln(os.Stderr, cmd.Usage())
 		os.Exit(1)
@@ -121,5 +121,5 @@ func rangeFunc(cmd *cobra.Command, args []string) {
 	wg.Wait()
 	close(r.Results())
 	bar.Finish()
-	fmt.Printf("%s", <-rc)
+	printReport("%s", <-rc)
 }
@@ -57,10 +57,12 @@ var (
 	autoSyncInterval time.Duration
 
 	generatePerfReport bool
+
+	outputFormat string
 )
 
 func init() {
-	RootCmd.PersistentFlags().StringSliceVar(&endpoints, "endpoints", []string{"127.0.0.1:2379"}, "gRPC endpoints")
+	RootCmd.PersistentFlags().StringSliceVar(&endpoints, "endpoints", []string{"127.0.0.1:2379"}, "gRPC endpoints, or a single xds:/// target resolved by the xDS management server of a proxyless gRPC service mesh")
 	RootCmd.PersistentFlags().UintVar(&totalConns, "conns", 1, "Total number of gRPC connections")
 	RootCmd.PersistentFlags().UintVar(&totalClients, "clients", 1, "Total number of gRPC clients")
 
@@ -78,4 +80,5 @@ func init() {
 	RootCmd.PersistentFlags().DurationVar(&autoSyncInterval, "auto-sync-interval", time.Duration(0), "AutoSyncInterval is the interval to update endpoints with its latest members")
 
 	RootCmd.PersistentFlags().BoolVar(&generatePerfReport, "report-perfdash", false, "Generate benchmark report in perfdash format")
+	RootCmd.PersistentFlags().StringVar(&outputFormat, "output", outputText, "Output format of the reports (text, json). With json, each report is printed as a line of JSON read by 'benchmark compare'")
 }
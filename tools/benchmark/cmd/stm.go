@@ -148,7 +148,7 @@ func stmFunc(cmd *cobra.Command, _ []string) {
 	wg.Wait()
 	close(r.Results())
 	bar.Finish()
-	fmt.Printf("%s", <-rc)
+	printReport("%s", <-rc)
 }
 
 func doSTM(client *v3.Client, requests <-chan stmApply, results chan<- report.Result) {
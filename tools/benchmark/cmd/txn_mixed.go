@@ -76,9 +76,9 @@ func mixedTxnFunc(cmd *cobra.Command, _ []string) {
 	}
 
 	if rangeConsistency == "l" {
-		fmt.Println("bench with linearizable range")
+		fmt.Fprintln(infoWriter(), "bench with linearizable range")
 	} else if rangeConsistency == "s" {
-		fmt.Println("bench with serializable range")
+		fmt.Fprintln(infoWriter(), "bench with serializable range")
 	} else {
 		fmt.Fprintln(os.Stderr, cmd.Usage())
 		os.Exit(1)
@@ -144,8 +144,6 @@ func mixedTxnFunc(cmd *cobra.Command, _ []string) {
 	close(reportRead.Results())
 	close(reportWrite.Results())
 	bar.Finish()
-	fmt.Printf("Total Read Ops: %d\nDetails:", readOpsTotal)
-	fmt.Println(<-rcRead)
-	fmt.Printf("Total Write Ops: %d\nDetails:", writeOpsTotal)
-	fmt.Println(<-rcWrite)
+	printReport(fmt.Sprintf("Total Read Ops: %d\nDetails:%%s\n", readOpsTotal), <-rcRead)
+	printReport(fmt.Sprintf("Total Write Ops: %d\nDetails:%%s\n", writeOpsTotal), <-rcWrite)
 }
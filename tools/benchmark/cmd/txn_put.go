@@ -109,5 +109,5 @@ func txnPutFunc(cmd *cobra.Command, _ []string) {
 	wg.Wait()
 	close(r.Results())
 	bar.Finish()
-	fmt.Println(<-rc)
+	printReport("%s\n", <-rc)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -25,6 +26,7 @@ import (
 	"google.golang.org/grpc/grpclog"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
+	_ "go.etcd.io/etcd/client/v3/xds" // enables the xds endpoints
 	"go.etcd.io/etcd/pkg/v3/report"
 )
 
@@ -163,9 +165,9 @@ func newReport(reportName string) report.Report {
 		p = "%g"
 	}
 	if sample {
-		return report.NewReportSample(p, reportName, generatePerfReport)
+		return withOutputFormat(report.NewReportSample(p, reportName, generatePerfReport), reportName)
 	}
-	return report.NewReport(p, reportName, generatePerfReport)
+	return withOutputFormat(report.NewReport(p, reportName, generatePerfReport), reportName)
 }
 
 func newWeightedReport(reportName string) report.Report {
@@ -174,7 +176,46 @@ func newWeightedReport(reportName string) report.Report {
 		p = "%g"
 	}
 	if sample {
-		return report.NewReportSample(p, reportName, generatePerfReport)
+		return withOutputFormat(report.NewReportSample(p, reportName, generatePerfReport), reportName)
 	}
-	return report.NewWeightedReport(report.NewReport(p, reportName, generatePerfReport), p, reportName, generatePerfReport)
+	return withOutputFormat(report.NewWeightedReport(report.NewReport(p, reportName, generatePerfReport), p, reportName, generatePerfReport), reportName)
+}
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// withOutputFormat returns the report r printing its summary in the output
+// format.
+func withOutputFormat(r report.Report, reportName string) report.Report {
+	switch outputFormat {
+	case outputText:
+		return r
+	case outputJSON:
+		return report.NewJSONReport(r, reportName)
+	}
+	fmt.Fprintf(os.Stderr, "unknown output format %q\n", outputFormat)
+	os.Exit(1)
+	return nil
+}
+
+// printReport prints the summary of a report with the format, unless the
+// output format is JSON, printing it alone so that the output can be read by
+// "benchmark compare".
+func printReport(format, summary string) {
+	if outputFormat == outputJSON {
+		fmt.Println(summary)
+		return
+	}
+	fmt.Printf(format, summary)
+}
+
+// infoWriter returns the writer of the output other than the reports, which
+// is stderr if the output format is JSON.
+func infoWriter() io.Writer {
+	if outputFormat == outputJSON {
+		return os.Stderr
+	}
+	return os.Stdout
 }
@@ -153,7 +153,7 @@ func benchMakeWatches(clients []*clientv3.Client, wk *watchedKeys) {
 	wg.Wait()
 	bar.Finish()
 	close(r.Results())
-	fmt.Printf("Watch creation summary:\n%s", <-rc)
+	printReport("Watch creation summary:\n%s", <-rc)
 
 	for i := 0; i < len(streams); i++ {
 		wk.watches = append(wk.watches, (<-wc)...)
@@ -232,7 +232,7 @@ func benchPutWatches(clients []*clientv3.Client, wk *watchedKeys) {
 	wg.Wait()
 	bar.Finish()
 	close(r.Results())
-	fmt.Printf("Watch events received summary:\n%s", <-rc)
+	printReport("Watch events received summary:\n%s", <-rc)
 }
 
 func recvWatchChan(wch clientv3.WatchChan, results chan<- report.Result, nrRxed *int32) {
@@ -98,7 +98,7 @@ func watchGetFunc(cmd *cobra.Command, _ []string) {
 	wg.Wait()
 	cancel()
 	bar.Finish()
-	fmt.Printf("Get during watch summary:\n%s", <-rc)
+	printReport("Get during watch summary:\n%s", <-rc)
 }
 
 func doUnsyncWatch(stream v3.Watcher, rev int64, f func()) {
@@ -114,7 +114,7 @@ func watchLatencyFunc(cmd *cobra.Command, _ []string) {
 	wg.Wait()
 	close(putReport.Results())
 	bar.Finish()
-	fmt.Printf("\nPut summary:\n%s", <-putReportResults)
+	printReport("\nPut summary:\n%s", <-putReportResults)
 
 	for i := 0; i < len(wchs); i++ {
 		for j := 0; j < watchLPutTotal; j++ {
@@ -128,7 +128,7 @@ func watchLatencyFunc(cmd *cobra.Command, _ []string) {
 	}
 
 	close(watchReport.Results())
-	fmt.Printf("\nWatch events summary:\n%s", <-watchReportResults)
+	printReport("\nWatch events summary:\n%s", <-watchReportResults)
 }
 
 func setupWatchChannels(key string) []clientv3.WatchChan {
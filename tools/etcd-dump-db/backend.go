@@ -15,9 +15,11 @@
 package main
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -52,7 +54,7 @@ func getBuckets(dbPath string) (buckets []string, err error) {
 
 // TODO: import directly from packages, rather than copy&paste
 
-type decoder func(k, v []byte)
+type decoder func(k, v []byte) record
 
 // key is the bucket name, and value is the function to decode K/V in the bucket.
 var decoders = map[string]decoder{
@@ -64,17 +66,49 @@ var decoders = map[string]decoder{
 	"meta":      metaDecoder,
 }
 
-func defaultDecoder(k, v []byte) {
-	fmt.Printf("key=%q, value=%q\n", k, v)
+func defaultDecoder(k, v []byte) record {
+	return record{
+		text:   fmt.Sprintf("key=%q, value=%q", k, v),
+		fields: []field{{"key", string(k)}, {"value", string(v)}},
+	}
 }
 
-func keyDecoder(k, v []byte) {
+func keyDecoder(k, v []byte) record {
 	rev := mvcc.BytesToBucketKey(k)
 	var kv mvccpb.KeyValue
 	if err := kv.Unmarshal(v); err != nil {
 		panic(err)
 	}
-	fmt.Printf("rev=%+v, value=[key %q | val %q | created %d | mod %d | ver %d]\n", rev, string(kv.Key), string(kv.Value), kv.CreateRevision, kv.ModRevision, kv.Version)
+	value := kv.Value
+	if valueExcerptBytes != 0 && len(value) > valueExcerptBytes {
+		value = value[:valueExcerptBytes]
+	}
+	return record{
+		text: fmt.Sprintf("rev={main:%d sub:%d}, tombstone=%t, key=%q, created=%d, mod=%d, ver=%d, lease=%016x, value=%s",
+			rev.Main, rev.Sub, mvcc.IsTombstone(k), kv.Key, kv.CreateRevision, kv.ModRevision, kv.Version, kv.Lease, valueExcerpt(kv.Value)),
+		fields: []field{
+			{"main", rev.Main},
+			{"sub", rev.Sub},
+			{"tombstone", mvcc.IsTombstone(k)},
+			{"key", string(kv.Key)},
+			{"create_revision", kv.CreateRevision},
+			{"mod_revision", kv.ModRevision},
+			{"version", kv.Version},
+			{"lease", kv.Lease},
+			{"value", string(value)},
+			{"value_size", len(kv.Value)},
+		},
+	}
+}
+
+// valueExcerpt quotes the first valueExcerptBytes bytes of the value, or the
+// whole value if valueExcerptBytes is 0, followed by the size of the value if
+// it is truncated.
+func valueExcerpt(v []byte) string {
+	if valueExcerptBytes == 0 || len(v) <= valueExcerptBytes {
+		return fmt.Sprintf("%q", v)
+	}
+	return fmt.Sprintf("%q... (%d bytes)", v[:valueExcerptBytes], len(v))
 }
 
 func bytesToLeaseID(bytes []byte) int64 {
@@ -84,54 +118,122 @@ func bytesToLeaseID(bytes []byte) int64 {
 	return int64(binary.BigEndian.Uint64(bytes))
 }
 
-func leaseDecoder(k, v []byte) {
+func leaseDecoder(k, v []byte) record {
 	leaseID := bytesToLeaseID(k)
 	var lpb leasepb.Lease
 	if err := lpb.Unmarshal(v); err != nil {
 		panic(err)
 	}
-	fmt.Printf("lease ID=%016x, TTL=%ds, remaining TTL=%ds\n", leaseID, lpb.TTL, lpb.RemainingTTL)
+	// the expiry of the leases is not persisted: a new leader grants them
+	// their checkpointed remaining TTL, or their whole TTL if none is
+	expiresIn := lpb.TTL
+	if lpb.RemainingTTL > 0 {
+		expiresIn = lpb.RemainingTTL
+	}
+	return record{
+		text: fmt.Sprintf("lease ID=%016x, TTL=%ds, remaining TTL=%ds, expires in=%ds after leader election",
+			leaseID, lpb.TTL, lpb.RemainingTTL, expiresIn),
+		fields: []field{
+			{"id", leaseID},
+			{"ttl", lpb.TTL},
+			{"remaining_ttl", lpb.RemainingTTL},
+			{"expires_in", expiresIn},
+		},
+	}
 }
 
-func authDecoder(k, v []byte) {
-	if string(k) == "authRevision" {
+func authDecoder(k, v []byte) record {
+	switch {
+	case bytes.Equal(k, schema.AuthRevisionKeyName) && len(v) == 8:
 		rev := binary.BigEndian.Uint64(v)
-		fmt.Printf("key=%q, value=%v\n", k, rev)
-	} else {
-		fmt.Printf("key=%q, value=%v\n", k, v)
+		return record{
+			text:   fmt.Sprintf("key=%q, value=%v", k, rev),
+			fields: []field{{"key", string(k)}, {"value", rev}},
+		}
+	case bytes.Equal(k, schema.AuthEnabledKeyName) && len(v) == 1:
+		enabled := v[0] == 1
+		return record{
+			text:   fmt.Sprintf("key=%q, value=%t", k, enabled),
+			fields: []field{{"key", string(k)}, {"value", enabled}},
+		}
+	default:
+		return record{
+			text:   fmt.Sprintf("key=%q, value=%v", k, v),
+			fields: []field{{"key", string(k)}, {"value", string(v)}},
+		}
 	}
 }
 
-func authRolesDecoder(_, v []byte) {
+// permission is the structured form of a key permission of a role.
+type permission struct {
+	Type     string `json:"type"`
+	Key      string `json:"key"`
+	RangeEnd string `json:"range_end,omitempty"`
+}
+
+// String returns the permission type followed by the key, or the range of
+// keys, it applies to.
+func (p permission) String() string {
+	if p.RangeEnd == "" {
+		return fmt.Sprintf("%s %q", p.Type, p.Key)
+	}
+	return fmt.Sprintf("%s [%q, %q)", p.Type, p.Key, p.RangeEnd)
+}
+
+func authRolesDecoder(_, v []byte) record {
 	role := &authpb.Role{}
 	err := role.Unmarshal(v)
 	if err != nil {
 		panic(err)
 	}
-	fmt.Printf("role=%q, keyPermission=%v\n", string(role.Name), role.KeyPermission)
+	perms := make([]permission, len(role.KeyPermission))
+	texts := make([]string, len(role.KeyPermission))
+	for i, p := range role.KeyPermission {
+		perms[i] = permission{Type: p.PermType.String(), Key: string(p.Key), RangeEnd: string(p.RangeEnd)}
+		texts[i] = perms[i].String()
+	}
+	return record{
+		text:   fmt.Sprintf("role=%q, permissions=[%s]", string(role.Name), strings.Join(texts, ", ")),
+		fields: []field{{"role", string(role.Name)}, {"permissions", perms}},
+	}
 }
 
-func authUsersDecoder(_, v []byte) {
+func authUsersDecoder(_, v []byte) record {
 	user := &authpb.User{}
 	err := user.Unmarshal(v)
 	if err != nil {
 		panic(err)
 	}
-	fmt.Printf("user=%q, roles=%q, option=%v\n", user.Name, user.Roles, user.Options)
+	roles := user.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	// the password hash is not printed
+	noPassword := user.Options != nil && user.Options.NoPassword
+	return record{
+		text:   fmt.Sprintf("user=%q, roles=%q, no password=%t", user.Name, user.Roles, noPassword),
+		fields: []field{{"user", string(user.Name)}, {"roles", roles}, {"no_password", noPassword}},
+	}
 }
 
-func metaDecoder(k, v []byte) {
+func metaDecoder(k, v []byte) record {
 	if string(k) == string(schema.MetaConsistentIndexKeyName) || string(k) == string(schema.MetaTermKeyName) {
-		fmt.Printf("key=%q, value=%v\n", k, binary.BigEndian.Uint64(v))
+		value := binary.BigEndian.Uint64(v)
+		return record{
+			text:   fmt.Sprintf("key=%q, value=%v", k, value),
+			fields: []field{{"key", string(k)}, {"value", value}},
+		}
 	} else if string(k) == string(schema.ScheduledCompactKeyName) || string(k) == string(schema.FinishedCompactKeyName) {
 		rev := mvcc.BytesToRev(v)
-		fmt.Printf("key=%q, value=%v\n", k, rev)
-	} else {
-		defaultDecoder(k, v)
+		return record{
+			text:   fmt.Sprintf("key=%q, value=%v", k, rev),
+			fields: []field{{"key", string(k)}, {"value", rev.Main}},
+		}
 	}
+	return defaultDecoder(k, v)
 }
 
-func iterateBucket(dbPath, bucket string, limit uint64, decode bool) (err error) {
+func iterateBucket(p printer, dbPath, bucket string, limit uint64, decode bool) (err error) {
 	db, err := bolt.Open(dbPath, 0o600, &bolt.Options{Timeout: flockTimeout})
 	if err != nil {
 		return fmt.Errorf("failed to open bolt DB %w", err)
@@ -150,10 +252,12 @@ func iterateBucket(dbPath, bucket string, limit uint64, decode bool) (err error)
 		for k, v := c.Last(); k != nil; k, v = c.Prev() {
 			// TODO: remove sensitive information
 			// (https://github.com/etcd-io/etcd/issues/7620)
-			if dec, ok := decoders[bucket]; decode && ok {
-				dec(k, v)
-			} else {
-				defaultDecoder(k, v)
+			dec, ok := decoders[bucket]
+			if !decode || !ok {
+				dec = defaultDecoder
+			}
+			if err := p.print(dec(k, v)); err != nil {
+				return err
 			}
 
 			limit--
@@ -164,7 +268,10 @@ func iterateBucket(dbPath, bucket string, limit uint64, decode bool) (err error)
 
 		return nil
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	return p.flush()
 }
 
 func getHash(dbPath string) (hash uint32, err error) {
@@ -0,0 +1,235 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+const (
+	diffOnlyInFirst  = "only-in-first"
+	diffOnlyInSecond = "only-in-second"
+	diffDifferent    = "different"
+)
+
+// diffDBs compares the buckets of two db files and prints a record per bucket
+// or key present in only one of them and per key whose values differ. If
+// prefix is set, only the revisions of the keys with the prefix of the key
+// bucket, and the consistent index and term of the meta bucket, are compared.
+// It returns the number of differences.
+func diffDBs(p printer, firstPath, secondPath, prefix string) (n int, err error) {
+	first, err := bolt.Open(firstPath, 0o600, &bolt.Options{Timeout: flockTimeout})
+	if err != nil {
+		return 0, fmt.Errorf("failed to open bolt DB %w", err)
+	}
+	defer first.Close()
+	second, err := bolt.Open(secondPath, 0o600, &bolt.Options{Timeout: flockTimeout})
+	if err != nil {
+		return 0, fmt.Errorf("failed to open bolt DB %w", err)
+	}
+	defer second.Close()
+
+	d := &dbDiff{p: p, prefix: []byte(prefix)}
+	err = first.View(func(ftx *bolt.Tx) error {
+		return second.View(func(stx *bolt.Tx) error {
+			return d.diffBuckets(ftx, stx)
+		})
+	})
+	if err != nil {
+		return d.n, err
+	}
+	return d.n, p.flush()
+}
+
+type dbDiff struct {
+	p      printer
+	prefix []byte
+	n      int
+}
+
+func (d *dbDiff) diffBuckets(ftx, stx *bolt.Tx) error {
+	fc, sc := ftx.Cursor(), stx.Cursor()
+	fname, _ := fc.First()
+	sname, _ := sc.First()
+	for fname != nil || sname != nil {
+		switch c := compareKeys(fname, sname); {
+		case c < 0:
+			if err := d.bucketOnlyIn(fname, diffOnlyInFirst); err != nil {
+				return err
+			}
+			fname, _ = fc.Next()
+		case c > 0:
+			if err := d.bucketOnlyIn(sname, diffOnlyInSecond); err != nil {
+				return err
+			}
+			sname, _ = sc.Next()
+		default:
+			if err := d.diffBucket(fname, ftx.Bucket(fname), stx.Bucket(sname)); err != nil {
+				return err
+			}
+			fname, _ = fc.Next()
+			sname, _ = sc.Next()
+		}
+	}
+	return nil
+}
+
+func (d *dbDiff) bucketOnlyIn(bucket []byte, status string) error {
+	if len(d.prefix) != 0 && !bytes.Equal(bucket, schema.Key.Name()) && !bytes.Equal(bucket, schema.Meta.Name()) {
+		return nil
+	}
+	d.n++
+	return d.p.print(record{
+		text:   fmt.Sprintf("bucket=%q: %s", bucket, status),
+		fields: []field{{"bucket", string(bucket)}, {"key", ""}, {"revision", ""}, {"status", status}, {"first", ""}, {"second", ""}},
+	})
+}
+
+func (d *dbDiff) diffBucket(bucket []byte, fb, sb *bolt.Bucket) error {
+	fc, sc := fb.Cursor(), sb.Cursor()
+	fk, fv := fc.First()
+	sk, sv := sc.First()
+	for fk != nil || sk != nil {
+		var err error
+		switch c := compareKeys(fk, sk); {
+		case c < 0:
+			if d.included(bucket, fk, fv, nil) {
+				err = d.keyDiff(bucket, fk, fv, nil, diffOnlyInFirst)
+			}
+			fk, fv = fc.Next()
+		case c > 0:
+			if d.included(bucket, sk, nil, sv) {
+				err = d.keyDiff(bucket, sk, nil, sv, diffOnlyInSecond)
+			}
+			sk, sv = sc.Next()
+		default:
+			if !bytes.Equal(fv, sv) && d.included(bucket, fk, fv, sv) {
+				err = d.keyDiff(bucket, fk, fv, sv, diffDifferent)
+			}
+			fk, fv = fc.Next()
+			sk, sv = sc.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// included returns true if the key of the bucket is compared, fv and sv being
+// its values in the first and second db files, nil if it is missing.
+func (d *dbDiff) included(bucket, k, fv, sv []byte) bool {
+	if len(d.prefix) == 0 {
+		return true
+	}
+	switch {
+	case bytes.Equal(bucket, schema.Key.Name()):
+		for _, v := range [][]byte{fv, sv} {
+			var kv mvccpb.KeyValue
+			if v != nil && kv.Unmarshal(v) == nil && bytes.HasPrefix(kv.Key, d.prefix) {
+				return true
+			}
+		}
+		return false
+	case bytes.Equal(bucket, schema.Meta.Name()):
+		return bytes.Equal(k, schema.MetaConsistentIndexKeyName) || bytes.Equal(k, schema.MetaTermKeyName)
+	default:
+		return false
+	}
+}
+
+func (d *dbDiff) keyDiff(bucket, k, fv, sv []byte, status string) error {
+	d.n++
+	var key, rev string
+	if bytes.Equal(bucket, schema.Key.Name()) {
+		r := mvcc.BytesToBucketKey(k)
+		rev = fmt.Sprintf("{main:%d sub:%d}", r.Main, r.Sub)
+		var kv mvccpb.KeyValue
+		for _, v := range [][]byte{fv, sv} {
+			if v != nil && kv.Unmarshal(v) == nil {
+				key = string(kv.Key)
+				break
+			}
+		}
+	} else {
+		key = string(k)
+	}
+	first, second := diffValue(bucket, k, fv), diffValue(bucket, k, sv)
+
+	text := fmt.Sprintf("bucket=%q, key=%q", bucket, key)
+	if rev != "" {
+		text = fmt.Sprintf("bucket=%q, rev=%s, key=%q", bucket, rev, key)
+	}
+	switch status {
+	case diffOnlyInFirst:
+		text += fmt.Sprintf(": %s, first: %s", status, first)
+	case diffOnlyInSecond:
+		text += fmt.Sprintf(": %s, second: %s", status, second)
+	default:
+		text += fmt.Sprintf(": %s, first: %s, second: %s", status, first, second)
+	}
+	return d.p.print(record{
+		text: text,
+		fields: []field{
+			{"bucket", string(bucket)},
+			{"key", key},
+			{"revision", rev},
+			{"status", status},
+			{"first", first},
+			{"second", second},
+		},
+	})
+}
+
+// diffValue describes the value of the key of the bucket, decoding it if the
+// bucket has a decoder. It returns an empty string if the value is nil.
+func diffValue(bucket, k, v []byte) string {
+	if v == nil {
+		return ""
+	}
+	if bytes.Equal(bucket, schema.Key.Name()) {
+		var kv mvccpb.KeyValue
+		if err := kv.Unmarshal(v); err != nil {
+			return fmt.Sprintf("%q", v)
+		}
+		return fmt.Sprintf("{tombstone=%t, created=%d, mod=%d, ver=%d, lease=%016x, value=%s}",
+			mvcc.IsTombstone(k), kv.CreateRevision, kv.ModRevision, kv.Version, kv.Lease, valueExcerpt(kv.Value))
+	}
+	if dec, ok := decoders[string(bucket)]; ok {
+		return "{" + dec(k, v).text + "}"
+	}
+	return fmt.Sprintf("%q", v)
+}
+
+// compareKeys compares two keys of cursors, nil being the end of the cursor,
+// greater than any key.
+func compareKeys(a, b []byte) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return bytes.Compare(a, b)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// testRevision is a revision of a key written to the key bucket of a test db
+// file.
+type testRevision struct {
+	main      int64
+	tombstone bool
+	key       string
+	value     string
+	lease     int64
+}
+
+// testDB describes the buckets of a test db file.
+type testDB struct {
+	revisions []testRevision
+	// buckets are the other buckets, by name, with their keys and values
+	buckets map[string]map[string]string
+}
+
+func revisionKey(main int64, tombstone bool) []byte {
+	k := mvcc.RevToBytes(mvcc.Revision{Main: main}, mvcc.NewRevBytes())
+	if tombstone {
+		k = append(k, 't')
+	}
+	return k
+}
+
+// createTestDB writes the test db file to a temporary directory and returns
+// its path.
+func createTestDB(t *testing.T, tdb testDB) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "db")
+	db, err := bolt.Open(path, 0o600, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	err = db.Update(func(tx *bolt.Tx) error {
+		kb, err := tx.CreateBucketIfNotExists(schema.Key.Name())
+		if err != nil {
+			return err
+		}
+		for _, r := range tdb.revisions {
+			kv := mvccpb.KeyValue{Key: []byte(r.key)}
+			if !r.tombstone {
+				kv = mvccpb.KeyValue{Key: []byte(r.key), Value: []byte(r.value), CreateRevision: r.main, ModRevision: r.main, Version: 1, Lease: r.lease}
+			}
+			v, err := kv.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := kb.Put(revisionKey(r.main, r.tombstone), v); err != nil {
+				return err
+			}
+		}
+		for name, kvs := range tdb.buckets {
+			b, err := tx.CreateBucketIfNotExists([]byte(name))
+			if err != nil {
+				return err
+			}
+			for k, v := range kvs {
+				if err := b.Put([]byte(k), []byte(v)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return path
+}
+
+// jsonRecords decodes the records printed by a json printer.
+func jsonRecords(t *testing.T, out *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	s := bufio.NewScanner(out)
+	for s.Scan() {
+		r := make(map[string]any)
+		require.NoError(t, json.Unmarshal(s.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, s.Err())
+	return records
+}
+
+func TestDiffDBs(t *testing.T) {
+	base := testDB{
+		revisions: []testRevision{
+			{main: 2, key: "/a/1", value: "v1"},
+			{main: 3, key: "/a/2", value: "v2"},
+			{main: 4, key: "/b/1", value: "v3"},
+		},
+		buckets: map[string]map[string]string{"members": {"m1": "x"}},
+	}
+	tests := []struct {
+		name   string
+		second testDB
+		prefix string
+		// want are the bucket, key and status of the reported differences
+		want [][3]string
+	}{
+		{
+			name:   "identical",
+			second: base,
+		},
+		{
+			name: "key added",
+			second: testDB{
+				revisions: append(append([]testRevision{}, base.revisions...), testRevision{main: 5, key: "/a/3", value: "v4"}),
+				buckets:   base.buckets,
+			},
+			want: [][3]string{{"key", "/a/3", diffOnlyInSecond}},
+		},
+		{
+			name: "key removed",
+			second: testDB{
+				revisions: base.revisions[:2],
+				buckets:   base.buckets,
+			},
+			want: [][3]string{{"key", "/b/1", diffOnlyInFirst}},
+		},
+		{
+			name: "value changed",
+			second: testDB{
+				revisions: []testRevision{base.revisions[0], {main: 3, key: "/a/2", value: "changed"}, base.revisions[2]},
+				buckets:   map[string]map[string]string{"members": {"m1": "y"}},
+			},
+			want: [][3]string{{"key", "/a/2", diffDifferent}, {"members", "m1", diffDifferent}},
+		},
+		{
+			name: "bucket added",
+			second: testDB{
+				revisions: base.revisions,
+				buckets:   map[string]map[string]string{"members": {"m1": "x"}, "lease": {"l": "v"}},
+			},
+			want: [][3]string{{"lease", "", diffOnlyInSecond}},
+		},
+		{
+			name: "prefix",
+			second: testDB{
+				revisions: []testRevision{base.revisions[0], {main: 3, key: "/a/2", value: "changed"}, {main: 4, key: "/b/1", value: "changed"}},
+				buckets:   map[string]map[string]string{"members": {"m1": "y"}},
+			},
+			prefix: "/a/",
+			want:   [][3]string{{"key", "/a/2", diffDifferent}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := createTestDB(t, base), createTestDB(t, tt.second)
+			var out bytes.Buffer
+			n, err := diffDBs(&jsonPrinter{w: &out}, first, second, tt.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), n)
+
+			var got [][3]string
+			for _, r := range jsonRecords(t, &out) {
+				got = append(got, [3]string{r["bucket"].(string), r["key"].(string), r["status"].(string)})
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiffDBsValues(t *testing.T) {
+	first := createTestDB(t, testDB{revisions: []testRevision{{main: 2, key: "foo", value: "bar"}}})
+	second := createTestDB(t, testDB{revisions: []testRevision{{main: 2, key: "foo", value: "baz", lease: 1}, {main: 3, tombstone: true, key: "foo"}}})
+	var out bytes.Buffer
+	n, err := diffDBs(&textPrinter{w: &out}, first, second, "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t,
+		`bucket="key", rev={main:2 sub:0}, key="foo": different, first: {tombstone=false, created=2, mod=2, ver=1, lease=0000000000000000, value="bar"}, second: {tombstone=false, created=2, mod=2, ver=1, lease=0000000000000001, value="baz"}
+bucket="key", rev={main:3 sub:0}, key="foo": only-in-second, second: {tombstone=true, created=0, mod=0, ver=0, lease=0000000000000000, value=""}
+`, out.String())
+}
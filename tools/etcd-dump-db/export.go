@@ -0,0 +1,245 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// exportBatchSize is the number of keys written per transaction of the
+// exported db file.
+const exportBatchSize = 10000
+
+// exportDB copies the keys with the prefix, as of the revision, from the db
+// file to a new db file, as a compacted key space holding the last revision of
+// each key only, along with the leases attached to the keys and the meta and
+// cluster buckets. A revision of 0 exports the current revision.
+//
+// The revisions of the keys are collected in a first pass over the key bucket,
+// which holds the revisions in order, and their values are copied in a second
+// one, so that only the keys and revisions of the exported keys are held in
+// memory.
+func exportDB(p printer, dbPath, outPath, prefix string, rev int64) error {
+	if existFileOrDir(outPath) {
+		return fmt.Errorf("%q already exists", outPath)
+	}
+	src, err := bolt.Open(dbPath, 0o600, &bolt.Options{Timeout: flockTimeout, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to open bolt DB %w", err)
+	}
+	defer src.Close()
+
+	var revs [][]byte
+	leases := make(map[int64]struct{})
+	err = src.View(func(tx *bolt.Tx) error {
+		if rev, err = exportRevision(tx, rev); err != nil {
+			return err
+		}
+		revs, err = exportedRevisions(tx, []byte(prefix), rev, leases)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	dst, err := bolt.Open(outPath, 0o600, &bolt.Options{Timeout: flockTimeout})
+	if err != nil {
+		return fmt.Errorf("failed to create bolt DB %w", err)
+	}
+	err = src.View(func(stx *bolt.Tx) error {
+		return copyExported(stx, dst, revs, leases, rev)
+	})
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(outPath)
+		return err
+	}
+
+	fi, err := os.Stat(outPath)
+	if err != nil {
+		return err
+	}
+	err = p.print(record{
+		text: fmt.Sprintf("exported %d keys with prefix %q and %d leases at revision %d to %s (%d bytes)",
+			len(revs), prefix, len(leases), rev, outPath, fi.Size()),
+		fields: []field{
+			{"path", outPath},
+			{"prefix", prefix},
+			{"revision", rev},
+			{"keys", len(revs)},
+			{"leases", len(leases)},
+			{"size", fi.Size()},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return p.flush()
+}
+
+// exportRevision checks that the revision is neither compacted nor in the
+// future, and returns it, or the current revision if it is 0.
+func exportRevision(tx *bolt.Tx, rev int64) (int64, error) {
+	kb := tx.Bucket(schema.Key.Name())
+	if kb == nil {
+		return 0, errors.New("the db file has no key bucket")
+	}
+	var current int64
+	if k, _ := kb.Cursor().Last(); k != nil {
+		current = mvcc.BytesToRev(k).Main
+	}
+	var compacted int64
+	if mb := tx.Bucket(schema.Meta.Name()); mb != nil {
+		if v := mb.Get(schema.FinishedCompactKeyName); v != nil {
+			compacted = mvcc.BytesToRev(v).Main
+		}
+	}
+	current = max(current, compacted)
+	switch {
+	case rev == 0:
+		return current, nil
+	case rev > current:
+		return 0, fmt.Errorf("revision %d is a future revision (current revision is %d)", rev, current)
+	case rev < compacted:
+		return 0, fmt.Errorf("revision %d has been compacted (compacted revision is %d)", rev, compacted)
+	}
+	return rev, nil
+}
+
+// exportedRevisions returns, in order, the last revision up to rev of each key
+// with the prefix that is not deleted at rev, and adds the leases attached to
+// these keys to leases.
+func exportedRevisions(tx *bolt.Tx, prefix []byte, rev int64, leases map[int64]struct{}) ([][]byte, error) {
+	type lastRevision struct {
+		rev   []byte
+		lease int64
+	}
+	last := make(map[string]lastRevision)
+	c := tx.Bucket(schema.Key.Name()).Cursor()
+	for k, v := c.First(); k != nil && mvcc.BytesToRev(k).Main <= rev; k, v = c.Next() {
+		var kv mvccpb.KeyValue
+		if err := kv.Unmarshal(v); err != nil {
+			return nil, fmt.Errorf("failed to decode the key-value of revision %x: %w", k, err)
+		}
+		if !bytes.HasPrefix(kv.Key, prefix) {
+			continue
+		}
+		if mvcc.IsTombstone(k) {
+			delete(last, string(kv.Key))
+			continue
+		}
+		last[string(kv.Key)] = lastRevision{rev: bytes.Clone(k), lease: kv.Lease}
+	}
+
+	revs := make([][]byte, 0, len(last))
+	for _, l := range last {
+		revs = append(revs, l.rev)
+		if l.lease != 0 {
+			leases[l.lease] = struct{}{}
+		}
+	}
+	sort.Slice(revs, func(i, j int) bool { return bytes.Compare(revs[i], revs[j]) < 0 })
+	return revs, nil
+}
+
+// copyExported writes the revisions of the exported keys and their leases to
+// dst, along with the meta and cluster buckets of stx, the meta bucket
+// recording rev as the compacted revision.
+func copyExported(stx *bolt.Tx, dst *bolt.DB, revs [][]byte, leases map[int64]struct{}, rev int64) error {
+	err := dst.Update(func(dtx *bolt.Tx) error {
+		for _, name := range [][]byte{schema.Meta.Name(), schema.Cluster.Name()} {
+			sb := stx.Bucket(name)
+			if sb == nil {
+				continue
+			}
+			db, err := dtx.CreateBucket(name)
+			if err != nil {
+				return err
+			}
+			if err := sb.ForEach(db.Put); err != nil {
+				return err
+			}
+		}
+		mb, err := dtx.CreateBucketIfNotExists(schema.Meta.Name())
+		if err != nil {
+			return err
+		}
+		compactRev := mvcc.RevToBytes(mvcc.Revision{Main: rev}, mvcc.NewRevBytes())
+		if err := mb.Put(schema.ScheduledCompactKeyName, compactRev); err != nil {
+			return err
+		}
+		if err := mb.Put(schema.FinishedCompactKeyName, compactRev); err != nil {
+			return err
+		}
+
+		lb, err := dtx.CreateBucket(schema.Lease.Name())
+		if err != nil {
+			return err
+		}
+		if slb := stx.Bucket(schema.Lease.Name()); slb != nil {
+			for id := range leases {
+				k := leaseIDToBytes(id)
+				if v := slb.Get(k); v != nil {
+					if err := lb.Put(k, v); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		_, err = dtx.CreateBucket(schema.Key.Name())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	sb := stx.Bucket(schema.Key.Name())
+	for len(revs) > 0 {
+		batch := revs[:min(exportBatchSize, len(revs))]
+		revs = revs[len(batch):]
+		err := dst.Update(func(dtx *bolt.Tx) error {
+			b := dtx.Bucket(schema.Key.Name())
+			b.FillPercent = defragFillPercent
+			for _, k := range batch {
+				if err := b.Put(k, sb.Get(k)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func leaseIDToBytes(id int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(id))
+	return b
+}
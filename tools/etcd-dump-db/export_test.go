@@ -0,0 +1,148 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"maps"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// readTestDB returns the keys and values of the buckets of a db file, by
+// bucket name.
+func readTestDB(t *testing.T, path string) map[string]map[string][]byte {
+	t.Helper()
+	db, err := bolt.Open(path, 0o600, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer db.Close()
+	buckets := make(map[string]map[string][]byte)
+	err = db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			kvs := make(map[string][]byte)
+			buckets[string(name)] = kvs
+			return b.ForEach(func(k, v []byte) error {
+				kvs[string(k)] = bytes.Clone(v)
+				return nil
+			})
+		})
+	})
+	require.NoError(t, err)
+	return buckets
+}
+
+func TestExportDB(t *testing.T) {
+	leaseKey := func(id int64) string { return string(leaseIDToBytes(id)) }
+	revBytes := func(main int64) string { return string(revisionKey(main, false)) }
+	src := createTestDB(t, testDB{
+		revisions: []testRevision{
+			{main: 2, key: "/a/1", value: "v1"},
+			{main: 3, key: "/a/2", value: "v2", lease: 1},
+			{main: 4, key: "/b/1", value: "v3", lease: 2},
+			{main: 5, key: "/a/1", value: "v4"},
+			{main: 6, tombstone: true, key: "/a/2"},
+			{main: 7, key: "/a/3", value: "v5", lease: 3},
+		},
+		buckets: map[string]map[string]string{
+			"meta": {
+				string(schema.MetaConsistentIndexKeyName): "index",
+				string(schema.FinishedCompactKeyName):     revBytes(2),
+			},
+			"cluster": {"clusterVersion": "3.6.0"},
+			"members": {"m1": "x"},
+			"lease":   {leaseKey(1): "l1", leaseKey(2): "l2", leaseKey(3): "l3"},
+		},
+	})
+	srcBuckets := readTestDB(t, src)
+	srcKeys := srcBuckets["key"]
+
+	tests := []struct {
+		name   string
+		prefix string
+		rev    int64
+		// wantRevs are the main revisions of the exported keys
+		wantRevs   []int64
+		wantLeases []int64
+		wantRev    int64
+	}{
+		{name: "all keys", wantRevs: []int64{4, 5, 7}, wantLeases: []int64{2, 3}, wantRev: 7},
+		{name: "prefix", prefix: "/a/", wantRevs: []int64{5, 7}, wantLeases: []int64{3}, wantRev: 7},
+		{name: "past revision", prefix: "/a/", rev: 4, wantRevs: []int64{2, 3}, wantLeases: []int64{1}, wantRev: 4},
+		{name: "no key", prefix: "/c/", wantRev: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "exported.db")
+			var buf bytes.Buffer
+			require.NoError(t, exportDB(&jsonPrinter{w: &buf}, src, out, tt.prefix, tt.rev))
+			records := jsonRecords(t, &buf)
+			require.Len(t, records, 1)
+			assert.InDelta(t, tt.wantRev, records[0]["revision"], 0)
+			assert.InDelta(t, len(tt.wantRevs), records[0]["keys"], 0)
+			assert.InDelta(t, len(tt.wantLeases), records[0]["leases"], 0)
+
+			got := readTestDB(t, out)
+			assert.ElementsMatch(t, []string{"key", "lease", "meta", "cluster"}, slices.Collect(maps.Keys(got)))
+
+			// the last revisions of the keys are copied as is
+			wantKeys := make(map[string][]byte)
+			for _, main := range tt.wantRevs {
+				k := revBytes(main)
+				wantKeys[k] = srcKeys[k]
+				var kv mvccpb.KeyValue
+				require.NoError(t, kv.Unmarshal(got["key"][k]))
+				assert.Equal(t, main, kv.ModRevision)
+			}
+			assert.Equal(t, wantKeys, got["key"])
+
+			wantLeases := make(map[string][]byte)
+			for _, id := range tt.wantLeases {
+				wantLeases[leaseKey(id)] = srcBuckets["lease"][leaseKey(id)]
+			}
+			assert.Equal(t, wantLeases, got["lease"])
+
+			assert.Equal(t, srcBuckets["cluster"], got["cluster"])
+			compacted := []byte(revBytes(tt.wantRev))
+			assert.Equal(t, map[string][]byte{
+				string(schema.MetaConsistentIndexKeyName): []byte("index"),
+				string(schema.ScheduledCompactKeyName):    compacted,
+				string(schema.FinishedCompactKeyName):     compacted,
+			}, got["meta"])
+			assert.Equal(t, tt.wantRev, mvcc.BytesToRev(got["meta"][string(schema.FinishedCompactKeyName)]).Main)
+		})
+	}
+}
+
+func TestExportDBRevisionErrors(t *testing.T) {
+	src := createTestDB(t, testDB{
+		revisions: []testRevision{{main: 2, key: "foo", value: "bar"}, {main: 3, key: "foo", value: "baz"}},
+		buckets: map[string]map[string]string{
+			"meta": {string(schema.FinishedCompactKeyName): string(revisionKey(3, false))},
+		},
+	})
+	var buf bytes.Buffer
+	require.ErrorContains(t, exportDB(&jsonPrinter{w: &buf}, src, filepath.Join(t.TempDir(), "db"), "", 2), "compacted")
+	require.ErrorContains(t, exportDB(&jsonPrinter{w: &buf}, src, filepath.Join(t.TempDir(), "db"), "", 4), "future")
+	require.ErrorContains(t, exportDB(&jsonPrinter{w: &buf}, src, src, "", 0), "already exists")
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/server/v3/storage/mvcc"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// keyHistory is the result of a walk of the revisions of a key.
+type keyHistory struct {
+	// revisions is the number of revisions of the key found.
+	revisions int
+	// compacted and scheduled are the finished and scheduled compaction
+	// revisions of the db file, 0 if it was never compacted.
+	compacted int64
+	scheduled int64
+}
+
+// printKeyHistory prints, in order, every revision of the key stored in the
+// key bucket of the db file: its creations, modifications and deletions. The
+// revisions are keyed by revision, not by key, so the whole bucket is walked.
+//
+// Compaction removes the revisions of a key up to the compacted revision, but
+// the last one if it is not a deletion. Such a revision is printed as
+// compacted, its previous revisions being lost.
+func printKeyHistory(p printer, dbPath, key string) (h keyHistory, err error) {
+	db, err := bolt.Open(dbPath, 0o600, &bolt.Options{Timeout: flockTimeout, ReadOnly: true})
+	if err != nil {
+		return h, fmt.Errorf("failed to open bolt DB %w", err)
+	}
+	defer db.Close()
+
+	err = db.View(func(tx *bolt.Tx) error {
+		kb := tx.Bucket(schema.Key.Name())
+		if kb == nil {
+			return errors.New("the db file has no key bucket")
+		}
+		if mb := tx.Bucket(schema.Meta.Name()); mb != nil {
+			if v := mb.Get(schema.FinishedCompactKeyName); v != nil {
+				h.compacted = mvcc.BytesToRev(v).Main
+			}
+			if v := mb.Get(schema.ScheduledCompactKeyName); v != nil {
+				h.scheduled = mvcc.BytesToRev(v).Main
+			}
+		}
+
+		c := kb.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var kv mvccpb.KeyValue
+			if err := kv.Unmarshal(v); err != nil {
+				return fmt.Errorf("failed to decode the key-value of revision %x: %w", k, err)
+			}
+			if !bytes.Equal(kv.Key, []byte(key)) {
+				continue
+			}
+			h.revisions++
+			if err := p.print(keyRevisionRecord(k, &kv, h.compacted)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return h, err
+	}
+	return h, p.flush()
+}
+
+// keyRevisionRecord returns the record of the revision of a key, the event
+// being the deletion of the key for a tombstone, its creation for its first
+// version, and its modification otherwise.
+func keyRevisionRecord(k []byte, kv *mvccpb.KeyValue, compacted int64) record {
+	rev := mvcc.BytesToBucketKey(k)
+	event := "modify"
+	switch {
+	case mvcc.IsTombstone(k):
+		event = "delete"
+	case kv.Version == 1:
+		event = "create"
+	}
+	isCompacted := rev.Main <= compacted
+	value := kv.Value
+	if valueExcerptBytes != 0 && len(value) > valueExcerptBytes {
+		value = value[:valueExcerptBytes]
+	}
+	text := fmt.Sprintf("rev={main:%d sub:%d}, event=%s, created=%d, mod=%d, ver=%d, lease=%016x, value=%s",
+		rev.Main, rev.Sub, event, kv.CreateRevision, kv.ModRevision, kv.Version, kv.Lease, valueExcerpt(kv.Value))
+	if isCompacted {
+		text += ", compacted (previous revisions removed)"
+	}
+	return record{
+		text: text,
+		fields: []field{
+			{"main", rev.Main},
+			{"sub", rev.Sub},
+			{"event", event},
+			{"create_revision", kv.CreateRevision},
+			{"mod_revision", kv.ModRevision},
+			{"version", kv.Version},
+			{"lease", kv.Lease},
+			{"value", string(value)},
+			{"value_size", len(kv.Value)},
+			{"compacted", isCompacted},
+		},
+	}
+}
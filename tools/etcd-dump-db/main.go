@@ -46,6 +46,26 @@ var (
 		Short: "scan-keys scans all the key-value pairs starting from a specific revision in the key space.",
 		Run:   scanKeysCommandFunc,
 	}
+	diffCommand = &cobra.Command{
+		Use:   "diff [data dir or db file path] [data dir or db file path]",
+		Short: "diff compares the buckets of two db files.",
+		Run:   diffCommandFunc,
+	}
+	exportCommand = &cobra.Command{
+		Use:   "export [data dir or db file path] [output db file path]",
+		Short: "export copies the keys with a prefix, at a revision, to a new compacted db file.",
+		Run:   exportCommandFunc,
+	}
+	historyCommand = &cobra.Command{
+		Use:   "history [data dir or db file path] [key]",
+		Short: "history prints every revision of a key stored in the key bucket.",
+		Run:   historyCommandFunc,
+	}
+	statsCommand = &cobra.Command{
+		Use:   "stats [data dir or db file path]",
+		Short: "stats reports the page utilization of the buckets and the space reclaimable by defragmentation.",
+		Run:   statsCommandFunc,
+	}
 	getHashCommand = &cobra.Command{
 		Use:   "hash [data dir or db file path]",
 		Short: "hash computes the hash of db file.",
@@ -55,18 +75,34 @@ var (
 
 var (
 	flockTimeout        time.Duration
+	output              string
 	iterateBucketLimit  uint64
 	iterateBucketDecode bool
+	valueExcerptBytes   int
+	diffPrefix          string
+	exportPrefix        string
+	exportRev           int64
 )
 
 func init() {
 	rootCommand.PersistentFlags().DurationVar(&flockTimeout, "timeout", 10*time.Second, "time to wait to obtain a file lock on db file, 0 to block indefinitely")
+	rootCommand.PersistentFlags().StringVar(&output, "output", "text", "output format: text, json for a JSON object per line, or csv for a header row followed by a row per line")
 	iterateBucketCommand.PersistentFlags().Uint64Var(&iterateBucketLimit, "limit", 0, "max number of key-value pairs to iterate (0< to iterate all)")
-	iterateBucketCommand.PersistentFlags().BoolVar(&iterateBucketDecode, "decode", false, "true to decode Protocol Buffer encoded data")
+	iterateBucketCommand.PersistentFlags().BoolVar(&iterateBucketDecode, "decode", true, "true to decode the records of the key, lease, auth, authRoles, authUsers and meta buckets, false to print the raw records")
+	iterateBucketCommand.PersistentFlags().IntVar(&valueExcerptBytes, "value-excerpt-bytes", 64, "max number of bytes of the values printed when decoding the key bucket, 0 to print the whole values")
+	diffCommand.PersistentFlags().StringVar(&diffPrefix, "prefix", "", "if set, compares only the revisions of the keys with the prefix, and the consistent index and term")
+	diffCommand.PersistentFlags().IntVar(&valueExcerptBytes, "value-excerpt-bytes", 64, "max number of bytes of the values of the key bucket printed, 0 to print the whole values")
+	exportCommand.PersistentFlags().StringVar(&exportPrefix, "prefix", "", "prefix of the keys to export, all the keys if empty")
+	exportCommand.PersistentFlags().Int64Var(&exportRev, "rev", 0, "revision to export the keys at, 0 for the current revision")
+	historyCommand.PersistentFlags().IntVar(&valueExcerptBytes, "value-excerpt-bytes", 64, "max number of bytes of the values printed, 0 to print the whole values")
 
 	rootCommand.AddCommand(listBucketCommand)
 	rootCommand.AddCommand(iterateBucketCommand)
 	rootCommand.AddCommand(scanKeySpaceCommand)
+	rootCommand.AddCommand(diffCommand)
+	rootCommand.AddCommand(exportCommand)
+	rootCommand.AddCommand(historyCommand)
+	rootCommand.AddCommand(statsCommand)
 	rootCommand.AddCommand(getHashCommand)
 }
 
@@ -89,12 +125,18 @@ func listBucketCommandFunc(_ *cobra.Command, args []string) {
 		log.Fatalf("%q does not exist", dp)
 	}
 
+	p := mustNewPrinter()
 	bts, err := getBuckets(dp)
 	if err != nil {
 		log.Fatal(err)
 	}
 	for _, b := range bts {
-		fmt.Println(b)
+		if err := p.print(record{text: b, fields: []field{{"bucket", b}}}); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := p.flush(); err != nil {
+		log.Fatal(err)
 	}
 }
 
@@ -110,7 +152,10 @@ func iterateBucketCommandFunc(_ *cobra.Command, args []string) {
 		log.Fatalf("%q does not exist", dp)
 	}
 	bucket := args[1]
-	err := iterateBucket(dp, bucket, iterateBucketLimit, iterateBucketDecode)
+	if valueExcerptBytes < 0 {
+		log.Fatalf("--value-excerpt-bytes must not be negative (got %d)", valueExcerptBytes)
+	}
+	err := iterateBucket(mustNewPrinter(), dp, bucket, iterateBucketLimit, iterateBucketDecode)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -131,10 +176,106 @@ func scanKeysCommandFunc(_ *cobra.Command, args []string) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	err = scanKeys(dp, startRev)
+	err = scanKeys(mustNewPrinter(), dp, startRev)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func diffCommandFunc(_ *cobra.Command, args []string) {
+	if len(args) != 2 {
+		log.Fatalf("Must provide 2 arguments (got %v)", args)
+	}
+	var dps []string
+	for _, dp := range args {
+		if !strings.HasSuffix(dp, "db") {
+			dp = filepath.Join(snapDir(dp), "db")
+		}
+		if !existFileOrDir(dp) {
+			log.Fatalf("%q does not exist", dp)
+		}
+		dps = append(dps, dp)
+	}
+	if valueExcerptBytes < 0 {
+		log.Fatalf("--value-excerpt-bytes must not be negative (got %d)", valueExcerptBytes)
+	}
+
+	n, err := diffDBs(mustNewPrinter(), dps[0], dps[1], diffPrefix)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if output == "text" {
+		fmt.Printf("%d differences between %s and %s\n", n, dps[0], dps[1])
+	}
+}
+
+func exportCommandFunc(_ *cobra.Command, args []string) {
+	if len(args) != 2 {
+		log.Fatalf("Must provide 2 arguments (got %v)", args)
+	}
+	dp := args[0]
+	if !strings.HasSuffix(dp, "db") {
+		dp = filepath.Join(snapDir(dp), "db")
+	}
+	if !existFileOrDir(dp) {
+		log.Fatalf("%q does not exist", dp)
+	}
+	if exportRev < 0 {
+		log.Fatalf("--rev must not be negative (got %d)", exportRev)
+	}
+
+	if err := exportDB(mustNewPrinter(), dp, args[1], exportPrefix, exportRev); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func historyCommandFunc(_ *cobra.Command, args []string) {
+	if len(args) != 2 {
+		log.Fatalf("Must provide 2 arguments (got %v)", args)
+	}
+	dp := args[0]
+	if !strings.HasSuffix(dp, "db") {
+		dp = filepath.Join(snapDir(dp), "db")
+	}
+	if !existFileOrDir(dp) {
+		log.Fatalf("%q does not exist", dp)
+	}
+	if valueExcerptBytes < 0 {
+		log.Fatalf("--value-excerpt-bytes must not be negative (got %d)", valueExcerptBytes)
+	}
+
+	h, err := printKeyHistory(mustNewPrinter(), dp, args[1])
 	if err != nil {
 		log.Fatal(err)
 	}
+	if output != "text" {
+		return
+	}
+	fmt.Printf("%d revisions of key %q", h.revisions, args[1])
+	if h.compacted != 0 {
+		fmt.Printf(", compacted revision %d", h.compacted)
+	}
+	if h.scheduled > h.compacted {
+		fmt.Printf(", compaction to revision %d not finished", h.scheduled)
+	}
+	fmt.Println()
+}
+
+func statsCommandFunc(_ *cobra.Command, args []string) {
+	if len(args) < 1 {
+		log.Fatalf("Must provide at least 1 argument (got %v)", args)
+	}
+	dp := args[0]
+	if !strings.HasSuffix(dp, "db") {
+		dp = filepath.Join(snapDir(dp), "db")
+	}
+	if !existFileOrDir(dp) {
+		log.Fatalf("%q does not exist", dp)
+	}
+
+	if err := dbStats(mustNewPrinter(), dp); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func getHashCommandFunc(_ *cobra.Command, args []string) {
@@ -149,9 +290,27 @@ func getHashCommandFunc(_ *cobra.Command, args []string) {
 		log.Fatalf("%q does not exist", dp)
 	}
 
+	p := mustNewPrinter()
 	hash, err := getHash(dp)
 	if err != nil {
 		log.Fatal(err)
 	}
-	fmt.Printf("db path: %s\nHash: %d\n", dp, hash)
+	r := record{
+		text:   fmt.Sprintf("db path: %s\nHash: %d", dp, hash),
+		fields: []field{{"path", dp}, {"hash", hash}},
+	}
+	if err := p.print(r); err != nil {
+		log.Fatal(err)
+	}
+	if err := p.flush(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func mustNewPrinter() printer {
+	p, err := newPrinter(output, os.Stdout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return p
 }
@@ -0,0 +1,134 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// record is a line printed by the subcommands, such as a bucket name or a
+// decoded key-value pair.
+type record struct {
+	// text is the line printed with --output=text.
+	text string
+	// fields are the named values of the record printed with --output=json
+	// and --output=csv, in column order.
+	fields []field
+}
+
+type field struct {
+	name  string
+	value any
+}
+
+// printer prints the records of a subcommand in the format selected by --output.
+type printer interface {
+	print(r record) error
+	// flush writes the buffered records, if any.
+	flush() error
+}
+
+func newPrinter(output string, w io.Writer) (printer, error) {
+	switch output {
+	case "text":
+		return &textPrinter{w: w}, nil
+	case "json":
+		return &jsonPrinter{w: w}, nil
+	case "csv":
+		return &csvPrinter{w: csv.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("invalid output %q, must be text, json or csv", output)
+	}
+}
+
+type textPrinter struct{ w io.Writer }
+
+func (p *textPrinter) print(r record) error {
+	_, err := fmt.Fprintln(p.w, r.text)
+	return err
+}
+
+func (p *textPrinter) flush() error { return nil }
+
+// jsonPrinter prints a JSON object per line, its members being the fields of
+// the record in column order.
+type jsonPrinter struct{ w io.Writer }
+
+func (p *jsonPrinter) print(r record) error {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range r.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(f.name)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(f.value)
+		if err != nil {
+			return err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteString("}\n")
+	_, err := p.w.Write(buf.Bytes())
+	return err
+}
+
+func (p *jsonPrinter) flush() error { return nil }
+
+// csvPrinter prints a header row of the field names of the first record,
+// followed by a row of the field values per record. A record with other fields
+// starts a new table, separated by an empty line.
+type csvPrinter struct {
+	w      *csv.Writer
+	header []string
+}
+
+func (p *csvPrinter) print(r record) error {
+	names := make([]string, len(r.fields))
+	for i, f := range r.fields {
+		names[i] = f.name
+	}
+	if !slices.Equal(names, p.header) {
+		if p.header != nil {
+			if err := p.w.Write(nil); err != nil {
+				return err
+			}
+		}
+		if err := p.w.Write(names); err != nil {
+			return err
+		}
+		p.header = names
+	}
+	values := make([]string, len(r.fields))
+	for i, f := range r.fields {
+		values[i] = fmt.Sprint(f.value)
+	}
+	return p.w.Write(values)
+}
+
+func (p *csvPrinter) flush() error {
+	p.w.Flush()
+	return p.w.Error()
+}
@@ -22,27 +22,27 @@ import (
 	"go.etcd.io/etcd/server/v3/storage/mvcc"
 )
 
-func scanKeys(dbPath string, startRev int64) error {
+func scanKeys(p printer, dbPath string, startRev int64) error {
 	pgSize, hwm, err := readPageAndHWMSize(dbPath)
 	if err != nil {
 		return fmt.Errorf("failed to read page and HWM size: %w", err)
 	}
 
 	for pageID := uint64(2); pageID < hwm; {
-		p, _, err := readPage(dbPath, pgSize, pageID)
+		pg, _, err := readPage(dbPath, pgSize, pageID)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Reading page %d failed: %v. Continuting...\n", pageID, err)
 			pageID++
 			continue
 		}
 
-		if !p.isLeafPage() {
+		if !pg.isLeafPage() {
 			pageID++
 			continue
 		}
 
-		for i := uint16(0); i < p.count; i++ {
-			e := p.leafPageElement(i)
+		for i := uint16(0); i < pg.count; i++ {
+			e := pg.leafPageElement(i)
 
 			rev, err := bytesToBucketKey(e.key())
 			if err != nil {
@@ -57,13 +57,17 @@ func scanKeys(dbPath string, startRev int64) error {
 				continue
 			}
 
-			fmt.Printf("pageID=%d, index=%d/%d, ", pageID, i, p.count-1)
-			keyDecoder(e.key(), e.value())
+			r := keyDecoder(e.key(), e.value())
+			r.text = fmt.Sprintf("pageID=%d, index=%d/%d, %s", pageID, i, pg.count-1, r.text)
+			r.fields = append([]field{{"page_id", pageID}, {"index", i}, {"count", pg.count}}, r.fields...)
+			if err := p.print(r); err != nil {
+				return err
+			}
 		}
 
-		pageID += uint64(p.overflow) + 1
+		pageID += uint64(pg.overflow) + 1
 	}
-	return nil
+	return p.flush()
 }
 
 func bytesToBucketKey(key []byte) (rev mvcc.BucketKey, err error) {
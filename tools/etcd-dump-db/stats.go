@@ -0,0 +1,124 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// defragFillPercent is the fill percent of the pages of the buckets written by
+// the defragmentation.
+const defragFillPercent = 0.9
+
+// dbStats prints the page statistics of each bucket of the db file, followed
+// by the statistics of the whole file: its size, free pages, and an estimate
+// of the space a defragmentation would reclaim, computed from the bytes in use
+// by the buckets. The file is larger than the db when bolt preallocated space
+// to grow it.
+func dbStats(p printer, dbPath string) error {
+	fi, err := os.Stat(dbPath)
+	if err != nil {
+		return err
+	}
+	db, err := bolt.Open(dbPath, 0o600, &bolt.Options{Timeout: flockTimeout})
+	if err != nil {
+		return fmt.Errorf("failed to open bolt DB %w", err)
+	}
+	defer db.Close()
+
+	err = db.View(func(tx *bolt.Tx) error {
+		pageSize := int64(db.Info().PageSize)
+		// the meta pages, the freelist and the root bucket pages
+		estimated := 4 * pageSize
+		var inuse int64
+		err := tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			s := b.Stats()
+			bucketInuse := int64(s.BranchInuse + s.LeafInuse)
+			inuse += bucketInuse
+			if s.InlineBucketN == 0 {
+				estimated += pages(float64(bucketInuse)/defragFillPercent, pageSize) * pageSize
+			}
+			return p.print(bucketStatsRecord(string(name), s))
+		})
+		if err != nil {
+			return err
+		}
+
+		st := db.Stats()
+		size := tx.Size()
+		reclaimable := max(size-estimated, 0)
+		return p.print(record{
+			text: fmt.Sprintf("file size=%d bytes, db size=%d bytes (%d pages of %d bytes), in use=%d bytes, free pages=%d, pending pages=%d, freelist size=%d bytes, estimated reclaimable by defrag=%d bytes (%.1f%%)",
+				fi.Size(), size, size/pageSize, pageSize, inuse, st.FreePageN, st.PendingPageN, st.FreelistInuse, reclaimable, percent(reclaimable, size)),
+			fields: []field{
+				{"file_size", fi.Size()},
+				{"db_size", size},
+				{"page_size", pageSize},
+				{"pages", size / pageSize},
+				{"inuse_bytes", inuse},
+				{"free_pages", st.FreePageN},
+				{"pending_pages", st.PendingPageN},
+				{"freelist_bytes", st.FreelistInuse},
+				{"reclaimable_bytes", reclaimable},
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return p.flush()
+}
+
+func bucketStatsRecord(name string, s bolt.BucketStats) record {
+	alloc := int64(s.BranchAlloc + s.LeafAlloc)
+	inuse := int64(s.BranchInuse + s.LeafInuse)
+	inline := s.InlineBucketN > 0
+	text := fmt.Sprintf("bucket=%q, keys=%d, depth=%d, branch pages=%d (%d overflow), leaf pages=%d (%d overflow), allocated=%d bytes, in use=%d bytes",
+		name, s.KeyN, s.Depth, s.BranchPageN, s.BranchOverflowN, s.LeafPageN, s.LeafOverflowN, alloc, inuse)
+	if inline {
+		text += ", inline"
+	} else {
+		text += fmt.Sprintf(", utilization=%.1f%%", percent(inuse, alloc))
+	}
+	return record{
+		text: text,
+		fields: []field{
+			{"bucket", name},
+			{"keys", s.KeyN},
+			{"depth", s.Depth},
+			{"branch_pages", s.BranchPageN},
+			{"branch_overflow_pages", s.BranchOverflowN},
+			{"leaf_pages", s.LeafPageN},
+			{"leaf_overflow_pages", s.LeafOverflowN},
+			{"alloc_bytes", alloc},
+			{"inuse_bytes", inuse},
+			{"inline", inline},
+		},
+	}
+}
+
+func pages(bytes float64, pageSize int64) int64 {
+	return (int64(bytes) + pageSize - 1) / pageSize
+}
+
+func percent(n, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// printDiff prints the differences found by dump.Diff followed by the
+// comparison outcome. Mismatching entries are printed from both WALs, redacted
+// with redaction. It returns false if the WALs differ.
+func printDiff(out io.Writer, result *dump.DiffResult, redaction dump.Redaction) bool {
+	if len(result.Differences) == 0 {
+		fmt.Fprintf(out, "WALs identical: %d matching entries\n", result.Matching)
+		return true
+	}
+
+	fmt.Fprintf(out, "%10s\t%10s\tdifference\n", "first", "last")
+	for _, d := range result.Differences {
+		switch d.Kind {
+		case dump.TermMismatch:
+			fmt.Fprintf(out, "%10d\t%10d\t%s: a=%d b=%d\n", d.First, d.Last, d.Kind, d.A.Term, d.B.Term)
+		default:
+			fmt.Fprintf(out, "%10d\t%10d\t%s\n", d.First, d.Last, d.Kind)
+		}
+		if d.Kind == dump.TermMismatch || d.Kind == dump.PayloadMismatch {
+			for _, e := range []struct {
+				name  string
+				entry dump.Entry
+			}{{"a", d.A}, {"b", d.B}} {
+				dump.Redact(&e.entry, redaction)
+				fmt.Fprintf(out, "\t%s: ", e.name)
+				dump.PrintEntry(out, e.entry)
+				fmt.Fprintln(out)
+			}
+		}
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "WALs differ: %d matching entries, %d differences, first divergence at index %d\n",
+		result.Matching, len(result.Differences), result.Differences[0].First)
+	return false
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestPrintDiff(t *testing.T) {
+	put := func(term, index uint64, value string) dump.Entry {
+		rr := &etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte(value)}}
+		return dump.DecodeEntry(raftpb.Entry{Term: term, Index: index, Data: pbutil.MustMarshal(rr)}, "")
+	}
+	result := &dump.DiffResult{
+		Matching: 3,
+		Differences: []dump.Difference{
+			{Kind: dump.PayloadMismatch, First: 4, Last: 4, A: put(1, 4, "a"), B: put(1, 4, "b")},
+			{Kind: dump.TermMismatch, First: 5, Last: 6, A: put(1, 5, "a"), B: put(2, 5, "a")},
+			{Kind: dump.MissingInB, First: 7, Last: 9, A: put(1, 7, "a")},
+		},
+	}
+
+	var out bytes.Buffer
+	assert.False(t, printDiff(&out, result, dump.RedactNone))
+	assert.Equal(t, `     first	      last	difference
+         4	         4	payload mismatch
+	a:    1	         4	norm	put:<key:"foo" value:"a" > 
+	b:    1	         4	norm	put:<key:"foo" value:"b" > 
+         5	         6	term mismatch: a=1 b=2
+	a:    1	         5	norm	put:<key:"foo" value:"a" > 
+	b:    2	         5	norm	put:<key:"foo" value:"a" > 
+         7	         9	missing in b
+
+WALs differ: 3 matching entries, 3 differences, first divergence at index 4
+`, out.String())
+
+	out.Reset()
+	assert.True(t, printDiff(&out, &dump.DiffResult{Matching: 3}, dump.RedactNone))
+	assert.Equal(t, "WALs identical: 3 matching entries\n", out.String())
+}
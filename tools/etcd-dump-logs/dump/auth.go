@@ -0,0 +1,114 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"io"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// authRequests are the names of the auth requests of the internal raft
+// request. Each of them is accepted by the entry-type flag prefixed with IRR,
+// e.g. IRRAuthUserAdd.
+var authRequests = []string{
+	"AuthEnable",
+	"AuthDisable",
+	"AuthStatus",
+	"Authenticate",
+	"AuthUserAdd",
+	"AuthUserDelete",
+	"AuthUserGet",
+	"AuthUserChangePassword",
+	"AuthUserGrantRole",
+	"AuthUserRevokeRole",
+	"AuthUserList",
+	"AuthRoleList",
+	"AuthRoleAdd",
+	"AuthRoleDelete",
+	"AuthRoleGet",
+	"AuthRoleGrantPermission",
+	"AuthRoleRevokePermission",
+}
+
+// passIRRAuth returns a filter passing the internal raft requests setting the
+// auth request of the given name.
+func passIRRAuth(name string) EntryFilter {
+	return func(entry raftpb.Entry) (bool, string) {
+		var rr etcdserverpb.InternalRaftRequest
+		return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && RequestName(&rr) == name, "AuthRequest"
+	}
+}
+
+// printAuthRequest prints the auth request of the entry along with the user
+// that issued it, if known. Passwords and tokens are never printed.
+func printAuthRequest(w io.Writer, entry raftpb.Entry) {
+	var rr etcdserverpb.InternalRaftRequest
+	if err := rr.Unmarshal(entry.Data); err != nil {
+		return
+	}
+	fmt.Fprintf(w, "%4d\t%10d\tnorm\tmethod=%s", entry.Term, entry.Index, RequestName(&rr))
+	if h := rr.Header; h != nil {
+		if h.Username != "" {
+			fmt.Fprintf(w, " by=%q", h.Username)
+		}
+		if h.AuthRevision != 0 {
+			fmt.Fprintf(w, " auth-revision=%d", h.AuthRevision)
+		}
+	}
+	if args := authRequestArgs(&rr); args != "" {
+		fmt.Fprint(w, " ", args)
+	}
+}
+
+// authRequestArgs describes the users, roles and permissions the auth request
+// of rr applies to.
+func authRequestArgs(rr *etcdserverpb.InternalRaftRequest) string {
+	switch {
+	case rr.Authenticate != nil:
+		return fmt.Sprintf("user=%q", rr.Authenticate.Name)
+	case rr.AuthUserAdd != nil:
+		noPassword := rr.AuthUserAdd.Options != nil && rr.AuthUserAdd.Options.NoPassword
+		return fmt.Sprintf("user=%q no-password=%t", rr.AuthUserAdd.Name, noPassword)
+	case rr.AuthUserDelete != nil:
+		return fmt.Sprintf("user=%q", rr.AuthUserDelete.Name)
+	case rr.AuthUserGet != nil:
+		return fmt.Sprintf("user=%q", rr.AuthUserGet.Name)
+	case rr.AuthUserChangePassword != nil:
+		return fmt.Sprintf("user=%q", rr.AuthUserChangePassword.Name)
+	case rr.AuthUserGrantRole != nil:
+		return fmt.Sprintf("user=%q role=%q", rr.AuthUserGrantRole.User, rr.AuthUserGrantRole.Role)
+	case rr.AuthUserRevokeRole != nil:
+		return fmt.Sprintf("user=%q role=%q", rr.AuthUserRevokeRole.Name, rr.AuthUserRevokeRole.Role)
+	case rr.AuthRoleAdd != nil:
+		return fmt.Sprintf("role=%q", rr.AuthRoleAdd.Name)
+	case rr.AuthRoleDelete != nil:
+		return fmt.Sprintf("role=%q", rr.AuthRoleDelete.Role)
+	case rr.AuthRoleGet != nil:
+		return fmt.Sprintf("role=%q", rr.AuthRoleGet.Role)
+	case rr.AuthRoleGrantPermission != nil:
+		args := fmt.Sprintf("role=%q", rr.AuthRoleGrantPermission.Name)
+		if p := rr.AuthRoleGrantPermission.Perm; p != nil {
+			args += fmt.Sprintf(" perm=%s key=%q range-end=%q", p.PermType, p.Key, p.RangeEnd)
+		}
+		return args
+	case rr.AuthRoleRevokePermission != nil:
+		p := rr.AuthRoleRevokePermission
+		return fmt.Sprintf("role=%q key=%q range-end=%q", p.Role, p.Key, p.RangeEnd)
+	}
+	return ""
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/authpb"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestAuthEntryTypes(t *testing.T) {
+	header := &etcdserverpb.RequestHeader{ID: 1, Username: "root", AuthRevision: 7}
+	tcs := []struct {
+		entryType string
+		rr        *etcdserverpb.InternalRaftRequest
+		want      string
+	}{
+		{
+			entryType: "IRRAuthEnable",
+			rr:        &etcdserverpb.InternalRaftRequest{Header: header, AuthEnable: &etcdserverpb.AuthEnableRequest{}},
+			want:      `   1	         1	norm	method=AuthEnable by="root" auth-revision=7`,
+		},
+		{
+			entryType: "IRRAuthenticate",
+			rr:        &etcdserverpb.InternalRaftRequest{Authenticate: &etcdserverpb.InternalAuthenticateRequest{Name: "alice", Password: "secret", SimpleToken: "token"}},
+			want:      `   1	         1	norm	method=Authenticate user="alice"`,
+		},
+		{
+			entryType: "IRRAuthUserAdd",
+			rr: &etcdserverpb.InternalRaftRequest{Header: header, AuthUserAdd: &etcdserverpb.AuthUserAddRequest{
+				Name: "alice", HashedPassword: "secret", Options: &authpb.UserAddOptions{NoPassword: true},
+			}},
+			want: `   1	         1	norm	method=AuthUserAdd by="root" auth-revision=7 user="alice" no-password=true`,
+		},
+		{
+			entryType: "IRRAuthUserChangePassword",
+			rr:        &etcdserverpb.InternalRaftRequest{Header: header, AuthUserChangePassword: &etcdserverpb.AuthUserChangePasswordRequest{Name: "alice", HashedPassword: "secret"}},
+			want:      `   1	         1	norm	method=AuthUserChangePassword by="root" auth-revision=7 user="alice"`,
+		},
+		{
+			entryType: "IRRAuthRoleGrantPermission",
+			rr: &etcdserverpb.InternalRaftRequest{Header: header, AuthRoleGrantPermission: &etcdserverpb.AuthRoleGrantPermissionRequest{
+				Name: "reader", Perm: &authpb.Permission{PermType: authpb.READ, Key: []byte("/app/"), RangeEnd: []byte("/app0")},
+			}},
+			want: `   1	         1	norm	method=AuthRoleGrantPermission by="root" auth-revision=7 role="reader" perm=READ key="/app/" range-end="/app0"`,
+		},
+	}
+	put := raftpb.Entry{Term: 1, Index: 2, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{
+		Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")},
+	})}
+	allAuth, invalid := EntryFilters("IRRAuth")
+	require.Empty(t, invalid)
+	for _, tc := range tcs {
+		t.Run(tc.entryType, func(t *testing.T) {
+			filters, invalid := EntryFilters(tc.entryType)
+			require.Empty(t, invalid)
+			e := raftpb.Entry{Term: 1, Index: 1, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(tc.rr)}
+
+			passed, typ := PassEntryFilters(filters, e)
+			require.True(t, passed)
+			passed, _ = PassEntryFilters(allAuth, e)
+			require.True(t, passed)
+			passed, _ = PassEntryFilters(filters, put)
+			require.False(t, passed)
+
+			var out bytes.Buffer
+			PrintEntry(&out, DecodeEntry(e, typ))
+			assert.Equal(t, tc.want, out.String())
+			assert.NotContains(t, out.String(), "secret")
+
+			name, _ := DescribeEntry(e)
+			assert.Equal(t, tc.entryType, name)
+		})
+	}
+	passed, _ := PassEntryFilters(allAuth, put)
+	assert.False(t, passed)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"io"
+	"plugin"
+	"reflect"
+	"strings"
+)
+
+// Decoder decodes the data of the listed entries, e.g. to print the
+// application specific values they carry. A decoder is kept for a whole dump,
+// so it can keep state across the entries.
+type Decoder interface {
+	Decode(data []byte) ([]byte, error)
+}
+
+// DecoderFunc adapts a function to a Decoder.
+type DecoderFunc func(data []byte) ([]byte, error)
+
+func (f DecoderFunc) Decode(data []byte) ([]byte, error) { return f(data) }
+
+// DecodeCloser is a Decoder holding resources released by Close.
+type DecodeCloser interface {
+	Decoder
+	io.Closer
+}
+
+// OpenDecoder opens the decoder described by spec, which is one of:
+//
+//	plugin:<file>   a Go plugin, see OpenPluginDecoder
+//	wasm:<file>     a WebAssembly module, see OpenWASMDecoder
+//	grpc:<address>  a gRPC decoder service, see DialGRPCDecoder
+func OpenDecoder(spec string) (DecodeCloser, error) {
+	kind, arg, ok := strings.Cut(spec, ":")
+	if !ok || arg == "" {
+		return nil, fmt.Errorf("invalid decoder %q, must be plugin:<file>, wasm:<file> or grpc:<address>", spec)
+	}
+	switch kind {
+	case "plugin":
+		d, err := OpenPluginDecoder(arg)
+		if err != nil {
+			return nil, err
+		}
+		return nopCloser{d}, nil
+	case "wasm":
+		return OpenWASMDecoder(arg)
+	case "grpc":
+		return DialGRPCDecoder(arg)
+	default:
+		return nil, fmt.Errorf("invalid decoder %q, must be plugin:<file>, wasm:<file> or grpc:<address>", spec)
+	}
+}
+
+type nopCloser struct{ Decoder }
+
+func (nopCloser) Close() error { return nil }
+
+// OpenPluginDecoder loads the Go plugin of the given file. The plugin must
+// export either a Decoder variable whose value implements Decoder, or a
+// Decode function of the DecoderFunc signature. As any Go plugin, it must be
+// built with the same Go version and versions of the packages it shares with
+// etcd-dump-logs.
+func OpenPluginDecoder(path string) (Decoder, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open decoder plugin: %w", err)
+	}
+	if sym, err := p.Lookup("Decoder"); err == nil {
+		// the symbol of a variable is a pointer to it
+		if d, ok := sym.(Decoder); ok {
+			return d, nil
+		}
+		if v := reflect.ValueOf(sym); v.Kind() == reflect.Pointer && !v.IsNil() {
+			if d, ok := v.Elem().Interface().(Decoder); ok {
+				return d, nil
+			}
+		}
+		return nil, fmt.Errorf("decoder plugin %s: Decoder of type %T does not implement Decode([]byte) ([]byte, error)", path, sym)
+	}
+	sym, err := p.Lookup("Decode")
+	if err != nil {
+		return nil, fmt.Errorf("decoder plugin %s exports neither Decoder nor Decode", path)
+	}
+	f, ok := sym.(func([]byte) ([]byte, error))
+	if !ok {
+		return nil, fmt.Errorf("decoder plugin %s: Decode of type %T is not a func([]byte) ([]byte, error)", path, sym)
+	}
+	return DecoderFunc(f), nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testDecoder checks the behaviour of the test decoders: they number the
+// entries they decode and fail on empty entries.
+func testDecoder(t *testing.T, d Decoder) {
+	out, err := d.Decode([]byte("foo"))
+	require.NoError(t, err)
+	assert.Equal(t, "#1 3 bytes", string(out))
+	_, err = d.Decode(nil)
+	require.ErrorContains(t, err, "empty entry")
+	out, err = d.Decode([]byte("foobar"))
+	require.NoError(t, err)
+	assert.Equal(t, "#2 6 bytes", string(out))
+}
+
+// buildTestDecoder builds the test decoder of the given directory, skipping
+// the test if the toolchain cannot build it in this environment.
+func buildTestDecoder(t *testing.T, dir, file string, env []string, args ...string) string {
+	out := filepath.Join(t.TempDir(), file)
+	cmd := exec.Command("go", append(append([]string{"build"}, args...), "-o", out, ".")...)
+	cmd.Dir = filepath.Join("..", "testdecoder", dir)
+	cmd.Env = append(os.Environ(), env...)
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Skipf("cannot build the %s test decoder: %v\n%s", dir, err, b)
+	}
+	return out
+}
+
+func TestOpenDecoderInvalid(t *testing.T) {
+	for _, spec := range []string{"", "decoder.so", "plugin:", "exec:decoder"} {
+		_, err := OpenDecoder(spec)
+		require.ErrorContains(t, err, "invalid decoder", spec)
+	}
+}
+
+func TestPluginDecoder(t *testing.T) {
+	p := buildTestDecoder(t, "plugin", "decoder.so", nil, "-buildmode=plugin")
+	d, err := OpenDecoder("plugin:" + p)
+	if err != nil && strings.Contains(err.Error(), "different version of package") {
+		// e.g. when the tests are built with -race or -cover
+		t.Skipf("plugin not built like the test binary: %v", err)
+	}
+	require.NoError(t, err)
+	defer d.Close()
+	testDecoder(t, d)
+}
+
+func TestWASMDecoder(t *testing.T) {
+	p := buildTestDecoder(t, "wasm", "decoder.wasm", []string{"GOOS=wasip1", "GOARCH=wasm"}, "-buildmode=c-shared")
+	d, err := OpenDecoder("wasm:" + p)
+	require.NoError(t, err)
+	defer d.Close()
+	testDecoder(t, d)
+}
+
+func TestGRPCDecoder(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	n := 0
+	RegisterDecoderServer(s, DecoderFunc(func(data []byte) ([]byte, error) {
+		if len(data) == 0 {
+			return nil, errors.New("empty entry")
+		}
+		n++
+		return fmt.Appendf(nil, "#%d %d bytes", n, len(data)), nil
+	}))
+	go s.Serve(lis)
+	defer s.Stop()
+
+	d, err := OpenDecoder("grpc:" + lis.Addr().String())
+	require.NoError(t, err)
+	defer d.Close()
+	testDecoder(t, d)
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// DiffKind is the kind of a Difference found by Diff.
+type DiffKind int
+
+const (
+	// TermMismatch is reported for the entries both WALs hold at different terms.
+	TermMismatch DiffKind = iota + 1
+	// PayloadMismatch is reported for the entries both WALs hold at the same
+	// term, with a different type or data.
+	PayloadMismatch
+	// MissingInA is reported for the entries only the second WAL holds.
+	MissingInA
+	// MissingInB is reported for the entries only the first WAL holds.
+	MissingInB
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case TermMismatch:
+		return "term mismatch"
+	case PayloadMismatch:
+		return "payload mismatch"
+	case MissingInA:
+		return "missing in a"
+	case MissingInB:
+		return "missing in b"
+	}
+	return fmt.Sprintf("DiffKind(%d)", int(k))
+}
+
+// Difference is a range of consecutive indexes where two WALs differ the same
+// way. Consecutive term mismatches are only grouped together if they have the
+// same terms.
+type Difference struct {
+	Kind DiffKind
+	// First and Last are the first and last index of the range (inclusive).
+	First uint64
+	Last  uint64
+	// A and B are the entries of each WAL at index First, if the WAL holds it.
+	A Entry
+	B Entry
+}
+
+// DiffResult is the outcome of Diff.
+type DiffResult struct {
+	// Matching is the number of entries identical in both WALs.
+	Matching int
+	// Differences are ordered by index.
+	Differences []Difference
+}
+
+// add appends d, a difference at a single index, to the result, extending the
+// last difference if d continues it.
+func (r *DiffResult) add(d Difference) {
+	if n := len(r.Differences); n > 0 {
+		last := &r.Differences[n-1]
+		if last.Kind == d.Kind && last.Last+1 == d.First &&
+			(d.Kind != TermMismatch || last.A.Term == d.A.Term && last.B.Term == d.B.Term) {
+			last.Last = d.First
+			return
+		}
+	}
+	r.Differences = append(r.Differences, d)
+}
+
+// Diff aligns the entries returned by the iterators a and b by index and
+// compares them. The iterators must return entries in increasing index order,
+// as returned by Reader.Entries, and are consumed one entry at a time, so
+// WALs larger than the available memory can be compared.
+func Diff(a, b *Iterator) (*DiffResult, error) {
+	result := &DiffResult{}
+	okA, okB := a.Next(), b.Next()
+	for okA || okB {
+		ea, eb := a.Entry(), b.Entry()
+		switch {
+		case !okB || okA && ea.Index < eb.Index:
+			result.add(Difference{Kind: MissingInB, First: ea.Index, Last: ea.Index, A: ea})
+			okA = a.Next()
+		case !okA || eb.Index < ea.Index:
+			result.add(Difference{Kind: MissingInA, First: eb.Index, Last: eb.Index, B: eb})
+			okB = b.Next()
+		default:
+			switch {
+			case ea.Term != eb.Term:
+				result.add(Difference{Kind: TermMismatch, First: ea.Index, Last: ea.Index, A: ea, B: eb})
+			case ea.Entry.Type != eb.Entry.Type || !bytes.Equal(ea.Data, eb.Data):
+				result.add(Difference{Kind: PayloadMismatch, First: ea.Index, Last: ea.Index, A: ea, B: eb})
+			default:
+				result.Matching++
+			}
+			okA, okB = a.Next(), b.Next()
+		}
+	}
+	if err := a.Err(); err != nil {
+		return nil, fmt.Errorf("failed reading WAL a: %w", err)
+	}
+	if err := b.Err(); err != nil {
+		return nil, fmt.Errorf("failed reading WAL b: %w", err)
+	}
+	return result, nil
+}
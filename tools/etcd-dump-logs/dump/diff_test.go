@@ -0,0 +1,120 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// putEntry returns an entry putting key.
+func putEntry(term, index uint64, key string) raftpb.Entry {
+	rr := &etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte(key), Value: []byte("v")}}
+	return raftpb.Entry{Term: term, Index: index, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(rr)}
+}
+
+// walEntries writes ents to a new WAL and returns an iterator over them.
+func walEntries(t *testing.T, ents []raftpb.Entry) *Iterator {
+	t.Helper()
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Save(raftpb.HardState{}, ents))
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, r.Scan())
+	it, err := r.Entries()
+	require.NoError(t, err)
+	t.Cleanup(func() { it.Close() })
+	return it
+}
+
+func TestDiff(t *testing.T) {
+	a := []raftpb.Entry{
+		putEntry(1, 1, "a"), putEntry(1, 2, "b"), putEntry(1, 3, "c"),
+		putEntry(2, 4, "d"), putEntry(2, 5, "e"), putEntry(2, 6, "f"),
+	}
+	b := []raftpb.Entry{
+		putEntry(1, 1, "a"), putEntry(1, 2, "x"), putEntry(2, 3, "c"),
+		putEntry(3, 4, "d"), putEntry(3, 5, "e"),
+	}
+
+	tests := []struct {
+		name     string
+		a, b     []raftpb.Entry
+		matching int
+		expected []Difference
+	}{
+		{
+			name:     "identical",
+			a:        a,
+			b:        a,
+			matching: 6,
+		},
+		{
+			name:     "divergent",
+			a:        a,
+			b:        b,
+			matching: 1,
+			expected: []Difference{
+				{Kind: PayloadMismatch, First: 2, Last: 2},
+				{Kind: TermMismatch, First: 3, Last: 3},
+				{Kind: TermMismatch, First: 4, Last: 5},
+				{Kind: MissingInB, First: 6, Last: 6},
+			},
+		},
+		{
+			name:     "shorter a",
+			a:        a[:2],
+			b:        a,
+			matching: 2,
+			expected: []Difference{
+				{Kind: MissingInA, First: 3, Last: 6},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Diff(walEntries(t, tt.a), walEntries(t, tt.b))
+			require.NoError(t, err)
+			assert.Equal(t, tt.matching, result.Matching)
+
+			require.Len(t, result.Differences, len(tt.expected))
+			for i, d := range result.Differences {
+				assert.Equal(t, tt.expected[i].Kind, d.Kind)
+				assert.Equal(t, tt.expected[i].First, d.First)
+				assert.Equal(t, tt.expected[i].Last, d.Last)
+				if d.Kind != MissingInA {
+					assert.Equal(t, tt.a[d.First-1], d.A.Entry)
+				}
+				if d.Kind != MissingInB {
+					assert.Equal(t, tt.b[d.First-1], d.B.Entry)
+				}
+			}
+		})
+	}
+}
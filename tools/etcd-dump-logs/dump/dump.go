@@ -0,0 +1,229 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dump decodes and prints the entries of etcd write ahead logs. It is
+// the library behind the etcd-dump-logs tool and can be used by other tooling
+// to consume decoded WAL entries programmatically.
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// DefaultEntryTypes are the entry types returned when no entry types are
+// configured. They cover all the entries of the WAL.
+const DefaultEntryTypes = "Normal,ConfigChange"
+
+// Config configures the entries returned by Entries.
+type Config struct {
+	// WALDir is the directory of the WAL files.
+	WALDir string
+	// Snapshot is the snapshot to start reading from. Only the entries with
+	// an index greater than the snapshot index are returned.
+	Snapshot walpb.Snapshot
+	// EndIndex is the index to stop reading at (exclusive). Zero means no limit.
+	EndIndex uint64
+	// StartTerm is the lowest term of the returned entries (inclusive).
+	StartTerm uint64
+	// EndTerm is the term the returned entries stop at (exclusive). Zero
+	// means no limit.
+	EndTerm uint64
+	// MinSize is the smallest data size in bytes of the returned entries.
+	MinSize uint64
+	// EntryTypes is a comma separated list of the entry types to return, in
+	// the format of the etcd-dump-logs entry-type flag. Defaults to
+	// DefaultEntryTypes.
+	EntryTypes string
+	// SkipCorrupt makes Entries skip the corrupt records of the WAL instead
+	// of failing, see Reader.SetSkipCorrupt. OnSkip, if set, is called for
+	// each skipped range.
+	SkipCorrupt bool
+	OnSkip      func(SkippedRange)
+}
+
+// Entry is a decoded WAL entry.
+type Entry struct {
+	raftpb.Entry
+	// Type is the type of the entry chosen by the entry filters:
+	// ConfigChange, ConfigChangeV2, Request, InternalRaftRequest or
+	// UnknownNormal.
+	Type string
+
+	// ConfChange is set for ConfigChange entries.
+	ConfChange *raftpb.ConfChange
+	// ConfChangeV2 is set for ConfigChangeV2 entries.
+	ConfChangeV2 *raftpb.ConfChangeV2
+	// Request is set for Request entries.
+	Request *etcdserverpb.Request
+	// InternalRaftRequest is set for InternalRaftRequest entries.
+	InternalRaftRequest *etcdserverpb.InternalRaftRequest
+
+	// Segment is the name of the WAL file holding the entry record, and
+	// Offset the byte offset of the record in the file. They are set for the
+	// entries returned by an Iterator.
+	Segment string
+	Offset  int64
+
+	// Members resolves the member IDs of configuration changes to the
+	// names and peer URLs of the members when printing them. It is set for
+	// the entries returned by an Iterator of a Reader with members set by
+	// SetMembers.
+	Members Members
+}
+
+// Entries opens the WAL in cfg.WALDir and returns an iterator over the
+// entries matching cfg. Like wal.ReadAll, it returns wal.ErrSnapshotNotFound
+// and wal.ErrSliceOutOfRange together with an iterator over the continuous
+// entries that could be read. The returned iterator must be closed.
+func Entries(cfg Config) (*Iterator, error) {
+	endIndex := cfg.EndIndex
+	if endIndex == 0 {
+		endIndex = math.MaxUint64
+	}
+	entryTypes := cfg.EntryTypes
+	if entryTypes == "" {
+		entryTypes = DefaultEntryTypes
+	}
+	filters, invalid := EntryFilters(entryTypes)
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid entry types: %v", invalid)
+	}
+
+	r, err := NewReader(cfg.WALDir, cfg.Snapshot, endIndex)
+	if err != nil {
+		return nil, err
+	}
+	endTerm := cfg.EndTerm
+	if endTerm == 0 {
+		endTerm = math.MaxUint64
+	}
+	r.SetTermRange(cfg.StartTerm, endTerm)
+	r.SetMinSize(cfg.MinSize)
+	if cfg.SkipCorrupt {
+		r.SetSkipCorrupt(cfg.OnSkip)
+	}
+	serr := r.Scan()
+	if serr != nil && !errors.Is(serr, wal.ErrSnapshotNotFound) && !errors.Is(serr, wal.ErrSliceOutOfRange) {
+		return nil, serr
+	}
+	it, err := r.Entries(filters...)
+	if err != nil {
+		return nil, err
+	}
+	return it, serr
+}
+
+// DecodeEntry decodes the data of the entry of the given type. If typ is
+// empty, the type is chosen by the DefaultEntryTypes filters.
+func DecodeEntry(e raftpb.Entry, typ string) Entry {
+	if typ == "" {
+		filters, _ := EntryFilters(DefaultEntryTypes)
+		_, typ = PassEntryFilters(filters, e)
+	}
+	de := Entry{Entry: e, Type: typ}
+	switch typ {
+	case "ConfigChange":
+		var cc raftpb.ConfChange
+		if cc.Unmarshal(e.Data) == nil {
+			de.ConfChange = &cc
+		}
+	case "ConfigChangeV2":
+		var cc raftpb.ConfChangeV2
+		if cc.Unmarshal(e.Data) == nil {
+			de.ConfChangeV2 = &cc
+		}
+	case "Request":
+		var r etcdserverpb.Request
+		if r.Unmarshal(e.Data) == nil {
+			de.Request = &r
+		}
+	case "InternalRaftRequest", "AuthRequest":
+		var rr etcdserverpb.InternalRaftRequest
+		if rr.Unmarshal(e.Data) == nil {
+			de.InternalRaftRequest = &rr
+		}
+	}
+	return de
+}
+
+// DescribeEntry returns a short name of the entry type, as used by the
+// entry-type flag, and the key the entry targets, if any.
+func DescribeEntry(e raftpb.Entry) (string, string) {
+	switch e.Type {
+	case raftpb.EntryConfChange:
+		return "ConfigChange", ""
+	case raftpb.EntryConfChangeV2:
+		return "ConfigChangeV2", ""
+	}
+
+	var rr etcdserverpb.InternalRaftRequest
+	if rr.Unmarshal(e.Data) == nil {
+		switch {
+		case rr.Range != nil:
+			return "IRRRange", string(rr.Range.Key)
+		case rr.Put != nil:
+			return "IRRPut", string(rr.Put.Key)
+		case rr.DeleteRange != nil:
+			return "IRRDeleteRange", string(rr.DeleteRange.Key)
+		case rr.Txn != nil:
+			return "IRRTxn", txnKey(rr.Txn)
+		case rr.Compaction != nil:
+			return "IRRCompaction", ""
+		case rr.LeaseGrant != nil:
+			return "IRRLeaseGrant", ""
+		case rr.LeaseRevoke != nil:
+			return "IRRLeaseRevoke", ""
+		case rr.LeaseCheckpoint != nil:
+			return "IRRLeaseCheckpoint", ""
+		}
+		if name := RequestName(&rr); slices.Contains(authRequests, name) {
+			return "IRR" + name, ""
+		}
+		return "InternalRaftRequest", ""
+	}
+
+	var r etcdserverpb.Request
+	if r.Unmarshal(e.Data) == nil {
+		return "Request", r.Path
+	}
+	return "UnknownNormal", ""
+}
+
+// txnKey returns the key of the first operation found in the transaction.
+func txnKey(txn *etcdserverpb.TxnRequest) string {
+	if len(txn.Compare) > 0 {
+		return string(txn.Compare[0].Key)
+	}
+	for _, ops := range [][]*etcdserverpb.RequestOp{txn.Success, txn.Failure} {
+		for _, op := range ops {
+			switch {
+			case op.GetRequestPut() != nil:
+				return string(op.GetRequestPut().Key)
+			case op.GetRequestDeleteRange() != nil:
+				return string(op.GetRequestDeleteRange().Key)
+			case op.GetRequestRange() != nil:
+				return string(op.GetRequestRange().Key)
+			}
+		}
+	}
+	return ""
+}
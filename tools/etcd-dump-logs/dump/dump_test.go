@@ -0,0 +1,201 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestEntries(t *testing.T) {
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	ents := []raftpb.Entry{
+		{Term: 1, Index: 1, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 2})},
+		{Term: 1, Index: 2, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 1, Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}})},
+		{Term: 1, Index: 3, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 2, Compaction: &etcdserverpb.CompactionRequest{Revision: 1}})},
+		{Term: 2, Index: 4, Type: raftpb.EntryNormal, Data: []byte("?")},
+	}
+	require.NoError(t, w.Save(raftpb.HardState{}, ents))
+	require.NoError(t, w.Close())
+
+	tcs := []struct {
+		name         string
+		cfg          Config
+		wantIndexes  []uint64
+		wantTypes    []string
+		wantPrintout string
+	}{
+		{
+			name:        "default entry types",
+			cfg:         Config{WALDir: dir},
+			wantIndexes: []uint64{1, 2, 3, 4},
+			wantTypes:   []string{"ConfigChange", "InternalRaftRequest", "InternalRaftRequest", "UnknownNormal"},
+			wantPrintout: `   1	         1	conf	method=ConfChangeAddNode id=2
+   1	         2	norm	ID:1 put:<key:"foo" value:"bar" > 
+   1	         3	norm	ID:2 compaction:<revision:1 > 
+   2	         4	norm	???
+`,
+		},
+		{
+			name:        "put entries before index 3",
+			cfg:         Config{WALDir: dir, EntryTypes: "IRRPut,IRRCompaction", EndIndex: 3},
+			wantIndexes: []uint64{2},
+			wantTypes:   []string{"InternalRaftRequest"},
+			wantPrintout: `   1	         2	norm	ID:1 put:<key:"foo" value:"bar" > 
+`,
+		},
+		{
+			name:        "entries of term 2",
+			cfg:         Config{WALDir: dir, StartTerm: 2, EndTerm: 3},
+			wantIndexes: []uint64{4},
+			wantTypes:   []string{"UnknownNormal"},
+			wantPrintout: `   2	         4	norm	???
+`,
+		},
+		{
+			name:        "entries before term 2",
+			cfg:         Config{WALDir: dir, EndTerm: 2},
+			wantIndexes: []uint64{1, 2, 3},
+			wantTypes:   []string{"ConfigChange", "InternalRaftRequest", "InternalRaftRequest"},
+			wantPrintout: `   1	         1	conf	method=ConfChangeAddNode id=2
+   1	         2	norm	ID:1 put:<key:"foo" value:"bar" > 
+   1	         3	norm	ID:2 compaction:<revision:1 > 
+`,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			it, err := Entries(tc.cfg)
+			require.NoError(t, err)
+			defer it.Close()
+
+			var indexes []uint64
+			var types []string
+			var out bytes.Buffer
+			for it.Next() {
+				e := it.Entry()
+				indexes = append(indexes, e.Index)
+				types = append(types, e.Type)
+				PrintEntry(&out, e)
+				out.WriteString("\n")
+			}
+			require.NoError(t, it.Err())
+			assert.Equal(t, tc.wantIndexes, indexes)
+			assert.Equal(t, tc.wantTypes, types)
+			assert.Equal(t, tc.wantPrintout, out.String())
+		})
+	}
+}
+
+func TestEntriesInvalidEntryType(t *testing.T) {
+	_, err := Entries(Config{WALDir: t.TempDir(), EntryTypes: "IRRPut,Unknown"})
+	require.ErrorContains(t, err, "Unknown")
+}
+
+func TestDecodeEntry(t *testing.T) {
+	put := &etcdserverpb.InternalRaftRequest{ID: 1, Put: &etcdserverpb.PutRequest{Key: []byte("foo")}}
+	e := DecodeEntry(raftpb.Entry{Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(put)}, "")
+	assert.Equal(t, "InternalRaftRequest", e.Type)
+	require.NotNil(t, e.InternalRaftRequest)
+	assert.Equal(t, []byte("foo"), e.InternalRaftRequest.Put.Key)
+
+	e = DecodeEntry(raftpb.Entry{Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{NodeID: 3})}, "")
+	assert.Equal(t, "ConfigChange", e.Type)
+	require.NotNil(t, e.ConfChange)
+	assert.Equal(t, uint64(3), e.ConfChange.NodeID)
+
+	cc := &raftpb.ConfChangeV2{Changes: []raftpb.ConfChangeSingle{{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 4}}}
+	e = DecodeEntry(raftpb.Entry{Type: raftpb.EntryConfChangeV2, Data: pbutil.MustMarshal(cc)}, "")
+	assert.Equal(t, "ConfigChangeV2", e.Type)
+	require.NotNil(t, e.ConfChangeV2)
+	assert.Equal(t, cc.Changes, e.ConfChangeV2.Changes)
+}
+
+func TestPrintConfChangeV2(t *testing.T) {
+	tcs := []struct {
+		name         string
+		cc           raftpb.ConfChangeV2
+		wantPrintout string
+		wantMethod   string
+		wantNodeIDs  string
+	}{
+		{
+			name:         "simple",
+			cc:           raftpb.ConfChangeV2{Changes: []raftpb.ConfChangeSingle{{Type: raftpb.ConfChangeRemoveNode, NodeID: 2}}},
+			wantPrintout: "   1\t         5\tconf\tv2 method=ConfChangeRemoveNode id=2",
+			wantMethod:   "ConfChangeRemoveNode",
+			wantNodeIDs:  "2",
+		},
+		{
+			name:         "simple learner",
+			cc:           raftpb.ConfChangeV2{Changes: []raftpb.ConfChangeSingle{{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 3}}},
+			wantPrintout: "   1\t         5\tconf\tv2 method=ConfChangeAddLearnerNode id=3 learner=true",
+			wantMethod:   "ConfChangeAddLearnerNode",
+			wantNodeIDs:  "3",
+		},
+		{
+			name: "enter joint automatically left",
+			cc: raftpb.ConfChangeV2{Changes: []raftpb.ConfChangeSingle{
+				{Type: raftpb.ConfChangeAddNode, NodeID: 4},
+				{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 2},
+			}},
+			wantPrintout: "   1\t         5\tconf\tv2 method=EnterJoint auto-leave=true changes=[ConfChangeAddNode id=4, ConfChangeAddLearnerNode id=2 learner=true]",
+			wantMethod:   "EnterJoint",
+			wantNodeIDs:  "4 2",
+		},
+		{
+			name: "enter joint explicitly",
+			cc: raftpb.ConfChangeV2{Transition: raftpb.ConfChangeTransitionJointExplicit, Changes: []raftpb.ConfChangeSingle{
+				{Type: raftpb.ConfChangeRemoveNode, NodeID: 10},
+			}},
+			wantPrintout: "   1\t         5\tconf\tv2 method=EnterJoint auto-leave=false changes=[ConfChangeRemoveNode id=a]",
+			wantMethod:   "EnterJoint",
+			wantNodeIDs:  "a",
+		},
+		{
+			name:         "leave joint",
+			cc:           raftpb.ConfChangeV2{Context: []byte("ctx")},
+			wantPrintout: "   1\t         5\tconf\tv2 method=LeaveJoint",
+			wantMethod:   "LeaveJoint",
+		},
+		{
+			name:         "unknown transition",
+			cc:           raftpb.ConfChangeV2{Transition: 7},
+			wantPrintout: "   1\t         5\tconf\tv2 transition=7 ???",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			e := DecodeEntry(raftpb.Entry{Term: 1, Index: 5, Type: raftpb.EntryConfChangeV2, Data: pbutil.MustMarshal(&tc.cc)}, "")
+			var out bytes.Buffer
+			PrintEntry(&out, e)
+			assert.Equal(t, tc.wantPrintout, out.String())
+
+			values := FieldValues(e, []string{"method", "node-id"})
+			assert.Equal(t, []string{tc.wantMethod, tc.wantNodeIDs}, values)
+		})
+	}
+}
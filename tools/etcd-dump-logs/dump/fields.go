@@ -0,0 +1,209 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+)
+
+// Fields are the names of the entry fields returned by FieldValues:
+//
+//	term        the term of the entry
+//	index       the index of the entry
+//	type        the type of the entry: ConfigChange, ConfigChangeV2, Request, InternalRaftRequest or UnknownNormal
+//	method      the request of the entry, e.g. Put or LeaseGrant, the method of
+//	            Request entries, e.g. PUT, or the type of the configuration change,
+//	            EnterJoint or LeaveJoint for joint configuration changes
+//	key         the key of the request, the first key of transactions or the path of Request entries
+//	range-end   the range end of Range and DeleteRange requests
+//	value-size  the size of the value written by the request, summed over the puts of transactions
+//	lease       the lease attached by Put requests or granted, revoked or checkpointed
+//	ttl         the TTL of LeaseGrant requests
+//	revision    the revision of Compaction requests
+//	node-id     the member added, removed or updated by configuration changes,
+//	            space separated for joint configuration changes
+//	node-name   the names of the members of node-id, if known from the members
+//	            of the entry, with ? for the unknown ones
+//	request-id  the ID of the request
+//	size        the size of the entry data
+//	segment     the WAL file holding the entry
+//	offset      the byte offset of the entry record in the WAL file
+var Fields = []string{
+	"term", "index", "type", "method", "key", "range-end", "value-size",
+	"lease", "ttl", "revision", "node-id", "node-name", "request-id", "size", "segment",
+	"offset",
+}
+
+// DefaultFields are the fields exported when no fields are configured.
+const DefaultFields = "term,index,type,method,key,value-size,lease,size"
+
+// ParseFields parses a comma separated list of field names.
+func ParseFields(s string) ([]string, error) {
+	valid := make(map[string]bool, len(Fields))
+	for _, f := range Fields {
+		valid[f] = true
+	}
+	fields := strings.Split(s, ",")
+	for _, f := range fields {
+		if !valid[f] {
+			return nil, fmt.Errorf("invalid field %q, must be one of %s", f, strings.Join(Fields, ","))
+		}
+	}
+	return fields, nil
+}
+
+// RequestName returns the name of the request set in the internal raft
+// request, e.g. Put or AuthUserAdd, or an empty string if none is set.
+func RequestName(rr *etcdserverpb.InternalRaftRequest) string {
+	v := reflect.ValueOf(rr).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Pointer && !f.IsNil() && v.Type().Field(i).Name != "Header" {
+			return v.Type().Field(i).Name
+		}
+	}
+	return ""
+}
+
+// entryFields are the values of the fields of an entry. The fields that do
+// not apply to the entry are left empty.
+type entryFields map[string]string
+
+func (f entryFields) setInt(name string, v int64) {
+	if v != 0 {
+		f[name] = strconv.FormatInt(v, 10)
+	}
+}
+
+// FieldValues returns the values of the fields of the entry, in the order of
+// the fields. The values of the fields that do not apply to the entry, like
+// the key of a configuration change, are empty.
+func FieldValues(e Entry, fields []string) []string {
+	f := describeFields(e)
+	values := make([]string, len(fields))
+	for i, name := range fields {
+		values[i] = f[name]
+	}
+	return values
+}
+
+func describeFields(e Entry) entryFields {
+	f := entryFields{
+		"term":  strconv.FormatUint(e.Term, 10),
+		"index": strconv.FormatUint(e.Index, 10),
+		"type":  e.Type,
+		"size":  strconv.Itoa(len(e.Data)),
+	}
+	if e.Segment != "" {
+		f["segment"], f["offset"] = e.Segment, strconv.FormatInt(e.Offset, 10)
+	}
+	switch {
+	case e.ConfChange != nil:
+		f["method"] = e.ConfChange.Type.String()
+		f["node-id"] = types.ID(e.ConfChange.NodeID).String()
+		f["node-name"] = e.Members[e.ConfChange.NodeID].Name
+	case e.ConfChangeV2 != nil:
+		f["method"], _ = confChangeV2Method(*e.ConfChangeV2)
+		ids := make([]string, len(e.ConfChangeV2.Changes))
+		names := make([]string, len(e.ConfChangeV2.Changes))
+		named := false
+		for i, c := range e.ConfChangeV2.Changes {
+			ids[i] = types.ID(c.NodeID).String()
+			if names[i] = e.Members[c.NodeID].Name; names[i] != "" {
+				named = true
+			} else {
+				names[i] = "?"
+			}
+		}
+		f["node-id"] = strings.Join(ids, " ")
+		if named {
+			f["node-name"] = strings.Join(names, " ")
+		}
+	case e.Request != nil:
+		describeRequest(f, e.Request)
+	case e.InternalRaftRequest != nil:
+		describeInternalRaftRequest(f, e.InternalRaftRequest)
+	}
+	return f
+}
+
+func describeRequest(f entryFields, r *etcdserverpb.Request) {
+	f["request-id"] = strconv.FormatUint(r.ID, 10)
+	switch r.Method {
+	case "":
+		f["method"] = "noop"
+	case methodSync:
+		f["method"] = r.Method
+	case methodQGet, methodDelete:
+		f["method"], f["key"] = r.Method, r.Path
+	default:
+		f["method"], f["key"] = r.Method, r.Path
+		f["value-size"] = strconv.Itoa(len(r.Val))
+	}
+}
+
+func describeInternalRaftRequest(f entryFields, rr *etcdserverpb.InternalRaftRequest) {
+	f["method"] = RequestName(rr)
+	if rr.Header != nil {
+		f["request-id"] = strconv.FormatUint(rr.Header.ID, 10)
+	} else if rr.ID != 0 {
+		f["request-id"] = strconv.FormatUint(rr.ID, 10)
+	}
+	switch {
+	case rr.Range != nil:
+		f["key"], f["range-end"] = string(rr.Range.Key), string(rr.Range.RangeEnd)
+	case rr.Put != nil:
+		f["key"] = string(rr.Put.Key)
+		if !rr.Put.IgnoreValue {
+			f["value-size"] = strconv.Itoa(len(rr.Put.Value))
+		}
+		f.setInt("lease", rr.Put.Lease)
+	case rr.DeleteRange != nil:
+		f["key"], f["range-end"] = string(rr.DeleteRange.Key), string(rr.DeleteRange.RangeEnd)
+	case rr.Txn != nil:
+		f["key"] = txnKey(rr.Txn)
+		f["value-size"] = strconv.Itoa(txnValueSize(rr.Txn))
+	case rr.Compaction != nil:
+		f.setInt("revision", rr.Compaction.Revision)
+	case rr.LeaseGrant != nil:
+		f.setInt("lease", rr.LeaseGrant.ID)
+		f.setInt("ttl", rr.LeaseGrant.TTL)
+	case rr.LeaseRevoke != nil:
+		f.setInt("lease", rr.LeaseRevoke.ID)
+	case rr.LeaseCheckpoint != nil && len(rr.LeaseCheckpoint.Checkpoints) == 1:
+		f.setInt("lease", rr.LeaseCheckpoint.Checkpoints[0].ID)
+	}
+}
+
+// txnValueSize returns the size of the values written by the puts of the
+// transaction and of its nested transactions.
+func txnValueSize(txn *etcdserverpb.TxnRequest) (size int) {
+	for _, ops := range [][]*etcdserverpb.RequestOp{txn.Success, txn.Failure} {
+		for _, op := range ops {
+			switch {
+			case op.GetRequestPut() != nil:
+				size += len(op.GetRequestPut().Value)
+			case op.GetRequestTxn() != nil:
+				size += txnValueSize(op.GetRequestTxn())
+			}
+		}
+	}
+	return size
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestFieldValues(t *testing.T) {
+	fields := []string{"term", "index", "type", "method", "key", "range-end", "value-size", "lease", "ttl", "node-id", "request-id"}
+	txn := &etcdserverpb.TxnRequest{
+		Compare: []*etcdserverpb.Compare{{Key: []byte("a")}},
+		Success: []*etcdserverpb.RequestOp{
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("b"), Value: []byte("12")}}},
+			{Request: &etcdserverpb.RequestOp_RequestTxn{RequestTxn: &etcdserverpb.TxnRequest{
+				Success: []*etcdserverpb.RequestOp{{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("c"), Value: []byte("345")}}}},
+			}}},
+		},
+	}
+	tcs := []struct {
+		name  string
+		entry raftpb.Entry
+		want  []string
+	}{
+		{
+			name:  "put",
+			entry: raftpb.Entry{Term: 2, Index: 3, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Header: &etcdserverpb.RequestHeader{ID: 7}, Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar"), Lease: 5}})},
+			want:  []string{"2", "3", "InternalRaftRequest", "Put", "foo", "", "3", "5", "", "", "7"},
+		},
+		{
+			name:  "delete range",
+			entry: raftpb.Entry{Term: 2, Index: 4, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 8, DeleteRange: &etcdserverpb.DeleteRangeRequest{Key: []byte("a"), RangeEnd: []byte("b")}})},
+			want:  []string{"2", "4", "InternalRaftRequest", "DeleteRange", "a", "b", "", "", "", "", "8"},
+		},
+		{
+			name:  "txn",
+			entry: raftpb.Entry{Term: 2, Index: 5, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Txn: txn})},
+			want:  []string{"2", "5", "InternalRaftRequest", "Txn", "a", "", "5", "", "", "", ""},
+		},
+		{
+			name:  "lease grant",
+			entry: raftpb.Entry{Term: 2, Index: 6, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{LeaseGrant: &etcdserverpb.LeaseGrantRequest{ID: 9, TTL: 60}})},
+			want:  []string{"2", "6", "InternalRaftRequest", "LeaseGrant", "", "", "", "9", "60", "", ""},
+		},
+		{
+			name:  "request",
+			entry: raftpb.Entry{Term: 2, Index: 7, Data: pbutil.MustMarshal(&etcdserverpb.Request{ID: 10, Method: "PUT", Path: "/foo", Val: "bar"})},
+			want:  []string{"2", "7", "Request", "PUT", "/foo", "", "3", "", "", "", "10"},
+		},
+		{
+			name:  "conf change",
+			entry: raftpb.Entry{Term: 2, Index: 8, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 0x1f})},
+			want:  []string{"2", "8", "ConfigChange", "ConfChangeAddNode", "", "", "", "", "", "1f", ""},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, FieldValues(DecodeEntry(tc.entry, ""), fields))
+		})
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	fields, err := ParseFields(DefaultFields)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"term", "index", "type", "method", "key", "value-size", "lease", "size"}, fields)
+
+	_, err = ParseFields("term,value")
+	require.ErrorContains(t, err, `invalid field "value"`)
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// EntryFilter returns whether the entry passes the filter and the type of the
+// entry, which is used to choose how the entry is decoded and printed.
+type EntryFilter func(e raftpb.Entry) (bool, string)
+
+// The 9 pass functions below takes the raftpb.Entry and return if the entry should be printed and the type of entry,
+// the type of the entry will used in the following print function
+func passConfChange(entry raftpb.Entry) (bool, string) {
+	return entry.Type == raftpb.EntryConfChange, "ConfigChange"
+}
+
+func passConfChangeV2(entry raftpb.Entry) (bool, string) {
+	return entry.Type == raftpb.EntryConfChangeV2, "ConfigChangeV2"
+}
+
+func passInternalRaftRequest(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil, "InternalRaftRequest"
+}
+
+func passUnknownNormal(entry raftpb.Entry) (bool, string) {
+	var rr1 etcdserverpb.Request
+	var rr2 etcdserverpb.InternalRaftRequest
+	return (entry.Type == raftpb.EntryNormal) && (rr1.Unmarshal(entry.Data) != nil) && (rr2.Unmarshal(entry.Data) != nil), "UnknownNormal"
+}
+
+func passIRRRange(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Range != nil, "InternalRaftRequest"
+}
+
+func passIRRPut(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Put != nil, "InternalRaftRequest"
+}
+
+func passIRRDeleteRange(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.DeleteRange != nil, "InternalRaftRequest"
+}
+
+func passIRRTxn(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Txn != nil, "InternalRaftRequest"
+}
+
+func passIRRCompaction(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Compaction != nil, "InternalRaftRequest"
+}
+
+func passIRRLeaseGrant(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.LeaseGrant != nil, "InternalRaftRequest"
+}
+
+func passIRRLeaseRevoke(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.LeaseRevoke != nil, "InternalRaftRequest"
+}
+
+func passIRRLeaseCheckpoint(entry raftpb.Entry) (bool, string) {
+	var rr etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.LeaseCheckpoint != nil, "InternalRaftRequest"
+}
+
+func passRequest(entry raftpb.Entry) (bool, string) {
+	var rr1 etcdserverpb.Request
+	var rr2 etcdserverpb.InternalRaftRequest
+	return entry.Type == raftpb.EntryNormal && rr1.Unmarshal(entry.Data) == nil && rr2.Unmarshal(entry.Data) != nil, "Request"
+}
+
+// EntryFilters evaluates a comma separated list of entry types, as accepted by
+// the entry-type flag, and chooses the proper filters to filter entries. The
+// entry types that are not valid are ignored and returned as invalid.
+func EntryFilters(entrytype string) (filters []EntryFilter, invalid []string) {
+	var entrytypelist []string
+	if entrytype != "" {
+		entrytypelist = strings.Split(entrytype, ",")
+	}
+
+	validRequest := map[string][]EntryFilter{
+		"ConfigChange":        {passConfChange, passConfChangeV2},
+		"ConfigChangeV2":      {passConfChangeV2},
+		"Normal":              {passInternalRaftRequest, passRequest, passUnknownNormal},
+		"Request":             {passRequest},
+		"InternalRaftRequest": {passInternalRaftRequest},
+		"IRRRange":            {passIRRRange},
+		"IRRPut":              {passIRRPut},
+		"IRRDeleteRange":      {passIRRDeleteRange},
+		"IRRTxn":              {passIRRTxn},
+		"IRRCompaction":       {passIRRCompaction},
+		"IRRLeaseGrant":       {passIRRLeaseGrant},
+		"IRRLeaseRevoke":      {passIRRLeaseRevoke},
+		"IRRLeaseCheckpoint":  {passIRRLeaseCheckpoint},
+	}
+	for _, name := range authRequests {
+		f := passIRRAuth(name)
+		validRequest["IRR"+name] = []EntryFilter{f}
+		validRequest["IRRAuth"] = append(validRequest["IRRAuth"], f)
+	}
+	filters = make([]EntryFilter, 0)
+	for _, et := range entrytypelist {
+		if f, ok := validRequest[et]; ok {
+			filters = append(filters, f...)
+		} else {
+			invalid = append(invalid, et)
+		}
+	}
+
+	return filters, invalid
+}
+
+// PassEntryFilters returns whether the entry passes any of the filters and the type of the entry.
+func PassEntryFilters(filters []EntryFilter, e raftpb.Entry) (bool, string) {
+	for _, filter := range filters {
+		if passed, currtype := filter(e); passed {
+			return true, currtype
+		}
+	}
+	return false, ""
+}
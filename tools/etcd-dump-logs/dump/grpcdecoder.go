@@ -0,0 +1,108 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The gRPC decoder service is defined as:
+//
+//	syntax = "proto3";
+//	package etcddumplogs;
+//	import "google/protobuf/wrappers.proto";
+//
+//	service Decoder {
+//	  rpc Decode(google.protobuf.BytesValue) returns (google.protobuf.BytesValue);
+//	}
+//
+// The request carries the data of an entry, and the response its decoded data.
+const (
+	decoderServiceName = "etcddumplogs.Decoder"
+	decodeMethod       = "/" + decoderServiceName + "/Decode"
+
+	decodeTimeout = 10 * time.Second
+)
+
+// GRPCDecoder decodes the entries with a gRPC decoder service, over a single
+// connection kept for the whole dump.
+type GRPCDecoder struct {
+	conn *grpc.ClientConn
+}
+
+// DialGRPCDecoder connects to the decoder service serving the given target,
+// e.g. localhost:2390 or unix:///run/decoder.sock, without TLS.
+func DialGRPCDecoder(target string) (*GRPCDecoder, error) {
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &GRPCDecoder{conn: conn}, nil
+}
+
+// Decode returns the message of the status of the service errors.
+func (d *GRPCDecoder) Decode(data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), decodeTimeout)
+	defer cancel()
+	var out wrapperspb.BytesValue
+	if err := d.conn.Invoke(ctx, decodeMethod, wrapperspb.Bytes(data), &out); err != nil {
+		return nil, errors.New(status.Convert(err).Message())
+	}
+	return out.Value, nil
+}
+
+func (d *GRPCDecoder) Close() error {
+	return d.conn.Close()
+}
+
+// RegisterDecoderServer registers d as the decoder service of s. The errors
+// returned by d are reported with the InvalidArgument code.
+func RegisterDecoderServer(s *grpc.Server, d Decoder) {
+	s.RegisterService(&decoderServiceDesc, d)
+}
+
+var decoderServiceDesc = grpc.ServiceDesc{
+	ServiceName: decoderServiceName,
+	HandlerType: (*Decoder)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Decode", Handler: decodeHandler},
+	},
+}
+
+func decodeHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(wrapperspb.BytesValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(_ context.Context, req any) (any, error) {
+		out, err := srv.(Decoder).Decode(req.(*wrapperspb.BytesValue).Value)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return wrapperspb.Bytes(out), nil
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: decodeMethod}, handler)
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"errors"
+	"io"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// HardStateRecord is a HardState record of the WAL.
+type HardStateRecord struct {
+	raftpb.HardState
+	// Segment is the name of the WAL file holding the record, and Offset the
+	// byte offset of the record in the file.
+	Segment string
+	Offset  int64
+}
+
+// HardStates calls fn for each HardState record of the WAL files selected by
+// the reader, in the order they were written, until fn returns an error.
+// Unlike Scan, which only keeps the last one, it returns all the records,
+// including those written before the start snapshot in the first file. The
+// records with a term outside of the range set by SetTermRange are skipped.
+func (r *Reader) HardStates(fn func(HardStateRecord) error) error {
+	d, err := r.openRecords(false, r.names...)
+	if err != nil {
+		return err
+	}
+	defer d.close()
+
+	var rec walpb.Record
+	for {
+		if err := d.next(&rec); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if rec.Type != wal.StateType {
+			continue
+		}
+		state := wal.MustUnmarshalState(rec.Data)
+		if state.Term < r.startTerm || state.Term >= r.endTerm {
+			continue
+		}
+		if err := fn(HardStateRecord{HardState: state, Segment: d.locator.Segment(), Offset: d.locator.Offset()}); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestReaderHardStates(t *testing.T) {
+	dir := t.TempDir()
+	defer func(size int64) { wal.SegmentSizeBytes = size }(wal.SegmentSizeBytes)
+	wal.SegmentSizeBytes = 1024
+
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	var want []raftpb.HardState
+	for i := uint64(1); i <= 20; i++ {
+		st := raftpb.HardState{Term: 1 + i/10, Vote: 1 + i/10, Commit: i}
+		require.NoError(t, w.Save(st, []raftpb.Entry{{Term: st.Term, Index: i, Data: make([]byte, 100)}}))
+		want = append(want, st)
+	}
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+	require.NoError(t, err)
+	require.Greater(t, len(r.names), 2)
+
+	var (
+		got      []raftpb.HardState
+		segments = map[string]bool{}
+	)
+	require.NoError(t, r.HardStates(func(rec HardStateRecord) error {
+		got = append(got, rec.HardState)
+		segments[rec.Segment] = true
+		assert.Positive(t, rec.Offset)
+		return nil
+	}))
+	// the last HardState is saved again at the start of each new segment
+	assert.Len(t, got, len(want)+len(r.names)-1)
+	assert.Equal(t, want, slices.Compact(got))
+	assert.Len(t, segments, len(r.names))
+
+	// the term range is applied to the records
+	r.SetTermRange(2, 3)
+	got = nil
+	require.NoError(t, r.HardStates(func(rec HardStateRecord) error {
+		got = append(got, rec.HardState)
+		return nil
+	}))
+	assert.Equal(t, want[9:19], slices.Compact(got))
+}
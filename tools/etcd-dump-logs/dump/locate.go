@@ -0,0 +1,92 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+)
+
+// RecordLocator finds the WAL segment file and the byte offset of the records
+// decoded by a wal.Decoder reading several segments. The decoder only reports
+// the offset following the last record in the current segment, so the
+// locator detects when the decoder moves to the next segment by the offset
+// going back to the start of a file.
+type RecordLocator struct {
+	names []string
+	// hasRecords[i] is set if the segment i holds at least one record. The
+	// decoder skips the other segments.
+	hasRecords []bool
+
+	file   int
+	offset int64
+	end    int64
+}
+
+// NewRecordLocator returns a locator for a decoder reading the given WAL
+// files, in order.
+func NewRecordLocator(files []*os.File) *RecordLocator {
+	l := &RecordLocator{file: -1}
+	for _, f := range files {
+		var frame [8]byte
+		_, err := f.ReadAt(frame[:], 0)
+		l.names = append(l.names, filepath.Base(f.Name()))
+		l.hasRecords = append(l.hasRecords, err == nil && binary.LittleEndian.Uint64(frame[:]) != 0)
+	}
+	return l
+}
+
+// Locate returns the segment and the offset of rec, which must be the record
+// the decoder has just decoded. It must be called after each record decoded
+// by the decoder, including the records failing their CRC check.
+func (l *RecordLocator) Locate(d wal.Decoder, rec *walpb.Record) (segment string, offset int64) {
+	end := d.LastOffset()
+	start := end - recordFrameSize(rec)
+	if l.file < 0 || start < l.end {
+		l.file++
+		for l.file < len(l.names)-1 && !l.hasRecords[l.file] {
+			l.file++
+		}
+	}
+	l.offset, l.end = start, end
+	return l.Segment(), l.offset
+}
+
+// set locates the last record at the given offset of the file i.
+func (l *RecordLocator) set(i int, offset int64) {
+	l.file, l.offset = i, offset
+}
+
+// Segment returns the segment of the last located record.
+func (l *RecordLocator) Segment() string {
+	if l.file < 0 || l.file >= len(l.names) {
+		return ""
+	}
+	return l.names[l.file]
+}
+
+// Offset returns the offset of the last located record in its segment.
+func (l *RecordLocator) Offset() int64 { return l.offset }
+
+// recordFrameSize returns the size of the frame of the record in the WAL:
+// the length field, the record and its padding to 8 bytes.
+func recordFrameSize(rec *walpb.Record) int64 {
+	size := int64(rec.Size())
+	return 8 + size + (8-size%8)%8
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+)
+
+type location struct {
+	segment string
+	offset  int64
+}
+
+// entryLocations returns the locations of the entry records of the segments,
+// found by decoding each segment on its own.
+func entryLocations(t *testing.T, dir string, names []string) []location {
+	var locs []location
+	for _, name := range names {
+		offsets, _ := recordOffsets(t, filepath.Join(dir, name))
+		for _, off := range offsets {
+			locs = append(locs, location{name, off})
+		}
+	}
+	return locs
+}
+
+func TestRecordLocator(t *testing.T) {
+	dir := t.TempDir()
+	names := createSegmentedWAL(t, dir)
+	want := entryLocations(t, dir, names)
+
+	// the decoder skips the segments without records
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "empty.wal"), make([]byte, 64), 0o600))
+	paths := append([]string{names[0], "empty.wal"}, names[1:]...)
+
+	var (
+		files   []*os.File
+		readers []fileutil.FileReader
+	)
+	for _, name := range paths {
+		f, err := os.Open(filepath.Join(dir, name))
+		require.NoError(t, err)
+		defer f.Close()
+		files = append(files, f)
+		readers = append(readers, fileutil.NewFileReader(f))
+	}
+	decoder := wal.NewDecoder(readers...)
+	locator := NewRecordLocator(files)
+
+	var (
+		got []location
+		rec walpb.Record
+	)
+	for decoder.Decode(&rec) == nil {
+		segment, offset := locator.Locate(decoder, &rec)
+		if rec.Type == wal.CrcType {
+			decoder.UpdateCRC(rec.Crc)
+		}
+		if rec.Type == wal.EntryType {
+			got = append(got, location{segment, offset})
+		}
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestIteratorEntryLocations(t *testing.T) {
+	dir := t.TempDir()
+	names := createSegmentedWAL(t, dir)
+	want := entryLocations(t, dir, names)
+
+	r, err := NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, r.Scan())
+	it, err := r.Entries()
+	require.NoError(t, err)
+	defer it.Close()
+	var got []location
+	for it.Next() {
+		got = append(got, location{it.Entry().Segment, it.Entry().Offset})
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, want, got)
+
+	rit := r.ReverseEntries()
+	defer rit.Close()
+	got = nil
+	for rit.Next() {
+		got = append([]location{{rit.Entry().Segment, rit.Entry().Offset}}, got...)
+	}
+	require.NoError(t, rit.Err())
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+)
+
+// membersDBTimeout is how long LoadMembers waits for the lock of a database
+// held by a running member.
+const membersDBTimeout = time.Second
+
+// Member is the registration of a cluster member.
+type Member struct {
+	Name     string
+	PeerURLs []string
+	// Learner is set for the members registered as learners.
+	Learner bool
+	// Removed is set for the members removed from the cluster, whose names
+	// and peer URLs are no longer known.
+	Removed bool
+}
+
+// Members are the cluster members, by ID.
+type Members map[uint64]Member
+
+// LoadMembers reads the members and the removed members buckets of the
+// backend database of a member, e.g. member/snap/db of its data directory.
+// The database is opened read only, so it cannot be read while the member is
+// running.
+func LoadMembers(dbPath string) (Members, error) {
+	db, err := bolt.Open(dbPath, 0o400, &bolt.Options{ReadOnly: true, Timeout: membersDBTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", dbPath, err)
+	}
+	defer db.Close()
+
+	members := make(Members)
+	err = db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(schema.Members.Name()); b != nil {
+			if err := b.ForEach(func(_, v []byte) error {
+				var m membership.Member
+				if err := json.Unmarshal(v, &m); err != nil {
+					return fmt.Errorf("failed to decode member: %w", err)
+				}
+				members[uint64(m.ID)] = Member{Name: m.Name, PeerURLs: m.PeerURLs, Learner: m.IsLearner}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		if b := tx.Bucket(schema.MembersRemoved.Name()); b != nil {
+			return b.ForEach(func(k, _ []byte) error {
+				id, err := types.IDFromString(string(k))
+				if err != nil {
+					return fmt.Errorf("failed to decode removed member ID: %w", err)
+				}
+				members[uint64(id)] = Member{Removed: true}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// Describe describes the member of the given ID, or returns an empty string
+// if it is unknown.
+func (ms Members) Describe(id uint64) string {
+	m, ok := ms[id]
+	switch {
+	case !ok:
+		return ""
+	case m.Removed:
+		return "removed=true"
+	}
+	return fmt.Sprintf("name=%q peer-urls=%s", m.Name, strings.Join(m.PeerURLs, ","))
+}
+
+// PrintMembers prints the members sorted by ID, one per line.
+func PrintMembers(w io.Writer, ms Members) {
+	ids := make([]uint64, 0, len(ms))
+	for id := range ms {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		fmt.Fprintf(w, "%s\t%s", types.ID(id), ms.Describe(id))
+		if ms[id].Learner {
+			fmt.Fprint(w, " learner=true")
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// printConfChangeMembers appends the description of the known members the
+// configuration change entry applies to.
+func printConfChangeMembers(w io.Writer, e Entry) {
+	var ids []uint64
+	switch {
+	case e.ConfChange != nil:
+		ids = []uint64{e.ConfChange.NodeID}
+	case e.ConfChangeV2 != nil:
+		for _, c := range e.ConfChangeV2.Changes {
+			ids = append(ids, c.NodeID)
+		}
+	}
+	if len(ids) == 1 {
+		// the ID is already printed with the change
+		if d := e.Members.Describe(ids[0]); d != "" {
+			fmt.Fprintf(w, " %s", d)
+		}
+		return
+	}
+	var descs []string
+	for _, id := range ids {
+		if d := e.Members.Describe(id); d != "" {
+			descs = append(descs, fmt.Sprintf("%s %s", types.ID(id), d))
+		}
+	}
+	if len(descs) > 0 {
+		fmt.Fprintf(w, " members=[%s]", strings.Join(descs, ", "))
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/storage/schema"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func mustCreateMembersDB(t *testing.T, members []*membership.Member, removed []types.ID) string {
+	p := filepath.Join(t.TempDir(), "db")
+	db, err := bolt.Open(p, 0o600, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket(schema.Members.Name())
+		require.NoError(t, err)
+		for _, m := range members {
+			v, err := json.Marshal(m)
+			require.NoError(t, err)
+			require.NoError(t, b.Put([]byte(m.ID.String()), v))
+		}
+		rb, err := tx.CreateBucket(schema.MembersRemoved.Name())
+		require.NoError(t, err)
+		for _, id := range removed {
+			require.NoError(t, rb.Put([]byte(id.String()), []byte("removed")))
+		}
+		return nil
+	}))
+	return p
+}
+
+func TestLoadMembers(t *testing.T) {
+	p := mustCreateMembersDB(t, []*membership.Member{
+		{ID: 1, RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"http://10.0.0.1:2380"}}, Attributes: membership.Attributes{Name: "infra1"}},
+		{ID: 3, RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"http://10.0.0.3:2380", "http://10.0.1.3:2380"}, IsLearner: true}, Attributes: membership.Attributes{Name: "infra3"}},
+	}, []types.ID{2})
+
+	members, err := LoadMembers(p)
+	require.NoError(t, err)
+	assert.Equal(t, Members{
+		1: {Name: "infra1", PeerURLs: []string{"http://10.0.0.1:2380"}},
+		2: {Removed: true},
+		3: {Name: "infra3", PeerURLs: []string{"http://10.0.0.3:2380", "http://10.0.1.3:2380"}, Learner: true},
+	}, members)
+
+	var buf bytes.Buffer
+	PrintMembers(&buf, members)
+	assert.Equal(t, "1\tname=\"infra1\" peer-urls=http://10.0.0.1:2380\n"+
+		"2\tremoved=true\n"+
+		"3\tname=\"infra3\" peer-urls=http://10.0.0.3:2380,http://10.0.1.3:2380 learner=true\n", buf.String())
+
+	_, err = LoadMembers(filepath.Join(t.TempDir(), "db"))
+	require.Error(t, err)
+}
+
+func TestPrintEntryMembers(t *testing.T) {
+	members := Members{
+		1: {Name: "infra1", PeerURLs: []string{"http://10.0.0.1:2380"}},
+		2: {Removed: true},
+	}
+	joint := &raftpb.ConfChangeV2{Changes: []raftpb.ConfChangeSingle{
+		{Type: raftpb.ConfChangeAddNode, NodeID: 1},
+		{Type: raftpb.ConfChangeRemoveNode, NodeID: 2},
+		{Type: raftpb.ConfChangeAddNode, NodeID: 4},
+	}}
+	tcs := []struct {
+		name     string
+		entry    raftpb.Entry
+		want     string
+		wantName string
+	}{
+		{
+			name:     "conf change",
+			entry:    raftpb.Entry{Term: 1, Index: 2, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 1})},
+			want:     "   1\t         2\tconf\tmethod=ConfChangeAddNode id=1 name=\"infra1\" peer-urls=http://10.0.0.1:2380",
+			wantName: "infra1",
+		},
+		{
+			name:  "removed member",
+			entry: raftpb.Entry{Term: 1, Index: 3, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 2})},
+			want:  "   1\t         3\tconf\tmethod=ConfChangeRemoveNode id=2 removed=true",
+		},
+		{
+			name:  "unknown member",
+			entry: raftpb.Entry{Term: 1, Index: 4, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 4})},
+			want:  "   1\t         4\tconf\tmethod=ConfChangeAddNode id=4",
+		},
+		{
+			name:     "joint conf change",
+			entry:    raftpb.Entry{Term: 1, Index: 5, Type: raftpb.EntryConfChangeV2, Data: pbutil.MustMarshal(joint)},
+			want:     "   1\t         5\tconf\tv2 method=EnterJoint auto-leave=true changes=[ConfChangeAddNode id=1, ConfChangeRemoveNode id=2, ConfChangeAddNode id=4] members=[1 name=\"infra1\" peer-urls=http://10.0.0.1:2380, 2 removed=true]",
+			wantName: "infra1 ? ?",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			e := DecodeEntry(tc.entry, "")
+			e.Members = members
+			var buf bytes.Buffer
+			PrintEntry(&buf, e)
+			assert.Equal(t, tc.want, buf.String())
+			assert.Equal(t, []string{tc.wantName}, FieldValues(e, []string{"node-name"}))
+		})
+	}
+}
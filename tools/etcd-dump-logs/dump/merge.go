@@ -0,0 +1,131 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// MergeStatus is how the WALs merged by Merge agree on an index.
+type MergeStatus int
+
+const (
+	// Agreed is reported for the indexes all the WALs hold at the same term
+	// with the same payload.
+	Agreed MergeStatus = iota
+	// Missing is reported for the indexes some of the WALs do not hold, the
+	// others holding the same entry.
+	Missing
+	// TermDivergence is reported for the indexes the WALs hold at different
+	// terms.
+	TermDivergence
+	// PayloadDivergence is reported for the indexes the WALs hold at the same
+	// term, with a different type or data.
+	PayloadDivergence
+)
+
+func (s MergeStatus) String() string {
+	switch s {
+	case Agreed:
+		return "agreed"
+	case Missing:
+		return "missing"
+	case TermDivergence:
+		return "term divergence"
+	case PayloadDivergence:
+		return "payload divergence"
+	}
+	return fmt.Sprintf("MergeStatus(%d)", int(s))
+}
+
+// MergedIndex is the entries the merged WALs hold at an index.
+type MergedIndex struct {
+	Index uint64
+	// Entries holds the entry of each WAL, in the order of the iterators
+	// given to Merge, or nil if the WAL does not hold the index.
+	Entries []*Entry
+	Status  MergeStatus
+}
+
+// mergedStatus compares the entries held at an index.
+func mergedStatus(entries []*Entry) MergeStatus {
+	var (
+		first   *Entry
+		missing bool
+		status  = Agreed
+	)
+	for _, e := range entries {
+		switch {
+		case e == nil:
+			missing = true
+		case first == nil:
+			first = e
+		case e.Term != first.Term:
+			return TermDivergence
+		case e.Entry.Type != first.Entry.Type || !bytes.Equal(e.Data, first.Data):
+			status = PayloadDivergence
+		}
+	}
+	if status == Agreed && missing {
+		return Missing
+	}
+	return status
+}
+
+// Merge aligns the entries returned by the iterators by index and calls fn
+// with the entries of each index held by at least one of the WALs, in
+// increasing index order. Like Diff, it consumes the iterators one entry at a
+// time, so WALs larger than the available memory can be merged. It stops at
+// the first error returned by fn.
+func Merge(its []*Iterator, fn func(MergedIndex) error) error {
+	ok := make([]bool, len(its))
+	for i, it := range its {
+		ok[i] = it.Next()
+	}
+	for {
+		var (
+			index uint64
+			found bool
+		)
+		for i, it := range its {
+			if ok[i] && (!found || it.Entry().Index < index) {
+				index, found = it.Entry().Index, true
+			}
+		}
+		if !found {
+			break
+		}
+
+		m := MergedIndex{Index: index, Entries: make([]*Entry, len(its))}
+		for i, it := range its {
+			if ok[i] && it.Entry().Index == index {
+				e := it.Entry()
+				m.Entries[i] = &e
+				ok[i] = it.Next()
+			}
+		}
+		m.Status = mergedStatus(m.Entries)
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+	for i, it := range its {
+		if err := it.Err(); err != nil {
+			return fmt.Errorf("failed reading WAL %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestMerge(t *testing.T) {
+	a := []raftpb.Entry{
+		putEntry(1, 1, "a"), putEntry(1, 2, "b"), putEntry(1, 3, "c"),
+		putEntry(2, 4, "d"), putEntry(2, 5, "e"),
+	}
+	b := []raftpb.Entry{
+		putEntry(1, 1, "a"), putEntry(1, 2, "x"), putEntry(1, 3, "c"),
+		putEntry(2, 4, "d"),
+	}
+	c := []raftpb.Entry{
+		putEntry(1, 1, "a"), putEntry(1, 2, "b"), putEntry(1, 3, "c"),
+		putEntry(3, 4, "d"), putEntry(3, 5, "e"), putEntry(3, 6, "f"),
+	}
+
+	var merged []MergedIndex
+	err := Merge([]*Iterator{walEntries(t, a), walEntries(t, b), walEntries(t, c)}, func(m MergedIndex) error {
+		merged = append(merged, m)
+		return nil
+	})
+	require.NoError(t, err)
+
+	expected := []struct {
+		status MergeStatus
+		held   []bool
+	}{
+		{Agreed, []bool{true, true, true}},
+		{PayloadDivergence, []bool{true, true, true}},
+		{Agreed, []bool{true, true, true}},
+		{TermDivergence, []bool{true, true, true}},
+		{TermDivergence, []bool{true, false, true}},
+		{Missing, []bool{false, false, true}},
+	}
+	require.Len(t, merged, len(expected))
+	for i, m := range merged {
+		assert.Equal(t, uint64(i+1), m.Index)
+		assert.Equal(t, expected[i].status, m.Status, "index %d", m.Index)
+		for j, e := range m.Entries {
+			assert.Equal(t, expected[i].held[j], e != nil, "index %d, WAL %d", m.Index, j+1)
+			if e != nil {
+				assert.Equal(t, m.Index, e.Index)
+			}
+		}
+	}
+}
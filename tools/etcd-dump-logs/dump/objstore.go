@@ -0,0 +1,344 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// objectStore reads the objects of a bucket.
+type objectStore interface {
+	// list returns the keys of the objects with the given prefix.
+	list(ctx context.Context, prefix string) ([]string, error)
+	// get returns the content of the object with the given key.
+	get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// newObjectStore returns the store of the bucket of an s3:// or gs:// URL,
+// configured from the environment.
+func newObjectStore(u *url.URL) (objectStore, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing bucket in %q", u)
+	}
+	switch u.Scheme {
+	case "s3":
+		return newS3Store(u.Host, os.Getenv)
+	case "gs":
+		return newGCSStore(u.Host, os.Getenv)
+	}
+	return nil, fmt.Errorf("unsupported object storage %q", u.Scheme)
+}
+
+// httpGet sends the GET request and returns the body of its response,
+// failing if it is not successful.
+func httpGet(client *http.Client, req *http.Request) (io.ReadCloser, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("GET %s: %s: %s", req.URL.Redacted(), resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return resp.Body, nil
+}
+
+// s3Store reads the objects of an S3 bucket. Requests are signed with AWS
+// Signature Version 4 if the credentials are set by the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables, and
+// anonymous otherwise. AWS_ENDPOINT_URL_S3 or AWS_ENDPOINT_URL set the
+// endpoint of S3 compatible storages, addressing the bucket in the path.
+type s3Store struct {
+	client *http.Client
+	// bucket is the URL of the bucket.
+	bucket       *url.URL
+	region       string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	now          func() time.Time
+}
+
+func newS3Store(bucket string, getenv func(string) string) (*s3Store, error) {
+	region := firstEnv(getenv, "AWS_REGION", "AWS_DEFAULT_REGION")
+	if region == "" {
+		region = "us-east-1"
+	}
+	bucketURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", bucket, region)
+	if endpoint := firstEnv(getenv, "AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"); endpoint != "" {
+		bucketURL = strings.TrimSuffix(endpoint, "/") + "/" + bucket + "/"
+	}
+	u, err := url.Parse(bucketURL)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Store{
+		client:       http.DefaultClient,
+		bucket:       u,
+		region:       region,
+		accessKey:    getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: getenv("AWS_SESSION_TOKEN"),
+		now:          time.Now,
+	}, nil
+}
+
+func firstEnv(getenv func(string) string, names ...string) string {
+	for _, name := range names {
+		if v := getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func (s *s3Store) get(ctx context.Context, key string) (io.ReadCloser, error) {
+	u := *s.bucket
+	u.Path += key
+	u.RawPath = s3Escape(u.Path, false)
+	return s.do(ctx, &u)
+}
+
+func (s *s3Store) list(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		q := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			q.Set("continuation-token", token)
+		}
+		u := *s.bucket
+		u.RawQuery = s3Query(q)
+		body, err := s.do(ctx, &u)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Contents []struct {
+				Key string
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		err = xml.NewDecoder(body).Decode(&result)
+		body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("s3: failed decoding object list: %w", err)
+		}
+		for _, c := range result.Contents {
+			keys = append(keys, c.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *s3Store) do(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.accessKey != "" {
+		s.sign(req, s.now())
+	}
+	return httpGet(s.client, req)
+}
+
+// emptySHA256 is the hex encoded SHA-256 of the empty payload of GET requests.
+const emptySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// sign signs the request and all its headers with AWS Signature Version 4.
+func (s *s3Store) sign(req *http.Request, t time.Time) {
+	amzDate := t.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptySHA256)
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, headers[name])
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		s3Query(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		emptySHA256,
+	}, "\n")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hexSHA256(canonicalRequest)
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	for _, v := range []string{s.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, v)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign))))
+}
+
+func hexSHA256(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3Escape percent-encodes all the bytes of s but the unreserved characters,
+// and the slashes unless escapeSlash is set, as required by the canonical
+// requests of AWS Signature Version 4.
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && !escapeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// s3Query returns the canonical query string of the values, sorted by name.
+func s3Query(q url.Values) string {
+	names := make([]string, 0, len(q))
+	for name := range q {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var params []string
+	for _, name := range names {
+		values := append([]string(nil), q[name]...)
+		sort.Strings(values)
+		for _, v := range values {
+			params = append(params, s3Escape(name, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// gcsStore reads the objects of a Google Cloud Storage bucket through the
+// JSON API. Requests are authorized with the OAuth 2.0 access token set by
+// the GOOGLE_OAUTH_ACCESS_TOKEN environment variable, e.g. from
+// "gcloud auth print-access-token", and anonymous otherwise.
+// STORAGE_EMULATOR_HOST sets the endpoint of an emulator.
+type gcsStore struct {
+	client   *http.Client
+	endpoint string
+	bucket   string
+	token    string
+}
+
+func newGCSStore(bucket string, getenv func(string) string) (*gcsStore, error) {
+	endpoint := "https://storage.googleapis.com"
+	if host := getenv("STORAGE_EMULATOR_HOST"); host != "" {
+		endpoint = strings.TrimSuffix(host, "/")
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "http://" + endpoint
+		}
+	}
+	return &gcsStore{
+		client:   http.DefaultClient,
+		endpoint: endpoint,
+		bucket:   bucket,
+		token:    getenv("GOOGLE_OAUTH_ACCESS_TOKEN"),
+	}, nil
+}
+
+func (s *gcsStore) get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.do(ctx, "/o/"+url.PathEscape(key), url.Values{"alt": {"media"}})
+}
+
+func (s *gcsStore) list(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		q := url.Values{"prefix": {prefix}, "fields": {"items(name),nextPageToken"}}
+		if token != "" {
+			q.Set("pageToken", token)
+		}
+		body, err := s.do(ctx, "/o", q)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		err = json.NewDecoder(body).Decode(&result)
+		body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("gcs: failed decoding object list: %w", err)
+		}
+		for _, item := range result.Items {
+			keys = append(keys, item.Name)
+		}
+		if result.NextPageToken == "" {
+			return keys, nil
+		}
+		token = result.NextPageToken
+	}
+}
+
+func (s *gcsStore) do(ctx context.Context, p string, q url.Values) (io.ReadCloser, error) {
+	u := s.endpoint + "/storage/v1/b/" + url.PathEscape(s.bucket) + p + "?" + q.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	return httpGet(s.client, req)
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import "sync"
+
+// pipelineBatchSize is the number of entries a worker of a Pipeline decodes
+// at once, amortizing the synchronization over the entries.
+const pipelineBatchSize = 256
+
+// Pipeline filters and decodes the entries of an Iterator on several
+// goroutines and maps them with a function, returning the results in the
+// order of the entries. The records are still read and validated on a single
+// goroutine, which is cheap compared to unmarshalling the requests and
+// printing them.
+type Pipeline[T any] struct {
+	it *Iterator
+	fn func(Entry) T
+
+	// batches are the batches in the order of the entries, once they are
+	// queued for the workers.
+	batches chan *pipelineBatch[T]
+	stopc   chan struct{}
+	donec   chan struct{}
+	wg      sync.WaitGroup
+
+	cur   *pipelineBatch[T]
+	i     int
+	value T
+	done  bool
+}
+
+type pipelineBatch[T any] struct {
+	candidates []candidate
+	results    []T
+	// decoded is closed once the results are set.
+	decoded chan struct{}
+}
+
+// NewPipeline returns a pipeline mapping the entries returned by it with fn
+// on parallelism goroutines. If parallelism is one or less, the entries are
+// mapped on the goroutine calling Next, without any goroutine. fn must be safe
+// for concurrent use. The pipeline takes ownership of it, which is closed by
+// Close.
+func NewPipeline[T any](it *Iterator, parallelism int, fn func(Entry) T) *Pipeline[T] {
+	p := &Pipeline[T]{it: it, fn: fn}
+	if parallelism <= 1 {
+		return p
+	}
+	p.batches = make(chan *pipelineBatch[T], 2*parallelism)
+	p.stopc = make(chan struct{})
+	p.donec = make(chan struct{})
+	work := make(chan *pipelineBatch[T], parallelism)
+	p.wg.Add(parallelism)
+	for i := 0; i < parallelism; i++ {
+		go func() {
+			defer p.wg.Done()
+			for b := range work {
+				for _, c := range b.candidates {
+					if e, ok := it.decode(c); ok {
+						b.results = append(b.results, fn(e))
+					}
+				}
+				close(b.decoded)
+			}
+		}()
+	}
+	go p.read(work)
+	return p
+}
+
+// read reads the candidate entries of the iterator and queues them in
+// batches, until the end of the WAL or until the pipeline is closed.
+func (p *Pipeline[T]) read(work chan *pipelineBatch[T]) {
+	defer close(p.donec)
+	defer close(p.batches)
+	defer close(work)
+	for {
+		b := &pipelineBatch[T]{decoded: make(chan struct{})}
+		for len(b.candidates) < pipelineBatchSize {
+			c, ok := p.it.next()
+			if !ok {
+				break
+			}
+			b.candidates = append(b.candidates, c)
+		}
+		if len(b.candidates) == 0 {
+			return
+		}
+		// queue the batch in order before handing it to a worker, so that
+		// Next never waits for a batch no worker is decoding
+		select {
+		case p.batches <- b:
+		case <-p.stopc:
+			return
+		}
+		select {
+		case work <- b:
+		case <-p.stopc:
+			return
+		}
+	}
+}
+
+// Next advances the pipeline to the result of the next entry, which is then
+// available through Value. It returns false when the iteration stops, either
+// by reaching the end of the WAL or an error.
+func (p *Pipeline[T]) Next() bool {
+	if p.done {
+		return false
+	}
+	if p.batches == nil {
+		if !p.it.Next() {
+			p.done = true
+			return false
+		}
+		p.value = p.fn(p.it.Entry())
+		return true
+	}
+	for p.cur == nil || p.i == len(p.cur.results) {
+		b, ok := <-p.batches
+		if !ok {
+			p.done = true
+			return false
+		}
+		<-b.decoded
+		p.cur, p.i = b, 0
+	}
+	p.value = p.cur.results[p.i]
+	p.i++
+	return true
+}
+
+// Value returns the result of the current entry.
+func (p *Pipeline[T]) Value() T { return p.value }
+
+// Err returns the error that stopped the iteration, if any. It must be called
+// once Next returned false.
+func (p *Pipeline[T]) Err() error { return p.it.Err() }
+
+// Close stops the workers and closes the iterator.
+func (p *Pipeline[T]) Close() error {
+	p.done = true
+	if p.batches != nil {
+		select {
+		case <-p.stopc:
+		default:
+			close(p.stopc)
+		}
+		<-p.donec
+		p.wg.Wait()
+	}
+	return p.it.Close()
+}
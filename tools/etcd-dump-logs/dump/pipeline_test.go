@@ -0,0 +1,97 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestPipeline(t *testing.T) {
+	// more entries than batches in flight, with every other one filtered out
+	var ents []raftpb.Entry
+	for i := uint64(1); i <= 10*pipelineBatchSize+7; i++ {
+		if i%2 == 0 {
+			ents = append(ents, raftpb.Entry{Term: 1, Index: i, Type: raftpb.EntryNormal, Data: []byte("garbage")})
+		} else {
+			ents = append(ents, putEntry(1, i, fmt.Sprintf("key%d", i)))
+		}
+	}
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Save(raftpb.HardState{}, ents))
+	require.NoError(t, w.Close())
+	r, err := NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, r.Scan())
+	filters, _ := EntryFilters("IRRPut")
+
+	describe := func(e Entry) string {
+		return fmt.Sprintf("%d %s", e.Index, e.InternalRaftRequest.Put.Key)
+	}
+	var want []string
+	for i := 1; i <= len(ents); i += 2 {
+		want = append(want, fmt.Sprintf("%d key%d", i, i))
+	}
+
+	for _, parallelism := range []int{0, 1, 4} {
+		for _, reverse := range []bool{false, true} {
+			t.Run(fmt.Sprintf("parallelism=%d reverse=%t", parallelism, reverse), func(t *testing.T) {
+				var it *Iterator
+				if reverse {
+					it = r.ReverseEntries(filters...)
+				} else {
+					it, err = r.Entries(filters...)
+					require.NoError(t, err)
+				}
+				p := NewPipeline(it, parallelism, describe)
+				var got []string
+				for p.Next() {
+					got = append(got, p.Value())
+				}
+				require.NoError(t, p.Err())
+				require.NoError(t, p.Close())
+				if reverse {
+					for i, j := 0, len(got)-1; i < j; i, j = i+1, j-1 {
+						got[i], got[j] = got[j], got[i]
+					}
+				}
+				assert.Equal(t, want, got)
+			})
+		}
+	}
+
+	t.Run("close before the end", func(t *testing.T) {
+		it, err := r.Entries(filters...)
+		require.NoError(t, err)
+		p := NewPipeline(it, 4, describe)
+		for i := 0; i < 3; i++ {
+			require.True(t, p.Next())
+			assert.Equal(t, want[i], p.Value())
+		}
+		require.NoError(t, p.Close())
+		assert.False(t, p.Next())
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+)
+
+// prettyIndent aligns the continuation lines of PrintEntryPretty with the data
+// column of the first line.
+const prettyIndent = "\t\t\t"
+
+// PrintEntryPretty prints the entry like PrintEntry, except for transactions,
+// which are printed over several lines: the compares and the operations of
+// each branch are printed on separate lines indented under their branch, and
+// nested transactions are indented further. Only the first line starts with
+// the term, index and type of the entry, and the last line has no trailing
+// newline.
+func PrintEntryPretty(w io.Writer, e Entry) {
+	rr := e.InternalRaftRequest
+	if rr == nil || rr.Txn == nil {
+		PrintEntry(w, e)
+		return
+	}
+	header := *rr
+	header.Txn = nil
+	fmt.Fprintf(w, "%4d\t%10d\tnorm\t%stxn:", e.Term, e.Index, header.String())
+	printPrettyTxn(w, rr.Txn, 1)
+}
+
+func printPrettyTxn(w io.Writer, txn *etcdserverpb.TxnRequest, depth int) {
+	if len(txn.Compare) > 0 {
+		printPrettyLine(w, depth, "compare:")
+		for _, cmp := range txn.Compare {
+			printPrettyLine(w, depth+1, strings.TrimSpace(cmp.String()))
+		}
+	}
+	branches := []struct {
+		name string
+		ops  []*etcdserverpb.RequestOp
+	}{
+		{"success:", txn.Success},
+		{"failure:", txn.Failure},
+	}
+	for _, branch := range branches {
+		if len(branch.ops) == 0 {
+			continue
+		}
+		printPrettyLine(w, depth, branch.name)
+		for _, op := range branch.ops {
+			printPrettyRequestOp(w, op, depth+1)
+		}
+	}
+}
+
+func printPrettyRequestOp(w io.Writer, op *etcdserverpb.RequestOp, depth int) {
+	switch r := op.Request.(type) {
+	case *etcdserverpb.RequestOp_RequestRange:
+		printPrettyLine(w, depth, "request_range: "+strings.TrimSpace(r.RequestRange.String()))
+	case *etcdserverpb.RequestOp_RequestPut:
+		printPrettyLine(w, depth, "request_put: "+strings.TrimSpace(r.RequestPut.String()))
+	case *etcdserverpb.RequestOp_RequestDeleteRange:
+		printPrettyLine(w, depth, "request_delete_range: "+strings.TrimSpace(r.RequestDeleteRange.String()))
+	case *etcdserverpb.RequestOp_RequestTxn:
+		printPrettyLine(w, depth, "request_txn:")
+		printPrettyTxn(w, r.RequestTxn, depth+1)
+	default:
+		printPrettyLine(w, depth, strings.TrimSpace(op.String()))
+	}
+}
+
+// printPrettyLine starts a continuation line of PrintEntryPretty at the given
+// depth of indentation.
+func printPrettyLine(w io.Writer, depth int, s string) {
+	fmt.Fprintf(w, "\n%s%s%s", prettyIndent, strings.Repeat("  ", depth), s)
+}
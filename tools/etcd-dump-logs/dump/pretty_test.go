@@ -0,0 +1,86 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestPrintEntryPretty(t *testing.T) {
+	txn := &etcdserverpb.InternalRaftRequest{Header: &etcdserverpb.RequestHeader{ID: 7}, Txn: &etcdserverpb.TxnRequest{
+		Compare: []*etcdserverpb.Compare{
+			{Key: []byte("foo"), Target: etcdserverpb.Compare_VALUE, TargetUnion: &etcdserverpb.Compare_Value{Value: []byte("bar")}},
+			{Key: []byte("baz"), Result: etcdserverpb.Compare_GREATER, Target: etcdserverpb.Compare_MOD, TargetUnion: &etcdserverpb.Compare_ModRevision{ModRevision: 3}},
+		},
+		Success: []*etcdserverpb.RequestOp{
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("qux"), Lease: 1}}},
+			{Request: &etcdserverpb.RequestOp_RequestTxn{RequestTxn: &etcdserverpb.TxnRequest{
+				Success: []*etcdserverpb.RequestOp{{Request: &etcdserverpb.RequestOp_RequestRange{RequestRange: &etcdserverpb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b")}}}},
+				Failure: []*etcdserverpb.RequestOp{{Request: &etcdserverpb.RequestOp_RequestDeleteRange{RequestDeleteRange: &etcdserverpb.DeleteRangeRequest{Key: []byte("a")}}}},
+			}}},
+		},
+		Failure: []*etcdserverpb.RequestOp{
+			{Request: &etcdserverpb.RequestOp_RequestDeleteRange{RequestDeleteRange: &etcdserverpb.DeleteRangeRequest{Key: []byte("foo"), PrevKv: true}}},
+		},
+	}}
+	tcs := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{
+			name: "txn",
+			data: pbutil.MustMarshal(txn),
+			want: `   1	         1	norm	header:<ID:7 > txn:
+			  compare:
+			    target:VALUE key:"foo" value:"bar"
+			    result:GREATER target:MOD key:"baz" mod_revision:3
+			  success:
+			    request_put: key:"foo" value:"qux" lease:1
+			    request_txn:
+			      success:
+			        request_range: key:"a" range_end:"b"
+			      failure:
+			        request_delete_range: key:"a"
+			  failure:
+			    request_delete_range: key:"foo" prev_kv:true`,
+		},
+		{
+			name: "empty txn",
+			data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 8, Txn: &etcdserverpb.TxnRequest{}}),
+			want: `   1	         1	norm	ID:8 txn:`,
+		},
+		{
+			name: "put",
+			data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}}),
+			want: `   1	         1	norm	put:<key:"foo" value:"bar" > `,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			e := DecodeEntry(raftpb.Entry{Term: 1, Index: 1, Type: raftpb.EntryNormal, Data: tc.data}, "")
+			var out bytes.Buffer
+			PrintEntryPretty(&out, e)
+			assert.Equal(t, tc.want, out.String())
+		})
+	}
+}
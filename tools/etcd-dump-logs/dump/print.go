@@ -0,0 +1,166 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+const (
+	methodSync   string = "SYNC"
+	methodQGet   string = "QGET"
+	methodDelete string = "DELETE"
+)
+
+// EntryPrinter prints an entry of a given type.
+type EntryPrinter func(w io.Writer, e raftpb.Entry)
+
+// The 4 print functions below print the entry format based on there types
+
+// printInternalRaftRequest is used to print entry information for IRRRange, IRRPut,
+// IRRDeleteRange and IRRTxn entries
+func printInternalRaftRequest(w io.Writer, entry raftpb.Entry) {
+	var rr etcdserverpb.InternalRaftRequest
+	if err := rr.Unmarshal(entry.Data); err == nil {
+		// Ensure we don't log user password
+		if rr.AuthUserChangePassword != nil && rr.AuthUserChangePassword.Password != "" {
+			rr.AuthUserChangePassword.Password = "<value removed>"
+		}
+		fmt.Fprintf(w, "%4d\t%10d\tnorm\t%s", entry.Term, entry.Index, rr.String())
+	}
+}
+
+func printUnknownNormal(w io.Writer, entry raftpb.Entry) {
+	fmt.Fprintf(w, "%4d\t%10d\tnorm\t???", entry.Term, entry.Index)
+}
+
+func printConfChange(w io.Writer, entry raftpb.Entry) {
+	fmt.Fprintf(w, "%4d\t%10d", entry.Term, entry.Index)
+	fmt.Fprint(w, "\tconf")
+	var r raftpb.ConfChange
+	if err := r.Unmarshal(entry.Data); err != nil {
+		fmt.Fprint(w, "\t???")
+	} else {
+		fmt.Fprintf(w, "\tmethod=%s id=%s", r.Type, types.ID(r.NodeID))
+	}
+}
+
+func printConfChangeV2(w io.Writer, entry raftpb.Entry) {
+	fmt.Fprintf(w, "%4d\t%10d", entry.Term, entry.Index)
+	fmt.Fprint(w, "\tconf")
+	var r raftpb.ConfChangeV2
+	if err := r.Unmarshal(entry.Data); err != nil {
+		fmt.Fprint(w, "\t???")
+		return
+	}
+	method, ok := confChangeV2Method(r)
+	if !ok {
+		fmt.Fprintf(w, "\tv2 transition=%d ???", r.Transition)
+		return
+	}
+	fmt.Fprintf(w, "\tv2 method=%s", method)
+	autoLeave, joint := r.EnterJoint()
+	if !joint {
+		// a single change, or none when leaving a joint configuration
+		for _, c := range r.Changes {
+			fmt.Fprintf(w, " %s", confChangeTarget(c))
+		}
+		return
+	}
+	changes := make([]string, len(r.Changes))
+	for i, c := range r.Changes {
+		changes[i] = c.Type.String() + " " + confChangeTarget(c)
+	}
+	fmt.Fprintf(w, " auto-leave=%t changes=[%s]", autoLeave, strings.Join(changes, ", "))
+}
+
+// confChangeV2Method returns LeaveJoint if the configuration change leaves a
+// joint configuration, EnterJoint if it enters one, or the type of its single
+// change otherwise. It returns false if the transition is unknown.
+func confChangeV2Method(cc raftpb.ConfChangeV2) (string, bool) {
+	if _, ok := raftpb.ConfChangeTransition_name[int32(cc.Transition)]; !ok {
+		return "", false
+	}
+	if cc.LeaveJoint() {
+		return "LeaveJoint", true
+	}
+	if _, joint := cc.EnterJoint(); joint || len(cc.Changes) == 0 {
+		return "EnterJoint", true
+	}
+	return cc.Changes[0].Type.String(), true
+}
+
+// confChangeTarget describes the member a single configuration change applies to.
+func confChangeTarget(c raftpb.ConfChangeSingle) string {
+	if c.Type == raftpb.ConfChangeAddLearnerNode {
+		return fmt.Sprintf("id=%s learner=true", types.ID(c.NodeID))
+	}
+	return fmt.Sprintf("id=%s", types.ID(c.NodeID))
+}
+
+func printRequest(w io.Writer, entry raftpb.Entry) {
+	var r etcdserverpb.Request
+	if err := r.Unmarshal(entry.Data); err == nil {
+		fmt.Fprintf(w, "%4d\t%10d\tnorm", entry.Term, entry.Index)
+		switch r.Method {
+		case "":
+			fmt.Fprint(w, "\tnoop")
+		case methodSync:
+			fmt.Fprintf(w, "\tmethod=SYNC time=%q", time.Unix(0, r.Time).UTC())
+		case methodQGet, methodDelete:
+			fmt.Fprintf(w, "\tmethod=%s path=%s", r.Method, Excerpt(r.Path, 64, 64))
+		default:
+			fmt.Fprintf(w, "\tmethod=%s path=%s val=%s", r.Method, Excerpt(r.Path, 64, 64), Excerpt(r.Val, 128, 0))
+		}
+	}
+}
+
+var printerMap = map[string]EntryPrinter{
+	"InternalRaftRequest": printInternalRaftRequest,
+	"AuthRequest":         printAuthRequest,
+	"Request":             printRequest,
+	"ConfigChange":        printConfChange,
+	"ConfigChangeV2":      printConfChangeV2,
+	"UnknownNormal":       printUnknownNormal,
+}
+
+// PrintEntry prints the entry in the etcd-dump-logs format, without the
+// trailing newline. Entries of a type without printer are not printed. The
+// configuration changes are followed by the names and peer URLs of their
+// members if e.Members knows them.
+func PrintEntry(w io.Writer, e Entry) {
+	if printer, ok := printerMap[e.Type]; ok {
+		printer(w, e.Entry)
+		if e.Members != nil {
+			printConfChangeMembers(w, e)
+		}
+	}
+}
+
+// Excerpt replaces middle part with ellipsis and returns a double-quoted
+// string safely escaped with Go syntax.
+func Excerpt(str string, pre, suf int) string {
+	if pre+suf > len(str) {
+		return fmt.Sprintf("%q", str)
+	}
+	return fmt.Sprintf("%q...%q", str[:pre], str[len(str)-suf:])
+}
@@ -0,0 +1,615 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// override records that the entry record at position pos replaced all the
+// previously read entries with index >= index.
+type override struct {
+	pos   int
+	index uint64
+}
+
+// Reader reads the entries of a WAL one record at a time. Unlike
+// wal.ReadAll, it never holds more than a single entry in memory, so it can
+// be used to dump WAL directories larger than the available memory.
+//
+// The WAL is read twice: Scan validates the records and collects the WAL
+// metadata, the final HardState and the positions of overridden entries;
+// Entries then decodes the records again and yields the entries ReadAll
+// would have returned, in the same order.
+type Reader struct {
+	dir      string
+	names    []string
+	start    walpb.Snapshot
+	endIndex uint64
+	// startTerm and endTerm bound the terms of the entries returned by Entries.
+	startTerm uint64
+	endTerm   uint64
+	// minSize is the smallest data size of the entries returned by Entries.
+	minSize uint64
+	// members are set to the Members of the entries returned by Entries.
+	members Members
+	// skipCorrupt is set if corrupt records are skipped, and onSkip is
+	// called for each skipped range.
+	skipCorrupt bool
+	onSkip      func(SkippedRange)
+
+	metadata  []byte
+	state     raftpb.HardState
+	count     int
+	lastIndex uint64
+
+	// overrides holds the entry overrides found by Scan, ordered by pos.
+	overrides []override
+	// minOverride[i] is the smallest index in overrides[i:].
+	minOverride []uint64
+	// stopPos is the position of the first entry record not returned by
+	// Entries, or -1 if all records are returned.
+	stopPos int
+	// gaps are the index ranges missing from the entries in the skip-corrupt
+	// mode, in ascending order.
+	gaps []indexRange
+}
+
+// indexRange is the range of indexes [from, to).
+type indexRange struct {
+	from, to uint64
+}
+
+// NewReader selects the WAL files in dir needed to read entries after the
+// given snapshot. Entries with index >= endIndex are skipped.
+func NewReader(dir string, snap walpb.Snapshot, endIndex uint64) (*Reader, error) {
+	names, err := fileutil.ReadDir(dir, fileutil.WithExt(".wal"))
+	if err != nil {
+		return nil, err
+	}
+	var walNames []string
+	var seqs, indexes []uint64
+	for _, name := range names {
+		var seq, index uint64
+		if _, err := fmt.Sscanf(name, "%016x-%016x.wal", &seq, &index); err != nil {
+			continue
+		}
+		walNames = append(walNames, name)
+		seqs = append(seqs, seq)
+		indexes = append(indexes, index)
+	}
+	if len(walNames) == 0 {
+		return nil, wal.ErrFileNotFound
+	}
+
+	// select the last file whose raft index is equal to or smaller than the snapshot index
+	nameIndex := sort.Search(len(indexes), func(i int) bool { return indexes[i] > snap.Index }) - 1
+	if nameIndex < 0 {
+		return nil, fmt.Errorf("wal: file not found which matches the snapshot index '%d'", snap.Index)
+	}
+	for i := nameIndex + 1; i < len(seqs); i++ {
+		if seqs[i] != seqs[i-1]+1 {
+			return nil, fmt.Errorf("wal: file sequence numbers (starting from %d) do not increase continuously", nameIndex)
+		}
+	}
+
+	return &Reader{
+		dir:      dir,
+		names:    walNames[nameIndex:],
+		start:    snap,
+		endIndex: endIndex,
+		endTerm:  math.MaxUint64,
+		stopPos:  -1,
+	}, nil
+}
+
+// SetTermRange makes Entries skip the entries with a term lower than
+// startTerm or greater than or equal to endTerm. It does not change Count
+// nor LastIndex.
+func (r *Reader) SetTermRange(startTerm, endTerm uint64) {
+	r.startTerm, r.endTerm = startTerm, endTerm
+}
+
+// SetMinSize makes Entries skip the entries whose data is smaller than
+// minSize bytes. It does not change Count nor LastIndex.
+func (r *Reader) SetMinSize(minSize uint64) { r.minSize = minSize }
+
+// SetSkipCorrupt makes the reader skip the records that cannot be decoded,
+// fail their CRC check or carry data that cannot be unmarshaled, instead of
+// failing, and resynchronize on the next valid record of the segment. Scan
+// then no longer fails on the gaps left between the entries, and onSkip is
+// called for each skipped range by Scan, or by the iterator of
+// ReverseEntries.
+func (r *Reader) SetSkipCorrupt(onSkip func(SkippedRange)) {
+	r.skipCorrupt, r.onSkip = true, onSkip
+}
+
+// SetMembers sets the members resolving the member IDs of the entries
+// returned by Entries when printing them.
+func (r *Reader) SetMembers(members Members) { r.members = members }
+
+// Metadata returns the WAL metadata found by Scan.
+func (r *Reader) Metadata() []byte { return r.metadata }
+
+// HardState returns the last HardState found by Scan.
+func (r *Reader) HardState() raftpb.HardState { return r.state }
+
+// Count returns the number of entries Entries yields without filters.
+func (r *Reader) Count() int { return r.count }
+
+// LastIndex returns the index of the last entry Entries yields without filters.
+func (r *Reader) LastIndex() uint64 { return r.lastIndex }
+
+// recordDecoder decodes the records of a list of WAL files, validating the CRC chain.
+type recordDecoder struct {
+	files   []*os.File
+	decoder wal.Decoder
+	// resync replaces decoder in the skip-corrupt mode.
+	resync  *resyncDecoder
+	locator *RecordLocator
+}
+
+// openRecords opens the given WAL files. In the skip-corrupt mode, report
+// tells whether the skipped ranges are reported.
+func (r *Reader) openRecords(report bool, names ...string) (*recordDecoder, error) {
+	d := &recordDecoder{}
+	var readers []fileutil.FileReader
+	for _, name := range names {
+		f, err := os.OpenFile(filepath.Join(r.dir, name), os.O_RDONLY, fileutil.PrivateFileMode)
+		if err != nil {
+			d.close()
+			return nil, err
+		}
+		d.files = append(d.files, f)
+		readers = append(readers, fileutil.NewFileReader(f))
+	}
+	d.locator = NewRecordLocator(d.files)
+	if r.skipCorrupt {
+		var onSkip func(SkippedRange)
+		if report {
+			onSkip = r.onSkip
+		}
+		d.resync = newResyncDecoder(d.files, onSkip)
+		return d, nil
+	}
+	d.decoder = wal.NewDecoder(readers...)
+	return d, nil
+}
+
+// next decodes the next record. It returns io.EOF when there are no more records.
+func (d *recordDecoder) next(rec *walpb.Record) error {
+	if d.resync != nil {
+		if err := d.resync.decode(rec); err != nil {
+			return err
+		}
+		d.locator.set(d.resync.file, d.resync.recOff)
+		return nil
+	}
+	err := d.decoder.Decode(rec)
+	if err != nil {
+		// The last record maybe a partial written one, so
+		// `io.ErrUnexpectedEOF` might be returned.
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return io.EOF
+		}
+		return err
+	}
+	d.locator.Locate(d.decoder, rec)
+	if rec.Type == wal.CrcType {
+		crc := d.decoder.LastCRC()
+		// current crc of decoder must match the crc of the record.
+		if crc != 0 && rec.Validate(crc) != nil {
+			return wal.ErrCRCMismatch
+		}
+		d.decoder.UpdateCRC(rec.Crc)
+	}
+	return nil
+}
+
+func (d *recordDecoder) close() error {
+	var err error
+	for _, f := range d.files {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Scan reads through the WAL once, collecting the WAL metadata, the last
+// HardState, the number of entries and the overridden entry ranges. It returns
+// the same errors wal.ReadAll would. On wal.ErrSliceOutOfRange and
+// wal.ErrSnapshotNotFound the continuous entries read so far are still
+// available through Entries.
+func (r *Reader) Scan() error {
+	d, err := r.openRecords(true, r.names...)
+	if err != nil {
+		return err
+	}
+	defer d.close()
+
+	var (
+		match bool
+		pos   int
+		next  = r.start.Index + 1
+		rec   walpb.Record
+	)
+	for err == nil {
+		if err = d.next(&rec); err != nil {
+			break
+		}
+		switch rec.Type {
+		case wal.EntryType:
+			e := wal.MustUnmarshalEntry(rec.Data)
+			if e.Index <= r.start.Index {
+				continue
+			}
+			if e.Index > next && r.skipCorrupt {
+				r.gaps = append(r.gaps, indexRange{from: next, to: e.Index})
+			} else if e.Index > next {
+				r.stopPos = pos
+				err = fmt.Errorf("%w, snapshot[Index: %d, Term: %d], current entry[Index: %d, Term: %d], len(ents): %d",
+					wal.ErrSliceOutOfRange, r.start.Index, r.start.Term, e.Index, e.Term, next-r.start.Index-1)
+				continue
+			}
+			if e.Index < next {
+				r.overrides = append(r.overrides, override{pos: pos, index: e.Index})
+				r.gaps = truncateGaps(r.gaps, e.Index)
+			}
+			next = e.Index + 1
+			pos++
+		case wal.StateType:
+			r.state = wal.MustUnmarshalState(rec.Data)
+		case wal.MetadataType:
+			if r.metadata != nil && !bytes.Equal(r.metadata, rec.Data) {
+				err = wal.ErrMetadataConflict
+			}
+			r.metadata = rec.Data
+		case wal.CrcType:
+		case wal.SnapshotType:
+			var snap walpb.Snapshot
+			pbutil.MustUnmarshal(&snap, rec.Data)
+			if snap.Index == r.start.Index {
+				if snap.Term != r.start.Term {
+					err = wal.ErrSnapshotMismatch
+				}
+				match = true
+			}
+		default:
+			err = fmt.Errorf("unexpected block type %d", rec.Type)
+		}
+	}
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+	if err != nil && !errors.Is(err, wal.ErrSliceOutOfRange) {
+		r.state.Reset()
+		r.stopPos = 0
+		return err
+	}
+
+	r.minOverride = make([]uint64, len(r.overrides))
+	for i := len(r.overrides) - 1; i >= 0; i-- {
+		r.minOverride[i] = r.overrides[i].index
+		if i+1 < len(r.overrides) && r.minOverride[i+1] < r.minOverride[i] {
+			r.minOverride[i] = r.minOverride[i+1]
+		}
+	}
+
+	// Entries that survived the overrides form the continuous range [start.Index+1, next).
+	last := min(next, r.endIndex)
+	if last > r.start.Index+1 {
+		r.count = int(last - r.start.Index - 1)
+		r.lastIndex = last - 1
+	}
+	for i := len(r.gaps) - 1; i >= 0; i-- {
+		g := r.gaps[i]
+		r.count -= int(min(g.to, last) - min(g.from, last))
+		if r.lastIndex >= g.from && r.lastIndex < g.to {
+			r.lastIndex = g.from - 1
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+	if !match {
+		return wal.ErrSnapshotNotFound
+	}
+	return nil
+}
+
+// truncateGaps removes the indexes >= index from the gaps, which are
+// overridden by an entry with the given index.
+func truncateGaps(gaps []indexRange, index uint64) []indexRange {
+	for len(gaps) > 0 && gaps[len(gaps)-1].from >= index {
+		gaps = gaps[:len(gaps)-1]
+	}
+	if len(gaps) > 0 && gaps[len(gaps)-1].to > index {
+		gaps[len(gaps)-1].to = index
+	}
+	return gaps
+}
+
+// Entries returns an iterator over the entries wal.ReadAll would have
+// returned, in the same order, decoding one record at a time. If filters are
+// given, only the entries passing at least one of them are returned. Entries
+// must be called after Scan and the returned iterator must be closed.
+func (r *Reader) Entries(filters ...EntryFilter) (*Iterator, error) {
+	// the skipped ranges were reported by Scan
+	d, err := r.openRecords(false, r.names...)
+	if err != nil {
+		return nil, err
+	}
+	return &Iterator{r: r, d: d, filters: filters}, nil
+}
+
+// ScanLast reads the last WAL file only, collecting the WAL metadata and the
+// last HardState. Unlike Scan, it does not validate the entries nor count
+// them, so that ReverseEntries can start from the tail of a large WAL right
+// away.
+func (r *Reader) ScanLast() error {
+	// the skipped ranges are reported by the iterator of ReverseEntries
+	d, err := r.openRecords(false, r.names[len(r.names)-1])
+	if err != nil {
+		return err
+	}
+	defer d.close()
+
+	var rec walpb.Record
+	for {
+		if err = d.next(&rec); err != nil {
+			break
+		}
+		switch rec.Type {
+		case wal.StateType:
+			r.state = wal.MustUnmarshalState(rec.Data)
+		case wal.MetadataType:
+			r.metadata = rec.Data
+		}
+	}
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+// ReverseEntries returns an iterator over the entries Entries would return,
+// from the last one to the first. It reads the WAL files backwards, decoding
+// one file at a time, so the tail of a large WAL is returned without reading
+// the files before it. ReverseEntries does not need Scan to be called, and it
+// does not check the WAL for gaps between the entries. The returned iterator
+// must be closed.
+func (r *Reader) ReverseEntries(filters ...EntryFilter) *Iterator {
+	return &Iterator{
+		r:       r,
+		filters: filters,
+		reverse: &reverseState{file: len(r.names), minIndex: math.MaxUint64},
+	}
+}
+
+// reverseState is the state of an iterator returning entries backwards.
+type reverseState struct {
+	// file is the index of the WAL file ents were read from.
+	file int
+	// ents are the entries of the file not returned yet.
+	ents []fileEntry
+	// minIndex is the smallest index of the entries read so far. Entries with
+	// an index >= minIndex are overridden by them.
+	minIndex uint64
+}
+
+// fileEntry is an entry read by fileEntries and the offset of its record.
+type fileEntry struct {
+	raftpb.Entry
+	offset int64
+}
+
+// fileEntries decodes the entries of a WAL file, in order.
+func (r *Reader) fileEntries(name string) ([]fileEntry, error) {
+	d, err := r.openRecords(true, name)
+	if err != nil {
+		return nil, err
+	}
+	defer d.close()
+
+	var (
+		ents []fileEntry
+		rec  walpb.Record
+	)
+	for {
+		if err := d.next(&rec); err != nil {
+			if errors.Is(err, io.EOF) {
+				return ents, nil
+			}
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if rec.Type == wal.EntryType {
+			ents = append(ents, fileEntry{Entry: wal.MustUnmarshalEntry(rec.Data), offset: d.locator.Offset()})
+		}
+	}
+}
+
+// Iterator iterates over the decoded entries of a WAL.
+type Iterator struct {
+	r       *Reader
+	d       *recordDecoder
+	filters []EntryFilter
+	// reverse is set if the iterator returns the entries backwards.
+	reverse *reverseState
+
+	rec   walpb.Record
+	pos   int
+	o     int
+	entry Entry
+	err   error
+	done  bool
+}
+
+// Next advances the iterator to the next entry, which is then available
+// through Entry. It returns false when the iteration stops, either by reaching
+// the end of the WAL or an error.
+func (it *Iterator) Next() bool {
+	for {
+		c, ok := it.next()
+		if !ok {
+			return false
+		}
+		if e, ok := it.decode(c); ok {
+			it.entry = e
+			return true
+		}
+	}
+}
+
+// candidate is an entry in the index and term ranges of an iterator, not
+// filtered by entry type nor decoded yet, and the location of its record.
+type candidate struct {
+	raftpb.Entry
+	segment string
+	offset  int64
+}
+
+// next returns the next entry in the index and term ranges of the iterator.
+func (it *Iterator) next() (candidate, bool) {
+	if it.done {
+		return candidate{}, false
+	}
+	if it.reverse != nil {
+		return it.nextReverse()
+	}
+	for {
+		if err := it.d.next(&it.rec); err != nil {
+			if !errors.Is(err, io.EOF) {
+				it.err = err
+			}
+			it.done = true
+			return candidate{}, false
+		}
+		if it.rec.Type != wal.EntryType {
+			continue
+		}
+		e := wal.MustUnmarshalEntry(it.rec.Data)
+		if e.Index <= it.r.start.Index {
+			continue
+		}
+		if it.pos == it.r.stopPos {
+			it.done = true
+			return candidate{}, false
+		}
+		it.pos++
+		for it.o < len(it.r.overrides) && it.r.overrides[it.o].pos < it.pos {
+			it.o++
+		}
+		// the entry is overridden by an entry read later
+		if it.o < len(it.r.overrides) && it.r.minOverride[it.o] <= e.Index {
+			continue
+		}
+		if it.inRange(e) {
+			return candidate{Entry: e, segment: it.d.locator.Segment(), offset: it.d.locator.Offset()}, true
+		}
+	}
+}
+
+func (it *Iterator) nextReverse() (candidate, bool) {
+	rs := it.reverse
+	for {
+		for len(rs.ents) > 0 {
+			e := rs.ents[len(rs.ents)-1]
+			rs.ents = rs.ents[:len(rs.ents)-1]
+			// the entry is overridden by an entry read before
+			if e.Index >= rs.minIndex {
+				continue
+			}
+			rs.minIndex = e.Index
+			// all the previous entries are either in the snapshot or overridden
+			if e.Index <= it.r.start.Index {
+				it.done = true
+				return candidate{}, false
+			}
+			if it.inRange(e.Entry) {
+				return candidate{Entry: e.Entry, segment: it.r.names[rs.file], offset: e.offset}, true
+			}
+		}
+		if rs.file == 0 {
+			it.done = true
+			return candidate{}, false
+		}
+		rs.file--
+		ents, err := it.r.fileEntries(it.r.names[rs.file])
+		if err != nil {
+			it.err = err
+			it.done = true
+			return candidate{}, false
+		}
+		rs.ents = ents
+	}
+}
+
+// inRange reports whether the entry is in the index and term ranges of the
+// iterator and is not smaller than its minimum size.
+func (it *Iterator) inRange(e raftpb.Entry) bool {
+	// WAL might contain entries with e.Index >= endIndex from prev term, then e.Index < endIndex in the next term.
+	// We cannot stop when e.Index >= endIndex.
+	if e.Index >= it.r.endIndex || uint64(len(e.Data)) < it.r.minSize {
+		return false
+	}
+	return e.Term >= it.r.startTerm && e.Term < it.r.endTerm
+}
+
+// decode decodes the candidate entry if it passes the entry type filters of
+// the iterator. It does not modify the iterator, so that the candidates can
+// be decoded concurrently.
+func (it *Iterator) decode(c candidate) (Entry, bool) {
+	typ := ""
+	if len(it.filters) > 0 {
+		var passed bool
+		if passed, typ = PassEntryFilters(it.filters, c.Entry); !passed {
+			return Entry{}, false
+		}
+	}
+	e := DecodeEntry(c.Entry, typ)
+	e.Segment, e.Offset = c.segment, c.offset
+	e.Members = it.r.members
+	return e, true
+}
+
+// Entry returns the current entry.
+func (it *Iterator) Entry() Entry { return it.entry }
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error { return it.err }
+
+// Close releases the WAL files held by the iterator.
+func (it *Iterator) Close() error {
+	it.done = true
+	if it.d == nil {
+		return nil
+	}
+	return it.d.close()
+}
@@ -0,0 +1,180 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestReaderMatchesReadAll(t *testing.T) {
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, []byte("metadata"))
+	require.NoError(t, err)
+	// Entries 3-5 of term 1 are overridden by entries of term 2, and entry 4
+	// of term 2 is overridden again by term 3.
+	batches := [][]raftpb.Entry{
+		{{Term: 1, Index: 1}, {Term: 1, Index: 2}, {Term: 1, Index: 3}, {Term: 1, Index: 4}, {Term: 1, Index: 5}},
+		{{Term: 2, Index: 3}, {Term: 2, Index: 4}},
+		{{Term: 3, Index: 4}, {Term: 3, Index: 5}, {Term: 3, Index: 6}},
+	}
+	for i, ents := range batches {
+		require.NoError(t, w.Save(raftpb.HardState{Term: uint64(i + 1), Commit: 2}, ents))
+	}
+	require.NoError(t, w.Close())
+
+	rw, err := wal.OpenForRead(zaptest.NewLogger(t), dir, walpb.Snapshot{})
+	require.NoError(t, err)
+	metadata, state, expected, err := rw.ReadAll()
+	require.NoError(t, err)
+	rw.Close()
+
+	for _, endIndex := range []uint64{math.MaxUint64, 5} {
+		r, err := NewReader(dir, walpb.Snapshot{}, endIndex)
+		require.NoError(t, err)
+		require.NoError(t, r.Scan())
+		assert.Equal(t, metadata, r.metadata)
+		assert.Equal(t, state, r.state)
+
+		var want []raftpb.Entry
+		for _, e := range expected {
+			if e.Index < endIndex {
+				want = append(want, e)
+			}
+		}
+		assert.Equal(t, want, readEntries(t, r))
+		assert.Equal(t, len(want), r.Count())
+		assert.Equal(t, want[len(want)-1].Index, r.LastIndex())
+	}
+}
+
+func TestReaderStartIndex(t *testing.T) {
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	var ents []raftpb.Entry
+	for i := uint64(1); i <= 10; i++ {
+		ents = append(ents, raftpb.Entry{Term: 1, Index: i})
+	}
+	require.NoError(t, w.Save(raftpb.HardState{}, ents))
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(dir, walpb.Snapshot{Index: 6}, math.MaxUint64)
+	require.NoError(t, err)
+	require.ErrorIs(t, r.Scan(), wal.ErrSnapshotNotFound)
+
+	assert.Equal(t, ents[6:], readEntries(t, r))
+	assert.Equal(t, 4, r.Count())
+	assert.Equal(t, uint64(10), r.LastIndex())
+}
+
+func TestReaderMinSize(t *testing.T) {
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	ents := []raftpb.Entry{
+		{Term: 1, Index: 1},
+		{Term: 1, Index: 2, Data: make([]byte, 100)},
+		{Term: 1, Index: 3, Data: make([]byte, 99)},
+		{Term: 1, Index: 4, Data: make([]byte, 1000)},
+	}
+	require.NoError(t, w.Save(raftpb.HardState{}, ents))
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, r.Scan())
+	r.SetMinSize(100)
+
+	assert.Equal(t, []raftpb.Entry{ents[1], ents[3]}, readEntries(t, r))
+	assert.Equal(t, 4, r.Count())
+	assert.Equal(t, uint64(4), r.LastIndex())
+}
+
+func readEntries(t *testing.T, r *Reader) []raftpb.Entry {
+	t.Helper()
+	it, err := r.Entries()
+	require.NoError(t, err)
+	defer it.Close()
+	var ents []raftpb.Entry
+	for it.Next() {
+		ents = append(ents, it.Entry().Entry)
+	}
+	require.NoError(t, it.Err())
+	return ents
+}
+
+func TestReaderReverseEntries(t *testing.T) {
+	dir := t.TempDir()
+	defer func(size int64) { wal.SegmentSizeBytes = size }(wal.SegmentSizeBytes)
+	wal.SegmentSizeBytes = 1024
+
+	w, err := wal.Create(zaptest.NewLogger(t), dir, []byte("metadata"))
+	require.NoError(t, err)
+	// the entries of term 1 after index 20 are overridden by term 2, spread
+	// over several files
+	for i := uint64(1); i <= 30; i++ {
+		e := raftpb.Entry{Term: 1, Index: i, Data: make([]byte, 100)}
+		require.NoError(t, w.Save(raftpb.HardState{Term: 1, Commit: i}, []raftpb.Entry{e}))
+	}
+	for i := uint64(21); i <= 25; i++ {
+		e := raftpb.Entry{Term: 2, Index: i, Data: make([]byte, 100)}
+		require.NoError(t, w.Save(raftpb.HardState{Term: 2, Commit: i}, []raftpb.Entry{e}))
+	}
+	require.NoError(t, w.Close())
+
+	for _, tc := range []struct {
+		snap     walpb.Snapshot
+		endIndex uint64
+	}{
+		{endIndex: math.MaxUint64},
+		{endIndex: 23},
+		{snap: walpb.Snapshot{Index: 10, Term: 1}, endIndex: math.MaxUint64},
+	} {
+		r, err := NewReader(dir, tc.snap, tc.endIndex)
+		require.NoError(t, err)
+		require.Greater(t, len(r.names), 2)
+		if err = r.Scan(); tc.snap.Index != 0 {
+			require.ErrorIs(t, err, wal.ErrSnapshotNotFound)
+		} else {
+			require.NoError(t, err)
+		}
+		want := readEntries(t, r)
+		require.NotEmpty(t, want)
+
+		r, err = NewReader(dir, tc.snap, tc.endIndex)
+		require.NoError(t, err)
+		require.NoError(t, r.ScanLast())
+		assert.Equal(t, []byte("metadata"), r.Metadata())
+		assert.Equal(t, raftpb.HardState{Term: 2, Commit: 25}, r.HardState())
+
+		it := r.ReverseEntries()
+		var got []raftpb.Entry
+		for it.Next() {
+			got = append([]raftpb.Entry{it.Entry().Entry}, got...)
+		}
+		require.NoError(t, it.Err())
+		require.NoError(t, it.Close())
+		assert.Equal(t, want, got)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+)
+
+// Redaction selects how Redact replaces the values of the entries.
+type Redaction int
+
+const (
+	// RedactNone keeps the values.
+	RedactNone Redaction = iota
+	// RedactHash replaces each value with its SHA-256 digest, so that equal
+	// values can still be told apart from different ones.
+	RedactHash
+	// RedactRemove replaces each value with a placeholder.
+	RedactRemove
+)
+
+const removedValue = "<value removed>"
+
+// ParseRedaction parses a redaction mode: "hash" or "remove".
+func ParseRedaction(s string) (Redaction, error) {
+	switch s {
+	case "hash":
+		return RedactHash, nil
+	case "remove":
+		return RedactRemove, nil
+	}
+	return RedactNone, fmt.Errorf("invalid redaction %q, must be hash or remove", s)
+}
+
+func (r Redaction) String() string {
+	switch r {
+	case RedactHash:
+		return "hash"
+	case RedactRemove:
+		return "remove"
+	}
+	return "none"
+}
+
+func (r Redaction) redact(v []byte) []byte {
+	if r == RedactHash {
+		return fmt.Appendf(nil, "sha256:%x", sha256.Sum256(v))
+	}
+	return []byte(removedValue)
+}
+
+func (r Redaction) redactString(v string) string {
+	if v == "" {
+		return v
+	}
+	return string(r.redact([]byte(v)))
+}
+
+// Redact replaces the values written by the entry, the values compared by
+// its transactions and the user passwords and tokens it carries, and
+// re-encodes the entry data accordingly. Keys are kept.
+func Redact(e *Entry, r Redaction) {
+	if r == RedactNone {
+		return
+	}
+	switch {
+	case e.InternalRaftRequest != nil:
+		redactInternalRaftRequest(e.InternalRaftRequest, r)
+		e.Data = pbutil.MustMarshal(e.InternalRaftRequest)
+	case e.Request != nil:
+		e.Request.Val = r.redactString(e.Request.Val)
+		e.Data = pbutil.MustMarshal(e.Request)
+	}
+}
+
+func redactInternalRaftRequest(rr *etcdserverpb.InternalRaftRequest, r Redaction) {
+	if rr.Put != nil {
+		redactPut(rr.Put, r)
+	}
+	if rr.Txn != nil {
+		redactTxn(rr.Txn, r)
+	}
+	if rr.Authenticate != nil {
+		rr.Authenticate.Password = r.redactString(rr.Authenticate.Password)
+		rr.Authenticate.SimpleToken = r.redactString(rr.Authenticate.SimpleToken)
+	}
+	if rr.AuthUserAdd != nil {
+		rr.AuthUserAdd.Password = r.redactString(rr.AuthUserAdd.Password)
+		rr.AuthUserAdd.HashedPassword = r.redactString(rr.AuthUserAdd.HashedPassword)
+	}
+	if rr.AuthUserChangePassword != nil {
+		rr.AuthUserChangePassword.Password = r.redactString(rr.AuthUserChangePassword.Password)
+		rr.AuthUserChangePassword.HashedPassword = r.redactString(rr.AuthUserChangePassword.HashedPassword)
+	}
+}
+
+func redactPut(put *etcdserverpb.PutRequest, r Redaction) {
+	if put.IgnoreValue {
+		return
+	}
+	put.Value = r.redact(put.Value)
+}
+
+func redactTxn(txn *etcdserverpb.TxnRequest, r Redaction) {
+	for _, cmp := range txn.Compare {
+		if v, ok := cmp.TargetUnion.(*etcdserverpb.Compare_Value); ok {
+			v.Value = r.redact(v.Value)
+		}
+	}
+	for _, ops := range [][]*etcdserverpb.RequestOp{txn.Success, txn.Failure} {
+		for _, op := range ops {
+			switch {
+			case op.GetRequestPut() != nil:
+				redactPut(op.GetRequestPut(), r)
+			case op.GetRequestTxn() != nil:
+				redactTxn(op.GetRequestTxn(), r)
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// sha256 digest of "bar"
+const barDigest = "sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9"
+
+func TestRedact(t *testing.T) {
+	txn := &etcdserverpb.InternalRaftRequest{Txn: &etcdserverpb.TxnRequest{
+		Compare: []*etcdserverpb.Compare{{Key: []byte("foo"), Target: etcdserverpb.Compare_VALUE, TargetUnion: &etcdserverpb.Compare_Value{Value: []byte("bar")}}},
+		Success: []*etcdserverpb.RequestOp{
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}}},
+			{Request: &etcdserverpb.RequestOp_RequestTxn{RequestTxn: &etcdserverpb.TxnRequest{
+				Failure: []*etcdserverpb.RequestOp{{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("baz"), Value: []byte("bar")}}}},
+			}}},
+		},
+	}}
+	tcs := []struct {
+		name      string
+		data      []byte
+		redaction Redaction
+		want      string
+	}{
+		{
+			name:      "put hash",
+			data:      pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}}),
+			redaction: RedactHash,
+			want:      `   1	         1	norm	put:<key:"foo" value:"` + barDigest + `" > `,
+		},
+		{
+			name:      "put remove",
+			data:      pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}}),
+			redaction: RedactRemove,
+			want:      `   1	         1	norm	put:<key:"foo" value:"<value removed>" > `,
+		},
+		{
+			name:      "put ignore value",
+			data:      pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), IgnoreValue: true}}),
+			redaction: RedactRemove,
+			want:      `   1	         1	norm	put:<key:"foo" ignore_value:true > `,
+		},
+		{
+			name:      "txn",
+			data:      pbutil.MustMarshal(txn),
+			redaction: RedactRemove,
+			want: `   1	         1	norm	txn:<compare:<target:VALUE key:"foo" value:"<value removed>" > ` +
+				`success:<request_put:<key:"foo" value:"<value removed>" > > ` +
+				`success:<request_txn:<failure:<request_put:<key:"baz" value:"<value removed>" > > > > > `,
+		},
+		{
+			name:      "user add",
+			data:      pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{AuthUserAdd: &etcdserverpb.AuthUserAddRequest{Name: "root", HashedPassword: "bar"}}),
+			redaction: RedactHash,
+			want:      `   1	         1	norm	auth_user_add:<name:"root" hashedPassword:"` + barDigest + `" > `,
+		},
+		{
+			name:      "authenticate",
+			data:      pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Authenticate: &etcdserverpb.InternalAuthenticateRequest{Name: "root", Password: "bar", SimpleToken: "bar"}}),
+			redaction: RedactHash,
+			want:      `   1	         1	norm	authenticate:<name:"root" password:"` + barDigest + `" simple_token:"` + barDigest + `" > `,
+		},
+		{
+			name:      "v2 request",
+			data:      pbutil.MustMarshal(&etcdserverpb.Request{Method: "PUT", Path: "/foo", Val: "bar"}),
+			redaction: RedactRemove,
+			want:      `   1	         1	norm	method=PUT path="/foo" val="<value removed>"`,
+		},
+		{
+			name:      "none",
+			data:      pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}}),
+			redaction: RedactNone,
+			want:      `   1	         1	norm	put:<key:"foo" value:"bar" > `,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			e := DecodeEntry(raftpb.Entry{Term: 1, Index: 1, Type: raftpb.EntryNormal, Data: tc.data}, "")
+			Redact(&e, tc.redaction)
+			var out bytes.Buffer
+			PrintEntry(&out, e)
+			assert.Equal(t, tc.want, out.String())
+		})
+	}
+}
+
+func TestParseRedaction(t *testing.T) {
+	r, err := ParseRedaction("hash")
+	require.NoError(t, err)
+	assert.Equal(t, RedactHash, r)
+	r, err = ParseRedaction("remove")
+	require.NoError(t, err)
+	assert.Equal(t, RedactRemove, r)
+	_, err = ParseRedaction("mask")
+	require.Error(t, err)
+}
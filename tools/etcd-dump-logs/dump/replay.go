@@ -0,0 +1,193 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// ReplayEncoding is the encoding of the entries of a replay stream.
+type ReplayEncoding string
+
+const (
+	// ReplayBinary encodes each entry as a protobuf message prefixed with its
+	// uvarint encoded length.
+	ReplayBinary ReplayEncoding = "binary"
+	// ReplayBase64 encodes each entry as a line holding the standard base64
+	// encoding of the protobuf message.
+	ReplayBase64 ReplayEncoding = "base64"
+)
+
+// replayHeaderPrefix starts the header line of replay streams. It is followed
+// by the encoding of the stream.
+const replayHeaderPrefix = "etcd-dump-logs replay v1 "
+
+// maxReplayEntrySize bounds the size of the entries read from a binary replay
+// stream, to fail fast on streams that are not replay streams.
+const maxReplayEntrySize = 1 << 30
+
+var errInvalidReplayHeader = errors.New("dump: not a replay stream")
+
+// ReplayWriter writes entries to a replay stream, which starts with a header
+// line naming its encoding. The stream can be read back by a ReplayReader.
+type ReplayWriter struct {
+	w   *bufio.Writer
+	enc ReplayEncoding
+}
+
+// NewReplayWriter writes the header of a replay stream with the given
+// encoding to w.
+func NewReplayWriter(w io.Writer, enc ReplayEncoding) (*ReplayWriter, error) {
+	if enc != ReplayBinary && enc != ReplayBase64 {
+		return nil, fmt.Errorf("dump: invalid replay encoding %q", enc)
+	}
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(replayHeaderPrefix + string(enc) + "\n"); err != nil {
+		return nil, err
+	}
+	return &ReplayWriter{w: bw, enc: enc}, nil
+}
+
+// Write appends the entry to the stream.
+func (w *ReplayWriter) Write(e raftpb.Entry) error {
+	data, err := e.Marshal()
+	if err != nil {
+		return err
+	}
+	if w.enc == ReplayBase64 {
+		if _, err = w.w.WriteString(base64.StdEncoding.EncodeToString(data)); err != nil {
+			return err
+		}
+		return w.w.WriteByte('\n')
+	}
+	if _, err = w.w.Write(binary.AppendUvarint(nil, uint64(len(data)))); err != nil {
+		return err
+	}
+	_, err = w.w.Write(data)
+	return err
+}
+
+// Flush writes the buffered entries to the underlying writer.
+func (w *ReplayWriter) Flush() error { return w.w.Flush() }
+
+// ReplayReader reads the entries of a replay stream written by a ReplayWriter.
+type ReplayReader struct {
+	r   *bufio.Reader
+	enc ReplayEncoding
+}
+
+// NewReplayReader reads the header of the replay stream of r.
+func NewReplayReader(r io.Reader) (*ReplayReader, error) {
+	br := bufio.NewReader(r)
+	header, err := br.ReadString('\n')
+	if err != nil || !strings.HasPrefix(header, replayHeaderPrefix) {
+		return nil, errInvalidReplayHeader
+	}
+	enc := ReplayEncoding(strings.TrimSuffix(strings.TrimPrefix(header, replayHeaderPrefix), "\n"))
+	if enc != ReplayBinary && enc != ReplayBase64 {
+		return nil, fmt.Errorf("dump: invalid replay encoding %q", enc)
+	}
+	return &ReplayReader{r: br, enc: enc}, nil
+}
+
+// Encoding returns the encoding of the stream.
+func (r *ReplayReader) Encoding() ReplayEncoding { return r.enc }
+
+// Next returns the next entry of the stream. It returns io.EOF at the end of
+// the stream, and io.ErrUnexpectedEOF if the last entry is truncated.
+func (r *ReplayReader) Next() (raftpb.Entry, error) {
+	var data []byte
+	if r.enc == ReplayBase64 {
+		line, err := r.r.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) && line != "" {
+				err = io.ErrUnexpectedEOF
+			}
+			return raftpb.Entry{}, err
+		}
+		if data, err = base64.StdEncoding.DecodeString(strings.TrimSuffix(line, "\n")); err != nil {
+			return raftpb.Entry{}, err
+		}
+	} else {
+		n, err := binary.ReadUvarint(r.r)
+		if err != nil {
+			return raftpb.Entry{}, err
+		}
+		if n > maxReplayEntrySize {
+			return raftpb.Entry{}, fmt.Errorf("dump: replay entry too large (%d bytes)", n)
+		}
+		data = make([]byte, n)
+		if _, err = io.ReadFull(r.r, data); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return raftpb.Entry{}, err
+		}
+	}
+	var e raftpb.Entry
+	if err := e.Unmarshal(data); err != nil {
+		return raftpb.Entry{}, err
+	}
+	return e, nil
+}
+
+// ReplayTarget applies the requests of replayed entries to a cluster.
+type ReplayTarget struct {
+	KV    etcdserverpb.KVClient
+	Lease etcdserverpb.LeaseClient
+}
+
+// Apply sends the request of the entry to the cluster. Only the put, delete
+// range, transaction, compaction, lease grant and lease revoke requests are
+// applied, as they are sent by clients; the other entries are skipped and
+// Apply returns false. Leases are granted with their original IDs, so that
+// the keys put with a lease are attached to the same lease.
+func (t ReplayTarget) Apply(ctx context.Context, e raftpb.Entry) (bool, error) {
+	if e.Type != raftpb.EntryNormal {
+		return false, nil
+	}
+	rr := DecodeEntry(e, "").InternalRaftRequest
+	if rr == nil {
+		return false, nil
+	}
+	var err error
+	switch {
+	case rr.Put != nil:
+		_, err = t.KV.Put(ctx, rr.Put)
+	case rr.DeleteRange != nil:
+		_, err = t.KV.DeleteRange(ctx, rr.DeleteRange)
+	case rr.Txn != nil:
+		_, err = t.KV.Txn(ctx, rr.Txn)
+	case rr.Compaction != nil:
+		_, err = t.KV.Compact(ctx, rr.Compaction)
+	case rr.LeaseGrant != nil:
+		_, err = t.Lease.LeaseGrant(ctx, rr.LeaseGrant)
+	case rr.LeaseRevoke != nil:
+		_, err = t.Lease.LeaseRevoke(ctx, rr.LeaseRevoke)
+	default:
+		return false, nil
+	}
+	return true, err
+}
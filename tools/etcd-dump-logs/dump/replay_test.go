@@ -0,0 +1,168 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestReplayStream(t *testing.T) {
+	ents := []raftpb.Entry{
+		{Term: 1, Index: 1, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 2})},
+		{Term: 1, Index: 2, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 1, Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: bytes.Repeat([]byte{'a'}, 300)}})},
+		{Term: 2, Index: 3, Type: raftpb.EntryNormal},
+	}
+	for _, enc := range []ReplayEncoding{ReplayBinary, ReplayBase64} {
+		t.Run(string(enc), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewReplayWriter(&buf, enc)
+			require.NoError(t, err)
+			for _, e := range ents {
+				require.NoError(t, w.Write(e))
+			}
+			require.NoError(t, w.Flush())
+			data := buf.Bytes()
+
+			r, err := NewReplayReader(bytes.NewReader(data))
+			require.NoError(t, err)
+			assert.Equal(t, enc, r.Encoding())
+			var got []raftpb.Entry
+			for {
+				e, err := r.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(t, err)
+				got = append(got, e)
+			}
+			assert.Equal(t, ents, got)
+
+			r, err = NewReplayReader(bytes.NewReader(data[:len(data)-2]))
+			require.NoError(t, err)
+			for range ents[:len(ents)-1] {
+				_, err = r.Next()
+				require.NoError(t, err)
+			}
+			_, err = r.Next()
+			require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		})
+	}
+
+	_, err := NewReplayWriter(io.Discard, "hex")
+	require.Error(t, err)
+	_, err = NewReplayReader(bytes.NewReader([]byte("term,index\n")))
+	require.ErrorIs(t, err, errInvalidReplayHeader)
+}
+
+// fakeReplayClients records the requests sent by a ReplayTarget.
+type fakeReplayClients struct {
+	etcdserverpb.KVClient
+	etcdserverpb.LeaseClient
+	reqs []any
+	err  error
+}
+
+func (c *fakeReplayClients) Put(_ context.Context, r *etcdserverpb.PutRequest, _ ...grpc.CallOption) (*etcdserverpb.PutResponse, error) {
+	c.reqs = append(c.reqs, r)
+	return &etcdserverpb.PutResponse{}, c.err
+}
+
+func (c *fakeReplayClients) Txn(_ context.Context, r *etcdserverpb.TxnRequest, _ ...grpc.CallOption) (*etcdserverpb.TxnResponse, error) {
+	c.reqs = append(c.reqs, r)
+	return &etcdserverpb.TxnResponse{}, c.err
+}
+
+func (c *fakeReplayClients) LeaseGrant(_ context.Context, r *etcdserverpb.LeaseGrantRequest, _ ...grpc.CallOption) (*etcdserverpb.LeaseGrantResponse, error) {
+	c.reqs = append(c.reqs, r)
+	return &etcdserverpb.LeaseGrantResponse{}, c.err
+}
+
+func TestReplayTargetApply(t *testing.T) {
+	put := &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar"), Lease: 7}
+	txn := &etcdserverpb.TxnRequest{Success: []*etcdserverpb.RequestOp{{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: put}}}}
+	grant := &etcdserverpb.LeaseGrantRequest{ID: 7, TTL: 10}
+	tcs := []struct {
+		name        string
+		entry       raftpb.Entry
+		wantApplied bool
+		wantReq     any
+	}{
+		{
+			name:        "put",
+			entry:       raftpb.Entry{Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 1, Put: put})},
+			wantApplied: true,
+			wantReq:     put,
+		},
+		{
+			name:        "txn",
+			entry:       raftpb.Entry{Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 2, Txn: txn})},
+			wantApplied: true,
+			wantReq:     txn,
+		},
+		{
+			name:        "lease grant keeps the lease ID",
+			entry:       raftpb.Entry{Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 3, LeaseGrant: grant})},
+			wantApplied: true,
+			wantReq:     grant,
+		},
+		{
+			name:  "range",
+			entry: raftpb.Entry{Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 4, Range: &etcdserverpb.RangeRequest{Key: []byte("foo")}})},
+		},
+		{
+			name:  "auth",
+			entry: raftpb.Entry{Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: 5, AuthEnable: &etcdserverpb.AuthEnableRequest{}})},
+		},
+		{
+			name:  "conf change",
+			entry: raftpb.Entry{Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{NodeID: 2})},
+		},
+		{
+			name:  "empty",
+			entry: raftpb.Entry{},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &fakeReplayClients{}
+			applied, err := ReplayTarget{KV: c, Lease: c}.Apply(context.Background(), tc.entry)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantApplied, applied)
+			if tc.wantReq == nil {
+				assert.Empty(t, c.reqs)
+				return
+			}
+			require.Len(t, c.reqs, 1)
+			assert.Equal(t, tc.wantReq, c.reqs[0])
+		})
+	}
+
+	c := &fakeReplayClients{err: errors.New("lease not found")}
+	applied, err := ReplayTarget{KV: c, Lease: c}.Apply(context.Background(), tcs[0].entry)
+	assert.True(t, applied)
+	require.EqualError(t, err, "lease not found")
+}
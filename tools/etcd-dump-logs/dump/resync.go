@@ -0,0 +1,230 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errZeroFrame is returned for a frame with a zero length, which starts the
+// preallocated space at the end of a segment, or a torn write.
+var errZeroFrame = errors.New("zero record length")
+
+// SkippedRange is a corrupt range of a WAL segment skipped by a Reader in the
+// skip-corrupt mode.
+type SkippedRange struct {
+	// Corruption locates the first invalid record of the range.
+	Corruption
+	// End is the offset of the valid record the reader resynchronized on, or
+	// the size of the segment if no valid record follows the corruption.
+	End int64
+}
+
+// resyncDecoder decodes the records of WAL files like wal.Decoder, but
+// instead of failing on a record that cannot be decoded, fails its CRC check
+// or carries data that cannot be unmarshaled, it skips to the next valid
+// record of the segment. Records are framed on 8 byte boundaries, so the next
+// record is searched for at each following boundary; a candidate is only
+// accepted if the CRC of the record after it chains from it, or if it is the
+// last record of the segment. Unlike wal.Decoder, it reads one whole segment
+// into memory at a time.
+type resyncDecoder struct {
+	files []*os.File
+	// onSkip is called for each skipped range, if set.
+	onSkip func(SkippedRange)
+
+	file int
+	data []byte
+	// off is the offset of the next frame in data, and recOff the offset of
+	// the last decoded record.
+	off    int64
+	recOff int64
+	crc    uint32
+}
+
+func newResyncDecoder(files []*os.File, onSkip func(SkippedRange)) *resyncDecoder {
+	return &resyncDecoder{files: files, onSkip: onSkip, file: -1}
+}
+
+// decode decodes the next valid record. It returns io.EOF when there are no
+// more records.
+func (d *resyncDecoder) decode(rec *walpb.Record) error {
+	for {
+		if d.file < 0 || d.off >= int64(len(d.data)) {
+			if err := d.nextFile(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		start := d.off
+		end, err := d.frameAt(start, rec)
+		if err == nil {
+			if err = d.checkCRC(rec); err == nil {
+				d.recOff, d.off = start, end
+				return nil
+			}
+		}
+
+		next, end, found := d.resync(start+8, rec)
+		lastFile := d.file == len(d.files)-1
+		// the preallocated space at the end of a segment, and the torn
+		// last record of the WAL are not corruption
+		tail := errors.Is(err, errZeroFrame) || (lastFile && errors.Is(err, io.ErrUnexpectedEOF))
+		if d.onSkip != nil && (found || !tail) {
+			d.onSkip(SkippedRange{Corruption: Corruption{Segment: filepath.Base(d.files[d.file].Name()), Offset: start, Err: err}, End: next})
+		}
+		if !found {
+			d.off = next
+			continue
+		}
+		d.recOff, d.off = next, end
+		return nil
+	}
+}
+
+func (d *resyncDecoder) nextFile() error {
+	d.file++
+	if d.file >= len(d.files) {
+		d.file, d.data = len(d.files), nil
+		return io.EOF
+	}
+	f := d.files[d.file]
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	d.data, d.off, d.recOff = data, 0, 0
+	return nil
+}
+
+// frameAt decodes the record framed at off and validates its data, without
+// checking its CRC. It returns the offset following the frame.
+func (d *resyncDecoder) frameAt(off int64, rec *walpb.Record) (int64, error) {
+	rec.Reset()
+	if off+8 > int64(len(d.data)) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	lenField := binary.LittleEndian.Uint64(d.data[off:])
+	if lenField == 0 {
+		return 0, errZeroFrame
+	}
+	recBytes := int64(lenField &^ (uint64(0xff) << 56))
+	padBytes := (8 - recBytes%8) % 8
+	wantPad := uint64(0)
+	if padBytes != 0 {
+		wantPad = uint64(0x80 | padBytes)
+	}
+	if lenField>>56 != wantPad {
+		return 0, fmt.Errorf("invalid record frame %#x", lenField)
+	}
+	end := off + 8 + recBytes + padBytes
+	if end > int64(len(d.data)) {
+		return 0, fmt.Errorf("%w: record of %d bytes exceeds the segment", io.ErrUnexpectedEOF, recBytes)
+	}
+	if err := rec.Unmarshal(d.data[off+8 : off+8+recBytes]); err != nil {
+		return 0, err
+	}
+	return end, validateRecordData(rec)
+}
+
+// validateRecordData checks that the data of the record can be unmarshaled,
+// so that the records returned by the decoder can be unmarshaled without
+// panicking.
+func validateRecordData(rec *walpb.Record) error {
+	var err error
+	switch rec.Type {
+	case wal.EntryType:
+		var e raftpb.Entry
+		if err = e.Unmarshal(rec.Data); err != nil {
+			err = fmt.Errorf("cannot unmarshal entry: %w", err)
+		}
+	case wal.StateType:
+		var state raftpb.HardState
+		if err = state.Unmarshal(rec.Data); err != nil {
+			err = fmt.Errorf("cannot unmarshal hard state: %w", err)
+		}
+	case wal.SnapshotType:
+		var snap walpb.Snapshot
+		if err = snap.Unmarshal(rec.Data); err != nil {
+			err = fmt.Errorf("cannot unmarshal snapshot: %w", err)
+		}
+	case wal.MetadataType, wal.CrcType:
+	default:
+		err = fmt.Errorf("unexpected block type %d", rec.Type)
+	}
+	return err
+}
+
+// checkCRC checks that the CRC of the record chains from the previous
+// records, and advances the chain.
+func (d *resyncDecoder) checkCRC(rec *walpb.Record) error {
+	if rec.Type == wal.CrcType {
+		// the CRC chain starts from 0 in the first segment of a WAL whose
+		// older segments were purged
+		if d.crc != 0 && rec.Validate(d.crc) != nil {
+			return wal.ErrCRCMismatch
+		}
+		d.crc = rec.Crc
+		return nil
+	}
+	crc := crc32.Update(d.crc, crcTable, rec.Data)
+	if rec.Validate(crc) != nil {
+		return wal.ErrCRCMismatch
+	}
+	d.crc = crc
+	return nil
+}
+
+// resync decodes into rec the first valid record at or after off, and
+// restarts the CRC chain from it. It returns the offsets of the record and
+// of the frame following it. If no valid record follows, it returns the size
+// of the segment and false.
+func (d *resyncDecoder) resync(off int64, rec *walpb.Record) (int64, int64, bool) {
+	var next walpb.Record
+	saved := d.crc
+	for ; off+8 <= int64(len(d.data)); off += 8 {
+		end, err := d.frameAt(off, rec)
+		if err != nil {
+			continue
+		}
+		// confirm the candidate by the record following it, which is
+		// unlikely to chain from a frame found in the middle of some data
+		d.crc = rec.Crc
+		_, err = d.frameAt(end, &next)
+		if errors.Is(err, errZeroFrame) || end == int64(len(d.data)) || (err == nil && d.checkCRC(&next) == nil) {
+			d.crc = rec.Crc
+			return off, end, true
+		}
+	}
+	d.crc = saved
+	rec.Reset()
+	return int64(len(d.data)), int64(len(d.data)), false
+}
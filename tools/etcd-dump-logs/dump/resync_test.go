@@ -0,0 +1,132 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+)
+
+func TestReaderSkipCorrupt(t *testing.T) {
+	tcs := []struct {
+		name string
+		// corrupt corrupts the frame of the record of the given size at off.
+		corrupt func(data []byte, off int64, size int)
+		// wantErr is the error of Scan without skipping the corrupt records,
+		// which stops at the corrupt record silently if not set.
+		wantErr error
+	}{
+		{
+			name:    "crc mismatch",
+			corrupt: func(data []byte, off int64, size int) { data[off+8+int64(size)/2] ^= 0xff },
+			wantErr: wal.ErrCRCMismatch,
+		},
+		{
+			name:    "invalid frame",
+			corrupt: func(data []byte, off int64, size int) { copy(data[off:], []byte{1, 2, 3, 4, 5, 6, 7, 8}) },
+		},
+		{
+			name: "torn write",
+			corrupt: func(data []byte, off int64, size int) {
+				clear(data[off : off+8+int64(size)])
+			},
+			wantErr: wal.ErrCRCMismatch,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			names := createSegmentedWAL(t, dir)
+			path := filepath.Join(dir, names[1])
+			offsets, sizes := recordOffsets(t, path)
+			require.Greater(t, len(offsets), 2)
+
+			r, err := NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+			require.NoError(t, err)
+			require.NoError(t, r.Scan())
+			all := readEntries(t, r)
+			var want []uint64
+			var corrupted uint64
+			it, err := r.Entries()
+			require.NoError(t, err)
+			for it.Next() {
+				e := it.Entry()
+				if e.Segment == names[1] && e.Offset == offsets[1] {
+					corrupted = e.Index
+					continue
+				}
+				want = append(want, e.Index)
+			}
+			require.NoError(t, it.Close())
+			require.NotZero(t, corrupted)
+			require.Len(t, want, len(all)-1)
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			tc.corrupt(data, offsets[1], sizes[1])
+			require.NoError(t, os.WriteFile(path, data, 0o600))
+
+			r, err = NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+			require.NoError(t, err)
+			// the entries after the corrupt record are not read
+			if err = r.Scan(); tc.wantErr != nil {
+				assert.True(t, errors.Is(err, tc.wantErr), err)
+			} else {
+				require.NoError(t, err)
+				assert.Less(t, r.Count(), len(want))
+			}
+
+			r, err = NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+			require.NoError(t, err)
+			var skipped []SkippedRange
+			r.SetSkipCorrupt(func(s SkippedRange) { skipped = append(skipped, s) })
+			require.NoError(t, r.Scan())
+			require.Len(t, skipped, 1)
+			assert.Equal(t, names[1], skipped[0].Segment)
+			assert.Equal(t, offsets[1], skipped[0].Offset)
+			// the reader resynchronizes on the record following the corrupt one
+			assert.Equal(t, offsets[1]+8+int64(sizes[1]+(8-sizes[1]%8)%8), skipped[0].End)
+			assert.Equal(t, len(want), r.Count())
+			assert.Equal(t, want[len(want)-1], r.LastIndex())
+
+			var got []uint64
+			for _, e := range readEntries(t, r) {
+				got = append(got, e.Index)
+			}
+			assert.Equal(t, want, got)
+			assert.Len(t, skipped, 1, "Entries must not report the skipped ranges again")
+
+			skipped = nil
+			it = r.ReverseEntries()
+			got = nil
+			for it.Next() {
+				got = append([]uint64{it.Entry().Index}, got...)
+			}
+			require.NoError(t, it.Err())
+			require.NoError(t, it.Close())
+			assert.Equal(t, want, got)
+			assert.Len(t, skipped, 1)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+)
+
+// Source is a local directory holding a data directory or WAL directory
+// given as a path, archive or object storage URL.
+type Source struct {
+	// Dir is the local directory of the source.
+	Dir string
+	// tmpDir is the temporary directory the source is extracted into, or
+	// empty if the source is a local directory.
+	tmpDir string
+}
+
+// OpenSource returns the local directory of the data directory or WAL
+// directory at p, which is one of:
+//
+//   - a local directory;
+//   - a .tar.gz, .tgz or .zip archive;
+//   - an s3://bucket/key or gs://bucket/key URL of such an archive, or of a
+//     prefix of objects laid out as a directory.
+//
+// Archives and objects are extracted into a temporary directory removed by
+// Close, tar.gz archives being streamed from object storage rather than
+// downloaded first. If the extracted files are all under a single top
+// directory other than member, Dir is that directory.
+func OpenSource(ctx context.Context, p string) (*Source, error) {
+	u, err := url.Parse(p)
+	if err != nil || (u.Scheme != "s3" && u.Scheme != "gs") {
+		if !isArchive(p) {
+			return &Source{Dir: p}, nil
+		}
+		return openSource(func(dir string) error { return extractArchiveFile(p, dir) })
+	}
+
+	store, err := newObjectStore(u)
+	if err != nil {
+		return nil, err
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	return openSource(func(dir string) error {
+		if isArchive(key) {
+			return downloadArchive(ctx, store, key, dir)
+		}
+		return downloadPrefix(ctx, store, key, dir)
+	})
+}
+
+func openSource(extract func(dir string) error) (*Source, error) {
+	tmpDir, err := os.MkdirTemp("", "etcd-dump-logs-*")
+	if err != nil {
+		return nil, err
+	}
+	s := &Source{tmpDir: tmpDir}
+	if err = extract(tmpDir); err == nil {
+		s.Dir, err = topDir(tmpDir)
+	}
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close removes the files extracted from the archive or object storage.
+func (s *Source) Close() error {
+	if s.tmpDir == "" {
+		return nil
+	}
+	return os.RemoveAll(s.tmpDir)
+}
+
+func isArchive(name string) bool {
+	return strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz") || strings.HasSuffix(name, ".zip")
+}
+
+// topDir descends into the single directory of dir as long as it is the
+// only entry, and is not the member directory of a data directory.
+func topDir(dir string) (string, error) {
+	for {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return "", err
+		}
+		if len(entries) != 1 || !entries[0].IsDir() || entries[0].Name() == "member" {
+			return dir, nil
+		}
+		dir = filepath.Join(dir, entries[0].Name())
+	}
+}
+
+func extractArchiveFile(p, dir string) error {
+	if strings.HasSuffix(p, ".zip") {
+		return extractZip(p, dir)
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return extractTarGz(f, dir)
+}
+
+// extractTarGz extracts the directories and regular files of the tar.gz
+// archive read from r into dir.
+func extractTarGz(r io.Reader, dir string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch h.Typeflag {
+		case tar.TypeDir:
+			err = extractDir(dir, h.Name)
+		case tar.TypeReg:
+			err = extractFile(dir, h.Name, tr)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// extractZip extracts the directories and regular files of the zip archive
+// p into dir.
+func extractZip(p, dir string) error {
+	zr, err := zip.OpenReader(p)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		switch mode := f.Mode(); {
+		case mode.IsDir():
+			err = extractDir(dir, f.Name)
+		case mode.IsRegular():
+			err = extractZipFile(dir, f)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractZipFile(dir string, f *zip.File) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return extractFile(dir, f.Name, rc)
+}
+
+// localPath returns the path of the archive entry or object name in dir,
+// rejecting the names escaping it.
+func localPath(dir, name string) (string, error) {
+	name = strings.TrimSuffix(name, "/")
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return "", fmt.Errorf("invalid file name %q", name)
+	}
+	return filepath.Join(dir, filepath.FromSlash(name)), nil
+}
+
+func extractDir(dir, name string) error {
+	p, err := localPath(dir, name)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(p, fileutil.PrivateDirMode)
+}
+
+func extractFile(dir, name string, r io.Reader) error {
+	p, err := localPath(dir, name)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(p), fileutil.PrivateDirMode); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fileutil.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// downloadArchive extracts the archive object key into dir. tar.gz archives
+// are extracted as they are read, zip archives, which are read from their
+// end, are downloaded first.
+func downloadArchive(ctx context.Context, store objectStore, key, dir string) error {
+	body, err := store.get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if !strings.HasSuffix(key, ".zip") {
+		return extractTarGz(body, dir)
+	}
+
+	f, err := os.CreateTemp("", "etcd-dump-logs-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = io.Copy(f, body); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return extractZip(f.Name(), dir)
+}
+
+// downloadPrefix downloads the objects under the prefix key into dir, at
+// their path relative to the prefix.
+func downloadPrefix(ctx context.Context, store objectStore, key, dir string) error {
+	prefix := key
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	keys, err := store.list(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no object found under %q", prefix)
+	}
+	for _, k := range keys {
+		if strings.HasSuffix(k, "/") {
+			continue
+		}
+		if err = downloadObject(ctx, store, k, dir, strings.TrimPrefix(k, prefix)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downloadObject(ctx context.Context, store objectStore, key, dir, name string) error {
+	body, err := store.get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return extractFile(dir, name, body)
+}
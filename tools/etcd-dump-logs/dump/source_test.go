@@ -0,0 +1,149 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sourceFiles = map[string]string{
+	"default.etcd/member/wal/0000000000000000-0000000000000000.wal": "wal",
+	"default.etcd/member/snap/db":                                   "db",
+}
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))}))
+		_, err := io.WriteString(tw, content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = io.WriteString(w, content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// assertSource checks that the source holds the member directory of
+// sourceFiles, and that Close removes it.
+func assertSource(t *testing.T, s *Source) {
+	for name, content := range sourceFiles {
+		b, err := os.ReadFile(filepath.Join(s.Dir, strings.TrimPrefix(name, "default.etcd/")))
+		require.NoError(t, err)
+		assert.Equal(t, content, string(b))
+	}
+	require.NoError(t, s.Close())
+	assert.NoDirExists(t, s.Dir)
+}
+
+func TestOpenSourceArchive(t *testing.T) {
+	dir := t.TempDir()
+	for name, archive := range map[string][]byte{
+		"backup.tar.gz": tarGz(t, sourceFiles),
+		"backup.tgz":    tarGz(t, sourceFiles),
+		"backup.zip":    zipArchive(t, sourceFiles),
+	} {
+		t.Run(name, func(t *testing.T) {
+			p := filepath.Join(dir, name)
+			require.NoError(t, os.WriteFile(p, archive, 0o600))
+			s, err := OpenSource(context.Background(), p)
+			require.NoError(t, err)
+			assert.Equal(t, "default.etcd", filepath.Base(s.Dir))
+			assertSource(t, s)
+		})
+	}
+}
+
+func TestOpenSourceDirectory(t *testing.T) {
+	dir := t.TempDir()
+	s, err := OpenSource(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, dir, s.Dir)
+	require.NoError(t, s.Close())
+	assert.DirExists(t, dir)
+}
+
+func TestOpenSourceInvalidArchive(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, os.WriteFile(p, tarGz(t, map[string]string{"../member/wal/0.wal": "wal"}), 0o600))
+	_, err := OpenSource(context.Background(), p)
+	require.ErrorContains(t, err, `invalid file name "../member/wal/0.wal"`)
+}
+
+func TestOpenSourceObjectStorage(t *testing.T) {
+	objects := map[string][]byte{
+		"backups/node1.tar.gz": tarGz(t, sourceFiles),
+		"backups/node1.zip":    zipArchive(t, sourceFiles),
+	}
+	for name, content := range sourceFiles {
+		objects["backups/node1/"+name] = []byte(content)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+		if key != "" {
+			w.Write(objects[key])
+			return
+		}
+		io.WriteString(w, "<ListBucketResult>")
+		for k := range objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				io.WriteString(w, "<Contents><Key>"+k+"</Key></Contents>")
+			}
+		}
+		io.WriteString(w, "</ListBucketResult>")
+	}))
+	defer srv.Close()
+	t.Setenv("AWS_ENDPOINT_URL", srv.URL)
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+
+	for _, u := range []string{"s3://bucket/backups/node1.tar.gz", "s3://bucket/backups/node1.zip", "s3://bucket/backups/node1"} {
+		t.Run(u, func(t *testing.T) {
+			s, err := OpenSource(context.Background(), u)
+			require.NoError(t, err)
+			assertSource(t, s)
+		})
+	}
+
+	_, err := OpenSource(context.Background(), "s3://bucket/backups/node2")
+	require.ErrorContains(t, err, `no object found under "backups/node2/"`)
+}
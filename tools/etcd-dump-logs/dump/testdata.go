@@ -0,0 +1,474 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// The kinds of entries written by GenerateTestdata.
+const (
+	TestdataPut         = "put"
+	TestdataDeleteRange = "delete-range"
+	TestdataTxn         = "txn"
+	TestdataLeaseGrant  = "lease-grant"
+	TestdataLeaseRevoke = "lease-revoke"
+	TestdataCompaction  = "compaction"
+	TestdataConfChange  = "conf-change"
+	// TestdataUnknown entries hold data that cannot be decoded.
+	TestdataUnknown = "unknown"
+)
+
+// TestdataEntryKinds are the kinds of entries of the mixes of GenerateTestdata.
+var TestdataEntryKinds = []string{
+	TestdataPut, TestdataDeleteRange, TestdataTxn, TestdataLeaseGrant,
+	TestdataLeaseRevoke, TestdataCompaction, TestdataConfChange, TestdataUnknown,
+}
+
+// DefaultTestdataMix is the default mix of the entries of GenerateTestdata.
+const DefaultTestdataMix = "put=60,delete-range=10,txn=10,lease-grant=5,lease-revoke=5,compaction=5,conf-change=5"
+
+// The corruptions GenerateTestdata can inject in the WAL.
+const (
+	// CorruptBadCRC flips a data byte of the entry record a third into the
+	// WAL, so that it fails its CRC check.
+	CorruptBadCRC = "bad-crc"
+	// CorruptTruncatedTail cuts the last entry record of the last segment in
+	// half, like a torn write.
+	CorruptTruncatedTail = "truncated-tail"
+	// CorruptTermRegression writes the first entry after the middle of the
+	// WAL that follows an entry of a term greater than 1 with a lower term.
+	CorruptTermRegression = "term-regression"
+)
+
+// TestdataCorruptions are the corruptions GenerateTestdata can inject.
+var TestdataCorruptions = []string{CorruptBadCRC, CorruptTruncatedTail, CorruptTermRegression}
+
+// ParseTestdataMix parses an entry mix of comma separated kind=weight pairs,
+// for instance "put=3,txn=1".
+func ParseTestdataMix(s string) (map[string]int, error) {
+	mix := make(map[string]int)
+	for _, p := range strings.Split(s, ",") {
+		kind, weight, ok := strings.Cut(strings.TrimSpace(p), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid entry mix %q, must be comma separated kind=weight pairs", s)
+		}
+		if !slices.Contains(TestdataEntryKinds, kind) {
+			return nil, fmt.Errorf("unknown entry kind %q, must be one of %s", kind, strings.Join(TestdataEntryKinds, ", "))
+		}
+		w, err := strconv.Atoi(weight)
+		if err != nil || w < 0 {
+			return nil, fmt.Errorf("invalid weight %q of the %s entries, must be a non-negative integer", weight, kind)
+		}
+		mix[kind] = w
+	}
+	return mix, nil
+}
+
+// TestdataConfig configures the fixture generated by GenerateTestdata.
+type TestdataConfig struct {
+	// Entries is the number of entries written after the snapshot.
+	Entries int
+	// Mix is the relative weight of each kind of entry, keyed by the kinds
+	// of TestdataEntryKinds.
+	Mix map[string]int
+	// Seed seeds the choice of the entries, so that the same configuration
+	// generates the same fixture.
+	Seed int64
+	// TermLength is the number of entries of each term.
+	TermLength int
+	// SnapshotIndex, if set, is the index of a snapshot written to the snap
+	// directory and recorded in the WAL, the entries following it.
+	SnapshotIndex uint64
+	// SegmentSize is the size the WAL segments are cut at, or
+	// wal.SegmentSizeBytes if 0. Since it is set through
+	// wal.SegmentSizeBytes while generating, fixtures with different sizes
+	// must not be generated concurrently.
+	SegmentSize int64
+	// ValueSize is the size of the values written by the put requests.
+	ValueSize int
+	// Corruptions are the corruptions injected in the WAL, among
+	// TestdataCorruptions.
+	Corruptions []string
+}
+
+// InjectedCorruption locates a corruption injected by GenerateTestdata.
+type InjectedCorruption struct {
+	Kind string
+	// Index is the index of the entry of the corrupted record.
+	Index   uint64
+	Segment string
+	Offset  int64
+}
+
+// TestdataResult describes the fixture generated by GenerateTestdata.
+type TestdataResult struct {
+	FirstIndex uint64
+	LastIndex  uint64
+	// Segments are the names of the WAL segments.
+	Segments []string
+	Injected []InjectedCorruption
+}
+
+// GenerateTestdata writes a WAL, and a snapshot if cfg.SnapshotIndex is set,
+// to a new data directory, with cfg.Entries entries drawn from the mix of cfg
+// and the requested corruptions injected, so that the edge cases of tools
+// reading WALs can be exercised reproducibly.
+func GenerateTestdata(lg *zap.Logger, dataDir string, cfg TestdataConfig) (*TestdataResult, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if names, err := fileutil.ReadDir(dataDir); err == nil && len(names) != 0 {
+		return nil, fmt.Errorf("%s is not empty", dataDir)
+	}
+	walDir := filepath.Join(dataDir, "member", "wal")
+	snapDir := filepath.Join(dataDir, "member", "snap")
+	if err := fileutil.TouchDirAll(lg, snapDir); err != nil {
+		return nil, err
+	}
+
+	if cfg.SegmentSize != 0 {
+		defer func(size int64) { wal.SegmentSizeBytes = size }(wal.SegmentSizeBytes)
+		wal.SegmentSizeBytes = cfg.SegmentSize
+	}
+	metadata := pbutil.MustMarshal(&etcdserverpb.Metadata{NodeID: 1, ClusterID: 0x1000})
+	w, err := wal.Create(lg, walDir, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	g := newTestdataGenerator(cfg)
+	result := &TestdataResult{FirstIndex: cfg.SnapshotIndex + 1, LastIndex: cfg.SnapshotIndex + uint64(cfg.Entries)}
+	if err := g.write(lg, w, snapDir, result); err != nil {
+		w.Close()
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	if result.Segments, err = fileutil.ReadDir(walDir, fileutil.WithExt(".wal")); err != nil {
+		return nil, err
+	}
+	if err := injectRecordCorruptions(walDir, cfg.Corruptions, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (cfg *TestdataConfig) validate() error {
+	if cfg.Entries <= 0 {
+		return errors.New("the number of entries must be positive")
+	}
+	if cfg.TermLength <= 0 {
+		return errors.New("the term length must be positive")
+	}
+	if cfg.ValueSize < 0 {
+		return errors.New("the value size must not be negative")
+	}
+	var total int
+	for kind, w := range cfg.Mix {
+		if !slices.Contains(TestdataEntryKinds, kind) {
+			return fmt.Errorf("unknown entry kind %q", kind)
+		}
+		total += w
+	}
+	if total == 0 {
+		return errors.New("the entry mix must have a positive weight")
+	}
+	for _, c := range cfg.Corruptions {
+		if !slices.Contains(TestdataCorruptions, c) {
+			return fmt.Errorf("unknown corruption %q, must be one of %s", c, strings.Join(TestdataCorruptions, ", "))
+		}
+	}
+	if len(cfg.Corruptions) != 0 && cfg.Entries < 3 {
+		return errors.New("injecting corruptions requires at least 3 entries")
+	}
+	return nil
+}
+
+type testdataGenerator struct {
+	cfg   TestdataConfig
+	rand  *rand.Rand
+	kinds []string
+	// weights are the cumulative weights of kinds.
+	weights []int
+
+	requestID uint64
+	leaseID   int64
+	// leases are the leases granted and not revoked yet.
+	leases []int64
+	// members are the IDs of the members added by the configuration changes.
+	members []uint64
+}
+
+func newTestdataGenerator(cfg TestdataConfig) *testdataGenerator {
+	g := &testdataGenerator{cfg: cfg, rand: rand.New(rand.NewSource(cfg.Seed))}
+	var total int
+	// iterate over the kinds in a fixed order for the fixture to be reproducible
+	for _, kind := range TestdataEntryKinds {
+		if w := cfg.Mix[kind]; w > 0 {
+			total += w
+			g.kinds = append(g.kinds, kind)
+			g.weights = append(g.weights, total)
+		}
+	}
+	return g
+}
+
+func (g *testdataGenerator) term(index uint64) uint64 {
+	return 1 + (index-1)/uint64(g.cfg.TermLength)
+}
+
+func (g *testdataGenerator) write(lg *zap.Logger, w *wal.WAL, snapDir string, result *TestdataResult) error {
+	if index := g.cfg.SnapshotIndex; index != 0 {
+		snapshot := raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{
+			Index:     index,
+			Term:      g.term(index),
+			ConfState: raftpb.ConfState{Voters: []uint64{1}},
+		}}
+		if err := snap.New(lg, snapDir).SaveSnap(snapshot); err != nil {
+			return err
+		}
+		err := w.SaveSnapshot(walpb.Snapshot{Index: index, Term: g.term(index), ConfState: &snapshot.Metadata.ConfState})
+		if err != nil {
+			return err
+		}
+	}
+
+	regressed := uint64(0)
+	if slices.Contains(g.cfg.Corruptions, CorruptTermRegression) {
+		middle := result.FirstIndex + uint64(g.cfg.Entries)/2
+		for i := middle; i <= result.LastIndex; i++ {
+			if g.term(i-1) > 1 {
+				regressed = i
+				break
+			}
+		}
+		if regressed == 0 {
+			return errors.New("injecting a term regression requires an entry of a term greater than 1 in the second half of the entries")
+		}
+	}
+
+	for index := result.FirstIndex; index <= result.LastIndex; index++ {
+		e := g.entry(index)
+		if index == regressed {
+			e.Term = g.term(index-1) - 1
+			result.Injected = append(result.Injected, InjectedCorruption{Kind: CorruptTermRegression, Index: index})
+		}
+		if err := w.Save(raftpb.HardState{Term: g.term(index), Vote: 1, Commit: index}, []raftpb.Entry{e}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *testdataGenerator) entry(index uint64) raftpb.Entry {
+	e := raftpb.Entry{Term: g.term(index), Index: index, Type: raftpb.EntryNormal}
+	n := g.rand.Intn(g.weights[len(g.weights)-1])
+	kind := g.kinds[0]
+	for i, w := range g.weights {
+		if n < w {
+			kind = g.kinds[i]
+			break
+		}
+	}
+	if kind == TestdataLeaseRevoke && len(g.leases) == 0 {
+		kind = TestdataLeaseGrant
+	}
+
+	g.requestID++
+	r := etcdserverpb.InternalRaftRequest{Header: &etcdserverpb.RequestHeader{ID: g.requestID}}
+	switch kind {
+	case TestdataPut:
+		r.Put = &etcdserverpb.PutRequest{Key: g.key(), Value: g.value()}
+		if len(g.leases) != 0 && g.rand.Intn(4) == 0 {
+			r.Put.Lease = g.leases[g.rand.Intn(len(g.leases))]
+		}
+	case TestdataDeleteRange:
+		prefix := g.prefix()
+		r.DeleteRange = &etcdserverpb.DeleteRangeRequest{Key: prefix, RangeEnd: append(prefix[:len(prefix)-1:len(prefix)-1], prefix[len(prefix)-1]+1)}
+	case TestdataTxn:
+		key := g.key()
+		r.Txn = &etcdserverpb.TxnRequest{
+			Compare: []*etcdserverpb.Compare{{
+				Key:         key,
+				Target:      etcdserverpb.Compare_VERSION,
+				Result:      etcdserverpb.Compare_EQUAL,
+				TargetUnion: &etcdserverpb.Compare_Version{Version: 0},
+			}},
+			Success: []*etcdserverpb.RequestOp{{Request: &etcdserverpb.RequestOp_RequestPut{
+				RequestPut: &etcdserverpb.PutRequest{Key: key, Value: g.value()},
+			}}},
+			Failure: []*etcdserverpb.RequestOp{{Request: &etcdserverpb.RequestOp_RequestRange{
+				RequestRange: &etcdserverpb.RangeRequest{Key: key},
+			}}},
+		}
+	case TestdataLeaseGrant:
+		g.leaseID++
+		g.leases = append(g.leases, g.leaseID)
+		r.LeaseGrant = &etcdserverpb.LeaseGrantRequest{ID: g.leaseID, TTL: 60}
+	case TestdataLeaseRevoke:
+		i := g.rand.Intn(len(g.leases))
+		r.LeaseRevoke = &etcdserverpb.LeaseRevokeRequest{ID: g.leases[i]}
+		g.leases = slices.Delete(g.leases, i, i+1)
+	case TestdataCompaction:
+		r.Compaction = &etcdserverpb.CompactionRequest{Revision: int64(index / 2)}
+	case TestdataConfChange:
+		cc := raftpb.ConfChange{ID: g.requestID}
+		if len(g.members) == 0 || g.rand.Intn(2) == 0 {
+			cc.Type, cc.NodeID = raftpb.ConfChangeAddNode, uint64(len(g.members)+2)
+			g.members = append(g.members, cc.NodeID)
+		} else {
+			cc.Type, cc.NodeID = raftpb.ConfChangeRemoveNode, g.members[len(g.members)-1]
+			g.members = g.members[:len(g.members)-1]
+		}
+		e.Type, e.Data = raftpb.EntryConfChange, pbutil.MustMarshal(&cc)
+		return e
+	case TestdataUnknown:
+		e.Data = []byte("?")
+		return e
+	}
+	e.Data = pbutil.MustMarshal(&r)
+	return e
+}
+
+// prefix returns one of the 4 application prefixes of the keys, ending with '/'.
+func (g *testdataGenerator) prefix() []byte {
+	return fmt.Appendf(nil, "/testdata/app-%d/", g.rand.Intn(4))
+}
+
+func (g *testdataGenerator) key() []byte {
+	return fmt.Appendf(g.prefix(), "key-%03d", g.rand.Intn(100))
+}
+
+func (g *testdataGenerator) value() []byte {
+	v := make([]byte, g.cfg.ValueSize)
+	for i := range v {
+		v[i] = byte('a' + g.rand.Intn(26))
+	}
+	return v
+}
+
+type entryRecord struct {
+	index   uint64
+	segment string
+	offset  int64
+	size    int
+}
+
+// injectRecordCorruptions corrupts the records of the WAL written in walDir
+// for the bad-crc and truncated-tail corruptions.
+func injectRecordCorruptions(walDir string, corruptions []string, result *TestdataResult) error {
+	badCRC := slices.Contains(corruptions, CorruptBadCRC)
+	truncatedTail := slices.Contains(corruptions, CorruptTruncatedTail)
+	if !badCRC && !truncatedTail {
+		return nil
+	}
+	records, err := entryRecords(walDir, result.Segments)
+	if err != nil {
+		return err
+	}
+
+	if badCRC {
+		r := records[len(records)/3]
+		path := filepath.Join(walDir, r.segment)
+		f, err := os.OpenFile(path, os.O_RDWR, fileutil.PrivateFileMode)
+		if err != nil {
+			return err
+		}
+		// the data of the entry is last in the record, after its 8 byte
+		// length frame
+		b := make([]byte, 1)
+		off := r.offset + 8 + int64(r.size) - 1
+		if _, err = f.ReadAt(b, off); err == nil {
+			b[0] ^= 0xff
+			_, err = f.WriteAt(b, off)
+		}
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+		result.Injected = append(result.Injected, InjectedCorruption{Kind: CorruptBadCRC, Index: r.index, Segment: r.segment, Offset: r.offset})
+	}
+
+	if truncatedTail {
+		last := result.Segments[len(result.Segments)-1]
+		i := len(records) - 1
+		if records[i].segment != last {
+			return fmt.Errorf("the last segment %s has no entry to truncate", last)
+		}
+		r := records[i]
+		if err := os.Truncate(filepath.Join(walDir, last), r.offset+8+int64(r.size)/2); err != nil {
+			return err
+		}
+		result.Injected = append(result.Injected, InjectedCorruption{Kind: CorruptTruncatedTail, Index: r.index, Segment: r.segment, Offset: r.offset})
+	}
+	return nil
+}
+
+// entryRecords returns the locations of the entry records of the segments.
+func entryRecords(walDir string, segments []string) ([]entryRecord, error) {
+	var records []entryRecord
+	var crc uint32
+	for _, name := range segments {
+		f, err := os.Open(filepath.Join(walDir, name))
+		if err != nil {
+			return nil, err
+		}
+		decoder := wal.NewDecoder(fileutil.NewFileReader(f))
+		decoder.UpdateCRC(crc)
+		var rec walpb.Record
+		for {
+			off := decoder.LastOffset()
+			if err := decoder.Decode(&rec); err != nil {
+				break
+			}
+			switch rec.Type {
+			case wal.CrcType:
+				decoder.UpdateCRC(rec.Crc)
+			case wal.EntryType:
+				var e raftpb.Entry
+				if err := e.Unmarshal(rec.Data); err != nil {
+					f.Close()
+					return nil, err
+				}
+				records = append(records, entryRecord{index: e.Index, segment: name, offset: off, size: rec.Size()})
+			}
+			crc = rec.Crc
+		}
+		f.Close()
+	}
+	return records, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+)
+
+func testdataConfig(t *testing.T, corruptions ...string) TestdataConfig {
+	t.Helper()
+	mix, err := ParseTestdataMix(DefaultTestdataMix)
+	require.NoError(t, err)
+	return TestdataConfig{
+		Entries:     60,
+		Mix:         mix,
+		Seed:        1,
+		TermLength:  10,
+		SegmentSize: 2 * 1024,
+		ValueSize:   16,
+		Corruptions: corruptions,
+	}
+}
+
+func TestGenerateTestdata(t *testing.T) {
+	dataDir := t.TempDir()
+	cfg := testdataConfig(t)
+	cfg.SnapshotIndex = 5
+	result, err := GenerateTestdata(zaptest.NewLogger(t), dataDir, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), result.FirstIndex)
+	assert.Equal(t, uint64(65), result.LastIndex)
+	assert.Empty(t, result.Injected)
+	require.Greater(t, len(result.Segments), 1)
+
+	v, err := Verify(filepath.Join(dataDir, "member", "wal"))
+	require.NoError(t, err)
+	require.Nil(t, v.Corruption)
+	require.Nil(t, v.TornTail)
+	var entries int
+	for _, seg := range v.Segments {
+		entries += seg.Entries
+	}
+	assert.Equal(t, 60, entries)
+
+	s, err := snap.New(zaptest.NewLogger(t), filepath.Join(dataDir, "member", "snap")).Load()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), s.Metadata.Index)
+
+	// the same configuration generates the same fixture
+	again := t.TempDir()
+	_, err = GenerateTestdata(zaptest.NewLogger(t), again, cfg)
+	require.NoError(t, err)
+	for _, name := range result.Segments {
+		want, err := os.ReadFile(filepath.Join(dataDir, "member", "wal", name))
+		require.NoError(t, err)
+		got, err := os.ReadFile(filepath.Join(again, "member", "wal", name))
+		require.NoError(t, err)
+		assert.Equal(t, want, got, name)
+	}
+}
+
+func TestGenerateTestdataCorruptions(t *testing.T) {
+	tests := []struct {
+		corruption string
+		torn       bool
+		wantErr    string
+	}{
+		{corruption: CorruptBadCRC, wantErr: "crc mismatch"},
+		{corruption: CorruptTruncatedTail, torn: true},
+		{corruption: CorruptTermRegression, wantErr: "is lower than the previous term"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.corruption, func(t *testing.T) {
+			dataDir := t.TempDir()
+			result, err := GenerateTestdata(zaptest.NewLogger(t), dataDir, testdataConfig(t, tt.corruption))
+			require.NoError(t, err)
+			require.Len(t, result.Injected, 1)
+			injected := result.Injected[0]
+			assert.Equal(t, tt.corruption, injected.Kind)
+
+			v, err := Verify(filepath.Join(dataDir, "member", "wal"))
+			require.NoError(t, err)
+			if tt.torn {
+				require.Nil(t, v.Corruption)
+				require.NotNil(t, v.TornTail)
+				assert.Equal(t, injected.Segment, v.TornTail.Segment)
+				assert.Equal(t, injected.Offset, v.TornTail.Offset)
+				return
+			}
+			require.NotNil(t, v.Corruption)
+			assert.ErrorContains(t, v.Corruption, tt.wantErr)
+			if injected.Segment != "" {
+				assert.Equal(t, injected.Segment, v.Corruption.Segment)
+				assert.Equal(t, injected.Offset, v.Corruption.Offset)
+			}
+		})
+	}
+}
+
+func TestGenerateTestdataInvalidConfig(t *testing.T) {
+	_, err := ParseTestdataMix("put=1,bogus=2")
+	require.ErrorContains(t, err, "unknown entry kind")
+	_, err = ParseTestdataMix("put")
+	require.ErrorContains(t, err, "invalid entry mix")
+
+	cfg := testdataConfig(t, "bit-rot")
+	_, err = GenerateTestdata(zaptest.NewLogger(t), t.TempDir(), cfg)
+	require.ErrorContains(t, err, "unknown corruption")
+
+	cfg = testdataConfig(t, CorruptTermRegression)
+	cfg.TermLength = 1000
+	_, err = GenerateTestdata(zaptest.NewLogger(t), t.TempDir(), cfg)
+	require.ErrorContains(t, err, "term greater than 1")
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// Segment summarizes a WAL segment file checked by Verify.
+type Segment struct {
+	Name string
+	// Records is the number of valid records read from the segment.
+	Records int
+	// Entries is the number of entry records read from the segment.
+	Entries    int
+	FirstIndex uint64
+	LastIndex  uint64
+}
+
+// Corruption locates the first invalid record of a WAL.
+type Corruption struct {
+	// Segment is the name of the WAL file holding the invalid record.
+	Segment string
+	// Offset is the offset of the invalid record in the segment.
+	Offset int64
+	Err    error
+}
+
+func (c *Corruption) Error() string {
+	return fmt.Sprintf("%s at offset %d: %v", c.Segment, c.Offset, c.Err)
+}
+
+func (c *Corruption) Unwrap() error { return c.Err }
+
+// VerifyResult is the outcome of Verify.
+type VerifyResult struct {
+	// Segments are the segments read, up to and including the corrupted one.
+	Segments []Segment
+	// Corruption is the first problem found, or nil if the WAL is valid.
+	Corruption *Corruption
+	// TornTail is set if the last record of the last segment was partially
+	// written. etcd repairs such a WAL when it starts, so it is not a corruption.
+	TornTail *Corruption
+}
+
+// Verify walks all the WAL segments in dir in order and validates the CRC of
+// every record, the monotonicity of entry indexes and terms, and the
+// continuity of the segment sequence numbers and start indexes. It stops at
+// the first problem found, which is reported in the result; the returned error
+// is only set if the WAL could not be read at all.
+func Verify(dir string) (*VerifyResult, error) {
+	names, err := fileutil.ReadDir(dir, fileutil.WithExt(".wal"))
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, wal.ErrFileNotFound
+	}
+
+	v := &verifier{dir: dir, result: &VerifyResult{}}
+	for i, name := range names {
+		if c := v.verifySegment(name, i == len(names)-1); c != nil {
+			v.result.Corruption = c
+			break
+		}
+	}
+	return v.result, nil
+}
+
+type verifier struct {
+	dir    string
+	result *VerifyResult
+
+	prevSeq uint64
+	// crc is the CRC of the records read so far, used to check the CRC
+	// record at the head of the next segment.
+	crc uint32
+	// last is the last entry read.
+	last raftpb.Entry
+	// next is the smallest index the next entry may start at without leaving a gap.
+	next uint64
+	// started is set once the first entry or snapshot record was read.
+	started bool
+}
+
+func (v *verifier) verifySegment(name string, lastSegment bool) *Corruption {
+	fail := func(off int64, format string, args ...any) *Corruption {
+		return &Corruption{Segment: name, Offset: off, Err: fmt.Errorf(format, args...)}
+	}
+
+	var seq, index uint64
+	if _, err := fmt.Sscanf(name, "%016x-%016x.wal", &seq, &index); err != nil {
+		return fail(0, "bad WAL file name")
+	}
+	if len(v.result.Segments) > 0 {
+		if seq != v.prevSeq+1 {
+			return fail(0, "segment sequence %d does not follow sequence %d", seq, v.prevSeq)
+		}
+		if v.started && index > v.next {
+			return fail(0, "segment starts at index %d but the previous segments end at index %d", index, v.next-1)
+		}
+	}
+	v.prevSeq = seq
+
+	f, err := os.OpenFile(filepath.Join(v.dir, name), os.O_RDONLY, fileutil.PrivateFileMode)
+	if err != nil {
+		return fail(0, "%w", err)
+	}
+	defer f.Close()
+
+	v.result.Segments = append(v.result.Segments, Segment{Name: name})
+	seg := &v.result.Segments[len(v.result.Segments)-1]
+
+	decoder := wal.NewDecoder(fileutil.NewFileReader(f))
+	decoder.UpdateCRC(v.crc)
+	var rec walpb.Record
+	for {
+		off := decoder.LastOffset()
+		err := decoder.Decode(&rec)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if lastSegment && errors.Is(err, io.ErrUnexpectedEOF) {
+				v.result.TornTail = &Corruption{Segment: name, Offset: off, Err: err}
+				break
+			}
+			return &Corruption{Segment: name, Offset: off, Err: err}
+		}
+
+		switch rec.Type {
+		case wal.CrcType:
+			// the CRC chain starts from 0 in the first segment of a WAL whose
+			// older segments were purged
+			if v.crc != 0 && rec.Validate(v.crc) != nil {
+				return fail(off, "%w: expected %d, got %d", wal.ErrCRCMismatch, v.crc, rec.Crc)
+			}
+			decoder.UpdateCRC(rec.Crc)
+		case wal.EntryType:
+			var e raftpb.Entry
+			if err := e.Unmarshal(rec.Data); err != nil {
+				return fail(off, "cannot unmarshal entry: %w", err)
+			}
+			if v.started {
+				switch {
+				case e.Index > v.next:
+					return fail(off, "entry index %d leaves a gap after index %d", e.Index, v.next-1)
+				case e.Index == v.last.Index+1 && e.Term < v.last.Term:
+					return fail(off, "entry term %d at index %d is lower than the previous term %d", e.Term, e.Index, v.last.Term)
+				}
+			}
+			if seg.Entries == 0 {
+				seg.FirstIndex = e.Index
+			}
+			seg.Entries++
+			seg.LastIndex = e.Index
+			v.last = e
+			v.next = e.Index + 1
+			v.started = true
+		case wal.SnapshotType:
+			var snap walpb.Snapshot
+			if err := snap.Unmarshal(rec.Data); err != nil {
+				return fail(off, "cannot unmarshal snapshot: %w", err)
+			}
+			if !v.started || snap.Index+1 > v.next {
+				v.next = snap.Index + 1
+			}
+			v.started = true
+		case wal.StateType:
+			var state raftpb.HardState
+			if err := state.Unmarshal(rec.Data); err != nil {
+				return fail(off, "cannot unmarshal hard state: %w", err)
+			}
+		case wal.MetadataType:
+		default:
+			return fail(off, "unexpected block type %d", rec.Type)
+		}
+		seg.Records++
+	}
+	v.crc = decoder.LastCRC()
+	return nil
+}
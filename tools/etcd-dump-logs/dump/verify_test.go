@@ -0,0 +1,162 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// createSegmentedWAL creates a WAL of 30 entries spread over several segments
+// and returns the names of its segments.
+func createSegmentedWAL(t *testing.T, dir string) []string {
+	t.Helper()
+	defer func(size int64) { wal.SegmentSizeBytes = size }(wal.SegmentSizeBytes)
+	wal.SegmentSizeBytes = 2 * 1024
+
+	w, err := wal.Create(zaptest.NewLogger(t), dir, []byte("metadata"))
+	require.NoError(t, err)
+	for i := uint64(1); i <= 30; i++ {
+		e := raftpb.Entry{Term: 1, Index: i, Data: bytes.Repeat([]byte{'a'}, 200)}
+		require.NoError(t, w.Save(raftpb.HardState{Term: 1, Commit: i}, []raftpb.Entry{e}))
+	}
+	require.NoError(t, w.Close())
+
+	names, err := fileutil.ReadDir(dir, fileutil.WithExt(".wal"))
+	require.NoError(t, err)
+	require.Greater(t, len(names), 2)
+	return names
+}
+
+// recordOffsets returns the offsets of the entry records of the segment.
+func recordOffsets(t *testing.T, path string) (offsets []int64, sizes []int) {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	decoder := wal.NewDecoder(fileutil.NewFileReader(f))
+	var rec walpb.Record
+	for {
+		off := decoder.LastOffset()
+		if decoder.Decode(&rec) != nil {
+			return offsets, sizes
+		}
+		if rec.Type == wal.CrcType {
+			decoder.UpdateCRC(rec.Crc)
+		}
+		if rec.Type == wal.EntryType {
+			offsets = append(offsets, off)
+			sizes = append(sizes, rec.Size())
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	names := createSegmentedWAL(t, dir)
+
+	result, err := Verify(dir)
+	require.NoError(t, err)
+	require.Nil(t, result.Corruption)
+	require.Nil(t, result.TornTail)
+	require.Len(t, result.Segments, len(names))
+
+	var entries int
+	for i, seg := range result.Segments {
+		assert.Equal(t, names[i], seg.Name)
+		entries += seg.Entries
+	}
+	assert.Equal(t, 30, entries)
+	assert.Equal(t, uint64(1), result.Segments[0].FirstIndex)
+	assert.Equal(t, uint64(30), result.Segments[len(names)-1].LastIndex)
+}
+
+func TestVerifyCRCMismatch(t *testing.T) {
+	dir := t.TempDir()
+	names := createSegmentedWAL(t, dir)
+
+	// flip the last data byte of the second entry of the second segment
+	path := filepath.Join(dir, names[1])
+	offsets, sizes := recordOffsets(t, path)
+	require.Greater(t, len(offsets), 1)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[offsets[1]+8+int64(sizes[1])-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	result, err := Verify(dir)
+	require.NoError(t, err)
+	require.NotNil(t, result.Corruption)
+	assert.Equal(t, names[1], result.Corruption.Segment)
+	assert.Equal(t, offsets[1], result.Corruption.Offset)
+	require.ErrorIs(t, result.Corruption, walpb.ErrCRCMismatch)
+	assert.Len(t, result.Segments, 2)
+}
+
+func TestVerifyMissingSegment(t *testing.T) {
+	dir := t.TempDir()
+	names := createSegmentedWAL(t, dir)
+	require.NoError(t, os.Remove(filepath.Join(dir, names[1])))
+
+	result, err := Verify(dir)
+	require.NoError(t, err)
+	require.NotNil(t, result.Corruption)
+	assert.Equal(t, names[2], result.Corruption.Segment)
+	assert.Equal(t, int64(0), result.Corruption.Offset)
+	assert.Contains(t, result.Corruption.Error(), "segment sequence 2 does not follow sequence 0")
+}
+
+func TestVerifyTornTail(t *testing.T) {
+	dir := t.TempDir()
+	names := createSegmentedWAL(t, dir)
+
+	// cut the last entry record of the last segment in half
+	path := filepath.Join(dir, names[len(names)-1])
+	offsets, sizes := recordOffsets(t, path)
+	last := len(offsets) - 1
+	require.NoError(t, os.Truncate(path, offsets[last]+8+int64(sizes[last])/2))
+
+	result, err := Verify(dir)
+	require.NoError(t, err)
+	require.Nil(t, result.Corruption)
+	require.NotNil(t, result.TornTail)
+	assert.Equal(t, names[len(names)-1], result.TornTail.Segment)
+	assert.Equal(t, offsets[last], result.TornTail.Offset)
+}
+
+func TestVerifyIndexGap(t *testing.T) {
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Term: 1, Index: 1}, {Term: 1, Index: 2}}))
+	require.NoError(t, w.Save(raftpb.HardState{}, []raftpb.Entry{{Term: 1, Index: 4}}))
+	require.NoError(t, w.Close())
+
+	result, err := Verify(dir)
+	require.NoError(t, err)
+	require.NotNil(t, result.Corruption)
+	assert.Contains(t, result.Corruption.Error(), "entry index 4 leaves a gap after index 2")
+}
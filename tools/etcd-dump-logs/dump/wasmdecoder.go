@@ -0,0 +1,127 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// WASMDecoder decodes the entries with a WebAssembly module. The module is
+// instantiated once, with the WASI preview 1 imports, and must export:
+//
+//	memory                       its linear memory
+//	alloc(size i32) i32          allocating size bytes for the data of an entry
+//	decode(ptr i32, size i32) i64
+//
+// decode returns the address of the output in the upper 32 bits and its size
+// in the lower 32 bits. The first byte of the output is 0 if the data was
+// decoded, followed by the decoded data, and 1 otherwise, followed by the error
+// message. The output must stay valid until the next call. If the module
+// exports free(ptr i32, size i32), it is called to release the data of each
+// entry once it is decoded, and if it exports _initialize, as the reactors
+// built by Go and Rust do, it is called once the module is instantiated.
+type WASMDecoder struct {
+	ctx     context.Context
+	runtime wazero.Runtime
+	mod     api.Module
+
+	alloc, decode, free api.Function
+}
+
+// OpenWASMDecoder compiles and instantiates the WebAssembly module of the
+// given file. The module writes to the stderr of etcd-dump-logs.
+func OpenWASMDecoder(path string) (*WASMDecoder, error) {
+	bin, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	r := wazero.NewRuntime(ctx)
+	d, err := newWASMDecoder(ctx, r, bin)
+	if err != nil {
+		r.Close(ctx)
+		return nil, fmt.Errorf("failed to instantiate decoder module %s: %w", path, err)
+	}
+	return d, nil
+}
+
+func newWASMDecoder(ctx context.Context, r wazero.Runtime, bin []byte) (*WASMDecoder, error) {
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		return nil, err
+	}
+	compiled, err := r.CompileModule(ctx, bin)
+	if err != nil {
+		return nil, err
+	}
+	mod, err := r.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().
+		WithStartFunctions("_initialize").
+		WithStderr(os.Stderr))
+	if err != nil {
+		return nil, err
+	}
+	d := &WASMDecoder{
+		ctx:     ctx,
+		runtime: r,
+		mod:     mod,
+		alloc:   mod.ExportedFunction("alloc"),
+		decode:  mod.ExportedFunction("decode"),
+		free:    mod.ExportedFunction("free"),
+	}
+	if mod.Memory() == nil || d.alloc == nil || d.decode == nil {
+		return nil, errors.New("the module must export memory, alloc and decode")
+	}
+	return d, nil
+}
+
+func (d *WASMDecoder) Decode(data []byte) ([]byte, error) {
+	res, err := d.alloc.Call(d.ctx, uint64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("alloc: %w", err)
+	}
+	ptr := uint32(res[0])
+	if !d.mod.Memory().Write(ptr, data) {
+		return nil, fmt.Errorf("alloc returned %d, out of the memory of the module", ptr)
+	}
+	res, err = d.decode.Call(d.ctx, uint64(ptr), uint64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	if d.free != nil {
+		if _, err = d.free.Call(d.ctx, uint64(ptr), uint64(len(data))); err != nil {
+			return nil, fmt.Errorf("free: %w", err)
+		}
+	}
+	out, ok := d.mod.Memory().Read(uint32(res[0]>>32), uint32(res[0]))
+	if !ok || len(out) == 0 {
+		return nil, fmt.Errorf("decode returned %d bytes at %d, out of the memory of the module", uint32(res[0]), uint32(res[0]>>32))
+	}
+	// out is a view of the memory of the module, overwritten by the next call
+	if out[0] != 0 {
+		return nil, errors.New(string(out[1:]))
+	}
+	return append([]byte(nil), out[1:]...), nil
+}
+
+// Close releases the module and its runtime.
+func (d *WASMDecoder) Close() error {
+	return d.runtime.Close(d.ctx)
+}
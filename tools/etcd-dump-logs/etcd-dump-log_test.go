@@ -15,6 +15,15 @@
 package main
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
 	"os"
 	"os/exec"
 	"path"
@@ -25,15 +34,27 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
 
+	bolt "go.etcd.io/bbolt"
 	"go.etcd.io/etcd/api/v3/authpb"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/membership"
+	"go.etcd.io/etcd/server/v3/storage/schema"
 	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
 	"go.etcd.io/raft/v3/raftpb"
 )
 
+const (
+	methodSync   string = "SYNC"
+	methodQGet   string = "QGET"
+	methodDelete string = "DELETE"
+	methodRandom string = "RANDOM"
+)
+
 func TestEtcdDumpLogEntryType(t *testing.T) {
 	// directory where the command is
 	binDir, err := os.Getwd()
@@ -48,9 +69,19 @@ func TestEtcdDumpLogEntryType(t *testing.T) {
 	decoderCorrectOutputFormat := filepath.Join(binDir, "/testdecoder/decoder_correctoutputformat.sh")
 	decoderWrongOutputFormat := filepath.Join(binDir, "/testdecoder/decoder_wrongoutputformat.sh")
 
+	grpcDecoder := startGRPCDecoder(t)
+
 	p := t.TempDir()
 
 	mustCreateWALLog(t, p)
+	v2 := t.TempDir()
+	mustCreateConfChangeV2WALLog(t, v2)
+	withMembers := t.TempDir()
+	mustCreateWALLog(t, withMembers)
+	mustCreateMembersDB(t, withMembers)
+	archives := t.TempDir()
+	dataDirArchive := mustCreateArchive(t, p, filepath.Join(archives, "default.etcd.tar.gz"), "default.etcd")
+	walDirArchive := mustCreateArchive(t, walDir(p), filepath.Join(archives, "wal.zip"), "")
 
 	argtests := []struct {
 		name         string
@@ -58,7 +89,12 @@ func TestEtcdDumpLogEntryType(t *testing.T) {
 		fileExpected string
 	}{
 		{"no entry-type", []string{p}, "expectedoutput/listAll.output"},
+		{"data-dir archive", []string{dataDirArchive}, "expectedoutput/listAll.output"},
+		{"wal-dir archive", []string{"-wal-dir", walDirArchive, p}, "expectedoutput/listAll.output"},
 		{"confchange entry-type", []string{"-entry-type", "ConfigChange", p}, "expectedoutput/listConfigChange.output"},
+		{"confchange entry-type with members", []string{"-entry-type", "ConfigChange", withMembers}, "expectedoutput/listConfigChangeMembers.output"},
+		{"confchange entry-type with v2", []string{"-entry-type", "ConfigChange", v2}, "expectedoutput/listConfigChangeWithV2.output"},
+		{"confchangev2 entry-type", []string{"-entry-type", "ConfigChangeV2", v2}, "expectedoutput/listConfigChangeV2.output"},
 		{"normal entry-type", []string{"-entry-type", "Normal", p}, "expectedoutput/listNormal.output"},
 		{"request entry-type", []string{"-entry-type", "Request", p}, "expectedoutput/listRequest.output"},
 		{"internalRaftRequest entry-type", []string{"-entry-type", "InternalRaftRequest", p}, "expectedoutput/listInternalRaftRequest.output"},
@@ -66,12 +102,36 @@ func TestEtcdDumpLogEntryType(t *testing.T) {
 		{"put entry-type", []string{"-entry-type", "IRRPut", p}, "expectedoutput/listIRRPut.output"},
 		{"del entry-type", []string{"-entry-type", "IRRDeleteRange", p}, "expectedoutput/listIRRDeleteRange.output"},
 		{"txn entry-type", []string{"-entry-type", "IRRTxn", p}, "expectedoutput/listIRRTxn.output"},
+		{"txn entry-type pretty", []string{"-entry-type", "IRRTxn,IRRPut", "-pretty", p}, "expectedoutput/listIRRTxnPretty.output"},
 		{"compaction entry-type", []string{"-entry-type", "IRRCompaction", p}, "expectedoutput/listIRRCompaction.output"},
 		{"lease grant entry-type", []string{"-entry-type", "IRRLeaseGrant", p}, "expectedoutput/listIRRLeaseGrant.output"},
 		{"lease revoke entry-type", []string{"-entry-type", "IRRLeaseRevoke", p}, "expectedoutput/listIRRLeaseRevoke.output"},
+		{"auth entry-type", []string{"-entry-type", "IRRAuth", p}, "expectedoutput/listIRRAuth.output"},
+		{"auth user entry-type", []string{"-entry-type", "IRRAuthUserGrantRole,IRRAuthUserRevokeRole", p}, "expectedoutput/listIRRAuthUserRole.output"},
 		{"confchange and txn entry-type", []string{"-entry-type", "ConfigChange,IRRCompaction", p}, "expectedoutput/listConfigChangeIRRCompaction.output"},
 		{"decoder_correctoutputformat", []string{"-stream-decoder", decoderCorrectOutputFormat, p}, "expectedoutput/decoder_correctoutputformat.output"},
 		{"decoder_wrongoutputformat", []string{"-stream-decoder", decoderWrongOutputFormat, p}, "expectedoutput/decoder_wrongoutputformat.output"},
+		{"grpc decoder", []string{"-decoder", "grpc:" + grpcDecoder, p}, "expectedoutput/decoder_grpc.output"},
+		{"term range", []string{"-start-term", "2", "-end-term", "4", p}, "expectedoutput/listTermRange.output"},
+		{"limit", []string{"-limit", "3", p}, "expectedoutput/listLimit.output"},
+		{"min size", []string{"-min-size", "40B", p}, "expectedoutput/listMinSize.output"},
+		{"size histogram", []string{"-size-histogram", "-min-size", "20", p}, "expectedoutput/sizeHistogram.output"},
+		{"parallelism", []string{"-parallelism", "4", p}, "expectedoutput/listAll.output"},
+		{"skip-corrupt", []string{"-skip-corrupt", p}, "expectedoutput/listAll.output"},
+		{"csv output with parallelism", []string{"-output", "csv", "-parallelism", "4", p}, "expectedoutput/exportCSV.output"},
+		{"reverse limit", []string{"-reverse", "-limit", "5", p}, "expectedoutput/listReverseLimit.output"},
+		{"verify", []string{"-verify", p}, "expectedoutput/verify.output"},
+		{"diff identical", []string{"-diff", p, p}, "expectedoutput/diffIdentical.output"},
+		{"merge identical", []string{"-merge", "-start-index", "30", p, p, p}, "expectedoutput/mergeIdentical.output"},
+		{"summary", []string{"-summary", "-summary-top", "3", p}, "expectedoutput/summary.output"},
+		{"lease report", []string{"-lease-report", p}, "expectedoutput/leaseReport.output"},
+		{"redact put values", []string{"-entry-type", "IRRPut", "-redact-values", p}, "expectedoutput/listIRRPutRedactHash.output"},
+		{"remove normal values", []string{"-entry-type", "Normal", "-redact-values=remove", p}, "expectedoutput/listNormalRedactRemove.output"},
+		{"show offsets", []string{"-show-offsets", "-entry-type", "IRRPut,IRRTxn,ConfigChange", p}, "expectedoutput/listShowOffsets.output"},
+		{"csv output", []string{"-output", "csv", p}, "expectedoutput/exportCSV.output"},
+		{"tsv output with fields", []string{"-output", "tsv", "-fields", "index,method,key,range-end,lease,ttl,revision,node-id", "-entry-type", "ConfigChange,IRRDeleteRange,IRRCompaction,IRRLeaseGrant", p}, "expectedoutput/exportTSVFields.output"},
+		{"json output with fields", []string{"-output", "json", "-fields", "index,method,key,value-size,lease,ttl,revision", "-entry-type", "IRRPut,IRRTxn,IRRCompaction,IRRLeaseGrant", p}, "expectedoutput/exportJSONFields.output"},
+		{"replay-base64 output", []string{"-output", "replay-base64", "-entry-type", "IRRPut,IRRLeaseGrant", "-limit", "3", p}, "expectedoutput/exportReplayBase64.output"},
 	}
 
 	for _, argtest := range argtests {
@@ -90,6 +150,162 @@ func TestEtcdDumpLogEntryType(t *testing.T) {
 	}
 }
 
+func TestEtcdDumpLogExitCodes(t *testing.T) {
+	binDir, err := os.Getwd()
+	require.NoError(t, err)
+	dumpLogsBinary := path.Join(binDir + "/etcd-dump-logs")
+	if !fileutil.Exist(dumpLogsBinary) {
+		t.Skipf("%q does not exist", dumpLogsBinary)
+	}
+
+	p := t.TempDir()
+	mustCreateWALLog(t, p)
+
+	// change the key of a request in the middle of the WAL
+	corrupt := t.TempDir()
+	mustCreateWALLog(t, corrupt)
+	segment := filepath.Join(walDir(corrupt), "0000000000000000-0000000000000000.wal")
+	data, err := os.ReadFile(segment)
+	require.NoError(t, err)
+	i := bytes.Index(data, []byte("/path1"))
+	require.Positive(t, i)
+	data[i+1] ^= 0xff
+	require.NoError(t, os.WriteFile(segment, data, 0o600))
+
+	tcs := []struct {
+		name string
+		args []string
+		code exitCode
+	}{
+		{"usage", []string{"-start-snap", "0000000000000001-0000000000000001.snap", "-start-index", "3", p}, exitUsage},
+		{"no snapshot", []string{"-start-snap", "0000000000000001-0000000000000001.snap", p}, exitNoSnapshot},
+		{"corrupt wal", []string{corrupt}, exitCorruptWAL},
+		{"verify corrupt wal", []string{"-verify", corrupt}, exitCorruptWAL},
+		{"entry out of range", []string{"-extract-index", "1000", "-out", filepath.Join(t.TempDir(), "entry"), p}, exitOutOfRange},
+		{"decoder failure", []string{"-stream-decoder", filepath.Join(t.TempDir(), "missing-decoder"), p}, exitDecoderFailure},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var stderr strings.Builder
+			cmd := exec.Command(dumpLogsBinary, append([]string{"-error-format=json"}, tc.args...)...)
+			cmd.Stderr = &stderr
+			err := cmd.Run()
+			var exitErr *exec.ExitError
+			require.ErrorAs(t, err, &exitErr)
+			assert.Equal(t, int(tc.code), exitErr.ExitCode())
+
+			lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+			var report errorReport
+			require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &report), stderr.String())
+			assert.Equal(t, tc.code.String(), report.Category)
+			assert.Equal(t, int(tc.code), report.ExitCode)
+			assert.NotEmpty(t, report.Error)
+			if tc.name == "verify corrupt wal" {
+				assert.Equal(t, "0000000000000000-0000000000000000.wal", report.Segment)
+				assert.NotNil(t, report.Offset)
+			}
+		})
+	}
+}
+
+// mustCreateArchive creates the .tar.gz or .zip archive p of the files
+// and directories of dir, under the top directory top if it is not empty.
+func mustCreateArchive(t *testing.T, dir, p, top string) string {
+	f, err := os.Create(p)
+	require.NoError(t, err)
+	defer f.Close()
+	var (
+		tw *tar.Writer
+		zw *zip.Writer
+	)
+	if strings.HasSuffix(p, ".zip") {
+		zw = zip.NewWriter(f)
+		defer func() { require.NoError(t, zw.Close()) }()
+	} else {
+		gw := gzip.NewWriter(f)
+		defer func() { require.NoError(t, gw.Close()) }()
+		tw = tar.NewWriter(gw)
+		defer func() { require.NoError(t, tw.Close()) }()
+	}
+	require.NoError(t, filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil || file == dir {
+			return err
+		}
+		rel, err := filepath.Rel(dir, file)
+		require.NoError(t, err)
+		name := path.Join(top, filepath.ToSlash(rel))
+		if d.IsDir() {
+			if zw != nil {
+				_, err = zw.Create(name + "/")
+				return err
+			}
+			return tw.WriteHeader(&tar.Header{Name: name + "/", Typeflag: tar.TypeDir, Mode: 0o700})
+		}
+		b, err := os.ReadFile(file)
+		require.NoError(t, err)
+		if zw != nil {
+			w, err := zw.Create(name)
+			require.NoError(t, err)
+			_, err = w.Write(b)
+			return err
+		}
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(b))}))
+		_, err = tw.Write(b)
+		return err
+	}))
+	return p
+}
+
+// mustCreateMembersDB creates the backend database of the data directory,
+// holding the member added as learner by appendConfigChangeEnts and the
+// removed one.
+func mustCreateMembersDB(t *testing.T, path string) {
+	db, err := bolt.Open(filepath.Join(snapDir(path), "db"), 0o600, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket(schema.Members.Name())
+		if err != nil {
+			return err
+		}
+		m := membership.Member{
+			ID:             3,
+			RaftAttributes: membership.RaftAttributes{PeerURLs: []string{"http://127.0.0.1:12380"}, IsLearner: true},
+			Attributes:     membership.Attributes{Name: "infra3"},
+		}
+		v, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		if err = b.Put([]byte(m.ID.String()), v); err != nil {
+			return err
+		}
+		rb, err := tx.CreateBucket(schema.MembersRemoved.Name())
+		if err != nil {
+			return err
+		}
+		return rb.Put([]byte("2"), []byte("removed"))
+	})
+	require.NoError(t, err)
+}
+
+// startGRPCDecoder serves a decoder printing the size of the entries and
+// returns its address.
+func startGRPCDecoder(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	dump.RegisterDecoderServer(s, dump.DecoderFunc(func(data []byte) ([]byte, error) {
+		if len(data) == 0 {
+			return nil, errors.New("empty entry")
+		}
+		return fmt.Appendf(nil, "%d bytes", len(data)), nil
+	}))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
 func mustCreateWALLog(t *testing.T, path string) {
 	memberdir := filepath.Join(path, "member")
 	err := os.Mkdir(memberdir, 0o744)
@@ -117,6 +333,32 @@ func mustCreateWALLog(t *testing.T, path string) {
 	w.Close()
 }
 
+// mustCreateConfChangeV2WALLog creates a WAL of configuration changes, adding a
+// member, then replacing a voter in a joint configuration.
+func mustCreateConfChangeV2WALLog(t *testing.T, path string) {
+	require.NoError(t, os.MkdirAll(snapDir(path), 0o744))
+	w, err := wal.Create(zaptest.NewLogger(t), walDir(path), nil)
+	require.NoError(t, err)
+
+	ccs := []raftpb.ConfChangeV2{
+		{Changes: []raftpb.ConfChangeSingle{{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 3}}},
+		{Transition: raftpb.ConfChangeTransitionJointExplicit, Changes: []raftpb.ConfChangeSingle{
+			{Type: raftpb.ConfChangeAddNode, NodeID: 3},
+			{Type: raftpb.ConfChangeRemoveNode, NodeID: 2},
+		}},
+		{},
+	}
+	ents := []raftpb.Entry{
+		{Term: 1, Index: 1, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{ID: 1, Type: raftpb.ConfChangeAddNode, NodeID: 2})},
+		{Term: 1, Index: 2, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}})},
+	}
+	for i := range ccs {
+		ents = append(ents, raftpb.Entry{Term: 2, Index: uint64(3 + i), Type: raftpb.EntryConfChangeV2, Data: pbutil.MustMarshal(&ccs[i])})
+	}
+	require.NoError(t, w.Save(raftpb.HardState{}, ents))
+	require.NoError(t, w.Close())
+}
+
 func appendConfigChangeEnts(ents *[]raftpb.Entry) {
 	configChangeData := []raftpb.ConfChange{
 		{ID: 1, Type: raftpb.ConfChangeAddNode, NodeID: 2, Context: []byte("")},
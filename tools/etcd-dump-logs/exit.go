@@ -0,0 +1,162 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// exitCode is the exit status of etcd-dump-logs. The failures have their own
+// codes, so that the scripts wrapping the tool can tell, for instance, a
+// corrupt WAL from a WAL without entries, which is dumped successfully.
+type exitCode int
+
+const (
+	exitOK exitCode = 0
+	// exitFailure is returned by the failures of no other category, when the
+	// WALs compared by --diff differ, and when entries fail to be replayed
+	// by --replay-into.
+	exitFailure exitCode = 1
+	// exitUsage is returned for invalid flags and arguments, like the flag
+	// package does for the flags failing to parse.
+	exitUsage exitCode = 2
+	// exitNoSnapshot is returned when the snapshot given by --start-snap does
+	// not exist.
+	exitNoSnapshot exitCode = 3
+	// exitCorruptWAL is returned when a WAL record is truncated or fails its
+	// CRC check, including when --verify finds a corrupted record.
+	exitCorruptWAL exitCode = 4
+	// exitOutOfRange is returned when the requested entries are not in the
+	// WAL: the WAL does not contain the snapshot the dump starts from, it has
+	// a gap, or the entry to extract is not found.
+	exitOutOfRange exitCode = 5
+	// exitDecoderFailure is returned when the decoder fails to start or
+	// stops answering.
+	exitDecoderFailure exitCode = 6
+)
+
+var exitCategories = map[exitCode]string{
+	exitOK:             "ok",
+	exitFailure:        "failure",
+	exitUsage:          "usage",
+	exitNoSnapshot:     "no-snapshot",
+	exitCorruptWAL:     "corrupt-wal",
+	exitOutOfRange:     "out-of-range",
+	exitDecoderFailure: "decoder-failure",
+}
+
+func (c exitCode) String() string {
+	if s, ok := exitCategories[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("exit-%d", int(c))
+}
+
+// errorFormat is the format of the error reports, set by --error-format.
+var errorFormat = "text"
+
+func parseErrorFormat(format string) error {
+	switch format {
+	case "text", "json":
+		errorFormat = format
+		return nil
+	}
+	return fmt.Errorf("invalid error-format %q, must be text or json", format)
+}
+
+// errorReport is the error report printed to stderr with --error-format=json.
+type errorReport struct {
+	Error    string `json:"error"`
+	Category string `json:"category"`
+	ExitCode int    `json:"exit_code"`
+	// Segment and Offset locate the corrupted WAL record, if known.
+	Segment string `json:"segment,omitempty"`
+	Offset  *int64 `json:"offset,omitempty"`
+}
+
+// printError prints the error message msg, caused by err if it is not nil, in
+// the format set by --error-format.
+func printError(w io.Writer, code exitCode, msg string, err error) {
+	if errorFormat != "json" {
+		log.New(w, "", log.LstdFlags).Print(msg)
+		return
+	}
+	report := errorReport{Error: msg, Category: code.String(), ExitCode: int(code)}
+	var c *dump.Corruption
+	if errors.As(err, &c) {
+		report.Segment, report.Offset = c.Segment, &c.Offset
+	}
+	b, _ := json.Marshal(report)
+	fmt.Fprintf(w, "%s\n", b)
+}
+
+// walErrorCode returns the exit code of the error err reading a WAL.
+func walErrorCode(err error) exitCode {
+	var c *dump.Corruption
+	switch {
+	case errors.As(err, &c),
+		errors.Is(err, wal.ErrCRCMismatch),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, wal.ErrMetadataConflict),
+		errors.Is(err, wal.ErrSnapshotMismatch):
+		return exitCorruptWAL
+	case errors.Is(err, wal.ErrSnapshotNotFound),
+		errors.Is(err, wal.ErrSliceOutOfRange),
+		errors.Is(err, errEntryNotFound):
+		return exitOutOfRange
+	}
+	return exitFailure
+}
+
+// snapshotErrorCode returns the exit code of the error err loading a snapshot.
+func snapshotErrorCode(err error) exitCode {
+	if errors.Is(err, snap.ErrNoSnapshot) || errors.Is(err, os.ErrNotExist) {
+		return exitNoSnapshot
+	}
+	return exitFailure
+}
+
+// firstError returns the first error of v, or nil.
+func firstError(v []any) error {
+	for _, a := range v {
+		if err, ok := a.(error); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// exit and fatalf remove the extracted sources before calling os.Exit.
+func exit(code exitCode) {
+	closeSources()
+	os.Exit(int(code))
+}
+
+// fatalf reports the error, formatted according to format, and exits with
+// code. The error report locates the corrupted WAL record of the first error
+// of v, if any.
+func fatalf(code exitCode, format string, v ...any) {
+	printError(os.Stderr, code, fmt.Sprintf(format, v...), firstError(v))
+	exit(code)
+}
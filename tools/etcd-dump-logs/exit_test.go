@@ -0,0 +1,71 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+func TestWALErrorCode(t *testing.T) {
+	tcs := []struct {
+		err  error
+		want exitCode
+	}{
+		{fmt.Errorf("%w: in file 'a.wal' at position: 10", walpb.ErrCRCMismatch), exitCorruptWAL},
+		{fmt.Errorf("%w: in file 'a.wal' at position: 10", io.ErrUnexpectedEOF), exitCorruptWAL},
+		{&dump.Corruption{Segment: "a.wal", Offset: 10, Err: errors.New("bad record")}, exitCorruptWAL},
+		{wal.ErrSnapshotNotFound, exitOutOfRange},
+		{wal.ErrSliceOutOfRange, exitOutOfRange},
+		{fmt.Errorf("entry with index 3 %w", errEntryNotFound), exitOutOfRange},
+		{wal.ErrFileNotFound, exitFailure},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.want, walErrorCode(tc.err), tc.err.Error())
+	}
+}
+
+func TestSnapshotErrorCode(t *testing.T) {
+	assert.Equal(t, exitNoSnapshot, snapshotErrorCode(snap.ErrNoSnapshot))
+	assert.Equal(t, exitNoSnapshot, snapshotErrorCode(&os.PathError{Op: "open", Path: "a.snap", Err: os.ErrNotExist}))
+	assert.Equal(t, exitFailure, snapshotErrorCode(snap.ErrCRCMismatch))
+}
+
+func TestPrintErrorJSON(t *testing.T) {
+	defer func() { errorFormat = "text" }()
+	require.NoError(t, parseErrorFormat("json"))
+	require.Error(t, parseErrorFormat("xml"))
+
+	var out bytes.Buffer
+	err := &dump.Corruption{Segment: "a.wal", Offset: 10, Err: errors.New("bad record")}
+	printError(&out, exitCorruptWAL, "Failed reading WAL: "+err.Error(), err)
+	assert.JSONEq(t, `{"error":"Failed reading WAL: a.wal at offset 10: bad record","category":"corrupt-wal","exit_code":4,"segment":"a.wal","offset":10}`, out.String())
+
+	out.Reset()
+	printError(&out, exitUsage, "bad flag", nil)
+	assert.JSONEq(t, `{"error":"bad flag","category":"usage","exit_code":2}`, out.String())
+}
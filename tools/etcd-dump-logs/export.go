@@ -0,0 +1,48 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"io"
+
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// exportEntries writes a header row of the fields followed by a row of the
+// field values of each entry of the iterator, stopping after limit entries if
+// limit is set. The values are separated by comma, and computed on
+// parallelism goroutines. The iterator is closed.
+func exportEntries(out io.Writer, it *dump.Iterator, fields []string, comma rune, limit int, parallelism int) error {
+	p := dump.NewPipeline(it, parallelism, func(e dump.Entry) []string {
+		return dump.FieldValues(e, fields)
+	})
+	defer p.Close()
+	w := csv.NewWriter(out)
+	w.Comma = comma
+	if err := w.Write(fields); err != nil {
+		return err
+	}
+	for cnt := 0; (limit <= 0 || cnt < limit) && p.Next(); cnt++ {
+		if err := w.Write(p.Value()); err != nil {
+			return err
+		}
+	}
+	if err := p.Err(); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// genTestdataCommand is the name of the subcommand generating fixtures.
+const genTestdataCommand = "gen-testdata"
+
+// genTestdataMain runs the gen-testdata subcommand with the arguments
+// following it, writing a WAL and snapshot fixture to a new data directory.
+func genTestdataMain(args []string) {
+	fs := flag.NewFlagSet(genTestdataCommand, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s %s [flags] [data dir]\n\n", os.Args[0], genTestdataCommand)
+		fmt.Fprintln(fs.Output(), "Generates a data directory with a WAL, and optionally a snapshot, of seeded entries with the requested corruptions injected.")
+		fs.PrintDefaults()
+	}
+	entries := fs.Int("entries", 100, "The number of entries written after the snapshot")
+	mix := fs.String("mix", dump.DefaultTestdataMix, "The comma separated kind=weight pairs of the relative weights of the kinds of entries. The kinds are "+strings.Join(dump.TestdataEntryKinds, ", "))
+	seed := fs.Int64("seed", 1, "The seed of the choice of the entries, the same flags generating the same fixture")
+	termLength := fs.Int("term-length", 10, "The number of entries of each term")
+	snapshotIndex := fs.Uint64("snapshot-index", 0, "If set, writes a snapshot at the given index, the entries following it")
+	segmentSize := fs.Int64("segment-size", 0, "If set, the size in bytes the WAL segments are cut at instead of the 64MB of etcd, to generate WALs of several segments")
+	valueSize := fs.Int("value-size", 16, "The size of the values written by the put requests")
+	corrupt := fs.String("corrupt", "", "The comma separated corruptions injected in the WAL: "+strings.Join(dump.TestdataCorruptions, ", "))
+	errorFormatFlag := fs.String("error-format", "text", "The format of the error reported to stderr before exiting with the exit code of its category: text or json")
+	fs.Parse(args)
+
+	if err := parseErrorFormat(*errorFormatFlag); err != nil {
+		fatalf(exitUsage, "%v", err)
+	}
+	if fs.NArg() != 1 {
+		fatalf(exitUsage, "Must provide data-dir argument to %s (got %+v)", genTestdataCommand, fs.Args())
+	}
+	cfg := dump.TestdataConfig{
+		Entries:       *entries,
+		Seed:          *seed,
+		TermLength:    *termLength,
+		SnapshotIndex: *snapshotIndex,
+		SegmentSize:   *segmentSize,
+		ValueSize:     *valueSize,
+	}
+	var err error
+	if cfg.Mix, err = dump.ParseTestdataMix(*mix); err != nil {
+		fatalf(exitUsage, "%v", err)
+	}
+	if *corrupt != "" {
+		cfg.Corruptions = strings.Split(*corrupt, ",")
+	}
+
+	result, err := dump.GenerateTestdata(zap.NewNop(), fs.Arg(0), cfg)
+	if err != nil {
+		fatalf(exitFailure, "Failed generating test data: %v", err)
+	}
+	printTestdataResult(os.Stdout, fs.Arg(0), result)
+}
+
+func printTestdataResult(out io.Writer, dataDir string, result *dump.TestdataResult) {
+	fmt.Fprintf(out, "Generated entries %d to %d in %s\n", result.FirstIndex, result.LastIndex, dataDir)
+	fmt.Fprintf(out, "WAL segments: %s\n", strings.Join(result.Segments, ", "))
+	for _, c := range result.Injected {
+		if c.Segment == "" {
+			fmt.Fprintf(out, "Injected %s at index %d\n", c.Kind, c.Index)
+		} else {
+			fmt.Fprintf(out, "Injected %s at index %d (%s at offset %d)\n", c.Kind, c.Index, c.Segment, c.Offset)
+		}
+	}
+}
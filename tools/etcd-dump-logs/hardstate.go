@@ -0,0 +1,75 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// printHardStateHistory prints all the HardState records of the WAL with
+// their location, marking the records that start a new term, cast a vote or
+// break the raft invariants, to reconstruct the timeline of the elections.
+func printHardStateHistory(out io.Writer, r *dump.Reader, members dump.Members) error {
+	fmt.Fprintln(out, "HardState history:")
+	fmt.Fprintf(out, "location\t%4s\t%16s\t%10s\tevents\n", "term", "vote", "commit")
+	var (
+		prev  dump.HardStateRecord
+		n     int
+		terms = map[uint64]bool{}
+	)
+	err := r.HardStates(func(rec dump.HardStateRecord) error {
+		events := hardStateEvents(prev, rec, n == 0, members)
+		terms[rec.Term] = true
+		fmt.Fprintf(out, "%s:%d\t%4d\t%16s\t%10d\t%s\n", rec.Segment, rec.Offset, rec.Term, types.ID(rec.Vote), rec.Commit, strings.Join(events, ", "))
+		prev = rec
+		n++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "\nHardState records: %d, terms: %d\n", n, len(terms))
+	return nil
+}
+
+// hardStateEvents describes the changes from the prev HardState record to rec.
+func hardStateEvents(prev, rec dump.HardStateRecord, first bool, members dump.Members) []string {
+	var events []string
+	switch {
+	case first:
+	case rec.Term > prev.Term:
+		events = append(events, "new term")
+	case rec.Term < prev.Term:
+		events = append(events, "TERM REGRESSION")
+	case rec.Vote != prev.Vote && prev.Vote != 0:
+		events = append(events, "VOTE CHANGED WITHIN TERM")
+	}
+	if rec.Vote != 0 && (first || rec.Term != prev.Term || rec.Vote != prev.Vote) {
+		vote := "voted for " + types.ID(rec.Vote).String()
+		if d := members.Describe(rec.Vote); d != "" {
+			vote += " " + d
+		}
+		events = append(events, vote)
+	}
+	if !first && rec.Commit < prev.Commit {
+		events = append(events, "COMMIT REGRESSION")
+	}
+	return events
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestPrintHardStateHistory(t *testing.T) {
+	dir := t.TempDir()
+	w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+	require.NoError(t, err)
+	for _, st := range []raftpb.HardState{
+		{Term: 1, Vote: 1, Commit: 1},
+		{Term: 1, Vote: 1, Commit: 2},
+		{Term: 2, Commit: 2},
+		{Term: 2, Vote: 2, Commit: 2},
+		{Term: 2, Vote: 3, Commit: 3},
+		{Term: 3, Vote: 3, Commit: 1},
+		{Term: 2, Vote: 3, Commit: 1},
+	} {
+		require.NoError(t, w.Save(st, nil))
+	}
+	require.NoError(t, w.Close())
+
+	r, err := dump.NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	members := dump.Members{2: {Name: "infra2", PeerURLs: []string{"http://127.0.0.1:2380"}}}
+	require.NoError(t, printHardStateHistory(&out, r, members))
+	assert.Equal(t, `HardState history:
+location	term	            vote	    commit	events
+0000000000000000-0000000000000000.wal:56	   1	               1	         1	voted for 1
+0000000000000000-0000000000000000.wal:80	   1	               1	         2	
+0000000000000000-0000000000000000.wal:104	   2	               0	         2	new term
+0000000000000000-0000000000000000.wal:128	   2	               2	         2	voted for 2 name="infra2" peer-urls=http://127.0.0.1:2380
+0000000000000000-0000000000000000.wal:152	   2	               3	         3	VOTE CHANGED WITHIN TERM, voted for 3
+0000000000000000-0000000000000000.wal:176	   3	               3	         1	new term, voted for 3, COMMIT REGRESSION
+0000000000000000-0000000000000000.wal:200	   2	               3	         1	TERM REGRESSION, voted for 3
+
+HardState records: 7, terms: 3
+`, out.String())
+}
@@ -0,0 +1,507 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/term"
+
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+const browseHelp = "↑/↓ move  PgUp/PgDn page  g/G first/last  / search  n/N next/previous match  Tab scroll detail  q quit"
+
+// browseRow is an entry listed by the interactive browser. Only the raw
+// entry is kept, it is decoded again when selected.
+type browseRow struct {
+	entry   raftpb.Entry
+	typ     string
+	method  string
+	key     string
+	segment string
+	offset  int64
+}
+
+// browser is the state of the interactive browser: a list of entries, the
+// detail pane of the selected entry, and the status line, which holds the
+// query while searching.
+type browser struct {
+	rows      []browseRow
+	members   dump.Members
+	redaction dump.Redaction
+
+	width, height int
+	// sel is the selected row and top the first row of the list pane.
+	sel, top int
+	// detail holds the lines of the detail pane of the selected row, and
+	// detailTop the first line shown.
+	detail    []string
+	detailTop int
+	// focusDetail is set if the arrows scroll the detail pane.
+	focusDetail bool
+
+	searching bool
+	query     string
+	// lastQuery is the query n and N look for.
+	lastQuery string
+	// searchFrom is the row selected when the search started.
+	searchFrom int
+	message    string
+}
+
+// loadBrowseRows reads the entries of the iterator.
+func loadBrowseRows(it *dump.Iterator) ([]browseRow, error) {
+	defer it.Close()
+	var rows []browseRow
+	for it.Next() {
+		rows = append(rows, newBrowseRow(it.Entry()))
+	}
+	return rows, it.Err()
+}
+
+func newBrowseRow(e dump.Entry) browseRow {
+	values := dump.FieldValues(e, []string{"method", "key"})
+	return browseRow{
+		entry:   e.Entry,
+		typ:     e.Type,
+		method:  values[0],
+		key:     values[1],
+		segment: e.Segment,
+		offset:  e.Offset,
+	}
+}
+
+func newBrowser(rows []browseRow, members dump.Members, redaction dump.Redaction) *browser {
+	b := &browser{rows: rows, members: members, redaction: redaction, width: 80, height: 24}
+	b.selectRow(0)
+	return b
+}
+
+// listHeight returns the number of rows of the list pane. The screen is
+// split between the header line, the list pane, the separator line, the
+// detail pane and the status line.
+func (b *browser) listHeight() int {
+	return max(1, (b.height-3)/2)
+}
+
+func (b *browser) detailHeight() int {
+	return max(0, b.height-3-b.listHeight())
+}
+
+func (b *browser) resize(width, height int) {
+	b.width, b.height = width, height
+	b.selectRow(b.sel)
+}
+
+// selectRow selects the row i, scrolling the list pane to show it.
+func (b *browser) selectRow(i int) {
+	i = max(0, min(i, len(b.rows)-1))
+	if i != b.sel || b.detail == nil {
+		b.sel, b.detailTop = i, 0
+		b.detail = b.describe(i)
+	}
+	h := b.listHeight()
+	if b.sel < b.top {
+		b.top = b.sel
+	}
+	if b.sel >= b.top+h {
+		b.top = b.sel - h + 1
+	}
+	b.top = max(0, b.top)
+}
+
+func (b *browser) scrollDetail(n int) {
+	b.detailTop = max(0, min(b.detailTop+n, len(b.detail)-b.detailHeight()))
+}
+
+// handleKey updates the browser for the key, one of the names returned by
+// readKey. It returns true if the browser is closed.
+func (b *browser) handleKey(key string) bool {
+	if b.searching {
+		b.handleSearchKey(key)
+		return false
+	}
+	b.message = ""
+	move := b.selectRowBy
+	if b.focusDetail {
+		move = b.scrollDetail
+	}
+	switch key {
+	case "q", "ctrl-c":
+		return true
+	case "up", "k":
+		move(-1)
+	case "down", "j":
+		move(1)
+	case "pgup":
+		move(-b.listHeight())
+	case "pgdn", " ":
+		move(b.listHeight())
+	case "home", "g":
+		move(-len(b.rows) - len(b.detail))
+	case "end", "G":
+		move(len(b.rows) + len(b.detail))
+	case "tab":
+		b.focusDetail = !b.focusDetail
+	case "/":
+		b.searching, b.query, b.searchFrom = true, "", b.sel
+	case "n":
+		b.findNext(b.lastQuery, b.sel+1, 1)
+	case "N":
+		b.findNext(b.lastQuery, b.sel-1, -1)
+	}
+	return false
+}
+
+func (b *browser) selectRowBy(n int) { b.selectRow(b.sel + n) }
+
+// handleSearchKey updates the query, searching incrementally from the row
+// selected when the search started.
+func (b *browser) handleSearchKey(key string) {
+	switch key {
+	case "enter":
+		b.searching, b.lastQuery = false, b.query
+		return
+	case "esc", "ctrl-c":
+		b.searching, b.message = false, ""
+		b.selectRow(b.searchFrom)
+		return
+	case "backspace":
+		if b.query == "" {
+			return
+		}
+		_, size := utf8.DecodeLastRuneInString(b.query)
+		b.query = b.query[:len(b.query)-size]
+	default:
+		if r, size := utf8.DecodeRuneInString(key); size != len(key) || !unicode.IsPrint(r) {
+			return
+		}
+		b.query += key
+	}
+	if b.query == "" {
+		b.message = ""
+		b.selectRow(b.searchFrom)
+		return
+	}
+	if !b.findNext(b.query, b.searchFrom, 1) {
+		b.selectRow(b.searchFrom)
+	}
+}
+
+// findNext selects the first row matching the query from the row from, in
+// the given direction, wrapping around the list. It returns false if no row
+// matches.
+func (b *browser) findNext(query string, from, dir int) bool {
+	if query == "" {
+		b.message = "no search yet, press / to search"
+		return false
+	}
+	q := parseBrowseQuery(query)
+	n := len(b.rows)
+	for i := 0; i < n; i++ {
+		j := ((from+dir*i)%n + n) % n
+		if q.matches(b.rows[j]) {
+			b.message = ""
+			b.selectRow(j)
+			return true
+		}
+	}
+	b.message = fmt.Sprintf("no entry matches %q", query)
+	return false
+}
+
+// browseQuery matches the rows by the fields of the query "key:<text>",
+// "type:<text>", "index:<index>" or "term:<term>". The text of a query
+// without field matches either the key, the request method or the entry type.
+type browseQuery struct {
+	field string
+	text  string
+	num   uint64
+}
+
+func parseBrowseQuery(s string) browseQuery {
+	for _, field := range []string{"key", "type", "index", "term"} {
+		if text, ok := strings.CutPrefix(s, field+":"); ok {
+			q := browseQuery{field: field, text: strings.ToLower(text)}
+			if field == "index" || field == "term" {
+				q.num, _ = strconv.ParseUint(text, 10, 64)
+			}
+			return q
+		}
+	}
+	return browseQuery{text: strings.ToLower(s)}
+}
+
+func (q browseQuery) matches(r browseRow) bool {
+	contains := func(s string) bool { return strings.Contains(strings.ToLower(s), q.text) }
+	switch q.field {
+	case "key":
+		return contains(r.key)
+	case "type":
+		return contains(r.typ) || contains(r.method)
+	case "index":
+		return r.entry.Index == q.num
+	case "term":
+		return r.entry.Term == q.num
+	}
+	return contains(r.key) || contains(r.typ) || contains(r.method)
+}
+
+// describe returns the lines of the detail pane of the row i: its location
+// in the WAL and its data, decoded as protobuf text.
+func (b *browser) describe(i int) []string {
+	if i < 0 || i >= len(b.rows) {
+		return []string{}
+	}
+	r := b.rows[i]
+	e := dump.DecodeEntry(r.entry, r.typ)
+	e.Segment, e.Offset, e.Members = r.segment, r.offset, b.members
+	dump.Redact(&e, b.redaction)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "term: %d\nindex: %d\ntype: %s\n", e.Term, e.Index, e.Type)
+	if r.method != "" {
+		fmt.Fprintf(&buf, "method: %s\n", r.method)
+	}
+	fmt.Fprintf(&buf, "segment: %s\noffset: %d\nsize: %d\n", e.Segment, e.Offset, len(e.Data))
+	if e.Members != nil && (e.ConfChange != nil || e.ConfChangeV2 != nil) {
+		var entry bytes.Buffer
+		dump.PrintEntry(&entry, e)
+		fmt.Fprintf(&buf, "entry: %s\n", strings.Join(strings.Fields(entry.String()), " "))
+	}
+	buf.WriteString("\n")
+
+	var msg proto.Message
+	switch {
+	case e.InternalRaftRequest != nil:
+		rr := e.InternalRaftRequest
+		// like the listing, never show the passwords
+		if rr.AuthUserChangePassword != nil && rr.AuthUserChangePassword.Password != "" {
+			rr.AuthUserChangePassword.Password = "<value removed>"
+		}
+		msg = rr
+	case e.Request != nil:
+		msg = e.Request
+	case e.ConfChange != nil:
+		msg = e.ConfChange
+	case e.ConfChangeV2 != nil:
+		msg = e.ConfChangeV2
+	}
+	if msg != nil {
+		buf.WriteString(proto.MarshalTextString(msg))
+	} else if len(e.Data) > 0 {
+		fmt.Fprintf(&buf, "data: %q\n", e.Data)
+	}
+	return strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+}
+
+// render draws the whole screen.
+func (b *browser) render(w io.Writer) {
+	var buf bytes.Buffer
+	buf.WriteString("\x1b[H")
+	line := func(s string, attr string) {
+		s = fitLine(s, b.width)
+		if attr != "" {
+			s = attr + s + strings.Repeat(" ", b.width-utf8.RuneCountInString(s)) + "\x1b[0m"
+		}
+		buf.WriteString(s + "\x1b[K\r\n")
+	}
+
+	line(fmt.Sprintf("%4s %10s %-20s %-22s %s", "term", "index", "type", "method", "key"), "\x1b[1;7m")
+	for i := b.top; i < b.top+b.listHeight(); i++ {
+		if i >= len(b.rows) {
+			line("", "")
+			continue
+		}
+		r := b.rows[i]
+		s := fmt.Sprintf("%4d %10d %-20s %-22s %s", r.entry.Term, r.entry.Index, r.typ, r.method, strconv.Quote(r.key))
+		if r.key == "" {
+			s = strings.TrimRight(s[:len(s)-2], " ")
+		}
+		attr := ""
+		if i == b.sel {
+			attr = "\x1b[7m"
+		}
+		line(s, attr)
+	}
+
+	sep := "─── no entries "
+	if len(b.rows) > 0 {
+		sep = fmt.Sprintf("─── entry %d/%d ", b.sel+1, len(b.rows))
+	}
+	if b.focusDetail {
+		sep += "(scrolling) "
+	}
+	line(sep+strings.Repeat("─", max(0, b.width-utf8.RuneCountInString(sep))), "")
+	for i := b.detailTop; i < b.detailTop+b.detailHeight(); i++ {
+		if i < len(b.detail) {
+			line(strings.ReplaceAll(b.detail[i], "\t", "    "), "")
+		} else {
+			line("", "")
+		}
+	}
+
+	status := browseHelp
+	switch {
+	case b.searching:
+		status = "/" + b.query
+		if b.message != "" {
+			status += "  (" + b.message + ")"
+		}
+	case b.message != "":
+		status = b.message
+	}
+	buf.WriteString(fitLine(status, b.width) + "\x1b[K")
+	w.Write(buf.Bytes())
+}
+
+// fitLine truncates the line to width runes.
+func fitLine(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:max(0, width)])
+}
+
+// readKey reads a key from the terminal in raw mode, and returns its name:
+// up, down, pgup, pgdn, home, end, tab, enter, esc, backspace, ctrl-c, or
+// the character typed.
+func readKey(r *bufio.Reader) (string, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	switch c {
+	case 0x1b:
+		if r.Buffered() == 0 {
+			return "esc", nil
+		}
+		if next, _ := r.ReadByte(); next != '[' && next != 'O' {
+			return "esc", nil
+		}
+		var seq []byte
+		for {
+			c, err := r.ReadByte()
+			if err != nil {
+				return "", err
+			}
+			seq = append(seq, c)
+			if c >= 0x40 && c <= 0x7e {
+				break
+			}
+		}
+		switch string(seq) {
+		case "A":
+			return "up", nil
+		case "B":
+			return "down", nil
+		case "H", "1~", "7~":
+			return "home", nil
+		case "F", "4~", "8~":
+			return "end", nil
+		case "5~":
+			return "pgup", nil
+		case "6~":
+			return "pgdn", nil
+		}
+		return "", nil
+	case '\r', '\n':
+		return "enter", nil
+	case '\t':
+		return "tab", nil
+	case 0x7f, 0x08:
+		return "backspace", nil
+	case 0x03:
+		return "ctrl-c", nil
+	}
+	if err := r.UnreadByte(); err != nil {
+		return "", err
+	}
+	ch, _, err := r.ReadRune()
+	return string(ch), err
+}
+
+// browse runs the interactive browser of the rows on the terminal of in and
+// out until it is closed.
+func browse(b *browser, in, out *os.File) error {
+	inFd, outFd := int(in.Fd()), int(out.Fd())
+	if !term.IsTerminal(inFd) || !term.IsTerminal(outFd) {
+		return errors.New("the interactive mode requires a terminal")
+	}
+	state, err := term.MakeRaw(inFd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(inFd, state)
+	// switch to the alternate screen, hiding the cursor
+	io.WriteString(out, "\x1b[?1049h\x1b[?25l")
+	defer io.WriteString(out, "\x1b[?25h\x1b[?1049l")
+
+	type keyOrErr struct {
+		key string
+		err error
+	}
+	keys := make(chan keyOrErr)
+	go func() {
+		br := bufio.NewReader(in)
+		for {
+			k, err := readKey(br)
+			keys <- keyOrErr{k, err}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	// the size is polled, to redraw the screen when the terminal is resized
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	redraw := true
+	for {
+		if w, h, err := term.GetSize(outFd); err == nil && (w != b.width || h != b.height) {
+			b.resize(w, h)
+			redraw = true
+		}
+		if redraw {
+			b.render(out)
+			redraw = false
+		}
+		select {
+		case k := <-keys:
+			if k.err != nil {
+				return k.err
+			}
+			if b.handleKey(k.key) {
+				return nil
+			}
+			redraw = true
+		case <-ticker.C:
+		}
+	}
+}
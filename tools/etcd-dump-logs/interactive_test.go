@@ -0,0 +1,186 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func testBrowser(t *testing.T) *browser {
+	t.Helper()
+	reqs := []*etcdserverpb.InternalRaftRequest{
+		{ID: 1, Put: &etcdserverpb.PutRequest{Key: []byte("/registry/pods/a"), Value: []byte("v1")}},
+		{ID: 2, Range: &etcdserverpb.RangeRequest{Key: []byte("/registry/pods/")}},
+		{ID: 3, DeleteRange: &etcdserverpb.DeleteRangeRequest{Key: []byte("/registry/pods/a")}},
+		{ID: 4, AuthUserChangePassword: &etcdserverpb.AuthUserChangePasswordRequest{Name: "root", Password: "secret"}},
+		{ID: 5, Put: &etcdserverpb.PutRequest{Key: []byte("/config/b"), Value: []byte("v2")}},
+	}
+	var rows []browseRow
+	for i, rr := range reqs {
+		e := raftpb.Entry{Term: 1, Index: uint64(i + 1), Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(rr)}
+		rows = append(rows, newBrowseRow(dump.DecodeEntry(e, "")))
+	}
+	cc := raftpb.Entry{Term: 2, Index: 6, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 2})}
+	rows = append(rows, newBrowseRow(dump.DecodeEntry(cc, "")))
+	b := newBrowser(rows, nil, dump.RedactNone)
+	b.resize(80, 13)
+	return b
+}
+
+func TestBrowserNavigation(t *testing.T) {
+	b := testBrowser(t)
+	require.Equal(t, 5, b.listHeight())
+
+	b.handleKey("down")
+	b.handleKey("j")
+	assert.Equal(t, 2, b.sel)
+	b.handleKey("up")
+	assert.Equal(t, 1, b.sel)
+	b.handleKey("G")
+	assert.Equal(t, 5, b.sel)
+	assert.Equal(t, 1, b.top)
+	b.handleKey("down")
+	assert.Equal(t, 5, b.sel)
+	b.handleKey("pgup")
+	assert.Equal(t, 0, b.sel)
+	assert.Equal(t, 0, b.top)
+
+	b.handleKey("tab")
+	b.handleKey("down")
+	assert.Equal(t, 0, b.sel)
+	assert.Equal(t, 1, b.detailTop)
+	b.handleKey("tab")
+	b.handleKey("down")
+	assert.Equal(t, 1, b.sel)
+	assert.Equal(t, 0, b.detailTop)
+
+	assert.True(t, b.handleKey("q"))
+}
+
+func TestBrowserSearch(t *testing.T) {
+	tcs := []struct {
+		name     string
+		query    string
+		want     int
+		wantNext int
+	}{
+		{name: "key", query: "key:/config", want: 4, wantNext: 4},
+		{name: "text", query: "pods/a", want: 0, wantNext: 2},
+		{name: "method", query: "type:deleterange", want: 2, wantNext: 2},
+		{name: "type", query: "type:ConfigChange", want: 5, wantNext: 5},
+		{name: "index", query: "index:4", want: 3, wantNext: 3},
+		{name: "term", query: "term:2", want: 5, wantNext: 5},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			b := testBrowser(t)
+			b.handleKey("/")
+			for _, r := range tc.query {
+				b.handleKey(string(r))
+			}
+			assert.Equal(t, tc.want, b.sel)
+			b.handleKey("enter")
+			b.handleKey("n")
+			assert.Equal(t, tc.wantNext, b.sel)
+			assert.Empty(t, b.message)
+		})
+	}
+
+	b := testBrowser(t)
+	b.handleKey("down")
+	b.handleKey("/")
+	for _, r := range "nothing" {
+		b.handleKey(string(r))
+	}
+	assert.Equal(t, 1, b.sel)
+	assert.Equal(t, `no entry matches "nothing"`, b.message)
+	b.handleKey("esc")
+	assert.Equal(t, 1, b.sel)
+	assert.False(t, b.searching)
+
+	b.handleKey("/")
+	b.handleKey("x")
+	b.handleKey("backspace")
+	assert.Empty(t, b.query)
+	b.handleKey("enter")
+	b.handleKey("N")
+	assert.Equal(t, "no search yet, press / to search", b.message)
+}
+
+func TestBrowserDetail(t *testing.T) {
+	b := testBrowser(t)
+	assert.Equal(t, []string{
+		"term: 1",
+		"index: 1",
+		"type: InternalRaftRequest",
+		"method: Put",
+		"segment: ",
+		"offset: 0",
+		"size: 26",
+		"",
+		"ID: 1",
+		"put: <",
+		`  key: "/registry/pods/a"`,
+		`  value: "v1"`,
+		">",
+	}, b.detail)
+
+	b.handleKey("/")
+	b.handleKey("i")
+	b.handleKey("n")
+	b.handleKey("d")
+	b.handleKey("e")
+	b.handleKey("x")
+	b.handleKey(":")
+	b.handleKey("4")
+	detail := strings.Join(b.detail, "\n")
+	assert.Contains(t, detail, `name: "root"`)
+	assert.NotContains(t, detail, "secret")
+}
+
+func TestBrowserRender(t *testing.T) {
+	b := testBrowser(t)
+	var out bytes.Buffer
+	b.render(&out)
+	screen := out.String()
+	assert.Equal(t, 12, strings.Count(screen, "\r\n"))
+	assert.Contains(t, screen, "\x1b[7m   1          1 InternalRaftRequest  Put                    \"/registry/pods/a\"")
+	assert.Contains(t, screen, "─── entry 1/6 ")
+	assert.True(t, strings.HasSuffix(screen, fitLine(browseHelp, 80)+"\x1b[K"))
+}
+
+func TestReadKey(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("\x1b[A\x1b[B\x1b[5~\x1b[6~\x1b[H\x1b[4~\t\r\x7f\x03/é"))
+	var keys []string
+	for {
+		k, err := readKey(r)
+		if err != nil {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"up", "down", "pgup", "pgdn", "home", "end", "tab", "enter", "backspace", "ctrl-c", "/", "é"}, keys)
+}
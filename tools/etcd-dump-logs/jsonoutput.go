@@ -0,0 +1,169 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+
+	"go.etcd.io/etcd/api/v3/version"
+	"go.etcd.io/etcd/client/pkg/v3/types"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+const (
+	// jsonSchema names the format of --output=json.
+	jsonSchema = "etcd-dump-logs"
+	// jsonSchemaVersion is the version of the format of --output=json. It is
+	// bumped on the changes breaking its readers, like a field removed or of
+	// another type, but not when a field is added.
+	jsonSchemaVersion = 1
+)
+
+// jsonNumberFields are the fields exported as JSON numbers rather than
+// strings by --output=json.
+var jsonNumberFields = map[string]bool{
+	"term": true, "index": true, "value-size": true, "lease": true, "ttl": true,
+	"revision": true, "request-id": true, "size": true, "offset": true,
+}
+
+// jsonHeader is the first line of --output=json, describing the dump so that
+// its readers can check they support it.
+type jsonHeader struct {
+	Schema        string   `json:"schema"`
+	SchemaVersion int      `json:"schemaVersion"`
+	Tool          jsonTool `json:"tool"`
+	// Flags are the flags set on the command line.
+	Flags map[string]string `json:"flags"`
+	// Fields are the fields of the entry objects.
+	Fields []string `json:"fields"`
+	// Snapshot is the snapshot the entries are dumped from, nil if dumping
+	// from an index or if there is no snapshot.
+	Snapshot *jsonSnapshot `json:"snapshot"`
+	WAL      jsonWAL       `json:"wal"`
+}
+
+type jsonTool struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type jsonSnapshot struct {
+	Term      uint64           `json:"term"`
+	Index     uint64           `json:"index"`
+	ConfState raftpb.ConfState `json:"confState"`
+}
+
+type jsonWAL struct {
+	NodeID      string `json:"nodeID"`
+	ClusterID   string `json:"clusterID"`
+	Term        uint64 `json:"term"`
+	CommitIndex uint64 `json:"commitIndex"`
+	Vote        string `json:"vote"`
+	// Entries and LastIndex are not set when reading the WAL backwards.
+	Entries   *int    `json:"entries,omitempty"`
+	LastIndex *uint64 `json:"lastIndex,omitempty"`
+}
+
+// newJSONHeader returns the header of the dump of the entries of r, from the
+// snapshot if not nil.
+func newJSONHeader(r *dump.Reader, snapshot *raftpb.Snapshot, fields []string, reverse bool) jsonHeader {
+	h := jsonHeader{
+		Schema:        jsonSchema,
+		SchemaVersion: jsonSchemaVersion,
+		Tool:          jsonTool{Name: "etcd-dump-logs", Version: version.Version},
+		Flags:         map[string]string{},
+		Fields:        fields,
+	}
+	flag.Visit(func(f *flag.Flag) {
+		h.Flags[f.Name] = f.Value.String()
+	})
+	if snapshot != nil {
+		h.Snapshot = &jsonSnapshot{
+			Term:      snapshot.Metadata.Term,
+			Index:     snapshot.Metadata.Index,
+			ConfState: snapshot.Metadata.ConfState,
+		}
+	}
+	id, cid := parseWALMetadata(r.Metadata())
+	state := r.HardState()
+	h.WAL = jsonWAL{
+		NodeID:      id.String(),
+		ClusterID:   cid.String(),
+		Term:        state.Term,
+		CommitIndex: state.Commit,
+		Vote:        types.ID(state.Vote).String(),
+	}
+	if !reverse {
+		count, lastIndex := r.Count(), r.LastIndex()
+		h.WAL.Entries, h.WAL.LastIndex = &count, &lastIndex
+	}
+	return h
+}
+
+// exportJSON writes the header followed by a JSON object of the field values
+// of each entry of the iterator, one per line, stopping after limit entries if
+// limit is set. The fields that do not apply to an entry are omitted. The
+// values are computed on parallelism goroutines. The iterator is closed.
+func exportJSON(out io.Writer, h jsonHeader, it *dump.Iterator, limit int, parallelism int) error {
+	fields := h.Fields
+	p := dump.NewPipeline(it, parallelism, func(e dump.Entry) []byte {
+		return marshalJSONFields(fields, dump.FieldValues(e, fields))
+	})
+	defer p.Close()
+	w := bufio.NewWriter(out)
+	if err := json.NewEncoder(w).Encode(h); err != nil {
+		return err
+	}
+	for cnt := 0; (limit <= 0 || cnt < limit) && p.Next(); cnt++ {
+		if _, err := w.Write(p.Value()); err != nil {
+			return err
+		}
+	}
+	if err := p.Err(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// marshalJSONFields returns the line of the JSON object of the non empty
+// values of the fields, in the order of the fields.
+func marshalJSONFields(fields, values []string) []byte {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, name := range fields {
+		if values[i] == "" {
+			continue
+		}
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		k, _ := json.Marshal(name)
+		b.Write(k)
+		b.WriteByte(':')
+		if jsonNumberFields[name] {
+			b.WriteString(values[i])
+		} else {
+			v, _ := json.Marshal(values[i])
+			b.Write(v)
+		}
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// leaseEntryTypes are the entry types granting, revoking and checkpointing
+// the leases and attaching or detaching keys.
+const leaseEntryTypes = "IRRPut,IRRDeleteRange,IRRTxn,IRRLeaseGrant,IRRLeaseRevoke,IRRLeaseCheckpoint"
+
+// leaseEvent is a step in the timeline of a lease.
+type leaseEvent struct {
+	index, term uint64
+	what        string
+}
+
+// leaseTimeline is the life of a lease as recorded in the WAL.
+type leaseTimeline struct {
+	id      int64
+	events  []leaseEvent
+	granted bool
+	revoked bool
+	// keys are the keys attached to the lease, by the index of the entry
+	// attaching them.
+	keys map[string]uint64
+}
+
+// leaseReport correlates the lease requests of the entries added to it with
+// the puts attaching the keys to the leases.
+type leaseReport struct {
+	leases map[int64]*leaseTimeline
+	// order is the lease IDs by their first event.
+	order []int64
+	// keyLeases are the leases the keys are attached to.
+	keyLeases map[string]int64
+}
+
+func newLeaseReport() *leaseReport {
+	return &leaseReport{
+		leases:    make(map[int64]*leaseTimeline),
+		keyLeases: make(map[string]int64),
+	}
+}
+
+func (r *leaseReport) lease(id int64) *leaseTimeline {
+	l, ok := r.leases[id]
+	if !ok {
+		l = &leaseTimeline{id: id, keys: make(map[string]uint64)}
+		r.leases[id] = l
+		r.order = append(r.order, id)
+	}
+	return l
+}
+
+func (r *leaseReport) add(e dump.Entry) {
+	rr := e.InternalRaftRequest
+	if rr == nil {
+		return
+	}
+	event := func(id int64, format string, args ...any) *leaseTimeline {
+		l := r.lease(id)
+		l.events = append(l.events, leaseEvent{index: e.Index, term: e.Term, what: fmt.Sprintf(format, args...)})
+		return l
+	}
+	switch {
+	case rr.LeaseGrant != nil:
+		event(rr.LeaseGrant.ID, "granted with TTL %ds", rr.LeaseGrant.TTL).granted = true
+	case rr.LeaseRevoke != nil:
+		l := r.lease(rr.LeaseRevoke.ID)
+		keys := l.attachedKeys()
+		for _, k := range keys {
+			delete(r.keyLeases, k)
+			delete(l.keys, k)
+		}
+		what := "revoked"
+		if len(keys) > 0 {
+			what += fmt.Sprintf(", deleting %d attached keys: %s", len(keys), quoteKeys(keys))
+		}
+		event(l.id, "%s", what).revoked = true
+	case rr.LeaseCheckpoint != nil:
+		for _, cp := range rr.LeaseCheckpoint.Checkpoints {
+			event(cp.ID, "checkpointed with remaining TTL %ds", cp.Remaining_TTL)
+		}
+	case rr.Put != nil:
+		r.addPut(e.Index, e.Term, rr.Put)
+	case rr.DeleteRange != nil:
+		r.addDeleteRange(e.Index, e.Term, rr.DeleteRange)
+	case rr.Txn != nil:
+		r.addTxn(e.Index, e.Term, rr.Txn)
+	}
+}
+
+// addPut attaches the key to the lease of the put, and detaches it from its
+// previous lease unless the put keeps it.
+func (r *leaseReport) addPut(index, term uint64, put *etcdserverpb.PutRequest) {
+	if put.IgnoreLease {
+		return
+	}
+	key := string(put.Key)
+	prev, attached := r.keyLeases[key]
+	if attached && prev == put.Lease {
+		return
+	}
+	if attached {
+		r.detach(index, term, prev, key, "detached %s by a put")
+	}
+	if put.Lease == 0 {
+		return
+	}
+	l := r.lease(put.Lease)
+	l.keys[key] = index
+	l.events = append(l.events, leaseEvent{index: index, term: term, what: "attached " + dump.Excerpt(key, 64, 64)})
+	r.keyLeases[key] = put.Lease
+}
+
+// addDeleteRange detaches the deleted keys from their leases.
+func (r *leaseReport) addDeleteRange(index, term uint64, dr *etcdserverpb.DeleteRangeRequest) {
+	var deleted []string
+	for key := range r.keyLeases {
+		if deletesKey(dr.Key, dr.RangeEnd, []byte(key)) {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	for _, key := range deleted {
+		r.detach(index, term, r.keyLeases[key], key, "detached %s by a delete")
+	}
+}
+
+func (r *leaseReport) addTxn(index, term uint64, txn *etcdserverpb.TxnRequest) {
+	// the branch taken is unknown, the operations of both are applied in
+	// order, which is right for the common transactions with operations on a
+	// single branch
+	for _, ops := range [][]*etcdserverpb.RequestOp{txn.Success, txn.Failure} {
+		for _, op := range ops {
+			switch {
+			case op.GetRequestPut() != nil:
+				r.addPut(index, term, op.GetRequestPut())
+			case op.GetRequestDeleteRange() != nil:
+				r.addDeleteRange(index, term, op.GetRequestDeleteRange())
+			case op.GetRequestTxn() != nil:
+				r.addTxn(index, term, op.GetRequestTxn())
+			}
+		}
+	}
+}
+
+func (r *leaseReport) detach(index, term uint64, id int64, key, format string) {
+	l := r.lease(id)
+	delete(l.keys, key)
+	delete(r.keyLeases, key)
+	l.events = append(l.events, leaseEvent{index: index, term: term, what: fmt.Sprintf(format, dump.Excerpt(key, 64, 64))})
+}
+
+// deletesKey reports whether a delete range of [start, end) deletes key,
+// following the conventions of the range end of the etcd requests.
+func deletesKey(start, end, key []byte) bool {
+	switch {
+	case len(end) == 0:
+		return bytes.Equal(key, start)
+	case len(end) == 1 && end[0] == 0:
+		return bytes.Compare(key, start) >= 0
+	default:
+		return bytes.Compare(key, start) >= 0 && bytes.Compare(key, end) < 0
+	}
+}
+
+// attachedKeys returns the keys attached to the lease, sorted.
+func (l *leaseTimeline) attachedKeys() []string {
+	keys := make([]string, 0, len(l.keys))
+	for k := range l.keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func quoteKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, k := range keys {
+		quoted[i] = dump.Excerpt(k, 64, 64)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// print prints the timeline of each lease, by their first event, followed by
+// the number of leases granted, revoked and still holding keys.
+func (r *leaseReport) print(out io.Writer) {
+	fmt.Fprintln(out, "Lease report:")
+	var granted, revoked, holding int
+	for _, id := range r.order {
+		l := r.leases[id]
+		fmt.Fprintf(out, "\nlease %016x\n", uint64(l.id))
+		if !l.granted {
+			fmt.Fprintln(out, "  granted before the dumped entries")
+		}
+		for _, ev := range l.events {
+			fmt.Fprintf(out, "  index %d term %d: %s\n", ev.index, ev.term, ev.what)
+		}
+		if !l.revoked {
+			fmt.Fprintf(out, "  not revoked in the dumped entries, %d keys attached\n", len(l.keys))
+		}
+		if l.granted {
+			granted++
+		}
+		if l.revoked {
+			revoked++
+		}
+		if len(l.keys) > 0 {
+			holding++
+		}
+	}
+	fmt.Fprintf(out, "\nLeases: %d, granted: %d, revoked: %d, holding keys: %d\n", len(r.order), granted, revoked, holding)
+}
+
+// printLeaseReport prints the timeline of the leases of the entries: their
+// grant with the TTL, the keys attached and detached, the checkpoints and
+// their revocation with the keys deleted by it.
+func printLeaseReport(out io.Writer, r *dump.Reader) error {
+	it, err := r.Entries(evaluateEntrytypeFlag(leaseEntryTypes)...)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	report := newLeaseReport()
+	for it.Next() {
+		report.add(it.Entry())
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	report.print(out)
+	return nil
+}
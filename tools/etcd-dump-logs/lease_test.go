@@ -0,0 +1,87 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestLeaseReport(t *testing.T) {
+	put := func(key string, lease int64) *etcdserverpb.PutRequest {
+		return &etcdserverpb.PutRequest{Key: []byte(key), Value: []byte("v"), Lease: lease}
+	}
+	rrs := []*etcdserverpb.InternalRaftRequest{
+		{LeaseGrant: &etcdserverpb.LeaseGrantRequest{ID: 1, TTL: 10}},
+		{Put: put("/a/1", 1)},
+		{Txn: &etcdserverpb.TxnRequest{Success: []*etcdserverpb.RequestOp{
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: put("/a/2", 1)}},
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: put("/b", 2)}},
+		}}},
+		{Put: &etcdserverpb.PutRequest{Key: []byte("/a/1"), Value: []byte("v2"), IgnoreLease: true}},
+		{LeaseCheckpoint: &etcdserverpb.LeaseCheckpointRequest{Checkpoints: []*etcdserverpb.LeaseCheckpoint{{ID: 1, Remaining_TTL: 5}}}},
+		{Put: put("/b", 0)},
+		{LeaseGrant: &etcdserverpb.LeaseGrantRequest{ID: 3, TTL: 60}},
+		{Put: put("/c/1", 3)},
+		{Put: put("/c/2", 3)},
+		{DeleteRange: &etcdserverpb.DeleteRangeRequest{Key: []byte("/c/"), RangeEnd: []byte("/c0")}},
+		{LeaseRevoke: &etcdserverpb.LeaseRevokeRequest{ID: 1}},
+	}
+	report := newLeaseReport()
+	for i, rr := range rrs {
+		report.add(dump.Entry{Entry: raftpb.Entry{Term: 2, Index: uint64(i + 1)}, InternalRaftRequest: rr})
+	}
+	var out bytes.Buffer
+	report.print(&out)
+	assert.Equal(t, `Lease report:
+
+lease 0000000000000001
+  index 1 term 2: granted with TTL 10s
+  index 2 term 2: attached "/a/1"
+  index 3 term 2: attached "/a/2"
+  index 5 term 2: checkpointed with remaining TTL 5s
+  index 11 term 2: revoked, deleting 2 attached keys: "/a/1", "/a/2"
+
+lease 0000000000000002
+  granted before the dumped entries
+  index 3 term 2: attached "/b"
+  index 6 term 2: detached "/b" by a put
+  not revoked in the dumped entries, 0 keys attached
+
+lease 0000000000000003
+  index 7 term 2: granted with TTL 60s
+  index 8 term 2: attached "/c/1"
+  index 9 term 2: attached "/c/2"
+  index 10 term 2: detached "/c/1" by a delete
+  index 10 term 2: detached "/c/2" by a delete
+  not revoked in the dumped entries, 0 keys attached
+
+Leases: 3, granted: 2, revoked: 1, holding keys: 0
+`, out.String())
+}
+
+func TestDeletesKey(t *testing.T) {
+	assert.True(t, deletesKey([]byte("a"), nil, []byte("a")))
+	assert.False(t, deletesKey([]byte("a"), nil, []byte("ab")))
+	assert.True(t, deletesKey([]byte("a"), []byte("b"), []byte("ab")))
+	assert.False(t, deletesKey([]byte("a"), []byte("b"), []byte("b")))
+	assert.True(t, deletesKey([]byte("a"), []byte{0}, []byte("z")))
+}
@@ -16,6 +16,8 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -27,93 +29,431 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
-	"time"
 
+	"github.com/dustin/go-humanize"
 	"go.uber.org/zap"
 
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/client/pkg/v3/fileutil"
 	"go.etcd.io/etcd/client/pkg/v3/types"
 	"go.etcd.io/etcd/pkg/v3/pbutil"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/snap"
 	"go.etcd.io/etcd/server/v3/storage/wal"
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
 	"go.etcd.io/raft/v3/raftpb"
 )
 
-const (
-	defaultEntryTypes string = "Normal,ConfigChange"
-	methodSync        string = "SYNC"
-	methodQGet        string = "QGET"
-	methodDelete      string = "DELETE"
-	methodRandom      string = "RANDOM"
-)
-
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == genTestdataCommand {
+		genTestdataMain(os.Args[2:])
+		return
+	}
 	snapfile := flag.String("start-snap", "", "The base name of snapshot file to start dumping")
-	waldir := flag.String("wal-dir", "", "If set, dumps WAL from the informed path, rather than following the standard 'data_dir/member/wal/' location")
+	waldir := flag.String("wal-dir", "", "If set, dumps WAL from the informed path, rather than following the standard 'data_dir/member/wal/' location. Like the data directory, it can be a .tar.gz, .tgz or .zip archive, or an s3:// or gs:// URL of an archive or of a prefix of objects")
 	startIndex := flag.Uint64("start-index", 0, "The index to start dumping (inclusive). If unspecified, dumps from the index of the last snapshot.")
 	endIndex := flag.Uint64("end-index", math.MaxUint64, "The index to stop dumping (exclusive)")
+	startTerm := flag.Uint64("start-term", 0, "The lowest term of the entries to dump (inclusive)")
+	endTerm := flag.Uint64("end-term", math.MaxUint64, "The term to stop dumping at (exclusive)")
 	// Default entry types are Normal and ConfigChange
-	entrytype := flag.String("entry-type", defaultEntryTypes, `If set, filters output by entry type. Must be one or more than one of:
-ConfigChange, Normal, Request, InternalRaftRequest,
+	entrytype := flag.String("entry-type", dump.DefaultEntryTypes, `If set, filters output by entry type. Must be one or more than one of:
+ConfigChange (all the configuration changes), ConfigChangeV2,
+Normal, Request, InternalRaftRequest,
 IRRRange, IRRPut, IRRDeleteRange, IRRTxn,
-IRRCompaction, IRRLeaseGrant, IRRLeaseRevoke, IRRLeaseCheckpoint`)
+IRRCompaction, IRRLeaseGrant, IRRLeaseRevoke, IRRLeaseCheckpoint,
+IRRAuth (all the auth requests below), IRRAuthEnable, IRRAuthDisable,
+IRRAuthStatus, IRRAuthenticate, IRRAuthUserAdd, IRRAuthUserDelete,
+IRRAuthUserGet, IRRAuthUserChangePassword, IRRAuthUserGrantRole,
+IRRAuthUserRevokeRole, IRRAuthUserList, IRRAuthRoleList, IRRAuthRoleAdd,
+IRRAuthRoleDelete, IRRAuthRoleGet, IRRAuthRoleGrantPermission,
+IRRAuthRoleRevokePermission`)
 	streamdecoder := flag.String("stream-decoder", "", `The name of an executable decoding tool, the executable must process
 hex encoded lines of binary input (from etcd-dump-logs)
 and output a hex encoded line of binary for each input line`)
+	decoderSpec := flag.String("decoder", "", `The decoder of the entry data, kept for the whole dump so it can keep state
+across the entries: plugin:<file> for a Go plugin exporting a Decoder,
+wasm:<file> for a WebAssembly module or grpc:<address> for a decoder service.
+Cannot be used together with the stream-decoder flag`)
+	membersDB := flag.String("members-db", "", "The backend database the member IDs of the WAL metadata and of the configuration changes are resolved to member names and peer URLs from when listing entries, defaults to member/snap/db of the data directory if it exists. Set to none to disable")
 	raw := flag.Bool("raw", false, "Read the logs in the low-level form")
+	topSize := flag.Int("top-size", 0, "If set, prints the N largest entries (filtered by entry-type) and a histogram of entry data sizes instead of listing entries")
+	sizeHistogram := flag.Bool("size-histogram", false, "If set, prints a histogram of entry data sizes (filtered by entry-type) and the number of entries larger than the default max request size instead of listing entries")
+	minSizeFlag := flag.String("min-size", "", "If set, only dumps the entries whose data is at least the given size, in bytes or with a unit such as 512KiB or 40MB, to locate the giant proposals")
+	extractIndex := flag.Uint64("extract-index", 0, "If set, writes the raw data of the entry with the given index to the file set by --out instead of listing entries")
+	out := flag.String("out", "", "The file to write the entry data selected by --extract-index to")
+	skipCorrupt := flag.Bool("skip-corrupt", false, "If set, skips the WAL records that cannot be decoded, fail their CRC check or cannot be unmarshaled, logging the offsets of the skipped ranges, and resumes at the next valid record of the segment instead of stopping at the first one. The entries of the skipped records are missing from the output")
+	verify := flag.Bool("verify", false, "If set, validates the CRC of all the WAL records, the index/term monotonicity of the entries and the continuity of the segments, and reports the first corrupted record instead of listing entries")
+	summary := flag.Bool("summary", false, "If set, prints the entries (filtered by entry-type) aggregated by raft type, operation and term, the most written key prefixes and the largest entries instead of listing entries")
+	summaryTop := flag.Int("summary-top", 10, "The number of key prefixes and largest entries printed by --summary")
+	parallelism := flag.Int("parallelism", 1, "The number of goroutines decoding and printing the listed or exported entries, in the order of the WAL. Decoding the entries on several cores speeds up dumping large WALs from fast disks")
+	limit := flag.Int("limit", 0, "If set, lists at most N entries (filtered by entry-type)")
+	reverse := flag.Bool("reverse", false, "If set, lists the entries from the last one to the first, reading the WAL files backwards from the end of the WAL")
+	output := flag.String("output", "text", "The format of the listed entries: text, csv, tsv, json, replay or replay-base64. The csv and tsv formats print a header row followed by a row of the selected fields per entry, the json format a line of JSON object describing the dump, with the version of its schema, followed by a line of JSON object of the selected fields per entry, and the replay formats a stream of the entries, as length-prefixed protobuf messages or as lines of base64 encoded protobuf messages, that can be applied to a cluster with --replay-into. These formats print the snapshot and WAL metadata to stderr")
+	fields := flag.String("fields", dump.DefaultFields, "The comma separated fields of the rows printed by --output=csv, --output=tsv and --output=json. Must be one or more than one of:\n"+strings.Join(dump.Fields, ", "))
+	showOffsets := flag.Bool("show-offsets", false, "If set, prints the WAL file and the byte offset of the record of each listed entry, or of each record in the raw mode")
+	pretty := flag.Bool("pretty", false, "If set, prints transactions over several lines, with their compares and the operations of their success and failure branches indented on separate lines")
+	diff := flag.Bool("diff", false, "If set, compares the WALs of the two data directories given as arguments, aligning their entries by index, and reports the term mismatches, divergent payloads and missing index ranges between them instead of listing entries")
+	merge := flag.Bool("merge", false, "If set, merges the WALs of the two or more data directories given as arguments, for instance those of all the members of a cluster that lost its quorum, and prints a table of the indexes held by any of them, with the term each WAL holds the index at, and whether they agree, miss or diverge on the index, instead of listing entries")
+	replayIntoEndpoint := flag.String("replay-into", "", "If set, applies the put, delete range, transaction, compaction and lease requests of the replay stream written by --output=replay or --output=replay-base64 to the cluster serving the given endpoint, in order. The argument is the file of the replay stream, or - to read it from stdin")
+	hardstateHistory := flag.Bool("hardstate-history", false, "If set, lists every HardState record of the WAL (term, vote and commit) with the WAL file and the byte offset it was read from, marking the new terms, the votes and the records breaking the raft invariants, instead of listing entries")
+	leaseReport := flag.Bool("lease-report", false, "If set, prints the timeline of each lease granted, revoked or checkpointed by the entries or attached to a key: the index it is granted at with its TTL, the keys attached to and detached from it, its checkpoints and the index it is revoked at with the keys deleted by the revocation, instead of listing entries")
+	interactive := flag.Bool("interactive", false, "If set, browses the entries (filtered by entry-type) in the terminal: a list of the entries, searched incrementally by key, type, index or term, and a detail pane with the fully decoded request of the selected entry")
+	summaryPrefixDepth := flag.Int("summary-prefix-depth", 2, "The number of '/' separated segments of the keys grouped together by --summary")
+	var redact redactFlag
+	flag.Var(&redact, "redact-values", `If set, replaces the values written or compared by the listed entries, and the user passwords and tokens they carry,
+with their SHA-256 digest (--redact-values or --redact-values=hash) or removes them (--redact-values=remove)`)
+	errorFormatFlag := flag.String("error-format", "text", "The format of the error reported to stderr before exiting with the exit code of its category: text, or json for a single line JSON object with the error message, its category and the exit code")
 
 	flag.Parse()
 	lg := zap.NewExample()
+	if err := parseErrorFormat(*errorFormatFlag); err != nil {
+		fatalf(exitUsage, "%v", err)
+	}
+
+	if *replayIntoEndpoint != "" {
+		if len(flag.Args()) != 1 {
+			fatalf(exitUsage, "Must provide the replay stream file argument with the replay-into flag (got %+v)", flag.Args())
+		}
+		otherFlags := 0
+		flag.Visit(func(f *flag.Flag) {
+			if f.Name != "replay-into" && f.Name != "error-format" {
+				otherFlags++
+			}
+		})
+		if otherFlags != 0 {
+			fatalf(exitUsage, "replay-into flag cannot be used together with other flags than error-format.")
+		}
+		if !replayInto(os.Stdout, *replayIntoEndpoint, flag.Args()[0]) {
+			exit(exitFailure)
+		}
+		return
+	}
 
-	if len(flag.Args()) != 1 {
-		log.Fatalf("Must provide data-dir argument (got %+v)", flag.Args())
+	if *diff {
+		if len(flag.Args()) != 2 {
+			fatalf(exitUsage, "Must provide two data-dir arguments with the diff flag (got %+v)", flag.Args())
+		}
+	} else if *merge {
+		if len(flag.Args()) < 2 {
+			fatalf(exitUsage, "Must provide two or more data-dir arguments with the merge flag (got %+v)", flag.Args())
+		}
+	} else if len(flag.Args()) != 1 {
+		fatalf(exitUsage, "Must provide data-dir argument (got %+v)", flag.Args())
 	}
 	dataDir := flag.Args()[0]
 
 	if *snapfile != "" && *startIndex != 0 {
-		log.Fatal("start-snap and start-index flags cannot be used together.")
+		fatalf(exitUsage, "start-snap and start-index flags cannot be used together.")
 	}
 
-	startFromIndex := false
+	var minSize uint64
+	if *minSizeFlag != "" {
+		var err error
+		if minSize, err = humanize.ParseBytes(*minSizeFlag); err != nil {
+			fatalf(exitUsage, "Invalid min-size flag %q: %v", *minSizeFlag, err)
+		}
+	}
+	if minSize != 0 && (*raw || *verify || *diff) {
+		fatalf(exitUsage, "min-size flag cannot be used together with the raw, verify and diff flags.")
+	}
+
+	startFromIndex, fieldsSet := false, false
 	flag.Visit(func(f *flag.Flag) {
-		if f.Name == "start-index" {
+		switch f.Name {
+		case "start-index":
 			startFromIndex = true
+		case "fields":
+			fieldsSet = true
 		}
 	})
 
+	if *extractIndex != 0 && *out == "" {
+		fatalf(exitUsage, "extract-index flag requires the out flag to be set.")
+	}
+
+	if *streamdecoder != "" && *decoderSpec != "" {
+		fatalf(exitUsage, "stream-decoder and decoder flags cannot be used together.")
+	}
+	decoding := *streamdecoder != "" || *decoderSpec != ""
+
+	if redact.Redaction != dump.RedactNone && (*raw || decoding || *extractIndex != 0) {
+		fatalf(exitUsage, "redact-values flag cannot be used together with the raw, stream-decoder, decoder and extract-index flags.")
+	}
+
+	if *summary && (*topSize != 0 || *extractIndex != 0) {
+		fatalf(exitUsage, "summary flag cannot be used together with the top-size and extract-index flags.")
+	}
+
+	if (*limit != 0 || *reverse) && (*raw || *topSize != 0 || *extractIndex != 0 || *summary || *verify) {
+		fatalf(exitUsage, "limit and reverse flags cannot be used together with the raw, top-size, extract-index, summary and verify flags.")
+	}
+
+	var (
+		comma      rune
+		jsonOutput bool
+		replayEnc  dump.ReplayEncoding
+	)
+	switch *output {
+	case "text":
+	case "csv":
+		comma = ','
+	case "tsv":
+		comma = '\t'
+	case "json":
+		jsonOutput = true
+	case "replay":
+		replayEnc = dump.ReplayBinary
+	case "replay-base64":
+		replayEnc = dump.ReplayBase64
+	default:
+		fatalf(exitUsage, "invalid output %q, must be text, csv, tsv, json, replay or replay-base64.", *output)
+	}
+	if fieldsSet && comma == 0 && !jsonOutput {
+		fatalf(exitUsage, "fields flag requires the output flag to be set to csv, tsv or json.")
+	}
+	exportFields, err := dump.ParseFields(*fields)
+	if err != nil {
+		fatalf(exitUsage, "%v", err)
+	}
+	exporting := comma != 0 || jsonOutput || replayEnc != ""
+	if exporting && (*raw || decoding || *topSize != 0 || *extractIndex != 0 || *summary || *verify) {
+		fatalf(exitUsage, "csv, tsv, json, replay and replay-base64 outputs cannot be used together with the raw, stream-decoder, decoder, top-size, extract-index, summary and verify flags.")
+	}
+	if replayEnc != "" && (*reverse || redact.Redaction != dump.RedactNone) {
+		fatalf(exitUsage, "replay and replay-base64 outputs cannot be used together with the reverse and redact-values flags.")
+	}
+
+	if *showOffsets && (*topSize != 0 || *extractIndex != 0 || *summary || *verify || exporting) {
+		fatalf(exitUsage, "show-offsets flag cannot be used together with the top-size, extract-index, summary and verify flags, and with the csv, tsv, json, replay and replay-base64 outputs (use --fields=segment,offset instead).")
+	}
+
+	if *pretty && (*raw || decoding || *topSize != 0 || *extractIndex != 0 || *summary || *verify || exporting) {
+		fatalf(exitUsage, "pretty flag cannot be used together with the raw, stream-decoder, decoder, top-size, extract-index, summary and verify flags, and with the csv, tsv, json, replay and replay-base64 outputs.")
+	}
+
+	if *diff && (*waldir != "" || *entrytype != dump.DefaultEntryTypes || *raw || decoding || *topSize != 0 || *extractIndex != 0 ||
+		*summary || *verify || *limit != 0 || *reverse || *showOffsets || *pretty || exporting || *startTerm != 0 || *endTerm != math.MaxUint64) {
+		fatalf(exitUsage, "diff flag cannot be used together with the wal-dir, entry-type, raw, stream-decoder, decoder, top-size, extract-index, summary, verify, limit, reverse, show-offsets, pretty, start-term and end-term flags, and with the csv, tsv, json, replay and replay-base64 outputs.")
+	}
+
+	if *merge && (*diff || *waldir != "" || *entrytype != dump.DefaultEntryTypes || *raw || decoding || *topSize != 0 || *sizeHistogram || *extractIndex != 0 ||
+		*summary || *verify || *interactive || *hardstateHistory || *leaseReport || *limit != 0 || *reverse || *showOffsets || *pretty || minSize != 0 ||
+		exporting || *startTerm != 0 || *endTerm != math.MaxUint64) {
+		fatalf(exitUsage, "merge flag cannot be used together with the diff, wal-dir, entry-type, raw, stream-decoder, decoder, top-size, size-histogram, extract-index, summary, verify, interactive, hardstate-history, lease-report, limit, reverse, show-offsets, pretty, min-size, start-term and end-term flags, and with the csv, tsv, json, replay and replay-base64 outputs.")
+	}
+
+	if *verify && (*raw || *topSize != 0 || *extractIndex != 0 || *summary) {
+		fatalf(exitUsage, "verify flag cannot be used together with the raw, top-size, extract-index and summary flags.")
+	}
+
+	if *interactive && (*raw || decoding || *topSize != 0 || *extractIndex != 0 || *summary || *verify || *diff ||
+		*limit != 0 || *reverse || *showOffsets || *pretty || exporting) {
+		fatalf(exitUsage, "interactive flag cannot be used together with the raw, stream-decoder, decoder, top-size, extract-index, summary, verify, diff, limit, reverse, show-offsets and pretty flags, and with the csv, tsv, json, replay and replay-base64 outputs.")
+	}
+
+	if *sizeHistogram && (*raw || *topSize != 0 || *extractIndex != 0 || *summary || *verify || *diff || *interactive ||
+		*limit != 0 || *reverse || *showOffsets || *pretty || exporting) {
+		fatalf(exitUsage, "size-histogram flag cannot be used together with the raw, top-size, extract-index, summary, verify, diff, interactive, limit, reverse, show-offsets and pretty flags, and with the csv, tsv, json, replay and replay-base64 outputs.")
+	}
+
+	if *hardstateHistory && (*raw || decoding || *topSize != 0 || *sizeHistogram || *extractIndex != 0 || *summary || *verify || *diff ||
+		*interactive || *limit != 0 || *reverse || *showOffsets || *pretty || minSize != 0 || exporting) {
+		fatalf(exitUsage, "hardstate-history flag cannot be used together with the raw, stream-decoder, decoder, top-size, size-histogram, extract-index, summary, verify, diff, interactive, limit, reverse, show-offsets, pretty and min-size flags, and with the csv, tsv, json, replay and replay-base64 outputs.")
+	}
+
+	if *leaseReport && (*raw || decoding || *topSize != 0 || *sizeHistogram || *extractIndex != 0 || *summary || *verify || *diff ||
+		*interactive || *hardstateHistory || *limit != 0 || *reverse || *showOffsets || *pretty || minSize != 0 || exporting) {
+		fatalf(exitUsage, "lease-report flag cannot be used together with the raw, stream-decoder, decoder, top-size, size-histogram, extract-index, summary, verify, diff, interactive, hardstate-history, limit, reverse, show-offsets, pretty and min-size flags, and with the csv, tsv, json, replay and replay-base64 outputs.")
+	}
+
+	if *skipCorrupt && (*raw || *verify) {
+		fatalf(exitUsage, "skip-corrupt flag cannot be used together with the raw and verify flags.")
+	}
+
+	if *raw && (decoding ||
+		*topSize != 0 ||
+		*extractIndex != 0 ||
+		*summary) {
+		fatalf(exitUsage, "Flags --stream-decoder, --decoder, --top-size, --extract-index, --summary not supported in the RAW mode.")
+	}
+
+	// the sources are extracted after checking the flags, so that only the
+	// errors reading them have to remove them
+	dataDir = openSource(dataDir)
+	if *waldir != "" {
+		*waldir = openSource(*waldir)
+	}
+	defer closeSources()
+
+	if *diff {
+		if !diffWALs(lg, os.Stdout, startFromIndex, *startIndex, *endIndex, *snapfile, dataDir, openSource(flag.Args()[1]), *skipCorrupt, redact.Redaction) {
+			exit(exitFailure)
+		}
+		return
+	}
+
+	if *merge {
+		dataDirs := []string{dataDir}
+		for _, arg := range flag.Args()[1:] {
+			dataDirs = append(dataDirs, openSource(arg))
+		}
+		if !mergeWALs(lg, os.Stdout, startFromIndex, *startIndex, *endIndex, *snapfile, dataDirs, *skipCorrupt, redact.Redaction) {
+			exit(exitFailure)
+		}
+		return
+	}
+
+	if *verify {
+		wd := *waldir
+		if wd == "" {
+			wd = walDir(dataDir)
+		}
+		result, err := dump.Verify(wd)
+		if err != nil {
+			fatalf(walErrorCode(err), "Failed verifying WAL: %v", err)
+		}
+		if !printVerify(os.Stdout, result) {
+			// the corruption is already reported on stdout in the text format
+			if errorFormat == "json" {
+				printError(os.Stderr, exitCorruptWAL, "WAL corrupted: "+result.Corruption.Error(), result.Corruption)
+			}
+			exit(exitCorruptWAL)
+		}
+		return
+	}
+
 	if !*raw {
-		ents := readUsingReadAll(lg, startFromIndex, startIndex, endIndex, snapfile, dataDir, waldir)
+		// keep stdout for the rows when exporting the entries
+		info := io.Writer(os.Stdout)
+		if exporting {
+			info = os.Stderr
+		}
+		r, snapshot := readEntries(lg, info, startFromIndex, startIndex, endIndex, snapfile, dataDir, waldir, *reverse, *skipCorrupt)
+		r.SetTermRange(*startTerm, *endTerm)
+		r.SetMinSize(minSize)
+		members := loadMembers(dataDir, *membersDB)
+		if members != nil {
+			r.SetMembers(members)
+			printMetadataMembers(info, r, members)
+		}
 
-		fmt.Printf("WAL entries: %d\n", len(ents))
-		if len(ents) > 0 {
-			fmt.Printf("lastIndex=%d\n", ents[len(ents)-1].Index)
+		// the entries are not counted when reading the WAL backwards
+		if !*reverse {
+			fmt.Fprintf(info, "WAL entries: %d\n", r.Count())
+			if r.Count() > 0 {
+				fmt.Fprintf(info, "lastIndex=%d\n", r.LastIndex())
+			}
 		}
 
+		if *hardstateHistory {
+			if err := printHardStateHistory(os.Stdout, r, members); err != nil {
+				fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
+			}
+			return
+		}
+		if *leaseReport {
+			if err := printLeaseReport(os.Stdout, r); err != nil {
+				fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
+			}
+			return
+		}
+		if *interactive {
+			rows, err := loadBrowseRows(entryIterator(r, *entrytype, false))
+			if err != nil {
+				fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
+			}
+			if err := browse(newBrowser(rows, members, redact.Redaction), os.Stdin, os.Stdout); err != nil {
+				fatalf(exitFailure, "Failed browsing entries: %v", err)
+			}
+			return
+		}
+		if *extractIndex != 0 {
+			if err := extractEntry(r, *extractIndex, *out); err != nil {
+				fatalf(walErrorCode(err), "Failed extracting entry: %v", err)
+			}
+			fmt.Printf("Entry data of index %d written to %s\n", *extractIndex, *out)
+			return
+		}
+		if *summary {
+			if err := printSummary(os.Stdout, r, *entrytype, *summaryTop, *summaryPrefixDepth); err != nil {
+				fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
+			}
+			return
+		}
+		if *topSize > 0 || *sizeHistogram {
+			if err := printTopSize(os.Stdout, r, *entrytype, *topSize); err != nil {
+				fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
+			}
+			return
+		}
+
+		if comma != 0 {
+			if err := exportEntries(os.Stdout, entryIterator(r, *entrytype, *reverse), exportFields, comma, *limit, *parallelism); err != nil {
+				fatalf(walErrorCode(err), "Failed exporting entries: %v", err)
+			}
+			return
+		}
+		if jsonOutput {
+			h := newJSONHeader(r, snapshot, exportFields, *reverse)
+			if err := exportJSON(os.Stdout, h, entryIterator(r, *entrytype, *reverse), *limit, *parallelism); err != nil {
+				fatalf(walErrorCode(err), "Failed exporting entries: %v", err)
+			}
+			return
+		}
+		if replayEnc != "" {
+			if err := exportReplay(os.Stdout, entryIterator(r, *entrytype, false), replayEnc, *limit); err != nil {
+				fatalf(walErrorCode(err), "Failed exporting entries: %v", err)
+			}
+			return
+		}
+
+		if *showOffsets {
+			fmt.Printf("%-37s\t%10s\t", "segment", "offset")
+		}
 		fmt.Printf("%4s\t%10s\ttype\tdata", "term", "index")
-		if *streamdecoder != "" {
+		if decoding {
 			fmt.Print("\tdecoder_status\tdecoded_data")
 		}
 		fmt.Println()
 
-		listEntriesType(*entrytype, *streamdecoder, ents)
-	} else {
-		if *snapfile != "" ||
-			*entrytype != defaultEntryTypes ||
-			*streamdecoder != "" {
-			log.Fatalf("Flags --entry-type, --stream-decoder, --entrytype not supported in the RAW mode.")
+		var decoder dump.DecodeCloser
+		if *decoderSpec != "" {
+			if decoder, err = dump.OpenDecoder(*decoderSpec); err != nil {
+				fatalf(exitDecoderFailure, "Failed opening decoder: %v", err)
+			}
+			defer decoder.Close()
 		}
-
+		listEntriesType(*entrytype, *streamdecoder, decoder, redact.Redaction, *limit, *reverse, *showOffsets, *pretty, *parallelism, r)
+	} else {
 		wd := *waldir
 		if wd == "" {
 			wd = walDir(dataDir)
 		}
-		readRaw(startIndex, wd, os.Stdout)
+		filter := allRecords()
+		filter.fromIndex, filter.endIndex = startIndex, *endIndex
+		filter.startTerm, filter.endTerm = *startTerm, *endTerm
+		if *entrytype != dump.DefaultEntryTypes {
+			filter.entryFilters = evaluateEntrytypeFlag(*entrytype)
+		}
+		if *snapfile != "" {
+			snapshot, err := snap.Read(lg, filepath.Join(snapDir(dataDir), *snapfile))
+			if err != nil {
+				fatalf(snapshotErrorCode(err), "Failed reading snapshot: %v", err)
+			}
+			filter.fromIndex = &snapshot.Metadata.Index
+		}
+		readRaw(filter, wd, *showOffsets, os.Stdout)
 	}
 }
 
-func readUsingReadAll(lg *zap.Logger, startFromIndex bool, startIndex *uint64, endIndex *uint64, snapfile *string, dataDir string, waldir *string) []raftpb.Entry {
+// readEntries prints the snapshot and WAL metadata to info and returns a reader over
+// the WAL entries to dump, and the snapshot they are dumped from, nil if there
+// is none or if dumping from an index. The entries are not loaded into memory. If reverse
+// is set, only the last WAL file is read to find the WAL metadata. If
+// skipCorrupt is set, the corrupt records are skipped and logged.
+func readEntries(lg *zap.Logger, info io.Writer, startFromIndex bool, startIndex *uint64, endIndex *uint64, snapfile *string, dataDir string, waldir *string, reverse, skipCorrupt bool) (*dump.Reader, *raftpb.Snapshot) {
 	var (
 		walsnap  walpb.Snapshot
 		snapshot *raftpb.Snapshot
@@ -122,8 +462,8 @@ func readUsingReadAll(lg *zap.Logger, startFromIndex bool, startIndex *uint64, e
 
 	endAtIndex := *endIndex < math.MaxUint64
 	if startFromIndex {
-		fmt.Printf("Start dumping log entries from index %d.\n", *startIndex)
-		// ReadAll() reads entries from the index after walsnap.Index, so we need to move walsnap.Index back one.
+		fmt.Fprintf(info, "Start dumping log entries from index %d.\n", *startIndex)
+		// The reader returns entries from the index after walsnap.Index, so we need to move walsnap.Index back one.
 		if *startIndex > 0 {
 			*startIndex--
 		}
@@ -145,14 +485,14 @@ func readUsingReadAll(lg *zap.Logger, startFromIndex bool, startIndex *uint64, e
 			if merr != nil {
 				confStateJSON = fmt.Appendf(nil, "confstate err: %v", merr)
 			}
-			fmt.Printf("Snapshot:\nterm=%d index=%d nodes=%s confstate=%s\n",
+			fmt.Fprintf(info, "Snapshot:\nterm=%d index=%d nodes=%s confstate=%s\n",
 				walsnap.Term, walsnap.Index, nodes, confStateJSON)
 		case errors.Is(err, snap.ErrNoSnapshot):
-			fmt.Print("Snapshot:\nempty\n")
+			fmt.Fprint(info, "Snapshot:\nempty\n")
 		default:
-			log.Fatalf("Failed loading snapshot: %v", err)
+			fatalf(snapshotErrorCode(err), "Failed loading snapshot: %v", err)
 		}
-		fmt.Println("Start dumping log entries from snapshot.")
+		fmt.Fprintln(info, "Start dumping log entries from snapshot.")
 	}
 
 	wd := *waldir
@@ -160,288 +500,300 @@ func readUsingReadAll(lg *zap.Logger, startFromIndex bool, startIndex *uint64, e
 		wd = walDir(dataDir)
 	}
 
-	w, err := wal.OpenForRead(zap.NewExample(), wd, walsnap)
+	r, err := dump.NewReader(wd, walsnap, *endIndex)
 	if err != nil {
-		log.Fatalf("Failed opening WAL: %v", err)
+		fatalf(walErrorCode(err), "Failed opening WAL: %v", err)
+	}
+	if skipCorrupt {
+		r.SetSkipCorrupt(func(s dump.SkippedRange) {
+			log.Printf("Skipped corrupt WAL records: %v, resuming at offset %d", &s.Corruption, s.End)
+		})
 	}
-	wmetadata, state, ents, err := w.ReadAll()
-	w.Close()
-	if err != nil && (!startFromIndex || !errors.Is(err, wal.ErrSnapshotNotFound)) {
-		// ReadAll might return ErrSliceOutOfRange and the first series of entries if the server is offline for a while and receives a snapshot from leader.
+	if reverse {
+		if err = r.ScanLast(); err != nil {
+			fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
+		}
+	} else if err = r.Scan(); err != nil && (!startFromIndex || !errors.Is(err, wal.ErrSnapshotNotFound)) {
+		// The WAL might contain a gap (ErrSliceOutOfRange) after the first series of entries if the server is offline for a while and receives a snapshot from leader.
 		// It is ok to ignore ErrSliceOutOfRange if just requesting a specific range of entries
 		if !endAtIndex || !errors.Is(err, wal.ErrSliceOutOfRange) {
-			log.Fatalf("Failed reading WAL: %v", err)
+			fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
 		}
 		log.Printf("Failed reading all WAL: %v", err)
 	}
-	id, cid := parseWALMetadata(wmetadata)
+	id, cid := parseWALMetadata(r.Metadata())
+	state := r.HardState()
 	vid := types.ID(state.Vote)
-	fmt.Printf("WAL metadata:\nnodeID=%s clusterID=%s term=%d commitIndex=%d vote=%s\n",
+	fmt.Fprintf(info, "WAL metadata:\nnodeID=%s clusterID=%s term=%d commitIndex=%d vote=%s\n",
 		id, cid, state.Term, state.Commit, vid)
-	if endAtIndex {
-		entries := make([]raftpb.Entry, 0)
-		for _, e := range ents {
-			// WAL might contain entries with e.Index >= *endIndex from prev term, then e.Index < *endIndex in the next term.
-			// We cannot break when e.Index >= *endIndex.
-			if e.Index >= *endIndex {
-				continue
-			}
-			entries = append(entries, e)
-		}
-		return entries
-	}
-	return ents
+	return r, snapshot
 }
 
-func walDir(dataDir string) string { return filepath.Join(dataDir, "member", "wal") }
-
-func snapDir(dataDir string) string { return filepath.Join(dataDir, "member", "snap") }
-
-func parseWALMetadata(b []byte) (id, cid types.ID) {
-	var metadata etcdserverpb.Metadata
-	pbutil.MustUnmarshal(&metadata, b)
-	id = types.ID(metadata.NodeID)
-	cid = types.ID(metadata.ClusterID)
-	return id, cid
-}
-
-func genIDSlice(a []uint64) []types.ID {
-	ids := make([]types.ID, len(a))
-	for i, id := range a {
-		ids[i] = types.ID(id)
+// loadMembers loads the members resolving the member IDs from the given
+// database, or from the database of the data directory if it is empty. It
+// returns nil if resolving the members is disabled, or if the database of
+// the data directory does not exist or cannot be read.
+func loadMembers(dataDir, dbPath string) dump.Members {
+	switch dbPath {
+	case "none":
+		return nil
+	case "":
+		dbPath = filepath.Join(snapDir(dataDir), "db")
+		if !fileutil.Exist(dbPath) {
+			return nil
+		}
+		members, err := dump.LoadMembers(dbPath)
+		if err != nil {
+			log.Printf("Failed loading members, member IDs are not resolved: %v", err)
+			return nil
+		}
+		return members
 	}
-	return ids
-}
-
-// excerpt replaces middle part with ellipsis and returns a double-quoted
-// string safely escaped with Go syntax.
-func excerpt(str string, pre, suf int) string {
-	if pre+suf > len(str) {
-		return fmt.Sprintf("%q", str)
+	members, err := dump.LoadMembers(dbPath)
+	if err != nil {
+		fatalf(exitFailure, "Failed loading members: %v", err)
 	}
-	return fmt.Sprintf("%q...%q", str[:pre], str[len(str)-suf:])
-}
-
-type EntryFilter func(e raftpb.Entry) (bool, string)
-
-// The 9 pass functions below takes the raftpb.Entry and return if the entry should be printed and the type of entry,
-// the type of the entry will used in the following print function
-func passConfChange(entry raftpb.Entry) (bool, string) {
-	return entry.Type == raftpb.EntryConfChange, "ConfigChange"
+	return members
 }
 
-func passInternalRaftRequest(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil, "InternalRaftRequest"
-}
-
-func passUnknownNormal(entry raftpb.Entry) (bool, string) {
-	var rr1 etcdserverpb.Request
-	var rr2 etcdserverpb.InternalRaftRequest
-	return (entry.Type == raftpb.EntryNormal) && (rr1.Unmarshal(entry.Data) != nil) && (rr2.Unmarshal(entry.Data) != nil), "UnknownNormal"
-}
-
-func passIRRRange(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Range != nil, "InternalRaftRequest"
+// printMetadataMembers resolves the member and the vote of the WAL metadata,
+// and prints the members.
+func printMetadataMembers(w io.Writer, r *dump.Reader, members dump.Members) {
+	id, _ := parseWALMetadata(r.Metadata())
+	for _, m := range []struct {
+		name string
+		id   uint64
+	}{{"nodeID", uint64(id)}, {"vote", r.HardState().Vote}} {
+		if d := members.Describe(m.id); d != "" {
+			fmt.Fprintf(w, "%s=%s %s\n", m.name, types.ID(m.id), d)
+		}
+	}
+	fmt.Fprintln(w, "Members:")
+	dump.PrintMembers(w, members)
 }
 
-func passIRRPut(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Put != nil, "InternalRaftRequest"
-}
+// diffWALs prints the metadata of the WALs of the data directories a and b,
+// and the differences between their entries. It returns false if they differ.
+func diffWALs(lg *zap.Logger, out io.Writer, startFromIndex bool, startIndex, endIndex uint64, snapfile, a, b string, skipCorrupt bool, redaction dump.Redaction) bool {
+	its := openWALs(lg, out, startFromIndex, startIndex, endIndex, snapfile, []string{"a", "b"}, []string{a, b}, skipCorrupt)
+	defer closeIterators(its)
 
-func passIRRDeleteRange(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.DeleteRange != nil, "InternalRaftRequest"
+	result, err := dump.Diff(its[0], its[1])
+	if err != nil {
+		fatalf(walErrorCode(err), "Failed comparing WALs: %v", err)
+	}
+	return printDiff(out, result, redaction)
 }
 
-func passIRRTxn(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Txn != nil, "InternalRaftRequest"
-}
+// mergeWALs prints the metadata of the WALs of the data directories, and a
+// merged table of their entries. It returns false if they do not all hold
+// the same entries.
+func mergeWALs(lg *zap.Logger, out io.Writer, startFromIndex bool, startIndex, endIndex uint64, snapfile string, dataDirs []string, skipCorrupt bool, redaction dump.Redaction) bool {
+	names := make([]string, len(dataDirs))
+	for i := range dataDirs {
+		names[i] = strconv.Itoa(i + 1)
+	}
+	its := openWALs(lg, out, startFromIndex, startIndex, endIndex, snapfile, names, dataDirs, skipCorrupt)
+	defer closeIterators(its)
 
-func passIRRCompaction(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.Compaction != nil, "InternalRaftRequest"
+	agreed, err := printMerge(out, its, redaction)
+	if err != nil {
+		fatalf(walErrorCode(err), "Failed merging WALs: %v", err)
+	}
+	return agreed
 }
 
-func passIRRLeaseGrant(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.LeaseGrant != nil, "InternalRaftRequest"
+// openWALs prints the metadata of the WAL of each data directory, named by
+// names, and returns iterators over their entries.
+func openWALs(lg *zap.Logger, out io.Writer, startFromIndex bool, startIndex, endIndex uint64, snapfile string, names, dataDirs []string, skipCorrupt bool) []*dump.Iterator {
+	var its []*dump.Iterator
+	for i, dataDir := range dataDirs {
+		// readEntries moves the start index back by one
+		start, end, snap, waldir := startIndex, endIndex, snapfile, ""
+		fmt.Fprintf(out, "WAL %s:\n", names[i])
+		r, _ := readEntries(lg, out, startFromIndex, &start, &end, &snap, dataDir, &waldir, false, skipCorrupt)
+		fmt.Fprintf(out, "WAL entries: %d\n", r.Count())
+		if r.Count() > 0 {
+			fmt.Fprintf(out, "lastIndex=%d\n", r.LastIndex())
+		}
+		fmt.Fprintln(out)
+		it, err := r.Entries()
+		if err != nil {
+			closeIterators(its)
+			fatalf(walErrorCode(err), "Failed reading WAL %s: %v", names[i], err)
+		}
+		its = append(its, it)
+	}
+	return its
 }
 
-func passIRRLeaseRevoke(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.LeaseRevoke != nil, "InternalRaftRequest"
+func closeIterators(its []*dump.Iterator) {
+	for _, it := range its {
+		it.Close()
+	}
 }
 
-func passIRRLeaseCheckpoint(entry raftpb.Entry) (bool, string) {
-	var rr etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr.Unmarshal(entry.Data) == nil && rr.LeaseCheckpoint != nil, "InternalRaftRequest"
-}
+// sources are the data and WAL directories given as archives or object
+// storage URLs, extracted into temporary directories removed on exit.
+var sources []*dump.Source
 
-func passRequest(entry raftpb.Entry) (bool, string) {
-	var rr1 etcdserverpb.Request
-	var rr2 etcdserverpb.InternalRaftRequest
-	return entry.Type == raftpb.EntryNormal && rr1.Unmarshal(entry.Data) == nil && rr2.Unmarshal(entry.Data) != nil, "Request"
+// openSource returns the local directory of the data or WAL directory p.
+func openSource(p string) string {
+	s, err := dump.OpenSource(context.Background(), p)
+	if err != nil {
+		fatalf(exitFailure, "Failed opening %s: %v", p, err)
+	}
+	sources = append(sources, s)
+	return s.Dir
 }
 
-type EntryPrinter func(e raftpb.Entry)
-
-// The 4 print functions below print the entry format based on there types
-
-// printInternalRaftRequest is used to print entry information for IRRRange, IRRPut,
-// IRRDeleteRange and IRRTxn entries
-func printInternalRaftRequest(entry raftpb.Entry) {
-	var rr etcdserverpb.InternalRaftRequest
-	if err := rr.Unmarshal(entry.Data); err == nil {
-		// Ensure we don't log user password
-		if rr.AuthUserChangePassword != nil && rr.AuthUserChangePassword.Password != "" {
-			rr.AuthUserChangePassword.Password = "<value removed>"
+func closeSources() {
+	for _, s := range sources {
+		if err := s.Close(); err != nil {
+			log.Printf("Failed removing %s: %v", s.Dir, err)
 		}
-		fmt.Printf("%4d\t%10d\tnorm\t%s", entry.Term, entry.Index, rr.String())
 	}
+	sources = nil
 }
 
-func printUnknownNormal(entry raftpb.Entry) {
-	fmt.Printf("%4d\t%10d\tnorm\t???", entry.Term, entry.Index)
-}
+func walDir(dataDir string) string { return filepath.Join(dataDir, "member", "wal") }
 
-func printConfChange(entry raftpb.Entry) {
-	fmt.Printf("%4d\t%10d", entry.Term, entry.Index)
-	fmt.Print("\tconf")
-	var r raftpb.ConfChange
-	if err := r.Unmarshal(entry.Data); err != nil {
-		fmt.Print("\t???")
-	} else {
-		fmt.Printf("\tmethod=%s id=%s", r.Type, types.ID(r.NodeID))
-	}
+func snapDir(dataDir string) string { return filepath.Join(dataDir, "member", "snap") }
+
+func parseWALMetadata(b []byte) (id, cid types.ID) {
+	var metadata etcdserverpb.Metadata
+	pbutil.MustUnmarshal(&metadata, b)
+	id = types.ID(metadata.NodeID)
+	cid = types.ID(metadata.ClusterID)
+	return id, cid
 }
 
-func printRequest(entry raftpb.Entry) {
-	var r etcdserverpb.Request
-	if err := r.Unmarshal(entry.Data); err == nil {
-		fmt.Printf("%4d\t%10d\tnorm", entry.Term, entry.Index)
-		switch r.Method {
-		case "":
-			fmt.Print("\tnoop")
-		case methodSync:
-			fmt.Printf("\tmethod=SYNC time=%q", time.Unix(0, r.Time).UTC())
-		case methodQGet, methodDelete:
-			fmt.Printf("\tmethod=%s path=%s", r.Method, excerpt(r.Path, 64, 64))
-		default:
-			fmt.Printf("\tmethod=%s path=%s val=%s", r.Method, excerpt(r.Path, 64, 64), excerpt(r.Val, 128, 0))
-		}
+func genIDSlice(a []uint64) []types.ID {
+	ids := make([]types.ID, len(a))
+	for i, id := range a {
+		ids[i] = types.ID(id)
 	}
+	return ids
 }
 
 // evaluateEntrytypeFlag evaluates entry-type flag and choose proper filter/filters to filter entries
-func evaluateEntrytypeFlag(entrytype string) []EntryFilter {
-	var entrytypelist []string
-	if entrytype != "" {
-		entrytypelist = strings.Split(entrytype, ",")
-	}
-
-	validRequest := map[string][]EntryFilter{
-		"ConfigChange":        {passConfChange},
-		"Normal":              {passInternalRaftRequest, passRequest, passUnknownNormal},
-		"Request":             {passRequest},
-		"InternalRaftRequest": {passInternalRaftRequest},
-		"IRRRange":            {passIRRRange},
-		"IRRPut":              {passIRRPut},
-		"IRRDeleteRange":      {passIRRDeleteRange},
-		"IRRTxn":              {passIRRTxn},
-		"IRRCompaction":       {passIRRCompaction},
-		"IRRLeaseGrant":       {passIRRLeaseGrant},
-		"IRRLeaseRevoke":      {passIRRLeaseRevoke},
-		"IRRLeaseCheckpoint":  {passIRRLeaseCheckpoint},
-	}
-	filters := make([]EntryFilter, 0)
-	for _, et := range entrytypelist {
-		if f, ok := validRequest[et]; ok {
-			filters = append(filters, f...)
-		} else {
-			log.Printf(`[%+v] is not a valid entry-type, ignored.
+func evaluateEntrytypeFlag(entrytype string) []dump.EntryFilter {
+	filters, invalid := dump.EntryFilters(entrytype)
+	for _, et := range invalid {
+		log.Printf(`[%+v] is not a valid entry-type, ignored.
 Please set entry-type to one or more of the following:
-ConfigChange, Normal, Request, InternalRaftRequest,
+ConfigChange (all the configuration changes), ConfigChangeV2,
+Normal, Request, InternalRaftRequest,
 IRRRange, IRRPut, IRRDeleteRange, IRRTxn,
-IRRCompaction, IRRLeaseGrant, IRRLeaseRevoke, IRRLeaseCheckpoint`, et)
-		}
+IRRCompaction, IRRLeaseGrant, IRRLeaseRevoke, IRRLeaseCheckpoint,
+IRRAuth (all the auth requests below), IRRAuthEnable, IRRAuthDisable,
+IRRAuthStatus, IRRAuthenticate, IRRAuthUserAdd, IRRAuthUserDelete,
+IRRAuthUserGet, IRRAuthUserChangePassword, IRRAuthUserGrantRole,
+IRRAuthUserRevokeRole, IRRAuthUserList, IRRAuthRoleList, IRRAuthRoleAdd,
+IRRAuthRoleDelete, IRRAuthRoleGet, IRRAuthRoleGrantPermission,
+IRRAuthRoleRevokePermission`, et)
 	}
-
 	return filters
 }
 
-// listEntriesType filters and prints entries based on the entry-type flag,
-func listEntriesType(entrytype string, streamdecoder string, ents []raftpb.Entry) {
+// entryIterator returns an iterator over the entries of r matching the
+// entry-type flag, from the last one to the first if reverse is set.
+func entryIterator(r *dump.Reader, entrytype string, reverse bool) *dump.Iterator {
 	entryFilters := evaluateEntrytypeFlag(entrytype)
-	printerMap := map[string]EntryPrinter{
-		"InternalRaftRequest": printInternalRaftRequest,
-		"Request":             printRequest,
-		"ConfigChange":        printConfChange,
-		"UnknownNormal":       printUnknownNormal,
+	if reverse {
+		return r.ReverseEntries(entryFilters...)
 	}
+	it, err := r.Entries(entryFilters...)
+	if err != nil {
+		fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
+	}
+	return it
+}
+
+// listEntriesType filters and prints entries based on the entry-type flag,
+// stopping after limit entries if limit is set. If showOffsets is set, each
+// entry is prefixed with its WAL file and the byte offset of its record. If
+// pretty is set, transactions are printed over several lines. If decoder is
+// set, the data of each entry is decoded by it instead of the stream decoder.
+// The entries are decoded and printed on parallelism goroutines.
+func listEntriesType(entrytype string, streamdecoder string, decoder dump.Decoder, redaction dump.Redaction, limit int, reverse bool, showOffsets bool, pretty bool, parallelism int, r *dump.Reader) {
 	var stderr strings.Builder
 	args := strings.Split(streamdecoder, " ")
 	cmd := exec.Command(args[0], args[1:]...)
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
-		log.Panic(err)
+		fatalf(exitDecoderFailure, "Failed starting decoder: %v", err)
 	}
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		log.Panic(err)
+		fatalf(exitDecoderFailure, "Failed starting decoder: %v", err)
 	}
 	cmd.Stderr = &stderr
 	if streamdecoder != "" {
 		err = cmd.Start()
 		if err != nil {
-			log.Panic(err)
+			fatalf(exitDecoderFailure, "Failed starting decoder: %v", err)
 		}
 	}
 
+	// the entries are redacted and printed by the workers of the pipeline
+	p := dump.NewPipeline(entryIterator(r, entrytype, reverse), parallelism, func(e dump.Entry) listedEntry {
+		var b bytes.Buffer
+		dump.Redact(&e, redaction)
+		if showOffsets {
+			fmt.Fprintf(&b, "%-37s\t%10d\t", e.Segment, e.Offset)
+		}
+		if pretty {
+			dump.PrintEntryPretty(&b, e)
+		} else {
+			dump.PrintEntry(&b, e)
+		}
+		return listedEntry{Entry: e, printed: b.Bytes()}
+	})
+	defer p.Close()
+
 	cnt := 0
 
-	for _, e := range ents {
-		passed := false
-		currtype := ""
-		for _, filter := range entryFilters {
-			passed, currtype = filter(e)
-			if passed {
-				cnt++
-				break
+	for (limit <= 0 || cnt < limit) && p.Next() {
+		e := p.Value()
+		cnt++
+		os.Stdout.Write(e.printed)
+		if decoder != nil {
+			if decoded, derr := decoder.Decode(e.Data); derr != nil {
+				fmt.Printf("\tERROR\t%q\n", derr.Error())
+			} else {
+				fmt.Printf("\tOK\t%q\n", decoded)
 			}
+			continue
+		}
+		if streamdecoder == "" {
+			fmt.Println()
+			continue
 		}
-		if passed {
-			printer := printerMap[currtype]
-			printer(e)
-			if streamdecoder == "" {
-				fmt.Println()
-				continue
-			}
 
-			// if decoder is set, pass the e.Data to stdin and read the stdout from decoder
-			io.WriteString(stdin, hex.EncodeToString(e.Data))
-			io.WriteString(stdin, "\n")
-			outputReader := bufio.NewReader(stdout)
-			decoderoutput, currerr := outputReader.ReadString('\n')
-			if currerr != nil {
-				fmt.Println(currerr)
-				return
-			}
+		// if decoder is set, pass the e.Data to stdin and read the stdout from decoder
+		io.WriteString(stdin, hex.EncodeToString(e.Data))
+		io.WriteString(stdin, "\n")
+		outputReader := bufio.NewReader(stdout)
+		decoderoutput, currerr := outputReader.ReadString('\n')
+		if currerr != nil {
+			fmt.Println(currerr)
+			fatalf(exitDecoderFailure, "Failed reading decoder output: %v", currerr)
+		}
 
-			decoderStatus, decodedData := parseDecoderOutput(decoderoutput)
+		decoderStatus, decodedData := parseDecoderOutput(decoderoutput)
 
-			fmt.Printf("\t%s\t%s", decoderStatus, decodedData)
-		}
+		fmt.Printf("\t%s\t%s", decoderStatus, decodedData)
+	}
+	if err := p.Err(); err != nil {
+		fatalf(walErrorCode(err), "Failed reading WAL: %v", err)
 	}
 
 	stdin.Close()
 	err = cmd.Wait()
 	if streamdecoder != "" {
 		if err != nil {
-			log.Panic(err)
+			fatalf(exitDecoderFailure, "Decoder failed: %v", err)
 		}
 		if stderr.String() != "" {
 			os.Stderr.WriteString("decoder stderr: " + stderr.String())
@@ -451,6 +803,12 @@ func listEntriesType(entrytype string, streamdecoder string, ents []raftpb.Entry
 	fmt.Printf("\nEntry types (%s) count is : %d\n", entrytype, cnt)
 }
 
+// listedEntry is an entry listed by listEntriesType and its printed form.
+type listedEntry struct {
+	dump.Entry
+	printed []byte
+}
+
 func parseDecoderOutput(decoderoutput string) (string, string) {
 	var decoderStatus string
 	var decodedData string
@@ -468,3 +826,28 @@ func parseDecoderOutput(decoderoutput string) (string, string) {
 	}
 	return decoderStatus, decodedData
 }
+
+// redactFlag is the value of the redact-values flag. It can be set without a
+// value, which selects the hash redaction.
+type redactFlag struct{ dump.Redaction }
+
+func (f *redactFlag) String() string {
+	if f == nil || f.Redaction == dump.RedactNone {
+		return ""
+	}
+	return f.Redaction.String()
+}
+
+func (f *redactFlag) Set(s string) (err error) {
+	switch s {
+	case "true":
+		s = "hash"
+	case "false":
+		f.Redaction = dump.RedactNone
+		return nil
+	}
+	f.Redaction, err = dump.ParseRedaction(s)
+	return err
+}
+
+func (f *redactFlag) IsBoolFlag() bool { return true }
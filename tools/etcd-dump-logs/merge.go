@@ -0,0 +1,95 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// printMerge prints a row per index held by at least one of the WALs, with
+// the term each WAL holds it at, or - if it does not hold it, and how the
+// WALs agree on it. The entries of the divergent indexes are printed from
+// each WAL holding them, redacted with redaction. It returns false if the
+// WALs do not all hold the same entries.
+func printMerge(out io.Writer, its []*dump.Iterator, redaction dump.Redaction) (bool, error) {
+	fmt.Fprintf(out, "%10s", "index")
+	for i := range its {
+		fmt.Fprintf(out, "\t%4d", i+1)
+	}
+	fmt.Fprintln(out, "\tstatus")
+
+	var (
+		indexes         int
+		counts          = map[dump.MergeStatus]int{}
+		held            = make([]int, len(its))
+		firstDivergence uint64
+	)
+	err := dump.Merge(its, func(m dump.MergedIndex) error {
+		indexes++
+		counts[m.Status]++
+		fmt.Fprintf(out, "%10d", m.Index)
+		for i, e := range m.Entries {
+			if e == nil {
+				fmt.Fprintf(out, "\t%4s", "-")
+				continue
+			}
+			held[i]++
+			fmt.Fprintf(out, "\t%4d", e.Term)
+		}
+		fmt.Fprintf(out, "\t%s\n", m.Status)
+
+		if m.Status != dump.TermDivergence && m.Status != dump.PayloadDivergence {
+			return nil
+		}
+		if firstDivergence == 0 {
+			firstDivergence = m.Index
+		}
+		for i, e := range m.Entries {
+			if e == nil {
+				continue
+			}
+			dump.Redact(e, redaction)
+			fmt.Fprintf(out, "\t%d: ", i+1)
+			dump.PrintEntry(out, *e)
+			fmt.Fprintln(out)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Fprintln(out)
+	entries := make([]string, len(held))
+	for i, n := range held {
+		entries[i] = fmt.Sprintf("%d: %d", i+1, n)
+	}
+	fmt.Fprintf(out, "Entries held: %s\n", strings.Join(entries, ", "))
+	if counts[dump.Agreed] == indexes {
+		fmt.Fprintf(out, "WALs identical: %d matching entries\n", indexes)
+		return true, nil
+	}
+	fmt.Fprintf(out, "WALs differ: %d indexes, %d agreed, %d missing, %d term divergences, %d payload divergences",
+		indexes, counts[dump.Agreed], counts[dump.Missing], counts[dump.TermDivergence], counts[dump.PayloadDivergence])
+	if firstDivergence != 0 {
+		fmt.Fprintf(out, ", first divergence at index %d", firstDivergence)
+	}
+	fmt.Fprintln(out)
+	return false, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/wal"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestPrintMerge(t *testing.T) {
+	put := func(term, index uint64, value string) raftpb.Entry {
+		rr := &etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte("foo"), Value: []byte(value)}}
+		return raftpb.Entry{Term: term, Index: index, Data: pbutil.MustMarshal(rr)}
+	}
+	iterator := func(ents ...raftpb.Entry) *dump.Iterator {
+		dir := t.TempDir()
+		w, err := wal.Create(zaptest.NewLogger(t), dir, nil)
+		require.NoError(t, err)
+		require.NoError(t, w.Save(raftpb.HardState{}, ents))
+		require.NoError(t, w.Close())
+		r, err := dump.NewReader(dir, walpb.Snapshot{}, math.MaxUint64)
+		require.NoError(t, err)
+		require.NoError(t, r.Scan())
+		it, err := r.Entries()
+		require.NoError(t, err)
+		t.Cleanup(func() { it.Close() })
+		return it
+	}
+
+	its := []*dump.Iterator{
+		iterator(put(1, 1, "a"), put(1, 2, "a"), put(2, 3, "a")),
+		iterator(put(1, 1, "a"), put(1, 2, "b")),
+		iterator(put(1, 1, "a"), put(1, 2, "a"), put(3, 3, "a"), put(3, 4, "a")),
+	}
+	var out bytes.Buffer
+	agreed, err := printMerge(&out, its, dump.RedactNone)
+	require.NoError(t, err)
+	assert.False(t, agreed)
+	assert.Equal(t, `     index	   1	   2	   3	status
+         1	   1	   1	   1	agreed
+         2	   1	   1	   1	payload divergence
+	1:    1	         2	norm	put:<key:"foo" value:"a" > 
+	2:    1	         2	norm	put:<key:"foo" value:"b" > 
+	3:    1	         2	norm	put:<key:"foo" value:"a" > 
+         3	   2	   -	   3	term divergence
+	1:    2	         3	norm	put:<key:"foo" value:"a" > 
+	3:    3	         3	norm	put:<key:"foo" value:"a" > 
+         4	   -	   -	   3	missing
+
+Entries held: 1: 3, 2: 2, 3: 4
+WALs differ: 4 indexes, 1 agreed, 1 missing, 1 term divergences, 1 payload divergences, first divergence at index 2
+`, out.String())
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
 
@@ -27,19 +28,67 @@ import (
 	"go.etcd.io/etcd/pkg/v3/pbutil"
 	"go.etcd.io/etcd/server/v3/storage/wal"
 	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
 	"go.etcd.io/raft/v3/raftpb"
 )
 
-func readRaw(fromIndex *uint64, waldir string, out io.Writer) {
-	var walReaders []fileutil.FileReader
+// rawFilter narrows down the records printed in the raw mode. The metadata and
+// CRC records are always printed.
+type rawFilter struct {
+	// fromIndex, if set, is the lowest index of the entries and snapshots, and
+	// the lowest commit index of the HardStates, printed.
+	fromIndex *uint64
+	// endIndex is the index the entries, snapshots and HardStates commit
+	// indexes printed stop at (exclusive).
+	endIndex uint64
+	// startTerm and endTerm are the range of the terms of the entries,
+	// snapshots and HardStates printed, endTerm is exclusive.
+	startTerm, endTerm uint64
+	// entryFilters, if set, are the types of the entries printed, the other
+	// entries are skipped.
+	entryFilters []dump.EntryFilter
+}
+
+// allRecords is the filter of the raw mode printing all the records.
+func allRecords() rawFilter {
+	return rawFilter{endIndex: math.MaxUint64, endTerm: math.MaxUint64}
+}
+
+func (f rawFilter) inRange(index, term uint64) bool {
+	return (f.fromIndex == nil || index >= *f.fromIndex) && index < f.endIndex &&
+		term >= f.startTerm && term < f.endTerm
+}
+
+func (f rawFilter) passEntry(e raftpb.Entry) bool {
+	if !f.inRange(e.Index, e.Term) {
+		return false
+	}
+	if f.entryFilters == nil {
+		return true
+	}
+	for _, filter := range f.entryFilters {
+		if ok, _ := filter(e); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// readRaw prints the records of the WAL files in waldir passing the filter. If
+// showOffsets is set, each record is prefixed with its WAL file and byte offset.
+func readRaw(filter rawFilter, waldir string, showOffsets bool, out io.Writer) {
+	var (
+		walFiles   []*os.File
+		walReaders []fileutil.FileReader
+	)
 	dirEntry, err := os.ReadDir(waldir)
 	if err != nil {
-		log.Fatalf("Error: Failed to read directory '%s' error:%v", waldir, err)
+		fatalf(exitFailure, "Error: Failed to read directory '%s' error:%v", waldir, err)
 	}
 	for _, e := range dirEntry {
 		finfo, err := e.Info()
 		if err != nil {
-			log.Fatalf("Error: failed to get fileInfo of file: %s, error: %v", e.Name(), err)
+			fatalf(exitFailure, "Error: failed to get fileInfo of file: %s, error: %v", e.Name(), err)
 		}
 		if filepath.Ext(finfo.Name()) != ".wal" {
 			log.Printf("Warning: Ignoring not .wal file: %s", finfo.Name())
@@ -48,10 +97,13 @@ func readRaw(fromIndex *uint64, waldir string, out io.Writer) {
 		f, err := os.Open(filepath.Join(waldir, finfo.Name()))
 		if err != nil {
 			log.Printf("Error: Failed to read file: %s . error:%v", finfo.Name(), err)
+			continue
 		}
+		walFiles = append(walFiles, f)
 		walReaders = append(walReaders, fileutil.NewFileReader(f))
 	}
 	decoder := wal.NewDecoderAdvanced(true, walReaders...)
+	locator := dump.NewRecordLocator(walFiles)
 	// The variable is used to not pollute log with multiple continuous crc errors.
 	crcDesync := false
 	for {
@@ -62,7 +114,12 @@ func readRaw(fromIndex *uint64, waldir string, out io.Writer) {
 				log.Printf("Error: Reading entry failed with CRC error: %c", err)
 				crcDesync = true
 			}
-			printRec(&rec, fromIndex, out)
+			var location string
+			if showOffsets {
+				segment, offset := locator.Locate(decoder, &rec)
+				location = fmt.Sprintf("%s:%d\t", segment, offset)
+			}
+			printRec(&rec, filter, location, out)
 			if rec.Type == wal.CrcType {
 				decoder.UpdateCRC(rec.Crc)
 				crcDesync = false
@@ -81,30 +138,31 @@ func readRaw(fromIndex *uint64, waldir string, out io.Writer) {
 	}
 }
 
-func printRec(rec *walpb.Record, fromIndex *uint64, out io.Writer) {
+// printRec prints the record, prefixed with location, if it passes the filter.
+func printRec(rec *walpb.Record, filter rawFilter, location string, out io.Writer) {
 	switch rec.Type {
 	case wal.MetadataType:
 		var metadata etcdserverpb.Metadata
 		pbutil.MustUnmarshal(&metadata, rec.Data)
-		fmt.Fprintf(out, "Metadata: %s\n", metadata.String())
+		fmt.Fprintf(out, "%sMetadata: %s\n", location, metadata.String())
 	case wal.CrcType:
-		fmt.Fprintf(out, "CRC: %d\n", rec.Crc)
+		fmt.Fprintf(out, "%sCRC: %d\n", location, rec.Crc)
 	case wal.EntryType:
 		e := wal.MustUnmarshalEntry(rec.Data)
-		if fromIndex == nil || e.Index >= *fromIndex {
-			fmt.Fprintf(out, "Entry: %s\n", e.String())
+		if filter.passEntry(e) {
+			fmt.Fprintf(out, "%sEntry: %s\n", location, e.String())
 		}
 	case wal.SnapshotType:
 		var snap walpb.Snapshot
 		pbutil.MustUnmarshal(&snap, rec.Data)
-		if fromIndex == nil || snap.Index >= *fromIndex {
-			fmt.Fprintf(out, "Snapshot: %s\n", snap.String())
+		if filter.inRange(snap.Index, snap.Term) {
+			fmt.Fprintf(out, "%sSnapshot: %s\n", location, snap.String())
 		}
 	case wal.StateType:
 		var state raftpb.HardState
 		pbutil.MustUnmarshal(&state, rec.Data)
-		if fromIndex == nil || state.Commit >= *fromIndex {
-			fmt.Fprintf(out, "HardState: %s\n", state.String())
+		if filter.inRange(state.Commit, state.Term) {
+			fmt.Fprintf(out, "%sHardState: %s\n", location, state.String())
 		}
 	default:
 		log.Printf("Unexpected WAL log type: %d", rec.Type)
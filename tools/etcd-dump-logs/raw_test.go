@@ -15,6 +15,7 @@ package main
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,7 +25,7 @@ func Test_readRaw(t *testing.T) {
 	path := t.TempDir()
 	mustCreateWALLog(t, path)
 	var out bytes.Buffer
-	readRaw(nil, walDir(path), &out)
+	readRaw(allRecords(), walDir(path), false, &out)
 	assert.Equal(t,
 		`CRC: 0
 Metadata: 
@@ -66,3 +67,76 @@ Entry: Term:27 Index:34 Data:"?"
 EOF: All entries were processed.
 `, out.String())
 }
+
+func Test_readRawShowOffsets(t *testing.T) {
+	path := t.TempDir()
+	mustCreateWALLog(t, path)
+	var out bytes.Buffer
+	readRaw(allRecords(), walDir(path), true, &out)
+	lines := strings.SplitN(out.String(), "\n", 5)
+	assert.Equal(t, []string{
+		"0000000000000000-0000000000000000.wal:0\tCRC: 0",
+		"0000000000000000-0000000000000000.wal:16\tMetadata: ",
+		"0000000000000000-0000000000000000.wal:32\tSnapshot: ",
+		`0000000000000000-0000000000000000.wal:56	Entry: Term:1 Index:1 Type:EntryConfChange Data:"\010\001\020\000\030\002\"\000" `,
+	}, lines[:4])
+}
+
+func Test_readRawFilter(t *testing.T) {
+	path := t.TempDir()
+	mustCreateWALLog(t, path)
+
+	from := uint64(10)
+	tcs := []struct {
+		name   string
+		filter func(f *rawFilter)
+		want   []string
+	}{
+		{
+			name: "index range",
+			filter: func(f *rawFilter) {
+				f.fromIndex, f.endIndex = &from, 12
+			},
+			want: []string{
+				"CRC: 0",
+				"Metadata: ",
+				`Entry: Term:4 Index:10 Data:"\010\005\032\025\n\0011\022\002hi\030\006 \001(\001X\240\234\001h\240\234\001" `,
+				`Entry: Term:5 Index:11 Data:"\010\006\"\020\n\004foo1\022\004bar1\030\0010\001" `,
+			},
+		},
+		{
+			name: "term range",
+			filter: func(f *rawFilter) {
+				f.startTerm, f.endTerm = 26, 27
+			},
+			want: []string{
+				"CRC: 0",
+				"Metadata: ",
+				`Entry: Term:26 Index:32 Data:"\010\033\232K\033\n\005role3\022\022\010\001\022\004Keys\032\010RangeEnd" `,
+			},
+		},
+		{
+			name: "entry type",
+			filter: func(f *rawFilter) {
+				f.fromIndex = &from
+				f.entryFilters = evaluateEntrytypeFlag("IRRDeleteRange,IRRTxn")
+			},
+			want: []string{
+				"CRC: 0",
+				"Metadata: ",
+				`Entry: Term:6 Index:12 Data:"\010\007*\010\n\0010\022\0019\030\001" `,
+				`Entry: Term:7 Index:13 Data:"\010\0102\024\022\010\032\006\n\001a\022\001b\032\010\032\006\n\001a\022\001b" `,
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := allRecords()
+			tc.filter(&filter)
+			var out bytes.Buffer
+			readRaw(filter, walDir(path), false, &out)
+			lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+			assert.Equal(t, append(tc.want, "EOF: All entries were processed."), lines)
+		})
+	}
+}
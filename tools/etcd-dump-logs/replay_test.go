@@ -0,0 +1,74 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// fakeKV fails the puts of the key failKey.
+type fakeKV struct {
+	etcdserverpb.KVClient
+	failKey string
+	puts    []string
+}
+
+func (kv *fakeKV) Put(_ context.Context, r *etcdserverpb.PutRequest, _ ...grpc.CallOption) (*etcdserverpb.PutResponse, error) {
+	if string(r.Key) == kv.failKey {
+		return nil, errors.New("no space")
+	}
+	kv.puts = append(kv.puts, string(r.Key))
+	return &etcdserverpb.PutResponse{}, nil
+}
+
+func TestReplayEntries(t *testing.T) {
+	put := func(index uint64, key string) raftpb.Entry {
+		return raftpb.Entry{Term: 1, Index: index, Data: pbutil.MustMarshal(&etcdserverpb.InternalRaftRequest{ID: index, Put: &etcdserverpb.PutRequest{Key: []byte(key)}})}
+	}
+	var stream bytes.Buffer
+	w, err := dump.NewReplayWriter(&stream, dump.ReplayBase64)
+	require.NoError(t, err)
+	for _, e := range []raftpb.Entry{
+		put(1, "foo"),
+		{Term: 1, Index: 2, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{NodeID: 2})},
+		put(3, "bar"),
+		put(4, "baz"),
+	} {
+		require.NoError(t, w.Write(e))
+	}
+	require.NoError(t, w.Flush())
+
+	r, err := dump.NewReplayReader(&stream)
+	require.NoError(t, err)
+	kv := &fakeKV{failKey: "bar"}
+	var out bytes.Buffer
+	stats, err := replayEntries(context.Background(), &out, r, dump.ReplayTarget{KV: kv})
+	require.NoError(t, err)
+	assert.Equal(t, replayStats{applied: 2, skipped: 1, failed: 1}, stats)
+	assert.Equal(t, []string{"foo", "baz"}, kv.puts)
+	assert.Equal(t, "Failed applying entry term=1 index=3: no space\n", out.String())
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/dustin/go-humanize"
+
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// sizeBuckets are the upper bounds (inclusive) of the entry data size histogram.
+// Entries larger than the last bound are counted in an overflow bucket.
+var sizeBuckets = []int{64, 256, 1024, 4 * 1024, 16 * 1024, 64 * 1024, 256 * 1024, 1024 * 1024, 4 * 1024 * 1024}
+
+// maxRequestBytes is the default limit of the size of the requests proposed by
+// etcd, see embed.DefaultMaxRequestBytes. Larger entries were proposed by a
+// member started with a higher --max-request-bytes.
+const maxRequestBytes = 1.5 * 1024 * 1024
+
+// sizeReport keeps the n largest entries and a histogram of the data sizes of
+// all the entries added to it.
+type sizeReport struct {
+	n int
+	// top is ordered by decreasing data size; entries of equal size keep the WAL order.
+	top    []raftpb.Entry
+	counts []int
+	total  int
+	added  int
+	// over is the number of entries larger than maxRequestBytes.
+	over int
+}
+
+func newSizeReport(n int) *sizeReport {
+	return &sizeReport{n: n, counts: make([]int, len(sizeBuckets)+1)}
+}
+
+func (r *sizeReport) add(e raftpb.Entry) {
+	r.added++
+	r.total += len(e.Data)
+	r.counts[sort.SearchInts(sizeBuckets, len(e.Data))]++
+	if len(e.Data) > maxRequestBytes {
+		r.over++
+	}
+
+	i := sort.Search(len(r.top), func(i int) bool { return len(r.top[i].Data) < len(e.Data) })
+	if i >= r.n {
+		return
+	}
+	if len(r.top) < r.n {
+		r.top = append(r.top, raftpb.Entry{})
+	}
+	copy(r.top[i+1:], r.top[i:])
+	r.top[i] = e
+}
+
+// print prints the largest entries followed by the size histogram. Only the
+// histogram is printed if the report keeps no entries.
+func (r *sizeReport) print(out io.Writer) {
+	if r.n > 0 {
+		r.printTop(out)
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintf(out, "Entry data size histogram (%d entries, %s total):\n", r.added, humanize.IBytes(uint64(r.total)))
+	for i, b := range sizeBuckets {
+		fmt.Fprintf(out, "%12s\t%d\n", "<= "+humanize.IBytes(uint64(b)), r.counts[i])
+	}
+	fmt.Fprintf(out, "%12s\t%d\n", "> "+humanize.IBytes(uint64(sizeBuckets[len(sizeBuckets)-1])), r.counts[len(sizeBuckets)])
+	fmt.Fprintf(out, "Entries larger than the default max request size (%s): %d\n", humanize.IBytes(maxRequestBytes), r.over)
+}
+
+func (r *sizeReport) printTop(out io.Writer) {
+	fmt.Fprintf(out, "Top %d largest entries:\n", len(r.top))
+	fmt.Fprintf(out, "%4s\t%10s\ttype\tsize\tkey\n", "term", "index")
+	for _, e := range r.top {
+		typ, key := dump.DescribeEntry(e)
+		if key != "" {
+			key = dump.Excerpt(key, 64, 64)
+		}
+		fmt.Fprintf(out, "%4d\t%10d\t%s\t%d\t%s\n", e.Term, e.Index, typ, len(e.Data), key)
+	}
+}
+
+// printTopSize prints the n entries passing the entry-type filter with the
+// largest data payload followed by a histogram of their data sizes. If n is
+// zero, only the histogram is printed.
+func printTopSize(out io.Writer, r *dump.Reader, entrytype string, n int) error {
+	it, err := r.Entries(evaluateEntrytypeFlag(entrytype)...)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	report := newSizeReport(n)
+	for it.Next() {
+		report.add(it.Entry().Entry)
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	report.print(out)
+	return nil
+}
+
+// errEntryNotFound is returned by extractEntry if the WAL has no entry at the
+// given index.
+var errEntryNotFound = errors.New("not found")
+
+// extractEntry writes the raw data of the entry at the given index to the file.
+func extractEntry(r *dump.Reader, index uint64, file string) error {
+	it, err := r.Entries()
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.Next() {
+		if e := it.Entry(); e.Index == index {
+			return os.WriteFile(file, e.Data, 0o600)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("entry with index %d %w", index, errEntryNotFound)
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/server/v3/storage/wal/walpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestPrintTopSize(t *testing.T) {
+	big := &etcdserverpb.InternalRaftRequest{ID: 1, Put: &etcdserverpb.PutRequest{Key: []byte("big"), Value: []byte(strings.Repeat("x", 2000))}}
+	small := &etcdserverpb.InternalRaftRequest{ID: 2, Put: &etcdserverpb.PutRequest{Key: []byte("small"), Value: []byte("v")}}
+	ents := []raftpb.Entry{
+		{Term: 1, Index: 1, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(small)},
+		{Term: 1, Index: 2, Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(big)},
+		{Term: 2, Index: 3, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{NodeID: 2})},
+	}
+
+	report := newSizeReport(2)
+	for _, e := range ents {
+		report.add(e)
+	}
+	var out bytes.Buffer
+	report.print(&out)
+	assert.Equal(t, `Top 2 largest entries:
+term	     index	type	size	key
+   1	         2	IRRPut	2013	"big"
+   1	         1	IRRPut	14	"small"
+
+Entry data size histogram (3 entries, 2.0 KiB total):
+     <= 64 B	2
+    <= 256 B	0
+  <= 1.0 KiB	0
+  <= 4.0 KiB	1
+   <= 16 KiB	0
+   <= 64 KiB	0
+  <= 256 KiB	0
+  <= 1.0 MiB	0
+  <= 4.0 MiB	0
+   > 4.0 MiB	0
+Entries larger than the default max request size (1.5 MiB): 0
+`, out.String())
+}
+
+func TestSizeHistogram(t *testing.T) {
+	report := newSizeReport(0)
+	for i, size := range []int{10, 2 * 1024 * 1024, 40 * 1000 * 1000} {
+		report.add(raftpb.Entry{Term: 1, Index: uint64(i + 1), Data: make([]byte, size)})
+	}
+	var out bytes.Buffer
+	report.print(&out)
+	assert.Equal(t, `Entry data size histogram (3 entries, 40 MiB total):
+     <= 64 B	1
+    <= 256 B	0
+  <= 1.0 KiB	0
+  <= 4.0 KiB	0
+   <= 16 KiB	0
+   <= 64 KiB	0
+  <= 256 KiB	0
+  <= 1.0 MiB	0
+  <= 4.0 MiB	1
+   > 4.0 MiB	1
+Entries larger than the default max request size (1.5 MiB): 2
+`, out.String())
+}
+
+func TestExtractEntry(t *testing.T) {
+	p := t.TempDir()
+	mustCreateWALLog(t, p)
+	r, err := dump.NewReader(walDir(p), walpb.Snapshot{}, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, r.Scan())
+	file := filepath.Join(t.TempDir(), "entry.bin")
+
+	require.NoError(t, extractEntry(r, 11, file))
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	var rr etcdserverpb.InternalRaftRequest
+	require.NoError(t, rr.Unmarshal(data))
+	assert.Equal(t, "foo1", string(rr.Put.Key))
+
+	require.Error(t, extractEntry(r, 100, file))
+}
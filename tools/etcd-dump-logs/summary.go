@@ -0,0 +1,217 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+// usage counts entries and their data size.
+type usage struct {
+	count int
+	bytes int
+}
+
+func (u *usage) add(size int) {
+	u.count++
+	u.bytes += size
+}
+
+// summaryReport aggregates the entries added to it by raft entry type,
+// operation, term and written key prefix.
+type summaryReport struct {
+	n           int
+	prefixDepth int
+
+	types      map[raftpb.EntryType]*usage
+	operations map[string]*usage
+	terms      map[uint64]*usage
+	// prefixes counts the keys written per prefix.
+	prefixes map[string]int
+	sizes    *sizeReport
+}
+
+func newSummaryReport(n, prefixDepth int) *summaryReport {
+	return &summaryReport{
+		n:           n,
+		prefixDepth: prefixDepth,
+		types:       make(map[raftpb.EntryType]*usage),
+		operations:  make(map[string]*usage),
+		terms:       make(map[uint64]*usage),
+		prefixes:    make(map[string]int),
+		sizes:       newSizeReport(n),
+	}
+}
+
+func addUsage[K comparable](m map[K]*usage, k K, size int) {
+	u, ok := m[k]
+	if !ok {
+		u = &usage{}
+		m[k] = u
+	}
+	u.add(size)
+}
+
+func (r *summaryReport) add(e dump.Entry) {
+	size := len(e.Data)
+	addUsage(r.types, e.Entry.Type, size)
+	addUsage(r.terms, e.Term, size)
+	r.sizes.add(e.Entry)
+
+	op, _ := dump.DescribeEntry(e.Entry)
+	if rr := e.InternalRaftRequest; rr != nil {
+		op = irrOperation(rr)
+		r.addWrites(rr)
+	}
+	addUsage(r.operations, op, size)
+}
+
+// irrOperation returns the name of the operation of the request, in the
+// format of the entry-type flag, e.g. IRRPut.
+func irrOperation(rr *etcdserverpb.InternalRaftRequest) string {
+	if name := dump.RequestName(rr); name != "" {
+		return "IRR" + name
+	}
+	return "InternalRaftRequest"
+}
+
+func (r *summaryReport) addWrites(rr *etcdserverpb.InternalRaftRequest) {
+	switch {
+	case rr.Put != nil:
+		r.prefixes[keyPrefix(rr.Put.Key, r.prefixDepth)]++
+	case rr.DeleteRange != nil:
+		r.prefixes[keyPrefix(rr.DeleteRange.Key, r.prefixDepth)]++
+	case rr.Txn != nil:
+		r.addTxnWrites(rr.Txn)
+	}
+}
+
+func (r *summaryReport) addTxnWrites(txn *etcdserverpb.TxnRequest) {
+	for _, ops := range [][]*etcdserverpb.RequestOp{txn.Success, txn.Failure} {
+		for _, op := range ops {
+			switch {
+			case op.GetRequestPut() != nil:
+				r.prefixes[keyPrefix(op.GetRequestPut().Key, r.prefixDepth)]++
+			case op.GetRequestDeleteRange() != nil:
+				r.prefixes[keyPrefix(op.GetRequestDeleteRange().Key, r.prefixDepth)]++
+			case op.GetRequestTxn() != nil:
+				r.addTxnWrites(op.GetRequestTxn())
+			}
+		}
+	}
+}
+
+// keyPrefix returns the first depth '/' separated segments of the key,
+// including the trailing '/'. A leading '/' does not start a segment.
+func keyPrefix(key []byte, depth int) string {
+	k := string(key)
+	start := 0
+	if strings.HasPrefix(k, "/") {
+		start = 1
+	}
+	for i := 0; i < depth; i++ {
+		j := strings.IndexByte(k[start:], '/')
+		if j < 0 {
+			return k
+		}
+		start += j + 1
+	}
+	return k[:start]
+}
+
+// print prints the aggregated usages followed by the most written key
+// prefixes and the largest entries.
+func (r *summaryReport) print(out io.Writer) {
+	types := make([]raftpb.EntryType, 0, len(r.types))
+	for typ := range r.types {
+		types = append(types, typ)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	fmt.Fprintf(out, "Entries by type:\n%-28s\t%10s\t%12s\n", "type", "count", "bytes")
+	for _, typ := range types {
+		fmt.Fprintf(out, "%-28s\t%10d\t%12d\n", typ, r.types[typ].count, r.types[typ].bytes)
+	}
+
+	ops := make([]string, 0, len(r.operations))
+	for op := range r.operations {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if r.operations[ops[i]].bytes != r.operations[ops[j]].bytes {
+			return r.operations[ops[i]].bytes > r.operations[ops[j]].bytes
+		}
+		return ops[i] < ops[j]
+	})
+	fmt.Fprintf(out, "\nEntries by operation:\n%-28s\t%10s\t%12s\n", "operation", "count", "bytes")
+	for _, op := range ops {
+		fmt.Fprintf(out, "%-28s\t%10d\t%12d\n", op, r.operations[op].count, r.operations[op].bytes)
+	}
+
+	terms := make([]uint64, 0, len(r.terms))
+	for term := range r.terms {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i] < terms[j] })
+	fmt.Fprintf(out, "\nEntries by term:\n%4s\t%10s\t%12s\n", "term", "count", "bytes")
+	for _, term := range terms {
+		fmt.Fprintf(out, "%4d\t%10d\t%12d\n", term, r.terms[term].count, r.terms[term].bytes)
+	}
+
+	prefixes := make([]string, 0, len(r.prefixes))
+	for prefix := range r.prefixes {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		if r.prefixes[prefixes[i]] != r.prefixes[prefixes[j]] {
+			return r.prefixes[prefixes[i]] > r.prefixes[prefixes[j]]
+		}
+		return prefixes[i] < prefixes[j]
+	})
+	if len(prefixes) > r.n {
+		prefixes = prefixes[:r.n]
+	}
+	fmt.Fprintf(out, "\nTop %d most written key prefixes:\n%10s\tprefix\n", len(prefixes), "writes")
+	for _, prefix := range prefixes {
+		fmt.Fprintf(out, "%10d\t%s\n", r.prefixes[prefix], dump.Excerpt(prefix, 64, 64))
+	}
+
+	fmt.Fprintln(out)
+	r.sizes.print(out)
+}
+
+// printSummary prints the summary of the entries passing the entry-type filter.
+func printSummary(out io.Writer, r *dump.Reader, entrytype string, n, prefixDepth int) error {
+	it, err := r.Entries(evaluateEntrytypeFlag(entrytype)...)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	report := newSummaryReport(n, prefixDepth)
+	for it.Next() {
+		report.add(it.Entry())
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	report.print(out)
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/pkg/v3/pbutil"
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+	"go.etcd.io/raft/v3/raftpb"
+)
+
+func TestSummaryReport(t *testing.T) {
+	put := func(key string) *etcdserverpb.InternalRaftRequest {
+		return &etcdserverpb.InternalRaftRequest{Put: &etcdserverpb.PutRequest{Key: []byte(key), Value: []byte("v")}}
+	}
+	txn := &etcdserverpb.InternalRaftRequest{Txn: &etcdserverpb.TxnRequest{
+		Success: []*etcdserverpb.RequestOp{
+			{Request: &etcdserverpb.RequestOp_RequestPut{RequestPut: &etcdserverpb.PutRequest{Key: []byte("/registry/pods/b")}}},
+			{Request: &etcdserverpb.RequestOp_RequestDeleteRange{RequestDeleteRange: &etcdserverpb.DeleteRangeRequest{Key: []byte("/registry/leases/a")}}},
+		},
+	}}
+	irrs := []*etcdserverpb.InternalRaftRequest{
+		put("/registry/pods/a"),
+		put("/registry/configmaps/a"),
+		txn,
+		{AuthUserAdd: &etcdserverpb.AuthUserAddRequest{Name: "root"}},
+		put("foo"),
+	}
+	var ents []raftpb.Entry
+	for i, rr := range irrs {
+		ents = append(ents, raftpb.Entry{Term: uint64(1 + i/3), Index: uint64(i + 1), Type: raftpb.EntryNormal, Data: pbutil.MustMarshal(rr)})
+	}
+	ents = append(ents, raftpb.Entry{Term: 2, Index: 6, Type: raftpb.EntryConfChange, Data: pbutil.MustMarshal(&raftpb.ConfChange{NodeID: 2})})
+
+	report := newSummaryReport(2, 2)
+	for _, e := range ents {
+		report.add(dump.DecodeEntry(e, ""))
+	}
+	var out bytes.Buffer
+	report.print(&out)
+	assert.Equal(t, `Entries by type:
+type                        	     count	       bytes
+EntryNormal                 	         5	         119
+EntryConfChange             	         1	           6
+
+Entries by operation:
+operation                   	     count	       bytes
+IRRPut                      	         3	          62
+IRRTxn                      	         1	          48
+IRRAuthUserAdd              	         1	           9
+ConfigChange                	         1	           6
+
+Entries by term:
+term	     count	       bytes
+   1	         3	         100
+   2	         3	          25
+
+Top 2 most written key prefixes:
+    writes	prefix
+         2	"/registry/pods/"
+         1	"/registry/configmaps/"
+
+Top 2 largest entries:
+term	     index	type	size	key
+   1	         3	IRRTxn	48	"/registry/pods/b"
+   1	         2	IRRPut	29	"/registry/configmaps/a"
+
+Entry data size histogram (6 entries, 125 B total):
+     <= 64 B	6
+    <= 256 B	0
+  <= 1.0 KiB	0
+  <= 4.0 KiB	0
+   <= 16 KiB	0
+   <= 64 KiB	0
+  <= 256 KiB	0
+  <= 1.0 MiB	0
+  <= 4.0 MiB	0
+   > 4.0 MiB	0
+Entries larger than the default max request size (1.5 MiB): 0
+`, out.String())
+}
+
+func TestKeyPrefix(t *testing.T) {
+	tcs := []struct {
+		key   string
+		depth int
+		want  string
+	}{
+		{key: "/registry/pods/default/a", depth: 2, want: "/registry/pods/"},
+		{key: "/registry/pods/default/a", depth: 1, want: "/registry/"},
+		{key: "a/b/c", depth: 1, want: "a/"},
+		{key: "/registry/pods", depth: 2, want: "/registry/pods"},
+		{key: "foo", depth: 2, want: "foo"},
+		{key: "/foo", depth: 0, want: "/"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.want, keyPrefix([]byte(tc.key), tc.depth), tc.key)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main is a decoder plugin for the tests, built with
+// go build -buildmode=plugin. It numbers the entries it decodes, to show that
+// the decoder keeps its state across the entries of a dump.
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+type decoder struct{ n int }
+
+func (d *decoder) Decode(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty entry")
+	}
+	d.n++
+	return fmt.Appendf(nil, "#%d %d bytes", d.n, len(data)), nil
+}
+
+var Decoder = &decoder{}
+
+func main() {}
@@ -0,0 +1,50 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build wasip1
+
+// Package main is a decoder module for the tests, built with
+// GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared. It numbers the entries
+// it decodes, to show that the decoder keeps its state across the entries of a
+// dump.
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+var (
+	in, out []byte
+	n       int
+)
+
+//go:wasmexport alloc
+func alloc(size uint32) uint32 {
+	in = make([]byte, max(size, 1))
+	return uint32(uintptr(unsafe.Pointer(&in[0])))
+}
+
+//go:wasmexport decode
+func decode(_, size uint32) uint64 {
+	if size == 0 {
+		out = append([]byte{1}, "empty entry"...)
+	} else {
+		n++
+		out = fmt.Appendf([]byte{0}, "#%d %d bytes", n, size)
+	}
+	return uint64(uintptr(unsafe.Pointer(&out[0])))<<32 | uint64(len(out))
+}
+
+func main() {}
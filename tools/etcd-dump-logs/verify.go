@@ -0,0 +1,48 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"go.etcd.io/etcd/v3/tools/etcd-dump-logs/dump"
+)
+
+// printVerify prints the segments checked by dump.Verify followed by the
+// verification outcome. It returns false if the WAL is corrupted.
+func printVerify(out io.Writer, result *dump.VerifyResult) bool {
+	var entries int
+	fmt.Fprintf(out, "%-37s\t%7s\t%7s\t%10s\t%10s\n", "segment", "records", "entries", "first", "last")
+	for _, seg := range result.Segments {
+		entries += seg.Entries
+		if seg.Entries == 0 {
+			fmt.Fprintf(out, "%-37s\t%7d\t%7d\t%10s\t%10s\n", seg.Name, seg.Records, seg.Entries, "-", "-")
+			continue
+		}
+		fmt.Fprintf(out, "%-37s\t%7d\t%7d\t%10d\t%10d\n", seg.Name, seg.Records, seg.Entries, seg.FirstIndex, seg.LastIndex)
+	}
+	fmt.Fprintln(out)
+
+	if c := result.TornTail; c != nil {
+		fmt.Fprintf(out, "Last record of %s at offset %d is partially written (repaired when etcd starts): %v\n", c.Segment, c.Offset, c.Err)
+	}
+	if c := result.Corruption; c != nil {
+		fmt.Fprintf(out, "WAL corrupted: first invalid record in %s at offset %d: %v\n", c.Segment, c.Offset, c.Err)
+		return false
+	}
+	fmt.Fprintf(out, "WAL verified: %d segments, %d entries\n", len(result.Segments), entries)
+	return true
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func main() {
+	endpoints := flag.String("endpoints", "127.0.0.1:2379", "Comma separated list of the gRPC endpoints used to list the members")
+	output := flag.String("output", "dot", "The format of the topology: dot or json")
+	zones := flag.String("zones", "", "Comma separated list of member name=zone pairs used to group the members by zone")
+	dialTimeout := flag.Duration("dial-timeout", 2*time.Second, "Dial timeout for client connections")
+	commandTimeout := flag.Duration("command-timeout", 5*time.Second, "Timeout of the member list and of each member status request")
+	cacert := flag.String("cacert", "", "Verify certificates of TLS-enabled secure servers using this CA bundle")
+	cert := flag.String("cert", "", "Identify secure client using this TLS certificate file")
+	key := flag.String("key", "", "Identify secure client using this TLS key file")
+	user := flag.String("user", "", "Username:password for authentication")
+	flag.Parse()
+
+	if *output != "dot" && *output != "json" {
+		log.Fatalf("Invalid output format %q, must be dot or json", *output)
+	}
+	zoneMap, err := parseZones(*zones)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	spec := &clientv3.ConfigSpec{
+		Endpoints:   strings.Split(*endpoints, ","),
+		DialTimeout: *dialTimeout,
+	}
+	if *cacert != "" || *cert != "" || *key != "" {
+		spec.Secure = &clientv3.SecureConfig{Cacert: *cacert, Cert: *cert, Key: *key}
+	}
+	if *user != "" {
+		name, password, _ := strings.Cut(*user, ":")
+		spec.Auth = &clientv3.AuthConfig{Username: name, Password: password}
+	}
+	cfg, err := clientv3.NewClientConfig(spec, zap.NewNop())
+	if err != nil {
+		log.Fatalf("Failed to configure client: %v", err)
+	}
+	cfg.Logger = zap.NewNop()
+	c, err := clientv3.New(*cfg)
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	t, err := collectTopology(context.Background(), c, zoneMap, *commandTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	t.Time = time.Now()
+
+	if *output == "json" {
+		err = writeJSON(os.Stdout, t)
+	} else {
+		err = writeDOT(os.Stdout, t)
+	}
+	if err != nil {
+		log.Fatalf("Failed to write topology: %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+)
+
+func writeJSON(w io.Writer, t *topology) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
+
+// writeDOT writes the topology as a Graphviz graph. The members are grouped
+// by zone, and the leader has an edge to every other member labeled with the
+// lag of the member. The edges to learners are dashed and the unreachable
+// members are red.
+func writeDOT(w io.Writer, t *topology) error {
+	var b strings.Builder
+	b.WriteString("digraph etcd {\n")
+	label := "etcd cluster " + t.ClusterID
+	if t.RaftTerm != 0 {
+		label += fmt.Sprintf(", raft term %d", t.RaftTerm)
+	}
+	if !t.Time.IsZero() {
+		label += ", " + t.Time.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	fmt.Fprintf(&b, "\tlabel=%q;\n", label)
+	b.WriteString("\tnode [shape=box];\n")
+
+	byZone := make(map[string][]member)
+	for _, m := range t.Members {
+		byZone[m.Zone] = append(byZone[m.Zone], m)
+	}
+	zones := make([]string, 0, len(byZone))
+	for zone := range byZone {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+	for _, zone := range zones {
+		indent := "\t"
+		if zone != "" {
+			fmt.Fprintf(&b, "\tsubgraph %q {\n\t\tlabel=%q;\n", "cluster_"+zone, zone)
+			indent = "\t\t"
+		}
+		for _, m := range byZone[zone] {
+			fmt.Fprintf(&b, "%s%q [%s];\n", indent, m.ID, dotNodeAttrs(m))
+		}
+		if zone != "" {
+			b.WriteString("\t}\n")
+		}
+	}
+
+	if t.Leader != "" {
+		for _, m := range t.Members {
+			if m.ID == t.Leader {
+				continue
+			}
+			attrs := fmt.Sprintf("label=%q", fmt.Sprintf("lag %d", m.Lag))
+			if m.Role == roleLearner {
+				attrs += ", style=dashed"
+			}
+			if !m.reachable() {
+				attrs = "label=\"unreachable\", color=red"
+			}
+			fmt.Fprintf(&b, "\t%q -> %q [%s];\n", t.Leader, m.ID, attrs)
+		}
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotNodeAttrs(m member) string {
+	name := m.Name
+	if name == "" {
+		name = m.ID
+	}
+	lines := []string{name, m.Role}
+	if m.reachable() {
+		lines = append(lines,
+			fmt.Sprintf("index %d, applied %d", m.RaftIndex, m.RaftAppliedIndex),
+			fmt.Sprintf("%.1fms, %s", m.LatencyMs, humanize.Bytes(uint64(m.DBSize))),
+		)
+	} else {
+		lines = append(lines, "unreachable")
+	}
+	attrs := fmt.Sprintf("label=%q", strings.Join(lines, "\n"))
+	switch {
+	case !m.reachable():
+		attrs += ", color=red"
+	case m.Role == roleLeader:
+		attrs += ", style=bold"
+	case m.Role == roleLearner:
+		attrs += ", style=dashed"
+	}
+	return attrs
+}
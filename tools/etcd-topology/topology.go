@@ -0,0 +1,178 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	roleLeader   = "leader"
+	roleFollower = "follower"
+	roleLearner  = "learner"
+)
+
+// clusterClient is the part of clientv3.Client queried for the topology.
+type clusterClient interface {
+	MemberList(ctx context.Context, opts ...clientv3.OpOption) (*clientv3.MemberListResponse, error)
+	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
+}
+
+// topology is a snapshot of the members of a cluster and their raft state.
+type topology struct {
+	ClusterID string    `json:"cluster_id"`
+	Time      time.Time `json:"time"`
+	// Leader is the ID of the leader, empty if no member reported one.
+	Leader   string   `json:"leader,omitempty"`
+	RaftTerm uint64   `json:"raft_term"`
+	Members  []member `json:"members"`
+}
+
+type member struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Role       string   `json:"role"`
+	Zone       string   `json:"zone,omitempty"`
+	PeerURLs   []string `json:"peer_urls"`
+	ClientURLs []string `json:"client_urls"`
+
+	// Endpoint is the client URL the status of the member was queried from.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Error is set if the status of the member could not be queried.
+	Error string `json:"error,omitempty"`
+	// LatencyMs is the round trip time of the status request.
+	LatencyMs float64 `json:"latency_ms,omitempty"`
+
+	Version          string `json:"version,omitempty"`
+	DBSize           int64  `json:"db_size,omitempty"`
+	RaftTerm         uint64 `json:"raft_term,omitempty"`
+	RaftIndex        uint64 `json:"raft_index,omitempty"`
+	RaftAppliedIndex uint64 `json:"raft_applied_index,omitempty"`
+	// Lag is the number of raft entries the member is behind the leader.
+	Lag uint64 `json:"lag"`
+	// ApplyLag is the number of committed raft entries the member has not applied yet.
+	ApplyLag uint64 `json:"apply_lag"`
+}
+
+func (m *member) reachable() bool { return m.Endpoint != "" && m.Error == "" }
+
+// collectTopology lists the members of the cluster and queries the status of
+// each of them through its first client URL.
+func collectTopology(ctx context.Context, c clusterClient, zones map[string]string, timeout time.Duration) (*topology, error) {
+	mctx, cancel := context.WithTimeout(ctx, timeout)
+	resp, err := c.MemberList(mctx)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	t := &topology{ClusterID: fmt.Sprintf("%x", resp.Header.ClusterId)}
+	statuses := make([]*etcdserverpb.StatusResponse, len(resp.Members))
+	for i, m := range resp.Members {
+		mb := member{
+			ID:         fmt.Sprintf("%x", m.ID),
+			Name:       m.Name,
+			Role:       roleFollower,
+			Zone:       zones[m.Name],
+			PeerURLs:   m.PeerURLs,
+			ClientURLs: m.ClientURLs,
+		}
+		if m.IsLearner {
+			mb.Role = roleLearner
+		}
+		if len(m.ClientURLs) == 0 {
+			mb.Error = "member not started"
+			t.Members = append(t.Members, mb)
+			continue
+		}
+
+		mb.Endpoint = m.ClientURLs[0]
+		sctx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		st, err := c.Status(sctx, mb.Endpoint)
+		cancel()
+		if err != nil {
+			mb.Error = err.Error()
+			t.Members = append(t.Members, mb)
+			continue
+		}
+		mb.LatencyMs = float64(time.Since(start).Microseconds()) / 1000
+		mb.Version = st.Version
+		mb.DBSize = st.DbSize
+		mb.RaftTerm = st.RaftTerm
+		mb.RaftIndex = st.RaftIndex
+		mb.RaftAppliedIndex = st.RaftAppliedIndex
+		if st.RaftIndex > st.RaftAppliedIndex {
+			mb.ApplyLag = st.RaftIndex - st.RaftAppliedIndex
+		}
+		statuses[i] = (*etcdserverpb.StatusResponse)(st)
+		t.Members = append(t.Members, mb)
+	}
+
+	// the leader is the one reported by the members of the highest term
+	var lead uint64
+	for _, st := range statuses {
+		if st != nil && st.Leader != 0 && st.RaftTerm >= t.RaftTerm {
+			lead, t.RaftTerm = st.Leader, st.RaftTerm
+		}
+	}
+	if lead != 0 {
+		t.Leader = fmt.Sprintf("%x", lead)
+	}
+	var leadIndex uint64
+	for i := range t.Members {
+		if t.Members[i].ID == t.Leader {
+			t.Members[i].Role = roleLeader
+			leadIndex = t.Members[i].RaftIndex
+		}
+	}
+	for i := range t.Members {
+		if mb := &t.Members[i]; mb.reachable() && leadIndex > mb.RaftIndex {
+			mb.Lag = leadIndex - mb.RaftIndex
+		}
+	}
+
+	sort.Slice(t.Members, func(i, j int) bool {
+		if t.Members[i].Name != t.Members[j].Name {
+			return t.Members[i].Name < t.Members[j].Name
+		}
+		return t.Members[i].ID < t.Members[j].ID
+	})
+	return t, nil
+}
+
+// parseZones parses a comma separated list of member name=zone pairs.
+func parseZones(s string) (map[string]string, error) {
+	zones := make(map[string]string)
+	if s == "" {
+		return zones, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		name, zone, ok := strings.Cut(pair, "=")
+		if !ok || name == "" || zone == "" {
+			return nil, errors.New("zones must be a comma separated list of member name=zone pairs")
+		}
+		zones[name] = zone
+	}
+	return zones, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type fakeClient struct {
+	members  []*etcdserverpb.Member
+	statuses map[string]*etcdserverpb.StatusResponse
+}
+
+func (c *fakeClient) MemberList(context.Context, ...clientv3.OpOption) (*clientv3.MemberListResponse, error) {
+	return &clientv3.MemberListResponse{Header: &etcdserverpb.ResponseHeader{ClusterId: 0xc1}, Members: c.members}, nil
+}
+
+func (c *fakeClient) Status(_ context.Context, ep string) (*clientv3.StatusResponse, error) {
+	st, ok := c.statuses[ep]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+	return (*clientv3.StatusResponse)(st), nil
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		members: []*etcdserverpb.Member{
+			{ID: 0xc, Name: "m3", ClientURLs: []string{"http://m3:2379"}, IsLearner: true},
+			{ID: 0xa, Name: "m1", ClientURLs: []string{"http://m1:2379"}},
+			{ID: 0xb, Name: "m2", ClientURLs: []string{"http://m2:2379"}},
+			{ID: 0xd, Name: "m4", ClientURLs: []string{"http://m4:2379"}},
+			{ID: 0xe},
+		},
+		statuses: map[string]*etcdserverpb.StatusResponse{
+			"http://m1:2379": {Leader: 0xa, RaftTerm: 3, RaftIndex: 100, RaftAppliedIndex: 100, DbSize: 1000},
+			"http://m2:2379": {Leader: 0xa, RaftTerm: 3, RaftIndex: 90, RaftAppliedIndex: 85, DbSize: 1000},
+			"http://m3:2379": {Leader: 0xb, RaftTerm: 2, RaftIndex: 40, RaftAppliedIndex: 40, DbSize: 1000},
+		},
+	}
+}
+
+func TestCollectTopology(t *testing.T) {
+	topo, err := collectTopology(context.Background(), newFakeClient(), map[string]string{"m1": "z1", "m2": "z2"}, time.Second)
+	require.NoError(t, err)
+
+	assert.Equal(t, "c1", topo.ClusterID)
+	assert.Equal(t, "a", topo.Leader)
+	assert.Equal(t, uint64(3), topo.RaftTerm)
+
+	type summary struct {
+		id, role, zone string
+		lag, applyLag  uint64
+		reachable      bool
+	}
+	var got []summary
+	for _, m := range topo.Members {
+		got = append(got, summary{m.ID, m.Role, m.Zone, m.Lag, m.ApplyLag, m.reachable()})
+	}
+	assert.Equal(t, []summary{
+		{"e", roleFollower, "", 0, 0, false},
+		{"a", roleLeader, "z1", 0, 0, true},
+		{"b", roleFollower, "z2", 10, 5, true},
+		{"c", roleLearner, "", 60, 0, true},
+		{"d", roleFollower, "", 0, 0, false},
+	}, got)
+	assert.Equal(t, "member not started", topo.Members[0].Error)
+	assert.Equal(t, "connection refused", topo.Members[4].Error)
+}
+
+func TestWriteDOT(t *testing.T) {
+	c := newFakeClient()
+	c.members = c.members[:3]
+	topo, err := collectTopology(context.Background(), c, map[string]string{"m1": "z1", "m2": "z1"}, time.Second)
+	require.NoError(t, err)
+	for i := range topo.Members {
+		topo.Members[i].LatencyMs = 1.5
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, writeDOT(&b, topo))
+	assert.Equal(t, `digraph etcd {
+	label="etcd cluster c1, raft term 3";
+	node [shape=box];
+	"c" [label="m3\nlearner\nindex 40, applied 40\n1.5ms, 1.0 kB", style=dashed];
+	subgraph "cluster_z1" {
+		label="z1";
+		"a" [label="m1\nleader\nindex 100, applied 100\n1.5ms, 1.0 kB", style=bold];
+		"b" [label="m2\nfollower\nindex 90, applied 85\n1.5ms, 1.0 kB"];
+	}
+	"a" -> "b" [label="lag 10"];
+	"a" -> "c" [label="lag 60", style=dashed];
+}
+`, b.String())
+}
+
+func TestParseZones(t *testing.T) {
+	zones, err := parseZones("m1=z1,m2=z2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"m1": "z1", "m2": "z2"}, zones)
+
+	_, err = parseZones("m1")
+	require.Error(t, err)
+}